@@ -25,6 +25,12 @@ func main() {
 	}
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	if shutdownErr := provider.ShutdownTracing(context.Background()); shutdownErr != nil {
+		log.Printf("[WARN] %s", shutdownErr)
+	}
+	if closeErr := provider.CloseClients(); closeErr != nil {
+		log.Printf("[WARN] %s", closeErr)
+	}
 	if err != nil {
 		log.Fatal(err.Error())
 	}
@@ -0,0 +1,60 @@
+// ABOUTME: Entry point for zenfra-mockserver, an in-memory Zenfra API for developing configurations locally.
+// ABOUTME: Serves internal/mockserver on a local address with flags for the token and fault injection.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/mockserver"
+)
+
+func main() {
+	var (
+		addr          string
+		token         string
+		latency       time.Duration
+		rateLimitRate float64
+		retryAfter    time.Duration
+	)
+
+	flag.StringVar(&addr, "listen", "127.0.0.1:8089", "address to listen on")
+	flag.StringVar(&token, "token", "", "API token requests must present; empty accepts any token")
+	flag.DurationVar(&latency, "latency", 0, "latency added to every response, e.g. 200ms")
+	flag.Float64Var(&rateLimitRate, "rate-limit-rate", 0, "fraction of requests, from 0 to 1, answered with 429 Too Many Requests")
+	flag.DurationVar(&retryAfter, "retry-after", time.Second, "Retry-After sent with injected 429 responses")
+	flag.Parse()
+
+	if rateLimitRate < 0 || rateLimitRate > 1 {
+		log.Fatalf("-rate-limit-rate must be between 0 and 1, got %v", rateLimitRate)
+	}
+
+	server := &http.Server{
+		Addr: addr,
+		Handler: mockserver.New(mockserver.Config{
+			Token:         token,
+			Latency:       latency,
+			RateLimitRate: rateLimitRate,
+			RetryAfter:    retryAfter,
+		}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		_ = server.Shutdown(context.Background())
+	}()
+
+	log.Printf("zenfra-mockserver listening on http://%s; point the provider at it with ZENFRA_API_ENDPOINT=http://%s", addr, addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatal(err.Error())
+	}
+}
@@ -0,0 +1,56 @@
+// ABOUTME: Entry point for zenfra-schema-export, which writes the provider's schema catalog as JSON.
+// ABOUTME: The catalog adds validators, replacement markers, and import ID formats to terraform providers schema output.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/provider"
+	"github.com/zenfra/terraform-provider-zenfra/internal/schemaexport"
+)
+
+var version = "dev"
+
+func main() {
+	var output string
+
+	flag.StringVar(&output, "output", "", "file to write the catalog to; empty writes to stdout")
+	flag.Parse()
+
+	catalog, err := schemaexport.Export(context.Background(), provider.New(version)(), version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	if output == "" {
+		if err := write(os.Stdout, catalog); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
+	// Only the file opened here is closed; its Close error can mean a failed write.
+	f, err := os.Create(output)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if err := write(f, catalog); err != nil {
+		f.Close() //nolint:errcheck // the write error is reported instead
+		log.Fatal(err.Error())
+	}
+	if err := f.Close(); err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+// write encodes the catalog as indented JSON.
+func write(w io.Writer, catalog *schemaexport.Catalog) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(catalog)
+}
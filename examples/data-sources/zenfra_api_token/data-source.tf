@@ -0,0 +1,9 @@
+# Reference a token created in the Zenfra UI, e.g. to scope a rate limit policy to it.
+data "zenfra_api_token" "ci" {
+  id = var.ci_token_id
+}
+
+resource "zenfra_rate_limit_policy" "ci" {
+  token_id            = data.zenfra_api_token.ci.id
+  requests_per_minute = 600
+}
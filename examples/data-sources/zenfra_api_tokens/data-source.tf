@@ -0,0 +1,9 @@
+# Active admin tokens, e.g. to review them before a credential rotation.
+data "zenfra_api_tokens" "admins" {
+  role   = "admin"
+  active = true
+}
+
+output "admin_tokens" {
+  value = { for t in data.zenfra_api_tokens.admins.tokens : t.name => t.expires_at }
+}
@@ -0,0 +1,8 @@
+# Review which stacks pick up a change to the shared AWS bundle before applying it.
+data "zenfra_bundle_attached_stacks" "aws_defaults" {
+  bundle_id = zenfra_bundle.aws_defaults.id
+}
+
+output "aws_defaults_blast_radius" {
+  value = [for s in data.zenfra_bundle_attached_stacks.aws_defaults.stacks : "${s.name} (via ${s.attached_via})"]
+}
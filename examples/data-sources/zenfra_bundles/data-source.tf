@@ -0,0 +1,9 @@
+# List bundles in a space
+data "zenfra_bundles" "shared" {
+  space_id = zenfra_space.production.id
+}
+
+resource "zenfra_bundle_attachment" "aws" {
+  stack_id  = zenfra_stack.app.id
+  bundle_id = data.zenfra_bundles.shared.as_map["aws-credentials"].id
+}
@@ -0,0 +1,15 @@
+# Export last quarter's run and approval evidence for the auditors.
+data "zenfra_compliance_report" "q1" {
+  from     = "2026-01-01T00:00:00Z"
+  to       = "2026-04-01T00:00:00Z"
+  sections = ["runs", "approvals"]
+}
+
+output "evidence_sha256" {
+  value = data.zenfra_compliance_report.q1.sha256
+}
+
+output "evidence_url" {
+  value     = data.zenfra_compliance_report.q1.download_url
+  sensitive = true
+}
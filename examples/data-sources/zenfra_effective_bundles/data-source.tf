@@ -0,0 +1,14 @@
+# Find out which bundle sets AWS_REGION for the stack, and which ones it shadows.
+data "zenfra_effective_bundles" "api" {
+  stack_id = zenfra_stack.api.id
+}
+
+output "api_bundle_order" {
+  value = [for b in data.zenfra_effective_bundles.api.bundles : "${b.slug} (via ${b.attached_via}, overrides lost: ${join(",", b.overridden_keys)})"]
+}
+
+# Only the AWS bundles, still in the order they are applied.
+data "zenfra_effective_bundles" "api_aws" {
+  stack_id = zenfra_stack.api.id
+  label    = "aws"
+}
@@ -0,0 +1,18 @@
+# Allow Zenfra runs in the EU region to reach a private API over HTTPS.
+data "zenfra_egress_ip_ranges" "eu" {
+  regions = ["eu"]
+}
+
+resource "aws_security_group" "internal_api" {
+  name   = "internal-api"
+  vpc_id = var.vpc_id
+
+  ingress {
+    description      = "Zenfra runners"
+    from_port        = 443
+    to_port          = 443
+    protocol         = "tcp"
+    cidr_blocks      = data.zenfra_egress_ip_ranges.eu.cidr_blocks
+    ipv6_cidr_blocks = data.zenfra_egress_ip_ranges.eu.ipv6_cidr_blocks
+  }
+}
@@ -0,0 +1,26 @@
+# Follow the newest OpenTofu 1.9 patch release.
+data "zenfra_iac_versions" "tofu" {
+  engine         = "opentofu"
+  version_prefix = "1.9"
+}
+
+resource "zenfra_stack" "network" {
+  name     = "network"
+  space_id = zenfra_space.platform.id
+
+  iac = {
+    engine  = "opentofu"
+    version = data.zenfra_iac_versions.tofu.latest_matching
+  }
+
+  source = {
+    type = "raw_git"
+    raw_git = {
+      url = "https://github.com/example/network.git"
+      ref = {
+        type = "branch"
+        name = "main"
+      }
+    }
+  }
+}
@@ -0,0 +1,15 @@
+# Adopt everything in a space that was set up in the UI. Apply this once, review
+# the generated files, then move them into the configuration and run terraform plan.
+data "zenfra_import_plan" "legacy" {
+  space_id = "space-abc123"
+}
+
+resource "local_file" "imports" {
+  filename = "${path.module}/adopt/imports.tf"
+  content  = data.zenfra_import_plan.legacy.import_blocks
+}
+
+resource "local_file" "resources" {
+  filename = "${path.module}/adopt/resources.tf"
+  content  = data.zenfra_import_plan.legacy.configuration
+}
@@ -0,0 +1,5 @@
+data "zenfra_rate_limit_policies" "all" {}
+
+output "max_requests_per_minute" {
+  value = data.zenfra_rate_limit_policies.all.max_requests_per_minute
+}
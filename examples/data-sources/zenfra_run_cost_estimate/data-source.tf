@@ -0,0 +1,10 @@
+# Stop the pipeline if the proposed run adds more than 500 (in the
+# estimate's currency) to the monthly bill.
+data "zenfra_run_cost_estimate" "proposed" {
+  run_id            = var.run_id
+  max_monthly_delta = 500
+}
+
+output "monthly_cost_change" {
+  value = "${data.zenfra_run_cost_estimate.proposed.delta_monthly_cost} ${data.zenfra_run_cost_estimate.proposed.currency}"
+}
@@ -0,0 +1,11 @@
+# Read the apply output of the production stack's latest run, e.g. to post it
+# on the pull request that triggered it.
+data "zenfra_run_logs" "production_apply" {
+  stack_id   = zenfra_stack.production.id
+  phase      = "apply"
+  tail_lines = 50
+}
+
+output "production_apply_output" {
+  value = data.zenfra_run_logs.production_apply.text
+}
@@ -0,0 +1,11 @@
+data "zenfra_run_plan" "network" {
+  run_id = var.network_run_id
+}
+
+# Refuse to continue if the upstream run would delete anything
+check "no_deletions" {
+  assert {
+    condition     = !data.zenfra_run_plan.network.has_deletions
+    error_message = "Run ${data.zenfra_run_plan.network.run_id} deletes ${data.zenfra_run_plan.network.summary.destroy} resource(s)."
+  }
+}
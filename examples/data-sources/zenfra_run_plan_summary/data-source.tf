@@ -0,0 +1,15 @@
+# Gate the apply of a pending run on manual approval when it replaces more than
+# two resources or destroys any.
+data "zenfra_run_plan_summary" "network" {
+  run_id           = var.network_run_id
+  max_destroys     = 0
+  max_replacements = 2
+}
+
+output "network_requires_approval" {
+  value = data.zenfra_run_plan_summary.network.threshold_exceeded
+}
+
+output "network_replaced" {
+  value = data.zenfra_run_plan_summary.network.replaced_addresses
+}
@@ -0,0 +1,7 @@
+data "zenfra_signing_key" "release" {
+  name = "release-2026"
+}
+
+output "release_key_fingerprint" {
+  value = data.zenfra_signing_key.release.fingerprint
+}
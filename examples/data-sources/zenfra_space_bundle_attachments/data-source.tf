@@ -0,0 +1,7 @@
+data "zenfra_space_bundle_attachments" "production" {
+  space_id = zenfra_space.production.id
+}
+
+output "production_bundle_ids" {
+  value = data.zenfra_space_bundle_attachments.production.attachments[*].bundle_id
+}
@@ -0,0 +1,23 @@
+data "zenfra_spaces" "all" {}
+
+# Look up a space by slug instead of re-indexing the list
+resource "zenfra_stack" "api" {
+  name     = "API"
+  space_id = data.zenfra_spaces.all.as_map["production"].id
+
+  iac {
+    engine  = "opentofu"
+    version = "1.8.0"
+  }
+
+  source {
+    type = "raw_git"
+    raw_git {
+      url = "https://github.com/example/api-infra.git"
+      ref {
+        type = "branch"
+        name = "main"
+      }
+    }
+  }
+}
@@ -1,3 +1,22 @@
 data "zenfra_stack" "app" {
   id = "st-abc123"
 }
+
+# Non-secret variables of the stack, as a map of key to value.
+output "app_variables" {
+  value = { for v in data.zenfra_stack.app.variables : v.key => v.value if !v.secret }
+}
+
+output "app_bundle_ids" {
+  value = data.zenfra_stack.app.attached_bundle_ids
+}
+
+# Fails the run of a monitoring configuration while the stack has drifted.
+output "app_health" {
+  value = data.zenfra_stack.app.health
+
+  precondition {
+    condition     = data.zenfra_stack.app.health != "drifted"
+    error_message = "Stack ${data.zenfra_stack.app.name} drifted at ${data.zenfra_stack.app.drift_detected_at}."
+  }
+}
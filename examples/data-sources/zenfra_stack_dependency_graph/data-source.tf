@@ -0,0 +1,14 @@
+# Fail CI when stacks in the platform space depend on each other in a cycle
+data "zenfra_stack_dependency_graph" "platform" {
+  space_id      = zenfra_space.platform.id
+  fail_on_cycle = true
+}
+
+# Render the edges as a Graphviz diagram
+output "platform_dependencies_dot" {
+  value = join("\n", concat(
+    ["digraph stacks {"],
+    [for e in data.zenfra_stack_dependency_graph.platform.edges : "  \"${e.from_stack_id}\" -> \"${e.to_stack_id}\" [label=\"${e.kind}\"];"],
+    ["}"],
+  ))
+}
@@ -0,0 +1,15 @@
+# Stop the promotion pipeline if the staging stack's latest run failed any
+# policy, including soft-mandatory and advisory ones.
+data "zenfra_stack_policy_check" "staging" {
+  stack_id           = zenfra_stack.staging.id
+  fail_on_violations = true
+}
+
+# Or inspect the results without failing the plan
+data "zenfra_stack_policy_check" "production" {
+  stack_id = zenfra_stack.production.id
+}
+
+output "production_violated_rules" {
+  value = data.zenfra_stack_policy_check.production.violated_rules
+}
@@ -0,0 +1,12 @@
+data "zenfra_stack_templates" "all" {}
+
+resource "zenfra_stack" "network" {
+  name        = "network"
+  space_id    = zenfra_space.production.id
+  template_id = data.zenfra_stack_templates.all.as_map["aws-vpc"].id
+
+  iac = {
+    engine  = data.zenfra_stack_templates.all.as_map["aws-vpc"].iac_engine
+    version = data.zenfra_stack_templates.all.as_map["aws-vpc"].iac_version
+  }
+}
@@ -2,3 +2,16 @@
 data "zenfra_stacks" "production" {
   space_id = zenfra_space.production.id
 }
+
+output "network_stack_id" {
+  value = data.zenfra_stacks.production.as_map["Network Stack"].id
+}
+
+# List every production stack in the organization, e.g. for an audit
+data "zenfra_stacks" "all_production" {
+  environment_type = "production"
+}
+
+output "production_stack_names" {
+  value = data.zenfra_stacks.all_production.stacks[*].name
+}
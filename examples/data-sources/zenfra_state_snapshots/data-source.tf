@@ -0,0 +1,7 @@
+data "zenfra_state_snapshots" "network" {
+  stack_id = zenfra_stack.network.id
+}
+
+output "network_previous_snapshot" {
+  value = data.zenfra_state_snapshots.network.snapshots[1].id
+}
@@ -0,0 +1,9 @@
+data "zenfra_usage" "current" {}
+
+output "run_minutes_remaining" {
+  value = data.zenfra_usage.current.run_minutes.remaining
+}
+
+output "worker_slot_utilization" {
+  value = data.zenfra_usage.current.worker_slots.used / data.zenfra_usage.current.worker_slots.limit
+}
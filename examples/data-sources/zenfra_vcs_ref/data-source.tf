@@ -0,0 +1,30 @@
+data "zenfra_vcs_ref" "network_release" {
+  integration_id = zenfra_vcs_integration.github.id
+  repository_id  = "acme/network"
+  ref            = "release/1.4"
+  ref_type       = "branch"
+}
+
+# Pin the stack to the commit the release branch pointed at during plan
+resource "zenfra_stack" "network" {
+  name     = "network"
+  space_id = zenfra_space.production.id
+
+  iac = {
+    engine  = "opentofu"
+    version = "1.8.0"
+  }
+
+  source = {
+    type = "vcs"
+    vcs = {
+      provider       = "github"
+      integration_id = zenfra_vcs_integration.github.id
+      repository_id  = data.zenfra_vcs_ref.network_release.repository_id
+      ref = {
+        type = "commit"
+        name = data.zenfra_vcs_ref.network_release.commit_sha
+      }
+    }
+  }
+}
@@ -0,0 +1,7 @@
+data "zenfra_webhook_endpoint" "audit" {
+  name = "audit-log-forwarder"
+}
+
+output "audit_webhook_url" {
+  value = data.zenfra_webhook_endpoint.audit.url
+}
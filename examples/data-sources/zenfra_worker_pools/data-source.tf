@@ -1 +1,14 @@
 data "zenfra_worker_pools" "all" {}
+
+output "private_pool_id" {
+  value = data.zenfra_worker_pools.all.as_map["private"].id
+}
+
+# Read every pool's details in one data source, e.g. to find pools without workers
+data "zenfra_worker_pools" "detailed" {
+  include_details = true
+}
+
+output "idle_pools" {
+  value = [for p in data.zenfra_worker_pools.detailed.pools : p.name if p.active_workers_count == 0]
+}
@@ -0,0 +1,23 @@
+resource "zenfra_stack" "network" {
+  name     = "network"
+  space_id = zenfra_space.production.id
+
+  iac = {
+    engine  = "opentofu"
+    version = "1.8.0"
+  }
+
+  source = {
+    type = "vcs"
+    vcs = {
+      provider       = "gitlab"
+      integration_id = zenfra_vcs_integration.gitlab.id
+      # "platform/networking/network"
+      repository_id = provider::zenfra::repository_id("gitlab", "platform/networking", "network")
+      ref = {
+        type = "branch"
+        name = "main"
+      }
+    }
+  }
+}
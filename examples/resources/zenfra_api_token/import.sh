@@ -1 +1,4 @@
 terraform import zenfra_api_token.ci $TOKEN_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_api_token.ci "$ORGANIZATION_ID/$TOKEN_ID"
@@ -3,6 +3,14 @@ resource "zenfra_api_token" "ci" {
   description     = "Token for CI/CD automation"
   role            = "write"
   expires_in_days = 90
+
+  # Replace the token once fewer than 14 days remain, creating the new one
+  # before the old one is revoked.
+  rotate_before_expiry_days = 14
+
+  lifecycle {
+    create_before_destroy = true
+  }
 }
 
 # The token value is only available at creation time.
@@ -0,0 +1 @@
+terraform import zenfra_bundle.aws_credentials $BUNDLE_ID
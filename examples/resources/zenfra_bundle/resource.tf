@@ -0,0 +1,34 @@
+resource "zenfra_bundle" "aws_credentials" {
+  name        = "AWS Credentials"
+  slug        = "aws-credentials"
+  space_id    = zenfra_space.production.id
+  description = "AWS credentials for production workloads"
+  labels      = ["aws", "production"]
+
+  # Fail the plan, not the apply, when the content breaks a server-side rule.
+  validate_content = true
+
+  environment_variable {
+    key    = "AWS_REGION"
+    value  = "us-east-1"
+    secret = false
+  }
+
+  environment_variable {
+    key    = "AWS_ACCESS_KEY_ID"
+    value  = var.aws_access_key_id
+    secret = true
+  }
+
+  mounted_file {
+    path        = "/etc/config/settings.json"
+    content     = file("${path.module}/settings.json")
+    description = "Application settings"
+    secret      = false
+  }
+
+  mounted_file {
+    path   = "/etc/ssl/certs/internal-ca.pem"
+    source = "${path.module}/files/internal-ca.pem"
+  }
+}
@@ -1,2 +1,5 @@
 # Import using composite ID: stack_id:bundle_id
 terraform import zenfra_bundle_attachment.app_aws $STACK_ID:$BUNDLE_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_bundle_attachment.app_aws "$ORGANIZATION_ID/$STACK_ID:$BUNDLE_ID"
@@ -0,0 +1,4 @@
+terraform import zenfra_bundle_secret_reference.db_password $BUNDLE_ID:$REFERENCE_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_bundle_secret_reference.db_password "$ORGANIZATION_ID/$BUNDLE_ID:$REFERENCE_ID"
@@ -0,0 +1,22 @@
+resource "zenfra_bundle" "database" {
+  name     = "Production Database"
+  slug     = "production-database"
+  space_id = zenfra_space.production.id
+}
+
+# Exposed to runs as DB_PASSWORD. The value is read from Vault when each
+# run starts and never stored in Zenfra or in Terraform state.
+resource "zenfra_bundle_secret_reference" "db_password" {
+  bundle_id  = zenfra_bundle.database.id
+  name       = "DB_PASSWORD"
+  backend_id = zenfra_secret_backend.vault.id
+  path       = "kv/data/production/database"
+  key        = "password"
+}
+
+resource "zenfra_bundle_secret_reference" "api_key" {
+  bundle_id  = zenfra_bundle.database.id
+  name       = "PAYMENTS_API_KEY"
+  backend_id = zenfra_secret_backend.aws.id
+  path       = "production/payments-api-key"
+}
@@ -1 +0,0 @@
-terraform import zenfra_configuration_bundle.aws_credentials $BUNDLE_ID
@@ -1,26 +1,12 @@
-resource "zenfra_configuration_bundle" "aws_credentials" {
-  name        = "AWS Credentials"
-  slug        = "aws-credentials"
-  space_id    = zenfra_space.production.id
-  description = "AWS credentials for production workloads"
-  labels      = ["aws", "production"]
-
-  environment_variable {
-    key    = "AWS_REGION"
-    value  = "us-east-1"
-    secret = false
-  }
-
-  environment_variable {
-    key    = "AWS_ACCESS_KEY_ID"
-    value  = var.aws_access_key_id
-    secret = true
-  }
+# zenfra_configuration_bundle is deprecated. Rename the resource block to zenfra_bundle
+# and move its state with a moved block (Terraform 1.8 or later); the bundle is not
+# changed by the move.
+resource "zenfra_bundle" "aws_credentials" {
+  name = "AWS Credentials"
+  slug = "aws-credentials"
+}
 
-  mounted_file {
-    path        = "/etc/config/settings.json"
-    content     = file("${path.module}/settings.json")
-    description = "Application settings"
-    secret      = false
-  }
+moved {
+  from = zenfra_configuration_bundle.aws_credentials
+  to   = zenfra_bundle.aws_credentials
 }
@@ -0,0 +1,4 @@
+terraform import zenfra_environment_variable_set.defaults $VARIABLE_SET_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_environment_variable_set.defaults "$ORGANIZATION_ID/$VARIABLE_SET_ID"
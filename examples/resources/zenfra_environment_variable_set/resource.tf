@@ -0,0 +1,12 @@
+# Variables every stack in the organization receives.
+resource "zenfra_environment_variable_set" "defaults" {
+  name                  = "organization-defaults"
+  description           = "Settings shared by every stack"
+  applies_to_all_stacks = true
+}
+
+# Variables only the stacks selected by zenfra_environment_variable_set_scope receive.
+resource "zenfra_environment_variable_set" "observability" {
+  name        = "observability"
+  description = "Datadog settings for production stacks"
+}
@@ -0,0 +1,4 @@
+terraform import zenfra_environment_variable_set_scope.production $VARIABLE_SET_ID:$SCOPE_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_environment_variable_set_scope.production "$ORGANIZATION_ID/$VARIABLE_SET_ID:$SCOPE_ID"
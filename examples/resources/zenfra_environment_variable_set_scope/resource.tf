@@ -0,0 +1,11 @@
+# Every stack in the production space and its child spaces.
+resource "zenfra_environment_variable_set_scope" "production" {
+  variable_set_id = zenfra_environment_variable_set.observability.id
+  space_id        = zenfra_space.production.id
+}
+
+# Every stack labeled team:payments, in any space.
+resource "zenfra_environment_variable_set_scope" "payments" {
+  variable_set_id = zenfra_environment_variable_set.observability.id
+  stack_label     = "team:payments"
+}
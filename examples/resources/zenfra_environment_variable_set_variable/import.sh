@@ -0,0 +1,4 @@
+terraform import zenfra_environment_variable_set_variable.region $VARIABLE_SET_ID:AWS_REGION
+
+# Import from another organization the API token has access to
+terraform import zenfra_environment_variable_set_variable.region "$ORGANIZATION_ID/$VARIABLE_SET_ID:AWS_REGION"
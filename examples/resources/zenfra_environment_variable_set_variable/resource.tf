@@ -0,0 +1,15 @@
+resource "zenfra_environment_variable_set_variable" "region" {
+  variable_set_id = zenfra_environment_variable_set.defaults.id
+  key             = "AWS_REGION"
+  value           = "eu-west-1"
+}
+
+# The value of a secret variable is write-only: Zenfra never returns it, so it is
+# kept from the configuration.
+resource "zenfra_environment_variable_set_variable" "datadog_api_key" {
+  variable_set_id = zenfra_environment_variable_set.observability.id
+  key             = "DATADOG_API_KEY"
+  value           = var.datadog_api_key
+  secret          = true
+  description     = "Rotated monthly"
+}
@@ -0,0 +1,4 @@
+terraform import zenfra_membership_invitation.jane $INVITATION_ID
+
+# Import an invitation from another organization the API token has access to
+terraform import zenfra_membership_invitation.contractor "$ORGANIZATION_ID/$INVITATION_ID"
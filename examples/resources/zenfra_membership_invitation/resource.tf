@@ -0,0 +1,10 @@
+resource "zenfra_membership_invitation" "jane" {
+  email           = "jane@example.com"
+  role            = "write"
+  expires_in_days = 14
+
+  # Bump the value to email the invitation again and restart its expiry.
+  resend_triggers = {
+    reminder = "1"
+  }
+}
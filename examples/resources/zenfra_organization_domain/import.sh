@@ -0,0 +1,4 @@
+terraform import zenfra_organization_domain.example $DOMAIN_ID
+
+# Import a domain claimed by another organization the API token has access to
+terraform import zenfra_organization_domain.example "$ORGANIZATION_ID/$DOMAIN_ID"
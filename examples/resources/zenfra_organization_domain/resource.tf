@@ -0,0 +1,13 @@
+resource "zenfra_organization_domain" "example" {
+  domain         = "example.com"
+  auto_join_role = "read"
+}
+
+# Publish the verification token, e.g. with the AWS provider.
+resource "aws_route53_record" "zenfra_verification" {
+  zone_id = var.zone_id
+  name    = zenfra_organization_domain.example.verification_record_name
+  type    = "TXT"
+  ttl     = 300
+  records = [zenfra_organization_domain.example.verification_token]
+}
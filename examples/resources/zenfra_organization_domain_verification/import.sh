@@ -0,0 +1 @@
+terraform import zenfra_organization_domain_verification.example $DOMAIN_ID
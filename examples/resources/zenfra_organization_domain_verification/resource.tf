@@ -0,0 +1,8 @@
+resource "zenfra_organization_domain_verification" "example" {
+  domain_id = zenfra_organization_domain.example.id
+
+  # DNS changes can take a while to reach Zenfra's resolvers.
+  timeout_seconds = 1800
+
+  depends_on = [aws_route53_record.zenfra_verification]
+}
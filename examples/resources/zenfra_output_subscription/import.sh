@@ -0,0 +1,4 @@
+terraform import zenfra_output_subscription.app_network $STACK_ID:$SUBSCRIPTION_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_output_subscription.app_network "$ORGANIZATION_ID/$STACK_ID:$SUBSCRIPTION_ID"
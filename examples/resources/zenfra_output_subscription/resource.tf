@@ -0,0 +1,6 @@
+# Run the application stack whenever the network stack changes its VPC or subnets.
+resource "zenfra_output_subscription" "app_network" {
+  stack_id        = zenfra_stack.app.id
+  source_stack_id = zenfra_stack.network.id
+  outputs         = ["vpc_id", "private_subnet_ids"]
+}
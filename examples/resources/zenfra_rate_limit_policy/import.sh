@@ -0,0 +1,4 @@
+terraform import zenfra_rate_limit_policy.ci $POLICY_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_rate_limit_policy.ci "$ORGANIZATION_ID/$POLICY_ID"
@@ -0,0 +1,18 @@
+resource "zenfra_api_token" "ci" {
+  name = "ci-deploys"
+  role = "write"
+}
+
+# Let the CI token make more requests than the organization default.
+resource "zenfra_rate_limit_policy" "ci" {
+  description         = "CI pipelines apply many stacks in parallel"
+  token_id            = zenfra_api_token.ci.id
+  requests_per_minute = 3000
+  burst               = 500
+}
+
+# Throttle requests from a shared office network, whatever token they use.
+resource "zenfra_rate_limit_policy" "office" {
+  source_cidr         = "203.0.113.0/24"
+  requests_per_minute = 300
+}
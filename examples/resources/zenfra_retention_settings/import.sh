@@ -0,0 +1,3 @@
+# Import using the ID of the organization the provider is authenticated to, or of another
+# organization the API token has access to
+terraform import zenfra_retention_settings.this $ORGANIZATION_ID
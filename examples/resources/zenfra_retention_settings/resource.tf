@@ -0,0 +1,5 @@
+# Keep runs for a year for audits, but drop their logs after a month.
+resource "zenfra_retention_settings" "this" {
+  run_retention_days = 365
+  log_retention_days = 30
+}
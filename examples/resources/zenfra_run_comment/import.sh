@@ -0,0 +1,4 @@
+terraform import zenfra_run_comment.release $RUN_ID:$COMMENT_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_run_comment.release "$ORGANIZATION_ID/$RUN_ID:$COMMENT_ID"
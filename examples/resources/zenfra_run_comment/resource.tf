@@ -0,0 +1,14 @@
+variable "run_id" {
+  type        = string
+  description = "The run triggered by this pipeline."
+}
+
+resource "zenfra_run_comment" "release" {
+  run_id = var.run_id
+  body   = "Deployed release 1.4.0. See the changelog for details."
+
+  metadata = {
+    ticket  = "OPS-1234"
+    release = "1.4.0"
+  }
+}
@@ -0,0 +1,22 @@
+# At most eight runs execute at once across the organization. Production
+# runs are dequeued first and may use all eight slots; development runs
+# never take more than two.
+resource "zenfra_run_queue_settings" "this" {
+  max_parallel_runs         = 8
+  max_queued_runs           = 200
+  max_queued_runs_per_stack = 5
+
+  priority_classes = [
+    {
+      name      = "production"
+      priority  = 100
+      space_ids = [zenfra_space.production.id]
+    },
+    {
+      name              = "development"
+      priority          = 10
+      max_parallel_runs = 2
+      space_ids         = [zenfra_space.development.id]
+    },
+  ]
+}
@@ -0,0 +1,10 @@
+# Every worker pool without a pin of its own runs the newest 1.4.x runner.
+resource "zenfra_runner_version_constraint" "this" {
+  constraint = "~> 1.4.0"
+}
+
+# Canary pool: try the next minor release here before moving the default.
+resource "zenfra_worker_pool" "canary" {
+  name                      = "Canary Workers"
+  runner_version_constraint = "~> 1.5.0"
+}
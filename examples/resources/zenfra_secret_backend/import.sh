@@ -0,0 +1 @@
+terraform import zenfra_secret_backend.vault $SECRET_BACKEND_ID
@@ -0,0 +1,25 @@
+# Runs log in to Vault with their Zenfra identity token. Configure a JWT
+# auth role in Vault that trusts Zenfra's issuer.
+resource "zenfra_secret_backend" "vault" {
+  name = "prod-vault"
+  type = "vault"
+
+  vault = {
+    address     = "https://vault.example.com:8200"
+    namespace   = "platform"
+    auth_method = "jwt"
+    auth_mount  = "zenfra"
+    role        = "zenfra-production"
+  }
+}
+
+# Runs assume an IAM role whose trust policy allows Zenfra's OIDC provider.
+resource "zenfra_secret_backend" "aws" {
+  name = "prod-secrets-manager"
+  type = "aws_secrets_manager"
+
+  aws_secrets_manager = {
+    region   = "eu-west-1"
+    role_arn = "arn:aws:iam::123456789012:role/zenfra-secrets"
+  }
+}
@@ -0,0 +1 @@
+terraform import zenfra_signing_key.release $SIGNING_KEY_ID
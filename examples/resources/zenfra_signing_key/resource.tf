@@ -0,0 +1,13 @@
+resource "zenfra_signing_key" "release" {
+  name       = "release-2026"
+  public_key = file("${path.module}/keys/release-2026.pub.pem")
+  expires_at = "2027-01-01T00:00:00Z"
+}
+
+# Register the next key before the current one expires, so uploads
+# signed with either key are accepted during the rollover.
+resource "zenfra_signing_key" "next" {
+  name       = "release-2027"
+  public_key = file("${path.module}/keys/release-2027.pub.pem")
+  expires_at = "2028-01-01T00:00:00Z"
+}
@@ -1 +1,4 @@
 terraform import zenfra_space.production $SPACE_ID
+
+# Import a space from another organization the API token has access to
+terraform import zenfra_space.customer "$ORGANIZATION_ID/$SPACE_ID"
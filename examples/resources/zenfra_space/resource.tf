@@ -1,7 +1,9 @@
+# Locked against edits in the Zenfra UI, so changes only come from this configuration
 resource "zenfra_space" "production" {
-  name        = "Production"
-  slug        = "production"
-  description = "Production infrastructure"
+  name                = "Production"
+  slug                = "production"
+  description         = "Production infrastructure"
+  managed_exclusively = true
 }
 
 resource "zenfra_space" "production_us" {
@@ -11,3 +13,18 @@ resource "zenfra_space" "production_us" {
   parent_id       = zenfra_space.production.id
   inherit_bundles = true
 }
+
+# Ephemeral space whose child spaces and stacks are removed on destroy
+resource "zenfra_space" "preview" {
+  name          = "Preview"
+  slug          = "preview"
+  description   = "Short-lived preview environments"
+  force_destroy = true
+}
+
+# Managed in a customer's organization with a token that has access to it
+resource "zenfra_space" "customer" {
+  organization_id = var.customer_organization_id
+  name            = "Customer Workloads"
+  slug            = "customer-workloads"
+}
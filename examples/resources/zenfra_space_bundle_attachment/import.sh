@@ -0,0 +1,5 @@
+# Import using composite ID: space_id:bundle_id
+terraform import zenfra_space_bundle_attachment.production_aws $SPACE_ID:$BUNDLE_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_space_bundle_attachment.production_aws "$ORGANIZATION_ID/$SPACE_ID:$BUNDLE_ID"
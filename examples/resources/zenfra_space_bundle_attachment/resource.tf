@@ -0,0 +1,6 @@
+# Every stack in the production space, and in child spaces that inherit
+# bundles, receives the AWS credentials bundle.
+resource "zenfra_space_bundle_attachment" "production_aws" {
+  space_id  = zenfra_space.production.id
+  bundle_id = zenfra_bundle.aws_credentials.id
+}
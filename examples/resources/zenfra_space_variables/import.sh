@@ -0,0 +1,4 @@
+terraform import zenfra_space_variables.production $SPACE_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_space_variables.production "$ORGANIZATION_ID/$SPACE_ID"
@@ -0,0 +1,17 @@
+# Variables inherited by every stack in the production space.
+# A stack's own zenfra_stack_variables override keys set here.
+# Variables not listed here will be deleted (replace-all semantics).
+resource "zenfra_space_variables" "production" {
+  space_id = zenfra_space.production.id
+
+  variable {
+    key   = "AWS_REGION"
+    value = "eu-west-1"
+  }
+
+  variable {
+    key    = "TF_VAR_datadog_api_key"
+    value  = var.datadog_api_key
+    secret = true
+  }
+}
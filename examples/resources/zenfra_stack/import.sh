@@ -1 +1,4 @@
 terraform import zenfra_stack.app $STACK_ID
+
+# Also report import blocks for the stack's variables and bundle attachments
+terraform import zenfra_stack.app "$STACK_ID?include=variables,bundles"
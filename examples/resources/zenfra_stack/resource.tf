@@ -1,7 +1,15 @@
 # Stack using a raw git source
 resource "zenfra_stack" "app" {
-  name     = "Application Stack"
-  space_id = zenfra_space.production.id
+  name             = "Application Stack"
+  space_id         = zenfra_space.production.id
+  environment_type = "production"
+
+  # Failed runs page the owning team and notify the collaborators.
+  owner_team_id         = "team-platform"
+  collaborator_team_ids = ["team-payments"]
+
+  # Keep production runs longer than the organization default.
+  run_retention_days = 365
 
   iac {
     engine  = "terraform"
@@ -22,9 +30,42 @@ resource "zenfra_stack" "app" {
 
   triggers {
     on_push {
-      enabled = true
-      paths   = ["stacks/app/**"]
+      enabled      = true
+      paths        = ["stacks/app/**"]
+      branches     = ["main", "release/*"]
+      tags         = ["v*"]
+      ignore_paths = ["stacks/app/**/*.md"]
+    }
+
+    on_pull_request {
+      enabled          = true
+      speculative_only = true
+      comment_results  = true
+      paths            = ["stacks/app/**"]
     }
+
+    # Re-apply nightly to correct drift, and plan hourly on weekdays to surface it
+    on_schedule = [
+      { cron = "0 3 * * *", run_type = "apply" },
+      { cron = "0 * * * MON-FRI", run_type = "plan" },
+    ]
+  }
+
+  # Run security and cost checks inside every Zenfra run
+  runner_image = "ghcr.io/example/zenfra-runner:1.4"
+  before_plan  = ["tfsec --soft-fail .", "infracost breakdown --path ."]
+
+  environment = {
+    TF_LOG = "info"
+  }
+
+  # Refuse destroy runs unless the latest run passed the tagging policy and plan
+  required_checks_before_destroy = ["pol-tagging", "plan"]
+
+  # Let the other stacks of the space, and the network stack, read this stack's outputs
+  state_sharing = {
+    share_with_space  = true
+    allowed_stack_ids = [zenfra_stack.network.id]
   }
 }
 
@@ -51,4 +92,9 @@ resource "zenfra_stack" "network" {
       }
     }
   }
+
+  # Block until the repository has been cloned and validated, so runs can be
+  # triggered as soon as the apply finishes.
+  wait_for_ready        = true
+  ready_timeout_seconds = 300
 }
@@ -0,0 +1,4 @@
+terraform import zenfra_stack_from_manifest.app $STACK_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_stack_from_manifest.app "$ORGANIZATION_ID/$STACK_ID"
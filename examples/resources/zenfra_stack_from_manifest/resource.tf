@@ -0,0 +1,17 @@
+resource "zenfra_space" "apps" {
+  name = "apps"
+}
+
+# Keep the stack's definition next to the application code, in zenfra.yaml.
+resource "zenfra_stack_from_manifest" "app" {
+  space_id = zenfra_space.apps.id
+  manifest = file("${path.module}/app/zenfra.yaml")
+}
+
+output "app_stack" {
+  value = {
+    name   = zenfra_stack_from_manifest.app.name
+    engine = zenfra_stack_from_manifest.app.iac_engine
+    ref    = zenfra_stack_from_manifest.app.source_ref_name
+  }
+}
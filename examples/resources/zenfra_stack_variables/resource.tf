@@ -4,9 +4,10 @@ resource "zenfra_stack_variables" "app" {
   stack_id = zenfra_stack.app.id
 
   variable {
-    key    = "TF_VAR_environment"
-    value  = "production"
-    secret = false
+    key         = "TF_VAR_environment"
+    value       = "production"
+    secret      = false
+    description = "Environment name used in resource tags"
   }
 
   variable {
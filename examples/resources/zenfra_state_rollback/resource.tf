@@ -0,0 +1,13 @@
+data "zenfra_state_snapshots" "network" {
+  stack_id = zenfra_stack.network.id
+}
+
+# Restore the state written before the most recent apply. The rollback is
+# refused if another apply lands between plan and apply.
+resource "zenfra_state_rollback" "network" {
+  stack_id                = zenfra_stack.network.id
+  snapshot_id             = data.zenfra_state_snapshots.network.snapshots[1].id
+  confirm_stack_id        = zenfra_stack.network.id
+  expected_current_serial = data.zenfra_state_snapshots.network.snapshots[0].serial
+  reason                  = "Revert state after failed provider upgrade"
+}
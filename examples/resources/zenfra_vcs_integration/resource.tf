@@ -12,3 +12,16 @@ resource "zenfra_vcs_integration" "gitlab" {
   personal_access_token = var.gitlab_pat
   api_url               = "https://gitlab.example.com"
 }
+
+# GitLab integration using a group access token, sent to Zenfra again whenever the
+# token is rotated in the secret store
+resource "zenfra_vcs_integration" "gitlab_group" {
+  name                  = "GitLab Platform Group"
+  provider_type         = "gitlab"
+  personal_access_token = data.vault_kv_secret_v2.gitlab.data["token"]
+  token_type            = "group"
+
+  rotate_token_on_change_of = {
+    version = data.vault_kv_secret_v2.gitlab.metadata["version"]
+  }
+}
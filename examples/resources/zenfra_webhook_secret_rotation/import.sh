@@ -0,0 +1,5 @@
+# The secret cannot be read back, so it stays null until the next rotation.
+terraform import zenfra_webhook_secret_rotation.audit $WEBHOOK_ENDPOINT_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_webhook_secret_rotation.audit "$ORGANIZATION_ID/$WEBHOOK_ENDPOINT_ID"
@@ -0,0 +1,21 @@
+data "zenfra_webhook_endpoint" "audit" {
+  name = "audit-log-forwarder"
+}
+
+# Rotate the signing secret every 90 days and hand the new one to the receiver.
+resource "time_rotating" "webhook_secret" {
+  rotation_days = 90
+}
+
+resource "zenfra_webhook_secret_rotation" "audit" {
+  webhook_endpoint_id = data.zenfra_webhook_endpoint.audit.id
+
+  rotation_triggers = {
+    rotated = time_rotating.webhook_secret.id
+  }
+}
+
+resource "aws_secretsmanager_secret_version" "webhook_secret" {
+  secret_id     = "zenfra/audit-webhook-secret"
+  secret_string = zenfra_webhook_secret_rotation.audit.secret
+}
@@ -2,6 +2,44 @@ resource "zenfra_worker_pool" "private" {
   name = "Private Workers"
 }
 
+# A shared pool that only the platform and data teams' spaces may run on.
+resource "zenfra_worker_pool" "shared" {
+  name              = "Shared GPU Workers"
+  allowed_space_ids = [zenfra_space.platform.id, zenfra_space.data.id]
+}
+
+# No new runs start during the weekly patch window or the monthly reboot.
+resource "zenfra_worker_pool" "patched" {
+  name = "Patched Workers"
+
+  maintenance_windows = [
+    {
+      cron             = "0 2 * * SUN"
+      duration_minutes = 120
+      timezone         = "Europe/Berlin"
+    },
+    {
+      cron             = "0 4 1 * *"
+      duration_minutes = 60
+    },
+  ]
+}
+
+# Pinned to the 1.4 runner series while other pools follow the organization
+# default, so a runner upgrade can be rolled out one pool at a time.
+resource "zenfra_worker_pool" "pinned" {
+  name                      = "Pinned Workers"
+  runner_version_constraint = "~> 1.4"
+}
+
+# Being decommissioned: no new runs are scheduled, and destroying the pool
+# waits up to an hour for the runs still on it to finish.
+resource "zenfra_worker_pool" "legacy" {
+  name                  = "Legacy Workers"
+  drain                 = true
+  drain_timeout_seconds = 3600
+}
+
 # The api_key is returned only on creation — store it securely.
 output "worker_pool_api_key" {
   value     = zenfra_worker_pool.private.api_key
@@ -0,0 +1,5 @@
+# Import using the space ID
+terraform import zenfra_worker_pool_assignment.production $SPACE_ID
+
+# Import from another organization the API token has access to
+terraform import zenfra_worker_pool_assignment.production "$ORGANIZATION_ID/$SPACE_ID"
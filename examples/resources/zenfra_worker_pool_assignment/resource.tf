@@ -0,0 +1,6 @@
+# Run every stack in the production space on the private pool by default
+resource "zenfra_worker_pool_assignment" "production" {
+  space_id       = zenfra_space.production.id
+  worker_pool_id = zenfra_worker_pool.private.id
+  allow_override = false
+}
@@ -0,0 +1,180 @@
+// ABOUTME: Parser for five-field cron expressions (minute hour day-of-month month day-of-week).
+// ABOUTME: Shared by worker pool maintenance windows and the cron attribute validator.
+
+// Package cronexpr parses the cron expressions Zenfra accepts in schedules and computes
+// the times they match.
+package cronexpr
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed five-field cron expression: minute, hour, day of month,
+// month, and day of week.
+type Schedule struct {
+	minutes  []int
+	hours    []int
+	days     [32]bool
+	months   [13]bool
+	weekdays [7]bool
+	// Like cron, when both day fields are restricted a day matches if either does.
+	daysRestricted, weekdaysRestricted bool
+}
+
+var monthNames = map[string]int{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+var weekdayNames = map[string]int{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+// Parse parses a five-field cron expression. Fields accept *, numbers, ranges
+// (a-b), steps (*/n, a-b/n), and comma-separated lists; months and weekdays also
+// accept three-letter names, and 7 is Sunday.
+func Parse(expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+
+	minutes, err := parseField(fields[0], 0, 59, nil)
+	if err != nil {
+		return nil, fmt.Errorf("minute: %w", err)
+	}
+	hours, err := parseField(fields[1], 0, 23, nil)
+	if err != nil {
+		return nil, fmt.Errorf("hour: %w", err)
+	}
+	days, err := parseField(fields[2], 1, 31, nil)
+	if err != nil {
+		return nil, fmt.Errorf("day of month: %w", err)
+	}
+	months, err := parseField(fields[3], 1, 12, monthNames)
+	if err != nil {
+		return nil, fmt.Errorf("month: %w", err)
+	}
+	weekdays, err := parseField(fields[4], 0, 7, weekdayNames)
+	if err != nil {
+		return nil, fmt.Errorf("day of week: %w", err)
+	}
+
+	s := &Schedule{
+		minutes:            minutes,
+		hours:              hours,
+		daysRestricted:     !strings.HasPrefix(fields[2], "*"),
+		weekdaysRestricted: !strings.HasPrefix(fields[4], "*"),
+	}
+	for _, d := range days {
+		s.days[d] = true
+	}
+	for _, m := range months {
+		s.months[m] = true
+	}
+	for _, w := range weekdays {
+		s.weekdays[w%7] = true
+	}
+	return s, nil
+}
+
+// parseField returns the sorted values matched by one cron field.
+func parseField(field string, lo, hi int, names map[string]int) ([]int, error) {
+	set := make(map[int]bool)
+	for part := range strings.SplitSeq(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.IndexByte(part, '/'); i >= 0 {
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			rangePart, step = part[:i], n
+		}
+
+		start, end := lo, hi
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			a, b, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = fieldValue(a, lo, hi, names); err != nil {
+				return nil, err
+			}
+			if end, err = fieldValue(b, lo, hi, names); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("range %q is backwards", rangePart)
+			}
+		default:
+			v, err := fieldValue(rangePart, lo, hi, names)
+			if err != nil {
+				return nil, err
+			}
+			start = v
+			if step == 1 {
+				end = v
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			set[v] = true
+		}
+	}
+
+	values := make([]int, 0, len(set))
+	for v := range set {
+		values = append(values, v)
+	}
+	sort.Ints(values)
+	return values, nil
+}
+
+func fieldValue(s string, lo, hi int, names map[string]int) (int, error) {
+	if v, ok := names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < lo || v > hi {
+		return 0, fmt.Errorf("value %d is outside %d-%d", v, lo, hi)
+	}
+	return v, nil
+}
+
+// MatchesDay reports whether the schedule runs on the given local date.
+func (s *Schedule) MatchesDay(t time.Time) bool {
+	if !s.months[t.Month()] {
+		return false
+	}
+	dayOK, weekdayOK := s.days[t.Day()], s.weekdays[t.Weekday()]
+	if s.daysRestricted && s.weekdaysRestricted {
+		return dayOK || weekdayOK
+	}
+	return dayOK && weekdayOK
+}
+
+// Starts returns every start time of the schedule in loc from start (inclusive) for
+// the given number of days.
+func (s *Schedule) Starts(loc *time.Location, start time.Time, days int) []time.Time {
+	var out []time.Time
+	local := start.In(loc)
+	for i := range days {
+		day := time.Date(local.Year(), local.Month(), local.Day()+i, 0, 0, 0, 0, loc)
+		if !s.MatchesDay(day) {
+			continue
+		}
+		for _, h := range s.hours {
+			for _, m := range s.minutes {
+				out = append(out, time.Date(day.Year(), day.Month(), day.Day(), h, m, 0, 0, loc))
+			}
+		}
+	}
+	return out
+}
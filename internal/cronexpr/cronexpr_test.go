@@ -0,0 +1,69 @@
+// ABOUTME: Unit tests for the five-field cron expression parser.
+// ABOUTME: Covers lists, ranges, steps, names, and how the two day fields combine.
+package cronexpr
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr    string
+		wantErr string
+	}{
+		{expr: "0 2 * * SUN"},
+		{expr: "0,30 1-5/2 1,15 jan-mar mon-fri"},
+		{expr: "*/15 * * * 7"},
+		{expr: "0 2 * *", wantErr: "expected 5 fields"},
+		{expr: "60 2 * * *", wantErr: "minute: value 60 is outside 0-59"},
+		{expr: "0 2 * * FUNDAY", wantErr: "day of week: invalid value"},
+		{expr: "0 5-1 * * *", wantErr: "hour: range \"5-1\" is backwards"},
+		{expr: "*/0 * * * *", wantErr: "minute: invalid step"},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.expr)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("Parse(%q): %v", tt.expr, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Parse(%q): expected error containing %q, got %v", tt.expr, tt.wantErr, err)
+		}
+	}
+}
+
+func TestScheduleMatchesDay(t *testing.T) {
+	// 2028-01-01 is a Saturday.
+	sat := time.Date(2028, time.January, 1, 0, 0, 0, 0, time.UTC)
+	sun := sat.AddDate(0, 0, 1)
+	fifteenth := time.Date(2028, time.January, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		cron string
+		day  time.Time
+		want bool
+	}{
+		{cron: "0 0 * * SAT", day: sat, want: true},
+		{cron: "0 0 * * SAT", day: sun, want: false},
+		{cron: "0 0 * * 7", day: sun, want: true},
+		{cron: "0 0 15 * *", day: fifteenth, want: true},
+		// With both day fields restricted, either one matching is enough.
+		{cron: "0 0 15 * SUN", day: sun, want: true},
+		{cron: "0 0 15 * SUN", day: sat, want: false},
+		{cron: "0 0 * FEB *", day: sat, want: false},
+	}
+
+	for _, tt := range tests {
+		schedule, err := Parse(tt.cron)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.cron, err)
+		}
+		if got := schedule.MatchesDay(tt.day); got != tt.want {
+			t.Errorf("%q on %s: got %v, want %v", tt.cron, tt.day.Format("Mon 2006-01-02"), got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// ABOUTME: Data source for reading a single Zenfra API token by ID.
+// ABOUTME: Returns the token's metadata only; the secret is shown once, when the token is created.
+package api_token
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type apiTokenDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &apiTokenDataSource{}
+var _ datasource.DataSourceWithConfigure = &apiTokenDataSource{}
+
+func NewAPITokenDataSource() datasource.DataSource {
+	return &apiTokenDataSource{}
+}
+
+func (d *apiTokenDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_token"
+}
+
+func (d *apiTokenDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := apiTokenAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "The unique identifier of the token.",
+		Required:            true,
+	}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the metadata of a Zenfra API token by ID, e.g. a token created outside Terraform. " +
+			"The secret token value is never returned; it is only shown when the token is created.",
+		Attributes: attributes,
+	}
+}
+
+func (d *apiTokenDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *apiTokenDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config apiTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := d.client.GetToken(ctx, config.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read API token, got error: %s", err))
+		return
+	}
+
+	data := mapTokenToModel(token)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,98 @@
+// ABOUTME: Model types for the zenfra_api_token and zenfra_api_tokens data sources.
+// ABOUTME: Maps API token metadata to Terraform types; the secret token value is never returned.
+package api_token
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// apiTokenModel represents a token, both as the zenfra_api_token data source and as
+// an item of zenfra_api_tokens.
+type apiTokenModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Role        types.String `tfsdk:"role"`
+	TokenPrefix types.String `tfsdk:"token_prefix"`
+	UsageCount  types.Int64  `tfsdk:"usage_count"`
+	Active      types.Bool   `tfsdk:"active"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	LastUsedAt  types.String `tfsdk:"last_used_at"`
+	RevokedAt   types.String `tfsdk:"revoked_at"`
+}
+
+// apiTokenAttributes returns the computed attributes of a token. The caller sets the
+// id attribute, which zenfra_api_token requires.
+func apiTokenAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the token.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the token.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the token.",
+			Computed:            true,
+		},
+		"role": schema.StringAttribute{
+			MarkdownDescription: "The role of the token: `read`, `write`, or `admin`.",
+			Computed:            true,
+		},
+		"token_prefix": schema.StringAttribute{
+			MarkdownDescription: "The first characters of the token, to recognize it without revealing the secret.",
+			Computed:            true,
+		},
+		"usage_count": schema.Int64Attribute{
+			MarkdownDescription: "Number of times the token has been used.",
+			Computed:            true,
+		},
+		"active": schema.BoolAttribute{
+			MarkdownDescription: "Whether the token is currently active.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the token was created.",
+			Computed:            true,
+		},
+		"expires_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the token expires.",
+			Computed:            true,
+		},
+		"last_used_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the token was last used. Null if it was never used.",
+			Computed:            true,
+		},
+		"revoked_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the token was revoked. Null unless it was revoked.",
+			Computed:            true,
+		},
+	}
+}
+
+// mapTokenToModel converts API token metadata to the data source model.
+func mapTokenToModel(token *zenfraclient.Token) apiTokenModel {
+	model := apiTokenModel{
+		ID:          types.StringValue(token.ID),
+		Name:        types.StringValue(token.Name),
+		Description: types.StringNull(),
+		Role:        types.StringValue(token.Role),
+		TokenPrefix: types.StringValue(token.TokenPrefix),
+		UsageCount:  types.Int64Value(token.UsageCount),
+		Active:      types.BoolValue(token.Active),
+		CreatedAt:   timeutil.String(token.CreatedAt),
+		ExpiresAt:   timeutil.String(token.ExpiresAt),
+		LastUsedAt:  timeutil.StringPointer(token.LastUsedAt),
+		RevokedAt:   timeutil.StringPointer(token.RevokedAt),
+	}
+	if token.Description != "" {
+		model.Description = types.StringValue(token.Description)
+	}
+	return model
+}
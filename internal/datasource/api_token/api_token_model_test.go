@@ -0,0 +1,40 @@
+// ABOUTME: Unit tests for the API token data source model mapping.
+// ABOUTME: Verifies timestamps, and that unset descriptions and timestamps map to null.
+package api_token
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapTokenToModel(t *testing.T) {
+	lastUsed := time.Date(2026, 5, 2, 9, 30, 0, 0, time.UTC)
+	model := mapTokenToModel(&zenfraclient.Token{
+		ID:          "tok-1",
+		Name:        "ci",
+		Description: "CI pipeline",
+		TokenPrefix: "zf_ab12",
+		Role:        zenfraclient.TokenRoleWrite,
+		CreatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		ExpiresAt:   time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		LastUsedAt:  &lastUsed,
+		UsageCount:  42,
+		Active:      true,
+	})
+	if model.Description.ValueString() != "CI pipeline" || model.Role.ValueString() != "write" || !model.Active.ValueBool() {
+		t.Errorf("unexpected token: %+v", model)
+	}
+	if model.LastUsedAt.ValueString() != "2026-05-02T09:30:00Z" || model.ExpiresAt.ValueString() != "2027-01-01T00:00:00Z" {
+		t.Errorf("unexpected timestamps: last_used_at %s, expires_at %s", model.LastUsedAt, model.ExpiresAt)
+	}
+	if !model.RevokedAt.IsNull() {
+		t.Errorf("expected revoked_at to be null, got %s", model.RevokedAt)
+	}
+
+	model = mapTokenToModel(&zenfraclient.Token{ID: "tok-2", Name: "unused"})
+	if !model.Description.IsNull() || !model.LastUsedAt.IsNull() {
+		t.Errorf("expected null description and last_used_at, got %+v", model)
+	}
+}
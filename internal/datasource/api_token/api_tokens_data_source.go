@@ -0,0 +1,108 @@
+// ABOUTME: Data source for listing Zenfra API tokens with optional role and active filters.
+// ABOUTME: Returns token metadata as a list and as a map keyed by token name, never the secrets.
+package api_token
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/asmap"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type apiTokensDataSource struct {
+	client *zenfraclient.Client
+}
+
+type apiTokensDataSourceModel struct {
+	Role   types.String    `tfsdk:"role"`
+	Active types.Bool      `tfsdk:"active"`
+	Tokens []apiTokenModel `tfsdk:"tokens"`
+
+	AsMap map[string]apiTokenModel `tfsdk:"as_map"`
+}
+
+var _ datasource.DataSource = &apiTokensDataSource{}
+var _ datasource.DataSourceWithConfigure = &apiTokensDataSource{}
+
+func NewAPITokensDataSource() datasource.DataSource {
+	return &apiTokensDataSource{}
+}
+
+func (d *apiTokensDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_tokens"
+}
+
+func (d *apiTokensDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the metadata of the organization's Zenfra API tokens with optional filtering. Secret token values are never returned.",
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Optional role filter to list only tokens with this role: `read`, `write`, or `admin`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.TokenRoleRead, zenfraclient.TokenRoleWrite, zenfraclient.TokenRoleAdmin),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Optional filter to list only active tokens (`true`) or only inactive ones (`false`).",
+				Optional:            true,
+			},
+			"tokens": schema.ListNestedAttribute{
+				MarkdownDescription: "List of tokens matching the filter criteria.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: apiTokenAttributes(),
+				},
+			},
+			"as_map": schema.MapNestedAttribute{
+				MarkdownDescription: "The same tokens keyed by name, e.g. `as_map[\"ci\"].id`. Names shared by several tokens are left out with a warning.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: apiTokenAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *apiTokensDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *apiTokensDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data apiTokensDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokens, err := d.client.ListTokens(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list API tokens, got error: %s", err))
+		return
+	}
+
+	// Map results; the API has no role or active filter, so apply them here.
+	data.Tokens = make([]apiTokenModel, 0, len(tokens))
+	for i := range tokens {
+		if !data.Role.IsNull() && tokens[i].Role != data.Role.ValueString() {
+			continue
+		}
+		if !data.Active.IsNull() && tokens[i].Active != data.Active.ValueBool() {
+			continue
+		}
+		data.Tokens = append(data.Tokens, mapTokenToModel(&tokens[i]))
+	}
+	data.AsMap = asmap.Build(data.Tokens, func(t apiTokenModel) string { return t.Name.ValueString() }, "zenfra_api_tokens", "name", &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
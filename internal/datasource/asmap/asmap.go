@@ -0,0 +1,52 @@
+// ABOUTME: Builds the as_map attribute of plural data sources, indexing list items by slug or name.
+// ABOUTME: Keys shared by several items are left out of the map and reported as a warning.
+package asmap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Build indexes items by key. A key shared by more than one item would make lookups
+// ambiguous, so such keys are omitted from the map and reported in a warning that
+// names the data source and the attribute used as the key.
+func Build[T any](items []T, key func(T) string, dataSource, keyAttr string, diags *diag.Diagnostics) map[string]T {
+	result := make(map[string]T, len(items))
+	counts := make(map[string]int, len(items))
+	for _, item := range items {
+		k := key(item)
+		counts[k]++
+		result[k] = item
+	}
+
+	var duplicates []string
+	for k, n := range counts {
+		if n > 1 {
+			delete(result, k)
+			duplicates = append(duplicates, k)
+		}
+	}
+
+	if len(duplicates) > 0 {
+		sort.Strings(duplicates)
+		diags.AddWarning(
+			"Duplicate Keys Omitted from as_map",
+			fmt.Sprintf("Several items returned by %s share the %s %s, so those keys are not present in as_map. "+
+				"Look those items up in the list attribute instead.",
+				dataSource, keyAttr, quoteAll(duplicates)),
+		)
+	}
+
+	return result
+}
+
+func quoteAll(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return strings.Join(quoted, ", ")
+}
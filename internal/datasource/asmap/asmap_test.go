@@ -0,0 +1,55 @@
+// ABOUTME: Unit tests for the as_map builder shared by plural data sources.
+// ABOUTME: Verifies indexing by key and that ambiguous keys are dropped with a warning.
+package asmap
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type item struct {
+	id, name string
+}
+
+func TestBuild(t *testing.T) {
+	items := []item{
+		{id: "1", name: "production"},
+		{id: "2", name: "staging"},
+	}
+
+	var diags diag.Diagnostics
+	got := Build(items, func(i item) string { return i.name }, "zenfra_spaces", "slug", &diags)
+
+	if len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(got) != 2 || got["production"].id != "1" || got["staging"].id != "2" {
+		t.Errorf("unexpected map: %+v", got)
+	}
+}
+
+func TestBuild_DuplicateKeys(t *testing.T) {
+	items := []item{
+		{id: "1", name: "app"},
+		{id: "2", name: "app"},
+		{id: "3", name: "network"},
+	}
+
+	var diags diag.Diagnostics
+	got := Build(items, func(i item) string { return i.name }, "zenfra_stacks", "name", &diags)
+
+	if _, ok := got["app"]; ok {
+		t.Error("expected duplicate key to be omitted")
+	}
+	if got["network"].id != "3" {
+		t.Errorf("expected unique key to be kept, got %+v", got)
+	}
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("expected exactly one warning, got %v", diags)
+	}
+	if !strings.Contains(diags.Warnings()[0].Detail(), `"app"`) {
+		t.Errorf("expected warning to name the duplicate key, got %q", diags.Warnings()[0].Detail())
+	}
+}
@@ -0,0 +1,136 @@
+// ABOUTME: Data source for listing the stacks that receive a Zenfra configuration bundle.
+// ABOUTME: Includes stacks attached directly and through a space, to assess the blast radius of a bundle change.
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type bundleAttachedStacksDataSource struct {
+	client *zenfraclient.Client
+}
+
+type bundleAttachedStacksDataSourceModel struct {
+	BundleID types.String                   `tfsdk:"bundle_id"`
+	StackIDs []types.String                 `tfsdk:"stack_ids"`
+	Stacks   []bundleAttachedStackItemModel `tfsdk:"stacks"`
+}
+
+type bundleAttachedStackItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	SpaceID     types.String `tfsdk:"space_id"`
+	AttachedVia types.String `tfsdk:"attached_via"`
+	ViaSpaceID  types.String `tfsdk:"via_space_id"`
+}
+
+var _ datasource.DataSource = &bundleAttachedStacksDataSource{}
+var _ datasource.DataSourceWithConfigure = &bundleAttachedStacksDataSource{}
+
+func NewBundleAttachedStacksDataSource() datasource.DataSource {
+	return &bundleAttachedStacksDataSource{}
+}
+
+func (d *bundleAttachedStacksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle_attached_stacks"
+}
+
+func (d *bundleAttachedStacksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the stacks that receive a configuration bundle, whether the bundle is attached to the stack itself " +
+			"or to a space the stack inherits bundles from. Use it to see which stacks a change to a shared bundle affects.",
+		Attributes: map[string]schema.Attribute{
+			"bundle_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the bundle.",
+				Required:            true,
+			},
+			"stack_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the stacks in `stacks`, in the same order.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"stacks": schema.ListNestedAttribute{
+				MarkdownDescription: "The stacks receiving the bundle, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the stack.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the stack.",
+							Computed:            true,
+						},
+						"space_id": schema.StringAttribute{
+							MarkdownDescription: "The space the stack belongs to.",
+							Computed:            true,
+						},
+						"attached_via": schema.StringAttribute{
+							MarkdownDescription: "How the stack receives the bundle: `stack` for a direct attachment, `space` for one inherited from a space.",
+							Computed:            true,
+						},
+						"via_space_id": schema.StringAttribute{
+							MarkdownDescription: "The space the bundle is attached to, when `attached_via` is `space`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *bundleAttachedStacksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *bundleAttachedStacksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data bundleAttachedStacksDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stacks, err := d.client.ListBundleAttachedStacks(ctx, data.BundleID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list stacks attached to bundle, got error: %s", err))
+		return
+	}
+	sort.SliceStable(stacks, func(i, j int) bool {
+		if stacks[i].StackName != stacks[j].StackName {
+			return stacks[i].StackName < stacks[j].StackName
+		}
+		return stacks[i].StackID < stacks[j].StackID
+	})
+
+	data.StackIDs = make([]types.String, 0, len(stacks))
+	data.Stacks = make([]bundleAttachedStackItemModel, 0, len(stacks))
+	for _, s := range stacks {
+		item := bundleAttachedStackItemModel{
+			ID:          types.StringValue(s.StackID),
+			Name:        types.StringValue(s.StackName),
+			SpaceID:     types.StringValue(s.SpaceID),
+			AttachedVia: types.StringValue(s.AttachedVia),
+			ViaSpaceID:  types.StringNull(),
+		}
+		if s.ViaSpaceID != "" {
+			item.ViaSpaceID = types.StringValue(s.ViaSpaceID)
+		}
+		data.StackIDs = append(data.StackIDs, item.ID)
+		data.Stacks = append(data.Stacks, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
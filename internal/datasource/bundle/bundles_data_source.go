@@ -0,0 +1,162 @@
+// ABOUTME: Data source for listing Zenfra configuration bundles with optional space_id and label filters.
+// ABOUTME: Returns the matching bundles as a list and as a map keyed by bundle slug.
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/asmap"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type bundlesDataSource struct {
+	client *zenfraclient.Client
+}
+
+type bundlesDataSourceModel struct {
+	SpaceID types.String           `tfsdk:"space_id"`
+	Label   types.String           `tfsdk:"label"`
+	Bundles []bundlesListItemModel `tfsdk:"bundles"`
+
+	AsMap map[string]bundlesListItemModel `tfsdk:"as_map"`
+}
+
+type bundlesListItemModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Slug           types.String   `tfsdk:"slug"`
+	SpaceID        types.String   `tfsdk:"space_id"`
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	Labels         []types.String `tfsdk:"labels"`
+	ContentVersion types.Int64    `tfsdk:"content_version"`
+}
+
+// bundlesListFields are the only bundle attributes the list maps, so env vars and
+// mounted file contents are not transferred.
+var bundlesListFields = zenfraclient.Fields{"id", "name", "slug", "space_id", "organization_id", "labels", "content_version"}
+
+var _ datasource.DataSource = &bundlesDataSource{}
+var _ datasource.DataSourceWithConfigure = &bundlesDataSource{}
+
+func NewBundlesDataSource() datasource.DataSource {
+	return &bundlesDataSource{}
+}
+
+func (d *bundlesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundles"
+}
+
+func (d *bundlesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists Zenfra configuration bundles with optional filtering. Bundle contents are not included; use `zenfra_bundle` to manage them.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Optional space ID filter to list bundles in a specific space.",
+				Optional:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Optional label filter to list only bundles with this label.",
+				Optional:            true,
+			},
+			"bundles": schema.ListNestedAttribute{
+				MarkdownDescription: "List of bundles matching the filter criteria.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: bundleItemAttributes(),
+				},
+			},
+			"as_map": schema.MapNestedAttribute{
+				MarkdownDescription: "The same bundles keyed by slug, e.g. `as_map[\"aws-credentials\"].id`. Slugs shared by several bundles are left out with a warning.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: bundleItemAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// bundleItemAttributes returns the attributes of a bundle in the bundles list and as_map.
+func bundleItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the bundle.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the bundle.",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "The URL-friendly slug for the bundle.",
+			Computed:            true,
+		},
+		"space_id": schema.StringAttribute{
+			MarkdownDescription: "The space ID containing this bundle.",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "The organization ID that owns this bundle.",
+			Computed:            true,
+		},
+		"labels": schema.ListAttribute{
+			MarkdownDescription: "The labels of the bundle.",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"content_version": schema.Int64Attribute{
+			MarkdownDescription: "The current content version of the bundle.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *bundlesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *bundlesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data bundlesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundles, err := d.client.ListBundles(ctx, &zenfraclient.ListOptions{Fields: bundlesListFields})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list bundles, got error: %s", err))
+		return
+	}
+
+	// Map results; the API has no space or label filter, so apply them here.
+	data.Bundles = make([]bundlesListItemModel, 0, len(bundles))
+	for i := range bundles {
+		if !data.SpaceID.IsNull() && bundles[i].SpaceID != data.SpaceID.ValueString() {
+			continue
+		}
+		if !data.Label.IsNull() && !slices.Contains(bundles[i].Labels, data.Label.ValueString()) {
+			continue
+		}
+		data.Bundles = append(data.Bundles, bundlesListItemModel{
+			ID:             types.StringValue(bundles[i].ID),
+			Name:           types.StringValue(bundles[i].Name),
+			Slug:           types.StringValue(bundles[i].Slug),
+			SpaceID:        types.StringValue(bundles[i].SpaceID),
+			OrganizationID: types.StringValue(bundles[i].OrganizationID),
+			Labels:         stringValues(bundles[i].Labels),
+			ContentVersion: types.Int64Value(bundles[i].ContentVersion),
+		})
+	}
+	data.AsMap = asmap.Build(data.Bundles, func(b bundlesListItemModel) string { return b.Slug.ValueString() }, "zenfra_bundles", "slug", &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
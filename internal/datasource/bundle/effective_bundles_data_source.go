@@ -0,0 +1,184 @@
+// ABOUTME: Data source for the bundles a Zenfra stack receives, resolved by the API in the order they apply.
+// ABOUTME: Covers direct and space-inherited attachments and the keys each bundle loses to a later one.
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type effectiveBundlesDataSource struct {
+	client *zenfraclient.Client
+}
+
+type effectiveBundlesDataSourceModel struct {
+	StackID   types.String               `tfsdk:"stack_id"`
+	Label     types.String               `tfsdk:"label"`
+	BundleIDs []types.String             `tfsdk:"bundle_ids"`
+	Bundles   []effectiveBundleItemModel `tfsdk:"bundles"`
+}
+
+type effectiveBundleItemModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Slug            types.String   `tfsdk:"slug"`
+	Labels          []types.String `tfsdk:"labels"`
+	AttachedVia     types.String   `tfsdk:"attached_via"`
+	ViaSpaceID      types.String   `tfsdk:"via_space_id"`
+	Priority        types.Int64    `tfsdk:"priority"`
+	OverriddenKeys  []types.String `tfsdk:"overridden_keys"`
+	OverriddenPaths []types.String `tfsdk:"overridden_paths"`
+}
+
+var _ datasource.DataSource = &effectiveBundlesDataSource{}
+var _ datasource.DataSourceWithConfigure = &effectiveBundlesDataSource{}
+
+func NewEffectiveBundlesDataSource() datasource.DataSource {
+	return &effectiveBundlesDataSource{}
+}
+
+func (d *effectiveBundlesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_effective_bundles"
+}
+
+func (d *effectiveBundlesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists every configuration bundle a stack receives, from its own attachments and those inherited from its space " +
+			"and parent spaces, in the order the bundles are applied. A later bundle overrides the environment variables and mounted " +
+			"files an earlier one sets, so use it to find out which bundle a stack's value comes from.",
+		Attributes: map[string]schema.Attribute{
+			"stack_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the stack.",
+				Required:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Only list bundles with this label. Overrides are still resolved against all of the stack's bundles.",
+				Optional:            true,
+			},
+			"bundle_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the bundles in `bundles`, in the same order.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"bundles": schema.ListNestedAttribute{
+				MarkdownDescription: "The bundles the stack receives, in the order they are applied: space attachments before the stack's own, " +
+					"the farthest parent space first, and attachments at the same level by priority.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the bundle.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the bundle.",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "The slug of the bundle.",
+							Computed:            true,
+						},
+						"labels": schema.ListAttribute{
+							MarkdownDescription: "The labels of the bundle.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"attached_via": schema.StringAttribute{
+							MarkdownDescription: "How the stack receives the bundle: `stack` for a direct attachment, `space` for one inherited from a space.",
+							Computed:            true,
+						},
+						"via_space_id": schema.StringAttribute{
+							MarkdownDescription: "The space the bundle is attached to, when `attached_via` is `space`.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The priority of the attachment among those at the same level.",
+							Computed:            true,
+						},
+						"overridden_keys": schema.ListAttribute{
+							MarkdownDescription: "The bundle's environment variables that a later bundle sets again.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"overridden_paths": schema.ListAttribute{
+							MarkdownDescription: "The bundle's mounted file paths that a later bundle mounts again.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *effectiveBundlesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *effectiveBundlesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data effectiveBundlesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bundles, err := d.client.ResolveStackBundles(ctx, data.StackID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve bundles of stack, got error: %s", err))
+		return
+	}
+
+	data.BundleIDs = make([]types.String, 0, len(bundles))
+	data.Bundles = make([]effectiveBundleItemModel, 0, len(bundles))
+	for i := range bundles {
+		// The API resolves overrides across all bundles, so the label filter only
+		// narrows the list and keeps the application order.
+		if !data.Label.IsNull() && !slices.Contains(bundles[i].Labels, data.Label.ValueString()) {
+			continue
+		}
+		item := mapEffectiveBundleToItem(&bundles[i])
+		data.BundleIDs = append(data.BundleIDs, item.ID)
+		data.Bundles = append(data.Bundles, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func mapEffectiveBundleToItem(b *zenfraclient.EffectiveBundle) effectiveBundleItemModel {
+	item := effectiveBundleItemModel{
+		ID:              types.StringValue(b.BundleID),
+		Name:            types.StringValue(b.BundleName),
+		Slug:            types.StringValue(b.BundleSlug),
+		Labels:          stringValues(b.Labels),
+		AttachedVia:     types.StringValue(b.AttachedVia),
+		ViaSpaceID:      types.StringNull(),
+		Priority:        types.Int64Value(int64(b.Priority)),
+		OverriddenKeys:  stringValues(b.OverriddenKeys),
+		OverriddenPaths: stringValues(b.OverriddenPaths),
+	}
+	if b.ViaSpaceID != "" {
+		item.ViaSpaceID = types.StringValue(b.ViaSpaceID)
+	}
+	return item
+}
+
+// stringValues converts a list from the API, returning an empty list rather than
+// null when the API omits it.
+func stringValues(values []string) []types.String {
+	out := make([]types.String, 0, len(values))
+	for _, v := range values {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
@@ -0,0 +1,36 @@
+// ABOUTME: Unit tests for the effective bundles data source model mapping.
+// ABOUTME: Covers space-inherited and direct attachments and lists the API omits.
+package bundle
+
+import (
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapEffectiveBundleToItem(t *testing.T) {
+	item := mapEffectiveBundleToItem(&zenfraclient.EffectiveBundle{
+		BundleID:       "b-org",
+		BundleName:     "org",
+		BundleSlug:     "org",
+		AttachedVia:    zenfraclient.BundleAttachedViaSpace,
+		ViaSpaceID:     "space-root",
+		OverriddenKeys: []string{"AWS_REGION"},
+	})
+	if item.ViaSpaceID.ValueString() != "space-root" || len(item.OverriddenKeys) != 1 || item.OverriddenKeys[0].ValueString() != "AWS_REGION" {
+		t.Errorf("unexpected space attachment: %+v", item)
+	}
+	if item.Labels == nil || len(item.Labels) != 0 || item.OverriddenPaths == nil {
+		t.Errorf("expected omitted lists to map to empty lists, got %+v", item)
+	}
+
+	item = mapEffectiveBundleToItem(&zenfraclient.EffectiveBundle{
+		BundleID:    "b-app",
+		AttachedVia: zenfraclient.BundleAttachedViaStack,
+		Labels:      []string{"aws"},
+		Priority:    2,
+	})
+	if !item.ViaSpaceID.IsNull() || item.Priority.ValueInt64() != 2 || item.Labels[0].ValueString() != "aws" {
+		t.Errorf("unexpected stack attachment: %+v", item)
+	}
+}
@@ -0,0 +1,179 @@
+// ABOUTME: Data source that exports a signed compliance evidence bundle for a time window.
+// ABOUTME: Requests the export, waits until it is ready, and exposes its download URL, checksum, and signature.
+package compliance_report
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+const (
+	// defaultTimeout bounds the wait for the export when timeout_seconds is not set.
+	defaultTimeout = 10 * time.Minute
+
+	// pollInterval is how often a pending export is checked.
+	pollInterval = 5 * time.Second
+)
+
+type complianceReportDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &complianceReportDataSource{}
+var _ datasource.DataSourceWithConfigure = &complianceReportDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &complianceReportDataSource{}
+
+func NewComplianceReportDataSource() datasource.DataSource {
+	return &complianceReportDataSource{}
+}
+
+func (d *complianceReportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_compliance_report"
+}
+
+func (d *complianceReportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports a signed evidence bundle of the runs, approvals, and policy results recorded in a time window, e.g. for a SOC 2 audit. " +
+			"Every read requests a new export and waits until it is ready, so a plan that reads this data source takes as long as the export. " +
+			"Download the archive from `download_url` before `expires_at` and compare it to `sha256`.",
+		Attributes: map[string]schema.Attribute{
+			"from": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp of the start of the window, inclusive.",
+				Required:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp of the end of the window, exclusive. Must be later than `from`.",
+				Required:            true,
+			},
+			"sections": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("The kinds of evidence to export: %s. Defaults to all of them.", "`"+strings.Join(sections, "`, `")+"`"),
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("How long to wait for the export to be ready. Defaults to %d.", int(defaultTimeout.Seconds())),
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the export.",
+				Computed:            true,
+			},
+			"download_url": schema.StringAttribute{
+				MarkdownDescription: "Pre-signed URL of the evidence archive. Anyone holding it can download the archive until `expires_at`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp after which `download_url` stops working.",
+				Computed:            true,
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "Hex-encoded SHA-256 checksum of the archive.",
+				Computed:            true,
+			},
+			"size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of the archive in bytes.",
+				Computed:            true,
+			},
+			"signature": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded signature of the archive, made with the signing key `signing_key_id`. Null if the organization has no signing key.",
+				Computed:            true,
+			},
+			"signing_key_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the signing key that signed the archive. Read its public key with the `zenfra_signing_key` data source.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp when the export was requested.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *complianceReportDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config complianceReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.From.IsUnknown() && !config.To.IsUnknown() {
+		if _, _, err := parseWindow(config.From.ValueString(), config.To.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid Report Window", err.Error())
+		}
+	}
+
+	if !config.Sections.IsNull() && !config.Sections.IsUnknown() {
+		var requested []types.String
+		resp.Diagnostics.Append(config.Sections.ElementsAs(ctx, &requested, false)...)
+		for _, s := range requested {
+			if !s.IsUnknown() && !validSection(s.ValueString()) {
+				resp.Diagnostics.AddAttributeError(path.Root("sections"), "Invalid Report Section",
+					fmt.Sprintf("Unknown section %q. Valid sections are: %s.", s.ValueString(), strings.Join(sections, ", ")))
+			}
+		}
+	}
+
+	if !config.TimeoutSeconds.IsNull() && !config.TimeoutSeconds.IsUnknown() && config.TimeoutSeconds.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout_seconds"), "Invalid Timeout", "timeout_seconds must be at least 1.")
+	}
+}
+
+func (d *complianceReportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *complianceReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data complianceReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	from, to, err := parseWindow(data.From.ValueString(), data.To.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid Report Window", err.Error())
+		return
+	}
+	createReq := zenfraclient.CreateComplianceReportRequest{From: from, To: to}
+	if !data.Sections.IsNull() {
+		resp.Diagnostics.Append(data.Sections.ElementsAs(ctx, &createReq.Sections, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	report, err := d.client.CreateComplianceReport(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to request compliance report, got error: %s", err))
+		return
+	}
+
+	timeout := defaultTimeout
+	if !data.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(data.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	report, err = d.client.WaitForComplianceReport(waitCtx, report.ID, pollInterval)
+	if err != nil {
+		resp.Diagnostics.AddError("Compliance Report Not Ready", fmt.Sprintf("Unable to export compliance report, got error: %s", err))
+		return
+	}
+
+	mapComplianceReport(&data, report)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
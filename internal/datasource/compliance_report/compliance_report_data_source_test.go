@@ -0,0 +1,105 @@
+// ABOUTME: Unit tests for the zenfra_compliance_report data source.
+// ABOUTME: Verifies window parsing, config validation, and mapping of a ready export.
+package compliance_report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestParseWindow(t *testing.T) {
+	tests := []struct {
+		from, to string
+		wantErr  bool
+	}{
+		{from: "2026-01-01T00:00:00Z", to: "2026-04-01T00:00:00Z"},
+		{from: "2026-01-01T00:00:00+02:00", to: "2026-01-01T00:00:00Z"},
+		{from: "2026-01-01", to: "2026-04-01T00:00:00Z", wantErr: true},
+		{from: "2026-04-01T00:00:00Z", to: "2026-04-01T00:00:00Z", wantErr: true},
+		{from: "2026-04-01T00:00:00Z", to: "2026-01-01T00:00:00Z", wantErr: true},
+	}
+	for _, tt := range tests {
+		if _, _, err := parseWindow(tt.from, tt.to); (err != nil) != tt.wantErr {
+			t.Errorf("parseWindow(%q, %q): expected error %v, got %v", tt.from, tt.to, tt.wantErr, err)
+		}
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	d := &complianceReportDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	config := func(sections []string, timeout *int64) tfsdk.Config {
+		values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+		for name, typ := range objType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		values["from"] = tftypes.NewValue(tftypes.String, "2026-01-01T00:00:00Z")
+		values["to"] = tftypes.NewValue(tftypes.String, "2026-04-01T00:00:00Z")
+		if sections != nil {
+			elems := make([]tftypes.Value, 0, len(sections))
+			for _, s := range sections {
+				elems = append(elems, tftypes.NewValue(tftypes.String, s))
+			}
+			values["sections"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elems)
+		}
+		if timeout != nil {
+			values["timeout_seconds"] = tftypes.NewValue(tftypes.Number, *timeout)
+		}
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)}
+	}
+	zero := int64(0)
+
+	tests := []struct {
+		name   string
+		config tfsdk.Config
+		want   string
+	}{
+		{name: "defaults", config: config(nil, nil)},
+		{name: "some sections", config: config([]string{"runs", "policies"}, nil)},
+		{name: "unknown section", config: config([]string{"runs", "deployments"}, nil), want: "Invalid Report Section"},
+		{name: "zero timeout", config: config(nil, &zero), want: "Invalid Timeout"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &datasource.ValidateConfigResponse{}
+			d.ValidateConfig(ctx, datasource.ValidateConfigRequest{Config: tt.config}, resp)
+
+			var got []string
+			for _, diag := range resp.Diagnostics.Errors() {
+				got = append(got, diag.Summary())
+			}
+			if (tt.want == "" && len(got) != 0) || (tt.want != "" && (len(got) != 1 || got[0] != tt.want)) {
+				t.Errorf("expected error %q, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMapComplianceReport(t *testing.T) {
+	var data complianceReportDataSourceModel
+	mapComplianceReport(&data, &zenfraclient.ComplianceReport{
+		ID:          "report-1",
+		Status:      zenfraclient.ComplianceReportStatusReady,
+		DownloadURL: "https://downloads.example.com/report-1.zip",
+		ExpiresAt:   time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC),
+		SHA256:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		SizeBytes:   2048,
+	})
+
+	if data.ID.ValueString() != "report-1" || data.ExpiresAt.ValueString() != "2026-04-02T00:00:00Z" || data.SizeBytes.ValueInt64() != 2048 {
+		t.Errorf("unexpected model: %+v", data)
+	}
+	if !data.Signature.IsNull() || !data.SigningKeyID.IsNull() || !data.CreatedAt.IsNull() {
+		t.Errorf("expected null signature, signing key, and created_at for an unsigned report, got %+v", data)
+	}
+}
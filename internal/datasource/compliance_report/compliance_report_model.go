@@ -0,0 +1,79 @@
+// ABOUTME: Model types for the zenfra_compliance_report data source.
+// ABOUTME: Parses the requested evidence window and maps the finished export to Terraform types.
+package compliance_report
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/tfvalue"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// sections are the kinds of evidence a report can contain, all exported by default.
+var sections = []string{
+	zenfraclient.ComplianceSectionRuns,
+	zenfraclient.ComplianceSectionApprovals,
+	zenfraclient.ComplianceSectionPolicies,
+}
+
+// complianceReportDataSourceModel represents the Terraform state for the compliance report data source.
+type complianceReportDataSourceModel struct {
+	From           types.String `tfsdk:"from"`
+	To             types.String `tfsdk:"to"`
+	Sections       types.Set    `tfsdk:"sections"`
+	TimeoutSeconds types.Int64  `tfsdk:"timeout_seconds"`
+	ID             types.String `tfsdk:"id"`
+	DownloadURL    types.String `tfsdk:"download_url"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	SHA256         types.String `tfsdk:"sha256"`
+	SizeBytes      types.Int64  `tfsdk:"size_bytes"`
+	Signature      types.String `tfsdk:"signature"`
+	SigningKeyID   types.String `tfsdk:"signing_key_id"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+// parseWindow parses the from and to timestamps of a report and checks that the
+// window is not empty.
+func parseWindow(from, to string) (time.Time, time.Time, error) {
+	start, err := timeutil.Parse(from)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must be an RFC3339 timestamp: %w", err)
+	}
+	end, err := timeutil.Parse(to)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("to must be an RFC3339 timestamp: %w", err)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("to (%s) must be later than from (%s)", to, from)
+	}
+	return start, end, nil
+}
+
+// validSection reports whether s names a report section.
+func validSection(s string) bool {
+	return slices.Contains(sections, s)
+}
+
+// mapComplianceReport fills the computed attributes of data from a ready report.
+func mapComplianceReport(data *complianceReportDataSourceModel, report *zenfraclient.ComplianceReport) {
+	data.ID = types.StringValue(report.ID)
+	data.DownloadURL = types.StringValue(report.DownloadURL)
+	data.ExpiresAt = timestampValue(report.ExpiresAt)
+	data.SHA256 = types.StringValue(report.SHA256)
+	data.SizeBytes = types.Int64Value(report.SizeBytes)
+	data.Signature = tfvalue.OptionalString(report.Signature)
+	data.SigningKeyID = tfvalue.OptionalString(report.SigningKeyID)
+	data.CreatedAt = timestampValue(report.CreatedAt)
+}
+
+// timestampValue returns null for timestamps the API left unset.
+func timestampValue(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return timeutil.String(t)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -145,20 +147,9 @@ func (d *currentOrganizationDataSource) Schema(_ context.Context, _ datasource.S
 }
 
 func (d *currentOrganizationDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
 	}
-
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
 }
 
 func (d *currentOrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -196,10 +187,10 @@ func (d *currentOrganizationDataSource) Read(ctx context.Context, req datasource
 		}
 	}
 	if org.CreatedAt != "" {
-		data.CreatedAt = types.StringValue(org.CreatedAt)
+		data.CreatedAt = types.StringValue(timeutil.Normalize(org.CreatedAt))
 	}
 	if org.UpdatedAt != "" {
-		data.UpdatedAt = types.StringValue(org.UpdatedAt)
+		data.UpdatedAt = types.StringValue(timeutil.Normalize(org.UpdatedAt))
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
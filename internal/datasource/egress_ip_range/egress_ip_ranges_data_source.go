@@ -0,0 +1,118 @@
+// ABOUTME: Data source for reading the static IP ranges Zenfra runners connect out from.
+// ABOUTME: Exposes per-region lists and merged CIDR sets ready for security group and firewall rules.
+package egress_ip_range
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type egressIPRangesDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &egressIPRangesDataSource{}
+var _ datasource.DataSourceWithConfigure = &egressIPRangesDataSource{}
+
+func NewEgressIPRangesDataSource() datasource.DataSource {
+	return &egressIPRangesDataSource{}
+}
+
+func (d *egressIPRangesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_egress_ip_ranges"
+}
+
+func (d *egressIPRangesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the static IP ranges Zenfra runners use for outbound connections, as published by Zenfra. " +
+			"Use `cidr_blocks` to allowlist runners in security groups and firewalls, so rules follow the ranges when Zenfra " +
+			"adds or retires addresses. Runs on private worker pools connect out from your own network instead.",
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.SetAttribute{
+				MarkdownDescription: "Regions to include, such as `us` or `eu`. Defaults to all regions; reading the data source " +
+					"fails if a region is not published.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "When Zenfra last changed the published ranges.",
+				Computed:            true,
+			},
+			"ranges": schema.ListNestedAttribute{
+				MarkdownDescription: "The ranges of each included region, sorted by region.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							MarkdownDescription: "The region name.",
+							Computed:            true,
+						},
+						"ipv4_cidrs": schema.ListAttribute{
+							MarkdownDescription: "IPv4 CIDR blocks of the region, sorted.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"ipv6_cidrs": schema.ListAttribute{
+							MarkdownDescription: "IPv6 CIDR blocks of the region, sorted.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"cidr_blocks": schema.SetAttribute{
+				MarkdownDescription: "IPv4 CIDR blocks of all included regions, for `cidr_blocks` of an `aws_security_group` rule.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"ipv6_cidr_blocks": schema.SetAttribute{
+				MarkdownDescription: "IPv6 CIDR blocks of all included regions, for `ipv6_cidr_blocks` of an `aws_security_group` rule.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *egressIPRangesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *egressIPRangesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data egressIPRangesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ranges, err := d.client.GetEgressIPRanges(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read egress IP ranges, got error: %s", err))
+		return
+	}
+
+	if unknown := mapRanges(&data, ranges); len(unknown) > 0 {
+		available := make([]string, 0, len(ranges.Regions))
+		for _, r := range ranges.Regions {
+			available = append(available, r.Region)
+		}
+		slices.Sort(available)
+		resp.Diagnostics.AddAttributeError(path.Root("regions"), "Unknown Egress Region",
+			fmt.Sprintf("Zenfra publishes no egress ranges for %s. Available regions: %s.",
+				strings.Join(unknown, ", "), strings.Join(available, ", ")))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,119 @@
+// ABOUTME: Unit tests for the zenfra_egress_ip_ranges data source.
+// ABOUTME: Covers region filtering, CIDR merging and de-duplication, and unknown regions.
+package egress_ip_range
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapRanges(t *testing.T) {
+	ranges := &zenfraclient.EgressIPRanges{
+		UpdatedAt: time.Date(2026, 9, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+		Regions: []zenfraclient.EgressRegion{
+			{Region: "us", IPv4CIDRs: []string{"203.0.113.16/28", "203.0.113.0/28"}, IPv6CIDRs: []string{"2001:db8:1::/48"}},
+			{Region: "eu", IPv4CIDRs: []string{"198.51.100.0/28", "203.0.113.0/28"}},
+			{Region: "gov", IPv4CIDRs: []string{"192.0.2.0/28"}, IPv6CIDRs: []string{"2001:db8:2::/48"}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		regions     []types.String
+		wantRegions []string
+		wantIPv4    []string
+		wantIPv6    []string
+	}{
+		{
+			name:        "all regions",
+			wantRegions: []string{"eu", "gov", "us"},
+			wantIPv4:    []string{"192.0.2.0/28", "198.51.100.0/28", "203.0.113.0/28", "203.0.113.16/28"},
+			wantIPv6:    []string{"2001:db8:1::/48", "2001:db8:2::/48"},
+		},
+		{
+			name:        "filtered",
+			regions:     []types.String{types.StringValue("us"), types.StringValue("eu")},
+			wantRegions: []string{"eu", "us"},
+			wantIPv4:    []string{"198.51.100.0/28", "203.0.113.0/28", "203.0.113.16/28"},
+			wantIPv6:    []string{"2001:db8:1::/48"},
+		},
+		{
+			name:        "empty filter",
+			regions:     []types.String{},
+			wantRegions: []string{},
+			wantIPv4:    []string{},
+			wantIPv6:    []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := egressIPRangesDataSourceModel{Regions: tt.regions}
+			if unknown := mapRanges(&model, ranges); unknown != nil {
+				t.Fatalf("unexpected unknown regions %v", unknown)
+			}
+
+			regions := make([]string, 0, len(model.Ranges))
+			for _, r := range model.Ranges {
+				regions = append(regions, r.Region.ValueString())
+			}
+			if !slices.Equal(regions, tt.wantRegions) {
+				t.Errorf("ranges: got regions %v, want %v", regions, tt.wantRegions)
+			}
+			assertCIDRs(t, "cidr_blocks", model.CIDRBlocks, tt.wantIPv4)
+			assertCIDRs(t, "ipv6_cidr_blocks", model.IPv6CIDRBlocks, tt.wantIPv6)
+			if model.UpdatedAt.ValueString() != "2026-09-01T10:00:00Z" {
+				t.Errorf("updated_at: got %s", model.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestMapRanges_RegionLists(t *testing.T) {
+	ranges := &zenfraclient.EgressIPRanges{Regions: []zenfraclient.EgressRegion{
+		{Region: "eu", IPv4CIDRs: []string{"198.51.100.16/28", "198.51.100.0/28"}},
+	}}
+
+	var model egressIPRangesDataSourceModel
+	mapRanges(&model, ranges)
+	if len(model.Ranges) != 1 {
+		t.Fatalf("expected one region, got %d", len(model.Ranges))
+	}
+	assertCIDRs(t, "ipv4_cidrs", model.Ranges[0].IPv4CIDRs, []string{"198.51.100.0/28", "198.51.100.16/28"})
+	if model.Ranges[0].IPv6CIDRs == nil || len(model.Ranges[0].IPv6CIDRs) != 0 {
+		t.Errorf("ipv6_cidrs: expected an empty list, got %v", model.Ranges[0].IPv6CIDRs)
+	}
+	if !model.UpdatedAt.IsNull() {
+		t.Errorf("updated_at: expected null without a publication time, got %s", model.UpdatedAt)
+	}
+}
+
+func TestMapRanges_UnknownRegions(t *testing.T) {
+	ranges := &zenfraclient.EgressIPRanges{Regions: []zenfraclient.EgressRegion{{Region: "us"}}}
+
+	model := egressIPRangesDataSourceModel{
+		Regions: []types.String{types.StringValue("us"), types.StringValue("moon"), types.StringValue("apac")},
+	}
+	unknown := mapRanges(&model, ranges)
+	if !slices.Equal(unknown, []string{"apac", "moon"}) {
+		t.Errorf("unknown regions: got %v, want [apac moon]", unknown)
+	}
+	if model.Ranges != nil {
+		t.Errorf("expected the model unchanged, got ranges %v", model.Ranges)
+	}
+}
+
+func assertCIDRs(t *testing.T, name string, got []types.String, want []string) {
+	t.Helper()
+	values := make([]string, 0, len(got))
+	for _, v := range got {
+		values = append(values, v.ValueString())
+	}
+	if !slices.Equal(values, want) {
+		t.Errorf("%s: got %v, want %v", name, values, want)
+	}
+}
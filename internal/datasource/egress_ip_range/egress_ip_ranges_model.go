@@ -0,0 +1,90 @@
+// ABOUTME: Model types for the zenfra_egress_ip_ranges data source.
+// ABOUTME: Filters the published ranges by region and merges them into CIDR sets for firewall rules.
+package egress_ip_range
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// egressIPRangesDataSourceModel represents the Terraform state for the egress IP ranges data source.
+type egressIPRangesDataSourceModel struct {
+	Regions        []types.String          `tfsdk:"regions"`
+	UpdatedAt      types.String            `tfsdk:"updated_at"`
+	Ranges         []egressRegionItemModel `tfsdk:"ranges"`
+	CIDRBlocks     []types.String          `tfsdk:"cidr_blocks"`
+	IPv6CIDRBlocks []types.String          `tfsdk:"ipv6_cidr_blocks"`
+}
+
+// egressRegionItemModel represents a single region in the ranges list.
+type egressRegionItemModel struct {
+	Region    types.String   `tfsdk:"region"`
+	IPv4CIDRs []types.String `tfsdk:"ipv4_cidrs"`
+	IPv6CIDRs []types.String `tfsdk:"ipv6_cidrs"`
+}
+
+// mapRanges fills the computed attributes of model from the published ranges, keeping
+// only the regions listed in model.Regions when it is set. Regions are sorted by name
+// and CIDRs sorted and de-duplicated. It returns the requested regions the document
+// does not list, sorted, and leaves model unchanged if there are any.
+func mapRanges(model *egressIPRangesDataSourceModel, ranges *zenfraclient.EgressIPRanges) []string {
+	byRegion := make(map[string]zenfraclient.EgressRegion, len(ranges.Regions))
+	for _, r := range ranges.Regions {
+		byRegion[r.Region] = r
+	}
+
+	var selected, unknown []string
+	if model.Regions == nil {
+		for name := range byRegion {
+			selected = append(selected, name)
+		}
+	}
+	for _, r := range model.Regions {
+		if _, ok := byRegion[r.ValueString()]; !ok {
+			unknown = append(unknown, r.ValueString())
+			continue
+		}
+		selected = append(selected, r.ValueString())
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return unknown
+	}
+	slices.Sort(selected)
+	selected = slices.Compact(selected)
+
+	var ipv4, ipv6 []string
+	model.Ranges = make([]egressRegionItemModel, 0, len(selected))
+	for _, name := range selected {
+		r := byRegion[name]
+		model.Ranges = append(model.Ranges, egressRegionItemModel{
+			Region:    types.StringValue(name),
+			IPv4CIDRs: cidrValues(r.IPv4CIDRs),
+			IPv6CIDRs: cidrValues(r.IPv6CIDRs),
+		})
+		ipv4 = append(ipv4, r.IPv4CIDRs...)
+		ipv6 = append(ipv6, r.IPv6CIDRs...)
+	}
+	model.CIDRBlocks = cidrValues(ipv4)
+	model.IPv6CIDRBlocks = cidrValues(ipv6)
+
+	model.UpdatedAt = types.StringNull()
+	if !ranges.UpdatedAt.IsZero() {
+		model.UpdatedAt = timeutil.String(ranges.UpdatedAt)
+	}
+	return nil
+}
+
+// cidrValues returns cidrs sorted and de-duplicated, as an empty rather than nil
+// slice so a region without ranges reads as an empty list instead of null.
+func cidrValues(cidrs []string) []types.String {
+	sorted := slices.Compact(slices.Sorted(slices.Values(cidrs)))
+	values := make([]types.String, 0, len(sorted))
+	for _, c := range sorted {
+		values = append(values, types.StringValue(c))
+	}
+	return values
+}
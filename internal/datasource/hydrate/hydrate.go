@@ -0,0 +1,70 @@
+// ABOUTME: Reads the full detail of each item of a plural data source concurrently (include_details).
+// ABOUTME: Bounded by a fixed worker count and by the provider's max_concurrent_operations semaphore.
+package hydrate
+
+import (
+	"context"
+	"sync"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/semaphore"
+)
+
+// Workers is the most items one data source reads at once. It keeps a large list from
+// starting hundreds of requests even when max_concurrent_operations is unlimited.
+const Workers = 8
+
+// Each calls read for every index in [0, n) from up to Workers goroutines, each holding
+// a slot of ops while it reads. read must only write to the result at its own index.
+// Each returns the first error, after which no further reads start, and waits for the
+// reads in flight before returning.
+func Each(ctx context.Context, ops semaphore.Semaphore, n int, read func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	indexes := make(chan int)
+	for range min(Workers, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := ops.Acquire(ctx); err != nil {
+					fail(err)
+					continue
+				}
+				err := read(ctx, i)
+				ops.Release()
+				if err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range n {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,76 @@
+// ABOUTME: Unit tests for the concurrent per-item reads of plural data sources.
+// ABOUTME: Checks the concurrency bounds, that every item is read, and that the first error stops further reads.
+package hydrate
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/semaphore"
+)
+
+// maxInFlight runs Each over n items with ops and returns the most reads seen at once.
+func maxInFlight(t *testing.T, ops semaphore.Semaphore, n int) int64 {
+	t.Helper()
+	var inFlight, peak atomic.Int64
+	read := make([]bool, n)
+	err := Each(context.Background(), ops, n, func(_ context.Context, i int) error {
+		cur := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if cur <= p || peak.CompareAndSwap(p, cur) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		inFlight.Add(-1)
+		read[i] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Each: %v", err)
+	}
+	for i, ok := range read {
+		if !ok {
+			t.Errorf("item %d was not read", i)
+		}
+	}
+	return peak.Load()
+}
+
+func TestEach_Bounds(t *testing.T) {
+	if got := maxInFlight(t, nil, 40); got > Workers || got < 2 {
+		t.Errorf("expected up to %d concurrent reads, got %d", Workers, got)
+	}
+	if got := maxInFlight(t, semaphore.New(2), 40); got > 2 {
+		t.Errorf("expected max_concurrent_operations to bound the reads to 2, got %d", got)
+	}
+	if got := maxInFlight(t, nil, 0); got != 0 {
+		t.Errorf("expected no reads for an empty list, got %d", got)
+	}
+}
+
+func TestEach_StopsOnError(t *testing.T) {
+	var mu sync.Mutex
+	var started int
+	boom := errors.New("boom")
+	err := Each(context.Background(), semaphore.New(1), 100, func(ctx context.Context, i int) error {
+		mu.Lock()
+		started++
+		mu.Unlock()
+		if i == 3 {
+			return boom
+		}
+		return ctx.Err()
+	})
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected the read's error, got %v", err)
+	}
+	if started >= 100 {
+		t.Errorf("expected reads to stop after the error, %d started", started)
+	}
+}
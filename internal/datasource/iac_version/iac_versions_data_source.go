@@ -0,0 +1,111 @@
+// ABOUTME: Data source for reading the IaC engine versions Zenfra stacks can run.
+// ABOUTME: Resolves a version prefix such as "1.9" to its newest available patch release.
+package iac_version
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type iacVersionsDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &iacVersionsDataSource{}
+var _ datasource.DataSourceWithConfigure = &iacVersionsDataSource{}
+
+func NewIACVersionsDataSource() datasource.DataSource {
+	return &iacVersionsDataSource{}
+}
+
+func (d *iacVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iac_versions"
+}
+
+func (d *iacVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the versions of an IaC engine that Zenfra stacks can run. Use `latest_matching` to follow the newest patch " +
+			"release of a minor version instead of hard-coding a version the platform may later deprecate.",
+		Attributes: map[string]schema.Attribute{
+			"engine": schema.StringAttribute{
+				MarkdownDescription: "The IaC engine: `terraform` or `opentofu`.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.IACEngineTerraform, zenfraclient.IACEngineOpenTofu),
+				},
+			},
+			"version_prefix": schema.StringAttribute{
+				MarkdownDescription: "A version prefix such as `1.9` or `1`. When set, `latest_matching` is the newest non-deprecated version under it, " +
+					"and reading the data source fails if there is none.",
+				Optional: true,
+			},
+			"include_prerelease": schema.BoolAttribute{
+				MarkdownDescription: "Include pre-release versions in `versions`, `deprecated_versions`, and `latest_matching`. Defaults to false.",
+				Optional:            true,
+			},
+			"versions": schema.ListAttribute{
+				MarkdownDescription: "Versions that new and existing stacks can use, newest first.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"deprecated_versions": schema.ListAttribute{
+				MarkdownDescription: "Versions that are still available but scheduled for removal, newest first.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"latest_stable": schema.StringAttribute{
+				MarkdownDescription: "The version the platform recommends for new stacks.",
+				Computed:            true,
+			},
+			"latest_matching": schema.StringAttribute{
+				MarkdownDescription: "The newest non-deprecated version under `version_prefix`. Null when `version_prefix` is not set.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *iacVersionsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *iacVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data iacVersionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	catalog, err := d.client.GetIACVersions(ctx, data.Engine.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read IaC versions, got error: %s", err))
+		return
+	}
+
+	mapCatalog(&data, catalog)
+
+	if data.VersionPrefix.ValueString() != "" && data.LatestMatching.IsNull() {
+		available := make([]string, 0, len(data.Versions))
+		for _, v := range data.Versions {
+			available = append(available, v.ValueString())
+		}
+		resp.Diagnostics.AddAttributeError(path.Root("version_prefix"), "No Matching IaC Version",
+			fmt.Sprintf("No available %s version matches %q. Available versions: %s.",
+				data.Engine.ValueString(), data.VersionPrefix.ValueString(), strings.Join(available, ", ")))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,138 @@
+// ABOUTME: Unit tests for the zenfra_iac_versions data source.
+// ABOUTME: Covers version ordering, pre-release filtering, and prefix matching.
+package iac_version
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.9.0", b: "1.9.0", want: 0},
+		{a: "1.10.0", b: "1.9.8", want: 1},
+		{a: "1.9.0", b: "1.9.0-rc1", want: 1},
+		{a: "1.9.0-rc2", b: "1.9.0-rc1", want: 1},
+		{a: "1.9.0-beta.10", b: "1.9.0-beta.9", want: 1},
+		{a: "v1.8.5", b: "1.9.0", want: -1},
+	}
+
+	for _, tt := range tests {
+		got := compareVersions(tt.a, tt.b)
+		if (got > 0) != (tt.want > 0) || (got < 0) != (tt.want < 0) {
+			t.Errorf("compareVersions(%q, %q) = %d, want sign of %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMatchesPrefix(t *testing.T) {
+	for _, tt := range []struct {
+		version, prefix string
+		want            bool
+	}{
+		{version: "1.9.3", prefix: "1.9", want: true},
+		{version: "1.9.0-rc1", prefix: "1.9.0", want: true},
+		{version: "1.9.3", prefix: "1", want: true},
+		{version: "1.9.3", prefix: "v1.9.3", want: true},
+		{version: "1.10.0", prefix: "1.1", want: false},
+		{version: "1.90.0", prefix: "1.9", want: false},
+	} {
+		if got := matchesPrefix(tt.version, tt.prefix); got != tt.want {
+			t.Errorf("matchesPrefix(%q, %q) = %v, want %v", tt.version, tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestMapCatalog(t *testing.T) {
+	catalog := &zenfraclient.IACVersionCatalog{
+		Engine:       "opentofu",
+		LatestStable: "1.10.1",
+		Versions: []zenfraclient.IACVersion{
+			{Version: "1.9.0"},
+			{Version: "1.10.1"},
+			{Version: "1.9.2"},
+			{Version: "1.10.2-rc1", Prerelease: true},
+			{Version: "1.8.5", Deprecated: true},
+			{Version: "1.9.3", Deprecated: true},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		prefix         types.String
+		prerelease     types.Bool
+		wantVersions   []string
+		wantDeprecated []string
+		wantMatching   types.String
+	}{
+		{
+			name:           "no prefix",
+			prefix:         types.StringNull(),
+			prerelease:     types.BoolNull(),
+			wantVersions:   []string{"1.10.1", "1.9.2", "1.9.0"},
+			wantDeprecated: []string{"1.9.3", "1.8.5"},
+			wantMatching:   types.StringNull(),
+		},
+		{
+			name:           "newest non-deprecated patch",
+			prefix:         types.StringValue("1.9"),
+			prerelease:     types.BoolNull(),
+			wantVersions:   []string{"1.10.1", "1.9.2", "1.9.0"},
+			wantDeprecated: []string{"1.9.3", "1.8.5"},
+			wantMatching:   types.StringValue("1.9.2"),
+		},
+		{
+			name:           "with pre-releases",
+			prefix:         types.StringValue("1.10"),
+			prerelease:     types.BoolValue(true),
+			wantVersions:   []string{"1.10.2-rc1", "1.10.1", "1.9.2", "1.9.0"},
+			wantDeprecated: []string{"1.9.3", "1.8.5"},
+			wantMatching:   types.StringValue("1.10.2-rc1"),
+		},
+		{
+			name:           "only deprecated versions match",
+			prefix:         types.StringValue("1.8"),
+			prerelease:     types.BoolNull(),
+			wantVersions:   []string{"1.10.1", "1.9.2", "1.9.0"},
+			wantDeprecated: []string{"1.9.3", "1.8.5"},
+			wantMatching:   types.StringNull(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := iacVersionsDataSourceModel{
+				Engine:            types.StringValue("opentofu"),
+				VersionPrefix:     tt.prefix,
+				IncludePrerelease: tt.prerelease,
+			}
+			mapCatalog(&model, catalog)
+
+			assertVersions(t, "versions", model.Versions, tt.wantVersions)
+			assertVersions(t, "deprecated_versions", model.DeprecatedVersions, tt.wantDeprecated)
+			if !model.LatestMatching.Equal(tt.wantMatching) {
+				t.Errorf("latest_matching: got %s, want %s", model.LatestMatching, tt.wantMatching)
+			}
+			if model.LatestStable.ValueString() != "1.10.1" {
+				t.Errorf("latest_stable: got %s", model.LatestStable)
+			}
+		})
+	}
+}
+
+func assertVersions(t *testing.T, name string, got []types.String, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %v, want %v", name, got, want)
+	}
+	for i := range want {
+		if got[i].ValueString() != want[i] {
+			t.Fatalf("%s: got %v, want %v", name, got, want)
+		}
+	}
+}
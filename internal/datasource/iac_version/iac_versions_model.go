@@ -0,0 +1,122 @@
+// ABOUTME: Model types for the zenfra_iac_versions data source.
+// ABOUTME: Orders catalog versions newest first and picks the newest version matching a prefix.
+package iac_version
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// iacVersionsDataSourceModel represents the Terraform state for the IaC versions data source.
+type iacVersionsDataSourceModel struct {
+	Engine             types.String   `tfsdk:"engine"`
+	VersionPrefix      types.String   `tfsdk:"version_prefix"`
+	IncludePrerelease  types.Bool     `tfsdk:"include_prerelease"`
+	Versions           []types.String `tfsdk:"versions"`
+	DeprecatedVersions []types.String `tfsdk:"deprecated_versions"`
+	LatestStable       types.String   `tfsdk:"latest_stable"`
+	LatestMatching     types.String   `tfsdk:"latest_matching"`
+}
+
+// mapCatalog fills the computed attributes of model from the catalog. Versions are
+// ordered newest first; pre-releases are left out unless include_prerelease is set.
+func mapCatalog(model *iacVersionsDataSourceModel, catalog *zenfraclient.IACVersionCatalog) {
+	includePrerelease := model.IncludePrerelease.ValueBool()
+
+	versions := make([]zenfraclient.IACVersion, 0, len(catalog.Versions))
+	for _, v := range catalog.Versions {
+		if v.Prerelease && !includePrerelease {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	sort.SliceStable(versions, func(i, j int) bool { return compareVersions(versions[i].Version, versions[j].Version) > 0 })
+
+	model.Versions = make([]types.String, 0, len(versions))
+	model.DeprecatedVersions = make([]types.String, 0)
+	for _, v := range versions {
+		if v.Deprecated {
+			model.DeprecatedVersions = append(model.DeprecatedVersions, types.StringValue(v.Version))
+			continue
+		}
+		model.Versions = append(model.Versions, types.StringValue(v.Version))
+	}
+
+	model.LatestStable = types.StringNull()
+	if catalog.LatestStable != "" {
+		model.LatestStable = types.StringValue(catalog.LatestStable)
+	}
+
+	model.LatestMatching = types.StringNull()
+	if prefix := model.VersionPrefix.ValueString(); prefix != "" {
+		for _, v := range model.Versions {
+			if matchesPrefix(v.ValueString(), prefix) {
+				model.LatestMatching = v
+				break
+			}
+		}
+	}
+}
+
+// matchesPrefix reports whether version falls under prefix on component boundaries,
+// so "1.9" matches "1.9.0" and "1.9.3-rc1" but not "1.10.0" or "1.90.0".
+func matchesPrefix(version, prefix string) bool {
+	version, prefix = strings.TrimPrefix(version, "v"), strings.TrimPrefix(prefix, "v")
+	if version == prefix {
+		return true
+	}
+	if !strings.HasPrefix(version, prefix) {
+		return false
+	}
+	next := version[len(prefix)]
+	return next == '.' || next == '-'
+}
+
+// compareVersions orders versions of the form major.minor.patch[-prerelease]. It
+// returns a negative number if a is older than b, zero if they are equal, and a
+// positive number if a is newer. A release is newer than its pre-releases.
+func compareVersions(a, b string) int {
+	aCore, aPre, _ := strings.Cut(strings.TrimPrefix(a, "v"), "-")
+	bCore, bPre, _ := strings.Cut(strings.TrimPrefix(b, "v"), "-")
+
+	if c := compareIdentifiers(aCore, bCore); c != 0 {
+		return c
+	}
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	}
+	return compareIdentifiers(aPre, bPre)
+}
+
+// compareIdentifiers compares dot-separated identifiers, numerically where both are numbers.
+func compareIdentifiers(a, b string) int {
+	aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range max(len(aParts), len(bParts)) {
+		if i >= len(aParts) {
+			return -1
+		}
+		if i >= len(bParts) {
+			return 1
+		}
+		aNum, aErr := strconv.Atoi(aParts[i])
+		bNum, bErr := strconv.Atoi(bParts[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if aNum != bNum {
+				return aNum - bNum
+			}
+		case aParts[i] != bParts[i]:
+			return strings.Compare(aParts[i], bParts[i])
+		}
+	}
+	return 0
+}
@@ -0,0 +1,305 @@
+// ABOUTME: Builds the import blocks and skeleton configuration of the zenfra_import_plan data source.
+// ABOUTME: Pure functions over API objects: naming, references between adopted objects, and HCL rendering.
+
+package import_plan
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Resource types an import plan adopts.
+const (
+	typeStack                 = "zenfra_stack"
+	typeBundle                = "zenfra_bundle"
+	typeBundleAttachment      = "zenfra_bundle_attachment"
+	typeSpaceBundleAttachment = "zenfra_space_bundle_attachment"
+)
+
+// plannedResource is one object of the space, with the import ID its resource accepts
+// and the attribute lines of its skeleton configuration.
+type plannedResource struct {
+	Type     string
+	Name     string
+	ImportID string
+	Body     []string
+}
+
+// Address is the resource address the object is imported to.
+func (p plannedResource) Address() string {
+	return p.Type + "." + p.Name
+}
+
+// spaceObjects are the objects of a space an import plan covers.
+type spaceObjects struct {
+	Stacks           []zenfraclient.Stack
+	Bundles          []zenfraclient.Bundle
+	SpaceAttachments []zenfraclient.SpaceBundleAttachment
+	// StackAttachments are the bundle attachments of each stack, by stack ID.
+	StackAttachments map[string][]zenfraclient.BundleAttachment
+}
+
+// buildImportPlan returns the resources adopting the objects of a space: bundles, stacks,
+// the space's bundle attachments, and the stacks' bundle attachments, each group in a
+// stable order. Attachments refer to adopted stacks and bundles by address, and to
+// bundles of other spaces by ID.
+func buildImportPlan(objects spaceObjects) []plannedResource {
+	names := map[string]map[string]bool{}
+	var plan []plannedResource
+
+	bundles := append([]zenfraclient.Bundle(nil), objects.Bundles...)
+	sort.Slice(bundles, func(i, j int) bool { return bundles[i].Slug < bundles[j].Slug })
+	bundleRefs := map[string]string{}
+	bundleNames := map[string]string{}
+	for _, bundle := range bundles {
+		name := uniqueName(names, typeBundle, firstNonEmpty(bundle.Slug, bundle.Name))
+		bundleRefs[bundle.ID] = typeBundle + "." + name + ".id"
+		bundleNames[bundle.ID] = name
+		body := []string{
+			"name = " + quote(bundle.Name),
+			"space_id = " + quote(bundle.SpaceID),
+		}
+		if bundle.Description != "" {
+			body = append(body, "description = "+quote(bundle.Description))
+		}
+		body = append(body, "",
+			"# environment_variable and mounted_file blocks are not exported, since they",
+			"# may hold secrets. Copy them from the bundle before applying, or the apply",
+			"# removes them.")
+		plan = append(plan, plannedResource{Type: typeBundle, Name: name, ImportID: bundle.ID, Body: body})
+	}
+
+	stacks := append([]zenfraclient.Stack(nil), objects.Stacks...)
+	sort.Slice(stacks, func(i, j int) bool { return stacks[i].Name < stacks[j].Name })
+	stackRefs := map[string]string{}
+	stackNames := map[string]string{}
+	for i := range stacks {
+		name := uniqueName(names, typeStack, stacks[i].Name)
+		stackRefs[stacks[i].ID] = typeStack + "." + name + ".id"
+		stackNames[stacks[i].ID] = name
+		plan = append(plan, plannedResource{Type: typeStack, Name: name, ImportID: stacks[i].ID, Body: stackBody(&stacks[i])})
+	}
+
+	bundleRef := func(id string) string {
+		if ref, ok := bundleRefs[id]; ok {
+			return ref
+		}
+		return quote(id)
+	}
+	bundleName := func(id string) string {
+		if name, ok := bundleNames[id]; ok {
+			return name
+		}
+		return id
+	}
+
+	spaceAttachments := append([]zenfraclient.SpaceBundleAttachment(nil), objects.SpaceAttachments...)
+	sort.Slice(spaceAttachments, func(i, j int) bool { return spaceAttachments[i].Priority < spaceAttachments[j].Priority })
+	for _, attachment := range spaceAttachments {
+		plan = append(plan, plannedResource{
+			Type:     typeSpaceBundleAttachment,
+			Name:     uniqueName(names, typeSpaceBundleAttachment, bundleName(attachment.BundleID)),
+			ImportID: attachment.SpaceID + ":" + attachment.BundleID,
+			Body: []string{
+				"space_id = " + quote(attachment.SpaceID),
+				"bundle_id = " + bundleRef(attachment.BundleID),
+			},
+		})
+	}
+
+	for _, stack := range stacks {
+		attachments := append([]zenfraclient.BundleAttachment(nil), objects.StackAttachments[stack.ID]...)
+		sort.Slice(attachments, func(i, j int) bool { return attachments[i].Priority < attachments[j].Priority })
+		for _, attachment := range attachments {
+			plan = append(plan, plannedResource{
+				Type:     typeBundleAttachment,
+				Name:     uniqueName(names, typeBundleAttachment, stackNames[stack.ID]+"_"+bundleName(attachment.BundleID)),
+				ImportID: attachment.StackID + ":" + attachment.BundleID,
+				Body: []string{
+					"stack_id = " + stackRefs[stack.ID],
+					"bundle_id = " + bundleRef(attachment.BundleID),
+				},
+			})
+		}
+	}
+	return plan
+}
+
+// stackBody returns the skeleton configuration of a stack: its identity, engine, and
+// source. Hooks, triggers, and run environment are left for the plan to show.
+func stackBody(stack *zenfraclient.Stack) []string {
+	body := []string{
+		"name = " + quote(stack.Name),
+		"space_id = " + quote(stack.SpaceID),
+	}
+	if stack.EnvironmentType != "" {
+		body = append(body, "environment_type = "+quote(stack.EnvironmentType))
+	}
+	if stack.OwnerTeamID != "" {
+		body = append(body, "owner_team_id = "+quote(stack.OwnerTeamID))
+	}
+	if stack.WorkerPoolID != nil && *stack.WorkerPoolID != "" {
+		body = append(body, "worker_pool_id = "+quote(*stack.WorkerPoolID))
+	}
+	body = append(body, "",
+		"iac = {",
+		"  engine = "+quote(stack.IAC.Engine),
+		"  version = "+quote(stack.IAC.Version),
+		"}",
+	)
+
+	if stack.TemplateID != "" {
+		return append(body, "", "template_id = "+quote(stack.TemplateID))
+	}
+	switch {
+	case stack.Source.Type == "raw_git" && stack.Source.RawGit != nil:
+		src := stack.Source.RawGit
+		body = append(body, "",
+			"source = {",
+			`  type = "raw_git"`,
+			"  raw_git = {",
+			"    url = "+quote(src.URL),
+			"    ref = { type = "+quote(src.Ref.Type)+", name = "+quote(src.Ref.Name)+" }",
+		)
+		if src.Path != "" {
+			body = append(body, "    path = "+quote(src.Path))
+		}
+		body = append(body, "  }", "}")
+	case stack.Source.Type == "vcs" && stack.Source.VCS != nil:
+		src := stack.Source.VCS
+		body = append(body, "",
+			"source = {",
+			`  type = "vcs"`,
+			"  vcs = {",
+			"    provider = "+quote(src.Provider),
+			"    integration_id = "+quote(src.IntegrationID),
+			"    repository_id = "+quote(src.RepositoryID),
+			"    ref = { type = "+quote(src.Ref.Type)+", name = "+quote(src.Ref.Name)+" }",
+		)
+		if src.Path != "" {
+			body = append(body, "    path = "+quote(src.Path))
+		}
+		body = append(body, "  }", "}")
+	}
+	return body
+}
+
+// renderImportBlocks renders an import block per resource.
+func renderImportBlocks(plan []plannedResource) string {
+	var b strings.Builder
+	for i, r := range plan {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "import {\n  to = %s\n  id = %s\n}\n", r.Address(), quote(r.ImportID))
+	}
+	return b.String()
+}
+
+// renderConfiguration renders a resource block per resource.
+func renderConfiguration(plan []plannedResource) string {
+	var b strings.Builder
+	for i, r := range plan {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "resource %q %q {\n", r.Type, r.Name)
+		for _, line := range alignEquals(r.Body) {
+			if line == "" {
+				b.WriteString("\n")
+				continue
+			}
+			b.WriteString("  " + line + "\n")
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+// attributeLine matches an attribute assignment, capturing its indentation, name, and value.
+var attributeLine = regexp.MustCompile(`^(\s*)([a-z_]+) = (.*)$`)
+
+// alignEquals pads the names of consecutive attributes at the same indentation so their
+// equals signs line up, as terraform fmt does.
+func alignEquals(lines []string) []string {
+	out := make([]string, len(lines))
+	for start := 0; start < len(lines); {
+		m := attributeLine.FindStringSubmatch(lines[start])
+		if m == nil {
+			out[start] = lines[start]
+			start++
+			continue
+		}
+		end, width := start, 0
+		for ; end < len(lines); end++ {
+			n := attributeLine.FindStringSubmatch(lines[end])
+			if n == nil || n[1] != m[1] {
+				break
+			}
+			width = max(width, len(n[2]))
+		}
+		for i := start; i < end; i++ {
+			n := attributeLine.FindStringSubmatch(lines[i])
+			out[i] = fmt.Sprintf("%s%-*s = %s", n[1], width, n[2], n[3])
+		}
+		start = end
+	}
+	return out
+}
+
+// uniqueName turns s into a Terraform identifier that no other resource of typ in
+// names uses, numbering repeats _2, _3, and so on.
+func uniqueName(names map[string]map[string]bool, typ, s string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range strings.ToLower(s) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+			underscore = false
+			continue
+		}
+		if !underscore && b.Len() > 0 {
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+	base := strings.TrimSuffix(b.String(), "_")
+	if base == "" {
+		base = "unnamed"
+	}
+	if base[0] >= '0' && base[0] <= '9' {
+		base = "_" + base
+	}
+
+	if names[typ] == nil {
+		names[typ] = map[string]bool{}
+	}
+	name := base
+	for n := 2; names[typ][name]; n++ {
+		name = fmt.Sprintf("%s_%d", base, n)
+	}
+	names[typ][name] = true
+	return name
+}
+
+// quote renders s as an HCL string literal, escaping template sequences so the value
+// is taken literally.
+func quote(s string) string {
+	q := strconv.Quote(s)
+	q = strings.ReplaceAll(q, "${", "$${")
+	return strings.ReplaceAll(q, "%{", "%%{")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,158 @@
+// ABOUTME: Data source listing the stacks, bundles, and bundle attachments of a space for adoption into Terraform.
+// ABOUTME: Emits ready-to-paste import blocks and a skeleton configuration for every object.
+
+package import_plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type importPlanDataSource struct {
+	client *zenfraclient.Client
+}
+
+type importPlanDataSourceModel struct {
+	SpaceID       types.String              `tfsdk:"space_id"`
+	Resources     []importPlanResourceModel `tfsdk:"resources"`
+	ImportBlocks  types.String              `tfsdk:"import_blocks"`
+	Configuration types.String              `tfsdk:"configuration"`
+}
+
+type importPlanResourceModel struct {
+	Type     types.String `tfsdk:"type"`
+	Name     types.String `tfsdk:"name"`
+	Address  types.String `tfsdk:"address"`
+	ImportID types.String `tfsdk:"import_id"`
+}
+
+var _ datasource.DataSource = &importPlanDataSource{}
+var _ datasource.DataSourceWithConfigure = &importPlanDataSource{}
+
+func NewImportPlanDataSource() datasource.DataSource {
+	return &importPlanDataSource{}
+}
+
+func (d *importPlanDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_plan"
+}
+
+func (d *importPlanDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the stacks, configuration bundles, and bundle attachments of a space, and generates the `import` blocks " +
+			"(Terraform 1.5+) and skeleton resource configuration to bring them under Terraform management. " +
+			"Write both outputs to files, review them, and run `terraform plan`: attributes the skeleton leaves out, such as " +
+			"triggers, hooks, and bundle contents, show up as changes that would remove them.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "The space whose objects to adopt. Child spaces are not included.",
+				Required:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "The objects to import: bundles, stacks, the space's bundle attachments, then the stacks' bundle attachments.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The resource type, e.g. `zenfra_stack`.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The resource name, derived from the object's name or slug.",
+							Computed:            true,
+						},
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The resource address the object is imported to, e.g. `zenfra_stack.network`.",
+							Computed:            true,
+						},
+						"import_id": schema.StringAttribute{
+							MarkdownDescription: "The ID the resource's import accepts.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"import_blocks": schema.StringAttribute{
+				MarkdownDescription: "An `import` block for every object in `resources`.",
+				Computed:            true,
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "A `resource` block for every object in `resources`, with its required attributes filled in. " +
+					"Attachments refer to the stacks and bundles they adopt along with them.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *importPlanDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *importPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data importPlanDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := data.SpaceID.ValueString()
+	objects := spaceObjects{StackAttachments: map[string][]zenfraclient.BundleAttachment{}}
+
+	var err error
+	objects.Stacks, err = d.client.ListStacks(ctx, &zenfraclient.ListStacksOptions{SpaceID: &spaceID})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list stacks, got error: %s", err))
+		return
+	}
+
+	// The API has no space filter for bundles, so apply it here.
+	bundles, err := d.client.ListBundles(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list bundles, got error: %s", err))
+		return
+	}
+	for _, bundle := range bundles {
+		if bundle.SpaceID == spaceID {
+			objects.Bundles = append(objects.Bundles, bundle)
+		}
+	}
+
+	objects.SpaceAttachments, err = d.client.ListSpaceBundles(ctx, spaceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list the bundles attached to space %s, got error: %s", spaceID, err))
+		return
+	}
+
+	for _, stack := range objects.Stacks {
+		attachments, err := d.client.ListStackBundles(ctx, stack.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list the bundles attached to stack %s, got error: %s", stack.ID, err))
+			return
+		}
+		objects.StackAttachments[stack.ID] = attachments
+	}
+
+	plan := buildImportPlan(objects)
+	data.Resources = make([]importPlanResourceModel, 0, len(plan))
+	for _, r := range plan {
+		data.Resources = append(data.Resources, importPlanResourceModel{
+			Type:     types.StringValue(r.Type),
+			Name:     types.StringValue(r.Name),
+			Address:  types.StringValue(r.Address()),
+			ImportID: types.StringValue(r.ImportID),
+		})
+	}
+	data.ImportBlocks = types.StringValue(renderImportBlocks(plan))
+	data.Configuration = types.StringValue(renderConfiguration(plan))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
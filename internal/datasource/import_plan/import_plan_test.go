@@ -0,0 +1,134 @@
+// ABOUTME: Unit tests for the zenfra_import_plan rendering.
+// ABOUTME: Checks resource naming, references between adopted objects, and the generated HCL.
+package import_plan
+
+import (
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestBuildImportPlan(t *testing.T) {
+	pool := "pool-1"
+	objects := spaceObjects{
+		Bundles: []zenfraclient.Bundle{
+			{ID: "bundle-2", SpaceID: "space-1", Name: "AWS creds", Slug: "aws-creds"},
+			{ID: "bundle-1", SpaceID: "space-1", Name: "Common", Slug: "common", Description: "Shared ${var} settings"},
+		},
+		Stacks: []zenfraclient.Stack{
+			{
+				ID: "stack-2", SpaceID: "space-1", Name: "Network", WorkerPoolID: &pool,
+				IAC: zenfraclient.IACConfig{Engine: "terraform", Version: "1.9.0"},
+				Source: zenfraclient.StackSource{Type: "vcs", VCS: &zenfraclient.StackSourceVCS{
+					Provider: "github", IntegrationID: "vcs-1", RepositoryID: "acme/infra",
+					Ref: zenfraclient.StackSourceRef{Type: "branch", Name: "main"}, Path: "network",
+				}},
+			},
+			{
+				ID: "stack-1", SpaceID: "space-1", Name: "2024 app", TemplateID: "tpl-1",
+				IAC: zenfraclient.IACConfig{Engine: "opentofu", Version: "1.8.0"},
+			},
+		},
+		SpaceAttachments: []zenfraclient.SpaceBundleAttachment{{SpaceID: "space-1", BundleID: "bundle-1"}},
+		StackAttachments: map[string][]zenfraclient.BundleAttachment{
+			"stack-2": {
+				{StackID: "stack-2", BundleID: "bundle-other", Priority: 2},
+				{StackID: "stack-2", BundleID: "bundle-2", Priority: 1},
+			},
+		},
+	}
+
+	plan := buildImportPlan(objects)
+
+	var addresses []string
+	for _, r := range plan {
+		addresses = append(addresses, r.Address()+" "+r.ImportID)
+	}
+	wantAddresses := []string{
+		"zenfra_bundle.aws_creds bundle-2",
+		"zenfra_bundle.common bundle-1",
+		"zenfra_stack._2024_app stack-1",
+		"zenfra_stack.network stack-2",
+		"zenfra_space_bundle_attachment.common space-1:bundle-1",
+		"zenfra_bundle_attachment.network_aws_creds stack-2:bundle-2",
+		"zenfra_bundle_attachment.network_bundle_other stack-2:bundle-other",
+	}
+	if len(addresses) != len(wantAddresses) {
+		t.Fatalf("expected %d resources, got %v", len(wantAddresses), addresses)
+	}
+	for i := range wantAddresses {
+		if addresses[i] != wantAddresses[i] {
+			t.Errorf("resource %d: expected %s, got %s", i, wantAddresses[i], addresses[i])
+		}
+	}
+
+	wantImports := `import {
+  to = zenfra_bundle.aws_creds
+  id = "bundle-2"
+}
+`
+	if got := renderImportBlocks(plan[:1]); got != wantImports {
+		t.Errorf("unexpected import blocks:\n%s", got)
+	}
+
+	wantConfiguration := `resource "zenfra_bundle" "common" {
+  name        = "Common"
+  space_id    = "space-1"
+  description = "Shared $${var} settings"
+
+  # environment_variable and mounted_file blocks are not exported, since they
+  # may hold secrets. Copy them from the bundle before applying, or the apply
+  # removes them.
+}
+
+resource "zenfra_stack" "network" {
+  name           = "Network"
+  space_id       = "space-1"
+  worker_pool_id = "pool-1"
+
+  iac = {
+    engine  = "terraform"
+    version = "1.9.0"
+  }
+
+  source = {
+    type = "vcs"
+    vcs  = {
+      provider       = "github"
+      integration_id = "vcs-1"
+      repository_id  = "acme/infra"
+      ref            = { type = "branch", name = "main" }
+      path           = "network"
+    }
+  }
+}
+
+resource "zenfra_space_bundle_attachment" "common" {
+  space_id  = "space-1"
+  bundle_id = zenfra_bundle.common.id
+}
+
+resource "zenfra_bundle_attachment" "network_bundle_other" {
+  stack_id  = zenfra_stack.network.id
+  bundle_id = "bundle-other"
+}
+`
+	if got := renderConfiguration([]plannedResource{plan[1], plan[3], plan[4], plan[6]}); got != wantConfiguration {
+		t.Errorf("unexpected configuration:\n%s", got)
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	names := map[string]map[string]bool{}
+	for _, tt := range []struct{ typ, in, want string }{
+		{typeStack, "Prod / Network", "prod_network"},
+		{typeStack, "prod-network", "prod_network_2"},
+		{typeBundle, "prod-network", "prod_network"},
+		{typeStack, "  ", "unnamed"},
+		{typeStack, "9lives", "_9lives"},
+	} {
+		if got := uniqueName(names, tt.typ, tt.in); got != tt.want {
+			t.Errorf("uniqueName(%q, %q) = %q, want %q", tt.typ, tt.in, got, tt.want)
+		}
+	}
+}
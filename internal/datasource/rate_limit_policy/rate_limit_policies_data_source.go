@@ -0,0 +1,132 @@
+// ABOUTME: Data source for listing the organization's API rate limit policies and limit bounds.
+// ABOUTME: Reports the default rate limit and the highest limits a zenfra_rate_limit_policy may grant.
+package rate_limit_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type rateLimitPoliciesDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &rateLimitPoliciesDataSource{}
+var _ datasource.DataSourceWithConfigure = &rateLimitPoliciesDataSource{}
+
+func NewRateLimitPoliciesDataSource() datasource.DataSource {
+	return &rateLimitPoliciesDataSource{}
+}
+
+func (d *rateLimitPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limit_policies"
+}
+
+func (d *rateLimitPoliciesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the organization's API rate limit policies, with the default limit that applies to requests " +
+			"no policy covers and the highest limits a policy may grant.",
+		Attributes: map[string]schema.Attribute{
+			"token_id": schema.StringAttribute{
+				MarkdownDescription: "Only list the policies of this API token.",
+				Optional:            true,
+			},
+			"default_requests_per_minute": schema.Int64Attribute{
+				MarkdownDescription: "The requests per minute allowed to requests no policy covers.",
+				Computed:            true,
+			},
+			"max_requests_per_minute": schema.Int64Attribute{
+				MarkdownDescription: "The highest `requests_per_minute` a policy may allow. Null if there is no maximum.",
+				Computed:            true,
+			},
+			"max_burst": schema.Int64Attribute{
+				MarkdownDescription: "The highest `burst` a policy may allow. Null if there is no maximum.",
+				Computed:            true,
+			},
+			"policies": schema.ListNestedAttribute{
+				MarkdownDescription: "Rate limit policies.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the policy.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Why the policy exists.",
+							Computed:            true,
+						},
+						"token_id": schema.StringAttribute{
+							MarkdownDescription: "The API token the policy covers, if it covers a token.",
+							Computed:            true,
+						},
+						"source_cidr": schema.StringAttribute{
+							MarkdownDescription: "The network the policy covers, if it covers a network.",
+							Computed:            true,
+						},
+						"requests_per_minute": schema.Int64Attribute{
+							MarkdownDescription: "The requests per minute the policy allows.",
+							Computed:            true,
+						},
+						"burst": schema.Int64Attribute{
+							MarkdownDescription: "The requests that may be made at once before the per-minute rate applies.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the policy was created.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the policy was last updated.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *rateLimitPoliciesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *rateLimitPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data rateLimitPoliciesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bounds, err := d.client.GetRateLimitBounds(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rate limit bounds, got error: %s", err))
+		return
+	}
+	policies, err := d.client.ListRateLimitPolicies(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list rate limit policies, got error: %s", err))
+		return
+	}
+
+	data.DefaultRequestsPerMinute = types.Int64Value(bounds.DefaultRequestsPerMinute)
+	data.MaxRequestsPerMinute = boundValue(bounds.MaxRequestsPerMinute)
+	data.MaxBurst = boundValue(bounds.MaxBurst)
+	data.Policies = make([]rateLimitPolicyItemModel, 0, len(policies))
+	for i := range policies {
+		if !data.TokenID.IsNull() && policies[i].TokenID != data.TokenID.ValueString() {
+			continue
+		}
+		data.Policies = append(data.Policies, mapPolicyToItem(&policies[i]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
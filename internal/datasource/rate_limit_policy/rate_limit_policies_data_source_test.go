@@ -0,0 +1,44 @@
+// ABOUTME: Unit tests for the rate limit policies data source model mapping.
+// ABOUTME: Covers token and network policies, the default burst, and unlimited bounds.
+package rate_limit_policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapPolicyToItem(t *testing.T) {
+	item := mapPolicyToItem(&zenfraclient.RateLimitPolicy{
+		ID:                "rlp-1",
+		Description:       "CI deploys",
+		TokenID:           "tok-ci",
+		RequestsPerMinute: 3000,
+		Burst:             500,
+		CreatedAt:         time.Date(2026, 4, 1, 8, 0, 0, 0, time.UTC),
+	})
+	if item.TokenID.ValueString() != "tok-ci" || !item.SourceCIDR.IsNull() || item.Burst.ValueInt64() != 500 {
+		t.Errorf("unexpected token policy: %+v", item)
+	}
+	if item.CreatedAt.ValueString() != "2026-04-01T08:00:00Z" {
+		t.Errorf("expected created_at 2026-04-01T08:00:00Z, got %s", item.CreatedAt.ValueString())
+	}
+
+	item = mapPolicyToItem(&zenfraclient.RateLimitPolicy{ID: "rlp-2", SourceCIDR: "10.0.0.0/8", RequestsPerMinute: 1200})
+	if !item.TokenID.IsNull() || item.SourceCIDR.ValueString() != "10.0.0.0/8" {
+		t.Errorf("unexpected network policy: %+v", item)
+	}
+	if item.Burst.ValueInt64() != 1200 {
+		t.Errorf("expected the default burst to equal requests_per_minute, got %d", item.Burst.ValueInt64())
+	}
+}
+
+func TestBoundValue(t *testing.T) {
+	if !boundValue(0).IsNull() {
+		t.Error("expected no maximum to map to null")
+	}
+	if boundValue(6000).ValueInt64() != 6000 {
+		t.Errorf("expected 6000, got %v", boundValue(6000))
+	}
+}
@@ -0,0 +1,63 @@
+// ABOUTME: Model types for the zenfra_rate_limit_policies data source.
+// ABOUTME: Maps API rate limit policies to list items; unset targets and bursts map to null.
+package rate_limit_policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// rateLimitPoliciesDataSourceModel represents the Terraform state for the rate limit policies data source.
+type rateLimitPoliciesDataSourceModel struct {
+	TokenID                  types.String               `tfsdk:"token_id"`
+	DefaultRequestsPerMinute types.Int64                `tfsdk:"default_requests_per_minute"`
+	MaxRequestsPerMinute     types.Int64                `tfsdk:"max_requests_per_minute"`
+	MaxBurst                 types.Int64                `tfsdk:"max_burst"`
+	Policies                 []rateLimitPolicyItemModel `tfsdk:"policies"`
+}
+
+// rateLimitPolicyItemModel represents a single item in the policies list.
+type rateLimitPolicyItemModel struct {
+	ID                types.String `tfsdk:"id"`
+	Description       types.String `tfsdk:"description"`
+	TokenID           types.String `tfsdk:"token_id"`
+	SourceCIDR        types.String `tfsdk:"source_cidr"`
+	RequestsPerMinute types.Int64  `tfsdk:"requests_per_minute"`
+	Burst             types.Int64  `tfsdk:"burst"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UpdatedAt         types.String `tfsdk:"updated_at"`
+}
+
+// mapPolicyToItem converts an API rate limit policy to a list item model. A policy
+// without its own burst reports its requests per minute, the burst the API applies.
+func mapPolicyToItem(policy *zenfraclient.RateLimitPolicy) rateLimitPolicyItemModel {
+	item := rateLimitPolicyItemModel{
+		ID:                types.StringValue(policy.ID),
+		Description:       types.StringValue(policy.Description),
+		TokenID:           types.StringNull(),
+		SourceCIDR:        types.StringNull(),
+		RequestsPerMinute: types.Int64Value(policy.RequestsPerMinute),
+		Burst:             types.Int64Value(policy.Burst),
+		CreatedAt:         timeutil.String(policy.CreatedAt),
+		UpdatedAt:         timeutil.String(policy.UpdatedAt),
+	}
+	if policy.TokenID != "" {
+		item.TokenID = types.StringValue(policy.TokenID)
+	}
+	if policy.SourceCIDR != "" {
+		item.SourceCIDR = types.StringValue(policy.SourceCIDR)
+	}
+	if policy.Burst == 0 {
+		item.Burst = types.Int64Value(policy.RequestsPerMinute)
+	}
+	return item
+}
+
+// boundValue maps a maximum of zero, which means no limit, to null.
+func boundValue(limit int64) types.Int64 {
+	if limit == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(limit)
+}
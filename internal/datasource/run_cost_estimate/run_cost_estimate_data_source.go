@@ -0,0 +1,112 @@
+// ABOUTME: Data source for reading the cost estimate of a Zenfra run's plan.
+// ABOUTME: Can fail the plan when the monthly cost increase exceeds a threshold, for cost-gated applies.
+package run_cost_estimate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type runCostEstimateDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &runCostEstimateDataSource{}
+var _ datasource.DataSourceWithConfigure = &runCostEstimateDataSource{}
+
+func NewRunCostEstimateDataSource() datasource.DataSource {
+	return &runCostEstimateDataSource{}
+}
+
+func (d *runCostEstimateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_cost_estimate"
+}
+
+func (d *runCostEstimateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the cost estimate of a Zenfra run's plan. Set `max_monthly_delta` to stop the Terraform plan that reads this data source " +
+			"when the run would raise the monthly cost by more than that amount.",
+		Attributes: map[string]schema.Attribute{
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the run whose cost estimate to read.",
+				Required:            true,
+			},
+			"max_monthly_delta": schema.Float64Attribute{
+				MarkdownDescription: "When set, reading the data source fails if the run raises the monthly cost by more than this amount, " +
+					"in the estimate's currency, or if the estimate did not finish.",
+				Optional: true,
+			},
+			"stack_id": schema.StringAttribute{
+				MarkdownDescription: "The stack the run belongs to.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the estimate: `pending`, `finished`, `errored`, or `skipped`. Costs are only set when it is `finished`.",
+				Computed:            true,
+			},
+			"currency": schema.StringAttribute{
+				MarkdownDescription: "The ISO 4217 currency of the costs, e.g. `USD`.",
+				Computed:            true,
+			},
+			"prior_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: "The estimated monthly cost of the resources before the run.",
+				Computed:            true,
+			},
+			"proposed_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: "The estimated monthly cost of the resources after the run.",
+				Computed:            true,
+			},
+			"delta_monthly_cost": schema.Float64Attribute{
+				MarkdownDescription: "The change in estimated monthly cost. Negative when the run saves money.",
+				Computed:            true,
+			},
+			"resources_priced": schema.Int64Attribute{
+				MarkdownDescription: "Number of changed resources included in the estimate.",
+				Computed:            true,
+			},
+			"resources_unpriced": schema.Int64Attribute{
+				MarkdownDescription: "Number of changed resources the estimator has no pricing for, and which are therefore not counted in the costs.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *runCostEstimateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *runCostEstimateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data runCostEstimateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	estimate, err := d.client.GetRunCostEstimate(ctx, data.RunID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read run cost estimate, got error: %s", err))
+		return
+	}
+	if estimate.RunID == "" {
+		estimate.RunID = data.RunID.ValueString()
+	}
+
+	mapCostEstimate(&data, estimate)
+
+	if !data.MaxMonthlyDelta.IsNull() && !data.MaxMonthlyDelta.IsUnknown() {
+		if violation := budgetViolation(estimate, data.MaxMonthlyDelta.ValueFloat64()); violation != "" {
+			resp.Diagnostics.AddError("Cost Threshold Exceeded", violation)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,70 @@
+// ABOUTME: Unit tests for the zenfra_run_cost_estimate data source model mapping.
+// ABOUTME: Verifies that costs are only set for finished estimates and the max_monthly_delta check.
+package run_cost_estimate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapCostEstimate(t *testing.T) {
+	var model runCostEstimateDataSourceModel
+	mapCostEstimate(&model, &zenfraclient.RunCostEstimate{
+		StackID: "stack-1", Status: zenfraclient.CostEstimateStatusFinished, Currency: "USD",
+		PriorMonthlyCost: 100, ProposedMonthlyCost: 80, DeltaMonthlyCost: -20, ResourcesPriced: 4, ResourcesUnpriced: 1,
+	})
+	if model.DeltaMonthlyCost.ValueFloat64() != -20 || model.Currency.ValueString() != "USD" || model.ResourcesUnpriced.ValueInt64() != 1 {
+		t.Errorf("unexpected model: %+v", model)
+	}
+
+	mapCostEstimate(&model, &zenfraclient.RunCostEstimate{StackID: "stack-1", Status: zenfraclient.CostEstimateStatusErrored})
+	if !model.DeltaMonthlyCost.IsNull() || !model.Currency.IsNull() {
+		t.Errorf("expected null costs for an errored estimate, got %+v", model)
+	}
+}
+
+func TestBudgetViolation(t *testing.T) {
+	tests := []struct {
+		name     string
+		estimate zenfraclient.RunCostEstimate
+		limit    float64
+		want     string
+	}{
+		{
+			name:     "within budget",
+			estimate: zenfraclient.RunCostEstimate{Status: zenfraclient.CostEstimateStatusFinished, DeltaMonthlyCost: 50},
+			limit:    50,
+		},
+		{
+			name:     "savings",
+			estimate: zenfraclient.RunCostEstimate{Status: zenfraclient.CostEstimateStatusFinished, DeltaMonthlyCost: -300},
+			limit:    0,
+		},
+		{
+			name:     "over budget",
+			estimate: zenfraclient.RunCostEstimate{RunID: "run-1", Status: zenfraclient.CostEstimateStatusFinished, Currency: "USD", DeltaMonthlyCost: 50.01},
+			limit:    50,
+			want:     "increases the monthly cost by 50.01 USD, more than the allowed 50.00 USD",
+		},
+		{
+			name:     "skipped estimate",
+			estimate: zenfraclient.RunCostEstimate{RunID: "run-1", Status: zenfraclient.CostEstimateStatusSkipped},
+			limit:    50,
+			want:     "is skipped",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := budgetViolation(&tt.estimate, tt.limit)
+			if tt.want == "" && got != "" {
+				t.Errorf("expected no violation, got %q", got)
+			}
+			if tt.want != "" && !strings.Contains(got, tt.want) {
+				t.Errorf("expected violation containing %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
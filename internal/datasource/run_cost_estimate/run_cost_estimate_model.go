@@ -0,0 +1,58 @@
+// ABOUTME: Model types for the zenfra_run_cost_estimate data source.
+// ABOUTME: Maps the API cost estimate to Terraform types and checks it against the configured monthly budget.
+package run_cost_estimate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// runCostEstimateDataSourceModel represents the Terraform state for the run cost estimate data source.
+type runCostEstimateDataSourceModel struct {
+	RunID               types.String  `tfsdk:"run_id"`
+	MaxMonthlyDelta     types.Float64 `tfsdk:"max_monthly_delta"`
+	StackID             types.String  `tfsdk:"stack_id"`
+	Status              types.String  `tfsdk:"status"`
+	Currency            types.String  `tfsdk:"currency"`
+	PriorMonthlyCost    types.Float64 `tfsdk:"prior_monthly_cost"`
+	ProposedMonthlyCost types.Float64 `tfsdk:"proposed_monthly_cost"`
+	DeltaMonthlyCost    types.Float64 `tfsdk:"delta_monthly_cost"`
+	ResourcesPriced     types.Int64   `tfsdk:"resources_priced"`
+	ResourcesUnpriced   types.Int64   `tfsdk:"resources_unpriced"`
+}
+
+// mapCostEstimate fills the computed attributes of model from the API estimate.
+// Costs are null unless the estimate finished.
+func mapCostEstimate(model *runCostEstimateDataSourceModel, estimate *zenfraclient.RunCostEstimate) {
+	model.StackID = types.StringValue(estimate.StackID)
+	model.Status = types.StringValue(estimate.Status)
+	model.ResourcesPriced = types.Int64Value(int64(estimate.ResourcesPriced))
+	model.ResourcesUnpriced = types.Int64Value(int64(estimate.ResourcesUnpriced))
+
+	if estimate.Status != zenfraclient.CostEstimateStatusFinished {
+		model.Currency = types.StringNull()
+		model.PriorMonthlyCost = types.Float64Null()
+		model.ProposedMonthlyCost = types.Float64Null()
+		model.DeltaMonthlyCost = types.Float64Null()
+		return
+	}
+	model.Currency = types.StringValue(estimate.Currency)
+	model.PriorMonthlyCost = types.Float64Value(estimate.PriorMonthlyCost)
+	model.ProposedMonthlyCost = types.Float64Value(estimate.ProposedMonthlyCost)
+	model.DeltaMonthlyCost = types.Float64Value(estimate.DeltaMonthlyCost)
+}
+
+// budgetViolation explains why estimate breaks a max_monthly_delta of limit, or returns ""
+// if it does not. An estimate without costs cannot be checked and always violates.
+func budgetViolation(estimate *zenfraclient.RunCostEstimate, limit float64) string {
+	if estimate.Status != zenfraclient.CostEstimateStatusFinished {
+		return fmt.Sprintf("The cost estimate of run %s is %s, so max_monthly_delta cannot be checked.", estimate.RunID, estimate.Status)
+	}
+	if estimate.DeltaMonthlyCost > limit {
+		return fmt.Sprintf("Run %s increases the monthly cost by %.2f %s, more than the allowed %.2f %s.",
+			estimate.RunID, estimate.DeltaMonthlyCost, estimate.Currency, limit, estimate.Currency)
+	}
+	return ""
+}
@@ -0,0 +1,143 @@
+// ABOUTME: Data source for reading the tail of a Zenfra run's log output.
+// ABOUTME: Defaults to the stack's latest run, e.g. to annotate a pull request with apply output.
+package run_logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// defaultTailLines is how many lines are read when tail_lines is not set.
+const defaultTailLines = 100
+
+type runLogsDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &runLogsDataSource{}
+var _ datasource.DataSourceWithConfigure = &runLogsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &runLogsDataSource{}
+
+func NewRunLogsDataSource() datasource.DataSource {
+	return &runLogsDataSource{}
+}
+
+func (d *runLogsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_logs"
+}
+
+func (d *runLogsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the last lines of a run's log output, by default of a stack's latest run. " +
+			"The logs of a run still in progress are read as far as they have been written; `complete` tells whether the run has finished.",
+		Attributes: map[string]schema.Attribute{
+			"stack_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the stack.",
+				Required:            true,
+			},
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "The run whose logs to read. Defaults to the stack's latest run.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"phase": schema.StringAttribute{
+				MarkdownDescription: "Only read lines logged in this phase of the run, e.g. `plan` or `apply`. Defaults to all phases.",
+				Optional:            true,
+			},
+			"tail_lines": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of lines to read from the end of the log. Defaults to %d.", defaultTailLines),
+				Optional:            true,
+			},
+			"complete": schema.BoolAttribute{
+				MarkdownDescription: "Whether the run has finished, so the log will not grow any further.",
+				Computed:            true,
+			},
+			"lines": schema.ListNestedAttribute{
+				MarkdownDescription: "The log lines, oldest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "When the line was logged.",
+							Computed:            true,
+						},
+						"phase": schema.StringAttribute{
+							MarkdownDescription: "The phase of the run that logged the line.",
+							Computed:            true,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The text of the line.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The messages of `lines` joined by newlines, ready to post as a comment.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *runLogsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config runLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TailLines.IsNull() && !config.TailLines.IsUnknown() && config.TailLines.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("tail_lines"), "Invalid Tail Lines", "tail_lines must be at least 1.")
+	}
+}
+
+func (d *runLogsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *runLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data runLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := data.StackID.ValueString()
+	if data.RunID.IsNull() || data.RunID.IsUnknown() {
+		stack, err := d.client.GetStackFields(ctx, stackID, zenfraclient.Fields{"id", "last_run"})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stack, got error: %s", err))
+			return
+		}
+		if stack.LastRun == nil {
+			resp.Diagnostics.AddError("Stack Has No Runs", fmt.Sprintf("Stack %s has not run yet, so it has no logs.", stackID))
+			return
+		}
+		data.RunID = types.StringValue(stack.LastRun.ID)
+	}
+
+	tail := int64(defaultTailLines)
+	if !data.TailLines.IsNull() {
+		tail = data.TailLines.ValueInt64()
+	}
+
+	lines, complete, err := d.client.TailRunLogs(ctx, data.RunID.ValueString(), data.Phase.ValueString(), int(tail))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read run logs, got error: %s", err))
+		return
+	}
+
+	mapRunLogs(&data, lines, complete)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
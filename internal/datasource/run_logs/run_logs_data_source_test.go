@@ -0,0 +1,37 @@
+// ABOUTME: Unit tests for the zenfra_run_logs data source model mapping.
+// ABOUTME: Verifies line mapping, the joined text output, and empty logs.
+package run_logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapRunLogs(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	lines := []zenfraclient.RunLogLine{
+		{Timestamp: at, Phase: "apply", Message: "aws_s3_bucket.logs: Creating..."},
+		{Timestamp: at.Add(time.Second), Phase: "apply", Message: "Apply complete! Resources: 1 added, 0 changed, 0 destroyed."},
+	}
+
+	var data runLogsDataSourceModel
+	mapRunLogs(&data, lines, true)
+
+	if !data.Complete.ValueBool() {
+		t.Error("expected complete to be true")
+	}
+	if len(data.Lines) != 2 || data.Lines[0].Timestamp.ValueString() != "2026-03-01T12:00:00Z" || data.Lines[1].Phase.ValueString() != "apply" {
+		t.Errorf("unexpected lines %+v", data.Lines)
+	}
+	want := "aws_s3_bucket.logs: Creating...\nApply complete! Resources: 1 added, 0 changed, 0 destroyed."
+	if data.Text.ValueString() != want {
+		t.Errorf("expected text %q, got %q", want, data.Text.ValueString())
+	}
+
+	mapRunLogs(&data, nil, false)
+	if data.Lines == nil || len(data.Lines) != 0 || data.Text.ValueString() != "" || data.Complete.ValueBool() {
+		t.Errorf("expected an empty, incomplete log, got %+v", data)
+	}
+}
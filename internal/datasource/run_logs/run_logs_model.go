@@ -0,0 +1,45 @@
+// ABOUTME: Model types for the zenfra_run_logs data source.
+// ABOUTME: Maps the tail of a run's log lines to Terraform types and a joined text output.
+package run_logs
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// runLogsDataSourceModel represents the Terraform state for the run logs data source.
+type runLogsDataSourceModel struct {
+	StackID   types.String      `tfsdk:"stack_id"`
+	RunID     types.String      `tfsdk:"run_id"`
+	Phase     types.String      `tfsdk:"phase"`
+	TailLines types.Int64       `tfsdk:"tail_lines"`
+	Complete  types.Bool        `tfsdk:"complete"`
+	Lines     []runLogLineModel `tfsdk:"lines"`
+	Text      types.String      `tfsdk:"text"`
+}
+
+type runLogLineModel struct {
+	Timestamp types.String `tfsdk:"timestamp"`
+	Phase     types.String `tfsdk:"phase"`
+	Message   types.String `tfsdk:"message"`
+}
+
+// mapRunLogs sets the log output of data from lines, which must already be limited to
+// the requested tail.
+func mapRunLogs(data *runLogsDataSourceModel, lines []zenfraclient.RunLogLine, complete bool) {
+	data.Complete = types.BoolValue(complete)
+	data.Lines = make([]runLogLineModel, 0, len(lines))
+	messages := make([]string, 0, len(lines))
+	for _, l := range lines {
+		data.Lines = append(data.Lines, runLogLineModel{
+			Timestamp: timeutil.String(l.Timestamp),
+			Phase:     types.StringValue(l.Phase),
+			Message:   types.StringValue(l.Message),
+		})
+		messages = append(messages, l.Message)
+	}
+	data.Text = types.StringValue(strings.Join(messages, "\n"))
+}
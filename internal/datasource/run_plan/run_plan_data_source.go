@@ -0,0 +1,143 @@
+// ABOUTME: Data source for reading the structured plan produced by a Zenfra run.
+// ABOUTME: Exposes summary counts, per-resource actions, and the raw plan JSON for policy checks.
+package run_plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type runPlanDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &runPlanDataSource{}
+var _ datasource.DataSourceWithConfigure = &runPlanDataSource{}
+
+func NewRunPlanDataSource() datasource.DataSource {
+	return &runPlanDataSource{}
+}
+
+func (d *runPlanDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_plan"
+}
+
+func (d *runPlanDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the structured plan of a Zenfra run, e.g. to assert that a run contains no deletions before approving downstream applies.",
+		Attributes: map[string]schema.Attribute{
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the run whose plan to read.",
+				Required:            true,
+			},
+			"stack_id": schema.StringAttribute{
+				MarkdownDescription: "The stack the run belongs to.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the run's plan.",
+				Computed:            true,
+			},
+			"has_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether the plan contains any resource changes.",
+				Computed:            true,
+			},
+			"has_deletions": schema.BoolAttribute{
+				MarkdownDescription: "Whether the plan destroys any resource, including as part of a replacement.",
+				Computed:            true,
+			},
+			"summary": schema.SingleNestedAttribute{
+				MarkdownDescription: "Counts of planned actions.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"add": schema.Int64Attribute{
+						MarkdownDescription: "Number of resources to create.",
+						Computed:            true,
+					},
+					"change": schema.Int64Attribute{
+						MarkdownDescription: "Number of resources to update in place.",
+						Computed:            true,
+					},
+					"destroy": schema.Int64Attribute{
+						MarkdownDescription: "Number of resources to destroy.",
+						Computed:            true,
+					},
+					"replace": schema.Int64Attribute{
+						MarkdownDescription: "Number of resources to replace.",
+						Computed:            true,
+					},
+					"import": schema.Int64Attribute{
+						MarkdownDescription: "Number of resources to import.",
+						Computed:            true,
+					},
+				},
+			},
+			"resource_changes": schema.ListNestedAttribute{
+				MarkdownDescription: "Planned actions for each resource instance.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							MarkdownDescription: "The full resource instance address.",
+							Computed:            true,
+						},
+						"module_address": schema.StringAttribute{
+							MarkdownDescription: "The module address, if the resource is in a child module.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The resource type.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The resource name.",
+							Computed:            true,
+						},
+						"actions": schema.ListAttribute{
+							MarkdownDescription: "The planned actions (`create`, `update`, `delete`, `read`, `no-op`). A replacement lists both `delete` and `create`.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The raw plan in the IaC engine's JSON plan format, for use with `jsondecode`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *runPlanDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *runPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config runPlanDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan, err := d.client.GetRunPlan(ctx, config.RunID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read run plan, got error: %s", err))
+		return
+	}
+
+	data := mapRunPlanToDataSource(plan)
+	if data.RunID.ValueString() == "" {
+		data.RunID = config.RunID
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,100 @@
+// ABOUTME: Unit tests for the zenfra_run_plan data source model mapping.
+// ABOUTME: Verifies summary counts, resource change actions, and deletion detection.
+package run_plan
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapRunPlanToDataSource(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         *zenfraclient.RunPlan
+		wantChanges   bool
+		wantDeletions bool
+		wantJSON      bool
+	}{
+		{
+			name: "create only",
+			input: &zenfraclient.RunPlan{
+				RunID:   "run-1",
+				StackID: "stack-1",
+				Status:  "finished",
+				Summary: zenfraclient.RunPlanSummary{Add: 1},
+				ResourceChanges: []zenfraclient.RunPlanResourceChange{
+					{Address: "aws_s3_bucket.logs", Type: "aws_s3_bucket", Name: "logs", Actions: []string{"create"}},
+				},
+				PlanJSON: json.RawMessage(`{"format_version":"1.2"}`),
+			},
+			wantChanges:   true,
+			wantDeletions: false,
+			wantJSON:      true,
+		},
+		{
+			name: "replacement counts as deletion",
+			input: &zenfraclient.RunPlan{
+				RunID:   "run-2",
+				Summary: zenfraclient.RunPlanSummary{Replace: 1},
+				ResourceChanges: []zenfraclient.RunPlanResourceChange{
+					{
+						Address:       "module.net.aws_vpc.main",
+						ModuleAddress: "module.net",
+						Type:          "aws_vpc",
+						Name:          "main",
+						Actions:       []string{"delete", "create"},
+					},
+				},
+			},
+			wantChanges:   true,
+			wantDeletions: true,
+		},
+		{
+			name: "no-op plan",
+			input: &zenfraclient.RunPlan{
+				RunID: "run-3",
+				ResourceChanges: []zenfraclient.RunPlanResourceChange{
+					{Address: "aws_iam_role.ci", Type: "aws_iam_role", Name: "ci", Actions: []string{"no-op"}},
+				},
+			},
+			wantChanges:   false,
+			wantDeletions: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := mapRunPlanToDataSource(tt.input)
+
+			if result.RunID.ValueString() != tt.input.RunID {
+				t.Errorf("RunID mismatch: got %v, want %s", result.RunID, tt.input.RunID)
+			}
+			if result.HasChanges.ValueBool() != tt.wantChanges {
+				t.Errorf("HasChanges: got %v, want %v", result.HasChanges.ValueBool(), tt.wantChanges)
+			}
+			if result.HasDeletions.ValueBool() != tt.wantDeletions {
+				t.Errorf("HasDeletions: got %v, want %v", result.HasDeletions.ValueBool(), tt.wantDeletions)
+			}
+			if result.JSON.IsNull() == tt.wantJSON {
+				t.Errorf("JSON null: got %v, want %v", result.JSON.IsNull(), !tt.wantJSON)
+			}
+			if len(result.ResourceChanges) != len(tt.input.ResourceChanges) {
+				t.Fatalf("expected %d resource changes, got %d", len(tt.input.ResourceChanges), len(result.ResourceChanges))
+			}
+			for i, rc := range tt.input.ResourceChanges {
+				got := result.ResourceChanges[i]
+				if got.Address.ValueString() != rc.Address {
+					t.Errorf("Address mismatch: got %v, want %s", got.Address, rc.Address)
+				}
+				if len(got.Actions) != len(rc.Actions) {
+					t.Errorf("expected %d actions, got %d", len(rc.Actions), len(got.Actions))
+				}
+				if rc.ModuleAddress == "" && !got.ModuleAddress.IsNull() {
+					t.Errorf("expected null module_address, got %v", got.ModuleAddress)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// ABOUTME: Model types for the zenfra_run_plan data source.
+// ABOUTME: Maps the API RunPlan (summary counts and resource changes) to Terraform types.
+package run_plan
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// runPlanDataSourceModel represents the Terraform state for the run plan data source.
+type runPlanDataSourceModel struct {
+	RunID           types.String                 `tfsdk:"run_id"`
+	StackID         types.String                 `tfsdk:"stack_id"`
+	Status          types.String                 `tfsdk:"status"`
+	HasChanges      types.Bool                   `tfsdk:"has_changes"`
+	HasDeletions    types.Bool                   `tfsdk:"has_deletions"`
+	Summary         *runPlanSummaryModel         `tfsdk:"summary"`
+	ResourceChanges []runPlanResourceChangeModel `tfsdk:"resource_changes"`
+	JSON            types.String                 `tfsdk:"json"`
+}
+
+type runPlanSummaryModel struct {
+	Add     types.Int64 `tfsdk:"add"`
+	Change  types.Int64 `tfsdk:"change"`
+	Destroy types.Int64 `tfsdk:"destroy"`
+	Replace types.Int64 `tfsdk:"replace"`
+	Import  types.Int64 `tfsdk:"import"`
+}
+
+type runPlanResourceChangeModel struct {
+	Address       types.String   `tfsdk:"address"`
+	ModuleAddress types.String   `tfsdk:"module_address"`
+	Type          types.String   `tfsdk:"type"`
+	Name          types.String   `tfsdk:"name"`
+	Actions       []types.String `tfsdk:"actions"`
+}
+
+// mapRunPlanToDataSource converts an API RunPlan to the data source model.
+func mapRunPlanToDataSource(plan *zenfraclient.RunPlan) runPlanDataSourceModel {
+	model := runPlanDataSourceModel{
+		RunID:   types.StringValue(plan.RunID),
+		StackID: types.StringValue(plan.StackID),
+		Status:  types.StringValue(plan.Status),
+		Summary: &runPlanSummaryModel{
+			Add:     types.Int64Value(int64(plan.Summary.Add)),
+			Change:  types.Int64Value(int64(plan.Summary.Change)),
+			Destroy: types.Int64Value(int64(plan.Summary.Destroy)),
+			Replace: types.Int64Value(int64(plan.Summary.Replace)),
+			Import:  types.Int64Value(int64(plan.Summary.Import)),
+		},
+		ResourceChanges: make([]runPlanResourceChangeModel, 0, len(plan.ResourceChanges)),
+	}
+
+	hasChanges := false
+	// Replacements destroy the existing object too, so they count as deletions.
+	hasDeletions := plan.Summary.Destroy > 0 || plan.Summary.Replace > 0
+	for _, rc := range plan.ResourceChanges {
+		actions := make([]types.String, 0, len(rc.Actions))
+		for _, a := range rc.Actions {
+			actions = append(actions, types.StringValue(a))
+		}
+		if !slices.Equal(rc.Actions, []string{"no-op"}) && !slices.Equal(rc.Actions, []string{"read"}) {
+			hasChanges = true
+		}
+		if slices.Contains(rc.Actions, "delete") {
+			hasDeletions = true
+		}
+
+		item := runPlanResourceChangeModel{
+			Address: types.StringValue(rc.Address),
+			Type:    types.StringValue(rc.Type),
+			Name:    types.StringValue(rc.Name),
+			Actions: actions,
+		}
+		if rc.ModuleAddress != "" {
+			item.ModuleAddress = types.StringValue(rc.ModuleAddress)
+		} else {
+			item.ModuleAddress = types.StringNull()
+		}
+		model.ResourceChanges = append(model.ResourceChanges, item)
+	}
+
+	s := plan.Summary
+	model.HasChanges = types.BoolValue(hasChanges || s.Add+s.Change+s.Destroy+s.Replace+s.Import > 0)
+	model.HasDeletions = types.BoolValue(hasDeletions)
+
+	if len(plan.PlanJSON) > 0 {
+		model.JSON = types.StringValue(string(plan.PlanJSON))
+	} else {
+		model.JSON = types.StringNull()
+	}
+
+	return model
+}
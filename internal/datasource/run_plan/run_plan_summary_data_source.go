@@ -0,0 +1,132 @@
+// ABOUTME: Data source summarizing the destroys and replacements in a Zenfra run's plan.
+// ABOUTME: Warns, or fails with fail_on_threshold, when the plan exceeds max_destroys or max_replacements.
+package run_plan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type runPlanSummaryDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &runPlanSummaryDataSource{}
+var _ datasource.DataSourceWithConfigure = &runPlanSummaryDataSource{}
+
+func NewRunPlanSummaryDataSource() datasource.DataSource {
+	return &runPlanSummaryDataSource{}
+}
+
+func (d *runPlanSummaryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_plan_summary"
+}
+
+func (d *runPlanSummaryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Summarizes the destructive changes in a Zenfra run's plan before it is applied, so a pipeline can require manual " +
+			"approval when a run destroys or replaces more resources than expected. Exceeding `max_destroys` or `max_replacements` " +
+			"is a warning, or an error with `fail_on_threshold`.",
+		Attributes: map[string]schema.Attribute{
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the run whose plan to check.",
+				Required:            true,
+			},
+			"max_destroys": schema.Int64Attribute{
+				MarkdownDescription: "The number of resources the plan may destroy, replacements not included. Unlimited when unset.",
+				Optional:            true,
+			},
+			"max_replacements": schema.Int64Attribute{
+				MarkdownDescription: "The number of resources the plan may replace. Unlimited when unset.",
+				Optional:            true,
+			},
+			"fail_on_threshold": schema.BoolAttribute{
+				MarkdownDescription: "When true, reading the data source fails if the plan exceeds a threshold, instead of warning. Defaults to false.",
+				Optional:            true,
+			},
+			"stack_id": schema.StringAttribute{
+				MarkdownDescription: "The stack the run belongs to.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the run's plan.",
+				Computed:            true,
+			},
+			"has_destroys": schema.BoolAttribute{
+				MarkdownDescription: "Whether the plan destroys any resource, including as part of a replacement.",
+				Computed:            true,
+			},
+			"destroy_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of resources the plan destroys without replacing them.",
+				Computed:            true,
+			},
+			"replacement_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of resources the plan replaces.",
+				Computed:            true,
+			},
+			"destroyed_addresses": schema.ListAttribute{
+				MarkdownDescription: "Sorted addresses of the resources the plan destroys without replacing them.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"replaced_addresses": schema.ListAttribute{
+				MarkdownDescription: "Sorted addresses of the resources the plan replaces.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"threshold_exceeded": schema.BoolAttribute{
+				MarkdownDescription: "Whether the plan exceeds `max_destroys` or `max_replacements`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *runPlanSummaryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *runPlanSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data runPlanSummaryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attr, value := range map[string]types.Int64{"max_destroys": data.MaxDestroys, "max_replacements": data.MaxReplacements} {
+		if value.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root(attr), "Invalid Threshold",
+				fmt.Sprintf("%s must be at least 0, got %d.", attr, value.ValueInt64()))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan, err := d.client.GetRunPlan(ctx, data.RunID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read run plan, got error: %s", err))
+		return
+	}
+
+	summarizeRunPlan(&data, plan)
+
+	if data.ThresholdExceeded.ValueBool() {
+		if data.FailOnThreshold.ValueBool() {
+			resp.Diagnostics.AddError("Plan Exceeds Destructive Change Threshold", formatThresholdViolations(&data))
+			return
+		}
+		resp.Diagnostics.AddWarning("Plan Exceeds Destructive Change Threshold", formatThresholdViolations(&data))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
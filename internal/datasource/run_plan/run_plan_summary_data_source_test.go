@@ -0,0 +1,62 @@
+// ABOUTME: Unit tests for the zenfra_run_plan_summary data source.
+// ABOUTME: Verifies destroy and replacement counting, the summary fallback, and threshold checks.
+package run_plan
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestSummarizeRunPlan(t *testing.T) {
+	data := runPlanSummaryDataSourceModel{
+		RunID:           types.StringValue("run-1"),
+		MaxDestroys:     types.Int64Null(),
+		MaxReplacements: types.Int64Value(1),
+	}
+	summarizeRunPlan(&data, &zenfraclient.RunPlan{
+		RunID:   "run-1",
+		StackID: "stack-1",
+		Status:  "finished",
+		ResourceChanges: []zenfraclient.RunPlanResourceChange{
+			{Address: "aws_instance.b", Actions: []string{"create", "delete"}},
+			{Address: "aws_instance.a", Actions: []string{"delete", "create"}},
+			{Address: "aws_s3_bucket.old", Actions: []string{"delete"}},
+			{Address: "aws_s3_bucket.new", Actions: []string{"create"}},
+		},
+	})
+
+	if !data.HasDestroys.ValueBool() || data.DestroyCount.ValueInt64() != 1 || data.ReplacementCount.ValueInt64() != 2 {
+		t.Fatalf("unexpected counts: %+v", data)
+	}
+	if data.ReplacedAddresses[0].ValueString() != "aws_instance.a" || data.DestroyedAddresses[0].ValueString() != "aws_s3_bucket.old" {
+		t.Errorf("unexpected addresses: replaced %v, destroyed %v", data.ReplacedAddresses, data.DestroyedAddresses)
+	}
+	if !data.ThresholdExceeded.ValueBool() {
+		t.Error("expected 2 replacements to exceed max_replacements = 1")
+	}
+	msg := formatThresholdViolations(&data)
+	if !strings.Contains(msg, "replaces 2 resource(s), more than max_replacements (1)") || strings.Contains(msg, "max_destroys") {
+		t.Errorf("unexpected message: %s", msg)
+	}
+}
+
+func TestSummarizeRunPlan_SummaryFallback(t *testing.T) {
+	data := runPlanSummaryDataSourceModel{
+		MaxDestroys:     types.Int64Value(0),
+		MaxReplacements: types.Int64Null(),
+	}
+	summarizeRunPlan(&data, &zenfraclient.RunPlan{Summary: zenfraclient.RunPlanSummary{Replace: 3}})
+
+	if data.ReplacementCount.ValueInt64() != 3 || !data.HasDestroys.ValueBool() {
+		t.Errorf("expected the summary's counts without resource changes, got %+v", data)
+	}
+	if data.ThresholdExceeded.ValueBool() {
+		t.Error("replacements must not count against max_destroys")
+	}
+	if len(data.ReplacedAddresses) != 0 || data.ReplacedAddresses == nil {
+		t.Errorf("expected an empty address list, got %v", data.ReplacedAddresses)
+	}
+}
@@ -0,0 +1,104 @@
+// ABOUTME: Model types for the zenfra_run_plan_summary data source.
+// ABOUTME: Counts a plan's destroys and replacements and compares them with the configured thresholds.
+package run_plan
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// runPlanSummaryDataSourceModel represents the Terraform state for the run plan summary data source.
+type runPlanSummaryDataSourceModel struct {
+	RunID              types.String   `tfsdk:"run_id"`
+	MaxDestroys        types.Int64    `tfsdk:"max_destroys"`
+	MaxReplacements    types.Int64    `tfsdk:"max_replacements"`
+	FailOnThreshold    types.Bool     `tfsdk:"fail_on_threshold"`
+	StackID            types.String   `tfsdk:"stack_id"`
+	Status             types.String   `tfsdk:"status"`
+	HasDestroys        types.Bool     `tfsdk:"has_destroys"`
+	DestroyCount       types.Int64    `tfsdk:"destroy_count"`
+	ReplacementCount   types.Int64    `tfsdk:"replacement_count"`
+	DestroyedAddresses []types.String `tfsdk:"destroyed_addresses"`
+	ReplacedAddresses  []types.String `tfsdk:"replaced_addresses"`
+	ThresholdExceeded  types.Bool     `tfsdk:"threshold_exceeded"`
+}
+
+// summarizeRunPlan fills the computed attributes of data from plan. Counts come from
+// the resource changes, falling back to the plan summary when the API sends none.
+func summarizeRunPlan(data *runPlanSummaryDataSourceModel, plan *zenfraclient.RunPlan) {
+	var destroyed, replaced []string
+	for _, rc := range plan.ResourceChanges {
+		if !slices.Contains(rc.Actions, "delete") {
+			continue
+		}
+		if slices.Contains(rc.Actions, "create") {
+			replaced = append(replaced, rc.Address)
+		} else {
+			destroyed = append(destroyed, rc.Address)
+		}
+	}
+	sort.Strings(destroyed)
+	sort.Strings(replaced)
+
+	destroyCount, replacementCount := int64(len(destroyed)), int64(len(replaced))
+	if len(plan.ResourceChanges) == 0 {
+		destroyCount, replacementCount = int64(plan.Summary.Destroy), int64(plan.Summary.Replace)
+	}
+
+	data.StackID = types.StringValue(plan.StackID)
+	data.Status = types.StringValue(plan.Status)
+	// Replacements destroy the existing object too, as in zenfra_run_plan's has_deletions.
+	data.HasDestroys = types.BoolValue(destroyCount+replacementCount > 0)
+	data.DestroyCount = types.Int64Value(destroyCount)
+	data.ReplacementCount = types.Int64Value(replacementCount)
+	data.DestroyedAddresses = stringValues(destroyed)
+	data.ReplacedAddresses = stringValues(replaced)
+	data.ThresholdExceeded = types.BoolValue(len(thresholdViolations(data)) > 0)
+}
+
+// thresholdViolations describes each configured threshold the summarized plan exceeds.
+func thresholdViolations(data *runPlanSummaryDataSourceModel) []string {
+	var violations []string
+	if !data.MaxDestroys.IsNull() && data.DestroyCount.ValueInt64() > data.MaxDestroys.ValueInt64() {
+		violations = append(violations, fmt.Sprintf("destroys %d resource(s), more than max_destroys (%d)",
+			data.DestroyCount.ValueInt64(), data.MaxDestroys.ValueInt64()))
+	}
+	if !data.MaxReplacements.IsNull() && data.ReplacementCount.ValueInt64() > data.MaxReplacements.ValueInt64() {
+		violations = append(violations, fmt.Sprintf("replaces %d resource(s), more than max_replacements (%d)",
+			data.ReplacementCount.ValueInt64(), data.MaxReplacements.ValueInt64()))
+	}
+	return violations
+}
+
+// formatThresholdViolations renders the violations and the affected addresses for a diagnostic.
+func formatThresholdViolations(data *runPlanSummaryDataSourceModel) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Run %s of stack %s %s.", data.RunID.ValueString(), data.StackID.ValueString(),
+		strings.Join(thresholdViolations(data), " and "))
+	for _, group := range []struct {
+		label     string
+		addresses []types.String
+	}{{"Destroyed", data.DestroyedAddresses}, {"Replaced", data.ReplacedAddresses}} {
+		if len(group.addresses) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n\n%s:", group.label)
+		for _, a := range group.addresses {
+			fmt.Fprintf(&b, "\n  - %s", a.ValueString())
+		}
+	}
+	return b.String()
+}
+
+func stringValues(values []string) []types.String {
+	out := make([]types.String, 0, len(values))
+	for _, v := range values {
+		out = append(out, types.StringValue(v))
+	}
+	return out
+}
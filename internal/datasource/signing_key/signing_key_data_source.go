@@ -0,0 +1,164 @@
+// ABOUTME: Data source for reading a single Zenfra signing key by ID or name.
+// ABOUTME: Exposes the public key, fingerprint, and expiry for verification pipelines.
+package signing_key
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type signingKeyDataSource struct {
+	client *zenfraclient.Client
+}
+
+type signingKeyDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	PublicKey      types.String `tfsdk:"public_key"`
+	Algorithm      types.String `tfsdk:"algorithm"`
+	Fingerprint    types.String `tfsdk:"fingerprint"`
+	ExpiresAt      types.String `tfsdk:"expires_at"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+}
+
+var _ datasource.DataSource = &signingKeyDataSource{}
+var _ datasource.DataSourceWithConfigure = &signingKeyDataSource{}
+
+func NewSigningKeyDataSource() datasource.DataSource {
+	return &signingKeyDataSource{}
+}
+
+func (d *signingKeyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signing_key"
+}
+
+func (d *signingKeyDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a single organization signing key by ID or name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the signing key. Exactly one of `id` or `name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the signing key. Exactly one of `id` or `name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID this key belongs to.",
+				Computed:            true,
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded public key.",
+				Computed:            true,
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "The key algorithm, such as `ed25519` or `rsa`.",
+				Computed:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "The fingerprint of the public key.",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "When the key stops being accepted. Null for a key that does not expire.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the key was registered.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *signingKeyDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *signingKeyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data signingKeyDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !data.ID.IsNull() && !data.ID.IsUnknown()
+	hasName := !data.Name.IsNull() && !data.Name.IsUnknown()
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError("Missing Attribute", "Exactly one of `id` or `name` must be specified.")
+		return
+	}
+	if hasID && hasName {
+		resp.Diagnostics.AddError("Conflicting Attributes", "Only one of `id` or `name` may be specified, not both.")
+		return
+	}
+
+	if hasID {
+		key, err := d.client.GetSigningKey(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read signing key, got error: %s", err))
+			return
+		}
+
+		state := mapSigningKeyToDataSource(key)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	// Lookup by name: list all and find match.
+	keys, err := d.client.ListSigningKeys(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list signing keys, got error: %s", err))
+		return
+	}
+
+	targetName := data.Name.ValueString()
+	var matched *zenfraclient.SigningKey
+	for i := range keys {
+		if keys[i].Name == targetName {
+			if matched != nil {
+				resp.Diagnostics.AddError("Multiple Matches",
+					fmt.Sprintf("Found multiple signing keys with name %q. Use `id` instead.", targetName))
+				return
+			}
+			matched = &keys[i]
+		}
+	}
+
+	if matched == nil {
+		resp.Diagnostics.AddError("Not Found",
+			fmt.Sprintf("No signing key found with name %q.", targetName))
+		return
+	}
+
+	state := mapSigningKeyToDataSource(matched)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func mapSigningKeyToDataSource(key *zenfraclient.SigningKey) signingKeyDataSourceModel {
+	model := signingKeyDataSourceModel{
+		ID:             types.StringValue(key.ID),
+		Name:           types.StringValue(key.Name),
+		OrganizationID: types.StringValue(key.OrganizationID),
+		PublicKey:      types.StringValue(key.PublicKey),
+		Algorithm:      types.StringValue(key.Algorithm),
+		Fingerprint:    types.StringValue(key.Fingerprint),
+		ExpiresAt:      timeutil.StringPointer(key.ExpiresAt),
+		CreatedAt:      timeutil.String(key.CreatedAt),
+	}
+	return model
+}
@@ -0,0 +1,119 @@
+// ABOUTME: Data source for listing the configuration bundles attached directly to a Zenfra space.
+// ABOUTME: Bundles inherited from parent spaces are not included.
+
+package space
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type spaceBundleAttachmentsDataSource struct {
+	client *zenfraclient.Client
+}
+
+type spaceBundleAttachmentsDataSourceModel struct {
+	SpaceID     types.String                     `tfsdk:"space_id"`
+	Attachments []spaceBundleAttachmentItemModel `tfsdk:"attachments"`
+}
+
+type spaceBundleAttachmentItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	BundleID   types.String `tfsdk:"bundle_id"`
+	Priority   types.Int64  `tfsdk:"priority"`
+	AttachedAt types.String `tfsdk:"attached_at"`
+	AttachedBy types.String `tfsdk:"attached_by"`
+}
+
+var _ datasource.DataSource = &spaceBundleAttachmentsDataSource{}
+var _ datasource.DataSourceWithConfigure = &spaceBundleAttachmentsDataSource{}
+
+func NewSpaceBundleAttachmentsDataSource() datasource.DataSource {
+	return &spaceBundleAttachmentsDataSource{}
+}
+
+func (d *spaceBundleAttachmentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_bundle_attachments"
+}
+
+func (d *spaceBundleAttachmentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the configuration bundles attached directly to a Zenfra space. Bundles a space inherits from its parents are not included.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the space whose bundle attachments to list.",
+				Required:            true,
+			},
+			"attachments": schema.ListNestedAttribute{
+				MarkdownDescription: "Bundle attachments, ordered by ascending priority.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the attachment.",
+							Computed:            true,
+						},
+						"bundle_id": schema.StringAttribute{
+							MarkdownDescription: "The attached bundle.",
+							Computed:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "The attachment priority.",
+							Computed:            true,
+						},
+						"attached_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the bundle was attached.",
+							Computed:            true,
+						},
+						"attached_by": schema.StringAttribute{
+							MarkdownDescription: "The user or token that attached the bundle.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *spaceBundleAttachmentsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *spaceBundleAttachmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data spaceBundleAttachmentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attachments, err := d.client.ListSpaceBundles(ctx, data.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list space bundle attachments, got error: %s", err))
+		return
+	}
+	sort.SliceStable(attachments, func(i, j int) bool { return attachments[i].Priority < attachments[j].Priority })
+
+	data.Attachments = make([]spaceBundleAttachmentItemModel, 0, len(attachments))
+	for _, a := range attachments {
+		data.Attachments = append(data.Attachments, spaceBundleAttachmentItemModel{
+			ID:         types.StringValue(a.ID),
+			BundleID:   types.StringValue(a.BundleID),
+			Priority:   types.Int64Value(int64(a.Priority)),
+			AttachedAt: timeutil.String(a.AttachedAt),
+			AttachedBy: types.StringValue(a.AttachedBy),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -110,20 +112,9 @@ func (d *spaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 }
 
 func (d *spaceDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
 	}
-
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
 }
 
 func (d *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -153,8 +144,8 @@ func (d *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.ChildCount = types.Int64Value(int64(space.ChildCount))
 	data.StackCount = types.Int64Value(int64(space.StackCount))
 	data.CreatedBy = types.StringValue(space.CreatedBy)
-	data.CreatedAt = types.StringValue(space.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	data.UpdatedAt = types.StringValue(space.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.CreatedAt = timeutil.String(space.CreatedAt)
+	data.UpdatedAt = timeutil.String(space.UpdatedAt)
 	data.UpdatedBy = types.StringValue(space.UpdatedBy)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
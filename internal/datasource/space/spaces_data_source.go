@@ -0,0 +1,220 @@
+// ABOUTME: Data source for listing all Zenfra spaces in the organization.
+// ABOUTME: Returns the spaces as a list and as a map keyed by space slug, optionally with each space's details.
+
+package space
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/asmap"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/hydrate"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/semaphore"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type spacesDataSource struct {
+	client *zenfraclient.Client
+	ops    semaphore.Semaphore
+}
+
+type spacesDataSourceModel struct {
+	IncludeDetails types.Bool            `tfsdk:"include_details"`
+	Spaces         []spacesListItemModel `tfsdk:"spaces"`
+
+	AsMap map[string]spacesListItemModel `tfsdk:"as_map"`
+}
+
+type spacesListItemModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Slug           types.String `tfsdk:"slug"`
+	ParentID       types.String `tfsdk:"parent_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+
+	// Details, read per space when include_details is true.
+	Description    types.String `tfsdk:"description"`
+	Depth          types.Int64  `tfsdk:"depth"`
+	InheritBundles types.Bool   `tfsdk:"inherit_bundles"`
+	ChildCount     types.Int64  `tfsdk:"child_count"`
+	StackCount     types.Int64  `tfsdk:"stack_count"`
+	CreatedAt      types.String `tfsdk:"created_at"`
+	UpdatedAt      types.String `tfsdk:"updated_at"`
+}
+
+// spacesListFields are the space attributes the list maps.
+var spacesListFields = zenfraclient.Fields{"id", "name", "slug", "parent_id", "organization_id"}
+
+var _ datasource.DataSource = &spacesDataSource{}
+var _ datasource.DataSourceWithConfigure = &spacesDataSource{}
+
+func NewSpacesDataSource() datasource.DataSource {
+	return &spacesDataSource{}
+}
+
+func (d *spacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spaces"
+}
+
+func (d *spacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists all Zenfra spaces in the organization.",
+		Attributes: map[string]schema.Attribute{
+			"include_details": schema.BoolAttribute{
+				MarkdownDescription: "Read each space's details, such as its description and stack count, instead of only its name and IDs. " +
+					"The spaces are read concurrently, which is much faster than a `zenfra_space` data source per space, " +
+					"but still one API call per space. Defaults to false.",
+				Optional: true,
+			},
+			"spaces": schema.ListNestedAttribute{
+				MarkdownDescription: "List of spaces.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: spaceItemAttributes(),
+				},
+			},
+			"as_map": schema.MapNestedAttribute{
+				MarkdownDescription: "The same spaces keyed by slug, e.g. `as_map[\"production\"].id`. Slugs shared by several spaces are left out with a warning.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: spaceItemAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// spaceItemAttributes returns the attributes of a space in the spaces list and as_map.
+func spaceItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the space.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the space.",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "The URL-friendly slug for the space.",
+			Computed:            true,
+		},
+		"parent_id": schema.StringAttribute{
+			MarkdownDescription: "The parent space ID if this is a nested space.",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "The organization ID that owns this space.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the space. Null unless `include_details` is true, or if the space has none.",
+			Computed:            true,
+		},
+		"depth": schema.Int64Attribute{
+			MarkdownDescription: "The depth of the space in the hierarchy, 0 for a top-level space. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"inherit_bundles": schema.BoolAttribute{
+			MarkdownDescription: "Whether the space inherits bundles from its parent spaces. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"child_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of direct child spaces. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"stack_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of stacks in the space. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the space was created. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the space was last updated. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *spacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+		d.ops = data.Operations
+	}
+}
+
+func (d *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data spacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaces, err := d.client.ListSpaces(ctx, &zenfraclient.ListOptions{Fields: spacesListFields})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list spaces, got error: %s", err))
+		return
+	}
+
+	// Map results
+	data.Spaces = make([]spacesListItemModel, 0, len(spaces))
+	for i := range spaces {
+		item := spacesListItemModel{
+			ID:             types.StringValue(spaces[i].ID),
+			Name:           types.StringValue(spaces[i].Name),
+			Slug:           types.StringValue(spaces[i].Slug),
+			ParentID:       types.StringNull(),
+			OrganizationID: types.StringValue(spaces[i].OrganizationID),
+			Description:    types.StringNull(),
+			Depth:          types.Int64Null(),
+			InheritBundles: types.BoolNull(),
+			ChildCount:     types.Int64Null(),
+			StackCount:     types.Int64Null(),
+			CreatedAt:      types.StringNull(),
+			UpdatedAt:      types.StringNull(),
+		}
+		if spaces[i].ParentID != nil {
+			item.ParentID = types.StringValue(*spaces[i].ParentID)
+		}
+		data.Spaces = append(data.Spaces, item)
+	}
+
+	if data.IncludeDetails.ValueBool() {
+		err = hydrate.Each(ctx, d.ops, len(data.Spaces), func(ctx context.Context, i int) error {
+			space, err := d.client.GetSpace(ctx, spaces[i].ID)
+			if err != nil {
+				return fmt.Errorf("space %s: %w", spaces[i].ID, err)
+			}
+			setSpaceDetails(&data.Spaces[i], space)
+			return nil
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read space details, got error: %s", err))
+			return
+		}
+	}
+	data.AsMap = asmap.Build(data.Spaces, func(s spacesListItemModel) string { return s.Slug.ValueString() }, "zenfra_spaces", "slug", &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setSpaceDetails fills in the detail attributes of item from the full space.
+func setSpaceDetails(item *spacesListItemModel, space *zenfraclient.Space) {
+	item.Description = types.StringNull()
+	if space.Description != "" {
+		item.Description = types.StringValue(space.Description)
+	}
+	item.Depth = types.Int64Value(int64(space.Depth))
+	item.InheritBundles = types.BoolValue(space.InheritBundles)
+	item.ChildCount = types.Int64Value(int64(space.ChildCount))
+	item.StackCount = types.Int64Value(int64(space.StackCount))
+	item.CreatedAt = timeutil.String(space.CreatedAt)
+	item.UpdatedAt = timeutil.String(space.UpdatedAt)
+}
@@ -6,10 +6,14 @@ package stack
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/tfvalue"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -18,19 +22,34 @@ type stackDataSource struct {
 }
 
 type stackDataSourceModel struct {
-	ID              types.String        `tfsdk:"id"`
-	Name            types.String        `tfsdk:"name"`
-	SpaceID         types.String        `tfsdk:"space_id"`
-	OrganizationID  types.String        `tfsdk:"organization_id"`
-	WorkerPoolID    types.String        `tfsdk:"worker_pool_id"`
-	AllowPublicPool types.Bool          `tfsdk:"allow_public_pool"`
-	IAC             *iacConfigModel     `tfsdk:"iac"`
-	Source          *stackSourceModel   `tfsdk:"source"`
-	Triggers        *stackTriggersModel `tfsdk:"triggers"`
-	CreatedBy       types.String        `tfsdk:"created_by"`
-	CreatedAt       types.String        `tfsdk:"created_at"`
-	UpdatedAt       types.String        `tfsdk:"updated_at"`
-	UpdatedBy       types.String        `tfsdk:"updated_by"`
+	ID                types.String         `tfsdk:"id"`
+	Name              types.String         `tfsdk:"name"`
+	SpaceID           types.String         `tfsdk:"space_id"`
+	OrganizationID    types.String         `tfsdk:"organization_id"`
+	WorkerPoolID      types.String         `tfsdk:"worker_pool_id"`
+	AllowPublicPool   types.Bool           `tfsdk:"allow_public_pool"`
+	EnvironmentType   types.String         `tfsdk:"environment_type"`
+	OwnerTeamID       types.String         `tfsdk:"owner_team_id"`
+	Collaborators     []types.String       `tfsdk:"collaborator_team_ids"`
+	Health            types.String         `tfsdk:"health"`
+	DriftDetectedAt   types.String         `tfsdk:"drift_detected_at"`
+	IAC               *iacConfigModel      `tfsdk:"iac"`
+	Source            *stackSourceModel    `tfsdk:"source"`
+	Triggers          *stackTriggersModel  `tfsdk:"triggers"`
+	CreatedBy         types.String         `tfsdk:"created_by"`
+	CreatedAt         types.String         `tfsdk:"created_at"`
+	UpdatedAt         types.String         `tfsdk:"updated_at"`
+	UpdatedBy         types.String         `tfsdk:"updated_by"`
+	Variables         []stackVariableModel `tfsdk:"variables"`
+	AttachedBundleIDs []types.String       `tfsdk:"attached_bundle_ids"`
+}
+
+type stackVariableModel struct {
+	Key              types.String `tfsdk:"key"`
+	Value            types.String `tfsdk:"value"`
+	Secret           types.Bool   `tfsdk:"secret"`
+	Description      types.String `tfsdk:"description"`
+	SensitiveDisplay types.Bool   `tfsdk:"sensitive_display"`
 }
 
 type iacConfigModel struct {
@@ -103,6 +122,28 @@ func (d *stackDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "Whether to allow execution on public worker pools.",
 				Computed:            true,
 			},
+			"environment_type": schema.StringAttribute{
+				MarkdownDescription: "The environment tier of the stack, e.g. `production`. Null if the stack has none.",
+				Computed:            true,
+			},
+			"owner_team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the team that owns the stack. Null if the stack has no owner.",
+				Computed:            true,
+			},
+			"collaborator_team_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of further teams notified about the stack's failed runs.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"health": schema.StringAttribute{
+				MarkdownDescription: "Operational state of the stack: `ok`, `drifted` when drift detection found changes, `failed` when the latest run failed, or `locked`. " +
+					"Null if the API does not report it.",
+				Computed: true,
+			},
+			"drift_detected_at": schema.StringAttribute{
+				MarkdownDescription: "RFC3339 timestamp when drift detection last found changes. Null if the stack has not drifted since its last reconciling run.",
+				Computed:            true,
+			},
 			"iac": schema.SingleNestedAttribute{
 				MarkdownDescription: "Infrastructure as Code engine configuration.",
 				Computed:            true,
@@ -205,25 +246,47 @@ func (d *stackDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "The user ID who last updated this stack.",
 				Computed:            true,
 			},
+			"variables": schema.ListNestedAttribute{
+				MarkdownDescription: "Variables set directly on the stack. Values of secret variables are never returned.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The variable name.",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The variable value, or null if the variable is secret.",
+							Computed:            true,
+						},
+						"secret": schema.BoolAttribute{
+							MarkdownDescription: "Whether the variable is secret.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "What the variable is for, or null if it has no description.",
+							Computed:            true,
+						},
+						"sensitive_display": schema.BoolAttribute{
+							MarkdownDescription: "Whether the Zenfra UI and generated documentation mask the value.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"attached_bundle_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the configuration bundles attached to the stack, ordered by ascending attachment priority.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
 		},
 	}
 }
 
 func (d *stackDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
 	}
-
-	d.client = client
 }
 
 func (d *stackDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -248,6 +311,27 @@ func (d *stackDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.WorkerPoolID = types.StringNull()
 	}
 	data.AllowPublicPool = types.BoolValue(stack.AllowPublicPool)
+	data.EnvironmentType = tfvalue.OptionalString(stack.EnvironmentType)
+	data.OwnerTeamID = tfvalue.OptionalString(stack.OwnerTeamID)
+	data.Collaborators = make([]types.String, 0, len(stack.CollaboratorTeamIDs))
+	for _, team := range stack.CollaboratorTeamIDs {
+		data.Collaborators = append(data.Collaborators, types.StringValue(team))
+	}
+
+	data.Health, data.DriftDetectedAt = types.StringNull(), types.StringNull()
+	health := stack.Health
+	if health == nil {
+		var err error
+		health, err = d.client.GetStackHealth(ctx, stack.ID)
+		if err != nil && !zenfraclient.IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stack health, got error: %s", err))
+			return
+		}
+	}
+	if health != nil {
+		data.Health = types.StringValue(health.Status)
+		data.DriftDetectedAt = timeutil.StringPointer(health.DriftDetectedAt)
+	}
 
 	// Map IAC config
 	data.IAC = &iacConfigModel{
@@ -284,9 +368,40 @@ func (d *stackDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	data.CreatedBy = types.StringValue(stack.CreatedBy)
-	data.CreatedAt = types.StringValue(stack.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	data.UpdatedAt = types.StringValue(stack.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.CreatedAt = timeutil.String(stack.CreatedAt)
+	data.UpdatedAt = timeutil.String(stack.UpdatedAt)
 	data.UpdatedBy = types.StringValue(stack.UpdatedBy)
 
+	variables, err := d.client.GetStackVariables(ctx, stack.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stack variables, got error: %s", err))
+		return
+	}
+	data.Variables = make([]stackVariableModel, 0, len(variables))
+	for _, v := range variables {
+		item := stackVariableModel{
+			Key:              types.StringValue(v.Key),
+			Value:            types.StringValue(v.Value),
+			Secret:           types.BoolValue(v.Secret),
+			Description:      tfvalue.OptionalString(v.Description),
+			SensitiveDisplay: types.BoolValue(v.SensitiveDisplay),
+		}
+		if v.Secret || v.ValueMasked {
+			item.Value = types.StringNull()
+		}
+		data.Variables = append(data.Variables, item)
+	}
+
+	attachments, err := d.client.ListStackBundles(ctx, stack.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stack bundle attachments, got error: %s", err))
+		return
+	}
+	sort.SliceStable(attachments, func(i, j int) bool { return attachments[i].Priority < attachments[j].Priority })
+	data.AttachedBundleIDs = make([]types.String, 0, len(attachments))
+	for _, a := range attachments {
+		data.AttachedBundleIDs = append(data.AttachedBundleIDs, types.StringValue(a.BundleID))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -1,5 +1,5 @@
-// ABOUTME: Data source for listing Zenfra stacks with optional space_id filter.
-// ABOUTME: Returns a list of stacks matching the filter criteria.
+// ABOUTME: Data source for listing Zenfra stacks with optional space_id and environment_type filters.
+// ABOUTME: Returns the matching stacks as a list and as a map keyed by stack name, optionally with each stack's details.
 
 package stack
 
@@ -10,25 +10,51 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/asmap"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/hydrate"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/semaphore"
+	"github.com/zenfra/terraform-provider-zenfra/internal/tfvalue"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 type stacksDataSource struct {
 	client *zenfraclient.Client
+	ops    semaphore.Semaphore
 }
 
 type stacksDataSourceModel struct {
-	SpaceID types.String          `tfsdk:"space_id"`
-	Stacks  []stacksListItemModel `tfsdk:"stacks"`
+	SpaceID         types.String          `tfsdk:"space_id"`
+	EnvironmentType types.String          `tfsdk:"environment_type"`
+	IncludeDetails  types.Bool            `tfsdk:"include_details"`
+	Stacks          []stacksListItemModel `tfsdk:"stacks"`
+
+	AsMap map[string]stacksListItemModel `tfsdk:"as_map"`
 }
 
 type stacksListItemModel struct {
-	ID             types.String `tfsdk:"id"`
-	Name           types.String `tfsdk:"name"`
-	SpaceID        types.String `tfsdk:"space_id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	SpaceID         types.String `tfsdk:"space_id"`
+	OrganizationID  types.String `tfsdk:"organization_id"`
+	EnvironmentType types.String `tfsdk:"environment_type"`
+
+	// Details, read per stack when include_details is true.
+	WorkerPoolID    types.String `tfsdk:"worker_pool_id"`
+	AllowPublicPool types.Bool   `tfsdk:"allow_public_pool"`
+	OwnerTeamID     types.String `tfsdk:"owner_team_id"`
+	Status          types.String `tfsdk:"status"`
+	IACEngine       types.String `tfsdk:"iac_engine"`
+	IACVersion      types.String `tfsdk:"iac_version"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
 }
 
+// stacksListFields are the stack attributes the list maps. Leaving out the nested iac,
+// source, triggers, and hooks objects is most of the saving on large organizations.
+var stacksListFields = zenfraclient.Fields{"id", "name", "space_id", "organization_id", "environment_type"}
+
 var _ datasource.DataSource = &stacksDataSource{}
 var _ datasource.DataSourceWithConfigure = &stacksDataSource{}
 
@@ -48,49 +74,98 @@ func (d *stacksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				MarkdownDescription: "Optional space ID filter to list stacks in a specific space.",
 				Optional:            true,
 			},
+			"environment_type": schema.StringAttribute{
+				MarkdownDescription: "Optional filter to list only stacks of one environment type, e.g. `production`.",
+				Optional:            true,
+			},
+			"include_details": schema.BoolAttribute{
+				MarkdownDescription: "Read each stack's details, such as its worker pool, IaC engine, and status, instead of only its name and IDs. " +
+					"The stacks are read concurrently, which is much faster than a `zenfra_stack` data source per stack, " +
+					"but still one API call per stack. Defaults to false.",
+				Optional: true,
+			},
 			"stacks": schema.ListNestedAttribute{
 				MarkdownDescription: "List of stacks matching the filter criteria.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "The unique identifier of the stack.",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "The name of the stack.",
-							Computed:            true,
-						},
-						"space_id": schema.StringAttribute{
-							MarkdownDescription: "The space ID containing this stack.",
-							Computed:            true,
-						},
-						"organization_id": schema.StringAttribute{
-							MarkdownDescription: "The organization ID that owns this stack.",
-							Computed:            true,
-						},
-					},
+					Attributes: stackItemAttributes(),
+				},
+			},
+			"as_map": schema.MapNestedAttribute{
+				MarkdownDescription: "The same stacks keyed by name, e.g. `as_map[\"network\"].id`. " +
+					"Stack names are only unique within a space, so names shared by several stacks are left out with a warning; set `space_id` to avoid this.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: stackItemAttributes(),
 				},
 			},
 		},
 	}
 }
 
-func (d *stacksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+// stackItemAttributes returns the attributes of a stack in the stacks list and as_map.
+func stackItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the stack.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the stack.",
+			Computed:            true,
+		},
+		"space_id": schema.StringAttribute{
+			MarkdownDescription: "The space ID containing this stack.",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "The organization ID that owns this stack.",
+			Computed:            true,
+		},
+		"environment_type": schema.StringAttribute{
+			MarkdownDescription: "The environment tier of the stack, e.g. `production`. Null if the stack has none.",
+			Computed:            true,
+		},
+		"worker_pool_id": schema.StringAttribute{
+			MarkdownDescription: "The worker pool the stack's runs use. Null unless `include_details` is true, or if the stack has none.",
+			Computed:            true,
+		},
+		"allow_public_pool": schema.BoolAttribute{
+			MarkdownDescription: "Whether the stack may use the public worker pool. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"owner_team_id": schema.StringAttribute{
+			MarkdownDescription: "The team that owns the stack. Null unless `include_details` is true, or if the stack has none.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the stack. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"iac_engine": schema.StringAttribute{
+			MarkdownDescription: "The IaC engine of the stack, e.g. `terraform`. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"iac_version": schema.StringAttribute{
+			MarkdownDescription: "The IaC engine version of the stack. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the stack was created. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the stack was last updated. Null unless `include_details` is true.",
+			Computed:            true,
+		},
 	}
+}
 
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
+func (d *stacksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+		d.ops = data.Operations
 	}
-
-	d.client = client
 }
 
 func (d *stacksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -101,11 +176,15 @@ func (d *stacksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	}
 
 	// Build options
-	opts := &zenfraclient.ListStacksOptions{}
+	opts := &zenfraclient.ListStacksOptions{Fields: stacksListFields}
 	if !data.SpaceID.IsNull() {
 		spaceID := data.SpaceID.ValueString()
 		opts.SpaceID = &spaceID
 	}
+	if !data.EnvironmentType.IsNull() {
+		environmentType := data.EnvironmentType.ValueString()
+		opts.EnvironmentType = &environmentType
+	}
 
 	stacks, err := d.client.ListStacks(ctx, opts)
 	if err != nil {
@@ -117,12 +196,52 @@ func (d *stacksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	data.Stacks = make([]stacksListItemModel, 0, len(stacks))
 	for i := range stacks {
 		data.Stacks = append(data.Stacks, stacksListItemModel{
-			ID:             types.StringValue(stacks[i].ID),
-			Name:           types.StringValue(stacks[i].Name),
-			SpaceID:        types.StringValue(stacks[i].SpaceID),
-			OrganizationID: types.StringValue(stacks[i].OrganizationID),
+			ID:              types.StringValue(stacks[i].ID),
+			Name:            types.StringValue(stacks[i].Name),
+			SpaceID:         types.StringValue(stacks[i].SpaceID),
+			OrganizationID:  types.StringValue(stacks[i].OrganizationID),
+			EnvironmentType: tfvalue.OptionalString(stacks[i].EnvironmentType),
+			WorkerPoolID:    types.StringNull(),
+			AllowPublicPool: types.BoolNull(),
+			OwnerTeamID:     types.StringNull(),
+			Status:          types.StringNull(),
+			IACEngine:       types.StringNull(),
+			IACVersion:      types.StringNull(),
+			CreatedAt:       types.StringNull(),
+			UpdatedAt:       types.StringNull(),
+		})
+	}
+
+	if data.IncludeDetails.ValueBool() {
+		err = hydrate.Each(ctx, d.ops, len(data.Stacks), func(ctx context.Context, i int) error {
+			stack, err := d.client.GetStack(ctx, stacks[i].ID)
+			if err != nil {
+				return fmt.Errorf("stack %s: %w", stacks[i].ID, err)
+			}
+			setStackDetails(&data.Stacks[i], stack)
+			return nil
 		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stack details, got error: %s", err))
+			return
+		}
 	}
+	data.AsMap = asmap.Build(data.Stacks, func(s stacksListItemModel) string { return s.Name.ValueString() }, "zenfra_stacks", "name", &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// setStackDetails fills in the detail attributes of item from the full stack.
+func setStackDetails(item *stacksListItemModel, stack *zenfraclient.Stack) {
+	item.WorkerPoolID = types.StringNull()
+	if stack.WorkerPoolID != nil {
+		item.WorkerPoolID = tfvalue.OptionalString(*stack.WorkerPoolID)
+	}
+	item.AllowPublicPool = types.BoolValue(stack.AllowPublicPool)
+	item.OwnerTeamID = tfvalue.OptionalString(stack.OwnerTeamID)
+	item.Status = types.StringValue(stack.Status)
+	item.IACEngine = types.StringValue(stack.IAC.Engine)
+	item.IACVersion = types.StringValue(stack.IAC.Version)
+	item.CreatedAt = timeutil.String(stack.CreatedAt)
+	item.UpdatedAt = timeutil.String(stack.UpdatedAt)
+}
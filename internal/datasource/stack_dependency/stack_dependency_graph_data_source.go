@@ -0,0 +1,170 @@
+// ABOUTME: Data source exposing the dependency graph between Zenfra stacks from run triggers and kv references.
+// ABOUTME: Reports dependency cycles and can fail the plan when one exists, for CI checks.
+package stack_dependency
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type stackDependencyGraphDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &stackDependencyGraphDataSource{}
+var _ datasource.DataSourceWithConfigure = &stackDependencyGraphDataSource{}
+
+func NewStackDependencyGraphDataSource() datasource.DataSource {
+	return &stackDependencyGraphDataSource{}
+}
+
+func (d *stackDependencyGraphDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_dependency_graph"
+}
+
+func (d *stackDependencyGraphDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the dependency graph between stacks. An edge means the `to_stack_id` stack depends on the `from_stack_id` stack, " +
+			"either because a run of one triggers the other or because it reads one of its outputs. " +
+			"Set `fail_on_cycle` to stop the Terraform plan that reads this data source when stacks depend on each other in a cycle.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				MarkdownDescription: "Limit the graph to stacks in this space and the stacks they are connected to.",
+				Optional:            true,
+			},
+			"stack_id": schema.StringAttribute{
+				MarkdownDescription: "Limit the graph to the stacks this stack depends on, or that depend on it, directly or transitively.",
+				Optional:            true,
+			},
+			"fail_on_cycle": schema.BoolAttribute{
+				MarkdownDescription: "When true, reading the data source fails if the graph contains a cycle. Defaults to false.",
+				Optional:            true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "The stacks in the graph.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"stack_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the stack.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the stack.",
+							Computed:            true,
+						},
+						"space_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the space the stack belongs to.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				MarkdownDescription: "The dependencies between stacks.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"from_stack_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the stack that is depended on.",
+							Computed:            true,
+						},
+						"to_stack_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the dependent stack.",
+							Computed:            true,
+						},
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Why the dependency exists: `run_trigger`, `output_subscription`, or `kv_reference`.",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The referenced output, for `kv_reference` edges.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"has_cycle": schema.BoolAttribute{
+				MarkdownDescription: "Whether any stacks depend on each other in a cycle.",
+				Computed:            true,
+			},
+			"cycles": schema.ListAttribute{
+				MarkdownDescription: "Each group of stacks that depend on each other, directly or transitively, as sorted stack IDs. " +
+					"A stack that depends on itself forms a group of one.",
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+func (d *stackDependencyGraphDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *stackDependencyGraphDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data stackDependencyGraphDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := &zenfraclient.StackDependencyGraphOptions{}
+	if !data.SpaceID.IsNull() {
+		v := data.SpaceID.ValueString()
+		opts.SpaceID = &v
+	}
+	if !data.StackID.IsNull() {
+		v := data.StackID.ValueString()
+		opts.StackID = &v
+	}
+
+	graph, err := d.client.GetStackDependencyGraph(ctx, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stack dependency graph, got error: %s", err))
+		return
+	}
+
+	mapGraph(&data, graph)
+
+	if data.FailOnCycle.ValueBool() && data.HasCycle.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Stack Dependency Cycle",
+			fmt.Sprintf("The following stacks depend on each other in a cycle:\n\n%s", formatCycles(data.Cycles, graph.Nodes)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// formatCycles renders each cycle as an indented list entry, naming stacks where known.
+func formatCycles(cycles [][]types.String, nodes []zenfraclient.StackDependencyNode) string {
+	names := make(map[string]string, len(nodes))
+	for _, n := range nodes {
+		names[n.StackID] = n.Name
+	}
+
+	var b strings.Builder
+	for _, cycle := range cycles {
+		labels := make([]string, 0, len(cycle))
+		for _, id := range cycle {
+			if name := names[id.ValueString()]; name != "" {
+				labels = append(labels, fmt.Sprintf("%s (%s)", name, id.ValueString()))
+			} else {
+				labels = append(labels, id.ValueString())
+			}
+		}
+		fmt.Fprintf(&b, "  - %s\n", strings.Join(labels, ", "))
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,39 @@
+// ABOUTME: Unit tests for the zenfra_stack_dependency_graph data source model mapping.
+// ABOUTME: Verifies nodes, edges, and detected cycles are mapped to Terraform types.
+package stack_dependency
+
+import (
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func edge(from, to string) zenfraclient.StackDependencyEdge {
+	return zenfraclient.StackDependencyEdge{FromStackID: from, ToStackID: to, Kind: zenfraclient.StackDependencyRunTrigger}
+}
+
+func TestMapGraph(t *testing.T) {
+	graph := &zenfraclient.StackDependencyGraph{
+		Nodes: []zenfraclient.StackDependencyNode{{StackID: "net", Name: "network"}, {StackID: "app", Name: "app"}},
+		Edges: []zenfraclient.StackDependencyEdge{
+			{FromStackID: "net", ToStackID: "app", Kind: zenfraclient.StackDependencyKVReference, Key: "vpc_id"},
+			edge("net", "app"),
+		},
+	}
+
+	var model stackDependencyGraphDataSourceModel
+	mapGraph(&model, graph)
+
+	if model.HasCycle.ValueBool() || len(model.Cycles) != 0 {
+		t.Errorf("expected no cycles, got %v", model.Cycles)
+	}
+	if len(model.Edges) != 2 {
+		t.Fatalf("expected 2 edges, got %d", len(model.Edges))
+	}
+	if model.Edges[0].Key.ValueString() != "vpc_id" {
+		t.Errorf("expected kv_reference key vpc_id, got %s", model.Edges[0].Key)
+	}
+	if !model.Edges[1].Key.IsNull() {
+		t.Errorf("expected null key for run_trigger edge, got %s", model.Edges[1].Key)
+	}
+}
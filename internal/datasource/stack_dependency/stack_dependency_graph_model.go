@@ -0,0 +1,70 @@
+// ABOUTME: Model types for the zenfra_stack_dependency_graph data source.
+// ABOUTME: Maps the API graph, and the dependency cycles between its stacks, to Terraform types.
+package stack_dependency
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/stackgraph"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// stackDependencyGraphDataSourceModel represents the Terraform state for the stack dependency graph data source.
+type stackDependencyGraphDataSourceModel struct {
+	SpaceID     types.String     `tfsdk:"space_id"`
+	StackID     types.String     `tfsdk:"stack_id"`
+	FailOnCycle types.Bool       `tfsdk:"fail_on_cycle"`
+	Nodes       []nodeModel      `tfsdk:"nodes"`
+	Edges       []edgeModel      `tfsdk:"edges"`
+	HasCycle    types.Bool       `tfsdk:"has_cycle"`
+	Cycles      [][]types.String `tfsdk:"cycles"`
+}
+
+type nodeModel struct {
+	StackID types.String `tfsdk:"stack_id"`
+	Name    types.String `tfsdk:"name"`
+	SpaceID types.String `tfsdk:"space_id"`
+}
+
+type edgeModel struct {
+	FromStackID types.String `tfsdk:"from_stack_id"`
+	ToStackID   types.String `tfsdk:"to_stack_id"`
+	Kind        types.String `tfsdk:"kind"`
+	Key         types.String `tfsdk:"key"`
+}
+
+// mapGraph fills the computed attributes of model from the API graph.
+func mapGraph(model *stackDependencyGraphDataSourceModel, graph *zenfraclient.StackDependencyGraph) {
+	model.Nodes = make([]nodeModel, 0, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		model.Nodes = append(model.Nodes, nodeModel{
+			StackID: types.StringValue(n.StackID),
+			Name:    types.StringValue(n.Name),
+			SpaceID: types.StringValue(n.SpaceID),
+		})
+	}
+
+	model.Edges = make([]edgeModel, 0, len(graph.Edges))
+	for _, e := range graph.Edges {
+		key := types.StringNull()
+		if e.Key != "" {
+			key = types.StringValue(e.Key)
+		}
+		model.Edges = append(model.Edges, edgeModel{
+			FromStackID: types.StringValue(e.FromStackID),
+			ToStackID:   types.StringValue(e.ToStackID),
+			Kind:        types.StringValue(e.Kind),
+			Key:         key,
+		})
+	}
+
+	cycles := stackgraph.FindCycles(graph.Edges)
+	model.HasCycle = types.BoolValue(len(cycles) > 0)
+	model.Cycles = make([][]types.String, 0, len(cycles))
+	for _, cycle := range cycles {
+		ids := make([]types.String, 0, len(cycle))
+		for _, id := range cycle {
+			ids = append(ids, types.StringValue(id))
+		}
+		model.Cycles = append(model.Cycles, ids)
+	}
+}
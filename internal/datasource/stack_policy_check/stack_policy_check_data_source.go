@@ -0,0 +1,178 @@
+// ABOUTME: Data source exposing the policy check results of a Zenfra stack's latest (or a given) run.
+// ABOUTME: Can fail the plan when any policy failed, so promotion pipelines stop on soft failures too.
+package stack_policy_check
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type stackPolicyCheckDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &stackPolicyCheckDataSource{}
+var _ datasource.DataSourceWithConfigure = &stackPolicyCheckDataSource{}
+
+func NewStackPolicyCheckDataSource() datasource.DataSource {
+	return &stackPolicyCheckDataSource{}
+}
+
+func (d *stackPolicyCheckDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_policy_check"
+}
+
+func (d *stackPolicyCheckDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the policy check results of a stack's latest run, or of a specific run. " +
+			"Soft-mandatory and advisory failures do not stop a Zenfra run; set `fail_on_violations` to stop the Terraform plan that reads this data source instead.",
+		Attributes: map[string]schema.Attribute{
+			"stack_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the stack.",
+				Required:            true,
+			},
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "The run whose policy results to read. Defaults to the stack's latest run.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"fail_on_violations": schema.BoolAttribute{
+				MarkdownDescription: "When true, reading the data source fails if any policy failed, whatever its enforcement level. Defaults to false.",
+				Optional:            true,
+			},
+			"passed": schema.BoolAttribute{
+				MarkdownDescription: "Whether every policy passed.",
+				Computed:            true,
+			},
+			"hard_failed": schema.BoolAttribute{
+				MarkdownDescription: "Whether a `hard_mandatory` policy failed.",
+				Computed:            true,
+			},
+			"soft_failed": schema.BoolAttribute{
+				MarkdownDescription: "Whether a `soft_mandatory` or `advisory` policy failed.",
+				Computed:            true,
+			},
+			"violated_rules": schema.ListAttribute{
+				MarkdownDescription: "Sorted, de-duplicated names of all violated rules.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"results": schema.ListNestedAttribute{
+				MarkdownDescription: "The result of each policy evaluated against the run.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the policy.",
+							Computed:            true,
+						},
+						"policy_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the policy.",
+							Computed:            true,
+						},
+						"enforcement_level": schema.StringAttribute{
+							MarkdownDescription: "How the policy is enforced: `advisory`, `soft_mandatory`, or `hard_mandatory`.",
+							Computed:            true,
+						},
+						"outcome": schema.StringAttribute{
+							MarkdownDescription: "The outcome of the check: `passed` or `failed`.",
+							Computed:            true,
+						},
+						"violations": schema.ListNestedAttribute{
+							MarkdownDescription: "The rules the run violated.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"rule": schema.StringAttribute{
+										MarkdownDescription: "The name of the violated rule.",
+										Computed:            true,
+									},
+									"message": schema.StringAttribute{
+										MarkdownDescription: "The message reported by the rule.",
+										Computed:            true,
+									},
+									"resource_address": schema.StringAttribute{
+										MarkdownDescription: "The address of the offending resource, if the rule reported one.",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *stackPolicyCheckDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *stackPolicyCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data stackPolicyCheckDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := data.StackID.ValueString()
+	if data.RunID.IsNull() || data.RunID.IsUnknown() {
+		stack, err := d.client.GetStackFields(ctx, stackID, zenfraclient.Fields{"id", "last_run"})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read stack, got error: %s", err))
+			return
+		}
+		if stack.LastRun == nil {
+			resp.Diagnostics.AddError("Stack Has No Runs", fmt.Sprintf("Stack %s has not run yet, so it has no policy results.", stackID))
+			return
+		}
+		data.RunID = types.StringValue(stack.LastRun.ID)
+	}
+
+	results, err := d.client.ListRunPolicyResults(ctx, data.RunID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read policy results, got error: %s", err))
+		return
+	}
+
+	mapPolicyResults(&data, results)
+
+	if data.FailOnViolations.ValueBool() && !data.Passed.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Policy Check Failed",
+			fmt.Sprintf("Run %s of stack %s failed policy checks:\n\n%s", data.RunID.ValueString(), stackID, formatFailures(results)),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// formatFailures renders each failed policy and its violations as an indented list.
+func formatFailures(results []zenfraclient.RunPolicyResult) string {
+	var b strings.Builder
+	for _, r := range results {
+		if r.Outcome != zenfraclient.PolicyOutcomeFailed {
+			continue
+		}
+		fmt.Fprintf(&b, "  - %s (%s)\n", r.PolicyName, r.EnforcementLevel)
+		for _, v := range r.Violations {
+			if v.ResourceAddress != "" {
+				fmt.Fprintf(&b, "      %s: %s [%s]\n", v.Rule, v.Message, v.ResourceAddress)
+			} else {
+				fmt.Fprintf(&b, "      %s: %s\n", v.Rule, v.Message)
+			}
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,95 @@
+// ABOUTME: Unit tests for the zenfra_stack_policy_check data source model mapping.
+// ABOUTME: Verifies pass/fail flags by enforcement level and the violated rule list.
+package stack_policy_check
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapPolicyResults(t *testing.T) {
+	tests := []struct {
+		name                 string
+		results              []zenfraclient.RunPolicyResult
+		wantPassed, wantHard bool
+		wantSoft             bool
+		wantRules            []string
+	}{
+		{
+			name:       "no policies",
+			wantPassed: true,
+		},
+		{
+			name: "all passed",
+			results: []zenfraclient.RunPolicyResult{
+				{PolicyName: "tagging", EnforcementLevel: zenfraclient.PolicyEnforcementHardMandatory, Outcome: zenfraclient.PolicyOutcomePassed},
+			},
+			wantPassed: true,
+		},
+		{
+			name: "soft failure",
+			results: []zenfraclient.RunPolicyResult{
+				{
+					PolicyName: "tagging", EnforcementLevel: zenfraclient.PolicyEnforcementSoftMandatory, Outcome: zenfraclient.PolicyOutcomeFailed,
+					Violations: []zenfraclient.RunPolicyViolation{
+						{Rule: "require-owner-tag", ResourceAddress: "aws_s3_bucket.logs"},
+						{Rule: "require-owner-tag", ResourceAddress: "aws_s3_bucket.data"},
+					},
+				},
+				{PolicyName: "cost", EnforcementLevel: zenfraclient.PolicyEnforcementAdvisory, Outcome: zenfraclient.PolicyOutcomeFailed,
+					Violations: []zenfraclient.RunPolicyViolation{{Rule: "max-instance-size"}}},
+			},
+			wantSoft:  true,
+			wantRules: []string{"max-instance-size", "require-owner-tag"},
+		},
+		{
+			name: "hard failure",
+			results: []zenfraclient.RunPolicyResult{
+				{PolicyName: "no-public-buckets", EnforcementLevel: zenfraclient.PolicyEnforcementHardMandatory, Outcome: zenfraclient.PolicyOutcomeFailed,
+					Violations: []zenfraclient.RunPolicyViolation{{Rule: "deny-public-acl"}}},
+			},
+			wantHard:  true,
+			wantRules: []string{"deny-public-acl"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var model stackPolicyCheckDataSourceModel
+			mapPolicyResults(&model, tt.results)
+
+			if model.Passed.ValueBool() != tt.wantPassed || model.HardFailed.ValueBool() != tt.wantHard || model.SoftFailed.ValueBool() != tt.wantSoft {
+				t.Errorf("expected passed=%v hard=%v soft=%v, got %v %v %v", tt.wantPassed, tt.wantHard, tt.wantSoft,
+					model.Passed.ValueBool(), model.HardFailed.ValueBool(), model.SoftFailed.ValueBool())
+			}
+			if len(model.ViolatedRules) != len(tt.wantRules) {
+				t.Fatalf("expected rules %v, got %v", tt.wantRules, model.ViolatedRules)
+			}
+			for i, rule := range tt.wantRules {
+				if model.ViolatedRules[i].ValueString() != rule {
+					t.Errorf("expected rule %d to be %q, got %q", i, rule, model.ViolatedRules[i].ValueString())
+				}
+			}
+			if len(model.Results) != len(tt.results) {
+				t.Errorf("expected %d results, got %d", len(tt.results), len(model.Results))
+			}
+		})
+	}
+}
+
+func TestFormatFailures(t *testing.T) {
+	got := formatFailures([]zenfraclient.RunPolicyResult{
+		{PolicyName: "ok", Outcome: zenfraclient.PolicyOutcomePassed},
+		{PolicyName: "tagging", EnforcementLevel: zenfraclient.PolicyEnforcementSoftMandatory, Outcome: zenfraclient.PolicyOutcomeFailed,
+			Violations: []zenfraclient.RunPolicyViolation{{Rule: "require-owner-tag", Message: "missing owner", ResourceAddress: "aws_s3_bucket.logs"}}},
+	})
+
+	if strings.Contains(got, "ok") {
+		t.Errorf("expected passing policies to be omitted, got:\n%s", got)
+	}
+	if !strings.Contains(got, "tagging (soft_mandatory)") || !strings.Contains(got, "require-owner-tag: missing owner [aws_s3_bucket.logs]") {
+		t.Errorf("unexpected output:\n%s", got)
+	}
+}
@@ -0,0 +1,86 @@
+// ABOUTME: Model types for the zenfra_stack_policy_check data source.
+// ABOUTME: Maps API policy results to Terraform types and derives pass/fail flags and violated rules.
+package stack_policy_check
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// stackPolicyCheckDataSourceModel represents the Terraform state for the stack policy check data source.
+type stackPolicyCheckDataSourceModel struct {
+	StackID          types.String        `tfsdk:"stack_id"`
+	RunID            types.String        `tfsdk:"run_id"`
+	FailOnViolations types.Bool          `tfsdk:"fail_on_violations"`
+	Passed           types.Bool          `tfsdk:"passed"`
+	HardFailed       types.Bool          `tfsdk:"hard_failed"`
+	SoftFailed       types.Bool          `tfsdk:"soft_failed"`
+	ViolatedRules    []types.String      `tfsdk:"violated_rules"`
+	Results          []policyResultModel `tfsdk:"results"`
+}
+
+type policyResultModel struct {
+	PolicyID         types.String           `tfsdk:"policy_id"`
+	PolicyName       types.String           `tfsdk:"policy_name"`
+	EnforcementLevel types.String           `tfsdk:"enforcement_level"`
+	Outcome          types.String           `tfsdk:"outcome"`
+	Violations       []policyViolationModel `tfsdk:"violations"`
+}
+
+type policyViolationModel struct {
+	Rule            types.String `tfsdk:"rule"`
+	Message         types.String `tfsdk:"message"`
+	ResourceAddress types.String `tfsdk:"resource_address"`
+}
+
+// mapPolicyResults fills the computed attributes of model from the API results.
+// A run passes only if no policy failed, whatever its enforcement level.
+func mapPolicyResults(model *stackPolicyCheckDataSourceModel, results []zenfraclient.RunPolicyResult) {
+	hardFailed, softFailed := false, false
+	var rules []string
+
+	model.Results = make([]policyResultModel, 0, len(results))
+	for _, r := range results {
+		if r.Outcome == zenfraclient.PolicyOutcomeFailed {
+			if r.EnforcementLevel == zenfraclient.PolicyEnforcementHardMandatory {
+				hardFailed = true
+			} else {
+				softFailed = true
+			}
+		}
+
+		item := policyResultModel{
+			PolicyID:         types.StringValue(r.PolicyID),
+			PolicyName:       types.StringValue(r.PolicyName),
+			EnforcementLevel: types.StringValue(r.EnforcementLevel),
+			Outcome:          types.StringValue(r.Outcome),
+			Violations:       make([]policyViolationModel, 0, len(r.Violations)),
+		}
+		for _, v := range r.Violations {
+			violation := policyViolationModel{
+				Rule:            types.StringValue(v.Rule),
+				Message:         types.StringValue(v.Message),
+				ResourceAddress: types.StringNull(),
+			}
+			if v.ResourceAddress != "" {
+				violation.ResourceAddress = types.StringValue(v.ResourceAddress)
+			}
+			item.Violations = append(item.Violations, violation)
+			rules = append(rules, v.Rule)
+		}
+		model.Results = append(model.Results, item)
+	}
+
+	slices.Sort(rules)
+	rules = slices.Compact(rules)
+	model.ViolatedRules = make([]types.String, 0, len(rules))
+	for _, rule := range rules {
+		model.ViolatedRules = append(model.ViolatedRules, types.StringValue(rule))
+	}
+
+	model.HardFailed = types.BoolValue(hardFailed)
+	model.SoftFailed = types.BoolValue(softFailed)
+	model.Passed = types.BoolValue(!hardFailed && !softFailed)
+}
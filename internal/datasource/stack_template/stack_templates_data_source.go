@@ -0,0 +1,138 @@
+// ABOUTME: Data source for listing the Zenfra stack templates new stacks can be initialized from.
+// ABOUTME: Returns the templates as a list and as a map keyed by template slug.
+
+package stack_template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/asmap"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type stackTemplatesDataSource struct {
+	client *zenfraclient.Client
+}
+
+type stackTemplatesDataSourceModel struct {
+	Templates []stackTemplateItemModel `tfsdk:"templates"`
+
+	AsMap map[string]stackTemplateItemModel `tfsdk:"as_map"`
+}
+
+type stackTemplateItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Description types.String `tfsdk:"description"`
+	IACEngine   types.String `tfsdk:"iac_engine"`
+	IACVersion  types.String `tfsdk:"iac_version"`
+	SourceType  types.String `tfsdk:"source_type"`
+}
+
+var _ datasource.DataSource = &stackTemplatesDataSource{}
+var _ datasource.DataSourceWithConfigure = &stackTemplatesDataSource{}
+
+func NewStackTemplatesDataSource() datasource.DataSource {
+	return &stackTemplatesDataSource{}
+}
+
+func (d *stackTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_templates"
+}
+
+func (d *stackTemplatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the stack templates available to the organization. Pass a template's `id` to `zenfra_stack.template_id` to scaffold a new stack from it.",
+		Attributes: map[string]schema.Attribute{
+			"templates": schema.ListNestedAttribute{
+				MarkdownDescription: "List of stack templates.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: stackTemplateItemAttributes(),
+				},
+			},
+			"as_map": schema.MapNestedAttribute{
+				MarkdownDescription: "The same templates keyed by slug, e.g. `as_map[\"aws-vpc\"].id`. Slugs shared by several templates are left out with a warning.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: stackTemplateItemAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// stackTemplateItemAttributes returns the attributes of a template in the templates list and as_map.
+func stackTemplateItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the template.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the template.",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "The URL-friendly slug for the template.",
+			Computed:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "A description of what the template provisions.",
+			Computed:            true,
+		},
+		"iac_engine": schema.StringAttribute{
+			MarkdownDescription: "The IaC engine the template is written for (terraform or opentofu).",
+			Computed:            true,
+		},
+		"iac_version": schema.StringAttribute{
+			MarkdownDescription: "The IaC engine version the template is written for.",
+			Computed:            true,
+		},
+		"source_type": schema.StringAttribute{
+			MarkdownDescription: "The type of source stacks created from the template use (raw_git or vcs).",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *stackTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *stackTemplatesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	templates, err := d.client.ListStackTemplates(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list stack templates, got error: %s", err))
+		return
+	}
+
+	var data stackTemplatesDataSourceModel
+	data.Templates = make([]stackTemplateItemModel, 0, len(templates))
+	for i := range templates {
+		item := stackTemplateItemModel{
+			ID:          types.StringValue(templates[i].ID),
+			Name:        types.StringValue(templates[i].Name),
+			Slug:        types.StringValue(templates[i].Slug),
+			Description: types.StringNull(),
+			IACEngine:   types.StringValue(templates[i].IAC.Engine),
+			IACVersion:  types.StringValue(templates[i].IAC.Version),
+			SourceType:  types.StringValue(templates[i].Source.Type),
+		}
+		if templates[i].Description != "" {
+			item.Description = types.StringValue(templates[i].Description)
+		}
+		data.Templates = append(data.Templates, item)
+	}
+	data.AsMap = asmap.Build(data.Templates, func(t stackTemplateItemModel) string { return t.Slug.ValueString() }, "zenfra_stack_templates", "slug", &resp.Diagnostics)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
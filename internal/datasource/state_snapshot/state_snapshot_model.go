@@ -0,0 +1,46 @@
+// ABOUTME: Model types for the zenfra_state_snapshots data source.
+// ABOUTME: Maps API StateSnapshot entries to Terraform types.
+package state_snapshot
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// stateSnapshotsDataSourceModel represents the Terraform state for the state snapshots data source.
+type stateSnapshotsDataSourceModel struct {
+	StackID   types.String             `tfsdk:"stack_id"`
+	Snapshots []stateSnapshotItemModel `tfsdk:"snapshots"`
+}
+
+// stateSnapshotItemModel represents a single item in the snapshots list.
+type stateSnapshotItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Serial    types.Int64  `tfsdk:"serial"`
+	Lineage   types.String `tfsdk:"lineage"`
+	SizeBytes types.Int64  `tfsdk:"size_bytes"`
+	RunID     types.String `tfsdk:"run_id"`
+	CreatedBy types.String `tfsdk:"created_by"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+// mapStateSnapshotToItem converts an API StateSnapshot to a list item model.
+func mapStateSnapshotToItem(snapshot *zenfraclient.StateSnapshot) stateSnapshotItemModel {
+	item := stateSnapshotItemModel{
+		ID:        types.StringValue(snapshot.ID),
+		Serial:    types.Int64Value(snapshot.Serial),
+		Lineage:   types.StringValue(snapshot.Lineage),
+		SizeBytes: types.Int64Value(snapshot.SizeBytes),
+		CreatedBy: types.StringValue(snapshot.CreatedBy),
+		CreatedAt: timeutil.String(snapshot.CreatedAt),
+	}
+
+	if snapshot.RunID != nil {
+		item.RunID = types.StringValue(*snapshot.RunID)
+	} else {
+		item.RunID = types.StringNull()
+	}
+
+	return item
+}
@@ -0,0 +1,103 @@
+// ABOUTME: Data source for listing the stored state snapshots of a Zenfra stack.
+// ABOUTME: Snapshot IDs feed zenfra_state_rollback when a stack's state must be restored.
+package state_snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type stateSnapshotsDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &stateSnapshotsDataSource{}
+var _ datasource.DataSourceWithConfigure = &stateSnapshotsDataSource{}
+
+func NewStateSnapshotsDataSource() datasource.DataSource {
+	return &stateSnapshotsDataSource{}
+}
+
+func (d *stateSnapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_state_snapshots"
+}
+
+func (d *stateSnapshotsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the stored state snapshots of a Zenfra stack, newest first. Every apply writes a new snapshot.",
+		Attributes: map[string]schema.Attribute{
+			"stack_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the stack whose snapshots to list.",
+				Required:            true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				MarkdownDescription: "State snapshots, ordered from newest to oldest.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the snapshot.",
+							Computed:            true,
+						},
+						"serial": schema.Int64Attribute{
+							MarkdownDescription: "The Terraform state serial recorded in the snapshot.",
+							Computed:            true,
+						},
+						"lineage": schema.StringAttribute{
+							MarkdownDescription: "The Terraform state lineage recorded in the snapshot.",
+							Computed:            true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The size of the stored state in bytes.",
+							Computed:            true,
+						},
+						"run_id": schema.StringAttribute{
+							MarkdownDescription: "The run that wrote the snapshot, if it was written by a run.",
+							Computed:            true,
+						},
+						"created_by": schema.StringAttribute{
+							MarkdownDescription: "The user or token that wrote the snapshot.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Timestamp when the snapshot was written.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *stateSnapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *stateSnapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data stateSnapshotsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, err := d.client.ListStateSnapshots(ctx, data.StackID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list state snapshots, got error: %s", err))
+		return
+	}
+
+	data.Snapshots = make([]stateSnapshotItemModel, 0, len(snapshots))
+	for i := range snapshots {
+		data.Snapshots = append(data.Snapshots, mapStateSnapshotToItem(&snapshots[i]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,176 @@
+// ABOUTME: Data source for reading the current organization's API quota and capacity usage.
+// ABOUTME: Exposes the API token's rate limit, run minutes used, and worker slot consumption.
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type usageDataSource struct {
+	client *zenfraclient.Client
+}
+
+type usageDataSourceModel struct {
+	APIRateLimit *apiRateLimitModel `tfsdk:"api_rate_limit"`
+	RunMinutes   *runMinutesModel   `tfsdk:"run_minutes"`
+	WorkerSlots  *workerSlotsModel  `tfsdk:"worker_slots"`
+}
+
+type apiRateLimitModel struct {
+	Limit     types.Int64  `tfsdk:"limit"`
+	Remaining types.Int64  `tfsdk:"remaining"`
+	ResetAt   types.String `tfsdk:"reset_at"`
+}
+
+type runMinutesModel struct {
+	Used        types.Int64  `tfsdk:"used"`
+	Included    types.Int64  `tfsdk:"included"`
+	Remaining   types.Int64  `tfsdk:"remaining"`
+	PeriodStart types.String `tfsdk:"period_start"`
+	PeriodEnd   types.String `tfsdk:"period_end"`
+}
+
+type workerSlotsModel struct {
+	Limit     types.Int64 `tfsdk:"limit"`
+	Used      types.Int64 `tfsdk:"used"`
+	Available types.Int64 `tfsdk:"available"`
+}
+
+var _ datasource.DataSource = &usageDataSource{}
+var _ datasource.DataSourceWithConfigure = &usageDataSource{}
+
+func NewUsageDataSource() datasource.DataSource {
+	return &usageDataSource{}
+}
+
+func (d *usageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usage"
+}
+
+func (d *usageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads the current organization's API quota and capacity usage, for building capacity dashboards and budget alerts. " +
+			"Values are read on every plan, so they describe the moment Terraform ran.",
+		Attributes: map[string]schema.Attribute{
+			"api_rate_limit": schema.SingleNestedAttribute{
+				MarkdownDescription: "Rate limit quota of the API token the provider is configured with.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"limit": schema.Int64Attribute{
+						MarkdownDescription: "Requests allowed per rate limit window.",
+						Computed:            true,
+					},
+					"remaining": schema.Int64Attribute{
+						MarkdownDescription: "Requests left in the current window.",
+						Computed:            true,
+					},
+					"reset_at": schema.StringAttribute{
+						MarkdownDescription: "RFC3339 timestamp when the current window resets.",
+						Computed:            true,
+					},
+				},
+			},
+			"run_minutes": schema.SingleNestedAttribute{
+				MarkdownDescription: "Run minutes consumed in the current billing period.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"used": schema.Int64Attribute{
+						MarkdownDescription: "Run minutes used so far in the billing period.",
+						Computed:            true,
+					},
+					"included": schema.Int64Attribute{
+						MarkdownDescription: "Run minutes included in the billing plan for the period.",
+						Computed:            true,
+					},
+					"remaining": schema.Int64Attribute{
+						MarkdownDescription: "Included run minutes not yet used. Zero once usage exceeds the included minutes.",
+						Computed:            true,
+					},
+					"period_start": schema.StringAttribute{
+						MarkdownDescription: "RFC3339 timestamp when the billing period started.",
+						Computed:            true,
+					},
+					"period_end": schema.StringAttribute{
+						MarkdownDescription: "RFC3339 timestamp when the billing period ends.",
+						Computed:            true,
+					},
+				},
+			},
+			"worker_slots": schema.SingleNestedAttribute{
+				MarkdownDescription: "Worker slot consumption across the organization.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"limit": schema.Int64Attribute{
+						MarkdownDescription: "Maximum number of worker slots.",
+						Computed:            true,
+					},
+					"used": schema.Int64Attribute{
+						MarkdownDescription: "Number of worker slots currently in use.",
+						Computed:            true,
+					},
+					"available": schema.Int64Attribute{
+						MarkdownDescription: "Number of worker slots available.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *usageDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *usageDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	usage, err := d.client.GetOrganizationUsage(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read organization usage, got error: %s", err))
+		return
+	}
+
+	data := mapUsage(usage)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func mapUsage(usage *zenfraclient.OrganizationUsage) usageDataSourceModel {
+	return usageDataSourceModel{
+		APIRateLimit: &apiRateLimitModel{
+			Limit:     types.Int64Value(usage.APIRateLimit.Limit),
+			Remaining: types.Int64Value(usage.APIRateLimit.Remaining),
+			ResetAt:   timestampValue(usage.APIRateLimit.ResetAt),
+		},
+		RunMinutes: &runMinutesModel{
+			Used:        types.Int64Value(usage.RunMinutes.Used),
+			Included:    types.Int64Value(usage.RunMinutes.Included),
+			Remaining:   types.Int64Value(max(usage.RunMinutes.Included-usage.RunMinutes.Used, 0)),
+			PeriodStart: timestampValue(usage.RunMinutes.PeriodStart),
+			PeriodEnd:   timestampValue(usage.RunMinutes.PeriodEnd),
+		},
+		WorkerSlots: &workerSlotsModel{
+			Limit:     types.Int64Value(usage.WorkerSlots.Limit),
+			Used:      types.Int64Value(usage.WorkerSlots.Used),
+			Available: types.Int64Value(usage.WorkerSlots.Available),
+		},
+	}
+}
+
+// timestampValue returns null for timestamps the API left unset.
+func timestampValue(t time.Time) types.String {
+	if t.IsZero() {
+		return types.StringNull()
+	}
+	return timeutil.String(t)
+}
@@ -0,0 +1,34 @@
+// ABOUTME: Unit tests for the zenfra_usage data source mapping.
+// ABOUTME: Verifies timestamp handling and remaining run minute calculation.
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapUsage(t *testing.T) {
+	t.Parallel()
+
+	usage := &zenfraclient.OrganizationUsage{
+		APIRateLimit: zenfraclient.APIRateLimitUsage{Limit: 1000, Remaining: 940, ResetAt: time.Date(2026, 3, 1, 10, 1, 0, 0, time.UTC)},
+		RunMinutes:   zenfraclient.RunMinutesUsage{Used: 5200, Included: 5000, PeriodStart: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		WorkerSlots:  zenfraclient.WorkerSlotUsage{Limit: 10, Used: 3, Available: 7},
+	}
+
+	got := mapUsage(usage)
+	if got.APIRateLimit.ResetAt.ValueString() != "2026-03-01T10:01:00Z" {
+		t.Errorf("reset_at = %s", got.APIRateLimit.ResetAt)
+	}
+	if got.RunMinutes.Remaining.ValueInt64() != 0 {
+		t.Errorf("run_minutes.remaining = %d, want 0 once usage exceeds included", got.RunMinutes.Remaining.ValueInt64())
+	}
+	if !got.RunMinutes.PeriodEnd.IsNull() {
+		t.Errorf("period_end = %s, want null for unset timestamp", got.RunMinutes.PeriodEnd)
+	}
+	if got.WorkerSlots.Available.ValueInt64() != 7 {
+		t.Errorf("worker_slots.available = %d", got.WorkerSlots.Available.ValueInt64())
+	}
+}
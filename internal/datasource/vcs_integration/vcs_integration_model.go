@@ -1,9 +1,10 @@
-// ABOUTME: Shared model types for VCS integration data sources.
-// ABOUTME: Maps between API VCSIntegration types and Terraform data source schema types.
+// ABOUTME: Shared model types for VCS integration and VCS ref data sources.
+// ABOUTME: Maps between API VCSIntegration/VCSRef types and Terraform data source schema types.
 package vcs_integration
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -35,6 +36,17 @@ type vcsIntegrationListItemModel struct {
 	Status         types.String `tfsdk:"status"`
 }
 
+// vcsRefDataSourceModel represents the Terraform state for the VCS ref data source.
+type vcsRefDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	IntegrationID types.String `tfsdk:"integration_id"`
+	RepositoryID  types.String `tfsdk:"repository_id"`
+	Ref           types.String `tfsdk:"ref"`
+	RefType       types.String `tfsdk:"ref_type"`
+	CommitSHA     types.String `tfsdk:"commit_sha"`
+	CommittedAt   types.String `tfsdk:"committed_at"`
+}
+
 // mapVCSIntegrationToDataSource converts an API VCSIntegration to the singular data source model.
 func mapVCSIntegrationToDataSource(vcs *zenfraclient.VCSIntegration) vcsIntegrationDataSourceModel {
 	model := vcsIntegrationDataSourceModel{
@@ -43,8 +55,8 @@ func mapVCSIntegrationToDataSource(vcs *zenfraclient.VCSIntegration) vcsIntegrat
 		Name:           types.StringValue(vcs.DisplayName),
 		ProviderType:   types.StringValue(vcs.Provider),
 		Status:         types.StringValue(vcs.Status),
-		CreatedAt:      types.StringValue(vcs.CreatedAt),
-		UpdatedAt:      types.StringValue(vcs.UpdatedAt),
+		CreatedAt:      types.StringValue(timeutil.Normalize(vcs.CreatedAt)),
+		UpdatedAt:      types.StringValue(timeutil.Normalize(vcs.UpdatedAt)),
 	}
 
 	if vcs.GitHub != nil {
@@ -8,7 +8,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -34,6 +37,9 @@ func (d *vcsIntegrationsDataSource) Schema(_ context.Context, _ datasource.Schem
 			"provider_type": schema.StringAttribute{
 				MarkdownDescription: "Optional filter by VCS provider type (github or gitlab).",
 				Optional:            true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.VCSProviderGitHub, zenfraclient.VCSProviderGitLab),
+				},
 			},
 			"integrations": schema.ListNestedAttribute{
 				MarkdownDescription: "List of VCS integrations.",
@@ -68,20 +74,9 @@ func (d *vcsIntegrationsDataSource) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (d *vcsIntegrationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
 	}
-
-	d.client = client
 }
 
 func (d *vcsIntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -0,0 +1,104 @@
+// ABOUTME: Data source resolving a branch or tag in a VCS integration repository to its current commit SHA.
+// ABOUTME: Lets stack sources be pinned to an immutable commit chosen at plan time.
+package vcs_integration
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type vcsRefDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &vcsRefDataSource{}
+var _ datasource.DataSourceWithConfigure = &vcsRefDataSource{}
+
+func NewVCSRefDataSource() datasource.DataSource {
+	return &vcsRefDataSource{}
+}
+
+func (d *vcsRefDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vcs_ref"
+}
+
+func (d *vcsRefDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resolves a branch or tag in a repository reachable through a VCS integration to the commit it currently points at. " +
+			"Use `commit_sha` as a stack source ref of type `commit` to pin the stack to the commit seen at plan time.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The resolved commit SHA.",
+				Computed:            true,
+			},
+			"integration_id": schema.StringAttribute{
+				MarkdownDescription: "The VCS integration used to access the repository.",
+				Required:            true,
+			},
+			"repository_id": schema.StringAttribute{
+				MarkdownDescription: "The repository identifier, as used in a stack's `source.vcs.repository_id`.",
+				Required:            true,
+			},
+			"ref": schema.StringAttribute{
+				MarkdownDescription: "The branch or tag name to resolve.",
+				Required:            true,
+			},
+			"ref_type": schema.StringAttribute{
+				MarkdownDescription: "Whether `ref` is a `branch` or a `tag`. If omitted, `ref` is looked up as a branch first and then as a tag, and the matched type is reported here.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.SourceRefBranch, zenfraclient.SourceRefTag),
+				},
+			},
+			"commit_sha": schema.StringAttribute{
+				MarkdownDescription: "The full SHA of the commit `ref` currently points at.",
+				Computed:            true,
+			},
+			"committed_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the resolved commit.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *vcsRefDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *vcsRefDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vcsRefDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	refType := ""
+	if !data.RefType.IsNull() && !data.RefType.IsUnknown() {
+		refType = data.RefType.ValueString()
+	}
+
+	ref, err := d.client.ResolveVCSRef(ctx, data.IntegrationID.ValueString(), data.RepositoryID.ValueString(), data.Ref.ValueString(), refType)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve VCS ref %q, got error: %s", data.Ref.ValueString(), err))
+		return
+	}
+
+	data.ID = types.StringValue(ref.CommitSHA)
+	data.RefType = types.StringValue(ref.Type)
+	data.CommitSHA = types.StringValue(ref.CommitSHA)
+	data.CommittedAt = types.StringValue(ref.CommittedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
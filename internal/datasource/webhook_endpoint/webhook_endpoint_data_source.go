@@ -0,0 +1,144 @@
+// ABOUTME: Data source for reading a single Zenfra webhook endpoint by ID or name.
+// ABOUTME: Exposes delivery settings and when the secret was last rotated; the secret itself is never readable.
+package webhook_endpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type webhookEndpointDataSource struct {
+	client *zenfraclient.Client
+}
+
+var _ datasource.DataSource = &webhookEndpointDataSource{}
+var _ datasource.DataSourceWithConfigure = &webhookEndpointDataSource{}
+
+func NewWebhookEndpointDataSource() datasource.DataSource {
+	return &webhookEndpointDataSource{}
+}
+
+func (d *webhookEndpointDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_endpoint"
+}
+
+func (d *webhookEndpointDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a single webhook endpoint by ID or name. The signing secret is not readable; " +
+			"use `zenfra_webhook_secret_rotation` to rotate it and obtain the new one.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the webhook endpoint. Exactly one of `id` or `name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the webhook endpoint. Exactly one of `id` or `name` must be specified.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID this endpoint belongs to.",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL events are delivered to.",
+				Computed:            true,
+			},
+			"events": schema.ListAttribute{
+				MarkdownDescription: "The event types delivered to the endpoint, such as `run.finished`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether events are currently delivered.",
+				Computed:            true,
+			},
+			"secret_rotated_at": schema.StringAttribute{
+				MarkdownDescription: "When the signing secret was last rotated. Null if it never was.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the endpoint was created.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Timestamp when the endpoint was last updated.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *webhookEndpointDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+	}
+}
+
+func (d *webhookEndpointDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data webhookEndpointDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasID := !data.ID.IsNull() && !data.ID.IsUnknown()
+	hasName := !data.Name.IsNull() && !data.Name.IsUnknown()
+
+	if !hasID && !hasName {
+		resp.Diagnostics.AddError("Missing Attribute", "Exactly one of `id` or `name` must be specified.")
+		return
+	}
+	if hasID && hasName {
+		resp.Diagnostics.AddError("Conflicting Attributes", "Only one of `id` or `name` may be specified, not both.")
+		return
+	}
+
+	var matched *zenfraclient.WebhookEndpoint
+	if hasID {
+		endpoint, err := d.client.GetWebhookEndpoint(ctx, data.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook endpoint, got error: %s", err))
+			return
+		}
+		matched = endpoint
+	} else {
+		// Lookup by name: list all and find match.
+		endpoints, err := d.client.ListWebhookEndpoints(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list webhook endpoints, got error: %s", err))
+			return
+		}
+
+		targetName := data.Name.ValueString()
+		for i := range endpoints {
+			if endpoints[i].Name == targetName {
+				if matched != nil {
+					resp.Diagnostics.AddError("Multiple Matches",
+						fmt.Sprintf("Found multiple webhook endpoints with name %q. Use `id` instead.", targetName))
+					return
+				}
+				matched = &endpoints[i]
+			}
+		}
+		if matched == nil {
+			resp.Diagnostics.AddError("Not Found",
+				fmt.Sprintf("No webhook endpoint found with name %q.", targetName))
+			return
+		}
+	}
+
+	state, diags := mapWebhookEndpointToDataSource(ctx, matched)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,54 @@
+// ABOUTME: Unit tests for the webhook endpoint data source model mapping.
+// ABOUTME: Covers endpoints with and without a rotated secret and without events.
+package webhook_endpoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapWebhookEndpointToDataSource(t *testing.T) {
+	ctx := context.Background()
+	rotated := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	endpoint := &zenfraclient.WebhookEndpoint{
+		ID:              "wh-1",
+		OrganizationID:  "org-1",
+		Name:            "audit",
+		URL:             "https://hooks.example.com/zenfra",
+		Events:          []string{"run.finished", "stack.deleted"},
+		Enabled:         true,
+		SecretRotatedAt: &rotated,
+		CreatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:       time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	model, diags := mapWebhookEndpointToDataSource(ctx, endpoint)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if model.ID.ValueString() != "wh-1" || model.URL.ValueString() != "https://hooks.example.com/zenfra" || !model.Enabled.ValueBool() {
+		t.Errorf("unexpected model: %+v", model)
+	}
+	if len(model.Events.Elements()) != 2 {
+		t.Errorf("expected 2 events, got %s", model.Events)
+	}
+	if model.SecretRotatedAt.ValueString() != "2026-03-01T12:00:00Z" {
+		t.Errorf("expected secret_rotated_at 2026-03-01T12:00:00Z, got %s", model.SecretRotatedAt)
+	}
+
+	endpoint.SecretRotatedAt = nil
+	endpoint.Events = nil
+	model, diags = mapWebhookEndpointToDataSource(ctx, endpoint)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !model.SecretRotatedAt.IsNull() {
+		t.Errorf("expected null secret_rotated_at for a never-rotated secret, got %s", model.SecretRotatedAt)
+	}
+	if model.Events.IsNull() || len(model.Events.Elements()) != 0 {
+		t.Errorf("expected an empty events list, got %s", model.Events)
+	}
+}
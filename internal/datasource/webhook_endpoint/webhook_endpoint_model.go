@@ -0,0 +1,46 @@
+// ABOUTME: Model types for the zenfra_webhook_endpoint data source.
+// ABOUTME: Maps the API WebhookEndpoint type to Terraform data source schema types.
+package webhook_endpoint
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// webhookEndpointDataSourceModel represents the Terraform state for the webhook endpoint data source.
+type webhookEndpointDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	OrganizationID  types.String `tfsdk:"organization_id"`
+	URL             types.String `tfsdk:"url"`
+	Events          types.List   `tfsdk:"events"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	SecretRotatedAt types.String `tfsdk:"secret_rotated_at"`
+	CreatedAt       types.String `tfsdk:"created_at"`
+	UpdatedAt       types.String `tfsdk:"updated_at"`
+}
+
+func mapWebhookEndpointToDataSource(ctx context.Context, endpoint *zenfraclient.WebhookEndpoint) (webhookEndpointDataSourceModel, diag.Diagnostics) {
+	events := endpoint.Events
+	if events == nil {
+		events = []string{}
+	}
+	eventList, diags := types.ListValueFrom(ctx, types.StringType, events)
+
+	model := webhookEndpointDataSourceModel{
+		ID:              types.StringValue(endpoint.ID),
+		Name:            types.StringValue(endpoint.Name),
+		OrganizationID:  types.StringValue(endpoint.OrganizationID),
+		URL:             types.StringValue(endpoint.URL),
+		Events:          eventList,
+		Enabled:         types.BoolValue(endpoint.Enabled),
+		SecretRotatedAt: timeutil.StringPointer(endpoint.SecretRotatedAt),
+		CreatedAt:       timeutil.String(endpoint.CreatedAt),
+		UpdatedAt:       timeutil.String(endpoint.UpdatedAt),
+	}
+	return model, diags
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -29,6 +31,8 @@ type workerPoolDataSourceModel struct {
 	CreatedAt          types.String `tfsdk:"created_at"`
 	UpdatedAt          types.String `tfsdk:"updated_at"`
 	LastUsedAt         types.String `tfsdk:"last_used_at"`
+
+	RunnerVersionConstraint types.String `tfsdk:"runner_version_constraint"`
 }
 
 var _ datasource.DataSource = &workerPoolDataSource{}
@@ -90,25 +94,18 @@ func (d *workerPoolDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				MarkdownDescription: "RFC3339 timestamp when the worker pool was last used.",
 				Computed:            true,
 			},
+			"runner_version_constraint": schema.StringAttribute{
+				MarkdownDescription: "The runner version constraint the pool is pinned to. Null if the pool follows the organization default.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
 func (d *workerPoolDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
 	}
-
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
-	}
-
-	d.client = client
 }
 
 func (d *workerPoolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -135,12 +132,14 @@ func (d *workerPoolDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	data.KeyVersion = types.Int64Value(int64(pool.KeyVersion))
 	data.Active = types.BoolValue(pool.Active)
 	data.ActiveWorkersCount = types.Int64Value(pool.ActiveWorkersCount)
-	data.CreatedAt = types.StringValue(pool.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	data.UpdatedAt = types.StringValue(pool.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"))
-	if pool.LastUsedAt != nil {
-		data.LastUsedAt = types.StringValue(pool.LastUsedAt.Format("2006-01-02T15:04:05Z07:00"))
+	data.CreatedAt = timeutil.String(pool.CreatedAt)
+	data.UpdatedAt = timeutil.String(pool.UpdatedAt)
+	data.LastUsedAt = timeutil.StringPointer(pool.LastUsedAt)
+
+	if pool.RunnerVersionConstraint != "" {
+		data.RunnerVersionConstraint = types.StringValue(pool.RunnerVersionConstraint)
 	} else {
-		data.LastUsedAt = types.StringNull()
+		data.RunnerVersionConstraint = types.StringNull()
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
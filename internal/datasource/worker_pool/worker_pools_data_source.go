@@ -1,5 +1,5 @@
 // ABOUTME: Data source for listing all Zenfra worker pools in the organization.
-// ABOUTME: Returns a list of worker pools with basic attributes.
+// ABOUTME: Returns the worker pools as a list and as a map keyed by pool name, optionally with each pool's details.
 
 package worker_pool
 
@@ -10,15 +10,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/asmap"
+	"github.com/zenfra/terraform-provider-zenfra/internal/datasource/hydrate"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/semaphore"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 type workerPoolsDataSource struct {
 	client *zenfraclient.Client
+	ops    semaphore.Semaphore
 }
 
 type workerPoolsDataSourceModel struct {
-	Pools []workerPoolsListItemModel `tfsdk:"pools"`
+	IncludeDetails types.Bool                 `tfsdk:"include_details"`
+	Pools          []workerPoolsListItemModel `tfsdk:"pools"`
+
+	AsMap map[string]workerPoolsListItemModel `tfsdk:"as_map"`
 }
 
 type workerPoolsListItemModel struct {
@@ -26,8 +35,20 @@ type workerPoolsListItemModel struct {
 	Name           types.String `tfsdk:"name"`
 	OrganizationID types.String `tfsdk:"organization_id"`
 	Active         types.Bool   `tfsdk:"active"`
+
+	// Details, read per pool when include_details is true.
+	PoolType                types.String `tfsdk:"pool_type"`
+	ActiveWorkersCount      types.Int64  `tfsdk:"active_workers_count"`
+	Draining                types.Bool   `tfsdk:"draining"`
+	RunnerVersionConstraint types.String `tfsdk:"runner_version_constraint"`
+	CreatedAt               types.String `tfsdk:"created_at"`
+	UpdatedAt               types.String `tfsdk:"updated_at"`
+	LastUsedAt              types.String `tfsdk:"last_used_at"`
 }
 
+// workerPoolsListFields are the worker pool attributes the list maps.
+var workerPoolsListFields = zenfraclient.Fields{"id", "name", "organization_id", "active"}
+
 var _ datasource.DataSource = &workerPoolsDataSource{}
 var _ datasource.DataSourceWithConfigure = &workerPoolsDataSource{}
 
@@ -43,49 +64,85 @@ func (d *workerPoolsDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Lists all Zenfra worker pools in the organization.",
 		Attributes: map[string]schema.Attribute{
+			"include_details": schema.BoolAttribute{
+				MarkdownDescription: "Read each worker pool's details, such as its worker count and runner version constraint, instead of only its name and status. " +
+					"The pools are read concurrently, which is much faster than a `zenfra_worker_pool` data source per pool, " +
+					"but still one API call per pool. Defaults to false.",
+				Optional: true,
+			},
 			"pools": schema.ListNestedAttribute{
 				MarkdownDescription: "List of worker pools.",
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.StringAttribute{
-							MarkdownDescription: "The unique identifier of the worker pool.",
-							Computed:            true,
-						},
-						"name": schema.StringAttribute{
-							MarkdownDescription: "The name of the worker pool.",
-							Computed:            true,
-						},
-						"organization_id": schema.StringAttribute{
-							MarkdownDescription: "The organization ID that owns this worker pool.",
-							Computed:            true,
-						},
-						"active": schema.BoolAttribute{
-							MarkdownDescription: "Whether the worker pool is active.",
-							Computed:            true,
-						},
-					},
+					Attributes: workerPoolItemAttributes(),
+				},
+			},
+			"as_map": schema.MapNestedAttribute{
+				MarkdownDescription: "The same worker pools keyed by name, e.g. `as_map[\"private\"].id`. Names shared by several pools are left out with a warning.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: workerPoolItemAttributes(),
 				},
 			},
 		},
 	}
 }
 
-func (d *workerPoolsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+// workerPoolItemAttributes returns the attributes of a worker pool in the pools list and as_map.
+func workerPoolItemAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The unique identifier of the worker pool.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the worker pool.",
+			Computed:            true,
+		},
+		"organization_id": schema.StringAttribute{
+			MarkdownDescription: "The organization ID that owns this worker pool.",
+			Computed:            true,
+		},
+		"active": schema.BoolAttribute{
+			MarkdownDescription: "Whether the worker pool is active.",
+			Computed:            true,
+		},
+		"pool_type": schema.StringAttribute{
+			MarkdownDescription: "The type of the worker pool. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"active_workers_count": schema.Int64Attribute{
+			MarkdownDescription: "The number of workers currently connected to the pool. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"draining": schema.BoolAttribute{
+			MarkdownDescription: "Whether the pool is draining, so no new runs are scheduled on it. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"runner_version_constraint": schema.StringAttribute{
+			MarkdownDescription: "The runner version constraint of the pool's workers. Null unless `include_details` is true, or if the pool has none.",
+			Computed:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the worker pool was created. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the worker pool was last updated. Null unless `include_details` is true.",
+			Computed:            true,
+		},
+		"last_used_at": schema.StringAttribute{
+			MarkdownDescription: "Timestamp when the worker pool last ran a job. Null unless `include_details` is true, or if it never has.",
+			Computed:            true,
+		},
 	}
+}
 
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
+func (d *workerPoolsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if data := providerdata.FromDataSource(req, resp); data != nil {
+		d.client = data.Client
+		d.ops = data.Operations
 	}
-
-	d.client = client
 }
 
 func (d *workerPoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -95,7 +152,7 @@ func (d *workerPoolsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	pools, err := d.client.ListWorkerPools(ctx)
+	pools, err := d.client.ListWorkerPools(ctx, &zenfraclient.ListOptions{Fields: workerPoolsListFields})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list worker pools, got error: %s", err))
 		return
@@ -109,8 +166,46 @@ func (d *workerPoolsDataSource) Read(ctx context.Context, req datasource.ReadReq
 			Name:           types.StringValue(pools[i].Name),
 			OrganizationID: types.StringValue(pools[i].OrganizationID),
 			Active:         types.BoolValue(pools[i].Active),
+
+			PoolType:                types.StringNull(),
+			ActiveWorkersCount:      types.Int64Null(),
+			Draining:                types.BoolNull(),
+			RunnerVersionConstraint: types.StringNull(),
+			CreatedAt:               types.StringNull(),
+			UpdatedAt:               types.StringNull(),
+			LastUsedAt:              types.StringNull(),
+		})
+	}
+
+	if data.IncludeDetails.ValueBool() {
+		err = hydrate.Each(ctx, d.ops, len(data.Pools), func(ctx context.Context, i int) error {
+			pool, err := d.client.GetWorkerPool(ctx, pools[i].ID)
+			if err != nil {
+				return fmt.Errorf("worker pool %s: %w", pools[i].ID, err)
+			}
+			setWorkerPoolDetails(&data.Pools[i], pool)
+			return nil
 		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read worker pool details, got error: %s", err))
+			return
+		}
 	}
+	data.AsMap = asmap.Build(data.Pools, func(p workerPoolsListItemModel) string { return p.Name.ValueString() }, "zenfra_worker_pools", "name", &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// setWorkerPoolDetails fills in the detail attributes of item from the full pool.
+func setWorkerPoolDetails(item *workerPoolsListItemModel, pool *zenfraclient.WorkerPool) {
+	item.PoolType = types.StringValue(pool.PoolType)
+	item.ActiveWorkersCount = types.Int64Value(pool.ActiveWorkersCount)
+	item.Draining = types.BoolValue(pool.Draining)
+	item.RunnerVersionConstraint = types.StringNull()
+	if pool.RunnerVersionConstraint != "" {
+		item.RunnerVersionConstraint = types.StringValue(pool.RunnerVersionConstraint)
+	}
+	item.CreatedAt = timeutil.String(pool.CreatedAt)
+	item.UpdatedAt = timeutil.String(pool.UpdatedAt)
+	item.LastUsedAt = timeutil.StringPointer(pool.LastUsedAt)
+}
@@ -0,0 +1,53 @@
+// ABOUTME: Collects the identifiers hand-written files already declare in the target package.
+// ABOUTME: Lets the generator refuse to emit a type or method that is also written by hand.
+
+package gen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Declared returns the top-level type names and Client method names declared in the
+// Go files in dir, each mapped to the file that declares it. Tests and the generated
+// file named generated are skipped.
+func Declared(dir, generated string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", dir, err)
+	}
+
+	declared := map[string]string{}
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == generated {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range d.Specs {
+					declared[spec.(*ast.TypeSpec).Name.Name] = name
+				}
+			case *ast.FuncDecl:
+				if d.Recv != nil {
+					declared[d.Name.Name] = name
+				}
+			}
+		}
+	}
+	return declared, nil
+}
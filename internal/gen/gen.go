@@ -0,0 +1,386 @@
+// ABOUTME: Generates zenfraclient DTOs and basic CRUD methods from the Zenfra OpenAPI document.
+// ABOUTME: Generated methods are unexported; hand-written methods in zenfraclient wrap them.
+
+// Package gen generates Go source for the zenfraclient package from the Zenfra OpenAPI
+// document. Every component schema becomes a struct, unless it is marked x-handwritten
+// because its type is declared by hand, and every operation with an operationId becomes
+// an unexported Client method named after it with an "api" prefix, e.g. getStack becomes
+// apiGetStack. The exported client API stays hand-written: the wrappers in zenfraclient
+// add error context, option types, waiting, and caching on top of the generated calls.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Config controls what Generate emits.
+type Config struct {
+	// Package is the package name of the generated file.
+	Package string
+
+	// Source names the document in the generated file's header.
+	Source string
+
+	// Declared maps the identifiers declared by hand-written files in the package to
+	// their file, see Declared. Generate fails if a schema's type or an operation's
+	// method is among them, so one definition cannot silently shadow the other.
+	Declared map[string]string
+
+	// SkipOperations lists operationIds not to generate methods for.
+	SkipOperations []string
+}
+
+type generator struct {
+	cfg     Config
+	b       bytes.Buffer
+	pending []namedSchema // inline object schemas still to be emitted as structs
+	imports map[string]bool
+}
+
+type namedSchema struct {
+	name   string
+	schema *Schema
+	inline bool // declared inside another schema rather than under components
+}
+
+// Generate returns the formatted Go source for spec.
+func Generate(spec *Spec, cfg Config) ([]byte, error) {
+	g := &generator{cfg: cfg, imports: map[string]bool{}}
+
+	var body bytes.Buffer
+	names := make([]string, 0, len(spec.Components.Schemas))
+	for name := range spec.Components.Schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		g.pending = append(g.pending, namedSchema{name: GoName(name), schema: spec.Components.Schemas[name]})
+	}
+	if err := g.writePending(); err != nil {
+		return nil, err
+	}
+	body.Write(g.b.Bytes())
+	g.b.Reset()
+
+	ops, err := spec.operations()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].path != ops[j].path {
+			return ops[i].path < ops[j].path
+		}
+		return slices.Index(methods, strings.ToLower(ops[i].method)) < slices.Index(methods, strings.ToLower(ops[j].method))
+	})
+	for _, op := range ops {
+		if slices.Contains(cfg.SkipOperations, op.OperationID) {
+			continue
+		}
+		if err := g.writeOperation(op); err != nil {
+			return nil, fmt.Errorf("operation %s: %w", op.OperationID, err)
+		}
+	}
+	// Inline request and response bodies queue structs of their own.
+	if err := g.writePending(); err != nil {
+		return nil, err
+	}
+	body.Write(g.b.Bytes())
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by internal/gen from %s. DO NOT EDIT.\n\n", cfg.Source)
+	fmt.Fprintf(&out, "package %s\n\n", cfg.Package)
+	if len(g.imports) > 0 {
+		imports := make([]string, 0, len(g.imports))
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		out.WriteString("import (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&out, "\t%q\n", imp)
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(body.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %w\n%s", err, out.Bytes())
+	}
+	return src, nil
+}
+
+func (g *generator) writePending() error {
+	for len(g.pending) > 0 {
+		next := g.pending[0]
+		g.pending = g.pending[1:]
+		file, declared := g.cfg.Declared[next.name]
+		if !next.inline && next.schema.Handwritten {
+			if !declared {
+				return fmt.Errorf("schema %s is marked x-handwritten but no hand-written file declares type %s", next.name, next.name)
+			}
+			continue
+		}
+		if declared {
+			return fmt.Errorf("type %s is also declared by hand in %s; delete it there to use the generated type", next.name, file)
+		}
+		if err := g.writeType(next); err != nil {
+			return fmt.Errorf("schema %s: %w", next.name, err)
+		}
+	}
+	return nil
+}
+
+func (g *generator) writeType(n namedSchema) error {
+	name, s := n.name, n.schema
+	writeComment(&g.b, name, s.Description, n.inline)
+	if s.Ref != "" || (s.Type != "object" && s.Type != "") || len(s.Properties) == 0 {
+		typ, err := g.goType(name, s)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&g.b, "type %s %s\n\n", name, typ)
+		return nil
+	}
+
+	props := make([]string, 0, len(s.Properties))
+	for prop := range s.Properties {
+		props = append(props, prop)
+	}
+	sort.Strings(props)
+
+	fmt.Fprintf(&g.b, "type %s struct {\n", name)
+	for _, prop := range props {
+		ps := s.Properties[prop]
+		field := GoName(prop)
+		typ, err := g.goType(name+field, ps)
+		if err != nil {
+			return fmt.Errorf("property %s: %w", prop, err)
+		}
+		tag := prop
+		if !slices.Contains(s.Required, prop) {
+			tag += ",omitempty"
+		}
+		if ps.Nullable && !strings.HasPrefix(typ, "[]") && !strings.HasPrefix(typ, "map[") && typ != "any" {
+			typ = "*" + typ
+		}
+		if ps.Description != "" {
+			for _, line := range strings.Split(strings.TrimSpace(ps.Description), "\n") {
+				fmt.Fprintf(&g.b, "\t// %s\n", strings.TrimSpace(line))
+			}
+		}
+		fmt.Fprintf(&g.b, "\t%s %s `json:%q`\n", field, typ, tag)
+	}
+	g.b.WriteString("}\n\n")
+	return nil
+}
+
+// goType returns the Go type for s. Inline object schemas with properties are queued
+// as a struct named name.
+func (g *generator) goType(name string, s *Schema) (string, error) {
+	if s == nil {
+		return "any", nil
+	}
+	if s.Ref != "" {
+		const prefix = "#/components/schemas/"
+		if !strings.HasPrefix(s.Ref, prefix) {
+			return "", fmt.Errorf("unsupported reference %q", s.Ref)
+		}
+		return GoName(strings.TrimPrefix(s.Ref, prefix)), nil
+	}
+
+	switch s.Type {
+	case "string":
+		switch s.Format {
+		case "date-time":
+			g.imports["time"] = true
+			return "time.Time", nil
+		case "binary":
+			return "[]byte", nil
+		}
+		return "string", nil
+	case "integer":
+		if s.Format == "int64" {
+			return "int64", nil
+		}
+		return "int", nil
+	case "number":
+		return "float64", nil
+	case "boolean":
+		return "bool", nil
+	case "array":
+		elem, err := g.goType(name+"Item", s.Items)
+		if err != nil {
+			return "", err
+		}
+		return "[]" + elem, nil
+	case "object", "":
+		if len(s.Properties) > 0 {
+			g.pending = append(g.pending, namedSchema{name: name, schema: s, inline: true})
+			return name, nil
+		}
+		add, err := s.additional()
+		if err != nil {
+			return "", err
+		}
+		if add == nil && s.Type == "" {
+			return "any", nil
+		}
+		elem, err := g.goType(name+"Value", add)
+		if err != nil {
+			return "", err
+		}
+		return "map[string]" + elem, nil
+	}
+	return "", fmt.Errorf("unsupported schema type %q", s.Type)
+}
+
+func (g *generator) writeOperation(op *Operation) error {
+	name := "api" + GoName(op.OperationID)
+	if file, ok := g.cfg.Declared[name]; ok {
+		return fmt.Errorf("method %s is also declared by hand in %s; delete it there or skip the operation", name, file)
+	}
+	g.imports["context"] = true
+	g.imports["net/http"] = true
+
+	args := []string{"ctx context.Context"}
+	path := fmt.Sprintf("%q", op.path)
+	var hasQuery bool
+	for _, p := range op.Parameters {
+		switch p.In {
+		case "path":
+			arg := lowerFirst(GoName(p.Name))
+			args = append(args, arg+" string")
+			path = strings.Replace(path, "{"+p.Name+"}", `"+`+arg+`+"`, 1)
+		case "query":
+			hasQuery = true
+		}
+	}
+	path = strings.TrimSuffix(strings.TrimPrefix(path, `""+`), `+""`)
+	if strings.Contains(path, "{") {
+		return fmt.Errorf("path %s has parameters that are not declared", op.path)
+	}
+	if hasQuery {
+		g.imports["net/url"] = true
+		args = append(args, "query url.Values")
+		path = "withQuery(" + path + ", nil, query)"
+	}
+
+	bodyArg := "nil"
+	if s := op.RequestBody.jsonSchema(); s != nil {
+		typ, err := g.goType(GoName(op.OperationID)+"Request", s)
+		if err != nil {
+			return fmt.Errorf("request body: %w", err)
+		}
+		args = append(args, "body "+typ)
+		bodyArg = "body"
+	}
+
+	var result *Schema
+	for _, code := range []string{"200", "201", "202"} {
+		if s := op.Responses[code].jsonSchema(); s != nil {
+			result = s
+			break
+		}
+	}
+
+	summary := fmt.Sprintf("calls %s %s.", op.method, op.path)
+	if op.Summary != "" {
+		summary = fmt.Sprintf("calls %s %s: %s", op.method, op.path, strings.TrimSuffix(op.Summary, ".")+".")
+	}
+	fmt.Fprintf(&g.b, "// %s %s\n", name, summary)
+
+	method := "http.Method" + methodConst(op.method)
+	if result == nil {
+		fmt.Fprintf(&g.b, "func (c *Client) %s(%s) error {\n", name, strings.Join(args, ", "))
+		fmt.Fprintf(&g.b, "\treturn c.doJSON(ctx, %s, %s, %s, nil)\n}\n\n", method, path, bodyArg)
+		return nil
+	}
+
+	typ, err := g.goType(GoName(op.OperationID)+"Response", result)
+	if err != nil {
+		return fmt.Errorf("response: %w", err)
+	}
+	ret := typ
+	if !strings.HasPrefix(typ, "[]") && !strings.HasPrefix(typ, "map[") {
+		ret = "*" + typ
+	}
+	fmt.Fprintf(&g.b, "func (c *Client) %s(%s) (%s, error) {\n", name, strings.Join(args, ", "), ret)
+	fmt.Fprintf(&g.b, "\tvar result %s\n", typ)
+	fmt.Fprintf(&g.b, "\tif err := c.doJSON(ctx, %s, %s, %s, &result); err != nil {\n\t\treturn nil, err\n\t}\n", method, path, bodyArg)
+	if ret == typ {
+		g.b.WriteString("\treturn result, nil\n}\n\n")
+	} else {
+		g.b.WriteString("\treturn &result, nil\n}\n\n")
+	}
+	return nil
+}
+
+func methodConst(method string) string {
+	return method[:1] + strings.ToLower(method[1:])
+}
+
+func writeComment(b *bytes.Buffer, name, description string, inline bool) {
+	if inline {
+		fmt.Fprintf(b, "// %s is generated from an inline OpenAPI schema.\n", name)
+	} else {
+		fmt.Fprintf(b, "// %s is generated from the OpenAPI schema of the same name.\n", name)
+	}
+	if description = strings.TrimSpace(description); description != "" {
+		b.WriteString("//\n")
+		for _, line := range strings.Split(description, "\n") {
+			fmt.Fprintf(b, "// %s\n", strings.TrimSpace(line))
+		}
+	}
+}
+
+// initialisms maps words to their spelling in Go names, matching the hand-written client.
+var initialisms = map[string]string{
+	"api": "API", "cidr": "CIDR", "github": "GitHub", "gitlab": "GitLab", "http": "HTTP", "iac": "IAC", "id": "ID",
+	"ip": "IP", "ipv4": "IPv4", "ipv6": "IPv6", "json": "JSON", "sha": "SHA", "sha256": "SHA256", "ssh": "SSH",
+	"url": "URL", "uuid": "UUID", "vcs": "VCS",
+}
+
+// GoName converts an OpenAPI name such as worker_pool_id or getStack to an exported
+// Go identifier such as WorkerPoolID or GetStack. Plurals of initialisms keep a
+// lower-case s: space_ids becomes SpaceIDs.
+func GoName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-' || r == '.' || r == ' '
+	})
+	var b strings.Builder
+	for _, part := range parts {
+		lower := strings.ToLower(part)
+		singular, plural := strings.CutSuffix(lower, "s")
+		switch {
+		case initialisms[lower] != "":
+			b.WriteString(initialisms[lower])
+		case plural && initialisms[singular] != "":
+			b.WriteString(initialisms[singular] + "s")
+		default:
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
+
+func lowerFirst(s string) string {
+	// Leading initialisms are lowered as a whole: ID -> id, VCSID -> vcsID. The longest
+	// match wins, so IPv4CIDR becomes ipv4CIDR rather than ipV4CIDR.
+	var word string
+	for lower, spelled := range initialisms {
+		if len(lower) > len(word) && strings.HasPrefix(s, spelled) &&
+			(len(s) == len(spelled) || s[len(spelled)] >= 'A' && s[len(spelled)] <= 'Z') {
+			word = lower
+		}
+	}
+	if word != "" {
+		return word + s[len(word):]
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
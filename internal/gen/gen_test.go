@@ -0,0 +1,176 @@
+// ABOUTME: Unit tests for the OpenAPI client generator.
+// ABOUTME: Compares generated source for testdata/openapi.json with a golden file; run with -update to rewrite it.
+
+package gen
+
+import (
+	"bytes"
+	"flag"
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite testdata/zz_generated.go.golden")
+
+func TestGenerate_Golden(t *testing.T) {
+	f, err := os.Open("testdata/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close() //nolint:errcheck // best-effort close
+
+	spec, err := Load(f)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	got, err := Generate(spec, Config{Package: "zenfraclient", Source: "testdata/openapi.json"})
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+
+	const golden = "testdata/zz_generated.go.golden"
+	if *update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("generated source differs from %s; run go test ./internal/gen -update and review the diff.\n%s", golden, got)
+	}
+}
+
+func TestGenerate_SkipOperations(t *testing.T) {
+	spec, err := Load(strings.NewReader(`{"openapi": "3.0.0", "paths": {
+		"/a": {"get": {"operationId": "getA"}, "delete": {"operationId": "deleteA"}}}}`))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	got, err := Generate(spec, Config{Package: "p", Source: "test", SkipOperations: []string{"getA"}})
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if strings.Contains(string(got), "apiGetA") || !strings.Contains(string(got), "apiDeleteA") {
+		t.Errorf("expected only apiDeleteA, got:\n%s", got)
+	}
+}
+
+func TestGenerate_Handwritten(t *testing.T) {
+	spec, err := Load(strings.NewReader(`{"openapi": "3.0.0", "components": {"schemas": {
+		"A": {"type": "object", "x-handwritten": true},
+		"B": {"type": "object", "properties": {"a": {"$ref": "#/components/schemas/A"}}}}}}`))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	got, err := Generate(spec, Config{Package: "p", Source: "test", Declared: map[string]string{"A": "types.go"}})
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	if strings.Contains(string(got), "type A ") || !strings.Contains(string(got), "A A `json:\"a,omitempty\"`") {
+		t.Errorf("expected B to reference the hand-written A without generating it, got:\n%s", got)
+	}
+}
+
+func TestGenerate_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		declared map[string]string
+		wantErr  string
+	}{
+		{
+			name:    "undeclared path parameter",
+			doc:     `{"openapi": "3.0.0", "paths": {"/a/{id}": {"get": {"operationId": "getA"}}}}`,
+			wantErr: "parameters that are not declared",
+		},
+		{
+			name:    "external reference",
+			doc:     `{"openapi": "3.0.0", "components": {"schemas": {"A": {"$ref": "other.json#/A"}}}}`,
+			wantErr: "unsupported reference",
+		},
+		{
+			name:     "type declared by hand",
+			doc:      `{"openapi": "3.0.0", "components": {"schemas": {"A": {"type": "object", "properties": {"id": {"type": "string"}}}}}}`,
+			declared: map[string]string{"A": "types.go"},
+			wantErr:  "type A is also declared by hand in types.go",
+		},
+		{
+			name:    "handwritten type not declared",
+			doc:     `{"openapi": "3.0.0", "components": {"schemas": {"A": {"type": "object", "x-handwritten": true}}}}`,
+			wantErr: "schema A is marked x-handwritten but no hand-written file declares type A",
+		},
+		{
+			name:     "method declared by hand",
+			doc:      `{"openapi": "3.0.0", "paths": {"/a": {"delete": {"operationId": "deleteA"}}}}`,
+			declared: map[string]string{"apiDeleteA": "a.go"},
+			wantErr:  "method apiDeleteA is also declared by hand in a.go",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := Load(strings.NewReader(tt.doc))
+			if err != nil {
+				t.Fatalf("Load: %v", err)
+			}
+			_, err = Generate(spec, Config{Package: "p", Source: "test", Declared: tt.declared})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected an error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestDeclared(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"types.go":        "package p\n\ntype Stack struct{}\n\ntype (\n\tRun struct{}\n)\n\nfunc (c *Client) apiGetStack() {}\n\nfunc helper() {}\n",
+		"types_test.go":   "package p\n\ntype fixture struct{}\n",
+		"zz_generated.go": "package p\n\ntype Space struct{}\n",
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := Declared(dir, "zz_generated.go")
+	if err != nil {
+		t.Fatalf("Declared: %v", err)
+	}
+	want := map[string]string{"Stack": "types.go", "Run": "types.go", "apiGetStack": "types.go"}
+	if !maps.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestLoad_RejectsSwagger2(t *testing.T) {
+	if _, err := Load(strings.NewReader(`{"swagger": "2.0"}`)); err == nil {
+		t.Error("expected an error for a Swagger 2.0 document")
+	}
+}
+
+func TestGoName(t *testing.T) {
+	tests := map[string]string{
+		"worker_pool_id":  "WorkerPoolID",
+		"getStack":        "GetStack",
+		"cancel_run":      "CancelRun",
+		"iac":             "IAC",
+		"space_ids":       "SpaceIDs",
+		"ipv4_cidrs":      "IPv4CIDRs",
+		"gitlab":          "GitLab",
+		"sha256":          "SHA256",
+		"status":          "Status",
+		"vcs-integration": "VCSIntegration",
+	}
+	for in, want := range tests {
+		if got := GoName(in); got != want {
+			t.Errorf("GoName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
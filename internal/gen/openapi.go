@@ -0,0 +1,146 @@
+// ABOUTME: The subset of the OpenAPI 3 document model the client generator reads.
+// ABOUTME: Only JSON documents are supported; the published Zenfra spec is served as JSON.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Spec is an OpenAPI 3 document.
+type Spec struct {
+	OpenAPI    string                     `json:"openapi"`
+	Paths      map[string]json.RawMessage `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	} `json:"components"`
+}
+
+// Operation is a single method on a path.
+type Operation struct {
+	OperationID string           `json:"operationId"`
+	Summary     string           `json:"summary"`
+	Parameters  []Parameter      `json:"parameters"`
+	RequestBody *Body            `json:"requestBody"`
+	Responses   map[string]*Body `json:"responses"`
+	method      string           // upper-case HTTP method
+	path        string           // path template, e.g. /api/v1/stacks/{stack_id}
+}
+
+// Parameter is a path or query parameter.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+// Body is a request body or response. Only the application/json content is used.
+type Body struct {
+	Content map[string]struct {
+		Schema *Schema `json:"schema"`
+	} `json:"content"`
+}
+
+func (b *Body) jsonSchema() *Schema {
+	if b == nil {
+		return nil
+	}
+	return b.Content["application/json"].Schema
+}
+
+// Schema is a JSON schema as used by OpenAPI 3.0.
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *Schema            `json:"items"`
+	AdditionalProperties json.RawMessage    `json:"additionalProperties"`
+	Nullable             bool               `json:"nullable"`
+	Enum                 []any              `json:"enum"`
+
+	// Handwritten marks a component schema whose Go type is declared by hand, because
+	// it needs fields or methods the generator cannot express. References to it
+	// resolve to the hand-written type and no type is generated for it.
+	Handwritten bool `json:"x-handwritten"`
+}
+
+// additional returns the schema of an object's additional properties, or nil if the
+// object has none. additionalProperties: true maps to a schema accepting anything.
+func (s *Schema) additional() (*Schema, error) {
+	raw := strings.TrimSpace(string(s.AdditionalProperties))
+	switch raw {
+	case "", "false":
+		return nil, nil
+	case "true", "{}":
+		return &Schema{}, nil
+	}
+	var schema Schema
+	if err := json.Unmarshal(s.AdditionalProperties, &schema); err != nil {
+		return nil, fmt.Errorf("parsing additionalProperties: %w", err)
+	}
+	return &schema, nil
+}
+
+var methods = []string{"get", "put", "post", "patch", "delete"}
+
+// Load parses an OpenAPI document.
+func Load(r io.Reader) (*Spec, error) {
+	var spec Spec
+	if err := json.NewDecoder(r).Decode(&spec); err != nil {
+		return nil, fmt.Errorf("parsing OpenAPI document: %w", err)
+	}
+	if !strings.HasPrefix(spec.OpenAPI, "3.") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %q, expected 3.x", spec.OpenAPI)
+	}
+	return &spec, nil
+}
+
+// operations returns every operation in the document that has an operationId.
+func (s *Spec) operations() ([]*Operation, error) {
+	var ops []*Operation
+	for path, raw := range s.Paths {
+		var item map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return nil, fmt.Errorf("parsing path %s: %w", path, err)
+		}
+
+		// Parameters declared on the path apply to every operation that does not
+		// redeclare them.
+		var shared []Parameter
+		if params, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(params, &shared); err != nil {
+				return nil, fmt.Errorf("parsing parameters of %s: %w", path, err)
+			}
+		}
+
+		for _, method := range methods {
+			body, ok := item[method]
+			if !ok {
+				continue
+			}
+			var op Operation
+			if err := json.Unmarshal(body, &op); err != nil {
+				return nil, fmt.Errorf("parsing %s %s: %w", strings.ToUpper(method), path, err)
+			}
+			if op.OperationID == "" {
+				continue
+			}
+			for _, p := range shared {
+				if !slices.ContainsFunc(op.Parameters, func(q Parameter) bool { return q.In == p.In && q.Name == p.Name }) {
+					op.Parameters = append(op.Parameters, p)
+				}
+			}
+			op.method, op.path = strings.ToUpper(method), path
+			ops = append(ops, &op)
+		}
+	}
+	return ops, nil
+}
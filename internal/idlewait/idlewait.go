@@ -0,0 +1,86 @@
+// ABOUTME: Retry of stack mutations the API rejects with 409 Conflict while a run is in progress.
+// ABOUTME: Shared by zenfra_stack and zenfra_stack_variables through their wait_for_idle settings.
+
+// Package idlewait retries changes to a stack that conflict with one of its runs. While a
+// run is queued or running, the API rejects changes to the stack's source, triggers, and
+// variables with 409 Conflict. With wait_for_idle set, the change is retried once the
+// stack's active runs have finished instead of failing the apply.
+package idlewait
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Attribute names of the settings.
+const (
+	EnabledAttribute = "wait_for_idle"
+	TimeoutAttribute = "idle_timeout_seconds"
+)
+
+// DefaultTimeout is how long a change waits for the stack to become idle when
+// idle_timeout_seconds is not set.
+const DefaultTimeout = 30 * time.Minute
+
+// pollInterval is how often the stack's active runs are checked while waiting.
+const pollInterval = 10 * time.Second
+
+// Client lists and waits out a stack's active runs.
+type Client interface {
+	ListActiveStackRuns(ctx context.Context, stackID string) ([]zenfraclient.ActiveRun, error)
+	WaitForStackIdle(ctx context.Context, stackID string, interval time.Duration) error
+}
+
+// ValidateConfig reports a configured idle_timeout_seconds shorter than a second.
+func ValidateConfig(timeoutSeconds types.Int64, diags *diag.Diagnostics) {
+	if !timeoutSeconds.IsNull() && !timeoutSeconds.IsUnknown() && timeoutSeconds.ValueInt64() < 1 {
+		diags.AddAttributeError(path.Root(TimeoutAttribute), "Invalid Idle Timeout",
+			fmt.Sprintf("%s must be at least 1, got %d.", TimeoutAttribute, timeoutSeconds.ValueInt64()))
+	}
+}
+
+// Do calls fn and returns its error. When enabled is true and fn fails with a conflict
+// while the stack has active runs, Do waits for them to finish and calls fn again,
+// for at most timeoutSeconds (DefaultTimeout if null) in total. A conflict while the
+// stack is idle has another cause and is returned after one more attempt, which covers
+// a run that finished between the conflict and the check.
+func Do(ctx context.Context, client Client, stackID string, enabled types.Bool, timeoutSeconds types.Int64, fn func(ctx context.Context) error) error {
+	err := fn(ctx)
+	if err == nil || !enabled.ValueBool() {
+		return err
+	}
+
+	timeout := DefaultTimeout
+	if !timeoutSeconds.IsNull() && !timeoutSeconds.IsUnknown() {
+		timeout = time.Duration(timeoutSeconds.ValueInt64()) * time.Second
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	retriedIdle := false
+	for zenfraclient.IsConflict(err) {
+		runs, listErr := client.ListActiveStackRuns(waitCtx, stackID)
+		if listErr != nil {
+			return fmt.Errorf("%w (could not check the stack's active runs: %v)", err, listErr)
+		}
+		if len(runs) == 0 {
+			if retriedIdle {
+				return err
+			}
+			retriedIdle = true
+		} else {
+			retriedIdle = false
+			if waitErr := client.WaitForStackIdle(waitCtx, stackID, pollInterval); waitErr != nil {
+				return fmt.Errorf("%w (stack %s did not become idle within %s: %v)", err, stackID, timeout, waitErr)
+			}
+		}
+		err = fn(ctx)
+	}
+	return err
+}
@@ -0,0 +1,118 @@
+// ABOUTME: Unit tests for retrying stack mutations that conflict with an active run.
+// ABOUTME: Uses a stub runs client that reports a fixed number of busy checks; no test talks to the API.
+package idlewait
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// stubRuns reports the stack busy for the first busy checks, and fails the wait when
+// waitErr is set.
+type stubRuns struct {
+	busy    int
+	waitErr error
+	lists   int
+	waits   int
+}
+
+func (s *stubRuns) ListActiveStackRuns(context.Context, string) ([]zenfraclient.ActiveRun, error) {
+	s.lists++
+	if s.lists <= s.busy {
+		return []zenfraclient.ActiveRun{{ID: "run-1", Status: "applying"}}, nil
+	}
+	return nil, nil
+}
+
+func (s *stubRuns) WaitForStackIdle(context.Context, string, time.Duration) error {
+	s.waits++
+	return s.waitErr
+}
+
+var errConflict = &zenfraclient.ConflictError{APIError: zenfraclient.APIError{StatusCode: 409, Message: "a run is in progress"}}
+
+// failing returns fn failing with err for its first n calls, and the number of calls made.
+func failing(n int, err error) (func(context.Context) error, *int) {
+	calls := 0
+	return func(context.Context) error {
+		calls++
+		if calls <= n {
+			return err
+		}
+		return nil
+	}, &calls
+}
+
+func TestDo(t *testing.T) {
+	ctx := context.Background()
+	other := errors.New("boom")
+
+	tests := []struct {
+		name      string
+		enabled   bool
+		client    *stubRuns
+		failures  int
+		err       error
+		wantCalls int
+		wantWaits int
+		wantErr   bool
+	}{
+		{name: "success", enabled: true, client: &stubRuns{}, wantCalls: 1},
+		{name: "disabled", client: &stubRuns{busy: 1}, failures: 1, err: errConflict, wantCalls: 1, wantErr: true},
+		{name: "other error", enabled: true, client: &stubRuns{busy: 1}, failures: 1, err: other, wantCalls: 1, wantErr: true},
+		{name: "retried after run", enabled: true, client: &stubRuns{busy: 1}, failures: 1, err: errConflict, wantCalls: 2, wantWaits: 1},
+		{name: "run finished before check", enabled: true, client: &stubRuns{}, failures: 1, err: errConflict, wantCalls: 2},
+		{name: "conflict while idle", enabled: true, client: &stubRuns{}, failures: 5, err: errConflict, wantCalls: 2, wantErr: true},
+		{name: "wait gives up", enabled: true, client: &stubRuns{busy: 1, waitErr: context.DeadlineExceeded}, failures: 5, err: errConflict, wantCalls: 1, wantWaits: 1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, calls := failing(tt.failures, tt.err)
+			err := Do(ctx, tt.client, "stack-1", types.BoolValue(tt.enabled), types.Int64Null(), fn)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, err)
+			}
+			if err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("expected the mutation's error to be kept, got %v", err)
+			}
+			if *calls != tt.wantCalls || tt.client.waits != tt.wantWaits {
+				t.Errorf("expected %d calls and %d waits, got %d and %d", tt.wantCalls, tt.wantWaits, *calls, tt.client.waits)
+			}
+		})
+	}
+}
+
+func TestDo_WaitErrorNamesTimeout(t *testing.T) {
+	fn, _ := failing(1, errConflict)
+	client := &stubRuns{busy: 1, waitErr: context.DeadlineExceeded}
+	err := Do(context.Background(), client, "stack-1", types.BoolValue(true), types.Int64Value(90), fn)
+	if err == nil || !strings.Contains(err.Error(), "did not become idle within 1m30s") {
+		t.Errorf("expected the configured timeout in the error, got %v", err)
+	}
+}
+
+func TestValidateConfig(t *testing.T) {
+	for _, tt := range []struct {
+		value   types.Int64
+		wantErr bool
+	}{
+		{value: types.Int64Null()},
+		{value: types.Int64Unknown()},
+		{value: types.Int64Value(1)},
+		{value: types.Int64Value(0), wantErr: true},
+	} {
+		var diags diag.Diagnostics
+		ValidateConfig(tt.value, &diags)
+		if diags.HasError() != tt.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tt.value, tt.wantErr, diags)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+// ABOUTME: Shared ImportState helpers that refuse to import objects owned by another organization.
+// ABOUTME: Fetches the object and compares its organization_id with the provider's current organization.
+package importguard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// OrganizationLookup fetches the object with the given ID and returns the ID of the
+// organization that owns it. An empty organization ID means the API does not report
+// ownership for the object, in which case only its existence is checked.
+type OrganizationLookup func(ctx context.Context, id string) (string, error)
+
+// VerifyOrganization fetches the object being imported and checks that it belongs to the
+// organization of the provider's API token. Without this check, importing an ID from
+// another organization succeeds and only fails, confusingly, at the next plan.
+// kind names the object in error messages (e.g. "stack"). It reports whether the import
+// may proceed; on false the reason has been added to diags.
+func VerifyOrganization(ctx context.Context, client zenfraclient.OrganizationAPI, kind, id string, lookup OrganizationLookup, diags *diag.Diagnostics) bool {
+	if client == nil {
+		return true
+	}
+
+	orgID, err := lookup(ctx, id)
+	if err != nil {
+		switch {
+		case zenfraclient.IsNotFound(err):
+			diags.AddError(
+				"Cannot Import Nonexistent Object",
+				fmt.Sprintf("No %s with ID %q exists in the organization the provider is authenticated to. "+
+					"Check the ID, and that api_token belongs to the organization that owns it.", kind, id),
+			)
+		case zenfraclient.IsForbidden(err):
+			diags.AddError(
+				"Cannot Import Inaccessible Object",
+				fmt.Sprintf("The provider's API token is not allowed to read %s %q. "+
+					"It may belong to another organization, or the token may be scoped to other spaces: %s", kind, id, err),
+			)
+		default:
+			diags.AddError(
+				"Error Importing Object",
+				fmt.Sprintf("Could not read %s %q to verify its organization: %s", kind, id, err),
+			)
+		}
+		return false
+	}
+
+	if orgID == "" {
+		return true
+	}
+
+	org, err := client.GetCurrentOrganization(ctx)
+	if err != nil {
+		diags.AddError(
+			"Error Importing Object",
+			fmt.Sprintf("Could not read the current organization to verify %s %q: %s", kind, id, err),
+		)
+		return false
+	}
+
+	if orgID != org.ID {
+		diags.AddError(
+			"Organization Mismatch",
+			fmt.Sprintf("The %s %q belongs to organization %s, but the provider's API token is for organization %s (%s). "+
+				"Import the object with a provider configured for organization %s, or check the ID.",
+				kind, id, orgID, org.ID, org.Name, orgID),
+		)
+		return false
+	}
+
+	return true
+}
+
+// PassthroughID verifies the imported object's organization and then sets attrPath to
+// the import ID, like resource.ImportStatePassthroughID.
+func PassthroughID(ctx context.Context, client zenfraclient.OrganizationAPI, kind string, attrPath path.Path, lookup OrganizationLookup, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !VerifyOrganization(ctx, client, kind, req.ID, lookup, &resp.Diagnostics) {
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, attrPath, req, resp)
+}
+
+// SplitOrganization splits an import ID of the form <organization_id>/<id>, used to
+// import objects from another organization the API token can access. An ID without a
+// "/" has no organization.
+func SplitOrganization(id string) (orgID, objectID string) {
+	if orgID, objectID, ok := strings.Cut(id, "/"); ok {
+		return orgID, objectID
+	}
+	return "", id
+}
+
+// PassthroughOrganizationID is PassthroughID for resources with an organization_id
+// attribute. The import ID is either the object ID or <organization_id>/<id>; the
+// latter verifies and imports the object in that organization and sets organization_id,
+// so later reads act on the same organization.
+func PassthroughOrganizationID(ctx context.Context, client zenfraclient.OrganizationAPI, kind string, lookup OrganizationLookup, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	PassthroughOrganizationAttribute(ctx, client, kind, path.Root("id"), lookup, req, resp)
+}
+
+// PassthroughOrganizationAttribute is PassthroughOrganizationID for resources whose
+// import ID is their parent's ID, such as the stack_id of zenfra_stack_variables. It
+// sets attrPath instead of id.
+func PassthroughOrganizationAttribute(ctx context.Context, client zenfraclient.OrganizationAPI, kind string, attrPath path.Path, lookup OrganizationLookup, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id := SplitOrganization(req.ID)
+	if id == "" || strings.HasPrefix(req.ID, "/") {
+		resp.Diagnostics.AddError("Invalid Import ID",
+			fmt.Sprintf("Expected format: <id> or <organization_id>/<id>, got: %q", req.ID))
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+	if !VerifyOrganization(ctx, client, kind, id, lookup, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+}
+
+// PassthroughOrganizationSingleton imports an organization-wide singleton, such as the
+// run queue settings, whose ID is the ID of the organization that owns it. The import
+// ID may name any organization the API token can access: the check runs in that
+// organization, and both id and organization_id are set to it, so later reads act on
+// the same organization.
+func PassthroughOrganizationSingleton(ctx context.Context, client zenfraclient.OrganizationAPI, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx = zenfraclient.WithOrganization(ctx, req.ID)
+	lookup := func(_ context.Context, id string) (string, error) { return id, nil }
+	if !VerifyOrganization(ctx, client, "organization", req.ID, lookup, &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), req.ID)...)
+}
+
+// StackGetter reads a stack by ID.
+type StackGetter interface {
+	GetStack(ctx context.Context, id string) (*zenfraclient.Stack, error)
+}
+
+// SpaceGetter reads a space by ID.
+type SpaceGetter interface {
+	GetSpace(ctx context.Context, id string) (*zenfraclient.Space, error)
+}
+
+// BundleGetter reads a configuration bundle by ID.
+type BundleGetter interface {
+	GetBundle(ctx context.Context, id string) (*zenfraclient.Bundle, error)
+}
+
+// WorkerPoolGetter reads a worker pool by ID.
+type WorkerPoolGetter interface {
+	GetWorkerPool(ctx context.Context, id string) (*zenfraclient.WorkerPool, error)
+}
+
+// VCSIntegrationGetter reads a VCS integration by ID.
+type VCSIntegrationGetter interface {
+	GetVCSIntegration(ctx context.Context, id string) (*zenfraclient.VCSIntegration, error)
+}
+
+// TokenGetter reads an API token by ID.
+type TokenGetter interface {
+	GetToken(ctx context.Context, id string) (*zenfraclient.Token, error)
+}
+
+// SigningKeyGetter reads a signing key by ID.
+type SigningKeyGetter interface {
+	GetSigningKey(ctx context.Context, id string) (*zenfraclient.SigningKey, error)
+}
+
+// InvitationGetter reads a membership invitation by ID.
+type InvitationGetter interface {
+	GetInvitation(ctx context.Context, id string) (*zenfraclient.Invitation, error)
+}
+
+// OrganizationDomainGetter reads an organization domain by ID.
+type OrganizationDomainGetter interface {
+	GetOrganizationDomain(ctx context.Context, id string) (*zenfraclient.OrganizationDomain, error)
+}
+
+// SecretBackendGetter reads a secret backend by ID.
+type SecretBackendGetter interface {
+	GetSecretBackend(ctx context.Context, id string) (*zenfraclient.SecretBackend, error)
+}
+
+// WebhookEndpointGetter reads a webhook endpoint by ID.
+type WebhookEndpointGetter interface {
+	GetWebhookEndpoint(ctx context.Context, id string) (*zenfraclient.WebhookEndpoint, error)
+}
+
+// RateLimitPolicyGetter reads a rate limit policy by ID.
+type RateLimitPolicyGetter interface {
+	GetRateLimitPolicy(ctx context.Context, id string) (*zenfraclient.RateLimitPolicy, error)
+}
+
+// VariableSetGetter reads a variable set by ID.
+type VariableSetGetter interface {
+	GetVariableSet(ctx context.Context, id string) (*zenfraclient.VariableSet, error)
+}
+
+// Stack looks up the organization of a stack.
+func Stack(client StackGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		stack, err := client.GetStack(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return stack.OrganizationID, nil
+	}
+}
+
+// Space looks up the organization of a space.
+func Space(client SpaceGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		space, err := client.GetSpace(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return space.OrganizationID, nil
+	}
+}
+
+// Bundle looks up the organization of a configuration bundle.
+func Bundle(client BundleGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		bundle, err := client.GetBundle(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return bundle.OrganizationID, nil
+	}
+}
+
+// WorkerPool looks up the organization of a worker pool.
+func WorkerPool(client WorkerPoolGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		pool, err := client.GetWorkerPool(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return pool.OrganizationID, nil
+	}
+}
+
+// VCSIntegration looks up the organization of a VCS integration.
+func VCSIntegration(client VCSIntegrationGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		integration, err := client.GetVCSIntegration(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return integration.OrganizationID, nil
+	}
+}
+
+// Token checks that an API token exists. Tokens do not report their organization, but
+// the API only returns tokens of the caller's organization.
+func Token(client TokenGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		if _, err := client.GetToken(ctx, id); err != nil {
+			return "", err
+		}
+		return "", nil
+	}
+}
+
+// SigningKey looks up the organization of a signing key.
+func SigningKey(client SigningKeyGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		key, err := client.GetSigningKey(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return key.OrganizationID, nil
+	}
+}
+
+// Invitation looks up the organization of a membership invitation.
+func Invitation(client InvitationGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		invitation, err := client.GetInvitation(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return invitation.OrganizationID, nil
+	}
+}
+
+// OrganizationDomain looks up the organization of an organization domain.
+func OrganizationDomain(client OrganizationDomainGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		domain, err := client.GetOrganizationDomain(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return domain.OrganizationID, nil
+	}
+}
+
+// SecretBackend looks up the organization of a secret backend.
+func SecretBackend(client SecretBackendGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		backend, err := client.GetSecretBackend(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return backend.OrganizationID, nil
+	}
+}
+
+// WebhookEndpoint looks up the organization of a webhook endpoint.
+func WebhookEndpoint(client WebhookEndpointGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		endpoint, err := client.GetWebhookEndpoint(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return endpoint.OrganizationID, nil
+	}
+}
+
+// RateLimitPolicy looks up the organization of a rate limit policy.
+func RateLimitPolicy(client RateLimitPolicyGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		policy, err := client.GetRateLimitPolicy(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return policy.OrganizationID, nil
+	}
+}
+
+// VariableSet looks up the organization of a variable set.
+func VariableSet(client VariableSetGetter) OrganizationLookup {
+	return func(ctx context.Context, id string) (string, error) {
+		set, err := client.GetVariableSet(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		return set.OrganizationID, nil
+	}
+}
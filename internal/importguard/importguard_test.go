@@ -0,0 +1,182 @@
+// ABOUTME: Unit tests for the shared organization check run during resource import.
+// ABOUTME: Uses an httptest server standing in for the Zenfra API.
+package importguard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func newTestClient(t *testing.T) *zenfraclient.Client {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	writeJSON := func(w http.ResponseWriter, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	mux.HandleFunc("GET /api/v1/organizations/current", func(w http.ResponseWriter, r *http.Request) {
+		// The token also has access to org-2, selected with the organization header.
+		if r.Header.Get(zenfraclient.OrganizationHeader) == "org-2" {
+			writeJSON(w, zenfraclient.Organization{ID: "org-2", Name: "Globex"})
+			return
+		}
+		writeJSON(w, zenfraclient.Organization{ID: "org-1", Name: "Acme"})
+	})
+	mux.HandleFunc("GET /api/v1/stacks/stack-own", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, zenfraclient.Stack{ID: "stack-own", OrganizationID: "org-1"})
+	})
+	mux.HandleFunc("GET /api/v1/stacks/stack-other", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, zenfraclient.Stack{ID: "stack-other", OrganizationID: "org-2"})
+	})
+	mux.HandleFunc("GET /api/v1/stacks/stack-missing", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not_found","message":"stack not found"}`))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := zenfraclient.NewClient(zenfraclient.ClientConfig{
+		Endpoint:   server.URL,
+		APIToken:   "test-token",
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return client
+}
+
+func TestVerifyOrganization(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		id        string
+		wantOK    bool
+		wantError string
+	}{
+		{name: "same organization", id: "stack-own", wantOK: true},
+		{name: "other organization", id: "stack-other", wantError: "Organization Mismatch"},
+		{name: "not found", id: "stack-missing", wantError: "Cannot Import Nonexistent Object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			ok := VerifyOrganization(ctx, client, "stack", tt.id, Stack(client), &diags)
+			if ok != tt.wantOK {
+				t.Fatalf("expected ok=%v, got %v (diags: %v)", tt.wantOK, ok, diags)
+			}
+			if tt.wantError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantError {
+				t.Errorf("expected %q error, got %v", tt.wantError, diags)
+			}
+		})
+	}
+}
+
+func TestVerifyOrganization_MismatchNamesBothOrganizations(t *testing.T) {
+	client := newTestClient(t)
+
+	var diags diag.Diagnostics
+	VerifyOrganization(context.Background(), client, "stack", "stack-other", Stack(client), &diags)
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+	detail := diags.Errors()[0].Detail()
+	for _, want := range []string{"org-2", "org-1", "Acme"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("expected detail to mention %q, got: %s", want, detail)
+		}
+	}
+}
+
+func TestVerifyOrganization_UnreportedOrganization(t *testing.T) {
+	var diags diag.Diagnostics
+	lookup := func(context.Context, string) (string, error) { return "", nil }
+
+	// The current organization is never fetched when the object does not report one.
+	client, err := zenfraclient.NewClient(zenfraclient.ClientConfig{Endpoint: "http://127.0.0.1:1", APIToken: "test-token"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if !VerifyOrganization(context.Background(), client, "API token", "tok-1", lookup, &diags) {
+		t.Errorf("expected import to proceed, got %v", diags)
+	}
+}
+
+func TestVerifyOrganization_OrganizationOverride(t *testing.T) {
+	client := newTestClient(t)
+	ctx := zenfraclient.WithOrganization(context.Background(), "org-2")
+
+	var diags diag.Diagnostics
+	if !VerifyOrganization(ctx, client, "stack", "stack-other", Stack(client), &diags) {
+		t.Errorf("expected a stack of the selected organization to be importable, got %v", diags)
+	}
+
+	diags = nil
+	if VerifyOrganization(ctx, client, "stack", "stack-own", Stack(client), &diags) {
+		t.Error("expected a stack of the token's own organization to be rejected when another organization is selected")
+	}
+}
+
+func TestPassthroughOrganizationSingleton(t *testing.T) {
+	ctx := context.Background()
+	client := newTestClient(t)
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		"id":              schema.StringAttribute{Computed: true},
+		"organization_id": schema.StringAttribute{Optional: true, Computed: true},
+	}}
+
+	// org-2 is not the token's default organization, but the token has access to it.
+	for _, orgID := range []string{"org-1", "org-2"} {
+		resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}}
+		PassthroughOrganizationSingleton(ctx, client, resource.ImportStateRequest{ID: orgID}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("importing %s: %v", orgID, resp.Diagnostics)
+		}
+		var id, org types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization_id"), &org)...)
+		if id.ValueString() != orgID || org.ValueString() != orgID {
+			t.Errorf("expected id and organization_id %s, got %s and %s", orgID, id, org)
+		}
+	}
+}
+
+func TestSplitOrganization(t *testing.T) {
+	tests := []struct {
+		id, wantOrg, wantID string
+	}{
+		{id: "stack-1", wantID: "stack-1"},
+		{id: "org-2/stack-1", wantOrg: "org-2", wantID: "stack-1"},
+		{id: "org-2/stack-1?include=variables", wantOrg: "org-2", wantID: "stack-1?include=variables"},
+	}
+	for _, tt := range tests {
+		orgID, id := SplitOrganization(tt.id)
+		if orgID != tt.wantOrg || id != tt.wantID {
+			t.Errorf("SplitOrganization(%q) = %q, %q; want %q, %q", tt.id, orgID, id, tt.wantOrg, tt.wantID)
+		}
+	}
+}
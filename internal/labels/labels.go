@@ -0,0 +1,140 @@
+// ABOUTME: Custom list type for labels attributes, whose order carries no meaning to the API.
+// ABOUTME: Semantic equality ignores order, so labels reordered outside Terraform are not a diff.
+
+// Package labels provides the attribute type shared by resources with a labels list.
+// The Zenfra UI and API may return labels in any order; with this type a refresh that
+// only reorders them keeps the configured order in state.
+package labels
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.ListTypable                    = ListType{}
+	_ basetypes.ListValuableWithSemanticEquals = ListValue{}
+)
+
+// ListType is the type of a labels attribute, a list of strings. Use NewListType, as the
+// zero value has no element type.
+type ListType struct {
+	basetypes.ListType
+}
+
+// NewListType returns the type to set as CustomType of a labels list attribute.
+func NewListType() ListType {
+	return ListType{ListType: basetypes.ListType{ElemType: types.StringType}}
+}
+
+func (t ListType) String() string {
+	return "labels.ListType"
+}
+
+func (t ListType) Equal(o attr.Type) bool {
+	other, ok := o.(ListType)
+	if !ok {
+		return false
+	}
+	return t.ListType.Equal(other.ListType)
+}
+
+func (t ListType) ValueFromList(_ context.Context, in basetypes.ListValue) (basetypes.ListValuable, diag.Diagnostics) {
+	return ListValue{ListValue: in}, nil
+}
+
+func (t ListType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.ListType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	listValue, ok := attrValue.(basetypes.ListValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return ListValue{ListValue: listValue}, nil
+}
+
+func (t ListType) ValueType(_ context.Context) attr.Value {
+	return ListValue{ListValue: basetypes.NewListNull(types.StringType)}
+}
+
+// ListValue is a list of labels where ["a", "b"] and ["b", "a"] are equal.
+type ListValue struct {
+	basetypes.ListValue
+}
+
+// NewListNull returns a null ListValue.
+func NewListNull() ListValue {
+	return ListValue{ListValue: basetypes.NewListNull(types.StringType)}
+}
+
+// NewListValue returns a ListValue holding values in the given order, or null if there
+// are none, matching an API that reports no labels for both an empty and an unset list.
+func NewListValue(values []string) ListValue {
+	if len(values) == 0 {
+		return NewListNull()
+	}
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return ListValue{ListValue: basetypes.NewListValueMust(types.StringType, elements)}
+}
+
+func (v ListValue) Type(_ context.Context) attr.Type {
+	return NewListType()
+}
+
+func (v ListValue) Equal(o attr.Value) bool {
+	other, ok := o.(ListValue)
+	if !ok {
+		return false
+	}
+	return v.ListValue.Equal(other.ListValue)
+}
+
+// ListSemanticEquals reports whether both lists hold the same labels, each the same
+// number of times, in any order. An empty list equals a null one. Unknown lists, or lists
+// with unknown labels, are only equal if identical.
+func (v ListValue) ListSemanticEquals(_ context.Context, newValuable basetypes.ListValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ListValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected labels.ListValue, got: %T", newValuable))
+		return false, diags
+	}
+
+	a, okA := sorted(v)
+	b, okB := sorted(newValue)
+	if !okA || !okB {
+		return v.Equal(newValue), diags
+	}
+	return slices.Equal(a, b), diags
+}
+
+// sorted returns the labels of v in sorted order, and false if the list or any of its
+// labels is unknown. A null list has no labels, the same as an empty one.
+func sorted(v ListValue) ([]string, bool) {
+	if v.IsUnknown() {
+		return nil, false
+	}
+	out := make([]string, 0, len(v.Elements()))
+	for _, element := range v.Elements() {
+		s, ok := element.(types.String)
+		if !ok || s.IsUnknown() {
+			return nil, false
+		}
+		out = append(out, s.ValueString())
+	}
+	slices.Sort(out)
+	return out, true
+}
@@ -0,0 +1,57 @@
+// ABOUTME: Unit tests for the order-insensitive labels list type.
+// ABOUTME: Verifies semantic equality across reorderings, duplicates, empty lists, and unknown values.
+package labels
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestListValue_SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+	withUnknown := ListValue{ListValue: basetypes.NewListValueMust(types.StringType, []attr.Value{types.StringValue("aws"), types.StringUnknown()})}
+	emptyList := ListValue{ListValue: basetypes.NewListValueMust(types.StringType, []attr.Value{})}
+
+	tests := []struct {
+		name           string
+		prior, refresh ListValue
+		want           bool
+	}{
+		{name: "same order", prior: NewListValue([]string{"aws", "prod"}), refresh: NewListValue([]string{"aws", "prod"}), want: true},
+		{name: "reordered", prior: NewListValue([]string{"aws", "prod"}), refresh: NewListValue([]string{"prod", "aws"}), want: true},
+		{name: "label added", prior: NewListValue([]string{"aws"}), refresh: NewListValue([]string{"aws", "prod"})},
+		{name: "duplicate differs", prior: NewListValue([]string{"aws", "aws"}), refresh: NewListValue([]string{"aws", "prod"})},
+		{name: "empty and null", prior: emptyList, refresh: NewListNull(), want: true},
+		{name: "unknown label", prior: withUnknown, refresh: NewListValue([]string{"aws", "prod"})},
+		{name: "unknown list", prior: NewListNull(), refresh: ListValue{ListValue: basetypes.NewListUnknown(types.StringType)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.prior.ListSemanticEquals(ctx, tt.refresh)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags.Errors())
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestNewListValue(t *testing.T) {
+	if !NewListValue(nil).IsNull() {
+		t.Error("expected no labels to map to null")
+	}
+	got := NewListValue([]string{"prod", "aws"})
+	if len(got.Elements()) != 2 || got.Elements()[0].(types.String).ValueString() != "prod" {
+		t.Errorf("expected the labels in the given order, got %s", got)
+	}
+	if !got.Type(context.Background()).Equal(NewListType()) {
+		t.Errorf("expected type %s, got %s", NewListType(), got.Type(context.Background()))
+	}
+}
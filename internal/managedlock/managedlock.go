@@ -0,0 +1,87 @@
+// ABOUTME: The managed_exclusively attribute shared by zenfra_space, zenfra_stack, and zenfra_bundle.
+// ABOUTME: Sets the API's managed lock after a write and warns on Read when someone overrode it in the UI.
+
+// Package managedlock maps the API's "managed by Terraform" lock to the
+// managed_exclusively attribute. While the lock is enabled the Zenfra UI refuses edits,
+// so changes only come from the configuration. An organization admin can still
+// override the lock in the UI; Read then reports who did so and when, so the drift
+// that follows has a clear source, and the next apply restores the lock.
+package managedlock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// SetFunc turns the lock of the object with the given ID on or off, such as
+// zenfraclient.Client.SetStackManagedLock.
+type SetFunc func(ctx context.Context, id string, enabled bool) (*zenfraclient.ManagedLock, error)
+
+// Attribute returns the managed_exclusively attribute for a resource managing a kind
+// of object, e.g. "stack".
+func Attribute(kind string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("When true, the %[1]s is locked as managed by Terraform and the Zenfra UI refuses edits to it. "+
+			"An organization admin can override the lock in the UI; the next refresh then warns who did so, and the next "+
+			"apply restores the lock. Defaults to false.", kind),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// Value returns the managed_exclusively value for a lock read from the API, which is
+// nil for an object that has never been locked.
+func Value(lock *zenfraclient.ManagedLock) types.Bool {
+	return types.BoolValue(lock != nil && lock.Enabled)
+}
+
+// Refresh returns the managed_exclusively value for a lock read from the API. When
+// prior, the value in state, is true and an admin has since overridden the lock, it
+// adds a warning naming them, so changes made in the UI are not mistaken for drift of
+// unknown origin.
+func Refresh(lock *zenfraclient.ManagedLock, prior types.Bool, kind, id string, diags *diag.Diagnostics) types.Bool {
+	value := Value(lock)
+	if !prior.ValueBool() || value.ValueBool() || lock == nil || lock.OverriddenBy == "" {
+		return value
+	}
+	when := ""
+	if lock.OverriddenAt != nil {
+		when = " at " + lock.OverriddenAt.UTC().Format(time.RFC3339)
+	}
+	diags.AddWarning(
+		"Managed Lock Overridden",
+		fmt.Sprintf("The managed lock on %s %s was overridden in the Zenfra UI by %s%s. Changes made there since are "+
+			"reported as drift, and the next apply restores the lock.", kind, id, lock.OverriddenBy, when),
+	)
+	return value
+}
+
+// Apply turns the lock of the object on or off to match planned when current, the
+// value the object has now, differs, and returns the managed_exclusively value to
+// store. On failure it adds an error and returns current.
+func Apply(ctx context.Context, set SetFunc, kind, id string, planned, current types.Bool, diags *diag.Diagnostics) types.Bool {
+	if planned.IsUnknown() || planned.ValueBool() == current.ValueBool() {
+		return current
+	}
+	lock, err := set(ctx, id, planned.ValueBool())
+	if err != nil {
+		action := "unlock"
+		if planned.ValueBool() {
+			action = "lock"
+		}
+		diags.AddError(
+			"Error Setting Managed Lock",
+			fmt.Sprintf("Could not %s %s ID %s: %s", action, kind, id, err),
+		)
+		return current
+	}
+	return Value(lock)
+}
@@ -0,0 +1,96 @@
+// ABOUTME: Unit tests for mapping the API's managed lock to managed_exclusively.
+// ABOUTME: Covers the override warning on Read and when Apply calls the API.
+package managedlock
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestRefresh(t *testing.T) {
+	overriddenAt := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	overridden := &zenfraclient.ManagedLock{OverriddenBy: "alice@example.com", OverriddenAt: &overriddenAt}
+
+	tests := []struct {
+		name     string
+		lock     *zenfraclient.ManagedLock
+		prior    types.Bool
+		want     bool
+		warnings int
+	}{
+		{name: "never locked", lock: nil, prior: types.BoolValue(false), want: false},
+		{name: "locked", lock: &zenfraclient.ManagedLock{Enabled: true}, prior: types.BoolValue(true), want: true},
+		{name: "overridden", lock: overridden, prior: types.BoolValue(true), want: false, warnings: 1},
+		{name: "overridden while unmanaged", lock: overridden, prior: types.BoolValue(false), want: false},
+		{name: "overridden before import", lock: overridden, prior: types.BoolNull(), want: false},
+		{name: "unlocked through the API", lock: &zenfraclient.ManagedLock{}, prior: types.BoolValue(true), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := Refresh(tt.lock, tt.prior, "stack", "stack-1", &diags)
+			if got.ValueBool() != tt.want {
+				t.Errorf("Refresh = %v, want %v", got, tt.want)
+			}
+			if diags.WarningsCount() != tt.warnings {
+				t.Fatalf("expected %d warnings, got %v", tt.warnings, diags)
+			}
+			if tt.warnings > 0 {
+				detail := diags.Warnings()[0].Detail()
+				for _, want := range []string{"stack stack-1", "alice@example.com", "2026-10-01T09:30:00Z"} {
+					if !strings.Contains(detail, want) {
+						t.Errorf("warning %q does not mention %q", detail, want)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	var calls []bool
+	set := func(_ context.Context, id string, enabled bool) (*zenfraclient.ManagedLock, error) {
+		if id != "bundle-1" {
+			t.Errorf("unexpected id %q", id)
+		}
+		calls = append(calls, enabled)
+		return &zenfraclient.ManagedLock{Enabled: enabled}, nil
+	}
+
+	var diags diag.Diagnostics
+	got := Apply(context.Background(), set, "bundle", "bundle-1", types.BoolValue(true), types.BoolValue(false), &diags)
+	if !got.ValueBool() || len(calls) != 1 || !calls[0] {
+		t.Errorf("expected the lock to be enabled, got %v after calls %v", got, calls)
+	}
+
+	got = Apply(context.Background(), set, "bundle", "bundle-1", types.BoolValue(true), types.BoolValue(true), &diags)
+	if !got.ValueBool() || len(calls) != 1 {
+		t.Errorf("expected no call for an unchanged lock, got %v after calls %v", got, calls)
+	}
+
+	got = Apply(context.Background(), set, "bundle", "bundle-1", types.BoolValue(false), types.BoolValue(true), &diags)
+	if got.ValueBool() || len(calls) != 2 || calls[1] {
+		t.Errorf("expected the lock to be disabled, got %v after calls %v", got, calls)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	failing := func(context.Context, string, bool) (*zenfraclient.ManagedLock, error) {
+		return nil, errors.New("forbidden")
+	}
+	got = Apply(context.Background(), failing, "bundle", "bundle-1", types.BoolValue(true), types.BoolValue(false), &diags)
+	if got.ValueBool() {
+		t.Error("expected the current value after a failed call")
+	}
+	if diags.ErrorsCount() != 1 || !strings.Contains(diags.Errors()[0].Detail(), "Could not lock bundle ID bundle-1: forbidden") {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
@@ -0,0 +1,342 @@
+// ABOUTME: An in-memory stand-in for the subset of the Zenfra API the provider uses, for local development.
+// ABOUTME: Serves organizations, token permissions, spaces, stacks, worker pools, and bundles with optional latency and 429 injection.
+
+// Package mockserver implements a fake Zenfra API that keeps its state in memory. It
+// answers the routes zenfraclient calls for the core resources closely enough to plan
+// and apply configurations against it, and can inject latency and rate limiting to
+// exercise the client's retry handling. It does not model validation, permissions
+// beyond a single bearer token, or anything run related.
+package mockserver
+
+import (
+	"encoding/json"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Config configures a Server.
+type Config struct {
+	// Token is the API token requests must present. Empty accepts any token.
+	Token string
+
+	// Latency is added before every response.
+	Latency time.Duration
+
+	// RateLimitRate is the fraction of requests, from 0 to 1, answered with 429 Too Many
+	// Requests and a Retry-After of RetryAfter.
+	RateLimitRate float64
+
+	// RetryAfter is the Retry-After sent with injected 429s. It is rounded up to whole
+	// seconds and defaults to one second.
+	RetryAfter time.Duration
+
+	// Now returns the current time for object timestamps. Defaults to time.Now.
+	Now func() time.Time
+}
+
+const organizationID = "org-mock"
+
+// Server is an http.Handler serving the mock API.
+type Server struct {
+	cfg Config
+	mux *http.ServeMux
+
+	mu        sync.Mutex
+	spaces    *collection
+	stacks    *collection
+	pools     *collection
+	bundles   *collection
+	variables map[string][]object // keyed by "spaces/<id>" or "stacks/<id>"
+}
+
+// New returns a Server with an empty organization.
+func New(cfg Config) *Server {
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	if cfg.RetryAfter <= 0 {
+		cfg.RetryAfter = time.Second
+	}
+	s := &Server{
+		cfg:       cfg,
+		mux:       http.NewServeMux(),
+		spaces:    newCollection("space"),
+		stacks:    newCollection("stack"),
+		pools:     newCollection("pool"),
+		bundles:   newCollection("bundle"),
+		variables: map[string][]object{},
+	}
+	s.routes()
+	return s
+}
+
+func (s *Server) routes() {
+	s.mux.HandleFunc("GET /api/v1/organizations/current", s.getOrganization)
+	s.mux.HandleFunc("GET /api/v1/token/permissions", s.getTokenPermissions)
+
+	s.handleCollection("/api/v1/spaces", s.spaces, "items", nil)
+	s.handleVariables("spaces", s.spaces)
+
+	s.handleCollection("/api/v1/stacks", s.stacks, "items", func(obj object) {
+		if _, ok := obj["status"]; !ok {
+			obj["status"] = "ready"
+		}
+	})
+	s.handleVariables("stacks", s.stacks)
+	s.mux.HandleFunc("PUT /api/v1/stacks/{id}/source", s.setStackField("source"))
+	s.mux.HandleFunc("PUT /api/v1/stacks/{id}/triggers", s.setStackField("triggers"))
+	s.mux.HandleFunc("GET /api/v1/stacks/{id}/status", s.getStackHealth)
+
+	s.handleCollection("/api/v1/bundles", s.bundles, "bundles", nil)
+
+	// Worker pools differ from the other collections: create also returns the pool's
+	// API key, and updates use PATCH.
+	s.mux.HandleFunc("POST /api/v1/worker-pools", s.createWorkerPool)
+	s.mux.HandleFunc("GET /api/v1/worker-pools", s.list(s.pools, "pools"))
+	s.mux.HandleFunc("GET /api/v1/worker-pools/{id}", s.get(s.pools))
+	s.mux.HandleFunc("PATCH /api/v1/worker-pools/{id}", s.update(s.pools))
+	s.mux.HandleFunc("DELETE /api/v1/worker-pools/{id}", s.remove(s.pools))
+}
+
+// handleCollection registers create, list, get, update (PUT), and delete for c under
+// base. defaults, if set, fills in fields of a new object the request left out.
+func (s *Server) handleCollection(base string, c *collection, listKey string, defaults func(object)) {
+	s.mux.HandleFunc("POST "+base, s.create(c, defaults))
+	s.mux.HandleFunc("GET "+base, s.list(c, listKey))
+	s.mux.HandleFunc("GET "+base+"/{id}", s.get(c))
+	s.mux.HandleFunc("PUT "+base+"/{id}", s.update(c))
+	s.mux.HandleFunc("DELETE "+base+"/{id}", s.remove(c))
+}
+
+// ServeHTTP applies fault injection and authentication, then serves the route.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.cfg.Latency > 0 {
+		select {
+		case <-time.After(s.cfg.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if s.cfg.RateLimitRate > 0 && rand.Float64() < s.cfg.RateLimitRate {
+		seconds := int((s.cfg.RetryAfter + time.Second - 1) / time.Second)
+		w.Header().Set("Retry-After", strconv.Itoa(seconds))
+		writeError(w, http.StatusTooManyRequests, "rate limit exceeded (injected by the mock server)")
+		return
+	}
+	if s.cfg.Token != "" && r.Header.Get("Authorization") != "Bearer "+s.cfg.Token {
+		writeError(w, http.StatusUnauthorized, "invalid API token")
+		return
+	}
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) getOrganization(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, object{
+		"id":         organizationID,
+		"name":       "Mock Organization",
+		"slug":       "mock",
+		"settings":   object{},
+		"created_at": "2026-01-01T00:00:00Z",
+	})
+}
+
+func (s *Server) getTokenPermissions(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, object{"role": "admin", "manage": []string{"*"}})
+}
+
+func (s *Server) create(c *collection, defaults func(object)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fields, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		if defaults != nil {
+			defaults(fields)
+		}
+		s.mu.Lock()
+		obj := c.create(fields, organizationID, s.cfg.Now())
+		s.mu.Unlock()
+		writeJSON(w, http.StatusCreated, obj)
+	}
+}
+
+// list serves the collection under listKey, filtered by any space_id query parameter.
+func (s *Server) list(c *collection, listKey string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var match func(object) bool
+		if spaceID := r.URL.Query().Get("space_id"); spaceID != "" {
+			match = func(obj object) bool { return obj["space_id"] == spaceID }
+		}
+		s.mu.Lock()
+		items := c.list(match)
+		s.mu.Unlock()
+		writeJSON(w, http.StatusOK, object{listKey: items})
+	}
+}
+
+func (s *Server) get(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		obj, ok := c.get(r.PathValue("id"))
+		s.mu.Unlock()
+		if !ok {
+			writeNotFound(w, c, r.PathValue("id"))
+			return
+		}
+		writeJSON(w, http.StatusOK, obj)
+	}
+}
+
+func (s *Server) update(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		fields, ok := decodeObject(w, r)
+		if !ok {
+			return
+		}
+		s.mu.Lock()
+		obj, ok := c.update(r.PathValue("id"), fields, s.cfg.Now())
+		s.mu.Unlock()
+		if !ok {
+			writeNotFound(w, c, r.PathValue("id"))
+			return
+		}
+		writeJSON(w, http.StatusOK, obj)
+	}
+}
+
+func (s *Server) remove(c *collection) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		s.mu.Lock()
+		ok := c.delete(id)
+		delete(s.variables, c.prefix+"s/"+id)
+		s.mu.Unlock()
+		if !ok {
+			writeNotFound(w, c, id)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) createWorkerPool(w http.ResponseWriter, r *http.Request) {
+	fields, ok := decodeObject(w, r)
+	if !ok {
+		return
+	}
+	fields["key_version"] = 1
+	fields["active"] = true
+	s.mu.Lock()
+	pool := s.pools.create(fields, organizationID, s.cfg.Now())
+	s.mu.Unlock()
+	writeJSON(w, http.StatusCreated, object{"pool": pool, "api_key": "zwp_mock_" + pool["id"].(string)})
+}
+
+// setStackField serves the stack sub-resources that replace one field and return no body.
+func (s *Server) setStackField(field string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var value any
+		if err := json.NewDecoder(r.Body).Decode(&value); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+			return
+		}
+		s.mu.Lock()
+		_, ok := s.stacks.update(r.PathValue("id"), object{field: value}, s.cfg.Now())
+		s.mu.Unlock()
+		if !ok {
+			writeNotFound(w, s.stacks, r.PathValue("id"))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// getStackHealth reports every stack as healthy; the mock server runs nothing that
+// could drift, fail, or lock it.
+func (s *Server) getStackHealth(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	_, ok := s.stacks.get(r.PathValue("id"))
+	s.mu.Unlock()
+	if !ok {
+		writeNotFound(w, s.stacks, r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, object{"status": "ok"})
+}
+
+// handleVariables registers the variables sub-resource of kind ("spaces" or "stacks").
+// Secret values are withheld on the way out, as the real API does.
+func (s *Server) handleVariables(kind string, owners *collection) {
+	serve := func(w http.ResponseWriter, vars []object) {
+		masked := make([]object, 0, len(vars))
+		for _, v := range vars {
+			if secret, _ := v["secret"].(bool); secret {
+				v = object{"key": v["key"], "value": "", "secret": true, "masked": true,
+					"description": v["description"], "sensitive_display": v["sensitive_display"]}
+			}
+			masked = append(masked, v)
+		}
+		writeJSON(w, http.StatusOK, object{"variables": masked})
+	}
+
+	s.mux.HandleFunc("GET /api/v1/"+kind+"/{id}/variables", func(w http.ResponseWriter, r *http.Request) {
+		id := r.PathValue("id")
+		s.mu.Lock()
+		_, ok := owners.get(id)
+		vars := s.variables[kind+"/"+id]
+		s.mu.Unlock()
+		if !ok {
+			writeNotFound(w, owners, id)
+			return
+		}
+		serve(w, vars)
+	})
+
+	s.mux.HandleFunc("PUT /api/v1/"+kind+"/{id}/variables", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables []object `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid JSON body: "+err.Error())
+			return
+		}
+		id := r.PathValue("id")
+		s.mu.Lock()
+		_, ok := owners.get(id)
+		if ok {
+			s.variables[kind+"/"+id] = body.Variables
+		}
+		s.mu.Unlock()
+		if !ok {
+			writeNotFound(w, owners, id)
+			return
+		}
+		serve(w, body.Variables)
+	})
+}
+
+func decodeObject(w http.ResponseWriter, r *http.Request) (object, bool) {
+	var fields object
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil || fields == nil {
+		writeError(w, http.StatusBadRequest, "request body must be a JSON object")
+		return nil, false
+	}
+	return fields, true
+}
+
+func writeNotFound(w http.ResponseWriter, c *collection, id string) {
+	writeError(w, http.StatusNotFound, c.prefix+" "+id+" not found")
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, object{"message": message})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,152 @@
+// ABOUTME: Tests for the mock Zenfra API, driven through zenfraclient so the routes match what the provider calls.
+// ABOUTME: Covers the stack lifecycle, variable masking, worker pool creation, authentication, and fault injection.
+package mockserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func newTestClient(t *testing.T, cfg Config) *zenfraclient.Client {
+	t.Helper()
+	server := httptest.NewServer(New(cfg))
+	t.Cleanup(server.Close)
+
+	c, err := zenfraclient.NewClient(zenfraclient.ClientConfig{
+		Endpoint:   server.URL,
+		APIToken:   "test-token",
+		MaxRetries: 1,
+	})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestStackLifecycle(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := newTestClient(t, Config{Token: "test-token"})
+
+	space, err := c.CreateSpace(ctx, zenfraclient.CreateSpaceRequest{Name: "Platform", Slug: "platform"})
+	if err != nil {
+		t.Fatalf("CreateSpace: %v", err)
+	}
+	stack, err := c.CreateStack(ctx, zenfraclient.CreateStackRequest{
+		SpaceID: space.ID,
+		Name:    "network",
+		IAC:     zenfraclient.IACConfig{Engine: "terraform", Version: "1.6.0"},
+	})
+	if err != nil {
+		t.Fatalf("CreateStack: %v", err)
+	}
+	if stack.OrganizationID != organizationID || stack.Status != zenfraclient.StackStatusReady || stack.CreatedAt.IsZero() {
+		t.Errorf("unexpected server-set fields: %+v", stack)
+	}
+
+	name := "network-v2"
+	if _, err := c.UpdateStack(ctx, stack.ID, zenfraclient.UpdateStackRequest{Name: &name}); err != nil {
+		t.Fatalf("UpdateStack: %v", err)
+	}
+	triggers := zenfraclient.StackTriggers{OnPush: zenfraclient.StackTriggerOnPush{Enabled: true, Branches: []string{"main"}}}
+	if err := c.SetStackTriggers(ctx, stack.ID, triggers); err != nil {
+		t.Fatalf("SetStackTriggers: %v", err)
+	}
+
+	got, err := c.GetStack(ctx, stack.ID)
+	if err != nil {
+		t.Fatalf("GetStack: %v", err)
+	}
+	if got.Name != name || got.IAC.Version != "1.6.0" || !got.Triggers.OnPush.Enabled {
+		t.Errorf("expected the update merged into the stored stack, got %+v", got)
+	}
+
+	listed, err := c.ListStacks(ctx, &zenfraclient.ListStacksOptions{SpaceID: &space.ID})
+	if err != nil || len(listed) != 1 {
+		t.Fatalf("ListStacks: expected one stack, got %v, %v", listed, err)
+	}
+
+	if err := c.DeleteStack(ctx, stack.ID, nil); err != nil {
+		t.Fatalf("DeleteStack: %v", err)
+	}
+	if _, err := c.GetStack(ctx, stack.ID); !zenfraclient.IsNotFound(err) {
+		t.Errorf("expected not found after delete, got %v", err)
+	}
+}
+
+func TestStackVariablesMaskSecrets(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	c := newTestClient(t, Config{})
+
+	stack, err := c.CreateStack(ctx, zenfraclient.CreateStackRequest{SpaceID: "space-1", Name: "app"})
+	if err != nil {
+		t.Fatalf("CreateStack: %v", err)
+	}
+	_, err = c.SetStackVariables(ctx, stack.ID, []zenfraclient.StackVariable{
+		{Key: "REGION", Value: "eu-west-1"},
+		{Key: "DB_PASSWORD", Value: "hunter2", Secret: true},
+	})
+	if err != nil {
+		t.Fatalf("SetStackVariables: %v", err)
+	}
+
+	vars, err := c.GetStackVariables(ctx, stack.ID)
+	if err != nil {
+		t.Fatalf("GetStackVariables: %v", err)
+	}
+	if len(vars) != 2 || vars[0].Value != "eu-west-1" || !vars[1].ValueMasked || vars[1].Value == "hunter2" {
+		t.Errorf("expected the plain value back and the secret masked, got %+v", vars)
+	}
+
+	if _, err := c.GetStackVariables(ctx, "stack-404"); !zenfraclient.IsNotFound(err) {
+		t.Errorf("expected not found for an unknown stack, got %v", err)
+	}
+}
+
+func TestCreateWorkerPool(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, Config{})
+
+	resp, err := c.CreateWorkerPool(context.Background(), zenfraclient.CreateWorkerPoolRequest{Name: "private"})
+	if err != nil {
+		t.Fatalf("CreateWorkerPool: %v", err)
+	}
+	if resp.Pool.ID == "" || resp.Pool.Name != "private" || !resp.Pool.Active || resp.APIKey == "" {
+		t.Errorf("unexpected create response: %+v", resp)
+	}
+}
+
+func TestAuthentication(t *testing.T) {
+	t.Parallel()
+	c := newTestClient(t, Config{Token: "another-token"})
+
+	if _, err := c.GetCurrentOrganization(context.Background()); !zenfraclient.IsUnauthorized(err) {
+		t.Errorf("expected unauthorized for a wrong token, got %v", err)
+	}
+}
+
+func TestFaultInjection(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(New(Config{RateLimitRate: 1, RetryAfter: 1500 * time.Millisecond, Latency: 20 * time.Millisecond}))
+	t.Cleanup(server.Close)
+
+	start := time.Now()
+	resp, err := http.Get(server.URL + "/api/v1/organizations/current")
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests || resp.Header.Get("Retry-After") != "2" {
+		t.Errorf("expected 429 with Retry-After 2, got %d and %q", resp.StatusCode, resp.Header.Get("Retry-After"))
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("expected at least 20ms of injected latency, got %s", elapsed)
+	}
+}
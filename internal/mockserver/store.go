@@ -0,0 +1,88 @@
+// ABOUTME: In-memory object collections backing the mock Zenfra API.
+// ABOUTME: Objects are JSON maps so the mock accepts whatever fields the client sends and echoes them back.
+package mockserver
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+// object is a stored API object as decoded from JSON.
+type object = map[string]any
+
+// collection holds the objects of one kind in creation order.
+type collection struct {
+	prefix  string // ID prefix, e.g. "stack"
+	next    int
+	order   []string
+	objects map[string]object
+}
+
+func newCollection(prefix string) *collection {
+	return &collection{prefix: prefix, objects: map[string]object{}}
+}
+
+// create stores fields as a new object, stamping the ID, organization, and timestamps.
+func (c *collection) create(fields object, orgID string, now time.Time) object {
+	c.next++
+	id := fmt.Sprintf("%s-%d", c.prefix, c.next)
+
+	obj := maps.Clone(fields)
+	obj["id"] = id
+	obj["organization_id"] = orgID
+	obj["created_at"] = now.Format(time.RFC3339)
+	obj["updated_at"] = now.Format(time.RFC3339)
+
+	c.order = append(c.order, id)
+	c.objects[id] = obj
+	return maps.Clone(obj)
+}
+
+func (c *collection) get(id string) (object, bool) {
+	obj, ok := c.objects[id]
+	return maps.Clone(obj), ok
+}
+
+// update replaces the top-level fields present in fields. The client omits fields it
+// does not change, so a merge is what the real API does for PUT and PATCH alike.
+func (c *collection) update(id string, fields object, now time.Time) (object, bool) {
+	obj, ok := c.objects[id]
+	if !ok {
+		return nil, false
+	}
+	for k, v := range fields {
+		switch k {
+		case "id", "organization_id", "created_at":
+			continue
+		}
+		obj[k] = v
+	}
+	obj["updated_at"] = now.Format(time.RFC3339)
+	return maps.Clone(obj), true
+}
+
+func (c *collection) delete(id string) bool {
+	if _, ok := c.objects[id]; !ok {
+		return false
+	}
+	delete(c.objects, id)
+	for i, v := range c.order {
+		if v == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// list returns the objects for which match returns true, oldest first.
+func (c *collection) list(match func(object) bool) []object {
+	items := []object{}
+	for _, id := range c.order {
+		if obj := c.objects[id]; match == nil || match(obj) {
+			items = append(items, maps.Clone(obj))
+		}
+	}
+	return items
+}
@@ -0,0 +1,71 @@
+// ABOUTME: Plan-time checks of payload sizes against the Zenfra API's request limits.
+// ABOUTME: Reports the actual and maximum size on the block at fault instead of the API's generic 413/422.
+
+// Package payloadsize checks the sizes of values the API limits before they are sent.
+// The API rejects an oversized bundle or variable with a generic 413 or 422, which does
+// not say which value is too large; checking at plan time points at the block at fault
+// and reports its size next to the limit.
+package payloadsize
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Format renders a size of n bytes in the largest binary unit it fills, e.g. "1.5 MiB".
+func Format(n int) string {
+	switch {
+	case n >= 1<<20:
+		return trimUnit(float64(n)/(1<<20), "MiB")
+	case n >= 1<<10:
+		return trimUnit(float64(n)/(1<<10), "KiB")
+	}
+	return fmt.Sprintf("%d B", n)
+}
+
+// trimUnit formats v with one decimal, dropping it for whole numbers.
+func trimUnit(v float64, unit string) string {
+	s := fmt.Sprintf("%.1f", v)
+	if len(s) > 2 && s[len(s)-2:] == ".0" {
+		s = s[:len(s)-2]
+	}
+	return s + " " + unit
+}
+
+// CheckVariables adds an error on each variable block in blocks, the set at root, whose
+// value exceeds zenfraclient.MaxVariableValueBytes. Unknown values are skipped, since
+// they are checked again once known.
+func CheckVariables(root path.Path, blocks types.Set, diags *diag.Diagnostics) {
+	if blocks.IsNull() || blocks.IsUnknown() {
+		return
+	}
+	for _, element := range blocks.Elements() {
+		obj, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		key, _ := obj.Attributes()["key"].(types.String)
+		value, ok := obj.Attributes()["value"].(types.String)
+		if !ok {
+			continue
+		}
+		CheckValue(root.AtSetValue(element), key.ValueString(), value, diags)
+	}
+}
+
+// CheckValue adds an error at attrPath if value, the value of the variable named key,
+// exceeds zenfraclient.MaxVariableValueBytes. A null or unknown value is skipped.
+func CheckValue(attrPath path.Path, key string, value types.String, diags *diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+	if size := len(value.ValueString()); size > zenfraclient.MaxVariableValueBytes {
+		diags.AddAttributeError(attrPath, "Variable Value Too Large",
+			fmt.Sprintf("The value of variable %s is %s, more than the %s the API accepts for a variable value.",
+				key, Format(size), Format(zenfraclient.MaxVariableValueBytes)))
+	}
+}
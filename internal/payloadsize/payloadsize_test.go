@@ -0,0 +1,51 @@
+// ABOUTME: Unit tests for payload size formatting and the variable value size check.
+// ABOUTME: Builds variable block sets directly; no test talks to the API.
+package payloadsize
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestFormat(t *testing.T) {
+	for n, want := range map[int]string{
+		512:                                "512 B",
+		2048:                               "2 KiB",
+		zenfraclient.MaxVariableValueBytes: "256 KiB",
+		zenfraclient.MaxBundleContentBytes: "1 MiB",
+		zenfraclient.MaxBundleContentBytes * 3 / 2: "1.5 MiB",
+	} {
+		if got := Format(n); got != want {
+			t.Errorf("Format(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestCheckVariables(t *testing.T) {
+	attrTypes := map[string]attr.Type{"key": types.StringType, "value": types.StringType, "secret": types.BoolType}
+	variable := func(key string, value types.String) attr.Value {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"key": types.StringValue(key), "value": value, "secret": types.BoolValue(true),
+		})
+	}
+	blocks := types.SetValueMust(types.ObjectType{AttrTypes: attrTypes}, []attr.Value{
+		variable("SMALL", types.StringValue("ok")),
+		variable("CERT_BUNDLE", types.StringValue(strings.Repeat("x", zenfraclient.MaxVariableValueBytes+1024))),
+		variable("PENDING", types.StringUnknown()),
+	})
+
+	var diags diag.Diagnostics
+	CheckVariables(path.Root("variable"), blocks, &diags)
+	if diags.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %v", diags)
+	}
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "CERT_BUNDLE is 257 KiB, more than the 256 KiB") {
+		t.Errorf("unexpected detail: %s", detail)
+	}
+}
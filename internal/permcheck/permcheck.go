@@ -0,0 +1,103 @@
+// ABOUTME: Plan-time check that the provider may manage the resources a plan changes.
+// ABOUTME: Fails the plan in read-only mode or when it destroys a protected type, and warns instead of letting apply fail with a 403 halfway through.
+
+// Package permcheck compares the changes of a plan with the permissions of the
+// provider's API token. The permissions are read once per provider run, so checking
+// every planned resource costs no extra API calls. Only a role that is known to lack a
+// permission produces a warning: when the permissions cannot be read, for example from
+// an API that predates the permissions endpoint, the plan is left alone and apply
+// reports any 403 as before.
+//
+// A provider configured with read_only = true may not manage anything, so every change
+// fails the plan, whatever the token's role. A resource type listed in
+// protect_resource_types may not be destroyed, including by replacement, unless
+// allow_protected_destroy overrides the protection for the run.
+package permcheck
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Permissions reads the token's permissions and the provider's read-only and destroy
+// protection settings; every resource client implements it.
+type Permissions interface {
+	GetTokenPermissionsCached(ctx context.Context) (*zenfraclient.TokenPermissions, error)
+	IsReadOnly() bool
+	IsDestroyProtected(typeName string) bool
+}
+
+// Check inspects a plan that creates, updates, or deletes the resource. It adds an
+// error to resp if the provider is read-only or the plan destroys a protected resource
+// type, and a warning if the token may not manage objects of kind. typeName is the
+// resource type the diagnostics name, e.g. "zenfra_worker_pool", and kind is the object
+// kind the token's permissions list, e.g. "worker_pool".
+func Check(ctx context.Context, client Permissions, typeName, kind string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	if client.IsReadOnly() {
+		resp.Diagnostics.AddError(
+			"Provider Is Read-Only",
+			fmt.Sprintf("The provider is configured with read_only = true, so it cannot %s this %s. "+
+				"Reads and plans without changes still work; unset read_only (or ZENFRA_READ_ONLY) to apply changes.", action(req), typeName),
+		)
+		return
+	}
+
+	if act := destroyAction(req, resp); act != "" && client.IsDestroyProtected(typeName) {
+		resp.Diagnostics.AddError(
+			"Resource Type Is Protected",
+			fmt.Sprintf("The provider is configured to protect %s with protect_resource_types, so this plan cannot %s it. "+
+				"If destroying it is intended, plan and apply with allow_protected_destroy = true "+
+				"(or ZENFRA_ALLOW_PROTECTED_DESTROY=true).", typeName, act),
+		)
+		return
+	}
+
+	permissions, err := client.GetTokenPermissionsCached(ctx)
+	if err != nil || permissions.CanManage(kind) {
+		return
+	}
+
+	role := permissions.Role
+	if role == "" {
+		role = "(unnamed)"
+	}
+	resp.Diagnostics.AddWarning(
+		"Insufficient API Token Permissions",
+		fmt.Sprintf("Token role %s cannot manage %s, so applying this change will fail with a permission error (HTTP 403). "+
+			"Use an API token whose role may manage %s objects, or leave this resource unchanged.", role, typeName, kind),
+	)
+}
+
+// action names what the plan does to the resource.
+func action(req resource.ModifyPlanRequest) string {
+	switch {
+	case req.State.Raw.IsNull():
+		return "create"
+	case req.Plan.Raw.IsNull():
+		return "delete"
+	default:
+		return "update"
+	}
+}
+
+// destroyAction names how the plan destroys the existing object, by deleting it or by
+// replacing it with a new one, or returns "" if it keeps the object.
+func destroyAction(req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) string {
+	switch {
+	case req.State.Raw.IsNull():
+		return ""
+	case req.Plan.Raw.IsNull():
+		return "delete"
+	case len(resp.RequiresReplace) > 0:
+		return "replace"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,203 @@
+// ABOUTME: Unit tests for the plan-time read-only, destroy protection, and token permission checks.
+// ABOUTME: Uses a one-attribute schema and a stub permission source; no test talks to the API.
+package permcheck
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type permissionsFunc func(ctx context.Context) (*zenfraclient.TokenPermissions, error)
+
+func (f permissionsFunc) GetTokenPermissionsCached(ctx context.Context) (*zenfraclient.TokenPermissions, error) {
+	return f(ctx)
+}
+
+func (f permissionsFunc) IsReadOnly() bool { return false }
+
+func (f permissionsFunc) IsDestroyProtected(string) bool { return false }
+
+// readOnly is a read-only provider whose token could manage everything.
+type readOnly struct{ permissionsFunc }
+
+func (readOnly) IsReadOnly() bool { return true }
+
+// protected protects zenfra_stack from destruction; its token could manage everything.
+type protected struct{ permissionsFunc }
+
+func (protected) IsDestroyProtected(typeName string) bool { return typeName == "zenfra_stack" }
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Required: true}}}
+	objType := s.Type().TerraformType(ctx)
+	value := func(name *string) tftypes.Value {
+		if name == nil {
+			return tftypes.NewValue(objType, nil)
+		}
+		return tftypes.NewValue(objType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, *name)})
+	}
+	a, b := "a", "b"
+
+	reader := permissionsFunc(func(context.Context) (*zenfraclient.TokenPermissions, error) {
+		return &zenfraclient.TokenPermissions{Role: "reader", Manage: []string{"stack"}}, nil
+	})
+	admin := permissionsFunc(func(context.Context) (*zenfraclient.TokenPermissions, error) {
+		return &zenfraclient.TokenPermissions{Role: "admin", Manage: []string{"*"}}, nil
+	})
+	unavailable := permissionsFunc(func(context.Context) (*zenfraclient.TokenPermissions, error) {
+		return nil, errors.New("not found")
+	})
+
+	tests := []struct {
+		name        string
+		client      Permissions
+		prior, plan *string
+		want        bool
+	}{
+		{name: "create", client: reader, plan: &a, want: true},
+		{name: "update", client: reader, prior: &a, plan: &b, want: true},
+		{name: "delete", client: reader, prior: &a, want: true},
+		{name: "no change", client: reader, prior: &a, plan: &a},
+		{name: "wildcard", client: admin, prior: &a, plan: &b},
+		{name: "permissions unavailable", client: unavailable, plan: &a},
+		{name: "unconfigured", client: nil, plan: &a},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: value(tt.plan)},
+				State: tfsdk.State{Schema: s, Raw: value(tt.prior)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			Check(ctx, tt.client, "zenfra_worker_pool", "worker_pool", req, resp)
+
+			warnings := resp.Diagnostics.Warnings()
+			if got := len(warnings) == 1; got != tt.want || len(warnings) > 1 {
+				t.Fatalf("expected warning %v, got %v", tt.want, resp.Diagnostics)
+			}
+			if tt.want && !strings.HasPrefix(warnings[0].Detail(), "Token role reader cannot manage zenfra_worker_pool,") {
+				t.Errorf("unexpected detail %q", warnings[0].Detail())
+			}
+		})
+	}
+}
+
+func TestCheck_ReadOnly(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Required: true}}}
+	objType := s.Type().TerraformType(ctx)
+	value := func(name *string) tftypes.Value {
+		if name == nil {
+			return tftypes.NewValue(objType, nil)
+		}
+		return tftypes.NewValue(objType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, *name)})
+	}
+	a, b := "a", "b"
+
+	client := readOnly{permissionsFunc(func(context.Context) (*zenfraclient.TokenPermissions, error) {
+		return &zenfraclient.TokenPermissions{Role: "admin", Manage: []string{"*"}}, nil
+	})}
+
+	tests := []struct {
+		name        string
+		prior, plan *string
+		wantAction  string
+	}{
+		{name: "create", plan: &a, wantAction: "create"},
+		{name: "update", prior: &a, plan: &b, wantAction: "update"},
+		{name: "delete", prior: &a, wantAction: "delete"},
+		{name: "no change", prior: &a, plan: &a},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: value(tt.plan)},
+				State: tfsdk.State{Schema: s, Raw: value(tt.prior)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			Check(ctx, client, "zenfra_worker_pool", "worker_pool", req, resp)
+
+			if tt.wantAction == "" {
+				if len(resp.Diagnostics) != 0 {
+					t.Fatalf("expected no diagnostics, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 || len(resp.Diagnostics) != 1 {
+				t.Fatalf("expected one error, got %v", resp.Diagnostics)
+			}
+			if !strings.Contains(errs[0].Detail(), "cannot "+tt.wantAction+" this zenfra_worker_pool") {
+				t.Errorf("unexpected detail %q", errs[0].Detail())
+			}
+		})
+	}
+}
+
+func TestCheck_DestroyProtected(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Required: true}}}
+	objType := s.Type().TerraformType(ctx)
+	value := func(name *string) tftypes.Value {
+		if name == nil {
+			return tftypes.NewValue(objType, nil)
+		}
+		return tftypes.NewValue(objType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, *name)})
+	}
+	a, b := "a", "b"
+
+	client := protected{permissionsFunc(func(context.Context) (*zenfraclient.TokenPermissions, error) {
+		return &zenfraclient.TokenPermissions{Role: "admin", Manage: []string{"*"}}, nil
+	})}
+
+	tests := []struct {
+		name        string
+		typeName    string
+		prior, plan *string
+		replace     bool
+		wantAction  string
+	}{
+		{name: "delete", typeName: "zenfra_stack", prior: &a, wantAction: "delete"},
+		{name: "replace", typeName: "zenfra_stack", prior: &a, plan: &b, replace: true, wantAction: "replace"},
+		{name: "update", typeName: "zenfra_stack", prior: &a, plan: &b},
+		{name: "create", typeName: "zenfra_stack", plan: &a},
+		{name: "unprotected delete", typeName: "zenfra_space", prior: &a},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: value(tt.plan)},
+				State: tfsdk.State{Schema: s, Raw: value(tt.prior)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			if tt.replace {
+				resp.RequiresReplace = path.Paths{path.Root("name")}
+			}
+			Check(ctx, client, tt.typeName, "stack", req, resp)
+
+			if tt.wantAction == "" {
+				if len(resp.Diagnostics) != 0 {
+					t.Fatalf("expected no diagnostics, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != 1 || len(resp.Diagnostics) != 1 {
+				t.Fatalf("expected one error, got %v", resp.Diagnostics)
+			}
+			if !strings.Contains(errs[0].Detail(), "cannot "+tt.wantAction+" it") {
+				t.Errorf("unexpected detail %q", errs[0].Detail())
+			}
+		})
+	}
+}
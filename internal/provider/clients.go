@@ -0,0 +1,38 @@
+// ABOUTME: Tracks the API clients created by Configure so they can be closed when the plugin server stops.
+// ABOUTME: Closing a client flushes and closes the ZENFRA_RECORD cassette it writes to.
+package provider
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// clients are the API clients of every Configure call in the plugin process. Aliased
+// providers each configure their own.
+var (
+	clientsMu sync.Mutex
+	clients   []*zenfraclient.Client
+)
+
+// trackClient records a client for CloseClients.
+func trackClient(client *zenfraclient.Client) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+	clients = append(clients, client)
+}
+
+// CloseClients closes the API clients the provider created, so a recording enabled by
+// ZENFRA_RECORD is complete on disk. Call it once the plugin server has stopped.
+func CloseClients() error {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	var errs []error
+	for _, client := range clients {
+		errs = append(errs, client.Close())
+	}
+	clients = nil
+	return errors.Join(errs...)
+}
@@ -1,37 +1,94 @@
 // ABOUTME: Defines the ZenfraProvider implementing the Terraform Plugin Framework provider interface.
-// ABOUTME: Configures endpoint and api_token, creates zenfraclient.Client for resource and data source use.
+// ABOUTME: Configures endpoint or region, api_token, User-Agent suffix, and extra headers, optionally validates credentials, and creates the zenfraclient.Client.
 package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/semaphore"
+	"go.opentelemetry.io/otel/trace"
 
+	dsAPIToken "github.com/zenfra/terraform-provider-zenfra/internal/datasource/api_token"
+	dsBundle "github.com/zenfra/terraform-provider-zenfra/internal/datasource/bundle"
+	dsComplianceReport "github.com/zenfra/terraform-provider-zenfra/internal/datasource/compliance_report"
 	dsCurrentOrg "github.com/zenfra/terraform-provider-zenfra/internal/datasource/current_organization"
+	dsEgressIPRange "github.com/zenfra/terraform-provider-zenfra/internal/datasource/egress_ip_range"
+	dsIACVersion "github.com/zenfra/terraform-provider-zenfra/internal/datasource/iac_version"
+	dsImportPlan "github.com/zenfra/terraform-provider-zenfra/internal/datasource/import_plan"
+	dsRateLimitPolicy "github.com/zenfra/terraform-provider-zenfra/internal/datasource/rate_limit_policy"
+	dsRunCostEstimate "github.com/zenfra/terraform-provider-zenfra/internal/datasource/run_cost_estimate"
+	dsRunLogs "github.com/zenfra/terraform-provider-zenfra/internal/datasource/run_logs"
+	dsRunPlan "github.com/zenfra/terraform-provider-zenfra/internal/datasource/run_plan"
+	dsSigningKey "github.com/zenfra/terraform-provider-zenfra/internal/datasource/signing_key"
 	dsSpace "github.com/zenfra/terraform-provider-zenfra/internal/datasource/space"
 	dsStack "github.com/zenfra/terraform-provider-zenfra/internal/datasource/stack"
+	dsStackDependency "github.com/zenfra/terraform-provider-zenfra/internal/datasource/stack_dependency"
+	dsStackPolicyCheck "github.com/zenfra/terraform-provider-zenfra/internal/datasource/stack_policy_check"
+	dsStackTemplate "github.com/zenfra/terraform-provider-zenfra/internal/datasource/stack_template"
+	dsStateSnapshot "github.com/zenfra/terraform-provider-zenfra/internal/datasource/state_snapshot"
+	dsUsage "github.com/zenfra/terraform-provider-zenfra/internal/datasource/usage"
 	dsVCS "github.com/zenfra/terraform-provider-zenfra/internal/datasource/vcs_integration"
+	dsWebhookEndpoint "github.com/zenfra/terraform-provider-zenfra/internal/datasource/webhook_endpoint"
 	dsWorkerPool "github.com/zenfra/terraform-provider-zenfra/internal/datasource/worker_pool"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerfunc"
 	resAPIToken "github.com/zenfra/terraform-provider-zenfra/internal/resource/api_token"
 	resBundle "github.com/zenfra/terraform-provider-zenfra/internal/resource/bundle"
 	resBundleAttachment "github.com/zenfra/terraform-provider-zenfra/internal/resource/bundle_attachment"
+	resBundleSecretRef "github.com/zenfra/terraform-provider-zenfra/internal/resource/bundle_secret_reference"
+	resVariableSet "github.com/zenfra/terraform-provider-zenfra/internal/resource/environment_variable_set"
+	resVariableSetScope "github.com/zenfra/terraform-provider-zenfra/internal/resource/environment_variable_set_scope"
+	resVariableSetVariable "github.com/zenfra/terraform-provider-zenfra/internal/resource/environment_variable_set_variable"
+	resMembershipInvitation "github.com/zenfra/terraform-provider-zenfra/internal/resource/membership_invitation"
+	resOrganizationDomain "github.com/zenfra/terraform-provider-zenfra/internal/resource/organization_domain"
+	resOrganizationDomainVerification "github.com/zenfra/terraform-provider-zenfra/internal/resource/organization_domain_verification"
+	resOutputSubscription "github.com/zenfra/terraform-provider-zenfra/internal/resource/output_subscription"
+	resRateLimitPolicy "github.com/zenfra/terraform-provider-zenfra/internal/resource/rate_limit_policy"
+	resRetentionSettings "github.com/zenfra/terraform-provider-zenfra/internal/resource/retention_settings"
+	resRunComment "github.com/zenfra/terraform-provider-zenfra/internal/resource/run_comment"
+	resRunQueueSettings "github.com/zenfra/terraform-provider-zenfra/internal/resource/run_queue_settings"
+	resRunnerVersionConstraint "github.com/zenfra/terraform-provider-zenfra/internal/resource/runner_version_constraint"
+	resSecretBackend "github.com/zenfra/terraform-provider-zenfra/internal/resource/secret_backend"
+	resSigningKey "github.com/zenfra/terraform-provider-zenfra/internal/resource/signing_key"
 	resSpace "github.com/zenfra/terraform-provider-zenfra/internal/resource/space"
+	resSpaceBundleAttachment "github.com/zenfra/terraform-provider-zenfra/internal/resource/space_bundle_attachment"
+	resSpaceVars "github.com/zenfra/terraform-provider-zenfra/internal/resource/space_variables"
 	resStack "github.com/zenfra/terraform-provider-zenfra/internal/resource/stack"
+	resStackFromManifest "github.com/zenfra/terraform-provider-zenfra/internal/resource/stack_from_manifest"
 	resStackVars "github.com/zenfra/terraform-provider-zenfra/internal/resource/stack_variables"
+	resStateRollback "github.com/zenfra/terraform-provider-zenfra/internal/resource/state_rollback"
 	resVCS "github.com/zenfra/terraform-provider-zenfra/internal/resource/vcs_integration"
+	resWebhookSecretRotation "github.com/zenfra/terraform-provider-zenfra/internal/resource/webhook_secret_rotation"
 	resWorkerPool "github.com/zenfra/terraform-provider-zenfra/internal/resource/worker_pool"
+	resWorkerPoolAssignment "github.com/zenfra/terraform-provider-zenfra/internal/resource/worker_pool_assignment"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 const defaultEndpoint = "https://api.zenfra.cloud"
 
-// Ensure ZenfraProvider satisfies the provider.Provider interface.
-var _ provider.Provider = &ZenfraProvider{}
+// discoverEndpoint is replaced in tests to avoid network access.
+var discoverEndpoint = zenfraclient.DiscoverEndpoint
+
+// Ensure ZenfraProvider satisfies the provider interfaces.
+var (
+	_ provider.Provider              = &ZenfraProvider{}
+	_ provider.ProviderWithFunctions = &ZenfraProvider{}
+)
 
 // ZenfraProvider defines the provider implementation.
 type ZenfraProvider struct {
@@ -40,8 +97,29 @@ type ZenfraProvider struct {
 
 // ZenfraProviderModel describes the provider data model.
 type ZenfraProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	APIToken types.String `tfsdk:"api_token"`
+	Endpoint       types.String `tfsdk:"endpoint"`
+	Region         types.String `tfsdk:"region"`
+	APIToken       types.String `tfsdk:"api_token"`
+	UserAgentExtra types.String `tfsdk:"user_agent_extra"`
+	Workspace      types.String `tfsdk:"workspace"`
+	ExtraHeaders   types.Map    `tfsdk:"extra_headers"`
+
+	TreatForbiddenAsNotFound types.Bool `tfsdk:"treat_forbidden_as_not_found"`
+	ValidateCredentials      types.Bool `tfsdk:"validate_credentials"`
+	EnableTracing            types.Bool `tfsdk:"enable_tracing"`
+	DisableTelemetry         types.Bool `tfsdk:"disable_telemetry"`
+	BulkRefresh              types.Bool `tfsdk:"bulk_refresh"`
+	ReadOnly                 types.Bool `tfsdk:"read_only"`
+
+	ProtectResourceTypes  types.Set  `tfsdk:"protect_resource_types"`
+	AllowProtectedDestroy types.Bool `tfsdk:"allow_protected_destroy"`
+
+	MaxIdleConnsPerHost    types.Int64 `tfsdk:"max_idle_conns_per_host"`
+	IdleConnTimeoutSeconds types.Int64 `tfsdk:"idle_conn_timeout_seconds"`
+	DisableKeepAlives      types.Bool  `tfsdk:"disable_keep_alives"`
+	DisableHTTP2           types.Bool  `tfsdk:"disable_http2"`
+
+	MaxConcurrentOperations types.Int64 `tfsdk:"max_concurrent_operations"`
 }
 
 // New returns a provider.Provider constructor function.
@@ -63,14 +141,108 @@ func (p *ZenfraProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 		Description: "The Zenfra provider enables managing infrastructure-as-code resources on the Zenfra platform.",
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				Description: "The Zenfra API endpoint URL. Defaults to https://api.zenfra.cloud. Can be set via ZENFRA_API_ENDPOINT environment variable.",
-				Optional:    true,
+				Description: "The Zenfra API endpoint URL. Defaults to https://api.zenfra.cloud, or to the endpoint of region when that is set. Takes precedence over region. " +
+					"Can be set via ZENFRA_API_ENDPOINT environment variable.",
+				Optional: true,
+			},
+			"region": schema.StringAttribute{
+				Description: fmt.Sprintf("The Zenfra region to connect to, one of %s. The provider discovers the region's API endpoint from its /.well-known/zenfra.json document. "+
+					"Ignored when endpoint is set. Can be set via ZENFRA_REGION environment variable.", strings.Join(zenfraclient.Regions(), ", ")),
+				Optional: true,
 			},
 			"api_token": schema.StringAttribute{
 				Description: "The API token for authenticating with the Zenfra API. Can be set via ZENFRA_API_TOKEN environment variable.",
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"user_agent_extra": schema.StringAttribute{
+				Description: "Optional text appended to the User-Agent header sent to the Zenfra API, e.g. to identify the pipeline making changes in the API audit log. Can be set via ZENFRA_USER_AGENT_EXTRA environment variable.",
+				Optional:    true,
+			},
+			"workspace": schema.StringAttribute{
+				Description: "Name of the Terraform workspace or pipeline applying this configuration, sent with the resource type in the X-Zenfra-Managed-By header " +
+					"of every API call (terraform/<workspace>/<resource type>), so the API audit log shows which configuration and resource type made each change. " +
+					"Set it to terraform.workspace or another stable name. Can be set via ZENFRA_WORKSPACE environment variable; falls back to TF_WORKSPACE, then \"default\".",
+				Optional: true,
+			},
+			"extra_headers": schema.MapAttribute{
+				Description: "Additional HTTP headers sent on every request to the Zenfra API, keyed by header name, e.g. the CF-Access-Client-Id and CF-Access-Client-Secret " +
+					"headers of a Cloudflare Access service token in front of a self-hosted API, or tracing headers. Cannot override Authorization, User-Agent, Content-Type, Accept, or X-Zenfra-Managed-By. " +
+					"Can be set via ZENFRA_EXTRA_HEADERS environment variable as a JSON object.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"treat_forbidden_as_not_found": schema.BoolAttribute{
+				Description: "When true, resources the API token is not permitted to read (HTTP 403) are removed from state on refresh, as if they had been deleted. " +
+					"Useful for tokens with partial visibility of the organization. Defaults to false, which fails the refresh. Can be set via ZENFRA_TREAT_FORBIDDEN_AS_NOT_FOUND environment variable.",
+				Optional: true,
+			},
+			"validate_credentials": schema.BoolAttribute{
+				Description: "When true, the provider reads the current organization while it is configured, so a wrong endpoint or an invalid, expired, or revoked API token " +
+					"is reported once against the provider configuration instead of on the first resource operation. Defaults to false. Can be set via ZENFRA_VALIDATE_CREDENTIALS environment variable.",
+				Optional: true,
+			},
+			"enable_tracing": schema.BoolAttribute{
+				Description: "When true, every Zenfra API call is recorded as an OpenTelemetry span and its trace context is sent to the API in the traceparent header. " +
+					"Spans are exported over OTLP/HTTP as configured by the standard OTEL_EXPORTER_OTLP_* environment variables. Defaults to false. " +
+					"Can be set via ZENFRA_ENABLE_TRACING environment variable.",
+				Optional: true,
+			},
+			"disable_telemetry": schema.BoolAttribute{
+				Description: "When true, the User-Agent header sent to the Zenfra API names the provider without its version, so Zenfra's usage statistics " +
+					"do not record which provider release is in use. user_agent_extra and the X-Zenfra-Managed-By audit header are still sent. " +
+					"Defaults to false. Can be set via ZENFRA_DISABLE_TELEMETRY environment variable.",
+				Optional: true,
+			},
+			"bulk_refresh": schema.BoolAttribute{
+				Description: "When true, refreshing a stack, space, or worker pool lists all objects of that kind once per operation and reads them from the list, " +
+					"instead of making one API call per resource. Objects missing from the list are read individually. Speeds up refresh of large workspaces. " +
+					"Defaults to false. Can be set via ZENFRA_BULK_REFRESH environment variable.",
+				Optional: true,
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "When true, the provider only reads: any plan that would create, update, or delete a Zenfra resource fails with an error, " +
+					"and the API client refuses requests that change objects. Data sources and refresh work as usual, so plans can run with production credentials " +
+					"in shared sandbox pipelines. Defaults to false. Can be set via ZENFRA_READ_ONLY environment variable.",
+				Optional: true,
+			},
+			"protect_resource_types": schema.SetAttribute{
+				Description: "Resource types, e.g. zenfra_stack and zenfra_space, that no plan may destroy: a plan that deletes or replaces a resource of one of these types fails with an error. " +
+					"A guard rail for shared workspaces with many contributors; set allow_protected_destroy for the run that is meant to destroy them. " +
+					"Can be set via ZENFRA_PROTECT_RESOURCE_TYPES environment variable as a comma-separated list.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"allow_protected_destroy": schema.BoolAttribute{
+				Description: "When true, plans may destroy resources of the types in protect_resource_types. Meant to be set for a single run, usually through the " +
+					"ZENFRA_ALLOW_PROTECTED_DESTROY environment variable or a Terraform variable, rather than left in the configuration. Defaults to false.",
+				Optional: true,
+			},
+			"max_idle_conns_per_host": schema.Int64Attribute{
+				Description: "Maximum number of idle connections kept open to the Zenfra API for reuse. Raise it for applies with high -parallelism. " +
+					"Defaults to 32. Can be set via ZENFRA_MAX_IDLE_CONNS_PER_HOST environment variable.",
+				Optional: true,
+			},
+			"idle_conn_timeout_seconds": schema.Int64Attribute{
+				Description: "How long an idle connection to the Zenfra API is kept open before it is closed. Defaults to 90. Can be set via ZENFRA_IDLE_CONN_TIMEOUT_SECONDS environment variable.",
+				Optional:    true,
+			},
+			"disable_keep_alives": schema.BoolAttribute{
+				Description: "When true, every API request uses a new connection. Only useful behind proxies that mishandle persistent connections. " +
+					"Defaults to false. Can be set via ZENFRA_DISABLE_KEEP_ALIVES environment variable.",
+				Optional: true,
+			},
+			"disable_http2": schema.BoolAttribute{
+				Description: "When true, the provider uses HTTP/1.1 even if the API gateway offers HTTP/2. Defaults to false. Can be set via ZENFRA_DISABLE_HTTP2 environment variable.",
+				Optional:    true,
+			},
+			"max_concurrent_operations": schema.Int64Attribute{
+				Description: "Maximum number of API calls all resources and data sources issue at the same time. Calls beyond the limit wait for a free slot, " +
+					"so a high -parallelism does not overwhelm a self-hosted Zenfra instance. Defaults to unlimited. " +
+					"Can be set via ZENFRA_MAX_CONCURRENT_OPERATIONS environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -82,13 +254,32 @@ func (p *ZenfraProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	// Resolve endpoint: config > env > default.
-	endpoint := defaultEndpoint
-	if envVal := os.Getenv("ZENFRA_API_ENDPOINT"); envVal != "" {
-		endpoint = envVal
+	// Values wired from other resources' outputs are unknown until apply.
+	// Defer when Terraform supports it, otherwise name each unknown attribute.
+	if unknown := unknownConfigAttributes(config); len(unknown) > 0 {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{
+				Reason: provider.DeferredReasonProviderConfigUnknown,
+			}
+			return
+		}
+
+		for _, attr := range unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Unknown Zenfra Provider Configuration Value",
+				fmt.Sprintf("The provider cannot create the Zenfra API client because %q depends on a value that is not known until apply. "+
+					"Either apply the resource producing the value first (e.g. with -target), set the value statically in the configuration, "+
+					"or use the %s environment variable.", attr.name, attr.envVar),
+			)
+		}
+		return
 	}
-	if !config.Endpoint.IsNull() && !config.Endpoint.IsUnknown() {
-		endpoint = config.Endpoint.ValueString()
+
+	// Resolve endpoint: config > env > region > default.
+	endpoint, ok := resolveEndpoint(ctx, config, &resp.Diagnostics)
+	if !ok {
+		return
 	}
 
 	// Resolve API token: config > env.
@@ -105,9 +296,130 @@ func (p *ZenfraProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Resolve User-Agent suffix: config > env.
+	userAgentExtra := os.Getenv("ZENFRA_USER_AGENT_EXTRA")
+	if !config.UserAgentExtra.IsNull() && !config.UserAgentExtra.IsUnknown() {
+		userAgentExtra = config.UserAgentExtra.ValueString()
+	}
+
+	// Resolve the telemetry opt-out: config > env > false.
+	disableTelemetry, ok := resolveBool(config.DisableTelemetry, "ZENFRA_DISABLE_TELEMETRY", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Resolve the workspace named in X-Zenfra-Managed-By: config > env > TF_WORKSPACE > "default".
+	workspace := resolveWorkspace(config.Workspace)
+	if strings.ContainsAny(workspace, "\r\n/") {
+		resp.Diagnostics.AddAttributeError(path.Root("workspace"), "Invalid Workspace",
+			fmt.Sprintf("workspace %q cannot contain slashes or line breaks, since it is sent in the X-Zenfra-Managed-By header.", workspace))
+		return
+	}
+
+	// Resolve extra request headers: config > env.
+	extraHeaders, ok := resolveExtraHeaders(ctx, config.ExtraHeaders, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Resolve 403 handling on Read: config > env > false.
+	treatForbiddenAsNotFound, ok := resolveBool(config.TreatForbiddenAsNotFound, "ZENFRA_TREAT_FORBIDDEN_AS_NOT_FOUND", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Resolve credential check: config > env > false.
+	validateCredentials, ok := resolveBool(config.ValidateCredentials, "ZENFRA_VALIDATE_CREDENTIALS", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Resolve tracing: config > env > false.
+	enableTracing, ok := resolveBool(config.EnableTracing, "ZENFRA_ENABLE_TRACING", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	var tracerProvider trace.TracerProvider
+	if enableTracing {
+		tp, err := newTracerProvider(ctx, p.version)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("enable_tracing"), "Unable to Enable Tracing", err.Error())
+			return
+		}
+		tracerProvider = tp
+	}
+
+	// Resolve bulk refresh: config > env > false.
+	bulkRefresh, ok := resolveBool(config.BulkRefresh, "ZENFRA_BULK_REFRESH", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Resolve read-only mode: config > env > false.
+	readOnly, ok := resolveBool(config.ReadOnly, "ZENFRA_READ_ONLY", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Resolve destroy protection: config > env > none, lifted by allow_protected_destroy.
+	protectedResourceTypes, ok := p.resolveProtectedResourceTypes(ctx, config.ProtectResourceTypes, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	allowProtectedDestroy, ok := resolveBool(config.AllowProtectedDestroy, "ZENFRA_ALLOW_PROTECTED_DESTROY", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	if allowProtectedDestroy {
+		protectedResourceTypes = nil
+	}
+
+	// Resolve connection pooling: config > env > client defaults.
+	maxIdleConnsPerHost, ok := resolveInt64(config.MaxIdleConnsPerHost, "ZENFRA_MAX_IDLE_CONNS_PER_HOST", "max_idle_conns_per_host", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	idleConnTimeoutSeconds, ok := resolveInt64(config.IdleConnTimeoutSeconds, "ZENFRA_IDLE_CONN_TIMEOUT_SECONDS", "idle_conn_timeout_seconds", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	disableKeepAlives, ok := resolveBool(config.DisableKeepAlives, "ZENFRA_DISABLE_KEEP_ALIVES", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	disableHTTP2, ok := resolveBool(config.DisableHTTP2, "ZENFRA_DISABLE_HTTP2", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	// Resolve the concurrency limit: config > env > unlimited.
+	maxConcurrentOperations, ok := resolveInt64(config.MaxConcurrentOperations, "ZENFRA_MAX_CONCURRENT_OPERATIONS", "max_concurrent_operations", &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
 	client, err := zenfraclient.NewClient(zenfraclient.ClientConfig{
-		Endpoint: endpoint,
-		APIToken: apiToken,
+		Endpoint:         endpoint,
+		APIToken:         apiToken,
+		Version:          p.version,
+		UserAgentExtra:   userAgentExtra,
+		DisableTelemetry: disableTelemetry,
+		ManagedBy:        "terraform/" + workspace,
+		ExtraHeaders:     extraHeaders,
+		RecordPath:       os.Getenv("ZENFRA_RECORD"),
+		TracerProvider:   tracerProvider,
+
+		MaxIdleConnsPerHost: int(maxIdleConnsPerHost),
+		IdleConnTimeout:     time.Duration(idleConnTimeoutSeconds) * time.Second,
+		DisableKeepAlives:   disableKeepAlives,
+		DisableHTTP2:        disableHTTP2,
+
+		MaxConcurrentRequests: int(maxConcurrentOperations),
+
+		BulkRefresh:              bulkRefresh,
+		TreatForbiddenAsNotFound: treatForbiddenAsNotFound,
+		ReadOnly:                 readOnly,
+		ProtectedResourceTypes:   protectedResourceTypes,
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -116,9 +428,336 @@ func (p *ZenfraProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		)
 		return
 	}
+	trackClient(client)
+
+	if validateCredentials {
+		resp.Diagnostics.Append(checkCredentials(ctx, client, endpoint)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	data := &providerdata.Data{
+		Client:     client,
+		Workspace:  workspace,
+		Operations: semaphore.New(int(maxConcurrentOperations)),
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
+}
+
+// resolveEndpoint returns the API endpoint from endpoint or ZENFRA_API_ENDPOINT, or else
+// discovers it for region or ZENFRA_REGION. If discovery fails, the region's well-known
+// base URL is used with a warning. It reports false for an unknown region.
+func resolveEndpoint(ctx context.Context, config ZenfraProviderModel, diags *diag.Diagnostics) (string, bool) {
+	if !config.Endpoint.IsNull() && !config.Endpoint.IsUnknown() {
+		return config.Endpoint.ValueString(), true
+	}
+	if envVal := os.Getenv("ZENFRA_API_ENDPOINT"); envVal != "" {
+		return envVal, true
+	}
+
+	region := os.Getenv("ZENFRA_REGION")
+	if !config.Region.IsNull() && !config.Region.IsUnknown() {
+		region = config.Region.ValueString()
+	}
+	if region == "" {
+		return defaultEndpoint, true
+	}
+
+	base, known := zenfraclient.RegionEndpoint(region)
+	if !known {
+		diags.AddAttributeError(
+			path.Root("region"),
+			"Unknown Zenfra Region",
+			fmt.Sprintf("Region %q is not a Zenfra region. Expected one of %s, or set endpoint to the API URL directly.",
+				region, strings.Join(zenfraclient.Regions(), ", ")),
+		)
+		return "", false
+	}
+
+	endpoint, err := discoverEndpoint(ctx, nil, base)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("region"),
+			"Zenfra Endpoint Discovery Failed",
+			fmt.Sprintf("Could not discover the API endpoint of region %q, using %s. Set endpoint to override it.\n\n%s", region, base, err),
+		)
+		return base, true
+	}
+	return endpoint, true
+}
+
+// resolveWorkspace returns the configured workspace, else ZENFRA_WORKSPACE, else the
+// TF_WORKSPACE Terraform itself reads, else "default".
+func resolveWorkspace(value types.String) string {
+	if !value.IsNull() && !value.IsUnknown() && value.ValueString() != "" {
+		return value.ValueString()
+	}
+	for _, envVar := range []string{"ZENFRA_WORKSPACE", "TF_WORKSPACE"} {
+		if v := os.Getenv(envVar); v != "" {
+			return v
+		}
+	}
+	return "default"
+}
+
+// resolveBool resolves a boolean provider setting from config, falling back to envVar
+// and then false. It reports false if envVar is set to something other than a boolean.
+func resolveBool(value types.Bool, envVar string, diags *diag.Diagnostics) (bool, bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), true
+	}
+
+	envVal := os.Getenv(envVar)
+	if envVal == "" {
+		return false, true
+	}
+	v, err := strconv.ParseBool(envVal)
+	if err != nil {
+		diags.AddError(
+			"Invalid Environment Variable",
+			fmt.Sprintf("%s must be a boolean, got %q.", envVar, envVal),
+		)
+		return false, false
+	}
+	return v, true
+}
+
+// resolveExtraHeaders resolves the extra request headers from config, falling back to
+// ZENFRA_EXTRA_HEADERS, a JSON object of header names to values. Header names are
+// validated when the client is created.
+func resolveExtraHeaders(ctx context.Context, value types.Map, diags *diag.Diagnostics) (map[string]string, bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		headers := make(map[string]string, len(value.Elements()))
+		diags.Append(value.ElementsAs(ctx, &headers, false)...)
+		return headers, !diags.HasError()
+	}
+
+	envVal := os.Getenv("ZENFRA_EXTRA_HEADERS")
+	if envVal == "" {
+		return nil, true
+	}
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(envVal), &headers); err != nil {
+		diags.AddError(
+			"Invalid Environment Variable",
+			fmt.Sprintf("ZENFRA_EXTRA_HEADERS must be a JSON object of header names to string values: %s", err),
+		)
+		return nil, false
+	}
+	return headers, true
+}
+
+// resolveProtectedResourceTypes resolves the destroy-protected resource types from
+// config, falling back to ZENFRA_PROTECT_RESOURCE_TYPES, a comma-separated list. It
+// reports false for a name that is not a resource type of this provider, so a typo
+// cannot silently leave a type unprotected.
+func (p *ZenfraProvider) resolveProtectedResourceTypes(ctx context.Context, value types.Set, diags *diag.Diagnostics) ([]string, bool) {
+	var typeNames []string
+	attrPath := path.Root("protect_resource_types")
+	if !value.IsNull() && !value.IsUnknown() {
+		diags.Append(value.ElementsAs(ctx, &typeNames, false)...)
+		if diags.HasError() {
+			return nil, false
+		}
+	} else if envVal := os.Getenv("ZENFRA_PROTECT_RESOURCE_TYPES"); envVal != "" {
+		for _, typeName := range strings.Split(envVal, ",") {
+			if typeName = strings.TrimSpace(typeName); typeName != "" {
+				typeNames = append(typeNames, typeName)
+			}
+		}
+	}
+
+	known := p.resourceTypeNames(ctx)
+	for _, typeName := range typeNames {
+		if !slices.Contains(known, typeName) {
+			diags.AddAttributeError(
+				attrPath,
+				"Unknown Protected Resource Type",
+				fmt.Sprintf("%q is not a resource type of the Zenfra provider. Expected resource types such as zenfra_stack or zenfra_space.", typeName),
+			)
+		}
+	}
+	return typeNames, !diags.HasError()
+}
+
+// resourceTypeNames returns the type names of the provider's resources.
+func (p *ZenfraProvider) resourceTypeNames(ctx context.Context) []string {
+	var providerMeta provider.MetadataResponse
+	p.Metadata(ctx, provider.MetadataRequest{}, &providerMeta)
+
+	var names []string
+	for _, newResource := range p.Resources(ctx) {
+		var meta resource.MetadataResponse
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerMeta.TypeName}, &meta)
+		names = append(names, meta.TypeName)
+	}
+	return names
+}
+
+// isFullyKnown reports whether a map and all of its elements are known.
+func isFullyKnown(value types.Map) bool {
+	if value.IsUnknown() {
+		return false
+	}
+	for _, elem := range value.Elements() {
+		if elem.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// isSetFullyKnown reports whether a set and all of its elements are known.
+func isSetFullyKnown(value types.Set) bool {
+	if value.IsUnknown() {
+		return false
+	}
+	for _, elem := range value.Elements() {
+		if elem.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveInt64 resolves an optional positive integer provider setting from config,
+// falling back to envVar. Zero means unset, leaving the client default in place.
+func resolveInt64(value types.Int64, envVar, attrName string, diags *diag.Diagnostics) (int64, bool) {
+	if !value.IsNull() && !value.IsUnknown() {
+		if value.ValueInt64() < 1 {
+			diags.AddAttributeError(
+				path.Root(attrName),
+				"Invalid Provider Configuration Value",
+				fmt.Sprintf("%s must be at least 1, got %d.", attrName, value.ValueInt64()),
+			)
+			return 0, false
+		}
+		return value.ValueInt64(), true
+	}
+
+	envVal := os.Getenv(envVar)
+	if envVal == "" {
+		return 0, true
+	}
+	v, err := strconv.ParseInt(envVal, 10, 64)
+	if err != nil || v < 1 {
+		diags.AddError(
+			"Invalid Environment Variable",
+			fmt.Sprintf("%s must be a positive integer, got %q.", envVar, envVal),
+		)
+		return 0, false
+	}
+	return v, true
+}
+
+// checkCredentials makes one lightweight API call and turns authentication and endpoint
+// failures into diagnostics on the provider configuration.
+func checkCredentials(ctx context.Context, client *zenfraclient.Client, endpoint string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := client.GetCurrentOrganization(ctx)
+	switch {
+	case err == nil:
+	case zenfraclient.IsUnauthorized(err):
+		diags.AddAttributeError(
+			path.Root("api_token"),
+			"Invalid Zenfra API Token",
+			fmt.Sprintf("The Zenfra API at %s rejected the API token. Check api_token or ZENFRA_API_TOKEN; the token may be mistyped, expired, or revoked.\n\n%s", endpoint, err),
+		)
+	case zenfraclient.IsForbidden(err):
+		diags.AddAttributeError(
+			path.Root("api_token"),
+			"Zenfra API Token Not Permitted",
+			fmt.Sprintf("The API token is valid but is not permitted to read its organization from %s. "+
+				"If the token is intentionally restricted, set validate_credentials = false.\n\n%s", endpoint, err),
+		)
+	case zenfraclient.IsNotFound(err):
+		diags.AddAttributeError(
+			path.Root("endpoint"),
+			"Invalid Zenfra API Endpoint",
+			fmt.Sprintf("%s does not appear to be a Zenfra API endpoint: reading the current organization returned not found. "+
+				"Check endpoint or ZENFRA_API_ENDPOINT.\n\n%s", endpoint, err),
+		)
+	default:
+		diags.AddAttributeError(
+			path.Root("endpoint"),
+			"Unable to Reach Zenfra API",
+			fmt.Sprintf("Could not verify the provider credentials against %s. Check endpoint or ZENFRA_API_ENDPOINT and network access.\n\n%s", endpoint, err),
+		)
+	}
+
+	return diags
+}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+// unknownConfigAttribute identifies a provider attribute whose value is unknown at Configure time.
+type unknownConfigAttribute struct {
+	name   string
+	envVar string
+}
+
+// unknownConfigAttributes returns the provider attributes that are unknown in the given configuration.
+func unknownConfigAttributes(config ZenfraProviderModel) []unknownConfigAttribute {
+	var unknown []unknownConfigAttribute
+	if config.Endpoint.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "endpoint", envVar: "ZENFRA_API_ENDPOINT"})
+	}
+	if config.Region.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "region", envVar: "ZENFRA_REGION"})
+	}
+	if config.APIToken.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "api_token", envVar: "ZENFRA_API_TOKEN"})
+	}
+	if config.UserAgentExtra.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "user_agent_extra", envVar: "ZENFRA_USER_AGENT_EXTRA"})
+	}
+	if config.Workspace.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "workspace", envVar: "ZENFRA_WORKSPACE"})
+	}
+	if !isFullyKnown(config.ExtraHeaders) {
+		unknown = append(unknown, unknownConfigAttribute{name: "extra_headers", envVar: "ZENFRA_EXTRA_HEADERS"})
+	}
+	if config.TreatForbiddenAsNotFound.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "treat_forbidden_as_not_found", envVar: "ZENFRA_TREAT_FORBIDDEN_AS_NOT_FOUND"})
+	}
+	if config.ValidateCredentials.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "validate_credentials", envVar: "ZENFRA_VALIDATE_CREDENTIALS"})
+	}
+	if config.EnableTracing.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "enable_tracing", envVar: "ZENFRA_ENABLE_TRACING"})
+	}
+	if config.DisableTelemetry.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "disable_telemetry", envVar: "ZENFRA_DISABLE_TELEMETRY"})
+	}
+	if config.BulkRefresh.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "bulk_refresh", envVar: "ZENFRA_BULK_REFRESH"})
+	}
+	if config.ReadOnly.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "read_only", envVar: "ZENFRA_READ_ONLY"})
+	}
+	if !isSetFullyKnown(config.ProtectResourceTypes) {
+		unknown = append(unknown, unknownConfigAttribute{name: "protect_resource_types", envVar: "ZENFRA_PROTECT_RESOURCE_TYPES"})
+	}
+	if config.AllowProtectedDestroy.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "allow_protected_destroy", envVar: "ZENFRA_ALLOW_PROTECTED_DESTROY"})
+	}
+	if config.MaxIdleConnsPerHost.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "max_idle_conns_per_host", envVar: "ZENFRA_MAX_IDLE_CONNS_PER_HOST"})
+	}
+	if config.IdleConnTimeoutSeconds.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "idle_conn_timeout_seconds", envVar: "ZENFRA_IDLE_CONN_TIMEOUT_SECONDS"})
+	}
+	if config.DisableKeepAlives.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "disable_keep_alives", envVar: "ZENFRA_DISABLE_KEEP_ALIVES"})
+	}
+	if config.DisableHTTP2.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "disable_http2", envVar: "ZENFRA_DISABLE_HTTP2"})
+	}
+	if config.MaxConcurrentOperations.IsUnknown() {
+		unknown = append(unknown, unknownConfigAttribute{name: "max_concurrent_operations", envVar: "ZENFRA_MAX_CONCURRENT_OPERATIONS"})
+	}
+	return unknown
 }
 
 func (p *ZenfraProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -126,23 +765,75 @@ func (p *ZenfraProvider) Resources(_ context.Context) []func() resource.Resource
 		resSpace.NewSpaceResource,
 		resStack.NewStackResource,
 		resWorkerPool.NewWorkerPoolResource,
+		resWorkerPoolAssignment.NewWorkerPoolAssignmentResource,
 		resBundle.NewBundleResource,
+		resBundle.NewConfigurationBundleResource,
 		resBundleAttachment.NewBundleAttachmentResource,
 		resStackVars.NewStackVariablesResource,
+		resSpaceVars.NewSpaceVariablesResource,
 		resAPIToken.NewAPITokenResource,
 		resVCS.NewVCSIntegrationResource,
+		resStateRollback.NewStateRollbackResource,
+		resSigningKey.NewSigningKeyResource,
+		resRunComment.NewRunCommentResource,
+		resSecretBackend.NewSecretBackendResource,
+		resBundleSecretRef.NewBundleSecretReferenceResource,
+		resSpaceBundleAttachment.NewSpaceBundleAttachmentResource,
+		resRunQueueSettings.NewRunQueueSettingsResource,
+		resRunnerVersionConstraint.NewRunnerVersionConstraintResource,
+		resWebhookSecretRotation.NewWebhookSecretRotationResource,
+		resMembershipInvitation.NewMembershipInvitationResource,
+		resRetentionSettings.NewRetentionSettingsResource,
+		resOrganizationDomain.NewOrganizationDomainResource,
+		resOrganizationDomainVerification.NewOrganizationDomainVerificationResource,
+		resOutputSubscription.NewOutputSubscriptionResource,
+		resRateLimitPolicy.NewRateLimitPolicyResource,
+		resStackFromManifest.NewStackFromManifestResource,
+		resVariableSet.NewEnvironmentVariableSetResource,
+		resVariableSetVariable.NewEnvironmentVariableSetVariableResource,
+		resVariableSetScope.NewEnvironmentVariableSetScopeResource,
 	}
 }
 
 func (p *ZenfraProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		dsSpace.NewSpaceDataSource,
+		dsSpace.NewSpacesDataSource,
 		dsStack.NewStackDataSource,
 		dsStack.NewStacksDataSource,
 		dsWorkerPool.NewWorkerPoolDataSource,
 		dsWorkerPool.NewWorkerPoolsDataSource,
+		dsBundle.NewBundlesDataSource,
+		dsBundle.NewBundleAttachedStacksDataSource,
+		dsBundle.NewEffectiveBundlesDataSource,
 		dsCurrentOrg.NewCurrentOrganizationDataSource,
+		dsComplianceReport.NewComplianceReportDataSource,
 		dsVCS.NewVCSIntegrationDataSource,
 		dsVCS.NewVCSIntegrationsDataSource,
+		dsVCS.NewVCSRefDataSource,
+		dsRunPlan.NewRunPlanDataSource,
+		dsRunPlan.NewRunPlanSummaryDataSource,
+		dsRunCostEstimate.NewRunCostEstimateDataSource,
+		dsRunLogs.NewRunLogsDataSource,
+		dsStackDependency.NewStackDependencyGraphDataSource,
+		dsStackPolicyCheck.NewStackPolicyCheckDataSource,
+		dsStackTemplate.NewStackTemplatesDataSource,
+		dsStateSnapshot.NewStateSnapshotsDataSource,
+		dsUsage.NewUsageDataSource,
+		dsSigningKey.NewSigningKeyDataSource,
+		dsSpace.NewSpaceBundleAttachmentsDataSource,
+		dsIACVersion.NewIACVersionsDataSource,
+		dsEgressIPRange.NewEgressIPRangesDataSource,
+		dsWebhookEndpoint.NewWebhookEndpointDataSource,
+		dsImportPlan.NewImportPlanDataSource,
+		dsRateLimitPolicy.NewRateLimitPoliciesDataSource,
+		dsAPIToken.NewAPITokenDataSource,
+		dsAPIToken.NewAPITokensDataSource,
+	}
+}
+
+func (p *ZenfraProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		providerfunc.NewRepositoryIDFunction,
 	}
 }
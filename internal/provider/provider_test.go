@@ -1,9 +1,29 @@
 // ABOUTME: Unit tests for the ZenfraProvider schema and configuration.
-// ABOUTME: Validates provider schema attributes and basic instantiation.
+// ABOUTME: Validates provider instantiation, Configure handling of unknown values and aliased configurations, endpoint, header, and protected type resolution, and the credential check.
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/resource/retention_settings"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 func TestNewProvider(t *testing.T) {
@@ -31,3 +51,380 @@ func TestProviderSchema(t *testing.T) {
 		t.Errorf("expected version 'test', got %q", zp.version)
 	}
 }
+
+// unknownTokenConfig builds a provider configuration whose api_token is unknown.
+func unknownTokenConfig(t *testing.T, p provider.Provider) tfsdk.Config {
+	t.Helper()
+	return providerConfig(t, p, "https://api.example.com", tftypes.NewValue(tftypes.String, tftypes.UnknownValue))
+}
+
+// providerConfig builds a provider configuration with endpoint and apiToken and every
+// other attribute unset.
+func providerConfig(t *testing.T, p provider.Provider, endpoint string, apiToken tftypes.Value) tfsdk.Config {
+	t.Helper()
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(context.Background(), provider.SchemaRequest{}, schemaResp)
+
+	objType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+			"endpoint":         tftypes.NewValue(tftypes.String, endpoint),
+			"region":           tftypes.NewValue(tftypes.String, nil),
+			"api_token":        apiToken,
+			"user_agent_extra": tftypes.NewValue(tftypes.String, nil),
+			"workspace":        tftypes.NewValue(tftypes.String, nil),
+			"extra_headers":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+
+			"treat_forbidden_as_not_found": tftypes.NewValue(tftypes.Bool, nil),
+			"validate_credentials":         tftypes.NewValue(tftypes.Bool, nil),
+			"enable_tracing":               tftypes.NewValue(tftypes.Bool, nil),
+			"disable_telemetry":            tftypes.NewValue(tftypes.Bool, nil),
+			"bulk_refresh":                 tftypes.NewValue(tftypes.Bool, nil),
+			"read_only":                    tftypes.NewValue(tftypes.Bool, nil),
+
+			"protect_resource_types":  tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+			"allow_protected_destroy": tftypes.NewValue(tftypes.Bool, nil),
+
+			"max_idle_conns_per_host":   tftypes.NewValue(tftypes.Number, nil),
+			"idle_conn_timeout_seconds": tftypes.NewValue(tftypes.Number, nil),
+			"disable_keep_alives":       tftypes.NewValue(tftypes.Bool, nil),
+			"disable_http2":             tftypes.NewValue(tftypes.Bool, nil),
+
+			"max_concurrent_operations": tftypes.NewValue(tftypes.Number, nil),
+		}),
+	}
+}
+
+func TestConfigure_UnknownValueDeferred(t *testing.T) {
+	t.Parallel()
+
+	p := New("test")()
+	req := provider.ConfigureRequest{
+		Config:             unknownTokenConfig(t, p),
+		ClientCapabilities: provider.ConfigureProviderClientCapabilities{DeferralAllowed: true},
+	}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics.Errors())
+	}
+	if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+		t.Errorf("expected provider config unknown deferral, got %+v", resp.Deferred)
+	}
+	if resp.ResourceData != nil {
+		t.Error("expected no client to be configured when deferred")
+	}
+}
+
+func TestConfigure_UnknownValueWithoutDeferral(t *testing.T) {
+	t.Parallel()
+
+	p := New("test")()
+	req := provider.ConfigureRequest{Config: unknownTokenConfig(t, p)}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(context.Background(), req, resp)
+
+	if resp.Deferred != nil {
+		t.Errorf("expected no deferral, got %+v", resp.Deferred)
+	}
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Summary() != "Unknown Zenfra Provider Configuration Value" {
+		t.Errorf("unexpected summary %q", errs[0].Summary())
+	}
+}
+
+// TestConfigure_AliasedProviders is a smoke test for two aliased provider
+// configurations, as an MSP managing several organizations might declare them: each
+// sends its own API token, and a resource's organization_id selects the organization
+// of its request without affecting the other configuration.
+func TestConfigure_AliasedProviders(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	type call struct{ token, organization string }
+	var mu sync.Mutex
+	var calls []call
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/organizations/current/retention-settings" {
+			http.NotFound(w, r)
+			return
+		}
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		orgID := r.Header.Get(zenfraclient.OrganizationHeader)
+		mu.Lock()
+		calls = append(calls, call{token: token, organization: orgID})
+		mu.Unlock()
+		if orgID == "" {
+			orgID = "default-org-of-" + token
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(zenfraclient.RetentionSettings{OrganizationID: orgID, RunRetentionDays: 30, LogRetentionDays: 7})
+	}))
+	defer server.Close()
+
+	apply := func(token, organizationID string) retention_settings.RetentionSettingsModel {
+		t.Helper()
+		p := New("test")()
+		configureResp := &provider.ConfigureResponse{}
+		p.Configure(ctx, provider.ConfigureRequest{Config: providerConfig(t, p, server.URL, tftypes.NewValue(tftypes.String, token))}, configureResp)
+		if configureResp.Diagnostics.HasError() {
+			t.Fatalf("Configure: %v", configureResp.Diagnostics)
+		}
+
+		r := retention_settings.NewRetentionSettingsResource()
+		r.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: configureResp.ResourceData}, &resource.ConfigureResponse{})
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+		orgID := types.StringUnknown()
+		if organizationID != "" {
+			orgID = types.StringValue(organizationID)
+		}
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		if diags := plan.Set(ctx, retention_settings.RetentionSettingsModel{
+			ID:               types.StringUnknown(),
+			OrganizationID:   orgID,
+			RunRetentionDays: types.Int64Value(30),
+			LogRetentionDays: types.Int64Value(7),
+			UpdatedAt:        timeutil.NewTimestampUnknown(),
+			UpdatedBy:        types.StringUnknown(),
+		}); diags.HasError() {
+			t.Fatalf("setting plan: %v", diags)
+		}
+		createResp := &resource.CreateResponse{State: tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}}
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, createResp)
+		if createResp.Diagnostics.HasError() {
+			t.Fatalf("Create: %v", createResp.Diagnostics)
+		}
+		var state retention_settings.RetentionSettingsModel
+		if diags := createResp.State.Get(ctx, &state); diags.HasError() {
+			t.Fatalf("reading state: %v", diags)
+		}
+		return state
+	}
+
+	// provider "zenfra" {} and provider "zenfra" { alias = "msp" }, with their own tokens.
+	own := apply("token-a", "")
+	customer := apply("token-b", "org-customer")
+
+	if own.OrganizationID.ValueString() != "default-org-of-token-a" {
+		t.Errorf("expected the default provider to manage its token's organization, got %s", own.OrganizationID)
+	}
+	if customer.OrganizationID.ValueString() != "org-customer" || customer.ID.ValueString() != "org-customer" {
+		t.Errorf("expected the aliased provider to manage org-customer, got %s (id %s)", customer.OrganizationID, customer.ID)
+	}
+	want := []call{{token: "token-a"}, {token: "token-b", organization: "org-customer"}}
+	if !slices.Equal(calls, want) {
+		t.Errorf("expected requests %+v, got %+v", want, calls)
+	}
+}
+
+func TestCheckCredentials(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantSummary string
+	}{
+		{name: "valid", status: http.StatusOK, body: `{"id":"org-1","name":"Acme"}`},
+		{name: "invalid token", status: http.StatusUnauthorized, body: `{"error":"unauthorized","message":"invalid token"}`, wantSummary: "Invalid Zenfra API Token"},
+		{name: "restricted token", status: http.StatusForbidden, body: `{"error":"forbidden","message":"forbidden"}`, wantSummary: "Zenfra API Token Not Permitted"},
+		{name: "wrong endpoint", status: http.StatusNotFound, body: `not found`, wantSummary: "Invalid Zenfra API Endpoint"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			client, err := zenfraclient.NewClient(zenfraclient.ClientConfig{Endpoint: server.URL, APIToken: "test-token", MaxRetries: 1})
+			if err != nil {
+				t.Fatalf("NewClient: %v", err)
+			}
+
+			diags := checkCredentials(context.Background(), client, server.URL)
+			if tt.wantSummary == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected errors: %v", diags.Errors())
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantSummary {
+				t.Errorf("expected %q, got %v", tt.wantSummary, diags.Errors())
+			}
+		})
+	}
+}
+
+func TestResolveEndpoint(t *testing.T) {
+	t.Setenv("ZENFRA_API_ENDPOINT", "")
+	t.Setenv("ZENFRA_REGION", "")
+
+	discovered := map[string]string{"https://api.eu.zenfra.cloud": "https://api-eu-1.zenfra.cloud"}
+	orig := discoverEndpoint
+	discoverEndpoint = func(_ context.Context, _ *http.Client, base string) (string, error) {
+		if endpoint, ok := discovered[base]; ok {
+			return endpoint, nil
+		}
+		return "", errors.New("connection refused")
+	}
+	t.Cleanup(func() { discoverEndpoint = orig })
+
+	tests := []struct {
+		name        string
+		config      ZenfraProviderModel
+		want        string
+		wantWarning bool
+		wantError   bool
+	}{
+		{name: "default", config: ZenfraProviderModel{}, want: defaultEndpoint},
+		{name: "explicit endpoint wins over region", config: ZenfraProviderModel{Endpoint: types.StringValue("https://zenfra.internal"), Region: types.StringValue("eu")}, want: "https://zenfra.internal"},
+		{name: "region discovered", config: ZenfraProviderModel{Region: types.StringValue("eu")}, want: "https://api-eu-1.zenfra.cloud"},
+		{name: "discovery fails", config: ZenfraProviderModel{Region: types.StringValue("gov")}, want: "https://api.gov.zenfra.cloud", wantWarning: true},
+		{name: "unknown region", config: ZenfraProviderModel{Region: types.StringValue("apac")}, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got, ok := resolveEndpoint(context.Background(), tt.config, &diags)
+			if tt.wantError {
+				if ok || !diags.HasError() {
+					t.Fatalf("expected error, got %q", got)
+				}
+				return
+			}
+			if !ok || got != tt.want {
+				t.Errorf("got %q (ok=%v), want %q", got, ok, tt.want)
+			}
+			if (diags.WarningsCount() > 0) != tt.wantWarning {
+				t.Errorf("unexpected warnings: %v", diags.Warnings())
+			}
+		})
+	}
+}
+
+func TestResolveExtraHeaders(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    types.Map
+		env       string
+		want      map[string]string
+		wantError bool
+	}{
+		{name: "unset", config: types.MapNull(types.StringType)},
+		{
+			name:   "config wins over env",
+			config: types.MapValueMust(types.StringType, map[string]attr.Value{"CF-Access-Client-Id": types.StringValue("from-config")}),
+			env:    `{"CF-Access-Client-Id": "from-env"}`,
+			want:   map[string]string{"CF-Access-Client-Id": "from-config"},
+		},
+		{
+			name:   "env",
+			config: types.MapNull(types.StringType),
+			env:    `{"traceparent": "00-abc-def-01"}`,
+			want:   map[string]string{"traceparent": "00-abc-def-01"},
+		},
+		{name: "env not JSON", config: types.MapNull(types.StringType), env: "traceparent=abc", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ZENFRA_EXTRA_HEADERS", tt.env)
+
+			var diags diag.Diagnostics
+			got, ok := resolveExtraHeaders(context.Background(), tt.config, &diags)
+			if tt.wantError {
+				if ok || !diags.HasError() {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if !ok || !maps.Equal(got, tt.want) {
+				t.Errorf("got %v (ok=%v), want %v", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveProtectedResourceTypes(t *testing.T) {
+	setOf := func(names ...string) types.Set {
+		elems := make([]attr.Value, 0, len(names))
+		for _, name := range names {
+			elems = append(elems, types.StringValue(name))
+		}
+		return types.SetValueMust(types.StringType, elems)
+	}
+
+	tests := []struct {
+		name      string
+		config    types.Set
+		env       string
+		want      []string
+		wantError bool
+	}{
+		{name: "unset", config: types.SetNull(types.StringType)},
+		{name: "config wins over env", config: setOf("zenfra_stack"), env: "zenfra_space", want: []string{"zenfra_stack"}},
+		{name: "env", config: types.SetNull(types.StringType), env: "zenfra_stack, zenfra_space,", want: []string{"zenfra_stack", "zenfra_space"}},
+		{name: "unknown type", config: setOf("zenfra_stack", "zenfra_stacks"), wantError: true},
+		{name: "data source type", config: setOf("zenfra_current_organization"), wantError: true},
+	}
+
+	p := &ZenfraProvider{version: "test"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ZENFRA_PROTECT_RESOURCE_TYPES", tt.env)
+
+			var diags diag.Diagnostics
+			got, ok := p.resolveProtectedResourceTypes(context.Background(), tt.config, &diags)
+			if tt.wantError {
+				if ok || !diags.HasError() {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if !ok || !slices.Equal(got, tt.want) {
+				t.Errorf("got %v (ok=%v), want %v", got, ok, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveWorkspace(t *testing.T) {
+	tests := []struct {
+		name          string
+		config        types.String
+		env, tfEnv    string
+		wantWorkspace string
+	}{
+		{name: "unset", config: types.StringNull(), wantWorkspace: "default"},
+		{name: "config wins over env", config: types.StringValue("prod"), env: "staging", tfEnv: "dev", wantWorkspace: "prod"},
+		{name: "env wins over TF_WORKSPACE", config: types.StringNull(), env: "staging", tfEnv: "dev", wantWorkspace: "staging"},
+		{name: "TF_WORKSPACE", config: types.StringNull(), tfEnv: "dev", wantWorkspace: "dev"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("ZENFRA_WORKSPACE", tt.env)
+			t.Setenv("TF_WORKSPACE", tt.tfEnv)
+
+			if got := resolveWorkspace(tt.config); got != tt.wantWorkspace {
+				t.Errorf("got %q, want %q", got, tt.wantWorkspace)
+			}
+		})
+	}
+}
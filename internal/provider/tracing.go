@@ -0,0 +1,76 @@
+// ABOUTME: Sets up OpenTelemetry tracing of Zenfra API calls when enable_tracing is set.
+// ABOUTME: Spans are exported over OTLP/HTTP, configured by the standard OTEL_EXPORTER_OTLP_* environment variables.
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerProvider is shared by every Configure call in the plugin process, so that
+// re-configuring the provider does not open another exporter.
+var (
+	tracerProviderMu sync.Mutex
+	tracerProvider   *sdktrace.TracerProvider
+)
+
+// newTracerProvider returns the process-wide tracer provider, creating it on first use.
+// Spans are exported in batches off the request path; ShutdownTracing flushes the last
+// batch when the plugin server stops.
+func newTracerProvider(ctx context.Context, version string) (trace.TracerProvider, error) {
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+
+	if tracerProvider != nil {
+		return tracerProvider, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("creating OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName("terraform-provider-zenfra"),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("creating trace resource: %w", err)
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	return tracerProvider, nil
+}
+
+// tracingShutdownTimeout bounds how long ShutdownTracing waits for the exporter.
+const tracingShutdownTimeout = 5 * time.Second
+
+// ShutdownTracing exports the spans still buffered and stops the tracer provider. It
+// does nothing if tracing was never enabled. Call it once the plugin server has stopped.
+func ShutdownTracing(ctx context.Context) error {
+	tracerProviderMu.Lock()
+	defer tracerProviderMu.Unlock()
+
+	if tracerProvider == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, tracingShutdownTimeout)
+	defer cancel()
+	err := tracerProvider.Shutdown(ctx)
+	tracerProvider = nil
+	if err != nil {
+		return fmt.Errorf("shutting down tracer provider: %w", err)
+	}
+	return nil
+}
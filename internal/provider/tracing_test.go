@@ -0,0 +1,46 @@
+// ABOUTME: Unit tests for the OpenTelemetry tracer provider of the plugin process.
+// ABOUTME: Checks that spans are batched and that ShutdownTracing flushes them to the OTLP endpoint.
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestShutdownTracing_FlushesBatchedSpans(t *testing.T) {
+	var exports atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/traces" {
+			exports.Add(1)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", server.URL)
+
+	ctx := context.Background()
+	tp, err := newTracerProvider(ctx, "test")
+	if err != nil {
+		t.Fatalf("newTracerProvider: %v", err)
+	}
+	t.Cleanup(func() { _ = ShutdownTracing(ctx) })
+
+	_, span := tp.Tracer("test").Start(ctx, "GET /api/v1/stacks")
+	span.End()
+	if got := exports.Load(); got != 0 {
+		t.Fatalf("expected the span to wait for its batch, got %d exports", got)
+	}
+
+	if err := ShutdownTracing(ctx); err != nil {
+		t.Fatalf("ShutdownTracing: %v", err)
+	}
+	if got := exports.Load(); got != 1 {
+		t.Errorf("expected the batch to be exported on shutdown, got %d exports", got)
+	}
+	if err := ShutdownTracing(ctx); err != nil {
+		t.Errorf("expected a second ShutdownTracing to do nothing, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+// ABOUTME: The value the provider's Configure hands to every resource and data source.
+// ABOUTME: Holds the API client, provider-level defaults, and the operation semaphore; FromResource/FromDataSource unpack it.
+
+// Package providerdata defines what resources and data sources receive from the
+// provider's Configure, and the helpers that unpack it in their own Configure methods.
+// State that has to be shared across all of them for one provider run belongs here:
+// the API client (which carries the token permission and stack variable caches),
+// settings resolved from the provider configuration, and the semaphore bounding
+// provider-side fan-out.
+package providerdata
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zenfra/terraform-provider-zenfra/internal/semaphore"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Data is the provider data of a configured provider.
+type Data struct {
+	// Client is the API client shared by every resource and data source. Resources
+	// should call Client.ForResource to name themselves in requests.
+	Client *zenfraclient.Client
+
+	// Workspace is the resolved workspace named in the X-Zenfra-Managed-By header.
+	Workspace string
+
+	// Operations bounds how many provider-side operations, such as the per-item reads
+	// of a data source, run at once. It is sized by max_concurrent_operations; the
+	// client separately bounds the requests in flight.
+	Operations semaphore.Semaphore
+}
+
+// FromResource returns the provider data passed to a resource's Configure, or nil if
+// the provider is not configured yet. An unexpected type is reported to resp.
+func FromResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *Data {
+	return configureFromProviderData(req.ProviderData, "Unexpected Resource Configure Type", &resp.Diagnostics)
+}
+
+// FromDataSource returns the provider data passed to a data source's Configure, or nil
+// if the provider is not configured yet. An unexpected type is reported to resp.
+func FromDataSource(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *Data {
+	return configureFromProviderData(req.ProviderData, "Unexpected Data Source Configure Type", &resp.Diagnostics)
+}
+
+// configureFromProviderData type-asserts providerData. Terraform calls Configure before
+// the provider is configured as well, with nil provider data, which is not an error.
+func configureFromProviderData(providerData any, summary string, diags *diag.Diagnostics) *Data {
+	if providerData == nil {
+		return nil
+	}
+	data, ok := providerData.(*Data)
+	if !ok {
+		diags.AddError(summary,
+			fmt.Sprintf("Expected *providerdata.Data, got: %T. Please report this issue to the provider developers.", providerData))
+		return nil
+	}
+	return data
+}
@@ -0,0 +1,42 @@
+// ABOUTME: Unit tests for unpacking provider data in Configure.
+// ABOUTME: Covers unconfigured providers and unexpected provider data types.
+package providerdata
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestFromResource(t *testing.T) {
+	resp := &resource.ConfigureResponse{}
+	if data := FromResource(resource.ConfigureRequest{}, resp); data != nil || resp.Diagnostics.HasError() {
+		t.Errorf("expected nil data without errors before the provider is configured, got %v, %v", data, resp.Diagnostics)
+	}
+
+	want := &Data{Workspace: "production"}
+	resp = &resource.ConfigureResponse{}
+	if data := FromResource(resource.ConfigureRequest{ProviderData: want}, resp); data != want || resp.Diagnostics.HasError() {
+		t.Errorf("expected the provider data, got %v, %v", data, resp.Diagnostics)
+	}
+
+	resp = &resource.ConfigureResponse{}
+	if data := FromResource(resource.ConfigureRequest{ProviderData: &zenfraclient.Client{}}, resp); data != nil {
+		t.Errorf("expected nil data for an unexpected type, got %v", data)
+	}
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Unexpected Resource Configure Type" {
+		t.Errorf("expected an unexpected type error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestFromDataSource(t *testing.T) {
+	resp := &datasource.ConfigureResponse{}
+	if data := FromDataSource(datasource.ConfigureRequest{ProviderData: "client"}, resp); data != nil {
+		t.Errorf("expected nil data for an unexpected type, got %v", data)
+	}
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Unexpected Data Source Configure Type" {
+		t.Errorf("expected an unexpected type error, got %v", resp.Diagnostics)
+	}
+}
@@ -0,0 +1,118 @@
+// ABOUTME: The provider::zenfra::repository_id function, building a stack source repository_id from owner and name.
+// ABOUTME: Validates both parts against the rules of the VCS vendor, so a malformed ID fails at plan time.
+
+// Package providerfunc holds the provider-defined functions of the Zenfra provider.
+package providerfunc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &RepositoryIDFunction{}
+
+var (
+	// githubOwner matches a GitHub user or organization login.
+	githubOwner = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9]|-[A-Za-z0-9]){0,38}$`)
+	// githubName matches a GitHub repository name.
+	githubName = regexp.MustCompile(`^[A-Za-z0-9._-]{1,100}$`)
+	// gitlabSegment matches one segment of a GitLab namespace or project path.
+	gitlabSegment = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]*$`)
+)
+
+// NewRepositoryIDFunction is a constructor for the repository_id function.
+func NewRepositoryIDFunction() function.Function {
+	return &RepositoryIDFunction{}
+}
+
+// RepositoryIDFunction implements provider::zenfra::repository_id.
+type RepositoryIDFunction struct{}
+
+func (f *RepositoryIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "repository_id"
+}
+
+func (f *RepositoryIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build the repository_id of a VCS stack source",
+		MarkdownDescription: "Returns the identifier the Zenfra API expects in a stack's `source.vcs.repository_id` for a repository of the given VCS provider. " +
+			"For `github` it is `owner/name`; for `gitlab` it is the full project path, where `owner` may include subgroups, e.g. `platform/networking`. " +
+			"A trailing `.git` on `name` is dropped. Owners and names that the provider would reject, such as URLs or nested GitHub owners, fail at plan time.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "provider",
+				MarkdownDescription: "The VCS provider, `github` or `gitlab`, as in `source.vcs.provider`.",
+			},
+			function.StringParameter{
+				Name:                "owner",
+				MarkdownDescription: "The GitHub user or organization, or the GitLab group path.",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The repository name.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RepositoryIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var vcsProvider, owner, name string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &vcsProvider, &owner, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	id, funcErr := repositoryID(vcsProvider, owner, name)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, id))
+}
+
+// repositoryID returns the repository_id for owner and name on vcsProvider, or an
+// error naming the offending argument.
+func repositoryID(vcsProvider, owner, name string) (string, *function.FuncError) {
+	if strings.Contains(owner, "://") || strings.Contains(name, "://") {
+		return "", function.NewFuncError("owner and name must be the parts of the repository path, not a URL.")
+	}
+	name = strings.TrimSuffix(name, ".git")
+
+	switch vcsProvider {
+	case "github":
+		if !githubOwner.MatchString(owner) {
+			return "", function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a GitHub user or organization: use up to 39 letters, digits, and single hyphens, not starting or ending with a hyphen.", owner))
+		}
+		if !githubName.MatchString(name) || name == "." || name == ".." {
+			return "", function.NewArgumentFuncError(2, fmt.Sprintf("%q is not a GitHub repository name: use letters, digits, '.', '-', and '_'.", name))
+		}
+	case "gitlab":
+		owner = strings.Trim(owner, "/")
+		if owner == "" {
+			return "", function.NewArgumentFuncError(1, "owner must be the GitLab group or user path of the project.")
+		}
+		for _, segment := range strings.Split(owner, "/") {
+			if !validGitLabSegment(segment) {
+				return "", function.NewArgumentFuncError(1, fmt.Sprintf("%q is not a GitLab group path: segment %q must start with a letter, digit, or '_', "+
+					"contain only letters, digits, '_', '-', and '.', and not end in '.git' or '.atom'.", owner, segment))
+			}
+		}
+		if !validGitLabSegment(name) {
+			return "", function.NewArgumentFuncError(2, fmt.Sprintf("%q is not a GitLab project name: it must start with a letter, digit, or '_', "+
+				"contain only letters, digits, '_', '-', and '.', and not end in '.atom'.", name))
+		}
+	default:
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("provider must be \"github\" or \"gitlab\", got %q.", vcsProvider))
+	}
+	return owner + "/" + name, nil
+}
+
+// validGitLabSegment reports whether s is a valid segment of a GitLab path.
+func validGitLabSegment(s string) bool {
+	return gitlabSegment.MatchString(s) && !strings.HasSuffix(s, ".git") && !strings.HasSuffix(s, ".atom")
+}
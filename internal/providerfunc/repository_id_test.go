@@ -0,0 +1,67 @@
+// ABOUTME: Unit tests for the provider::zenfra::repository_id function.
+// ABOUTME: Covers GitHub and GitLab identifiers, .git suffixes, nested GitLab groups, and rejected arguments.
+package providerfunc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRepositoryIDFunction(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name                string
+		provider, owner, in string
+		want                string
+		wantArg             *int64
+	}{
+		{name: "github", provider: "github", owner: "acme", in: "network", want: "acme/network"},
+		{name: "github .git suffix", provider: "github", owner: "acme", in: "network.git", want: "acme/network"},
+		{name: "github dotted name", provider: "github", owner: "acme-corp", in: "infra.modules", want: "acme-corp/infra.modules"},
+		{name: "github nested owner", provider: "github", owner: "acme/platform", in: "network", wantArg: ptr(1)},
+		{name: "github owner with trailing hyphen", provider: "github", owner: "acme-", in: "network", wantArg: ptr(1)},
+		{name: "github name with slash", provider: "github", owner: "acme", in: "infra/network", wantArg: ptr(2)},
+		{name: "gitlab", provider: "gitlab", owner: "acme", in: "network", want: "acme/network"},
+		{name: "gitlab subgroups", provider: "gitlab", owner: "/acme/platform/", in: "network.git", want: "acme/platform/network"},
+		{name: "gitlab group ending in .git", provider: "gitlab", owner: "acme/tools.git", in: "network", wantArg: ptr(1)},
+		{name: "gitlab empty owner", provider: "gitlab", owner: "/", in: "network", wantArg: ptr(1)},
+		{name: "gitlab name ending in .atom", provider: "gitlab", owner: "acme", in: "feed.atom", wantArg: ptr(2)},
+		{name: "unknown provider", provider: "bitbucket", owner: "acme", in: "network", wantArg: ptr(0)},
+		{name: "url", provider: "github", owner: "https://github.com/acme", in: "network"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(tt.provider), types.StringValue(tt.owner), types.StringValue(tt.in),
+				}),
+			}
+			resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewRepositoryIDFunction().Run(ctx, req, resp)
+
+			if tt.want == "" {
+				if resp.Error == nil {
+					t.Fatalf("expected an error, got %s", resp.Result.Value())
+				}
+				if tt.wantArg != nil && (resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != *tt.wantArg) {
+					t.Errorf("expected the error on argument %d, got %v", *tt.wantArg, resp.Error)
+				}
+				return
+			}
+			if resp.Error != nil {
+				t.Fatalf("Run: %s", resp.Error)
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func ptr(v int64) *int64 { return &v }
@@ -0,0 +1,105 @@
+// ABOUTME: Not-found grace period for resource Reads shortly after the object was written.
+// ABOUTME: Records write times in private state and retries 404s with exponential backoff.
+package readgrace
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// GracePeriod is how long after a create or update a 404 on Read is treated as
+// read-replica lag rather than the object having been deleted.
+const GracePeriod = 30 * time.Second
+
+const (
+	privateKey   = "zenfra_last_write"
+	initialDelay = 250 * time.Millisecond
+	maxDelay     = 4 * time.Second
+)
+
+// now and after are replaced in tests.
+var (
+	now   = time.Now
+	after = time.After
+)
+
+type lastWrite struct {
+	WrittenAt time.Time `json:"written_at"`
+}
+
+// PrivateSetter is the write side of a resource's private state, such as
+// resource.CreateResponse.Private.
+type PrivateSetter interface {
+	SetKey(ctx context.Context, key string, value []byte) diag.Diagnostics
+}
+
+// PrivateGetter is the read side of a resource's private state, such as
+// resource.ReadRequest.Private.
+type PrivateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// MarkWritten records in private state that the object was just created or updated, so
+// the next Read within GracePeriod retries 404s. It is a no-op when private is nil, as
+// it is for responses built outside the framework.
+func MarkWritten[T any, P interface {
+	*T
+	PrivateSetter
+}](ctx context.Context, private P) diag.Diagnostics {
+	if private == nil {
+		return nil
+	}
+	value, err := json.Marshal(lastWrite{WrittenAt: now().UTC()})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Error Recording Write Time", err.Error())
+		return diags
+	}
+	return private.SetKey(ctx, privateKey, value)
+}
+
+// Get calls get and returns its result. If get fails with a not-found error and private
+// state shows the object was written less than GracePeriod ago, get is retried with
+// exponential backoff until it succeeds, fails differently, or the grace period ends.
+func Get[T any](ctx context.Context, private PrivateGetter, get func(context.Context) (T, error)) (T, error) {
+	deadline, ok := graceDeadline(ctx, private)
+	delay := initialDelay
+	for {
+		v, err := get(ctx)
+		if err == nil || !ok || !zenfraclient.IsNotFound(err) {
+			return v, err
+		}
+
+		remaining := deadline.Sub(now())
+		if remaining <= 0 {
+			return v, err
+		}
+		select {
+		case <-ctx.Done():
+			return v, err
+		case <-after(min(delay, remaining)):
+		}
+		delay = min(delay*2, maxDelay)
+	}
+}
+
+// graceDeadline returns when the grace period for the last recorded write ends. A
+// missing or unreadable entry means there is no grace period.
+func graceDeadline(ctx context.Context, private PrivateGetter) (time.Time, bool) {
+	if private == nil {
+		return time.Time{}, false
+	}
+	value, diags := private.GetKey(ctx, privateKey)
+	if diags.HasError() || len(value) == 0 {
+		return time.Time{}, false
+	}
+	var w lastWrite
+	if err := json.Unmarshal(value, &w); err != nil || w.WrittenAt.IsZero() {
+		return time.Time{}, false
+	}
+	return w.WrittenAt.Add(GracePeriod), true
+}
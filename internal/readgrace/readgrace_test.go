@@ -0,0 +1,179 @@
+// ABOUTME: Unit tests for the not-found grace period applied to resource Reads.
+// ABOUTME: Uses an in-memory private state and a fake clock so no test actually sleeps.
+package readgrace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type privateState map[string][]byte
+
+func (p privateState) GetKey(_ context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func (p *privateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	if *p == nil {
+		*p = privateState{}
+	}
+	(*p)[key] = value
+	return nil
+}
+
+// fakeClock replaces now and after for the duration of a test. Waiting advances the clock
+// immediately and records the requested delay.
+func fakeClock(t *testing.T, start time.Time) *[]time.Duration {
+	t.Helper()
+	current := start
+	var waits []time.Duration
+	now = func() time.Time { return current }
+	after = func(d time.Duration) <-chan time.Time {
+		waits = append(waits, d)
+		current = current.Add(d)
+		ch := make(chan time.Time, 1)
+		ch <- current
+		return ch
+	}
+	t.Cleanup(func() { now, after = time.Now, time.After })
+	return &waits
+}
+
+// notFoundFor returns a getter that fails with a 404 the first n calls.
+func notFoundFor(n int) (func(context.Context) (string, error), *int) {
+	calls := 0
+	return func(context.Context) (string, error) {
+		calls++
+		if calls <= n {
+			return "", &zenfraclient.NotFoundError{}
+		}
+		return "found", nil
+	}, &calls
+}
+
+func TestGet_RetriesNotFoundAfterRecentWrite(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	waits := fakeClock(t, start)
+	ctx := context.Background()
+
+	var private privateState
+	if diags := MarkWritten(ctx, &private); diags.HasError() {
+		t.Fatalf("MarkWritten: %v", diags)
+	}
+
+	get, calls := notFoundFor(3)
+	got, err := Get(ctx, private, get)
+	if err != nil || got != "found" {
+		t.Fatalf("expected the object after retries, got %q, %v", got, err)
+	}
+	if *calls != 4 {
+		t.Errorf("expected 4 calls, got %d", *calls)
+	}
+	want := []time.Duration{250 * time.Millisecond, 500 * time.Millisecond, time.Second}
+	if len(*waits) != len(want) {
+		t.Fatalf("expected waits %v, got %v", want, *waits)
+	}
+	for i := range want {
+		if (*waits)[i] != want[i] {
+			t.Errorf("expected waits %v, got %v", want, *waits)
+			break
+		}
+	}
+}
+
+func TestGet_GivesUpAtEndOfGracePeriod(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	waits := fakeClock(t, start)
+	ctx := context.Background()
+
+	var private privateState
+	MarkWritten(ctx, &private)
+
+	get, _ := notFoundFor(1000)
+	if _, err := Get(ctx, private, get); !zenfraclient.IsNotFound(err) {
+		t.Fatalf("expected the not-found error once the grace period ends, got %v", err)
+	}
+
+	var total time.Duration
+	for _, w := range *waits {
+		if w > maxDelay {
+			t.Errorf("wait %s exceeds the maximum backoff %s", w, maxDelay)
+		}
+		total += w
+	}
+	if total != GracePeriod {
+		t.Errorf("expected retries to stop after %s, waited %s", GracePeriod, total)
+	}
+}
+
+func TestGet_NoRetry(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	written := func(at time.Time) privateState {
+		var p privateState
+		now = func() time.Time { return at }
+		MarkWritten(ctx, &p)
+		return p
+	}
+
+	tests := []struct {
+		name    string
+		private PrivateGetter
+		err     error
+	}{
+		{name: "never written", private: privateState{}, err: &zenfraclient.NotFoundError{}},
+		{name: "nil private state", private: nil, err: &zenfraclient.NotFoundError{}},
+		{name: "written long ago", private: written(start.Add(-time.Hour)), err: &zenfraclient.NotFoundError{}},
+		{name: "malformed entry", private: privateState{privateKey: []byte(`"yesterday"`)}, err: &zenfraclient.NotFoundError{}},
+		{name: "other error", private: written(start), err: errors.New("connection reset")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			waits := fakeClock(t, start)
+			calls := 0
+			_, err := Get(ctx, tt.private, func(context.Context) (string, error) {
+				calls++
+				return "", tt.err
+			})
+			if err != tt.err {
+				t.Errorf("expected the original error, got %v", err)
+			}
+			if calls != 1 || len(*waits) != 0 {
+				t.Errorf("expected a single call without waiting, got %d calls and waits %v", calls, *waits)
+			}
+		})
+	}
+}
+
+func TestGet_StopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var private privateState
+	MarkWritten(ctx, &private)
+
+	after = func(time.Duration) <-chan time.Time { return nil }
+	t.Cleanup(func() { after = time.After })
+
+	get, calls := notFoundFor(1000)
+	if _, err := Get(ctx, private, get); !zenfraclient.IsNotFound(err) {
+		t.Fatalf("expected the not-found error, got %v", err)
+	}
+	if *calls != 1 {
+		t.Errorf("expected no retry after the context is done, got %d calls", *calls)
+	}
+}
+
+func TestMarkWritten_NilPrivateState(t *testing.T) {
+	var private *privateState
+	if diags := MarkWritten(context.Background(), private); diags.HasError() {
+		t.Fatalf("expected a nil private state to be ignored, got %v", diags)
+	}
+}
@@ -4,38 +4,43 @@ package api_token
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 // APITokenModel represents the Terraform state model for a Zenfra API token.
 type APITokenModel struct {
-	ID            types.String `tfsdk:"id"`
-	Name          types.String `tfsdk:"name"`
-	Description   types.String `tfsdk:"description"`
-	Role          types.String `tfsdk:"role"`
-	ExpiresInDays types.Int64  `tfsdk:"expires_in_days"`
-	Token         types.String `tfsdk:"token"`
-	TokenPrefix   types.String `tfsdk:"token_prefix"`
-	UsageCount    types.Int64  `tfsdk:"usage_count"`
-	LastUsedAt    types.String `tfsdk:"last_used_at"`
-	CreatedAt     types.String `tfsdk:"created_at"`
-	ExpiresAt     types.String `tfsdk:"expires_at"`
-	Active        types.Bool   `tfsdk:"active"`
+	ID                     types.String            `tfsdk:"id"`
+	OrganizationID         types.String            `tfsdk:"organization_id"`
+	Name                   types.String            `tfsdk:"name"`
+	Description            types.String            `tfsdk:"description"`
+	Role                   types.String            `tfsdk:"role"`
+	ExpiresInDays          types.Int64             `tfsdk:"expires_in_days"`
+	RotateBeforeExpiryDays types.Int64             `tfsdk:"rotate_before_expiry_days"`
+	Token                  types.String            `tfsdk:"token"`
+	TokenPrefix            types.String            `tfsdk:"token_prefix"`
+	UsageCount             types.Int64             `tfsdk:"usage_count"`
+	LastUsedAt             timeutil.TimestampValue `tfsdk:"last_used_at"`
+	CreatedAt              timeutil.TimestampValue `tfsdk:"created_at"`
+	ExpiresAt              timeutil.TimestampValue `tfsdk:"expires_at"`
+	Active                 types.Bool              `tfsdk:"active"`
 }
 
 // mapTokenToState converts an API Token response to an APITokenModel.
-// Note: Does NOT set Token or ExpiresInDays fields - Token is only available at creation time,
-// and ExpiresInDays is a write-only input parameter not returned by the API.
+// Note: Does NOT set OrganizationID, Token, ExpiresInDays, or RotateBeforeExpiryDays fields - Token is
+// only available at creation time, and the others are input parameters not returned by the API.
 func mapTokenToState(token *zenfraclient.Token) APITokenModel {
 	model := APITokenModel{
-		ID:          types.StringValue(token.ID),
-		Name:        types.StringValue(token.Name),
-		Role:        types.StringValue(token.Role),
-		TokenPrefix: types.StringValue(token.TokenPrefix),
-		UsageCount:  types.Int64Value(token.UsageCount),
-		Active:      types.BoolValue(token.Active),
-		CreatedAt:   types.StringValue(token.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
-		ExpiresAt:   types.StringValue(token.ExpiresAt.Format("2006-01-02T15:04:05Z07:00")),
+		ID:             types.StringValue(token.ID),
+		OrganizationID: types.StringNull(),
+		Name:           types.StringValue(token.Name),
+		Role:           types.StringValue(token.Role),
+		TokenPrefix:    types.StringValue(token.TokenPrefix),
+		UsageCount:     types.Int64Value(token.UsageCount),
+		Active:         types.BoolValue(token.Active),
+		CreatedAt:      timeutil.Timestamp(token.CreatedAt),
+		ExpiresAt:      timeutil.Timestamp(token.ExpiresAt),
+		LastUsedAt:     timeutil.TimestampPointer(token.LastUsedAt),
 	}
 
 	if token.Description != "" {
@@ -44,11 +49,5 @@ func mapTokenToState(token *zenfraclient.Token) APITokenModel {
 		model.Description = types.StringNull()
 	}
 
-	if token.LastUsedAt != nil {
-		model.LastUsedAt = types.StringValue(token.LastUsedAt.Format("2006-01-02T15:04:05Z07:00"))
-	} else {
-		model.LastUsedAt = types.StringNull()
-	}
-
 	return model
 }
@@ -5,21 +5,34 @@ package api_token
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 var (
-	_ resource.Resource                = &APITokenResource{}
-	_ resource.ResourceWithImportState = &APITokenResource{}
+	_ resource.Resource                   = &APITokenResource{}
+	_ resource.ResourceWithImportState    = &APITokenResource{}
+	_ resource.ResourceWithModifyPlan     = &APITokenResource{}
+	_ resource.ResourceWithValidateConfig = &APITokenResource{}
 )
 
+// now is the clock used to decide whether a token is due for rotation; replaced in tests.
+var now = time.Now
+
 // NewAPITokenResource is a constructor for the API token resource.
 func NewAPITokenResource() resource.Resource {
 	return &APITokenResource{}
@@ -27,7 +40,7 @@ func NewAPITokenResource() resource.Resource {
 
 // APITokenResource is the resource implementation.
 type APITokenResource struct {
-	client *zenfraclient.Client
+	client zenfraclient.TokenAPI
 }
 
 func (r *APITokenResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,6 +58,14 @@ func (r *APITokenResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID the token belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage a token of another organization the provider's token has access to. Changing it forces a new token.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"name": schema.StringAttribute{
 				Description: "The name of the API token.",
 				Required:    true,
@@ -54,13 +75,21 @@ func (r *APITokenResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Optional:    true,
 			},
 			"role": schema.StringAttribute{
-				Description: "The role for this API token (read, write, admin).",
+				Description: "The role for this API token: read, write, or admin.",
 				Required:    true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.TokenRoleRead, zenfraclient.TokenRoleWrite, zenfraclient.TokenRoleAdmin),
+				},
 			},
 			"expires_in_days": schema.Int64Attribute{
 				Description: "Number of days until the token expires. 0 means no expiration. Defaults to 90 days if not specified.",
 				Optional:    true,
 			},
+			"rotate_before_expiry_days": schema.Int64Attribute{
+				Description: "If set, the token is replaced during any plan made when fewer than this many days remain before expires_at. " +
+					"Combine with lifecycle create_before_destroy so the new token exists before the old one is revoked. Must be less than expires_in_days.",
+				Optional: true,
+			},
 			"token": schema.StringAttribute{
 				Description: "The API token value. Only available after creation.",
 				Computed:    true,
@@ -82,10 +111,12 @@ func (r *APITokenResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"last_used_at": schema.StringAttribute{
 				Description: "Timestamp when the token was last used.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the token was created.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -93,6 +124,7 @@ func (r *APITokenResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 			},
 			"expires_at": schema.StringAttribute{
 				Description: "Timestamp when the token expires.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -106,19 +138,94 @@ func (r *APITokenResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
-func (r *APITokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+func (r *APITokenResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config APITokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RotateBeforeExpiryDays.IsNull() || config.RotateBeforeExpiryDays.IsUnknown() {
+		return
+	}
+	rotate := config.RotateBeforeExpiryDays.ValueInt64()
+	if rotate < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_before_expiry_days"), "Invalid Rotation Threshold",
+			"rotate_before_expiry_days must be at least 1.")
+		return
+	}
+
+	// Without an explicit lifetime the API applies its default, which the threshold must also fit within.
+	lifetime := int64(defaultExpiresInDays)
+	if !config.ExpiresInDays.IsNull() {
+		if config.ExpiresInDays.IsUnknown() {
+			return
+		}
+		lifetime = config.ExpiresInDays.ValueInt64()
+	}
+	if lifetime == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_before_expiry_days"), "Invalid Rotation Threshold",
+			"rotate_before_expiry_days has no effect on a token that never expires (expires_in_days = 0).")
+		return
+	}
+	if rotate >= lifetime {
+		resp.Diagnostics.AddAttributeError(path.Root("rotate_before_expiry_days"), "Invalid Rotation Threshold",
+			fmt.Sprintf("rotate_before_expiry_days (%d) must be less than the token lifetime of %d days, or every plan would replace the token.", rotate, lifetime))
+	}
+}
+
+// ModifyPlan plans a replacement when the token is within rotate_before_expiry_days of
+// expiring, then checks the resulting plan, so protect_resource_types also covers the
+// destroy a rotation causes.
+func (r *APITokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.planRotation(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	req.Plan = resp.Plan
+	permcheck.Check(ctx, r.client, "zenfra_api_token", "api_token", req, resp)
+}
+
+// planRotation marks expires_at unknown and requires replacement when the token is due
+// for rotation. Without the unknown value the plan would equal the state, and Terraform
+// ignores a replacement on an attribute that does not change.
+func (r *APITokenResource) planRotation(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state APITokenModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RotateBeforeExpiryDays.IsNull() || plan.RotateBeforeExpiryDays.IsUnknown() {
 		return
 	}
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T.", req.ProviderData),
-		)
+	if state.ExpiresAt.IsNull() || state.ExpiresAt.IsUnknown() {
 		return
 	}
-	r.client = client
+
+	due, err := rotationDue(state.ExpiresAt.ValueString(), plan.RotateBeforeExpiryDays.ValueInt64(), now())
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Check Token Expiry",
+			fmt.Sprintf("Could not parse expires_at %q, skipping rotation check: %s", state.ExpiresAt.ValueString(), err))
+		return
+	}
+	if !due {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), timeutil.NewTimestampUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+}
+
+func (r *APITokenResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_api_token")
+	}
 }
 
 func (r *APITokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -128,6 +235,8 @@ func (r *APITokenResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
 	createReq := zenfraclient.CreateTokenRequest{
 		Name: plan.Name.ValueString(),
 		Role: plan.Role.ValueString(),
@@ -147,9 +256,12 @@ func (r *APITokenResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	state := mapTokenToState(&createResp.TokenObj)
+	state.OrganizationID = plan.OrganizationID
 	state.Token = types.StringValue(createResp.Token)
 	state.ExpiresInDays = plan.ExpiresInDays
+	state.RotateBeforeExpiryDays = plan.RotateBeforeExpiryDays
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -160,12 +272,21 @@ func (r *APITokenResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	token, err := r.client.GetToken(ctx, state.ID.ValueString())
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	token, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.Token, error) {
+		return r.client.GetToken(ctx, state.ID.ValueString())
+	})
 	if err != nil {
-		if zenfraclient.IsNotFound(err) {
+		if r.client.IsNotFoundOnRead(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading API Token",
+				fmt.Sprintf("Could not read token ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
 		resp.Diagnostics.AddError("Error Reading API Token",
 			fmt.Sprintf("Could not read token ID %s: %s", state.ID.ValueString(), err))
 		return
@@ -173,8 +294,10 @@ func (r *APITokenResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	newState := mapTokenToState(token)
 	// Preserve write-once values from current state
+	newState.OrganizationID = state.OrganizationID
 	newState.Token = state.Token
 	newState.ExpiresInDays = state.ExpiresInDays
+	newState.RotateBeforeExpiryDays = state.RotateBeforeExpiryDays
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
@@ -187,6 +310,8 @@ func (r *APITokenResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	token, err := r.client.GetToken(ctx, state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading API Token", fmt.Sprintf("Could not read token: %s", err))
@@ -194,8 +319,11 @@ func (r *APITokenResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	newState := mapTokenToState(token)
+	newState.OrganizationID = state.OrganizationID
 	newState.Token = state.Token
 	newState.ExpiresInDays = state.ExpiresInDays
+	newState.RotateBeforeExpiryDays = plan.RotateBeforeExpiryDays
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
@@ -206,6 +334,8 @@ func (r *APITokenResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	err := r.client.DeleteToken(ctx, state.ID.ValueString())
 	if err != nil {
 		if zenfraclient.IsNotFound(err) {
@@ -217,5 +347,21 @@ func (r *APITokenResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *APITokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importguard.PassthroughOrganizationID(ctx, r.client, "API token", importguard.Token(r.client), req, resp)
+}
+
+// defaultExpiresInDays is the token lifetime the API applies when expires_in_days is omitted.
+const defaultExpiresInDays = 90
+
+// rotationDue reports whether a token expiring at expiresAt (RFC 3339) has fewer than
+// thresholdDays left at the given time. Tokens that never expire are never due.
+func rotationDue(expiresAt string, thresholdDays int64, at time.Time) (bool, error) {
+	expiry, err := timeutil.Parse(expiresAt)
+	if err != nil {
+		return false, err
+	}
+	if expiry.IsZero() {
+		return false, nil
+	}
+	return expiry.Sub(at) < time.Duration(thresholdDays)*24*time.Hour, nil
 }
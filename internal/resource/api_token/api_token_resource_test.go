@@ -1,13 +1,20 @@
 // ABOUTME: Unit tests for the zenfra_api_token resource model mapping.
-// ABOUTME: Verifies correct conversion and write-once token handling.
+// ABOUTME: Verifies correct conversion, write-once token handling, and rotation planning.
 package api_token
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
 )
 
 func TestMapTokenToState(t *testing.T) {
@@ -34,8 +41,8 @@ func TestMapTokenToState(t *testing.T) {
 				Name:        types.StringValue("CI/CD Token"),
 				Description: types.StringValue("Token for CI pipeline"),
 				Active:      types.BoolValue(true),
-				CreatedAt:   types.StringValue("2026-02-11T10:00:00Z"),
-				ExpiresAt:   types.StringValue("2026-05-11T10:00:00Z"),
+				CreatedAt:   timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				ExpiresAt:   timeutil.NewTimestampValue("2026-05-11T10:00:00Z"),
 			},
 		},
 		{
@@ -52,8 +59,8 @@ func TestMapTokenToState(t *testing.T) {
 				Name:        types.StringValue("Deploy Token"),
 				Description: types.StringNull(),
 				Active:      types.BoolValue(false),
-				CreatedAt:   types.StringValue("2026-02-11T10:00:00Z"),
-				ExpiresAt:   types.StringValue("2026-05-11T10:00:00Z"),
+				CreatedAt:   timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				ExpiresAt:   timeutil.NewTimestampValue("2026-05-11T10:00:00Z"),
 			},
 		},
 	}
@@ -80,3 +87,122 @@ func TestMapTokenToState(t *testing.T) {
 		})
 	}
 }
+
+func TestRotationDue(t *testing.T) {
+	at := time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		expiresAt string
+		threshold int64
+		want      bool
+	}{
+		{name: "well before threshold", expiresAt: "2026-07-01T10:00:00Z", threshold: 14, want: false},
+		{name: "exactly at threshold", expiresAt: "2026-05-15T10:00:00Z", threshold: 14, want: false},
+		{name: "inside threshold", expiresAt: "2026-05-10T10:00:00Z", threshold: 14, want: true},
+		{name: "already expired", expiresAt: "2026-04-01T10:00:00Z", threshold: 14, want: true},
+		{name: "never expires", expiresAt: "0001-01-01T00:00:00Z", threshold: 14, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rotationDue(tt.expiresAt, tt.threshold, at)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+
+	if _, err := rotationDue("not-a-time", 14, at); err == nil {
+		t.Error("expected error for unparseable expires_at")
+	}
+}
+
+// tokenState returns the state of a token expiring at expiresAt that rotates 14 days before.
+func tokenState(t *testing.T, r *APITokenResource, expiresAt string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	model := &APITokenModel{
+		ID:                     types.StringValue("tok-1"),
+		Name:                   types.StringValue("ci"),
+		Description:            types.StringNull(),
+		Role:                   types.StringValue(zenfraclient.TokenRoleWrite),
+		ExpiresInDays:          types.Int64Value(90),
+		RotateBeforeExpiryDays: types.Int64Value(14),
+		Token:                  types.StringValue("zf_secret"),
+		TokenPrefix:            types.StringValue("zf_secre"),
+		UsageCount:             types.Int64Value(3),
+		LastUsedAt:             timeutil.NewTimestampNull(),
+		CreatedAt:              timeutil.NewTimestampValue("2026-02-10T10:00:00Z"),
+		ExpiresAt:              timeutil.NewTimestampValue(expiresAt),
+		Active:                 types.BoolValue(true),
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("setting state: %v", diags)
+	}
+	return state
+}
+
+func TestAPITokenResource_ModifyPlanRotation(t *testing.T) {
+	ctx := context.Background()
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Date(2026, 5, 1, 10, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name        string
+		expiresAt   string
+		protected   []string
+		wantReplace bool
+		wantErr     string
+	}{
+		{name: "not due", expiresAt: "2026-07-01T10:00:00Z"},
+		{name: "due", expiresAt: "2026-05-10T10:00:00Z", wantReplace: true},
+		{
+			name:        "due but protected",
+			expiresAt:   "2026-05-10T10:00:00Z",
+			protected:   []string{"zenfra_api_token"},
+			wantReplace: true,
+			wantErr:     "Resource Type Is Protected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &zenfrafake.Client{}
+			fake.ProtectedResourceTypes = tt.protected
+			r := &APITokenResource{client: fake}
+			state := tokenState(t, r, tt.expiresAt)
+			// An unchanged configuration plans the prior state.
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(state)}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(state), State: state}, resp)
+
+			var expiresAt timeutil.TimestampValue
+			resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+			switch {
+			case tt.wantErr == "" && resp.Diagnostics.HasError():
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			case tt.wantErr != "" && (!resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.wantErr):
+				t.Fatalf("expected error %q, got %v", tt.wantErr, resp.Diagnostics)
+			}
+
+			replaces := len(resp.RequiresReplace) == 1 && resp.RequiresReplace[0].Equal(path.Root("expires_at"))
+			if replaces != tt.wantReplace {
+				t.Errorf("requires replace = %v, want %v", resp.RequiresReplace, tt.wantReplace)
+			}
+			// Terraform only replaces when a RequiresReplace attribute differs from the state.
+			if expiresAt.IsUnknown() != tt.wantReplace {
+				t.Errorf("planned expires_at = %s, want unknown %v", expiresAt, tt.wantReplace)
+			}
+		})
+	}
+}
@@ -1,27 +1,37 @@
-// ABOUTME: Terraform state models for the zenfra_configuration_bundle resource.
+// ABOUTME: Terraform state models for the zenfra_bundle resource.
 // ABOUTME: Maps between API Bundle types and Terraform schema types including nested env vars and mounted files.
 package bundle
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/labels"
+	"github.com/zenfra/terraform-provider-zenfra/internal/managedlock"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 // BundleModel represents the Terraform state model for a Zenfra configuration bundle.
 type BundleModel struct {
-	ID                  types.String `tfsdk:"id"`
-	OrganizationID      types.String `tfsdk:"organization_id"`
-	SpaceID             types.String `tfsdk:"space_id"`
-	Name                types.String `tfsdk:"name"`
-	Slug                types.String `tfsdk:"slug"`
-	Description         types.String `tfsdk:"description"`
-	Labels              types.List   `tfsdk:"labels"`
-	ContentVersion      types.Int64  `tfsdk:"content_version"`
-	AttachedStacksCount types.Int64  `tfsdk:"attached_stacks_count"`
-	EnvironmentVariable types.Set    `tfsdk:"environment_variable"`
-	MountedFile         types.Set    `tfsdk:"mounted_file"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	UpdatedAt           types.String `tfsdk:"updated_at"`
+	ID                  types.String            `tfsdk:"id"`
+	OrganizationID      types.String            `tfsdk:"organization_id"`
+	SpaceID             types.String            `tfsdk:"space_id"`
+	Name                types.String            `tfsdk:"name"`
+	Slug                types.String            `tfsdk:"slug"`
+	Description         types.String            `tfsdk:"description"`
+	Labels              labels.ListValue        `tfsdk:"labels"`
+	ContentVersion      types.Int64             `tfsdk:"content_version"`
+	AttachedStacksCount types.Int64             `tfsdk:"attached_stacks_count"`
+	EnvironmentVariable types.Set               `tfsdk:"environment_variable"`
+	MountedFile         types.Set               `tfsdk:"mounted_file"`
+	ValidateContent     types.Bool              `tfsdk:"validate_content"`
+	ManagedExclusively  types.Bool              `tfsdk:"managed_exclusively"`
+	CreatedAt           timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt           timeutil.TimestampValue `tfsdk:"updated_at"`
 }
 
 // EnvVariableModel represents an environment variable block in the bundle.
@@ -32,12 +42,34 @@ type EnvVariableModel struct {
 	Description types.String `tfsdk:"description"`
 }
 
-// MountedFileModel represents a mounted file block in the bundle.
+// MountedFileModel represents a mounted file block in the bundle. Exactly one of
+// Content and Source is set; files read from Source keep only their hash in state.
 type MountedFileModel struct {
-	Path        types.String `tfsdk:"path"`
-	Content     types.String `tfsdk:"content"`
-	Secret      types.Bool   `tfsdk:"secret"`
-	Description types.String `tfsdk:"description"`
+	Path          types.String `tfsdk:"path"`
+	Content       types.String `tfsdk:"content"`
+	Source        types.String `tfsdk:"source"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Secret        types.Bool   `tfsdk:"secret"`
+	Description   types.String `tfsdk:"description"`
+}
+
+// mountedFileContent returns the content of a mounted file, reading it from disk when
+// source is set.
+func mountedFileContent(f MountedFileModel) (string, error) {
+	if f.Source.IsNull() {
+		return f.Content.ValueString(), nil
+	}
+	data, err := os.ReadFile(f.Source.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("reading source of mounted file %s: %w", f.Path.ValueString(), err)
+	}
+	return string(data), nil
+}
+
+// contentHash returns the hex-encoded SHA-256 of content, as stored in content_sha256.
+func contentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
 }
 
 // mapBundleToState converts an API Bundle response to a BundleModel for Terraform state.
@@ -49,8 +81,9 @@ func mapBundleToState(bundle *zenfraclient.Bundle) BundleModel {
 		Name:                types.StringValue(bundle.Name),
 		ContentVersion:      types.Int64Value(bundle.ContentVersion),
 		AttachedStacksCount: types.Int64Value(bundle.AttachedStacksCount),
-		CreatedAt:           types.StringValue(bundle.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
-		UpdatedAt:           types.StringValue(bundle.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
+		ManagedExclusively:  managedlock.Value(bundle.ManagedLock),
+		CreatedAt:           timeutil.Timestamp(bundle.CreatedAt),
+		UpdatedAt:           timeutil.Timestamp(bundle.UpdatedAt),
 	}
 
 	if bundle.Slug != "" {
@@ -1,4 +1,4 @@
-// ABOUTME: Implements the zenfra_configuration_bundle Terraform resource with full CRUD lifecycle.
+// ABOUTME: Implements the zenfra_bundle Terraform resource, and its deprecated former name zenfra_configuration_bundle, with full CRUD lifecycle.
 // ABOUTME: Manages Zenfra configuration bundles including environment variables and mounted files with secret preservation.
 package bundle
 
@@ -15,13 +15,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/labels"
+	"github.com/zenfra/terraform-provider-zenfra/internal/managedlock"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/statemove"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 var (
-	_ resource.Resource                = &BundleResource{}
-	_ resource.ResourceWithImportState = &BundleResource{}
+	_ resource.Resource                   = &BundleResource{}
+	_ resource.ResourceWithImportState    = &BundleResource{}
+	_ resource.ResourceWithModifyPlan     = &BundleResource{}
+	_ resource.ResourceWithValidateConfig = &BundleResource{}
+	_ resource.ResourceWithMoveState      = &BundleResource{}
+)
+
+// Type names of the bundle resource. zenfra_configuration_bundle is the name before
+// the resource was renamed; it stays available, deprecated, until the next major version.
+const (
+	typeName       = "zenfra_bundle"
+	legacyTypeName = "zenfra_configuration_bundle"
 )
 
 // NewBundleResource is a constructor for the bundle resource.
@@ -29,13 +49,28 @@ func NewBundleResource() resource.Resource {
 	return &BundleResource{}
 }
 
+// NewConfigurationBundleResource is a constructor for the bundle resource under its
+// deprecated name, zenfra_configuration_bundle.
+func NewConfigurationBundleResource() resource.Resource {
+	return &BundleResource{legacy: true}
+}
+
 // BundleResource is the resource implementation.
 type BundleResource struct {
-	client *zenfraclient.Client
+	client zenfraclient.BundleAPI
+	// legacy registers the resource as zenfra_configuration_bundle.
+	legacy bool
+}
+
+func (r *BundleResource) typeName() string {
+	if r.legacy {
+		return legacyTypeName
+	}
+	return typeName
 }
 
-func (r *BundleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_configuration_bundle"
+func (r *BundleResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.typeName()
 }
 
 func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -50,10 +85,13 @@ func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 			"organization_id": schema.StringAttribute{
-				Description: "The organization ID this bundle belongs to.",
-				Computed:    true,
+				Description: "The organization ID this bundle belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the bundle in another organization the token has access to. Changing it forces a new bundle.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"space_id": schema.StringAttribute{
@@ -65,9 +103,12 @@ func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:    true,
 			},
 			"slug": schema.StringAttribute{
-				Description: "URL-friendly identifier. Computed from name if not specified.",
+				Description: "URL-friendly identifier of lowercase letters, digits, and hyphens. Computed from name if not specified.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					validators.Slug(),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -77,7 +118,8 @@ func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 			},
 			"labels": schema.ListAttribute{
-				Description: "Labels for categorizing the bundle.",
+				Description: "Labels for categorizing the bundle. Their order is not significant: labels reordered in the Zenfra UI are not a diff.",
+				CustomType:  labels.NewListType(),
 				Optional:    true,
 				ElementType: types.StringType,
 			},
@@ -92,8 +134,16 @@ func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description: "Number of stacks this bundle is attached to.",
 				Computed:    true,
 			},
+			"validate_content": schema.BoolAttribute{
+				Description: "Check changed environment variables and mounted files with the API's dry-run validation during plan, " +
+					"so content that breaks a syntax, size, or forbidden-path rule fails the plan on the offending block " +
+					"instead of failing the apply after the bundle's metadata was already updated. Defaults to false.",
+				Optional: true,
+			},
+			"managed_exclusively": managedlock.Attribute("bundle"),
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the bundle was created.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -101,6 +151,7 @@ func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"updated_at": schema.StringAttribute{
 				Description: "Timestamp when the bundle was last updated.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 			},
 		},
@@ -110,7 +161,7 @@ func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"key": schema.StringAttribute{
-							Description: "The environment variable name.",
+							Description: "The environment variable name. Must contain only letters, digits, and underscores, not start with a digit, and be unique within the bundle.",
 							Required:    true,
 						},
 						"value": schema.StringAttribute{
@@ -136,14 +187,24 @@ func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"path": schema.StringAttribute{
-							Description: "The file path where the content will be mounted.",
+							Description: "The absolute file path where the content will be mounted. Must be unique within the bundle.",
 							Required:    true,
 						},
 						"content": schema.StringAttribute{
-							Description: "The file content.",
-							Required:    true,
+							Description: "The file content. Conflicts with source.",
+							Optional:    true,
 							Sensitive:   true,
 						},
+						"source": schema.StringAttribute{
+							Description: "Path to a local file to upload instead of inline content, relative to the directory Terraform runs in " +
+								"(use path.module for files next to the configuration). Only the content hash is kept in state; " +
+								"the file is uploaded again whenever its hash changes. Conflicts with content.",
+							Optional: true,
+						},
+						"content_sha256": schema.StringAttribute{
+							Description: "Hex-encoded SHA-256 of the file content, used to detect changes to source files and to the content stored in Zenfra.",
+							Computed:    true,
+						},
 						"secret": schema.BoolAttribute{
 							Description: "Whether this file is secret. Secret files are write-only.",
 							Optional:    true,
@@ -159,21 +220,151 @@ func (r *BundleResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 		},
 	}
+	if r.legacy {
+		resp.Schema.DeprecationMessage = "zenfra_configuration_bundle has been renamed to zenfra_bundle. Move each bundle " +
+			"to the new name with a moved block (Terraform 1.8 or later); the bundle itself is not changed."
+	}
 }
 
-func (r *BundleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// MoveState moves bundles managed as zenfra_configuration_bundle to zenfra_bundle.
+func (r *BundleResource) MoveState(ctx context.Context) []resource.StateMover {
+	if r.legacy {
+		return nil
+	}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	return []resource.StateMover{statemove.Renamed(legacyTypeName, schemaResp.Schema)}
+}
+
+// ValidateConfig checks environment variable keys and mounted file paths, and that each
+// mounted file sets exactly one of content and source.
+func (r *BundleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var vars types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("environment_variable"), &vars)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !vars.IsNull() && !vars.IsUnknown() {
+		var varModels []EnvVariableModel
+		resp.Diagnostics.Append(vars.ElementsAs(ctx, &varModels, false)...)
+		for _, problem := range environmentVariableProblems(varModels) {
+			resp.Diagnostics.AddAttributeError(path.Root("environment_variable"), "Invalid Environment Variable", problem)
+		}
+	}
+
+	var files types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("mounted_file"), &files)...)
+	if resp.Diagnostics.HasError() || files.IsNull() || files.IsUnknown() {
+		return
+	}
+
+	var models []MountedFileModel
+	resp.Diagnostics.Append(files.ElementsAs(ctx, &models, false)...)
+	for _, problem := range mountedFileProblems(models) {
+		resp.Diagnostics.AddAttributeError(path.Root("mounted_file"), "Invalid Mounted File", problem)
+	}
+	for _, f := range models {
+		// Unknown values may still resolve to null, so only check what is known.
+		hasContent := !f.Content.IsNull()
+		hasSource := !f.Source.IsNull()
+		switch {
+		case hasContent && hasSource && !f.Content.IsUnknown() && !f.Source.IsUnknown():
+			resp.Diagnostics.AddAttributeError(path.Root("mounted_file"), "Conflicting Mounted File Content",
+				fmt.Sprintf("Mounted file %s sets both content and source; set only one.", f.Path.ValueString()))
+		case !hasContent && !hasSource:
+			resp.Diagnostics.AddAttributeError(path.Root("mounted_file"), "Missing Mounted File Content",
+				fmt.Sprintf("Mounted file %s must set either content or source.", f.Path.ValueString()))
+		}
+	}
+}
+
+// ModifyPlan computes content_sha256 for each planned mounted file, reading source files
+// from disk, so a changed local file shows up as a diff. It then checks the content
+// against the API's size limit and, with validate_content set, checks changed content
+// with the API.
+func (r *BundleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, r.typeName(), "bundle", req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var files types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("mounted_file"), &files)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !files.IsNull() && !files.IsUnknown() {
+		hashed := withContentHashes(ctx, files, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("mounted_file"), hashed)...)
+	}
+
+	var plan BundleModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkContentSize(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.validateContent(ctx, req, resp)
+}
+
+// validateContent sends planned content that differs from state to the API's dry-run
+// validation and reports each violation on the block at fault. Content that is not yet
+// fully known is left to the apply. If the API cannot be asked, the plan gets a warning
+// rather than failing, since the content is validated again when it is stored.
+func (r *BundleResource) validateContent(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var plan BundleModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.ValidateContent.ValueBool() || r.client == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T.", req.ProviderData),
-		)
+	if !fullyKnown(ctx, plan.EnvironmentVariable) || !fullyKnown(ctx, plan.MountedFile) {
+		return
+	}
+
+	validateReq := zenfraclient.ValidateBundleContentRequest{}
+	if !req.State.Raw.IsNull() {
+		var state BundleModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.EnvironmentVariable.Equal(state.EnvironmentVariable) && plan.MountedFile.Equal(state.MountedFile) {
+			return
+		}
+		validateReq.BundleID = state.ID.ValueString()
+	} else if len(plan.EnvironmentVariable.Elements()) == 0 && len(plan.MountedFile.Elements()) == 0 {
 		return
 	}
-	r.client = client
+
+	validateReq.Content = buildContentRequest(ctx, plan, &resp.Diagnostics).Content
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+	result, err := r.client.ValidateBundleContent(ctx, validateReq)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Validate Bundle Content",
+			fmt.Sprintf("Could not check the bundle content before apply, so problems with it will only surface when it is stored: %s", err))
+		return
+	}
+	for _, v := range result.Violations {
+		resp.Diagnostics.AddAttributeError(violationPath(plan, v), "Invalid Bundle Content", v.Message)
+	}
+}
+
+func (r *BundleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource(r.typeName())
+	}
 }
 
 func (r *BundleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -183,6 +374,8 @@ func (r *BundleResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
 	createReq := zenfraclient.CreateBundleRequest{
 		Name:    plan.Name.ValueString(),
 		SpaceID: plan.SpaceID.ValueString(),
@@ -196,12 +389,10 @@ func (r *BundleResource) Create(ctx context.Context, req resource.CreateRequest,
 		createReq.Description = plan.Description.ValueString()
 	}
 	if !plan.Labels.IsNull() {
-		var labels []string
-		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &createReq.Labels, false)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
-		createReq.Labels = labels
 	}
 
 	bundle, err := r.client.CreateBundle(ctx, createReq)
@@ -229,11 +420,15 @@ func (r *BundleResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	state := mapBundleToState(bundle)
+	state.ValidateContent = plan.ValidateContent
 	// Preserve plan values for content blocks - API masks secret values
 	state.EnvironmentVariable = plan.EnvironmentVariable
-	state.MountedFile = plan.MountedFile
+	state.MountedFile = withContentHashes(ctx, plan.MountedFile, &resp.Diagnostics)
 	state.Labels = plan.Labels
+	state.ManagedExclusively = managedlock.Apply(ctx, r.client.SetBundleManagedLock, "bundle", bundle.ID,
+		plan.ManagedExclusively, state.ManagedExclusively, &resp.Diagnostics)
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -245,19 +440,27 @@ func (r *BundleResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	bundle, err := r.client.GetBundle(ctx, state.ID.ValueString())
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	bundle, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.Bundle, error) {
+		return r.client.GetBundle(ctx, state.ID.ValueString())
+	})
 	if err != nil {
-		if zenfraclient.IsNotFound(err) {
+		if r.client.IsNotFoundOnRead(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Bundle", fmt.Sprintf("Could not read bundle ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
 		resp.Diagnostics.AddError("Error Reading Bundle", fmt.Sprintf("Could not read bundle ID %s: %s", state.ID.ValueString(), err))
 		return
 	}
 
 	// Build prior secret maps from current state for secret value preservation
 	priorEnvVars := make(map[string]string)
-	priorFiles := make(map[string]string)
+	priorFiles := make(map[string]MountedFileModel)
 	if !state.EnvironmentVariable.IsNull() {
 		var envVars []EnvVariableModel
 		resp.Diagnostics.Append(state.EnvironmentVariable.ElementsAs(ctx, &envVars, false)...)
@@ -271,13 +474,12 @@ func (r *BundleResource) Read(ctx context.Context, req resource.ReadRequest, res
 		var files []MountedFileModel
 		resp.Diagnostics.Append(state.MountedFile.ElementsAs(ctx, &files, false)...)
 		for _, f := range files {
-			if f.Secret.ValueBool() {
-				priorFiles[f.Path.ValueString()] = f.Content.ValueString()
-			}
+			priorFiles[f.Path.ValueString()] = f
 		}
 	}
 
 	newState := mapBundleToState(bundle)
+	newState.ValidateContent = state.ValidateContent
 
 	// Rebuild env vars from API, preserving secret values from prior state
 	envVarObjType := types.ObjectType{AttrTypes: envVarAttrTypes()}
@@ -315,21 +517,36 @@ func (r *BundleResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if len(bundle.MountedFiles) > 0 {
 		var fileObjects []attr.Value
 		for _, f := range bundle.MountedFiles {
-			content := f.Content
-			if f.Secret && content == "" {
-				if prior, ok := priorFiles[f.Path]; ok {
-					content = prior
+			prior, hasPrior := priorFiles[f.Path]
+			masked := f.Secret && f.Content == ""
+
+			content := types.StringValue(f.Content)
+			source := types.StringNull()
+			hash := types.StringValue(contentHash(f.Content))
+			switch {
+			case hasPrior && !prior.Source.IsNull():
+				// Files uploaded from disk keep only their hash in state.
+				content = types.StringNull()
+				source = prior.Source
+				if masked {
+					hash = prior.ContentSHA256
 				}
+			case hasPrior && masked:
+				content = prior.Content
+				hash = types.StringValue(contentHash(prior.Content.ValueString()))
 			}
+
 			desc := types.StringNull()
 			if f.Description != "" {
 				desc = types.StringValue(f.Description)
 			}
 			obj, diags := types.ObjectValue(mountedFileAttrTypes(), map[string]attr.Value{
-				"path":        types.StringValue(f.Path),
-				"content":     types.StringValue(content),
-				"secret":      types.BoolValue(f.Secret),
-				"description": desc,
+				"path":           types.StringValue(f.Path),
+				"content":        content,
+				"source":         source,
+				"content_sha256": hash,
+				"secret":         types.BoolValue(f.Secret),
+				"description":    desc,
 			})
 			resp.Diagnostics.Append(diags...)
 			fileObjects = append(fileObjects, obj)
@@ -341,18 +558,8 @@ func (r *BundleResource) Read(ctx context.Context, req resource.ReadRequest, res
 		newState.MountedFile = types.SetNull(fileObjType)
 	}
 
-	// Rebuild labels
-	if len(bundle.Labels) > 0 {
-		var labelValues []attr.Value
-		for _, l := range bundle.Labels {
-			labelValues = append(labelValues, types.StringValue(l))
-		}
-		labelsList, diags := types.ListValue(types.StringType, labelValues)
-		resp.Diagnostics.Append(diags...)
-		newState.Labels = labelsList
-	} else {
-		newState.Labels = types.ListNull(types.StringType)
-	}
+	newState.Labels = labels.NewListValue(bundle.Labels)
+	newState.ManagedExclusively = managedlock.Refresh(bundle.ManagedLock, state.ManagedExclusively, "bundle", bundle.ID, &resp.Diagnostics)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
@@ -366,6 +573,8 @@ func (r *BundleResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	var bundle *zenfraclient.Bundle
 
 	// Update metadata if changed
@@ -381,12 +590,12 @@ func (r *BundleResource) Update(ctx context.Context, req resource.UpdateRequest,
 			updateReq.Description = &desc
 		}
 		if !plan.Labels.Equal(state.Labels) {
-			var labels []string
-			resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+			planned := []string{}
+			resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &planned, false)...)
 			if resp.Diagnostics.HasError() {
 				return
 			}
-			updateReq.Labels = &labels
+			updateReq.Labels = &planned
 		}
 		if !plan.SpaceID.Equal(state.SpaceID) {
 			spaceID := plan.SpaceID.ValueString()
@@ -430,10 +639,14 @@ func (r *BundleResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	newState := mapBundleToState(bundle)
+	newState.ValidateContent = plan.ValidateContent
 	newState.EnvironmentVariable = plan.EnvironmentVariable
-	newState.MountedFile = plan.MountedFile
+	newState.MountedFile = withContentHashes(ctx, plan.MountedFile, &resp.Diagnostics)
 	newState.Labels = plan.Labels
+	newState.ManagedExclusively = managedlock.Apply(ctx, r.client.SetBundleManagedLock, "bundle", bundle.ID,
+		plan.ManagedExclusively, state.ManagedExclusively, &resp.Diagnostics)
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
@@ -444,6 +657,8 @@ func (r *BundleResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	err := r.client.DeleteBundle(ctx, state.ID.ValueString())
 	if err != nil {
 		if zenfraclient.IsNotFound(err) {
@@ -454,7 +669,7 @@ func (r *BundleResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *BundleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importguard.PassthroughOrganizationID(ctx, r.client, "bundle", importguard.Bundle(r.client), req, resp)
 }
 
 // envVarAttrTypes returns the attribute types for an environment variable object.
@@ -470,13 +685,46 @@ func envVarAttrTypes() map[string]attr.Type {
 // mountedFileAttrTypes returns the attribute types for a mounted file object.
 func mountedFileAttrTypes() map[string]attr.Type {
 	return map[string]attr.Type{
-		"path":        types.StringType,
-		"content":     types.StringType,
-		"secret":      types.BoolType,
-		"description": types.StringType,
+		"path":           types.StringType,
+		"content":        types.StringType,
+		"source":         types.StringType,
+		"content_sha256": types.StringType,
+		"secret":         types.BoolType,
+		"description":    types.StringType,
 	}
 }
 
+// withContentHashes returns files with content_sha256 set on every mounted file whose
+// content is known, reading source files from disk.
+func withContentHashes(ctx context.Context, files types.Set, diags *diag.Diagnostics) types.Set {
+	if files.IsNull() || files.IsUnknown() {
+		return files
+	}
+
+	var models []MountedFileModel
+	diags.Append(files.ElementsAs(ctx, &models, false)...)
+	if diags.HasError() {
+		return files
+	}
+
+	for i, f := range models {
+		if f.Content.IsUnknown() || f.Source.IsUnknown() {
+			models[i].ContentSHA256 = types.StringUnknown()
+			continue
+		}
+		content, err := mountedFileContent(f)
+		if err != nil {
+			diags.AddAttributeError(path.Root("mounted_file"), "Error Reading Mounted File", err.Error())
+			return files
+		}
+		models[i].ContentSHA256 = types.StringValue(contentHash(content))
+	}
+
+	hashed, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: mountedFileAttrTypes()}, models)
+	diags.Append(d...)
+	return hashed
+}
+
 // buildContentRequest extracts env vars and mounted files from the plan into an API content request.
 func buildContentRequest(ctx context.Context, plan BundleModel, diags *diag.Diagnostics) zenfraclient.UpdateBundleContentRequest {
 	type bundleContent struct {
@@ -505,9 +753,14 @@ func buildContentRequest(ctx context.Context, plan BundleModel, diags *diag.Diag
 		var files []MountedFileModel
 		diags.Append(plan.MountedFile.ElementsAs(ctx, &files, false)...)
 		for _, f := range files {
+			fileContent, err := mountedFileContent(f)
+			if err != nil {
+				diags.AddAttributeError(path.Root("mounted_file"), "Error Reading Mounted File", err.Error())
+				continue
+			}
 			apiFile := zenfraclient.MountedFile{
 				Path:    f.Path.ValueString(),
-				Content: f.Content.ValueString(),
+				Content: fileContent,
 				Secret:  f.Secret.ValueBool(),
 			}
 			if !f.Description.IsNull() {
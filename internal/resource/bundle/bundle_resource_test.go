@@ -1,12 +1,17 @@
-// ABOUTME: Unit tests for the zenfra_configuration_bundle resource model mapping.
+// ABOUTME: Unit tests for the zenfra_bundle resource model mapping and its deprecated zenfra_configuration_bundle name.
 // ABOUTME: Verifies correct conversion between API Bundle types and Terraform state.
 package bundle
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -42,8 +47,8 @@ func TestMapBundleToState(t *testing.T) {
 				Description:         types.StringValue("Production configuration bundle"),
 				ContentVersion:      types.Int64Value(3),
 				AttachedStacksCount: types.Int64Value(2),
-				CreatedAt:           types.StringValue("2026-02-11T10:00:00Z"),
-				UpdatedAt:           types.StringValue("2026-02-11T12:00:00Z"),
+				CreatedAt:           timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				UpdatedAt:           timeutil.NewTimestampValue("2026-02-11T12:00:00Z"),
 			},
 		},
 		{
@@ -65,8 +70,8 @@ func TestMapBundleToState(t *testing.T) {
 				Description:         types.StringNull(),
 				ContentVersion:      types.Int64Value(0),
 				AttachedStacksCount: types.Int64Value(0),
-				CreatedAt:           types.StringValue("2026-02-11T10:00:00Z"),
-				UpdatedAt:           types.StringValue("2026-02-11T12:00:00Z"),
+				CreatedAt:           timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				UpdatedAt:           timeutil.NewTimestampValue("2026-02-11T12:00:00Z"),
 			},
 		},
 	}
@@ -96,3 +101,70 @@ func TestMapBundleToState(t *testing.T) {
 		})
 	}
 }
+
+func TestMountedFileContent(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	source := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(source, []byte("-----BEGIN CERTIFICATE-----\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	inline := MountedFileModel{Path: types.StringValue("/etc/a"), Content: types.StringValue("hello"), Source: types.StringNull()}
+	got, err := mountedFileContent(inline)
+	if err != nil || got != "hello" {
+		t.Errorf("inline content = %q, %v; want %q", got, err, "hello")
+	}
+
+	fromDisk := MountedFileModel{Path: types.StringValue("/etc/ssl/ca.pem"), Content: types.StringNull(), Source: types.StringValue(source)}
+	got, err = mountedFileContent(fromDisk)
+	if err != nil || got != "-----BEGIN CERTIFICATE-----\n" {
+		t.Errorf("source content = %q, %v", got, err)
+	}
+
+	missing := MountedFileModel{Path: types.StringValue("/etc/b"), Content: types.StringNull(), Source: types.StringValue(filepath.Join(dir, "missing"))}
+	if _, err := mountedFileContent(missing); err == nil {
+		t.Error("expected error for missing source file")
+	}
+}
+
+func TestContentHash(t *testing.T) {
+	t.Parallel()
+
+	// sha256("hello")
+	want := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if got := contentHash("hello"); got != want {
+		t.Errorf("contentHash = %s, want %s", got, want)
+	}
+}
+
+func TestBundleResource_LegacyName(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		resource       resource.Resource
+		wantType       string
+		wantDeprecated bool
+		wantMovers     int
+	}{
+		{resource: NewBundleResource(), wantType: "zenfra_bundle", wantMovers: 1},
+		{resource: NewConfigurationBundleResource(), wantType: "zenfra_configuration_bundle", wantDeprecated: true},
+	} {
+		metadataResp := &resource.MetadataResponse{}
+		tt.resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "zenfra"}, metadataResp)
+		if metadataResp.TypeName != tt.wantType {
+			t.Errorf("expected type %s, got %s", tt.wantType, metadataResp.TypeName)
+		}
+
+		schemaResp := &resource.SchemaResponse{}
+		tt.resource.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		if deprecated := schemaResp.Schema.DeprecationMessage != ""; deprecated != tt.wantDeprecated {
+			t.Errorf("%s: expected deprecated %t, got message %q", tt.wantType, tt.wantDeprecated, schemaResp.Schema.DeprecationMessage)
+		}
+
+		if movers := tt.resource.(resource.ResourceWithMoveState).MoveState(ctx); len(movers) != tt.wantMovers {
+			t.Errorf("%s: expected %d state movers, got %d", tt.wantType, tt.wantMovers, len(movers))
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// ABOUTME: Plan-time checks for zenfra_bundle environment variables and mounted files.
+// ABOUTME: Catches invalid keys, relative paths, duplicates, and oversized content locally and places API dry-run violations on their block.
+package bundle
+
+import (
+	"context"
+	"fmt"
+	pathpkg "path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/payloadsize"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// envVarKeyPattern matches POSIX environment variable names.
+var envVarKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// environmentVariableProblems describes every invalid or duplicate key among vars.
+// Unknown keys are skipped, since they are checked again once known.
+func environmentVariableProblems(vars []EnvVariableModel) []string {
+	var problems []string
+	seen := make(map[string]bool, len(vars))
+	for _, v := range vars {
+		if v.Key.IsNull() || v.Key.IsUnknown() {
+			continue
+		}
+		key := v.Key.ValueString()
+		if !envVarKeyPattern.MatchString(key) {
+			problems = append(problems, fmt.Sprintf("Environment variable key %q is not a valid name: use only letters, digits, and underscores, and do not start with a digit.", key))
+			continue
+		}
+		if seen[key] {
+			problems = append(problems, fmt.Sprintf("Environment variable %s is set by more than one environment_variable block.", key))
+			continue
+		}
+		seen[key] = true
+	}
+	return problems
+}
+
+// mountedFileProblems describes every relative or duplicate path among files. Paths
+// that differ only in redundant separators or dot segments count as duplicates.
+func mountedFileProblems(files []MountedFileModel) []string {
+	var problems []string
+	seen := make(map[string]bool, len(files))
+	for _, f := range files {
+		if f.Path.IsNull() || f.Path.IsUnknown() {
+			continue
+		}
+		p := f.Path.ValueString()
+		if !pathpkg.IsAbs(p) {
+			problems = append(problems, fmt.Sprintf("Mounted file path %q must be absolute, e.g. /mnt/workspace/%s.", p, pathpkg.Base(p)))
+			continue
+		}
+		cleaned := pathpkg.Clean(p)
+		if seen[cleaned] {
+			problems = append(problems, fmt.Sprintf("Mounted file path %s is used by more than one mounted_file block.", cleaned))
+			continue
+		}
+		seen[cleaned] = true
+	}
+	return problems
+}
+
+// contentEntry is an environment variable or mounted file and the bytes it adds to the
+// bundle content.
+type contentEntry struct {
+	path path.Path
+	kind string // "Environment variable" or "Mounted file"
+	name string
+	size int
+}
+
+// checkContentSize adds an error when the planned content exceeds the API's bundle size
+// limit: on each entry that is too large by itself, or else on the largest entry, naming
+// the largest entries. Content that is not yet fully known is left to the apply.
+func checkContentSize(ctx context.Context, plan BundleModel, diags *diag.Diagnostics) {
+	if !fullyKnown(ctx, plan.EnvironmentVariable) || !fullyKnown(ctx, plan.MountedFile) {
+		return
+	}
+
+	var entries []contentEntry
+	total := 0
+	for _, element := range plan.EnvironmentVariable.Elements() {
+		var v EnvVariableModel
+		if obj, ok := element.(types.Object); !ok || obj.As(ctx, &v, basetypes.ObjectAsOptions{}).HasError() {
+			continue
+		}
+		entries = append(entries, contentEntry{
+			path: path.Root("environment_variable").AtSetValue(element),
+			kind: "Environment variable",
+			name: v.Key.ValueString(),
+			size: len(v.Value.ValueString()),
+		})
+	}
+	for _, element := range plan.MountedFile.Elements() {
+		var f MountedFileModel
+		if obj, ok := element.(types.Object); !ok || obj.As(ctx, &f, basetypes.ObjectAsOptions{}).HasError() {
+			continue
+		}
+		// Unreadable source files are reported when their content is hashed.
+		content, err := mountedFileContent(f)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, contentEntry{
+			path: path.Root("mounted_file").AtSetValue(element),
+			kind: "Mounted file",
+			name: f.Path.ValueString(),
+			size: len(content),
+		})
+	}
+	for _, e := range entries {
+		total += e.size
+	}
+
+	limit := zenfraclient.MaxBundleContentBytes
+	if total <= limit {
+		return
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].size > entries[j].size })
+	if entries[0].size > limit {
+		for _, e := range entries {
+			if e.size <= limit {
+				break
+			}
+			diags.AddAttributeError(e.path, "Bundle Content Too Large",
+				fmt.Sprintf("%s %s is %s, more than the %s the API accepts for the content of a bundle.",
+					e.kind, e.name, payloadsize.Format(e.size), payloadsize.Format(limit)))
+		}
+		return
+	}
+
+	largest := make([]string, 0, 3)
+	for _, e := range entries[:min(3, len(entries))] {
+		largest = append(largest, fmt.Sprintf("%s %s (%s)", strings.ToLower(e.kind), e.name, payloadsize.Format(e.size)))
+	}
+	diags.AddAttributeError(entries[0].path, "Bundle Content Too Large",
+		fmt.Sprintf("The bundle content is %s, more than the %s the API accepts. The largest entries are %s.",
+			payloadsize.Format(total), payloadsize.Format(limit), strings.Join(largest, ", ")))
+}
+
+// fullyKnown reports whether a content block set holds no unknown values, so it can be
+// sent for validation as it will be applied.
+func fullyKnown(ctx context.Context, blocks types.Set) bool {
+	value, err := blocks.ToTerraformValue(ctx)
+	return err == nil && value.IsFullyKnown()
+}
+
+// violationPath returns the path of the environment_variable or mounted_file block a
+// violation names, or of the whole set of blocks when no single block matches.
+func violationPath(plan BundleModel, v zenfraclient.BundleContentViolation) path.Path {
+	switch {
+	case v.EnvironmentVariable != "":
+		return blockPath(path.Root("environment_variable"), plan.EnvironmentVariable, "key", v.EnvironmentVariable)
+	case v.MountedFile != "":
+		return blockPath(path.Root("mounted_file"), plan.MountedFile, "path", pathpkg.Clean(v.MountedFile))
+	}
+	if len(plan.MountedFile.Elements()) > 0 {
+		return path.Root("mounted_file")
+	}
+	return path.Root("environment_variable")
+}
+
+// blockPath returns the path of the block in blocks whose attribute name equals want,
+// comparing mounted file paths after cleaning them, or root if there is none.
+func blockPath(root path.Path, blocks types.Set, name, want string) path.Path {
+	for _, element := range blocks.Elements() {
+		obj, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		value, ok := obj.Attributes()[name].(types.String)
+		if !ok {
+			continue
+		}
+		got := value.ValueString()
+		if name == "path" {
+			got = pathpkg.Clean(got)
+		}
+		if got == want {
+			return root.AtSetValue(element)
+		}
+	}
+	return root
+}
@@ -0,0 +1,272 @@
+// ABOUTME: Unit tests for the zenfra_bundle plan-time checks.
+// ABOUTME: Covers key syntax, mounted file paths, duplicates, content size, and placing API dry-run violations on their block.
+package bundle
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/labels"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func TestEnvironmentVariableProblems(t *testing.T) {
+	envVar := func(key string) EnvVariableModel {
+		return EnvVariableModel{Key: types.StringValue(key), Value: types.StringValue("v")}
+	}
+
+	tests := []struct {
+		name string
+		vars []EnvVariableModel
+		want []string
+	}{
+		{name: "valid", vars: []EnvVariableModel{envVar("AWS_REGION"), envVar("_private"), envVar("TF_VAR_x1")}},
+		{name: "leading digit", vars: []EnvVariableModel{envVar("1PASSWORD")}, want: []string{`"1PASSWORD" is not a valid name`}},
+		{name: "dash", vars: []EnvVariableModel{envVar("MY-VAR")}, want: []string{`"MY-VAR" is not a valid name`}},
+		{name: "empty", vars: []EnvVariableModel{envVar("")}, want: []string{`"" is not a valid name`}},
+		{
+			name: "duplicate",
+			vars: []EnvVariableModel{envVar("AWS_REGION"), {Key: types.StringValue("AWS_REGION"), Value: types.StringValue("other")}},
+			want: []string{"AWS_REGION is set by more than one"},
+		},
+		{name: "unknown skipped", vars: []EnvVariableModel{{Key: types.StringUnknown()}, {Key: types.StringUnknown()}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertProblems(t, environmentVariableProblems(tt.vars), tt.want)
+		})
+	}
+}
+
+func TestMountedFileProblems(t *testing.T) {
+	file := func(p string) MountedFileModel {
+		return MountedFileModel{Path: types.StringValue(p), Content: types.StringValue("x")}
+	}
+
+	tests := []struct {
+		name  string
+		files []MountedFileModel
+		want  []string
+	}{
+		{name: "valid", files: []MountedFileModel{file("/mnt/workspace/a.tfvars"), file("/etc/ssl/ca.pem")}},
+		{name: "relative", files: []MountedFileModel{file("config/a.tfvars")}, want: []string{`"config/a.tfvars" must be absolute, e.g. /mnt/workspace/a.tfvars`}},
+		{
+			name:  "duplicate after cleaning",
+			files: []MountedFileModel{file("/mnt/workspace/a.tfvars"), file("/mnt//workspace/./a.tfvars")},
+			want:  []string{"/mnt/workspace/a.tfvars is used by more than one"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertProblems(t, mountedFileProblems(tt.files), tt.want)
+		})
+	}
+}
+
+func assertProblems(t *testing.T, got, want []string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d problems, got %d: %q", len(want), len(got), got)
+	}
+	for i := range want {
+		if !strings.Contains(got[i], want[i]) {
+			t.Errorf("problem %d: expected %q to contain %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCheckContentSize(t *testing.T) {
+	ctx := context.Background()
+	envVars := func(values map[string]int) types.Set {
+		elements := make([]attr.Value, 0, len(values))
+		for key, size := range values {
+			elements = append(elements, types.ObjectValueMust(envVarAttrTypes(), map[string]attr.Value{
+				"key": types.StringValue(key), "value": types.StringValue(strings.Repeat("v", size)),
+				"secret": types.BoolValue(false), "description": types.StringNull(),
+			}))
+		}
+		return types.SetValueMust(types.ObjectType{AttrTypes: envVarAttrTypes()}, elements)
+	}
+	files := func(sizes map[string]int) types.Set {
+		elements := make([]attr.Value, 0, len(sizes))
+		for p, size := range sizes {
+			elements = append(elements, types.ObjectValueMust(mountedFileAttrTypes(), map[string]attr.Value{
+				"path": types.StringValue(p), "content": types.StringValue(strings.Repeat("f", size)), "source": types.StringNull(),
+				"content_sha256": types.StringValue(contentHash(strings.Repeat("f", size))), "secret": types.BoolValue(false), "description": types.StringNull(),
+			}))
+		}
+		return types.SetValueMust(types.ObjectType{AttrTypes: mountedFileAttrTypes()}, elements)
+	}
+	const kib = 1 << 10
+
+	tests := []struct {
+		name       string
+		vars       map[string]int
+		files      map[string]int
+		wantPaths  []string
+		wantDetail string
+	}{
+		{name: "within limit", vars: map[string]int{"TF_LOG": 5}, files: map[string]int{"/mnt/a": 1000 * kib}},
+		{
+			name:       "single file too large",
+			vars:       map[string]int{"TF_LOG": 5},
+			files:      map[string]int{"/mnt/state.json": 1536 * kib, "/mnt/a": 10},
+			wantPaths:  []string{"mounted_file[Value("},
+			wantDetail: "Mounted file /mnt/state.json is 1.5 MiB, more than the 1 MiB",
+		},
+		{
+			name:       "total too large",
+			vars:       map[string]int{"CA_BUNDLE": 300 * kib},
+			files:      map[string]int{"/mnt/a": 600 * kib, "/mnt/b": 200 * kib},
+			wantPaths:  []string{"mounted_file[Value("},
+			wantDetail: "The bundle content is 1.1 MiB, more than the 1 MiB the API accepts. The largest entries are mounted file /mnt/a (600 KiB), environment variable CA_BUNDLE (300 KiB), mounted file /mnt/b (200 KiB).",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			checkContentSize(ctx, BundleModel{EnvironmentVariable: envVars(tt.vars), MountedFile: files(tt.files)}, &diags)
+
+			errs := diags.Errors()
+			if len(errs) != len(tt.wantPaths) {
+				t.Fatalf("expected %d errors, got %v", len(tt.wantPaths), errs)
+			}
+			for i, want := range tt.wantPaths {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !strings.HasPrefix(withPath.Path().String(), want) {
+					t.Errorf("error %d: expected a path starting with %q, got %v", i, want, errs[i])
+				}
+				if !strings.Contains(errs[i].Detail(), tt.wantDetail) {
+					t.Errorf("error %d: expected detail to contain %q, got %q", i, tt.wantDetail, errs[i].Detail())
+				}
+			}
+		})
+	}
+}
+
+// contentPlan returns a plan creating a bundle with one environment variable and one
+// mounted file, and validate_content set to validate.
+func contentPlan(t *testing.T, validate bool) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&BundleResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	envVar := types.ObjectValueMust(envVarAttrTypes(), map[string]attr.Value{
+		"key": types.StringValue("TF_LOG"), "value": types.StringValue("debug"),
+		"secret": types.BoolValue(false), "description": types.StringNull(),
+	})
+	file := types.ObjectValueMust(mountedFileAttrTypes(), map[string]attr.Value{
+		"path": types.StringValue("/etc/ssl/ca.pem"), "content": types.StringValue("pem"), "source": types.StringNull(),
+		"content_sha256": types.StringUnknown(), "secret": types.BoolValue(false), "description": types.StringNull(),
+	})
+	model := BundleModel{
+		ID:                  types.StringUnknown(),
+		OrganizationID:      types.StringUnknown(),
+		SpaceID:             types.StringValue("space-1"),
+		Name:                types.StringValue("tls"),
+		Slug:                types.StringUnknown(),
+		Description:         types.StringNull(),
+		Labels:              labels.NewListNull(),
+		ContentVersion:      types.Int64Unknown(),
+		AttachedStacksCount: types.Int64Unknown(),
+		EnvironmentVariable: types.SetValueMust(envVar.Type(ctx), []attr.Value{envVar}),
+		MountedFile:         types.SetValueMust(file.Type(ctx), []attr.Value{file}),
+		ValidateContent:     types.BoolValue(validate),
+		CreatedAt:           timeutil.NewTimestampUnknown(),
+		UpdatedAt:           timeutil.NewTimestampUnknown(),
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	if diags := plan.Set(ctx, model); diags.HasError() {
+		t.Fatalf("setting plan: %v", diags)
+	}
+	return plan
+}
+
+func TestBundleResource_ValidateContent(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		validate     bool
+		violations   []zenfraclient.BundleContentViolation
+		err          error
+		wantCalled   bool
+		wantErrPaths []string
+		wantWarning  bool
+	}{
+		{name: "not requested", validate: false},
+		{name: "valid", validate: true, wantCalled: true},
+		{
+			name:     "violations on blocks",
+			validate: true,
+			violations: []zenfraclient.BundleContentViolation{
+				{MountedFile: "/etc//ssl/ca.pem", Rule: zenfraclient.BundleContentRuleForbiddenPath, Message: "/etc/ssl is reserved"},
+				{EnvironmentVariable: "TF_LOG", Rule: zenfraclient.BundleContentRuleSyntax, Message: "TF_LOG is set by Zenfra"},
+				{Rule: zenfraclient.BundleContentRuleSizeLimit, Message: "content exceeds 1 MiB"},
+			},
+			wantCalled:   true,
+			wantErrPaths: []string{"mounted_file[Value(", "environment_variable[Value(", "mounted_file"},
+		},
+		{name: "endpoint unavailable", validate: true, err: errors.New("404 Not Found"), wantCalled: true, wantWarning: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got zenfraclient.ValidateBundleContentRequest
+			called := false
+			fake := &zenfrafake.Client{
+				ValidateBundleContentFunc: func(_ context.Context, req zenfraclient.ValidateBundleContentRequest) (*zenfraclient.BundleContentValidation, error) {
+					called, got = true, req
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return &zenfraclient.BundleContentValidation{Violations: tt.violations}, nil
+				},
+			}
+			r := &BundleResource{client: fake}
+
+			plan := contentPlan(t, tt.validate)
+			state := tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+
+			if called != tt.wantCalled {
+				t.Fatalf("expected validation called = %v, got %v", tt.wantCalled, called)
+			}
+			if called && got.BundleID != "" {
+				t.Errorf("expected no bundle ID when creating, got %q", got.BundleID)
+			}
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.wantErrPaths) {
+				t.Fatalf("expected %d errors, got %v", len(tt.wantErrPaths), errs)
+			}
+			for i, want := range tt.wantErrPaths {
+				withPath, ok := errs[i].(interface{ Path() path.Path })
+				if !ok || !strings.HasPrefix(withPath.Path().String(), want) {
+					t.Errorf("error %d: expected a path starting with %q, got %v", i, want, errs[i])
+				}
+			}
+			if got := len(resp.Diagnostics.Warnings()) == 1; got != tt.wantWarning {
+				t.Errorf("expected warning %v, got %v", tt.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -8,7 +8,8 @@ import (
 
 // BundleAttachmentModel represents the Terraform state model for a bundle-to-stack attachment.
 type BundleAttachmentModel struct {
-	ID       types.String `tfsdk:"id"`
-	StackID  types.String `tfsdk:"stack_id"`
-	BundleID types.String `tfsdk:"bundle_id"`
+	ID             types.String `tfsdk:"id"`
+	StackID        types.String `tfsdk:"stack_id"`
+	BundleID       types.String `tfsdk:"bundle_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
 }
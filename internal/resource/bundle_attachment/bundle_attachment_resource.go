@@ -7,17 +7,23 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 var (
 	_ resource.Resource                = &BundleAttachmentResource{}
 	_ resource.ResourceWithImportState = &BundleAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &BundleAttachmentResource{}
 )
 
 // NewBundleAttachmentResource is a constructor for the bundle attachment resource.
@@ -27,7 +33,7 @@ func NewBundleAttachmentResource() resource.Resource {
 
 // BundleAttachmentResource is the resource implementation.
 type BundleAttachmentResource struct {
-	client *zenfraclient.Client
+	client zenfraclient.BundleAttachmentAPI
 }
 
 func (r *BundleAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +58,15 @@ func (r *BundleAttachmentResource) Schema(_ context.Context, _ resource.SchemaRe
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the stack and bundle. Defaults to the organization of the provider's API token; set it to the " +
+					"stack's organization_id when the stack belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"bundle_id": schema.StringAttribute{
 				Description: "The bundle to attach.",
 				Required:    true,
@@ -63,19 +78,15 @@ func (r *BundleAttachmentResource) Schema(_ context.Context, _ resource.SchemaRe
 	}
 }
 
+// ModifyPlan warns when the API token may not manage bundles.
+func (r *BundleAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_bundle_attachment", "bundle", req, resp)
+}
+
 func (r *BundleAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T.", req.ProviderData),
-		)
-		return
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_bundle_attachment")
 	}
-	r.client = client
 }
 
 func (r *BundleAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -85,6 +96,8 @@ func (r *BundleAttachmentResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
 	stackID := plan.StackID.ValueString()
 	bundleID := plan.BundleID.ValueString()
 
@@ -95,6 +108,7 @@ func (r *BundleAttachmentResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	plan.ID = types.StringValue(stackID + ":" + bundleID)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -105,15 +119,23 @@ func (r *BundleAttachmentResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	stackID := state.StackID.ValueString()
 	bundleID := state.BundleID.ValueString()
 
-	attachments, err := r.client.ListStackBundles(ctx, stackID)
+	attachments, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) ([]zenfraclient.BundleAttachment, error) {
+		return r.client.ListStackBundles(ctx, stackID)
+	})
 	if err != nil {
-		if zenfraclient.IsNotFound(err) {
+		if r.client.IsNotFoundOnRead(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Bundle Attachment", fmt.Sprintf("Could not list bundles for stack %s: %s\n\n%s", stackID, err, zenfraclient.ForbiddenReadHint))
+			return
+		}
 		resp.Diagnostics.AddError("Error Reading Bundle Attachment", fmt.Sprintf("Could not list bundles for stack %s: %s", stackID, err))
 		return
 	}
@@ -145,6 +167,8 @@ func (r *BundleAttachmentResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	err := r.client.DetachBundle(ctx, state.StackID.ValueString(), state.BundleID.ValueString())
 	if err != nil {
 		if zenfraclient.IsNotFound(err) {
@@ -156,18 +180,29 @@ func (r *BundleAttachmentResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *BundleAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, ":", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+	orgID, id := importguard.SplitOrganization(req.ID)
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.HasPrefix(req.ID, "/") {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected format: stack_id:bundle_id, got: %s", req.ID),
+			fmt.Sprintf("Expected format: [organization_id/]stack_id:bundle_id, got: %s", req.ID),
 		)
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+
+	if !importguard.VerifyOrganization(ctx, r.client, "stack", parts[0], importguard.Stack(r.client), &resp.Diagnostics) ||
+		!importguard.VerifyOrganization(ctx, r.client, "bundle", parts[1], importguard.Bundle(r.client), &resp.Diagnostics) {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, BundleAttachmentModel{
-		ID:       types.StringValue(req.ID),
+		ID:       types.StringValue(id),
 		StackID:  types.StringValue(parts[0]),
 		BundleID: types.StringValue(parts[1]),
 	})...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
 }
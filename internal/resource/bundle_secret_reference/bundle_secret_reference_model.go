@@ -0,0 +1,50 @@
+// ABOUTME: Terraform state model for the zenfra_bundle_secret_reference resource.
+// ABOUTME: Holds only where a secret lives, never its value.
+package bundle_secret_reference
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// BundleSecretReferenceModel represents the Terraform state model for a bundle secret reference.
+type BundleSecretReferenceModel struct {
+	ID             types.String            `tfsdk:"id"`
+	BundleID       types.String            `tfsdk:"bundle_id"`
+	Name           types.String            `tfsdk:"name"`
+	BackendID      types.String            `tfsdk:"backend_id"`
+	Path           types.String            `tfsdk:"path"`
+	Key            types.String            `tfsdk:"key"`
+	CreatedAt      timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt      timeutil.TimestampValue `tfsdk:"updated_at"`
+	OrganizationID types.String            `tfsdk:"organization_id"`
+}
+
+// mapReferenceToState converts an API BundleSecretReference to a BundleSecretReferenceModel.
+func mapReferenceToState(ref *zenfraclient.BundleSecretReference) BundleSecretReferenceModel {
+	model := BundleSecretReferenceModel{
+		ID:        types.StringValue(ref.ID),
+		BundleID:  types.StringValue(ref.BundleID),
+		Name:      types.StringValue(ref.Name),
+		BackendID: types.StringValue(ref.BackendID),
+		Path:      types.StringValue(ref.Path),
+		Key:       types.StringNull(),
+		CreatedAt: timeutil.Timestamp(ref.CreatedAt),
+		UpdatedAt: timeutil.Timestamp(ref.UpdatedAt),
+	}
+	if ref.Key != "" {
+		model.Key = types.StringValue(ref.Key)
+	}
+	return model
+}
+
+// referenceRequest builds the API request for a planned reference.
+func referenceRequest(plan BundleSecretReferenceModel) zenfraclient.BundleSecretReferenceRequest {
+	return zenfraclient.BundleSecretReferenceRequest{
+		Name:      plan.Name.ValueString(),
+		BackendID: plan.BackendID.ValueString(),
+		Path:      plan.Path.ValueString(),
+		Key:       plan.Key.ValueString(),
+	}
+}
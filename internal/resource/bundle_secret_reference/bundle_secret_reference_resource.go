@@ -0,0 +1,267 @@
+// ABOUTME: Implements the zenfra_bundle_secret_reference Terraform resource with full CRUD lifecycle.
+// ABOUTME: Exposes a secret from a secret backend to runs as a bundle environment variable, resolved when each run starts.
+package bundle_secret_reference
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &BundleSecretReferenceResource{}
+	_ resource.ResourceWithImportState    = &BundleSecretReferenceResource{}
+	_ resource.ResourceWithValidateConfig = &BundleSecretReferenceResource{}
+	_ resource.ResourceWithModifyPlan     = &BundleSecretReferenceResource{}
+)
+
+// envVarNamePattern matches the environment variable names runs accept.
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// NewBundleSecretReferenceResource is a constructor for the bundle secret reference resource.
+func NewBundleSecretReferenceResource() resource.Resource {
+	return &BundleSecretReferenceResource{}
+}
+
+// BundleSecretReferenceResource is the resource implementation.
+type BundleSecretReferenceResource struct {
+	client zenfraclient.BundleSecretReferenceAPI
+}
+
+func (r *BundleSecretReferenceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bundle_secret_reference"
+}
+
+func (r *BundleSecretReferenceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes a secret stored in a zenfra_secret_backend to runs as an environment variable of a configuration bundle. " +
+			"The runner reads the secret from the backend when each run starts, so its value never passes through Zenfra's API or Terraform state.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the secret reference.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"bundle_id": schema.StringAttribute{
+				Description: "The bundle that exposes the secret.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the bundle. Defaults to the organization of the provider's API token; set it to the " +
+					"bundle's organization_id when the bundle belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The environment variable the secret is exposed as. Must start with a letter or underscore " +
+					"and contain only letters, digits, and underscores. Changing it forces a new reference.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"backend_id": schema.StringAttribute{
+				Description: "The secret backend that stores the secret.",
+				Required:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "Where the secret is stored: the secret path for Vault (e.g. 'kv/data/prod/db'), " +
+					"or the secret name or ARN for AWS Secrets Manager.",
+				Required: true,
+			},
+			"key": schema.StringAttribute{
+				Description: "The field to read from a secret that holds several values, such as a Vault KV secret or a JSON secret in AWS Secrets Manager. " +
+					"Omit it to expose the whole secret.",
+				Optional: true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the secret reference was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the secret reference was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *BundleSecretReferenceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BundleSecretReferenceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Name.IsUnknown() && !config.Name.IsNull() && !envVarNamePattern.MatchString(config.Name.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Invalid Environment Variable Name",
+			fmt.Sprintf("name must start with a letter or underscore and contain only letters, digits, and underscores, got: %q", config.Name.ValueString()))
+	}
+	if !config.Path.IsUnknown() && !config.Path.IsNull() && strings.TrimSpace(config.Path.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid Secret Path", "path must not be empty.")
+	}
+}
+
+// ModifyPlan warns when the API token may not manage bundles.
+func (r *BundleSecretReferenceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_bundle_secret_reference", "bundle", req, resp)
+}
+
+func (r *BundleSecretReferenceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_bundle_secret_reference")
+	}
+}
+
+func (r *BundleSecretReferenceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BundleSecretReferenceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	bundleID := plan.BundleID.ValueString()
+	ref, err := r.client.CreateBundleSecretReference(ctx, bundleID, referenceRequest(plan))
+	if err != nil {
+		if zenfraclient.IsConflict(err) {
+			resp.Diagnostics.AddError("Error Creating Bundle Secret Reference",
+				fmt.Sprintf("Bundle %s already has an environment variable or secret reference named %s: %s", bundleID, plan.Name.ValueString(), err))
+			return
+		}
+		resp.Diagnostics.AddError("Error Creating Bundle Secret Reference",
+			fmt.Sprintf("Could not create secret reference on bundle %s: %s", bundleID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapReferenceToState(ref)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *BundleSecretReferenceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BundleSecretReferenceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	ref, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.BundleSecretReference, error) {
+		return r.client.GetBundleSecretReference(ctx, state.BundleID.ValueString(), state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Bundle Secret Reference",
+				fmt.Sprintf("Could not read secret reference ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Bundle Secret Reference",
+			fmt.Sprintf("Could not read secret reference ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	newState := mapReferenceToState(ref)
+	newState.OrganizationID = state.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *BundleSecretReferenceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state BundleSecretReferenceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	ref, err := r.client.UpdateBundleSecretReference(ctx, state.BundleID.ValueString(), state.ID.ValueString(), referenceRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Bundle Secret Reference",
+			fmt.Sprintf("Could not update secret reference ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapReferenceToState(ref)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *BundleSecretReferenceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BundleSecretReferenceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteBundleSecretReference(ctx, state.BundleID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Bundle Secret Reference",
+			fmt.Sprintf("Could not delete secret reference ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *BundleSecretReferenceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id := importguard.SplitOrganization(req.ID)
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.HasPrefix(req.ID, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format: [organization_id/]bundle_id:reference_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+
+	if !importguard.VerifyOrganization(ctx, r.client, "bundle", parts[0], importguard.Bundle(r.client), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(parts[1]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("bundle_id"), types.StringValue(parts[0]))...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+}
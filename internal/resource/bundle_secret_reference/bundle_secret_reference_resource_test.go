@@ -0,0 +1,53 @@
+// ABOUTME: Unit tests for the zenfra_bundle_secret_reference resource.
+// ABOUTME: Verifies state mapping, request building, and environment variable name validation.
+package bundle_secret_reference
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapReferenceToState(t *testing.T) {
+	ref := &zenfraclient.BundleSecretReference{
+		ID:        "ref-1",
+		BundleID:  "bundle-1",
+		Name:      "DB_PASSWORD",
+		BackendID: "sb-1",
+		Path:      "kv/data/prod/db",
+		Key:       "password",
+		CreatedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	state := mapReferenceToState(ref)
+	if state.ID.ValueString() != "ref-1" || state.BundleID.ValueString() != "bundle-1" || state.Key.ValueString() != "password" {
+		t.Errorf("unexpected state: %+v", state)
+	}
+
+	req := referenceRequest(state)
+	want := zenfraclient.BundleSecretReferenceRequest{Name: "DB_PASSWORD", BackendID: "sb-1", Path: "kv/data/prod/db", Key: "password"}
+	if req != want {
+		t.Errorf("expected request %+v, got %+v", want, req)
+	}
+
+	ref.Key = ""
+	if state := mapReferenceToState(ref); !state.Key.IsNull() {
+		t.Errorf("expected null key, got %s", state.Key)
+	}
+}
+
+func TestEnvVarNamePattern(t *testing.T) {
+	for name, want := range map[string]bool{
+		"DB_PASSWORD": true,
+		"_token":      true,
+		"1PASSWORD":   false,
+		"DB-PASSWORD": false,
+		"":            false,
+	} {
+		if got := envVarNamePattern.MatchString(name); got != want {
+			t.Errorf("envVarNamePattern.MatchString(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
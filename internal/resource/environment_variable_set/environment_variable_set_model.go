@@ -0,0 +1,39 @@
+// ABOUTME: Terraform state model for the zenfra_environment_variable_set resource.
+// ABOUTME: Maps API variable sets to state; an empty description maps to null.
+package environment_variable_set
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// EnvironmentVariableSetModel represents the Terraform state model for a variable set.
+type EnvironmentVariableSetModel struct {
+	ID                 types.String            `tfsdk:"id"`
+	OrganizationID     types.String            `tfsdk:"organization_id"`
+	Name               types.String            `tfsdk:"name"`
+	Description        types.String            `tfsdk:"description"`
+	AppliesToAllStacks types.Bool              `tfsdk:"applies_to_all_stacks"`
+	VariableCount      types.Int64             `tfsdk:"variable_count"`
+	CreatedAt          timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt          timeutil.TimestampValue `tfsdk:"updated_at"`
+}
+
+// mapSetToState converts an API variable set to state.
+func mapSetToState(set *zenfraclient.VariableSet) EnvironmentVariableSetModel {
+	model := EnvironmentVariableSetModel{
+		ID:                 types.StringValue(set.ID),
+		OrganizationID:     types.StringValue(set.OrganizationID),
+		Name:               types.StringValue(set.Name),
+		Description:        types.StringNull(),
+		AppliesToAllStacks: types.BoolValue(set.AppliesToAllStacks),
+		VariableCount:      types.Int64Value(set.VariableCount),
+		CreatedAt:          timeutil.Timestamp(set.CreatedAt),
+		UpdatedAt:          timeutil.Timestamp(set.UpdatedAt),
+	}
+	if set.Description != "" {
+		model.Description = types.StringValue(set.Description)
+	}
+	return model
+}
@@ -0,0 +1,220 @@
+// ABOUTME: Implements the zenfra_environment_variable_set Terraform resource with full CRUD lifecycle.
+// ABOUTME: Manages an organization-wide variable set; its variables and scopes are separate resources.
+package environment_variable_set
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                = &EnvironmentVariableSetResource{}
+	_ resource.ResourceWithImportState = &EnvironmentVariableSetResource{}
+	_ resource.ResourceWithModifyPlan  = &EnvironmentVariableSetResource{}
+)
+
+// NewEnvironmentVariableSetResource is a constructor for the variable set resource.
+func NewEnvironmentVariableSetResource() resource.Resource {
+	return &EnvironmentVariableSetResource{}
+}
+
+// EnvironmentVariableSetResource is the resource implementation.
+type EnvironmentVariableSetResource struct {
+	client zenfraclient.VariableSetAPI
+}
+
+func (r *EnvironmentVariableSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variable_set"
+}
+
+func (r *EnvironmentVariableSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an organization-wide set of environment variables. Stacks receive the set's variables alongside those of " +
+			"their configuration bundles: every stack when applies_to_all_stacks is true, otherwise the stacks selected by the set's " +
+			"zenfra_environment_variable_set_scope resources. Add variables with zenfra_environment_variable_set_variable.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the variable set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID the variable set belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the variable set in another organization the token has access to. Changing it forces a new variable set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the variable set, unique within the organization.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the variable set.",
+				Optional:    true,
+			},
+			"applies_to_all_stacks": schema.BoolAttribute{
+				Description: "When true, every stack in the organization receives the set's variables and its scopes are ignored. " +
+					"When false, only the stacks its scopes select do, so a new set reaches no stack until it is scoped. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"variable_count": schema.Int64Attribute{
+				Description: "Number of environment variables in the set.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the variable set was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the variable set was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *EnvironmentVariableSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_environment_variable_set")
+	}
+}
+
+// ModifyPlan warns when the API token may not manage variable sets.
+func (r *EnvironmentVariableSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_environment_variable_set", "variable_set", req, resp)
+}
+
+func (r *EnvironmentVariableSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EnvironmentVariableSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	set, err := r.client.CreateVariableSet(ctx, zenfraclient.CreateVariableSetRequest{
+		Name:               plan.Name.ValueString(),
+		Description:        plan.Description.ValueString(),
+		AppliesToAllStacks: plan.AppliesToAllStacks.ValueBool(),
+	})
+	if err != nil {
+		if zenfraclient.IsConflict(err) {
+			resp.Diagnostics.AddError("Error Creating Variable Set",
+				fmt.Sprintf("A variable set named %s already exists in the organization: %s", plan.Name.ValueString(), err))
+			return
+		}
+		resp.Diagnostics.AddError("Error Creating Variable Set", fmt.Sprintf("Could not create variable set: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapSetToState(set))...)
+}
+
+func (r *EnvironmentVariableSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EnvironmentVariableSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	set, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.VariableSet, error) {
+		return r.client.GetVariableSet(ctx, state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Variable Set",
+				fmt.Sprintf("Could not read variable set ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Variable Set",
+			fmt.Sprintf("Could not read variable set ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapSetToState(set))...)
+}
+
+func (r *EnvironmentVariableSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EnvironmentVariableSetModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	// Every changeable attribute is sent; an empty description clears it.
+	name := plan.Name.ValueString()
+	description := plan.Description.ValueString()
+	appliesToAll := plan.AppliesToAllStacks.ValueBool()
+	set, err := r.client.UpdateVariableSet(ctx, state.ID.ValueString(), zenfraclient.UpdateVariableSetRequest{
+		Name:               &name,
+		Description:        &description,
+		AppliesToAllStacks: &appliesToAll,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Variable Set",
+			fmt.Sprintf("Could not update variable set ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapSetToState(set))...)
+}
+
+func (r *EnvironmentVariableSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EnvironmentVariableSetModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteVariableSet(ctx, state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Variable Set",
+			fmt.Sprintf("Could not delete variable set ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *EnvironmentVariableSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationID(ctx, r.client, "variable set", importguard.VariableSet(r.client), req, resp)
+}
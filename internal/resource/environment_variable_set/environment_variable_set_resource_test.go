@@ -0,0 +1,67 @@
+// ABOUTME: Unit tests for the zenfra_environment_variable_set resource against the zenfrafake client.
+// ABOUTME: Covers state mapping and that an update sends every changeable attribute, clearing a removed description.
+package environment_variable_set
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func TestMapSetToState(t *testing.T) {
+	set := &zenfraclient.VariableSet{
+		ID:                 "vs-1",
+		OrganizationID:     "org-1",
+		Name:               "platform-defaults",
+		AppliesToAllStacks: true,
+		VariableCount:      3,
+		CreatedAt:          time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt:          time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	state := mapSetToState(set)
+	if state.ID.ValueString() != "vs-1" || !state.AppliesToAllStacks.ValueBool() || state.VariableCount.ValueInt64() != 3 {
+		t.Errorf("unexpected state: %+v", state)
+	}
+	if !state.Description.IsNull() {
+		t.Errorf("expected null description, got %s", state.Description)
+	}
+}
+
+func TestEnvironmentVariableSetResource_UpdateClearsDescription(t *testing.T) {
+	ctx := context.Background()
+	var got zenfraclient.UpdateVariableSetRequest
+	fake := &zenfrafake.Client{
+		UpdateVariableSetFunc: func(_ context.Context, id string, req zenfraclient.UpdateVariableSetRequest) (*zenfraclient.VariableSet, error) {
+			got = req
+			return &zenfraclient.VariableSet{ID: id, OrganizationID: "org-1", Name: *req.Name, Description: *req.Description, AppliesToAllStacks: *req.AppliesToAllStacks}, nil
+		},
+	}
+	r := &EnvironmentVariableSetResource{client: fake}
+
+	prior := mapSetToState(&zenfraclient.VariableSet{ID: "vs-1", OrganizationID: "org-1", Name: "defaults", Description: "Old"})
+	planned := prior
+	planned.Description = types.StringNull()
+	planned.AppliesToAllStacks = types.BoolValue(true)
+
+	resp := &resource.UpdateResponse{State: zenfrafake.State(t, r, &prior)}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(zenfrafake.State(t, r, &planned)), State: zenfrafake.State(t, r, &prior)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+	if got.Description == nil || *got.Description != "" || got.AppliesToAllStacks == nil || !*got.AppliesToAllStacks {
+		t.Errorf("unexpected request: %+v", got)
+	}
+
+	var state EnvironmentVariableSetModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if !state.Description.IsNull() || !state.AppliesToAllStacks.ValueBool() {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
@@ -0,0 +1,43 @@
+// ABOUTME: Terraform state model for the zenfra_environment_variable_set_scope resource.
+// ABOUTME: A scope selects stacks by space or by label; the unused selector maps to null.
+package environment_variable_set_scope
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// EnvironmentVariableSetScopeModel represents the Terraform state model for a scope of
+// a variable set.
+type EnvironmentVariableSetScopeModel struct {
+	ID               types.String            `tfsdk:"id"`
+	VariableSetID    types.String            `tfsdk:"variable_set_id"`
+	OrganizationID   types.String            `tfsdk:"organization_id"`
+	SpaceID          types.String            `tfsdk:"space_id"`
+	IncludeSubspaces types.Bool              `tfsdk:"include_subspaces"`
+	StackLabel       types.String            `tfsdk:"stack_label"`
+	CreatedAt        timeutil.TimestampValue `tfsdk:"created_at"`
+}
+
+// mapScopeToState converts an API variable set scope to state. The API reports
+// include_subspaces as false for a label scope, which would differ from the schema
+// default, so it is mapped to true there.
+func mapScopeToState(scope *zenfraclient.VariableSetScope) EnvironmentVariableSetScopeModel {
+	model := EnvironmentVariableSetScopeModel{
+		ID:               types.StringValue(scope.ID),
+		VariableSetID:    types.StringValue(scope.VariableSetID),
+		OrganizationID:   types.StringNull(),
+		SpaceID:          types.StringNull(),
+		IncludeSubspaces: types.BoolValue(scope.IncludeSubspaces || scope.SpaceID == ""),
+		StackLabel:       types.StringNull(),
+		CreatedAt:        timeutil.Timestamp(scope.CreatedAt),
+	}
+	if scope.SpaceID != "" {
+		model.SpaceID = types.StringValue(scope.SpaceID)
+	}
+	if scope.StackLabel != "" {
+		model.StackLabel = types.StringValue(scope.StackLabel)
+	}
+	return model
+}
@@ -0,0 +1,261 @@
+// ABOUTME: Implements the zenfra_environment_variable_set_scope Terraform resource; every change forces a new scope.
+// ABOUTME: Applies a variable set to the stacks of a space, optionally with its child spaces, or to the stacks with a label.
+package environment_variable_set_scope
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &EnvironmentVariableSetScopeResource{}
+	_ resource.ResourceWithImportState    = &EnvironmentVariableSetScopeResource{}
+	_ resource.ResourceWithValidateConfig = &EnvironmentVariableSetScopeResource{}
+	_ resource.ResourceWithModifyPlan     = &EnvironmentVariableSetScopeResource{}
+)
+
+// NewEnvironmentVariableSetScopeResource is a constructor for the variable set scope resource.
+func NewEnvironmentVariableSetScopeResource() resource.Resource {
+	return &EnvironmentVariableSetScopeResource{}
+}
+
+// EnvironmentVariableSetScopeResource is the resource implementation.
+type EnvironmentVariableSetScopeResource struct {
+	client zenfraclient.VariableSetAPI
+}
+
+func (r *EnvironmentVariableSetScopeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variable_set_scope"
+}
+
+func (r *EnvironmentVariableSetScopeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Applies a zenfra_environment_variable_set to the stacks of a space or to the stacks with a label. " +
+			"A set with several scopes applies to the stacks any of them selects. Scopes are ignored while the set's applies_to_all_stacks is true.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the scope.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"variable_set_id": schema.StringAttribute{
+				Description: "The variable set to apply. Changing it forces a new scope.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the variable set. Defaults to the organization of the provider's API token; set it to the " +
+					"variable set's organization_id when the set belongs to another organization the token has access to. Changing it " +
+					"forces a new scope.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Description: "Apply the set to the stacks of this space. Exactly one of space_id and stack_label must be set. " +
+					"Changing it forces a new scope.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_subspaces": schema.BoolAttribute{
+				Description: "With space_id, also apply the set to the stacks of the space's child spaces, at any depth. " +
+					"Defaults to true. Changing it forces a new scope.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"stack_label": schema.StringAttribute{
+				Description: "Apply the set to every stack with this label, in any space. Exactly one of space_id and stack_label must be set. " +
+					"Changing it forces a new scope.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the scope was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *EnvironmentVariableSetScopeResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_environment_variable_set_scope")
+	}
+}
+
+// ValidateConfig checks that the scope selects stacks in exactly one way.
+func (r *EnvironmentVariableSetScopeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EnvironmentVariableSetScopeModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unknown selector may still turn out to be null, so only known values are counted.
+	if config.SpaceID.IsUnknown() || config.StackLabel.IsUnknown() {
+		return
+	}
+	switch {
+	case config.SpaceID.IsNull() && config.StackLabel.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("space_id"), "Missing Variable Set Scope",
+			"Set space_id to apply the variable set to the stacks of a space, or stack_label to apply it to the stacks with a label.")
+	case !config.SpaceID.IsNull() && !config.StackLabel.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("stack_label"), "Conflicting Variable Set Scopes",
+			"space_id and stack_label cannot both be set. Create one scope per space and one per label.")
+	case !config.StackLabel.IsNull() && !config.IncludeSubspaces.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("include_subspaces"), "Invalid Variable Set Scope",
+			"include_subspaces only applies to a scope with space_id.")
+	}
+}
+
+// ModifyPlan warns when the API token may not manage variable sets.
+func (r *EnvironmentVariableSetScopeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_environment_variable_set_scope", "variable_set", req, resp)
+}
+
+func (r *EnvironmentVariableSetScopeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EnvironmentVariableSetScopeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	setID := plan.VariableSetID.ValueString()
+	createReq := zenfraclient.CreateVariableSetScopeRequest{
+		SpaceID:    plan.SpaceID.ValueString(),
+		StackLabel: plan.StackLabel.ValueString(),
+	}
+	if !plan.SpaceID.IsNull() {
+		createReq.IncludeSubspaces = plan.IncludeSubspaces.ValueBool()
+	}
+	scope, err := r.client.CreateVariableSetScope(ctx, setID, createReq)
+	if err != nil {
+		if zenfraclient.IsConflict(err) {
+			resp.Diagnostics.AddError("Error Creating Variable Set Scope",
+				fmt.Sprintf("Variable set %s already has a scope selecting the same stacks: %s", setID, err))
+			return
+		}
+		resp.Diagnostics.AddError("Error Creating Variable Set Scope",
+			fmt.Sprintf("Could not create scope on variable set %s: %s", setID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapScopeToState(scope)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *EnvironmentVariableSetScopeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EnvironmentVariableSetScopeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	scope, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.VariableSetScope, error) {
+		return r.client.GetVariableSetScope(ctx, state.VariableSetID.ValueString(), state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Variable Set Scope",
+				fmt.Sprintf("Could not read variable set scope ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Variable Set Scope",
+			fmt.Sprintf("Could not read variable set scope ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	newState := mapScopeToState(scope)
+	newState.OrganizationID = state.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *EnvironmentVariableSetScopeResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Unexpected Update", "Variable set scope does not support in-place updates.")
+}
+
+func (r *EnvironmentVariableSetScopeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EnvironmentVariableSetScopeModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteVariableSetScope(ctx, state.VariableSetID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Variable Set Scope",
+			fmt.Sprintf("Could not delete variable set scope ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *EnvironmentVariableSetScopeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id := importguard.SplitOrganization(req.ID)
+	setID, scopeID, ok := strings.Cut(id, ":")
+	if !ok || setID == "" || scopeID == "" || strings.HasPrefix(req.ID, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format: [organization_id/]variable_set_id:scope_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+
+	if !importguard.VerifyOrganization(ctx, r.client, "variable set", setID, importguard.VariableSet(r.client), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(scopeID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variable_set_id"), types.StringValue(setID))...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+}
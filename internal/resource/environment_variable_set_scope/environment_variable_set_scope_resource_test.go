@@ -0,0 +1,85 @@
+// ABOUTME: Unit tests for the zenfra_environment_variable_set_scope resource.
+// ABOUTME: Covers selector validation and the state mapping of space and label scopes.
+package environment_variable_set_scope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func scopeConfig(t *testing.T, spaceID, label string, includeSubspaces types.Bool) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	r := &EnvironmentVariableSetScopeResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	model := EnvironmentVariableSetScopeModel{
+		ID:               types.StringNull(),
+		VariableSetID:    types.StringValue("vs-1"),
+		SpaceID:          types.StringNull(),
+		IncludeSubspaces: includeSubspaces,
+		StackLabel:       types.StringNull(),
+		CreatedAt:        timeutil.NewTimestampNull(),
+	}
+	if spaceID != "" {
+		model.SpaceID = types.StringValue(spaceID)
+	}
+	if label != "" {
+		model.StackLabel = types.StringValue(label)
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("setting config: %v", diags)
+	}
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestEnvironmentVariableSetScopeResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name             string
+		spaceID, label   string
+		includeSubspaces types.Bool
+		wantErrors       int
+	}{
+		{name: "space", spaceID: "space-1", includeSubspaces: types.BoolValue(false)},
+		{name: "label", label: "team:payments", includeSubspaces: types.BoolNull()},
+		{name: "neither", includeSubspaces: types.BoolNull(), wantErrors: 1},
+		{name: "both", spaceID: "space-1", label: "team:payments", includeSubspaces: types.BoolNull(), wantErrors: 1},
+		{name: "label with include_subspaces", label: "team:payments", includeSubspaces: types.BoolValue(true), wantErrors: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &EnvironmentVariableSetScopeResource{}
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: scopeConfig(t, tt.spaceID, tt.label, tt.includeSubspaces)}, resp)
+			if resp.Diagnostics.ErrorsCount() != tt.wantErrors {
+				t.Errorf("expected %d errors, got %v", tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestMapScopeToState(t *testing.T) {
+	space := mapScopeToState(&zenfraclient.VariableSetScope{ID: "scope-1", VariableSetID: "vs-1", SpaceID: "space-1"})
+	if space.SpaceID.ValueString() != "space-1" || !space.StackLabel.IsNull() || space.IncludeSubspaces.ValueBool() {
+		t.Errorf("unexpected space scope state: %+v", space)
+	}
+
+	// A label scope reports include_subspaces as the schema default, so it plans no diff.
+	label := mapScopeToState(&zenfraclient.VariableSetScope{ID: "scope-2", VariableSetID: "vs-1", StackLabel: "team:payments"})
+	if !label.SpaceID.IsNull() || label.StackLabel.ValueString() != "team:payments" || !label.IncludeSubspaces.ValueBool() {
+		t.Errorf("unexpected label scope state: %+v", label)
+	}
+}
@@ -0,0 +1,62 @@
+// ABOUTME: Terraform state model for the zenfra_environment_variable_set_variable resource.
+// ABOUTME: Keeps the configured value of a secret variable, which the API never returns, as bundles do.
+package environment_variable_set_variable
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// EnvironmentVariableSetVariableModel represents the Terraform state model for a
+// variable of a variable set.
+type EnvironmentVariableSetVariableModel struct {
+	ID             types.String            `tfsdk:"id"`
+	VariableSetID  types.String            `tfsdk:"variable_set_id"`
+	OrganizationID types.String            `tfsdk:"organization_id"`
+	Key            types.String            `tfsdk:"key"`
+	Value          types.String            `tfsdk:"value"`
+	Secret         types.Bool              `tfsdk:"secret"`
+	Description    types.String            `tfsdk:"description"`
+	CreatedAt      timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt      timeutil.TimestampValue `tfsdk:"updated_at"`
+}
+
+// variableID returns the ID of a variable in state: its set and key, as accepted by import.
+func variableID(setID, key string) string {
+	return setID + ":" + key
+}
+
+// mapVariableToState converts an API variable to state. The API masks the value of a
+// secret variable, so priorValue, the value from the plan or prior state, is kept when
+// the value is masked.
+func mapVariableToState(v *zenfraclient.VariableSetVariable, priorValue types.String) EnvironmentVariableSetVariableModel {
+	model := EnvironmentVariableSetVariableModel{
+		ID:             types.StringValue(variableID(v.VariableSetID, v.Key)),
+		VariableSetID:  types.StringValue(v.VariableSetID),
+		OrganizationID: types.StringNull(),
+		Key:            types.StringValue(v.Key),
+		Value:          types.StringValue(v.Value),
+		Secret:         types.BoolValue(v.Secret),
+		Description:    types.StringNull(),
+		CreatedAt:      timeutil.Timestamp(v.CreatedAt),
+		UpdatedAt:      timeutil.Timestamp(v.UpdatedAt),
+	}
+	if v.ValueMasked && !priorValue.IsNull() && !priorValue.IsUnknown() {
+		model.Value = priorValue
+	}
+	if v.Description != "" {
+		model.Description = types.StringValue(v.Description)
+	}
+	return model
+}
+
+// variableRequest builds the API request for a planned variable.
+func variableRequest(plan EnvironmentVariableSetVariableModel) zenfraclient.VariableSetVariableRequest {
+	return zenfraclient.VariableSetVariableRequest{
+		Key:         plan.Key.ValueString(),
+		Value:       plan.Value.ValueString(),
+		Secret:      plan.Secret.ValueBool(),
+		Description: plan.Description.ValueString(),
+	}
+}
@@ -0,0 +1,279 @@
+// ABOUTME: Implements the zenfra_environment_variable_set_variable Terraform resource with full CRUD lifecycle.
+// ABOUTME: Manages one environment variable of an organization-wide variable set, with write-only secret values as in bundles.
+package environment_variable_set_variable
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/payloadsize"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &EnvironmentVariableSetVariableResource{}
+	_ resource.ResourceWithImportState    = &EnvironmentVariableSetVariableResource{}
+	_ resource.ResourceWithValidateConfig = &EnvironmentVariableSetVariableResource{}
+	_ resource.ResourceWithModifyPlan     = &EnvironmentVariableSetVariableResource{}
+)
+
+// envVarKeyPattern matches POSIX environment variable names.
+var envVarKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// NewEnvironmentVariableSetVariableResource is a constructor for the variable set variable resource.
+func NewEnvironmentVariableSetVariableResource() resource.Resource {
+	return &EnvironmentVariableSetVariableResource{}
+}
+
+// EnvironmentVariableSetVariableResource is the resource implementation.
+type EnvironmentVariableSetVariableResource struct {
+	client zenfraclient.VariableSetAPI
+}
+
+func (r *EnvironmentVariableSetVariableResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_environment_variable_set_variable"
+}
+
+func (r *EnvironmentVariableSetVariableResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an environment variable of a zenfra_environment_variable_set. As in configuration bundles, " +
+			"the value of a secret variable is write-only: the API never returns it, so changes made outside Terraform are not detected.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identifier of the variable, of the form variable_set_id:key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"variable_set_id": schema.StringAttribute{
+				Description: "The variable set the variable belongs to. Changing it forces a new variable.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the variable set. Defaults to the organization of the provider's API token; set it to the " +
+					"variable set's organization_id when the set belongs to another organization the token has access to. Changing it " +
+					"forces a new variable.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Description: "The environment variable name. Must contain only letters, digits, and underscores, not start with a digit, " +
+					"and be unique within the set. Changing it forces a new variable.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The environment variable value.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"secret": schema.BoolAttribute{
+				Description: "Whether this is a secret value. Secret values are write-only. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of this environment variable.",
+				Optional:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the variable was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the variable was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *EnvironmentVariableSetVariableResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_environment_variable_set_variable")
+	}
+}
+
+// ValidateConfig checks that key is a valid environment variable name.
+func (r *EnvironmentVariableSetVariableResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var key types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key"), &key)...)
+	if resp.Diagnostics.HasError() || key.IsNull() || key.IsUnknown() {
+		return
+	}
+	if !envVarKeyPattern.MatchString(key.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("key"), "Invalid Environment Variable",
+			fmt.Sprintf("Environment variable key %q is not a valid name: use only letters, digits, and underscores, and do not start with a digit.", key.ValueString()))
+	}
+}
+
+// ModifyPlan warns when the API token may not manage variable sets, and checks the
+// planned value against the API's size limit.
+func (r *EnvironmentVariableSetVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_environment_variable_set_variable", "variable_set", req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan EnvironmentVariableSetVariableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	payloadsize.CheckValue(path.Root("value"), plan.Key.ValueString(), plan.Value, &resp.Diagnostics)
+}
+
+func (r *EnvironmentVariableSetVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EnvironmentVariableSetVariableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	setID := plan.VariableSetID.ValueString()
+	variable, err := r.client.CreateVariableSetVariable(ctx, setID, variableRequest(plan))
+	if err != nil {
+		if zenfraclient.IsConflict(err) {
+			resp.Diagnostics.AddError("Error Creating Variable Set Variable",
+				fmt.Sprintf("Variable set %s already has a variable named %s. Import it instead: %s", setID, plan.Key.ValueString(), err))
+			return
+		}
+		resp.Diagnostics.AddError("Error Creating Variable Set Variable",
+			fmt.Sprintf("Could not create variable %s in variable set %s: %s", plan.Key.ValueString(), setID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapVariableToState(variable, plan.Value)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *EnvironmentVariableSetVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EnvironmentVariableSetVariableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	variable, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.VariableSetVariable, error) {
+		return r.client.GetVariableSetVariable(ctx, state.VariableSetID.ValueString(), state.Key.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Variable Set Variable",
+				fmt.Sprintf("Could not read variable ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Variable Set Variable",
+			fmt.Sprintf("Could not read variable ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	newState := mapVariableToState(variable, state.Value)
+	newState.OrganizationID = state.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *EnvironmentVariableSetVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EnvironmentVariableSetVariableModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	variable, err := r.client.UpdateVariableSetVariable(ctx, state.VariableSetID.ValueString(), state.Key.ValueString(), variableRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Variable Set Variable",
+			fmt.Sprintf("Could not update variable ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapVariableToState(variable, plan.Value)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *EnvironmentVariableSetVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EnvironmentVariableSetVariableModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteVariableSetVariable(ctx, state.VariableSetID.ValueString(), state.Key.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Variable Set Variable",
+			fmt.Sprintf("Could not delete variable ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *EnvironmentVariableSetVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id := importguard.SplitOrganization(req.ID)
+	setID, key, ok := strings.Cut(id, ":")
+	if !ok || setID == "" || key == "" || strings.HasPrefix(req.ID, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format: [organization_id/]variable_set_id:key, got: %s", req.ID),
+		)
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+
+	if !importguard.VerifyOrganization(ctx, r.client, "variable set", setID, importguard.VariableSet(r.client), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(variableID(setID, key)))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("variable_set_id"), types.StringValue(setID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), types.StringValue(key))...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+}
@@ -0,0 +1,158 @@
+// ABOUTME: Unit tests for the zenfra_environment_variable_set_variable resource against the zenfrafake client.
+// ABOUTME: Covers key validation, the value size check, keeping secret values the API masks, and import with an organization prefix.
+package environment_variable_set_variable
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func variableModel(key, value string, secret bool) *EnvironmentVariableSetVariableModel {
+	return &EnvironmentVariableSetVariableModel{
+		ID:            types.StringValue(variableID("vs-1", key)),
+		VariableSetID: types.StringValue("vs-1"),
+		Key:           types.StringValue(key),
+		Value:         types.StringValue(value),
+		Secret:        types.BoolValue(secret),
+		Description:   types.StringNull(),
+		CreatedAt:     timeutil.NewTimestampUnknown(),
+		UpdatedAt:     timeutil.NewTimestampUnknown(),
+	}
+}
+
+func TestEnvironmentVariableSetVariableResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &EnvironmentVariableSetVariableResource{}
+
+	for key, wantErrors := range map[string]int{"AWS_REGION": 0, "_token": 0, "1PASSWORD": 1, "DB-PASSWORD": 1} {
+		state := zenfrafake.State(t, r, variableModel(key, "value", false))
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+		if resp.Diagnostics.ErrorsCount() != wantErrors {
+			t.Errorf("key %q: expected %d errors, got %v", key, wantErrors, resp.Diagnostics)
+		}
+	}
+}
+
+func TestEnvironmentVariableSetVariableResource_ModifyPlanChecksSize(t *testing.T) {
+	ctx := context.Background()
+	r := &EnvironmentVariableSetVariableResource{client: &zenfrafake.Client{}}
+
+	plan := zenfrafake.State(t, r, variableModel("CA_BUNDLE", strings.Repeat("x", zenfraclient.MaxVariableValueBytes+1), false))
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: zenfrafake.State(t, r, nil)}, resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 || errs[0].Summary() != "Variable Value Too Large" {
+		t.Errorf("expected a size error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestEnvironmentVariableSetVariableResource_ReadKeepsSecretValue(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetVariableSetVariableFunc: func(_ context.Context, setID, key string) (*zenfraclient.VariableSetVariable, error) {
+			return &zenfraclient.VariableSetVariable{
+				VariableSetID: setID, Key: key, Secret: true, Description: "Rotated monthly", ValueMasked: true,
+			}, nil
+		},
+	}
+	r := &EnvironmentVariableSetVariableResource{client: fake}
+
+	prior := zenfrafake.State(t, r, variableModel("DATADOG_API_KEY", "s3cret", true))
+	resp := &resource.ReadResponse{State: prior}
+	r.Read(ctx, resource.ReadRequest{State: prior}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+
+	var state EnvironmentVariableSetVariableModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.Value.ValueString() != "s3cret" || state.Description.ValueString() != "Rotated monthly" {
+		t.Errorf("unexpected state: %+v", state)
+	}
+	if state.ID.ValueString() != "vs-1:DATADOG_API_KEY" {
+		t.Errorf("unexpected ID %s", state.ID)
+	}
+}
+
+func TestEnvironmentVariableSetVariableResource_ImportInvalidID(t *testing.T) {
+	r := &EnvironmentVariableSetVariableResource{client: &zenfrafake.Client{}}
+
+	for _, id := range []string{"vs-1", "vs-1:", ":AWS_REGION", "/vs-1:AWS_REGION", "org-2/vs-1"} {
+		resp := &resource.ImportStateResponse{State: zenfrafake.State(t, r, nil)}
+		r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("expected an error for import ID %q", id)
+		}
+	}
+}
+
+func TestEnvironmentVariableSetVariableResource_ImportOrganization(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetCurrentOrganizationFunc: func(context.Context) (*zenfraclient.Organization, error) {
+			return &zenfraclient.Organization{ID: "org-2"}, nil
+		},
+		GetVariableSetFunc: func(_ context.Context, id string) (*zenfraclient.VariableSet, error) {
+			return &zenfraclient.VariableSet{ID: id, OrganizationID: "org-2"}, nil
+		},
+	}
+	r := &EnvironmentVariableSetVariableResource{client: fake}
+
+	resp := &resource.ImportStateResponse{State: zenfrafake.State(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "org-2/vs-1:AWS_REGION"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState: %v", resp.Diagnostics)
+	}
+
+	var state EnvironmentVariableSetVariableModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+	if state.ID.ValueString() != "vs-1:AWS_REGION" || state.VariableSetID.ValueString() != "vs-1" || state.Key.ValueString() != "AWS_REGION" {
+		t.Errorf("expected the IDs without the organization prefix, got %s, %s, %s", state.ID, state.VariableSetID, state.Key)
+	}
+	if state.OrganizationID.ValueString() != "org-2" {
+		t.Errorf("expected organization_id org-2, got %s", state.OrganizationID)
+	}
+}
+
+func TestMapVariableToState_PlainValueFromAPI(t *testing.T) {
+	v := &zenfraclient.VariableSetVariable{VariableSetID: "vs-1", Key: "AWS_REGION", Value: "eu-west-1"}
+	if state := mapVariableToState(v, types.StringValue("us-east-1")); state.Value.ValueString() != "eu-west-1" {
+		t.Errorf("expected the API value to show drift, got %s", state.Value)
+	}
+}
+
+func TestMapVariableToState_MaskedForms(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "masked flag", body: `{"variable_set_id":"vs-1","key":"DB_PASSWORD","value":"","secret":true,"masked":true}`},
+		{name: "sentinel", body: `{"variable_set_id":"vs-1","key":"DB_PASSWORD","value":"****","secret":true}`},
+		{name: "empty value", body: `{"variable_set_id":"vs-1","key":"DB_PASSWORD","value":"","secret":true}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v zenfraclient.VariableSetVariable
+			if err := json.Unmarshal([]byte(tt.body), &v); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if state := mapVariableToState(&v, types.StringValue("s3cret")); state.Value.ValueString() != "s3cret" {
+				t.Errorf("expected the prior value to replace the masked one, got %s", state.Value)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+// ABOUTME: Terraform state model for the zenfra_membership_invitation resource.
+// ABOUTME: Maps API invitations to state and validates invitee email addresses.
+package membership_invitation
+
+import (
+	"net/mail"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// MembershipInvitationModel represents the Terraform state model for a membership invitation.
+type MembershipInvitationModel struct {
+	ID             types.String            `tfsdk:"id"`
+	OrganizationID types.String            `tfsdk:"organization_id"`
+	Email          types.String            `tfsdk:"email"`
+	Role           types.String            `tfsdk:"role"`
+	ExpiresInDays  types.Int64             `tfsdk:"expires_in_days"`
+	ResendTriggers types.Map               `tfsdk:"resend_triggers"`
+	Status         types.String            `tfsdk:"status"`
+	ExpiresAt      timeutil.TimestampValue `tfsdk:"expires_at"`
+	SentAt         timeutil.TimestampValue `tfsdk:"sent_at"`
+	AcceptedAt     timeutil.TimestampValue `tfsdk:"accepted_at"`
+	CreatedAt      timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt      timeutil.TimestampValue `tfsdk:"updated_at"`
+}
+
+// mapInvitationToState converts an API invitation to state. expires_in_days and
+// resend_triggers are not returned by the API and are taken from prior.
+func mapInvitationToState(invitation *zenfraclient.Invitation, prior MembershipInvitationModel) MembershipInvitationModel {
+	return MembershipInvitationModel{
+		ID:             types.StringValue(invitation.ID),
+		OrganizationID: types.StringValue(invitation.OrganizationID),
+		Email:          types.StringValue(invitation.Email),
+		Role:           types.StringValue(invitation.Role),
+		ExpiresInDays:  prior.ExpiresInDays,
+		ResendTriggers: prior.ResendTriggers,
+		Status:         types.StringValue(invitation.Status),
+		ExpiresAt:      timeutil.Timestamp(invitation.ExpiresAt),
+		SentAt:         timeutil.Timestamp(invitation.SentAt),
+		AcceptedAt:     timeutil.TimestampPointer(invitation.AcceptedAt),
+		CreatedAt:      timeutil.Timestamp(invitation.CreatedAt),
+		UpdatedAt:      timeutil.Timestamp(invitation.UpdatedAt),
+	}
+}
+
+// markAccepted records in state that the invitation was accepted by member, for an
+// invitation the API deleted on acceptance.
+func markAccepted(state MembershipInvitationModel, member *zenfraclient.Member) MembershipInvitationModel {
+	state.Status = types.StringValue(zenfraclient.InvitationStatusAccepted)
+	state.AcceptedAt = timeutil.Timestamp(member.JoinedAt)
+	return state
+}
+
+// accepted reports whether state records an accepted invitation.
+func (m MembershipInvitationModel) accepted() bool {
+	return m.Status.ValueString() == zenfraclient.InvitationStatusAccepted
+}
+
+// validEmail reports whether s is a bare email address, without a display name or
+// angle brackets.
+func validEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
@@ -0,0 +1,364 @@
+// ABOUTME: Implements the zenfra_membership_invitation resource, which invites someone to the organization by email.
+// ABOUTME: Accepted invitations stay in state without being re-sent; expired or revoked ones are planned for creation again.
+package membership_invitation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &MembershipInvitationResource{}
+	_ resource.ResourceWithImportState    = &MembershipInvitationResource{}
+	_ resource.ResourceWithModifyPlan     = &MembershipInvitationResource{}
+	_ resource.ResourceWithValidateConfig = &MembershipInvitationResource{}
+)
+
+// NewMembershipInvitationResource is a constructor for the membership invitation resource.
+func NewMembershipInvitationResource() resource.Resource {
+	return &MembershipInvitationResource{}
+}
+
+// MembershipInvitationResource is the resource implementation.
+type MembershipInvitationResource struct {
+	client zenfraclient.MembershipInvitationAPI
+}
+
+func (r *MembershipInvitationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_membership_invitation"
+}
+
+func (r *MembershipInvitationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Invites someone to the organization by email. The invitation has its own lifecycle, separate from the member it creates: " +
+			"once accepted, it stays in state as accepted, and destroying it leaves the member in place. " +
+			"An invitation that expires or is revoked outside Terraform is planned for creation, so the next apply invites again.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the invitation.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization to invite to. Defaults to the organization of the provider's API token; " +
+					"set it to invite to another organization the token has access to. Changing it forces a new invitation.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "The email address to send the invitation to. Changing it revokes the invitation and invites the new address.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "The organization role the invitee gets on accepting: read, write, or admin. " +
+					"Changing it updates a pending invitation; it has no effect once the invitation is accepted.",
+				Required: true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.OrganizationRoleRead, zenfraclient.OrganizationRoleWrite, zenfraclient.OrganizationRoleAdmin),
+				},
+			},
+			"expires_in_days": schema.Int64Attribute{
+				Description: "Number of days the invitation can be accepted for, counted from each time it is sent. " +
+					"Changing it resends the invitation. Defaults to the API's default of 7 days.",
+				Optional: true,
+			},
+			"resend_triggers": schema.MapAttribute{
+				Description: "Arbitrary values that resend the invitation email, restarting its expiry, when any of them changes.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the invitation: pending or accepted.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Timestamp after which the invitation can no longer be accepted.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sent_at": schema.StringAttribute{
+				Description: "Timestamp when the invitation email was last sent.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"accepted_at": schema.StringAttribute{
+				Description: "Timestamp when the invitation was accepted. Null while it is pending.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the invitation was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the invitation was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the email address and expires_in_days.
+func (r *MembershipInvitationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config MembershipInvitationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Email.IsNull() && !config.Email.IsUnknown() && !validEmail(config.Email.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("email"), "Invalid Email Address",
+			fmt.Sprintf("%q is not an email address. Use the bare address, such as jane@example.com.", config.Email.ValueString()))
+	}
+	if !config.ExpiresInDays.IsNull() && !config.ExpiresInDays.IsUnknown() && config.ExpiresInDays.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("expires_in_days"), "Invalid Invitation Lifetime",
+			"expires_in_days must be at least 1.")
+	}
+}
+
+// ModifyPlan warns when the API token may not manage invitations, and marks the send
+// and expiry times unknown when the invitation will be resent.
+func (r *MembershipInvitationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_membership_invitation", "invitation", req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state MembershipInvitationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || !resends(plan, state) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sent_at"), timeutil.NewTimestampUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expires_at"), timeutil.NewTimestampUnknown())...)
+}
+
+// resends reports whether applying plan over state sends the invitation again.
+func resends(plan, state MembershipInvitationModel) bool {
+	if state.accepted() {
+		return false
+	}
+	return !plan.ResendTriggers.Equal(state.ResendTriggers) || !plan.ExpiresInDays.Equal(state.ExpiresInDays)
+}
+
+func (r *MembershipInvitationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_membership_invitation")
+	}
+}
+
+func (r *MembershipInvitationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan MembershipInvitationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	createReq := zenfraclient.CreateInvitationRequest{
+		Email: plan.Email.ValueString(),
+		Role:  plan.Role.ValueString(),
+	}
+	if !plan.ExpiresInDays.IsNull() {
+		days := plan.ExpiresInDays.ValueInt64()
+		createReq.ExpiresInDays = &days
+	}
+
+	invitation, err := r.client.CreateInvitation(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Invitation",
+			fmt.Sprintf("Could not invite %s: %s", plan.Email.ValueString(), err))
+		return
+	}
+
+	state := mapInvitationToState(invitation, plan)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Read refreshes the invitation. The API may delete an invitation once it is accepted,
+// so a missing invitation whose email now belongs to a member is kept as accepted rather
+// than removed, which would invite the member again.
+func (r *MembershipInvitationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state MembershipInvitationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	invitation, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.Invitation, error) {
+		return r.client.GetInvitation(ctx, state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			r.readMissing(ctx, state, resp)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Invitation",
+				fmt.Sprintf("Could not read invitation ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Invitation",
+			fmt.Sprintf("Could not read invitation ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	switch invitation.Status {
+	case zenfraclient.InvitationStatusExpired, zenfraclient.InvitationStatusRevoked:
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	newState := mapInvitationToState(invitation, state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// readMissing handles an invitation the API no longer returns: it was either accepted,
+// in which case state records the acceptance, or revoked, in which case it is removed.
+func (r *MembershipInvitationResource) readMissing(ctx context.Context, state MembershipInvitationModel, resp *resource.ReadResponse) {
+	if state.accepted() {
+		return
+	}
+
+	member, err := r.client.FindMember(ctx, state.Email.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading Invitation",
+			fmt.Sprintf("Invitation ID %s no longer exists, and checking whether %s accepted it failed: %s",
+				state.ID.ValueString(), state.Email.ValueString(), err))
+		return
+	}
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, markAccepted(state, member))...)
+}
+
+// Update changes the role of a pending invitation and resends it when resend_triggers or
+// expires_in_days change. An accepted invitation is only updated in state.
+func (r *MembershipInvitationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state MembershipInvitationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	newState := state
+	newState.Role = plan.Role
+	newState.ExpiresInDays = plan.ExpiresInDays
+	newState.ResendTriggers = plan.ResendTriggers
+
+	if state.accepted() {
+		if !plan.Role.Equal(state.Role) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("role"), "Invitation Already Accepted",
+				fmt.Sprintf("%s accepted the invitation, so changing role does not change their role in the organization.", state.Email.ValueString()))
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+		return
+	}
+
+	id := state.ID.ValueString()
+	if !plan.Role.Equal(state.Role) {
+		role := plan.Role.ValueString()
+		invitation, err := r.client.UpdateInvitation(ctx, id, zenfraclient.UpdateInvitationRequest{Role: &role})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Updating Invitation",
+				fmt.Sprintf("Could not update invitation ID %s: %s", id, err))
+			return
+		}
+		newState = mapInvitationToState(invitation, plan)
+	}
+
+	if resends(plan, state) {
+		resendReq := zenfraclient.ResendInvitationRequest{}
+		if !plan.ExpiresInDays.IsNull() {
+			days := plan.ExpiresInDays.ValueInt64()
+			resendReq.ExpiresInDays = &days
+		}
+		invitation, err := r.client.ResendInvitation(ctx, id, resendReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Resending Invitation",
+				fmt.Sprintf("Could not resend invitation ID %s: %s", id, err))
+			return
+		}
+		newState = mapInvitationToState(invitation, plan)
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// Delete revokes a pending invitation. Destroying an accepted invitation makes no API
+// call: the member it created stays in the organization.
+func (r *MembershipInvitationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state MembershipInvitationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || state.accepted() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.RevokeInvitation(ctx, state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Revoking Invitation",
+			fmt.Sprintf("Could not revoke invitation ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *MembershipInvitationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationID(ctx, r.client, "invitation", importguard.Invitation(r.client), req, resp)
+}
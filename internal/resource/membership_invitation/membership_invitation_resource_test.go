@@ -0,0 +1,249 @@
+// ABOUTME: Unit tests for the zenfra_membership_invitation resource against the zenfrafake client.
+// ABOUTME: Covers acceptance detection on read, expired and revoked invitations, and updates and deletes after acceptance.
+package membership_invitation
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func pendingModel() *MembershipInvitationModel {
+	return &MembershipInvitationModel{
+		ID:             types.StringValue("inv-1"),
+		OrganizationID: types.StringValue("org-1"),
+		Email:          types.StringValue("jane@example.com"),
+		Role:           types.StringValue("write"),
+		ExpiresInDays:  types.Int64Null(),
+		ResendTriggers: types.MapNull(types.StringType),
+		Status:         types.StringValue(zenfraclient.InvitationStatusPending),
+		ExpiresAt:      timeutil.NewTimestampValue("2026-03-08T12:00:00Z"),
+		SentAt:         timeutil.NewTimestampValue("2026-03-01T12:00:00Z"),
+		AcceptedAt:     timeutil.NewTimestampNull(),
+		CreatedAt:      timeutil.NewTimestampValue("2026-03-01T12:00:00Z"),
+		UpdatedAt:      timeutil.NewTimestampValue("2026-03-01T12:00:00Z"),
+	}
+}
+
+func acceptedModel() *MembershipInvitationModel {
+	m := pendingModel()
+	m.Status = types.StringValue(zenfraclient.InvitationStatusAccepted)
+	m.AcceptedAt = timeutil.NewTimestampValue("2026-03-02T09:00:00Z")
+	return m
+}
+
+func TestMembershipInvitationResource_Read(t *testing.T) {
+	ctx := context.Background()
+	joined := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		prior       *MembershipInvitationModel
+		status      string
+		member      *zenfraclient.Member
+		wantRemoved bool
+		wantStatus  string
+		wantCalls   []string
+	}{
+		{name: "pending", prior: pendingModel(), status: zenfraclient.InvitationStatusPending,
+			wantStatus: zenfraclient.InvitationStatusPending, wantCalls: []string{"GetInvitation"}},
+		{name: "accepted and kept by the API", prior: pendingModel(), status: zenfraclient.InvitationStatusAccepted,
+			wantStatus: zenfraclient.InvitationStatusAccepted, wantCalls: []string{"GetInvitation"}},
+		{name: "expired", prior: pendingModel(), status: zenfraclient.InvitationStatusExpired,
+			wantRemoved: true, wantCalls: []string{"GetInvitation"}},
+		{name: "revoked", prior: pendingModel(), status: zenfraclient.InvitationStatusRevoked,
+			wantRemoved: true, wantCalls: []string{"GetInvitation"}},
+		{name: "deleted on acceptance", prior: pendingModel(),
+			member:     &zenfraclient.Member{UserID: "u-1", Email: "Jane@example.com", Role: "write", JoinedAt: joined},
+			wantStatus: zenfraclient.InvitationStatusAccepted, wantCalls: []string{"GetInvitation", "FindMember"}},
+		{name: "deleted without a member", prior: pendingModel(),
+			wantRemoved: true, wantCalls: []string{"GetInvitation", "FindMember"}},
+		{name: "already accepted and deleted", prior: acceptedModel(),
+			wantStatus: zenfraclient.InvitationStatusAccepted, wantCalls: []string{"GetInvitation"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &zenfrafake.Client{
+				GetInvitationFunc: func(_ context.Context, id string) (*zenfraclient.Invitation, error) {
+					if tt.status == "" {
+						return nil, zenfrafake.NotFound()
+					}
+					return &zenfraclient.Invitation{ID: id, OrganizationID: "org-1", Email: "jane@example.com", Role: "write", Status: tt.status}, nil
+				},
+				FindMemberFunc: func(_ context.Context, email string) (*zenfraclient.Member, error) {
+					if email != "jane@example.com" {
+						t.Errorf("expected a lookup of the invited email, got %q", email)
+					}
+					return tt.member, nil
+				},
+			}
+			r := &MembershipInvitationResource{client: fake}
+
+			state := zenfrafake.State(t, r, tt.prior)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", resp.Diagnostics)
+			}
+			if !slices.Equal(fake.Calls(), tt.wantCalls) {
+				t.Errorf("expected calls %v, got %v", tt.wantCalls, fake.Calls())
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Fatalf("expected removed = %v, got state %v", tt.wantRemoved, resp.State.Raw)
+			}
+			if tt.wantRemoved {
+				return
+			}
+
+			var got MembershipInvitationModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.Status.ValueString() != tt.wantStatus {
+				t.Errorf("expected status %q, got %s", tt.wantStatus, got.Status)
+			}
+			if tt.member != nil && got.AcceptedAt.ValueString() != "2026-03-02T09:00:00Z" {
+				t.Errorf("expected accepted_at from the member's join time, got %s", got.AcceptedAt)
+			}
+		})
+	}
+}
+
+func TestMembershipInvitationResource_Update(t *testing.T) {
+	ctx := context.Background()
+	resent := time.Date(2026, 3, 5, 12, 0, 0, 0, time.UTC)
+	triggers := types.MapValueMust(types.StringType, map[string]attr.Value{"reminder": types.StringValue("1")})
+
+	tests := []struct {
+		name      string
+		prior     *MembershipInvitationModel
+		change    func(*MembershipInvitationModel)
+		wantCalls []string
+		wantWarn  bool
+	}{
+		{name: "role", prior: pendingModel(),
+			change:    func(m *MembershipInvitationModel) { m.Role = types.StringValue("admin") },
+			wantCalls: []string{"UpdateInvitation"}},
+		{name: "resend triggers", prior: pendingModel(),
+			change:    func(m *MembershipInvitationModel) { m.ResendTriggers = triggers },
+			wantCalls: []string{"ResendInvitation"}},
+		{name: "role and expiry", prior: pendingModel(),
+			change: func(m *MembershipInvitationModel) {
+				m.Role = types.StringValue("admin")
+				m.ExpiresInDays = types.Int64Value(14)
+			},
+			wantCalls: []string{"UpdateInvitation", "ResendInvitation"}},
+		{name: "role after acceptance", prior: acceptedModel(),
+			change:   func(m *MembershipInvitationModel) { m.Role = types.StringValue("admin") },
+			wantWarn: true},
+		{name: "resend triggers after acceptance", prior: acceptedModel(),
+			change: func(m *MembershipInvitationModel) { m.ResendTriggers = triggers }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotExpiresIn *int64
+			role := tt.prior.Role.ValueString()
+			fake := &zenfrafake.Client{
+				UpdateInvitationFunc: func(_ context.Context, id string, req zenfraclient.UpdateInvitationRequest) (*zenfraclient.Invitation, error) {
+					role = *req.Role
+					return &zenfraclient.Invitation{ID: id, OrganizationID: "org-1", Email: "jane@example.com", Role: role, Status: zenfraclient.InvitationStatusPending}, nil
+				},
+				ResendInvitationFunc: func(_ context.Context, id string, req zenfraclient.ResendInvitationRequest) (*zenfraclient.Invitation, error) {
+					gotExpiresIn = req.ExpiresInDays
+					return &zenfraclient.Invitation{ID: id, OrganizationID: "org-1", Email: "jane@example.com", Role: role, Status: zenfraclient.InvitationStatusPending, SentAt: resent}, nil
+				},
+			}
+			r := &MembershipInvitationResource{client: fake}
+
+			plan := *tt.prior
+			tt.change(&plan)
+			resp := &resource.UpdateResponse{State: zenfrafake.State(t, r, tt.prior)}
+			r.Update(ctx, resource.UpdateRequest{
+				Plan:  tfsdk.Plan(zenfrafake.State(t, r, &plan)),
+				State: zenfrafake.State(t, r, tt.prior),
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update: %v", resp.Diagnostics)
+			}
+			if !slices.Equal(fake.Calls(), tt.wantCalls) {
+				t.Errorf("expected calls %v, got %v", tt.wantCalls, fake.Calls())
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarn {
+				t.Errorf("expected warning = %v, got %v", tt.wantWarn, resp.Diagnostics)
+			}
+
+			var got MembershipInvitationModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if !got.Role.Equal(plan.Role) || !got.ResendTriggers.Equal(plan.ResendTriggers) || !got.ExpiresInDays.Equal(plan.ExpiresInDays) {
+				t.Errorf("expected the planned arguments in state, got %+v", got)
+			}
+			if !got.Status.Equal(tt.prior.Status) {
+				t.Errorf("expected status %s, got %s", tt.prior.Status, got.Status)
+			}
+			if slices.Contains(tt.wantCalls, "ResendInvitation") && got.SentAt.ValueString() != "2026-03-05T12:00:00Z" {
+				t.Errorf("expected sent_at from the resend, got %s", got.SentAt)
+			}
+			if !plan.ExpiresInDays.IsNull() && (gotExpiresIn == nil || *gotExpiresIn != plan.ExpiresInDays.ValueInt64()) {
+				t.Errorf("expected the resend to carry expires_in_days %s, got %v", plan.ExpiresInDays, gotExpiresIn)
+			}
+		})
+	}
+}
+
+func TestMembershipInvitationResource_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		prior     *MembershipInvitationModel
+		err       error
+		wantCalls []string
+	}{
+		{name: "pending", prior: pendingModel(), wantCalls: []string{"RevokeInvitation"}},
+		{name: "already gone", prior: pendingModel(), err: zenfrafake.NotFound(), wantCalls: []string{"RevokeInvitation"}},
+		{name: "accepted", prior: acceptedModel()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &zenfrafake.Client{
+				RevokeInvitationFunc: func(context.Context, string) error { return tt.err },
+			}
+			r := &MembershipInvitationResource{client: fake}
+
+			resp := &resource.DeleteResponse{State: zenfrafake.State(t, r, tt.prior)}
+			r.Delete(ctx, resource.DeleteRequest{State: zenfrafake.State(t, r, tt.prior)}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Delete: %v", resp.Diagnostics)
+			}
+			if !slices.Equal(fake.Calls(), tt.wantCalls) {
+				t.Errorf("expected calls %v, got %v", tt.wantCalls, fake.Calls())
+			}
+		})
+	}
+}
+
+func TestValidEmail(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"jane@example.com", true},
+		{"jane.doe+infra@example.co.uk", true},
+		{"Jane Doe <jane@example.com>", false},
+		{"<jane@example.com>", false},
+		{"jane", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := validEmail(tt.in); got != tt.want {
+			t.Errorf("validEmail(%q): expected %v, got %v", tt.in, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// ABOUTME: Terraform state model for the zenfra_organization_domain resource.
+// ABOUTME: Maps API organization domains to state and validates domain names.
+package organization_domain
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// OrganizationDomainModel represents the Terraform state model for an organization domain.
+type OrganizationDomainModel struct {
+	ID                     types.String            `tfsdk:"id"`
+	OrganizationID         types.String            `tfsdk:"organization_id"`
+	Domain                 types.String            `tfsdk:"domain"`
+	AutoJoinRole           types.String            `tfsdk:"auto_join_role"`
+	Status                 types.String            `tfsdk:"status"`
+	VerificationRecordName types.String            `tfsdk:"verification_record_name"`
+	VerificationToken      types.String            `tfsdk:"verification_token"`
+	VerifiedAt             timeutil.TimestampValue `tfsdk:"verified_at"`
+	CreatedAt              timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt              timeutil.TimestampValue `tfsdk:"updated_at"`
+}
+
+// mapDomainToState converts an API organization domain to state. The API reports no
+// auto-join role as an empty string, which maps to null.
+func mapDomainToState(domain *zenfraclient.OrganizationDomain) OrganizationDomainModel {
+	model := OrganizationDomainModel{
+		ID:                     types.StringValue(domain.ID),
+		OrganizationID:         types.StringValue(domain.OrganizationID),
+		Domain:                 types.StringValue(domain.Domain),
+		AutoJoinRole:           types.StringNull(),
+		Status:                 types.StringValue(domain.Status),
+		VerificationRecordName: types.StringValue(domain.VerificationRecordName),
+		VerificationToken:      types.StringValue(domain.VerificationToken),
+		VerifiedAt:             timeutil.TimestampPointer(domain.VerifiedAt),
+		CreatedAt:              timeutil.Timestamp(domain.CreatedAt),
+		UpdatedAt:              timeutil.Timestamp(domain.UpdatedAt),
+	}
+	if domain.AutoJoinRole != "" {
+		model.AutoJoinRole = types.StringValue(domain.AutoJoinRole)
+	}
+	return model
+}
+
+// domainProblem describes why s is not a bare domain name the API accepts, or returns ""
+// if it is one.
+func domainProblem(s string) string {
+	switch {
+	case strings.Contains(s, "://") || strings.ContainsAny(s, "/@"):
+		return "Use the bare domain name, such as example.com, without a scheme, path, or email address."
+	case s != strings.ToLower(s):
+		return "Use lowercase letters; DNS names are case-insensitive and the API stores them in lowercase."
+	case strings.HasPrefix(s, "*."):
+		return "Wildcard domains cannot be verified. Claim each domain separately."
+	}
+
+	labels := strings.Split(s, ".")
+	if len(labels) < 2 {
+		return "The domain needs at least two labels, such as example.com."
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > 63 || strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+			return "Each dot-separated label must be 1 to 63 characters and may not start or end with a hyphen."
+		}
+		for _, r := range label {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return "Labels may only contain letters, digits, and hyphens. Use the punycode form of internationalized domains."
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,259 @@
+// ABOUTME: Implements the zenfra_organization_domain Terraform resource with full CRUD lifecycle.
+// ABOUTME: Claims an email domain for auto-join; exposes the DNS TXT record that verifies it.
+package organization_domain
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &OrganizationDomainResource{}
+	_ resource.ResourceWithImportState    = &OrganizationDomainResource{}
+	_ resource.ResourceWithValidateConfig = &OrganizationDomainResource{}
+	_ resource.ResourceWithModifyPlan     = &OrganizationDomainResource{}
+)
+
+// NewOrganizationDomainResource is a constructor for the organization domain resource.
+func NewOrganizationDomainResource() resource.Resource {
+	return &OrganizationDomainResource{}
+}
+
+// OrganizationDomainResource is the resource implementation.
+type OrganizationDomainResource struct {
+	client zenfraclient.OrganizationDomainAPI
+}
+
+func (r *OrganizationDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_domain"
+}
+
+func (r *OrganizationDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Claims an email domain for the organization. Publish verification_token as a TXT record named " +
+			"verification_record_name; once Zenfra finds it, people who sign in with an address at the domain can join " +
+			"the organization with auto_join_role. Use zenfra_organization_domain_verification to wait for the check to pass.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the organization domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID that claims the domain. Defaults to the organization of the provider's API token; " +
+					"set it to claim the domain for another organization the token has access to. Changing it forces a new domain.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "The email domain to claim, such as example.com. Changing it releases the domain and claims the new one, " +
+					"which has to be verified again.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"auto_join_role": schema.StringAttribute{
+				Description: "The organization role, read, write, or admin, given to people who join through the verified domain. " +
+					"Omit it to verify the domain without letting anyone join automatically.",
+				Optional: true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.OrganizationRoleRead, zenfraclient.OrganizationRoleWrite, zenfraclient.OrganizationRoleAdmin),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "The verification status of the domain: pending, verified, or failed. A verified domain whose TXT record " +
+					"is removed goes back to pending.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_record_name": schema.StringAttribute{
+				Description: "The DNS name of the TXT record that verifies the domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_token": schema.StringAttribute{
+				Description: "The value of the TXT record that verifies the domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verified_at": schema.StringAttribute{
+				Description: "Timestamp when the domain was verified. Null while it is not verified.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the domain was claimed.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the domain was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the domain name.
+func (r *OrganizationDomainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OrganizationDomainModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Domain.IsNull() && !config.Domain.IsUnknown() {
+		if problem := domainProblem(config.Domain.ValueString()); problem != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("domain"), "Invalid Domain",
+				fmt.Sprintf("%q is not a valid domain. %s", config.Domain.ValueString(), problem))
+		}
+	}
+}
+
+// ModifyPlan warns when the API token may not manage organization domains.
+func (r *OrganizationDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_organization_domain", "organization_domain", req, resp)
+}
+
+func (r *OrganizationDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_organization_domain")
+	}
+}
+
+func (r *OrganizationDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationDomainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	domain, err := r.client.CreateOrganizationDomain(ctx, zenfraclient.CreateOrganizationDomainRequest{
+		Domain:       plan.Domain.ValueString(),
+		AutoJoinRole: plan.AutoJoinRole.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Organization Domain",
+			fmt.Sprintf("Could not claim domain %s: %s", plan.Domain.ValueString(), err))
+		return
+	}
+
+	state := mapDomainToState(domain)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *OrganizationDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationDomainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	domain, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.OrganizationDomain, error) {
+		return r.client.GetOrganizationDomain(ctx, state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Organization Domain",
+				fmt.Sprintf("Could not read organization domain ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Organization Domain",
+			fmt.Sprintf("Could not read organization domain ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapDomainToState(domain))...)
+}
+
+func (r *OrganizationDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state OrganizationDomainModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	// auto_join_role is the only attribute that changes in place; an empty role turns
+	// auto-join off.
+	role := plan.AutoJoinRole.ValueString()
+	domain, err := r.client.UpdateOrganizationDomain(ctx, state.ID.ValueString(), zenfraclient.UpdateOrganizationDomainRequest{
+		AutoJoinRole: &role,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Organization Domain",
+			fmt.Sprintf("Could not update organization domain ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapDomainToState(domain))...)
+}
+
+func (r *OrganizationDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OrganizationDomainModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteOrganizationDomain(ctx, state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Organization Domain",
+			fmt.Sprintf("Could not release organization domain ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *OrganizationDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationID(ctx, r.client, "organization domain", importguard.OrganizationDomain(r.client), req, resp)
+}
@@ -0,0 +1,72 @@
+// ABOUTME: Unit tests for the zenfra_organization_domain resource model mapping and domain validation.
+// ABOUTME: Covers auto-join roles reported as empty strings and the domain forms the API rejects.
+package organization_domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapDomainToState(t *testing.T) {
+	verifiedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	domain := &zenfraclient.OrganizationDomain{
+		ID:                     "dom-1",
+		OrganizationID:         "org-1",
+		Domain:                 "example.com",
+		Status:                 zenfraclient.DomainStatusVerified,
+		VerificationRecordName: "_zenfra-challenge.example.com",
+		VerificationToken:      "zenfra-verify=abc",
+		AutoJoinRole:           "read",
+		VerifiedAt:             &verifiedAt,
+		CreatedAt:              time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC),
+		UpdatedAt:              time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC),
+	}
+
+	got := mapDomainToState(domain)
+	if got.AutoJoinRole.ValueString() != "read" || got.VerificationToken.ValueString() != "zenfra-verify=abc" {
+		t.Errorf("unexpected state: %+v", got)
+	}
+	if got.VerifiedAt.ValueString() != "2026-03-02T09:00:00Z" {
+		t.Errorf("expected verified_at 2026-03-02T09:00:00Z, got %s", got.VerifiedAt)
+	}
+
+	domain.AutoJoinRole = ""
+	domain.VerifiedAt = nil
+	domain.Status = zenfraclient.DomainStatusPending
+	got = mapDomainToState(domain)
+	if !got.AutoJoinRole.IsNull() {
+		t.Errorf("expected null auto_join_role, got %s", got.AutoJoinRole)
+	}
+	if !got.VerifiedAt.IsNull() {
+		t.Errorf("expected null verified_at, got %s", got.VerifiedAt)
+	}
+}
+
+func TestDomainProblem(t *testing.T) {
+	tests := []struct {
+		in   string
+		want bool
+	}{
+		{"example.com", false},
+		{"mail.example.co.uk", false},
+		{"xn--bcher-kva.example", false},
+		{"my-company.io", false},
+		{"Example.com", true},
+		{"https://example.com", true},
+		{"example.com/", true},
+		{"jane@example.com", true},
+		{"*.example.com", true},
+		{"localhost", true},
+		{"example..com", true},
+		{"-example.com", true},
+		{"bücher.example", true},
+		{"example_corp.com", true},
+	}
+	for _, tt := range tests {
+		if got := domainProblem(tt.in) != ""; got != tt.want {
+			t.Errorf("domainProblem(%q): expected problem %v, got %q", tt.in, tt.want, domainProblem(tt.in))
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// ABOUTME: Terraform state model for the zenfra_organization_domain_verification resource.
+// ABOUTME: Holds the wait settings and the verified domain's name and verification time.
+package organization_domain_verification
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+const (
+	defaultTimeout      = 15 * time.Minute
+	defaultPollInterval = 15 * time.Second
+)
+
+// OrganizationDomainVerificationModel represents the Terraform state model for a domain verification.
+type OrganizationDomainVerificationModel struct {
+	ID                  types.String            `tfsdk:"id"`
+	OrganizationID      types.String            `tfsdk:"organization_id"`
+	DomainID            types.String            `tfsdk:"domain_id"`
+	Domain              types.String            `tfsdk:"domain"`
+	TimeoutSeconds      types.Int64             `tfsdk:"timeout_seconds"`
+	PollIntervalSeconds types.Int64             `tfsdk:"poll_interval_seconds"`
+	VerifiedAt          timeutil.TimestampValue `tfsdk:"verified_at"`
+}
+
+// setDomain copies the verified domain into the model, keeping the wait settings.
+func (m *OrganizationDomainVerificationModel) setDomain(domain *zenfraclient.OrganizationDomain) {
+	m.ID = types.StringValue(domain.ID)
+	m.OrganizationID = types.StringValue(domain.OrganizationID)
+	m.DomainID = types.StringValue(domain.ID)
+	m.Domain = types.StringValue(domain.Domain)
+	m.VerifiedAt = timeutil.TimestampPointer(domain.VerifiedAt)
+}
+
+// waitSettings returns the configured timeout and poll interval, or their defaults.
+func (m *OrganizationDomainVerificationModel) waitSettings() (time.Duration, time.Duration) {
+	timeout, interval := defaultTimeout, defaultPollInterval
+	if !m.TimeoutSeconds.IsNull() {
+		timeout = time.Duration(m.TimeoutSeconds.ValueInt64()) * time.Second
+	}
+	if !m.PollIntervalSeconds.IsNull() {
+		interval = time.Duration(m.PollIntervalSeconds.ValueInt64()) * time.Second
+	}
+	return timeout, interval
+}
@@ -0,0 +1,231 @@
+// ABOUTME: Implements the zenfra_organization_domain_verification resource, which waits for a domain's DNS verification.
+// ABOUTME: Creating it triggers a TXT record check and polls until the domain is verified; destroying it changes nothing.
+package organization_domain_verification
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &OrganizationDomainVerificationResource{}
+	_ resource.ResourceWithImportState    = &OrganizationDomainVerificationResource{}
+	_ resource.ResourceWithValidateConfig = &OrganizationDomainVerificationResource{}
+	_ resource.ResourceWithModifyPlan     = &OrganizationDomainVerificationResource{}
+)
+
+// NewOrganizationDomainVerificationResource is a constructor for the domain verification resource.
+func NewOrganizationDomainVerificationResource() resource.Resource {
+	return &OrganizationDomainVerificationResource{}
+}
+
+// OrganizationDomainVerificationResource is the resource implementation.
+type OrganizationDomainVerificationResource struct {
+	client zenfraclient.OrganizationDomainVerificationAPI
+}
+
+func (r *OrganizationDomainVerificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_domain_verification"
+}
+
+func (r *OrganizationDomainVerificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Waits for a zenfra_organization_domain to be verified. Make it depend on the DNS record that publishes the " +
+			"domain's verification token: creating the resource asks Zenfra to check the record and polls until the domain is " +
+			"verified, so resources that rely on auto-join can depend on it. If the domain stops being verified, the resource is " +
+			"planned for creation again. Destroying it does not change the domain.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the organization domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID that claims the domain. Defaults to the organization of the provider's API token. " +
+					"Changing it waits for the domain in the new organization.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_id": schema.StringAttribute{
+				Description: "The ID of the organization domain to wait for. Changing it waits for the new domain.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Description: "The verified domain name.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeout_seconds": schema.Int64Attribute{
+				Description: "Maximum time to wait for the domain to be verified. DNS changes can take a while to propagate; " +
+					"creation fails if the timeout elapses first. Defaults to 900.",
+				Optional: true,
+			},
+			"poll_interval_seconds": schema.Int64Attribute{
+				Description: "Interval between verification status checks. Defaults to 15.",
+				Optional:    true,
+			},
+			"verified_at": schema.StringAttribute{
+				Description: "Timestamp when the domain was verified.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the wait settings.
+func (r *OrganizationDomainVerificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OrganizationDomainVerificationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.TimeoutSeconds.IsNull() && !config.TimeoutSeconds.IsUnknown() && config.TimeoutSeconds.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout_seconds"), "Invalid Timeout",
+			"timeout_seconds must be at least 1.")
+	}
+	if !config.PollIntervalSeconds.IsNull() && !config.PollIntervalSeconds.IsUnknown() && config.PollIntervalSeconds.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval_seconds"), "Invalid Poll Interval",
+			"poll_interval_seconds must be at least 1.")
+	}
+}
+
+// ModifyPlan warns when the API token may not manage organization domains.
+func (r *OrganizationDomainVerificationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_organization_domain_verification", "organization_domain", req, resp)
+}
+
+func (r *OrganizationDomainVerificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_organization_domain_verification")
+	}
+}
+
+// Create asks the API to check the domain's TXT record now, then polls until the domain
+// is verified. On failure nothing is stored, so the next apply checks again.
+func (r *OrganizationDomainVerificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OrganizationDomainVerificationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+	domainID := plan.DomainID.ValueString()
+
+	domain, err := r.client.VerifyOrganizationDomain(ctx, domainID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Verifying Organization Domain",
+			fmt.Sprintf("Could not start verification of organization domain ID %s: %s", domainID, err))
+		return
+	}
+
+	if domain.Status != zenfraclient.DomainStatusVerified {
+		timeout, interval := plan.waitSettings()
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		verified, err := r.client.WaitForOrganizationDomainVerified(waitCtx, domainID, interval)
+		cancel()
+		if err != nil {
+			resp.Diagnostics.AddError("Organization Domain Not Verified",
+				fmt.Sprintf("Domain %s was not verified: %s\n\nCheck that a TXT record named %s with the value %q is published "+
+					"and visible to public DNS resolvers, then apply again.",
+					domain.Domain, err, domain.VerificationRecordName, domain.VerificationToken))
+			return
+		}
+		domain = verified
+	}
+
+	plan.setDomain(domain)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read removes the resource from state when the domain is gone or no longer verified,
+// so the next apply waits for verification again.
+func (r *OrganizationDomainVerificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OrganizationDomainVerificationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	// An imported verification only knows the domain ID.
+	domainID := state.DomainID.ValueString()
+	if state.DomainID.IsNull() {
+		domainID = state.ID.ValueString()
+	}
+
+	domain, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.OrganizationDomain, error) {
+		return r.client.GetOrganizationDomain(ctx, domainID)
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Organization Domain",
+				fmt.Sprintf("Could not read organization domain ID %s: %s\n\n%s", domainID, err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Organization Domain",
+			fmt.Sprintf("Could not read organization domain ID %s: %s", domainID, err))
+		return
+	}
+
+	if domain.Status != zenfraclient.DomainStatusVerified {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.setDomain(domain)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *OrganizationDomainVerificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only the wait settings change in place, and they only matter on create.
+	var plan OrganizationDomainVerificationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *OrganizationDomainVerificationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Verification cannot be undone; destroying zenfra_organization_domain releases the domain.
+}
+
+// ImportState accepts an organization domain ID, or <organization_id>/<id>.
+func (r *OrganizationDomainVerificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationID(ctx, r.client, "organization domain", importguard.OrganizationDomain(r.client), req, resp)
+}
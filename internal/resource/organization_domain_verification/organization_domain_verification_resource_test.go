@@ -0,0 +1,158 @@
+// ABOUTME: Unit tests for the zenfra_organization_domain_verification resource against the zenfrafake client.
+// ABOUTME: Covers waiting on create, failed verification, and domains that stop being verified.
+package organization_domain_verification
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func plannedModel() *OrganizationDomainVerificationModel {
+	return &OrganizationDomainVerificationModel{
+		ID:                  types.StringUnknown(),
+		OrganizationID:      types.StringUnknown(),
+		DomainID:            types.StringValue("dom-1"),
+		Domain:              types.StringUnknown(),
+		TimeoutSeconds:      types.Int64Value(60),
+		PollIntervalSeconds: types.Int64Null(),
+		VerifiedAt:          timeutil.NewTimestampUnknown(),
+	}
+}
+
+func domain(status string) *zenfraclient.OrganizationDomain {
+	d := &zenfraclient.OrganizationDomain{
+		ID:                     "dom-1",
+		OrganizationID:         "org-1",
+		Domain:                 "example.com",
+		Status:                 status,
+		VerificationRecordName: "_zenfra-challenge.example.com",
+		VerificationToken:      "zenfra-verify=abc",
+	}
+	if status == zenfraclient.DomainStatusVerified {
+		verifiedAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+		d.VerifiedAt = &verifiedAt
+	}
+	return d
+}
+
+func TestOrganizationDomainVerificationResource_Create(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		verifyStatus string
+		waitErr      error
+		wantCalls    []string
+		wantErr      bool
+	}{
+		{name: "already verified", verifyStatus: zenfraclient.DomainStatusVerified,
+			wantCalls: []string{"VerifyOrganizationDomain"}},
+		{name: "verified while waiting", verifyStatus: zenfraclient.DomainStatusPending,
+			wantCalls: []string{"VerifyOrganizationDomain", "WaitForOrganizationDomainVerified"}},
+		{name: "not verified", verifyStatus: zenfraclient.DomainStatusPending, waitErr: context.DeadlineExceeded,
+			wantCalls: []string{"VerifyOrganizationDomain", "WaitForOrganizationDomainVerified"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotInterval time.Duration
+			var hasDeadline bool
+			fake := &zenfrafake.Client{
+				VerifyOrganizationDomainFunc: func(context.Context, string) (*zenfraclient.OrganizationDomain, error) {
+					return domain(tt.verifyStatus), nil
+				},
+				WaitForOrganizationDomainVerifiedFunc: func(ctx context.Context, _ string, interval time.Duration) (*zenfraclient.OrganizationDomain, error) {
+					gotInterval = interval
+					_, hasDeadline = ctx.Deadline()
+					if tt.waitErr != nil {
+						return domain(zenfraclient.DomainStatusPending), tt.waitErr
+					}
+					return domain(zenfraclient.DomainStatusVerified), nil
+				},
+			}
+			r := &OrganizationDomainVerificationResource{client: fake}
+
+			resp := &resource.CreateResponse{State: zenfrafake.State(t, r, nil)}
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(zenfrafake.State(t, r, plannedModel()))}, resp)
+
+			if !slices.Equal(fake.Calls(), tt.wantCalls) {
+				t.Errorf("expected calls %v, got %v", tt.wantCalls, fake.Calls())
+			}
+			if slices.Contains(tt.wantCalls, "WaitForOrganizationDomainVerified") && (gotInterval != defaultPollInterval || !hasDeadline) {
+				t.Errorf("expected a bounded wait polling every %s, got %s (deadline %v)", defaultPollInterval, gotInterval, hasDeadline)
+			}
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("expected an error")
+				}
+				if !resp.State.Raw.IsNull() {
+					t.Error("expected no state after a failed verification")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Create: %v", resp.Diagnostics)
+			}
+
+			var got OrganizationDomainVerificationModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.ID.ValueString() != "dom-1" || got.Domain.ValueString() != "example.com" || got.VerifiedAt.ValueString() != "2026-03-02T09:00:00Z" {
+				t.Errorf("unexpected state: %+v", got)
+			}
+			if got.TimeoutSeconds.ValueInt64() != 60 {
+				t.Errorf("expected the planned timeout in state, got %s", got.TimeoutSeconds)
+			}
+		})
+	}
+}
+
+func TestOrganizationDomainVerificationResource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		status      string
+		err         error
+		wantRemoved bool
+		wantErr     bool
+	}{
+		{name: "verified", status: zenfraclient.DomainStatusVerified},
+		{name: "back to pending", status: zenfraclient.DomainStatusPending, wantRemoved: true},
+		{name: "domain released", err: zenfrafake.NotFound(), wantRemoved: true},
+		{name: "api error", err: errors.New("boom"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &zenfrafake.Client{
+				GetOrganizationDomainFunc: func(context.Context, string) (*zenfraclient.OrganizationDomain, error) {
+					if tt.err != nil {
+						return nil, tt.err
+					}
+					return domain(tt.status), nil
+				},
+			}
+			r := &OrganizationDomainVerificationResource{client: fake}
+
+			prior := plannedModel()
+			prior.setDomain(domain(zenfraclient.DomainStatusVerified))
+			resp := &resource.ReadResponse{State: zenfrafake.State(t, r, prior)}
+			r.Read(ctx, resource.ReadRequest{State: zenfrafake.State(t, r, prior)}, resp)
+
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Fatalf("expected error %v, got %v", tt.wantErr, resp.Diagnostics)
+			}
+			if got := resp.State.Raw.IsNull(); got != tt.wantRemoved {
+				t.Errorf("expected removed %v, got %v", tt.wantRemoved, got)
+			}
+		})
+	}
+}
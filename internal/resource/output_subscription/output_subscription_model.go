@@ -0,0 +1,62 @@
+// ABOUTME: Terraform state model for the zenfra_output_subscription resource.
+// ABOUTME: Maps an API output subscription, whose empty output list means every output, to Terraform state.
+package output_subscription
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// OutputSubscriptionModel represents the Terraform state for a stack's subscription to
+// the outputs of another stack.
+type OutputSubscriptionModel struct {
+	ID             types.String            `tfsdk:"id"`
+	StackID        types.String            `tfsdk:"stack_id"`
+	SourceStackID  types.String            `tfsdk:"source_stack_id"`
+	Outputs        types.Set               `tfsdk:"outputs"`
+	CreatedAt      timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt      timeutil.TimestampValue `tfsdk:"updated_at"`
+	OrganizationID types.String            `tfsdk:"organization_id"`
+}
+
+// mapSubscriptionToState converts an API OutputSubscription to an OutputSubscriptionModel.
+// A subscription to every output maps to a null outputs set.
+func mapSubscriptionToState(ctx context.Context, sub *zenfraclient.OutputSubscription) (OutputSubscriptionModel, diag.Diagnostics) {
+	model := OutputSubscriptionModel{
+		ID:            types.StringValue(sub.ID),
+		StackID:       types.StringValue(sub.StackID),
+		SourceStackID: types.StringValue(sub.SourceStackID),
+		Outputs:       types.SetNull(types.StringType),
+		CreatedAt:     timeutil.Timestamp(sub.CreatedAt),
+		UpdatedAt:     timeutil.Timestamp(sub.UpdatedAt),
+	}
+
+	var diags diag.Diagnostics
+	if len(sub.Outputs) > 0 {
+		model.Outputs, diags = types.SetValueFrom(ctx, types.StringType, sub.Outputs)
+	}
+	return model, diags
+}
+
+// plannedOutputs returns the outputs set of model as a list for the API, empty rather
+// than nil when every output is watched.
+func plannedOutputs(ctx context.Context, model *OutputSubscriptionModel) ([]string, diag.Diagnostics) {
+	outputs := []string{}
+	if model.Outputs.IsNull() || model.Outputs.IsUnknown() {
+		return outputs, nil
+	}
+	diags := model.Outputs.ElementsAs(ctx, &outputs, false)
+	return outputs, diags
+}
+
+// keepEmptyOutputs keeps an explicitly empty outputs set from src, which the API reports
+// the same as an unset one.
+func (m *OutputSubscriptionModel) keepEmptyOutputs(src *OutputSubscriptionModel) {
+	if m.Outputs.IsNull() && !src.Outputs.IsNull() && !src.Outputs.IsUnknown() && len(src.Outputs.Elements()) == 0 {
+		m.Outputs = src.Outputs
+	}
+}
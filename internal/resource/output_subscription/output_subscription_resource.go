@@ -0,0 +1,356 @@
+// ABOUTME: Implements the zenfra_output_subscription Terraform resource, which makes a stack consume another stack's outputs.
+// ABOUTME: Zenfra queues a run of the subscribing stack when watched outputs change; plans fail on subscriptions that close a run cycle.
+package output_subscription
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/stackgraph"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &OutputSubscriptionResource{}
+	_ resource.ResourceWithImportState    = &OutputSubscriptionResource{}
+	_ resource.ResourceWithModifyPlan     = &OutputSubscriptionResource{}
+	_ resource.ResourceWithValidateConfig = &OutputSubscriptionResource{}
+)
+
+// NewOutputSubscriptionResource is a constructor for the output subscription resource.
+func NewOutputSubscriptionResource() resource.Resource {
+	return &OutputSubscriptionResource{}
+}
+
+// OutputSubscriptionResource is the resource implementation.
+type OutputSubscriptionResource struct {
+	client zenfraclient.OutputSubscriptionAPI
+}
+
+func (r *OutputSubscriptionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_output_subscription"
+}
+
+func (r *OutputSubscriptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Subscribes a stack to the outputs of another stack: when an output of the source stack changes, " +
+			"Zenfra queues a run of the subscribing stack. Plans fail if the subscription would make runs of a stack " +
+			"queue runs of itself through other subscriptions or run triggers.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the subscription.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The stack that consumes the outputs and is run when they change.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the stacks. Defaults to the organization of the provider's API token; set it to the " +
+					"stack's organization_id when the stack belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_stack_id": schema.StringAttribute{
+				Description: "The stack whose outputs are consumed.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"outputs": schema.SetAttribute{
+				Description: "Names of the source stack's outputs to watch. A change to any other output does not queue a run. " +
+					"Watches every output if not set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the subscription was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the subscription was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *OutputSubscriptionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_output_subscription")
+	}
+}
+
+// ValidateConfig rejects a stack subscribing to its own outputs and empty output names.
+func (r *OutputSubscriptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config OutputSubscriptionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.StackID.IsUnknown() && !config.SourceStackID.IsUnknown() && config.StackID.Equal(config.SourceStackID) {
+		resp.Diagnostics.AddAttributeError(path.Root("source_stack_id"), "Output Subscription Cycle",
+			"A stack cannot subscribe to its own outputs: every run that changed an output would queue another run.")
+	}
+
+	if config.Outputs.IsNull() || config.Outputs.IsUnknown() {
+		return
+	}
+	var outputs []types.String
+	resp.Diagnostics.Append(config.Outputs.ElementsAs(ctx, &outputs, false)...)
+	for _, o := range outputs {
+		if !o.IsUnknown() && strings.TrimSpace(o.ValueString()) == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("outputs"), "Invalid Output Name", "outputs must not contain empty names.")
+			return
+		}
+	}
+}
+
+// ModifyPlan reports a new subscription that would close a cycle of stacks queuing runs
+// of each other. Only edges that queue runs count: a kv reference reads an output
+// without triggering anything.
+func (r *OutputSubscriptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_output_subscription", "stack", req, resp)
+
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan OutputSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.StackID.IsUnknown() || plan.SourceStackID.IsUnknown() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state OutputSubscriptionModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (state.StackID.Equal(plan.StackID) && state.SourceStackID.Equal(plan.SourceStackID)) {
+			return
+		}
+	}
+
+	stackID := plan.StackID.ValueString()
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+	graph, err := r.client.GetStackDependencyGraph(ctx, &zenfraclient.StackDependencyGraphOptions{StackID: &stackID})
+	if err != nil {
+		// The API refuses cycles on apply as well; do not fail the plan over the check.
+		return
+	}
+
+	var edges []zenfraclient.StackDependencyEdge
+	for _, e := range graph.Edges {
+		if e.Kind == zenfraclient.StackDependencyRunTrigger || e.Kind == zenfraclient.StackDependencyOutputSubscription {
+			edges = append(edges, e)
+		}
+	}
+	cycle := stackgraph.CycleWith(edges, zenfraclient.StackDependencyEdge{
+		FromStackID: plan.SourceStackID.ValueString(),
+		ToStackID:   stackID,
+		Kind:        zenfraclient.StackDependencyOutputSubscription,
+	})
+	if cycle == nil {
+		return
+	}
+
+	names := make(map[string]string, len(graph.Nodes))
+	for _, n := range graph.Nodes {
+		names[n.StackID] = n.Name
+	}
+	labels := make([]string, 0, len(cycle))
+	for _, id := range cycle {
+		if name := names[id]; name != "" {
+			labels = append(labels, fmt.Sprintf("%s (%s)", name, id))
+		} else {
+			labels = append(labels, id)
+		}
+	}
+	resp.Diagnostics.AddAttributeError(path.Root("source_stack_id"), "Output Subscription Cycle",
+		fmt.Sprintf("Subscribing stack %s to the outputs of stack %s would make these stacks queue runs of each other "+
+			"through output subscriptions and run triggers: %s. Remove one of the dependencies between them.",
+			stackID, plan.SourceStackID.ValueString(), strings.Join(labels, ", ")))
+}
+
+func (r *OutputSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan OutputSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	outputs, diags := plannedOutputs(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stackID := plan.StackID.ValueString()
+	sub, err := r.client.CreateOutputSubscription(ctx, stackID, zenfraclient.CreateOutputSubscriptionRequest{
+		SourceStackID: plan.SourceStackID.ValueString(),
+		Outputs:       outputs,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Output Subscription",
+			fmt.Sprintf("Could not subscribe stack %s to the outputs of stack %s: %s", stackID, plan.SourceStackID.ValueString(), err))
+		return
+	}
+
+	state, diags := mapSubscriptionToState(ctx, sub)
+	state.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.keepEmptyOutputs(&plan)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *OutputSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state OutputSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	sub, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.OutputSubscription, error) {
+		return r.client.GetOutputSubscription(ctx, state.StackID.ValueString(), state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Output Subscription",
+				fmt.Sprintf("Could not read output subscription ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Output Subscription",
+			fmt.Sprintf("Could not read output subscription ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	newState, diags := mapSubscriptionToState(ctx, sub)
+	newState.OrganizationID = state.OrganizationID
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.keepEmptyOutputs(&state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *OutputSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OutputSubscriptionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	outputs, diags := plannedOutputs(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sub, err := r.client.UpdateOutputSubscription(ctx, plan.StackID.ValueString(), plan.ID.ValueString(),
+		zenfraclient.UpdateOutputSubscriptionRequest{Outputs: outputs})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Output Subscription",
+			fmt.Sprintf("Could not update output subscription ID %s: %s", plan.ID.ValueString(), err))
+		return
+	}
+
+	state, diags := mapSubscriptionToState(ctx, sub)
+	state.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.keepEmptyOutputs(&plan)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *OutputSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state OutputSubscriptionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteOutputSubscription(ctx, state.StackID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Output Subscription",
+			fmt.Sprintf("Could not delete output subscription ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *OutputSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id := importguard.SplitOrganization(req.ID)
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.HasPrefix(req.ID, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format: [organization_id/]stack_id:subscription_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+
+	if !importguard.VerifyOrganization(ctx, r.client, "stack", parts[0], importguard.Stack(r.client), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, OutputSubscriptionModel{
+		ID:            types.StringValue(parts[1]),
+		StackID:       types.StringValue(parts[0]),
+		SourceStackID: types.StringNull(),
+		Outputs:       types.SetNull(types.StringType),
+		CreatedAt:     timeutil.NewTimestampNull(),
+		UpdatedAt:     timeutil.NewTimestampNull(),
+	})...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+}
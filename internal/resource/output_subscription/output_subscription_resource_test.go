@@ -0,0 +1,170 @@
+// ABOUTME: Unit tests for the zenfra_output_subscription resource against the zenfrafake client.
+// ABOUTME: Covers state mapping, config validation, the plan-time cycle check, and create with all outputs.
+package output_subscription
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func subscriptionModel(stackID, sourceStackID string, outputs ...string) *OutputSubscriptionModel {
+	m := &OutputSubscriptionModel{
+		ID:            types.StringUnknown(),
+		StackID:       types.StringValue(stackID),
+		SourceStackID: types.StringValue(sourceStackID),
+		Outputs:       types.SetNull(types.StringType),
+		CreatedAt:     timeutil.NewTimestampUnknown(),
+		UpdatedAt:     timeutil.NewTimestampUnknown(),
+	}
+	if outputs != nil {
+		elems := make([]attr.Value, len(outputs))
+		for i, o := range outputs {
+			elems[i] = types.StringValue(o)
+		}
+		m.Outputs = types.SetValueMust(types.StringType, elems)
+	}
+	return m
+}
+
+func TestMapSubscriptionToState(t *testing.T) {
+	ctx := context.Background()
+	sub := &zenfraclient.OutputSubscription{
+		ID:            "sub-1",
+		StackID:       "app",
+		SourceStackID: "network",
+		Outputs:       []string{"vpc_id", "subnet_ids"},
+		CreatedAt:     time.Date(2026, 5, 1, 9, 0, 0, 0, time.UTC),
+		UpdatedAt:     time.Date(2026, 5, 2, 9, 0, 0, 0, time.UTC),
+	}
+
+	state, diags := mapSubscriptionToState(ctx, sub)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.ID.ValueString() != "sub-1" || state.StackID.ValueString() != "app" || state.SourceStackID.ValueString() != "network" {
+		t.Errorf("unexpected identifiers: %+v", state)
+	}
+	if len(state.Outputs.Elements()) != 2 {
+		t.Errorf("expected 2 outputs, got %v", state.Outputs)
+	}
+	if state.UpdatedAt.ValueString() != "2026-05-02T09:00:00Z" {
+		t.Errorf("expected updated_at 2026-05-02T09:00:00Z, got %s", state.UpdatedAt.ValueString())
+	}
+
+	state, diags = mapSubscriptionToState(ctx, &zenfraclient.OutputSubscription{ID: "sub-2"})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !state.Outputs.IsNull() {
+		t.Errorf("expected null outputs for a subscription to all outputs, got %v", state.Outputs)
+	}
+}
+
+func TestOutputSubscriptionResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name       string
+		model      *OutputSubscriptionModel
+		wantErrors int
+	}{
+		{name: "valid", model: subscriptionModel("app", "network", "vpc_id")},
+		{name: "all outputs", model: subscriptionModel("app", "network")},
+		{name: "own outputs", model: subscriptionModel("app", "app"), wantErrors: 1},
+		{name: "empty output name", model: subscriptionModel("app", "network", " "), wantErrors: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &OutputSubscriptionResource{}
+			state := zenfrafake.State(t, r, tt.model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+			if resp.Diagnostics.ErrorsCount() != tt.wantErrors {
+				t.Errorf("expected %d errors, got %v", tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestOutputSubscriptionResource_ModifyPlanCycle(t *testing.T) {
+	ctx := context.Background()
+	// network triggers app, and dns reads app's outputs without triggering anything.
+	graph := &zenfraclient.StackDependencyGraph{
+		Nodes: []zenfraclient.StackDependencyNode{{StackID: "network", Name: "Network"}, {StackID: "app", Name: "App"}, {StackID: "dns"}},
+		Edges: []zenfraclient.StackDependencyEdge{
+			{FromStackID: "network", ToStackID: "app", Kind: zenfraclient.StackDependencyRunTrigger},
+			{FromStackID: "app", ToStackID: "dns", Kind: zenfraclient.StackDependencyKVReference, Key: "zone"},
+		},
+	}
+	fake := &zenfrafake.Client{
+		GetStackDependencyGraphFunc: func(context.Context, *zenfraclient.StackDependencyGraphOptions) (*zenfraclient.StackDependencyGraph, error) {
+			return graph, nil
+		},
+	}
+	r := &OutputSubscriptionResource{client: fake}
+
+	for _, tt := range []struct {
+		name      string
+		model     *OutputSubscriptionModel
+		wantCycle bool
+	}{
+		{name: "closes run trigger cycle", model: subscriptionModel("network", "app"), wantCycle: true},
+		{name: "same direction as run trigger", model: subscriptionModel("app", "network")},
+		{name: "reverse of kv reference", model: subscriptionModel("app", "dns")},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := zenfrafake.State(t, r, tt.model)
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: zenfrafake.State(t, r, nil)}, resp)
+			if !tt.wantCycle {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Output Subscription Cycle" {
+				t.Fatalf("expected a single cycle error, got %v", resp.Diagnostics)
+			}
+			if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "Network (network)") || !strings.Contains(detail, "App (app)") {
+				t.Errorf("expected the cycle to name both stacks, got %q", detail)
+			}
+		})
+	}
+}
+
+func TestOutputSubscriptionResource_CreateAllOutputs(t *testing.T) {
+	ctx := context.Background()
+	var got zenfraclient.CreateOutputSubscriptionRequest
+	fake := &zenfrafake.Client{
+		CreateOutputSubscriptionFunc: func(_ context.Context, stackID string, req zenfraclient.CreateOutputSubscriptionRequest) (*zenfraclient.OutputSubscription, error) {
+			got = req
+			return &zenfraclient.OutputSubscription{ID: "sub-1", StackID: stackID, SourceStackID: req.SourceStackID}, nil
+		},
+	}
+	r := &OutputSubscriptionResource{client: fake}
+
+	plan := zenfrafake.State(t, r, subscriptionModel("app", "network"))
+	resp := &resource.CreateResponse{State: zenfrafake.State(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+	if got.SourceStackID != "network" || len(got.Outputs) != 0 {
+		t.Errorf("unexpected create request: %+v", got)
+	}
+
+	var state OutputSubscriptionModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "sub-1" || !state.Outputs.IsNull() {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
@@ -0,0 +1,54 @@
+// ABOUTME: Terraform state model for the zenfra_rate_limit_policy resource.
+// ABOUTME: Maps API rate limit policies to state; unset targets, descriptions, and bursts map to null.
+package rate_limit_policy
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// RateLimitPolicyModel represents the Terraform state model for a rate limit policy.
+type RateLimitPolicyModel struct {
+	ID                types.String            `tfsdk:"id"`
+	Description       types.String            `tfsdk:"description"`
+	TokenID           types.String            `tfsdk:"token_id"`
+	SourceCIDR        types.String            `tfsdk:"source_cidr"`
+	RequestsPerMinute types.Int64             `tfsdk:"requests_per_minute"`
+	Burst             types.Int64             `tfsdk:"burst"`
+	CreatedAt         timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt         timeutil.TimestampValue `tfsdk:"updated_at"`
+	OrganizationID    types.String            `tfsdk:"organization_id"`
+}
+
+// mapPolicyToState converts an API rate limit policy to state.
+func mapPolicyToState(policy *zenfraclient.RateLimitPolicy) RateLimitPolicyModel {
+	model := RateLimitPolicyModel{
+		ID:                types.StringValue(policy.ID),
+		Description:       types.StringNull(),
+		TokenID:           types.StringNull(),
+		SourceCIDR:        types.StringNull(),
+		RequestsPerMinute: types.Int64Value(policy.RequestsPerMinute),
+		Burst:             types.Int64Null(),
+		CreatedAt:         timeutil.Timestamp(policy.CreatedAt),
+		UpdatedAt:         timeutil.Timestamp(policy.UpdatedAt),
+	}
+	if policy.Description != "" {
+		model.Description = types.StringValue(policy.Description)
+	}
+	if policy.TokenID != "" {
+		model.TokenID = types.StringValue(policy.TokenID)
+	}
+	if policy.SourceCIDR != "" {
+		model.SourceCIDR = types.StringValue(policy.SourceCIDR)
+	}
+	if policy.Burst != 0 {
+		model.Burst = types.Int64Value(policy.Burst)
+	}
+	return model
+}
+
+// plannedChange reports whether planned is a known limit that differs from prior.
+func plannedChange(planned, prior types.Int64) bool {
+	return !planned.IsNull() && !planned.IsUnknown() && !planned.Equal(prior)
+}
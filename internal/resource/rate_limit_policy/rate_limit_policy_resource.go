@@ -0,0 +1,309 @@
+// ABOUTME: Implements the zenfra_rate_limit_policy Terraform resource with full CRUD lifecycle.
+// ABOUTME: Overrides the API rate limit for one token or source network; limits are checked against the organization's bounds at plan time.
+package rate_limit_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &RateLimitPolicyResource{}
+	_ resource.ResourceWithImportState    = &RateLimitPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &RateLimitPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &RateLimitPolicyResource{}
+)
+
+// NewRateLimitPolicyResource is a constructor for the rate limit policy resource.
+func NewRateLimitPolicyResource() resource.Resource {
+	return &RateLimitPolicyResource{}
+}
+
+// RateLimitPolicyResource is the resource implementation.
+type RateLimitPolicyResource struct {
+	client zenfraclient.RateLimitPolicyAPI
+}
+
+func (r *RateLimitPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limit_policy"
+}
+
+func (r *RateLimitPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Overrides the organization's API rate limit for the requests of one API token or of one source network, " +
+			"for example to give a CI token a higher limit. Limits are checked at plan time against the highest limit " +
+			"the organization may grant; read it with the zenfra_rate_limit_policies data source.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the rate limit policy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID the policy belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the policy in another organization the token has access to. Changing it forces a new policy.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Why the policy exists, shown in the API audit log.",
+				Optional:    true,
+			},
+			"token_id": schema.StringAttribute{
+				Description: "The API token whose requests the policy covers. Exactly one of token_id and source_cidr must be set. " +
+					"Changing it forces a new policy.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_cidr": schema.StringAttribute{
+				Description: "The network, in CIDR notation, whose requests the policy covers, whatever token they use. " +
+					"Exactly one of token_id and source_cidr must be set. Changing it forces a new policy.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{validators.CIDR()},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				Description: "The number of API requests per minute the policy allows.",
+				Required:    true,
+			},
+			"burst": schema.Int64Attribute{
+				Description: "The number of requests that may be made at once before the per-minute rate applies. " +
+					"Defaults to requests_per_minute.",
+				Optional: true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the policy was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the policy was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *RateLimitPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_rate_limit_policy")
+	}
+}
+
+// ValidateConfig checks that the policy has exactly one target and positive limits.
+func (r *RateLimitPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RateLimitPolicyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unknown target may still turn out to be null, so only known values are counted.
+	if !config.TokenID.IsUnknown() && !config.SourceCIDR.IsUnknown() {
+		switch {
+		case config.TokenID.IsNull() && config.SourceCIDR.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("token_id"), "Missing Rate Limit Target",
+				"Set token_id to cover the requests of an API token, or source_cidr to cover the requests from a network.")
+		case !config.TokenID.IsNull() && !config.SourceCIDR.IsNull():
+			resp.Diagnostics.AddAttributeError(path.Root("source_cidr"), "Conflicting Rate Limit Targets",
+				"token_id and source_cidr cannot both be set. Create one policy per token and one per network.")
+		}
+	}
+
+	for name, v := range map[string]types.Int64{"requests_per_minute": config.RequestsPerMinute, "burst": config.Burst} {
+		if !v.IsNull() && !v.IsUnknown() && v.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Rate Limit",
+				fmt.Sprintf("%s must be at least 1, got %d.", name, v.ValueInt64()))
+		}
+	}
+}
+
+// ModifyPlan checks planned limits against the highest limits the organization may
+// grant. Only limits the plan sets or changes are checked. When the bounds cannot be
+// read the plan is left alone and the API enforces them.
+func (r *RateLimitPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_rate_limit_policy", "rate_limit_policy", req, resp)
+
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RateLimitPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	prior := RateLimitPolicyModel{RequestsPerMinute: types.Int64Null(), Burst: types.Int64Null()}
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rpmChanged := plannedChange(plan.RequestsPerMinute, prior.RequestsPerMinute)
+	burstChanged := plannedChange(plan.Burst, prior.Burst)
+	if !rpmChanged && !burstChanged {
+		return
+	}
+
+	bounds, err := r.client.GetRateLimitBounds(zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString()))
+	if err != nil {
+		return
+	}
+
+	rpm := plan.RequestsPerMinute.ValueInt64()
+	if rpmChanged && bounds.MaxRequestsPerMinute > 0 && rpm > bounds.MaxRequestsPerMinute {
+		resp.Diagnostics.AddAttributeError(path.Root("requests_per_minute"), "Rate Limit Exceeds Organization Maximum",
+			fmt.Sprintf("requests_per_minute is %d, but policies of this organization may allow at most %d requests per minute.",
+				rpm, bounds.MaxRequestsPerMinute))
+	}
+	if burstChanged && bounds.MaxBurst > 0 && plan.Burst.ValueInt64() > bounds.MaxBurst {
+		resp.Diagnostics.AddAttributeError(path.Root("burst"), "Rate Limit Exceeds Organization Maximum",
+			fmt.Sprintf("burst is %d, but policies of this organization may allow bursts of at most %d requests.",
+				plan.Burst.ValueInt64(), bounds.MaxBurst))
+	}
+	if rpmChanged && rpm < bounds.DefaultRequestsPerMinute {
+		resp.Diagnostics.AddAttributeWarning(path.Root("requests_per_minute"), "Rate Limit Below Organization Default",
+			fmt.Sprintf("requests_per_minute is %d, lower than the organization's default of %d requests per minute, "+
+				"so the policy throttles the requests it covers more than if it did not exist.", rpm, bounds.DefaultRequestsPerMinute))
+	}
+}
+
+func (r *RateLimitPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RateLimitPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	policy, err := r.client.CreateRateLimitPolicy(ctx, zenfraclient.CreateRateLimitPolicyRequest{
+		Description:       plan.Description.ValueString(),
+		TokenID:           plan.TokenID.ValueString(),
+		SourceCIDR:        plan.SourceCIDR.ValueString(),
+		RequestsPerMinute: plan.RequestsPerMinute.ValueInt64(),
+		Burst:             plan.Burst.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Rate Limit Policy",
+			fmt.Sprintf("Could not create rate limit policy: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapPolicyToState(policy)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *RateLimitPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RateLimitPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	policy, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.RateLimitPolicy, error) {
+		return r.client.GetRateLimitPolicy(ctx, state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Rate Limit Policy",
+				fmt.Sprintf("Could not read rate limit policy ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Rate Limit Policy",
+			fmt.Sprintf("Could not read rate limit policy ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	newState := mapPolicyToState(policy)
+	newState.OrganizationID = state.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *RateLimitPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state RateLimitPolicyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	// Every changeable attribute is sent; an empty description and a zero burst clear them.
+	description := plan.Description.ValueString()
+	rpm := plan.RequestsPerMinute.ValueInt64()
+	burst := plan.Burst.ValueInt64()
+	policy, err := r.client.UpdateRateLimitPolicy(ctx, state.ID.ValueString(), zenfraclient.UpdateRateLimitPolicyRequest{
+		Description:       &description,
+		RequestsPerMinute: &rpm,
+		Burst:             &burst,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Rate Limit Policy",
+			fmt.Sprintf("Could not update rate limit policy ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapPolicyToState(policy)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *RateLimitPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RateLimitPolicyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteRateLimitPolicy(ctx, state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Rate Limit Policy",
+			fmt.Sprintf("Could not delete rate limit policy ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *RateLimitPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationID(ctx, r.client, "rate limit policy", importguard.RateLimitPolicy(r.client), req, resp)
+}
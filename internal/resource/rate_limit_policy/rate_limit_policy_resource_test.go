@@ -0,0 +1,136 @@
+// ABOUTME: Unit tests for the zenfra_rate_limit_policy resource against the zenfrafake client.
+// ABOUTME: Covers target and limit validation, the plan-time bounds check, and clearing the burst on update.
+package rate_limit_policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func policyModel(tokenID, cidr string, rpm int64, burst types.Int64) *RateLimitPolicyModel {
+	m := &RateLimitPolicyModel{
+		ID:                types.StringUnknown(),
+		Description:       types.StringNull(),
+		TokenID:           types.StringNull(),
+		SourceCIDR:        types.StringNull(),
+		RequestsPerMinute: types.Int64Value(rpm),
+		Burst:             burst,
+		CreatedAt:         timeutil.NewTimestampUnknown(),
+		UpdatedAt:         timeutil.NewTimestampUnknown(),
+	}
+	if tokenID != "" {
+		m.TokenID = types.StringValue(tokenID)
+	}
+	if cidr != "" {
+		m.SourceCIDR = types.StringValue(cidr)
+	}
+	return m
+}
+
+func TestRateLimitPolicyResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name       string
+		model      *RateLimitPolicyModel
+		wantErrors int
+	}{
+		{name: "token", model: policyModel("tok-ci", "", 3000, types.Int64Value(500))},
+		{name: "network", model: policyModel("", "10.0.0.0/8", 1200, types.Int64Null())},
+		{name: "no target", model: policyModel("", "", 1200, types.Int64Null()), wantErrors: 1},
+		{name: "both targets", model: policyModel("tok-ci", "10.0.0.0/8", 1200, types.Int64Null()), wantErrors: 1},
+		{name: "zero limits", model: policyModel("tok-ci", "", 0, types.Int64Value(0)), wantErrors: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RateLimitPolicyResource{}
+			state := zenfrafake.State(t, r, tt.model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+			if resp.Diagnostics.ErrorsCount() != tt.wantErrors {
+				t.Errorf("expected %d errors, got %v", tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRateLimitPolicyResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	var boundsReads int
+	fake := &zenfrafake.Client{
+		GetRateLimitBoundsFunc: func(context.Context) (*zenfraclient.RateLimitBounds, error) {
+			boundsReads++
+			return &zenfraclient.RateLimitBounds{DefaultRequestsPerMinute: 600, MaxRequestsPerMinute: 6000, MaxBurst: 1000}, nil
+		},
+	}
+	r := &RateLimitPolicyResource{client: fake}
+
+	for _, tt := range []struct {
+		name         string
+		prior        *RateLimitPolicyModel
+		plan         *RateLimitPolicyModel
+		wantErrors   int
+		wantWarnings int
+		wantReads    int
+	}{
+		{name: "within bounds", plan: policyModel("tok-ci", "", 3000, types.Int64Value(500)), wantReads: 1},
+		{name: "above maximums", plan: policyModel("tok-ci", "", 9000, types.Int64Value(2000)), wantErrors: 2, wantReads: 1},
+		{name: "below default", plan: policyModel("tok-ci", "", 100, types.Int64Null()), wantWarnings: 1, wantReads: 1},
+		{
+			name:  "unchanged limits are not checked",
+			prior: policyModel("tok-ci", "", 9000, types.Int64Null()),
+			plan:  policyModel("tok-ci", "", 9000, types.Int64Null()),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			boundsReads = 0
+			plan := zenfrafake.State(t, r, tt.plan)
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: zenfrafake.State(t, r, tt.prior)}, resp)
+			if resp.Diagnostics.ErrorsCount() != tt.wantErrors || resp.Diagnostics.WarningsCount() != tt.wantWarnings {
+				t.Errorf("expected %d errors and %d warnings, got %v", tt.wantErrors, tt.wantWarnings, resp.Diagnostics)
+			}
+			if boundsReads != tt.wantReads {
+				t.Errorf("expected %d bounds reads, got %d", tt.wantReads, boundsReads)
+			}
+		})
+	}
+}
+
+func TestRateLimitPolicyResource_UpdateClearsBurst(t *testing.T) {
+	ctx := context.Background()
+	var got zenfraclient.UpdateRateLimitPolicyRequest
+	fake := &zenfrafake.Client{
+		UpdateRateLimitPolicyFunc: func(_ context.Context, id string, req zenfraclient.UpdateRateLimitPolicyRequest) (*zenfraclient.RateLimitPolicy, error) {
+			got = req
+			return &zenfraclient.RateLimitPolicy{ID: id, TokenID: "tok-ci", RequestsPerMinute: *req.RequestsPerMinute}, nil
+		},
+	}
+	r := &RateLimitPolicyResource{client: fake}
+
+	prior := policyModel("tok-ci", "", 3000, types.Int64Value(500))
+	prior.ID = types.StringValue("rlp-1")
+	planned := policyModel("tok-ci", "", 3000, types.Int64Null())
+	planned.ID = types.StringValue("rlp-1")
+
+	resp := &resource.UpdateResponse{State: zenfrafake.State(t, r, prior)}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(zenfrafake.State(t, r, planned)), State: zenfrafake.State(t, r, prior)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+	if got.Burst == nil || *got.Burst != 0 {
+		t.Errorf("expected a zero burst to restore the default, got %v", got.Burst)
+	}
+
+	var state RateLimitPolicyModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if !state.Burst.IsNull() || state.RequestsPerMinute.ValueInt64() != 3000 {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
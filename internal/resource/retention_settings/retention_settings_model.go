@@ -0,0 +1,43 @@
+// ABOUTME: Terraform state model for the zenfra_retention_settings resource.
+// ABOUTME: Singleton keyed by organization ID, mapped directly from the API settings.
+package retention_settings
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// RetentionSettingsModel represents the Terraform state model for the organization's retention settings.
+type RetentionSettingsModel struct {
+	ID               types.String            `tfsdk:"id"`
+	OrganizationID   types.String            `tfsdk:"organization_id"`
+	RunRetentionDays types.Int64             `tfsdk:"run_retention_days"`
+	LogRetentionDays types.Int64             `tfsdk:"log_retention_days"`
+	UpdatedAt        timeutil.TimestampValue `tfsdk:"updated_at"`
+	UpdatedBy        types.String            `tfsdk:"updated_by"`
+}
+
+// mapSettingsToState converts the API settings to a RetentionSettingsModel.
+func mapSettingsToState(settings *zenfraclient.RetentionSettings) RetentionSettingsModel {
+	model := RetentionSettingsModel{
+		ID:               types.StringValue(settings.OrganizationID),
+		OrganizationID:   types.StringValue(settings.OrganizationID),
+		RunRetentionDays: types.Int64Value(settings.RunRetentionDays),
+		LogRetentionDays: types.Int64Value(settings.LogRetentionDays),
+		UpdatedAt:        timeutil.Timestamp(settings.UpdatedAt),
+		UpdatedBy:        types.StringNull(),
+	}
+	if settings.UpdatedBy != "" {
+		model.UpdatedBy = types.StringValue(settings.UpdatedBy)
+	}
+	return model
+}
+
+// buildUpdateRequest converts the planned settings to the API's replace request.
+func buildUpdateRequest(plan RetentionSettingsModel) zenfraclient.UpdateRetentionSettingsRequest {
+	return zenfraclient.UpdateRetentionSettingsRequest{
+		RunRetentionDays: plan.RunRetentionDays.ValueInt64(),
+		LogRetentionDays: plan.LogRetentionDays.ValueInt64(),
+	}
+}
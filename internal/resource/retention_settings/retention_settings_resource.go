@@ -0,0 +1,206 @@
+// ABOUTME: Implements the zenfra_retention_settings singleton resource for how long runs and logs are kept.
+// ABOUTME: Create and Update replace the settings; Delete restores the defaults of the organization's plan.
+package retention_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/retention"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &RetentionSettingsResource{}
+	_ resource.ResourceWithImportState    = &RetentionSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &RetentionSettingsResource{}
+	_ resource.ResourceWithModifyPlan     = &RetentionSettingsResource{}
+)
+
+// NewRetentionSettingsResource is a constructor for the retention settings resource.
+func NewRetentionSettingsResource() resource.Resource {
+	return &RetentionSettingsResource{}
+}
+
+// RetentionSettingsResource is the resource implementation.
+type RetentionSettingsResource struct {
+	client zenfraclient.RetentionSettingsAPI
+}
+
+func (r *RetentionSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_retention_settings"
+}
+
+func (r *RetentionSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages how long the organization keeps runs and run logs. Stacks can override either period with their own " +
+			"run_retention_days and log_retention_days. An organization has exactly one set of retention settings; declare this resource " +
+			"at most once. Destroying it restores the defaults of the organization's plan.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the organization the settings belong to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization whose retention settings to manage. Defaults to the organization of the provider's API token; " +
+					"set it to manage the retention settings of another organization the token has access to. Changing it forces a new resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"run_retention_days": schema.Int64Attribute{
+				Description: "Number of days runs are kept before they are deleted. Must not exceed the retention limit of the organization's plan.",
+				Required:    true,
+			},
+			"log_retention_days": schema.Int64Attribute{
+				Description: "Number of days run logs are kept before they are deleted. Must not exceed the retention limit of the organization's plan.",
+				Required:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp of the last change to the settings.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+			"updated_by": schema.StringAttribute{
+				Description: "The user or token that last changed the settings.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that both retention periods are at least a day.
+func (r *RetentionSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RetentionSettingsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	retention.ValidateConfig(config.RunRetentionDays, config.LogRetentionDays, &resp.Diagnostics)
+}
+
+// ModifyPlan warns when the API token may not manage organization settings, and fails
+// the plan when a new retention period exceeds the organization's plan.
+func (r *RetentionSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_retention_settings", "organization", req, resp)
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var orgID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization_id"), &orgID)...)
+	retention.CheckPlanLimits(zenfraclient.WithOrganization(ctx, orgID.ValueString()), r.client, req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RetentionSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_retention_settings")
+	}
+}
+
+func (r *RetentionSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RetentionSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	settings, err := r.client.UpdateRetentionSettings(ctx, buildUpdateRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Retention Settings", fmt.Sprintf("Could not update retention settings: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapSettingsToState(settings))...)
+}
+
+func (r *RetentionSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RetentionSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	settings, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.RetentionSettings, error) {
+		return r.client.GetRetentionSettings(ctx)
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Retention Settings", fmt.Sprintf("Could not read retention settings: %s\n\n%s", err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Retention Settings", fmt.Sprintf("Could not read retention settings: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapSettingsToState(settings))...)
+}
+
+func (r *RetentionSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RetentionSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	settings, err := r.client.UpdateRetentionSettings(ctx, buildUpdateRequest(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Retention Settings", fmt.Sprintf("Could not update retention settings: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapSettingsToState(settings))...)
+}
+
+func (r *RetentionSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RetentionSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.ResetRetentionSettings(ctx)
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Resetting Retention Settings", fmt.Sprintf("Could not restore the default retention settings: %s", err))
+	}
+}
+
+// ImportState accepts the ID of the organization the provider is authenticated to, or
+// of another organization its token has access to.
+func (r *RetentionSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Like run queue settings, retention settings are identified by their organization.
+	importguard.PassthroughOrganizationSingleton(ctx, r.client, req, resp)
+}
@@ -0,0 +1,136 @@
+// ABOUTME: Unit tests for the zenfra_retention_settings resource against the zenfrafake client.
+// ABOUTME: Covers config validation, the plan limit check, create, reset on delete, and import of the organization ID.
+package retention_settings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func settingsModel(runDays, logDays int64) *RetentionSettingsModel {
+	return &RetentionSettingsModel{
+		ID:               types.StringUnknown(),
+		RunRetentionDays: types.Int64Value(runDays),
+		LogRetentionDays: types.Int64Value(logDays),
+		UpdatedAt:        timeutil.NewTimestampUnknown(),
+		UpdatedBy:        types.StringUnknown(),
+	}
+}
+
+func TestRetentionSettingsResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name       string
+		model      *RetentionSettingsModel
+		wantErrors int
+	}{
+		{name: "valid", model: settingsModel(90, 30)},
+		{name: "zero run retention", model: settingsModel(0, 30), wantErrors: 1},
+		{name: "negative periods", model: settingsModel(-1, -1), wantErrors: 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RetentionSettingsResource{}
+			state := zenfrafake.State(t, r, tt.model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+			if resp.Diagnostics.ErrorsCount() != tt.wantErrors {
+				t.Errorf("expected %d errors, got %v", tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRetentionSettingsResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetCurrentOrganizationFunc: func(context.Context) (*zenfraclient.Organization, error) {
+			return &zenfraclient.Organization{ID: "org-1", Billing: &zenfraclient.OrganizationBilling{Plan: "team", MaxRunRetentionDays: 90, MaxLogRetentionDays: 30}}, nil
+		},
+	}
+	r := &RetentionSettingsResource{client: fake}
+
+	plan := zenfrafake.State(t, r, settingsModel(365, 30))
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: zenfrafake.State(t, r, nil)}, resp)
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Retention Exceeds Plan Limit" {
+		t.Errorf("expected a single plan limit error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestRetentionSettingsResource_CreateAndDelete(t *testing.T) {
+	ctx := context.Background()
+	var got zenfraclient.UpdateRetentionSettingsRequest
+	fake := &zenfrafake.Client{
+		UpdateRetentionSettingsFunc: func(_ context.Context, req zenfraclient.UpdateRetentionSettingsRequest) (*zenfraclient.RetentionSettings, error) {
+			got = req
+			return &zenfraclient.RetentionSettings{
+				OrganizationID:   "org-1",
+				RunRetentionDays: req.RunRetentionDays,
+				LogRetentionDays: req.LogRetentionDays,
+				UpdatedAt:        time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			}, nil
+		},
+		ResetRetentionSettingsFunc: func(context.Context) error { return zenfrafake.NotFound() },
+	}
+	r := &RetentionSettingsResource{client: fake}
+
+	plan := zenfrafake.State(t, r, settingsModel(90, 14))
+	resp := &resource.CreateResponse{State: zenfrafake.State(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+	if got.RunRetentionDays != 90 || got.LogRetentionDays != 14 {
+		t.Errorf("unexpected update request: %+v", got)
+	}
+
+	var state RetentionSettingsModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "org-1" || state.LogRetentionDays.ValueInt64() != 14 || !state.UpdatedBy.IsNull() {
+		t.Errorf("unexpected state: %+v", state)
+	}
+
+	// Settings that are already at their defaults are deleted successfully.
+	deleteResp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: resp.State}, deleteResp)
+	if deleteResp.Diagnostics.HasError() {
+		t.Errorf("Delete: %v", deleteResp.Diagnostics)
+	}
+}
+
+func TestRetentionSettingsResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetCurrentOrganizationFunc: func(context.Context) (*zenfraclient.Organization, error) {
+			return &zenfraclient.Organization{ID: "org-1"}, nil
+		},
+	}
+	r := &RetentionSettingsResource{client: fake}
+
+	resp := &resource.ImportStateResponse{State: zenfrafake.State(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "org-2"}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected importing another organization's settings to fail")
+	}
+
+	resp = &resource.ImportStateResponse{State: zenfrafake.State(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "org-1"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState: %v", resp.Diagnostics)
+	}
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if id.ValueString() != "org-1" {
+		t.Errorf("expected id org-1, got %s", id)
+	}
+}
@@ -0,0 +1,44 @@
+// ABOUTME: Terraform state model for the zenfra_run_comment resource.
+// ABOUTME: Maps an immutable API run comment, including its metadata, to Terraform state.
+package run_comment
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// RunCommentModel represents the Terraform state model for a comment on a run.
+type RunCommentModel struct {
+	ID             types.String            `tfsdk:"id"`
+	RunID          types.String            `tfsdk:"run_id"`
+	Body           types.String            `tfsdk:"body"`
+	Metadata       types.Map               `tfsdk:"metadata"`
+	StackID        types.String            `tfsdk:"stack_id"`
+	Author         types.String            `tfsdk:"author"`
+	CreatedAt      timeutil.TimestampValue `tfsdk:"created_at"`
+	OrganizationID types.String            `tfsdk:"organization_id"`
+}
+
+// mapRunCommentToState converts an API RunComment to a RunCommentModel. A comment
+// without metadata maps to a null metadata map.
+func mapRunCommentToState(ctx context.Context, comment *zenfraclient.RunComment) (RunCommentModel, diag.Diagnostics) {
+	model := RunCommentModel{
+		ID:        types.StringValue(comment.ID),
+		RunID:     types.StringValue(comment.RunID),
+		Body:      types.StringValue(comment.Body),
+		Metadata:  types.MapNull(types.StringType),
+		StackID:   types.StringValue(comment.StackID),
+		Author:    types.StringValue(comment.Author),
+		CreatedAt: timeutil.Timestamp(comment.CreatedAt),
+	}
+
+	var diags diag.Diagnostics
+	if len(comment.Metadata) > 0 {
+		model.Metadata, diags = types.MapValueFrom(ctx, types.StringType, comment.Metadata)
+	}
+	return model, diags
+}
@@ -0,0 +1,249 @@
+// ABOUTME: Implements the zenfra_run_comment Terraform resource, which posts a comment on a run.
+// ABOUTME: Comments are immutable: any change posts a new comment, and destroying one only removes it from state.
+package run_comment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                = &RunCommentResource{}
+	_ resource.ResourceWithImportState = &RunCommentResource{}
+	_ resource.ResourceWithModifyPlan  = &RunCommentResource{}
+)
+
+// NewRunCommentResource is a constructor for the run comment resource.
+func NewRunCommentResource() resource.Resource {
+	return &RunCommentResource{}
+}
+
+// RunCommentResource is the resource implementation.
+type RunCommentResource struct {
+	client zenfraclient.RunCommentAPI
+}
+
+func (r *RunCommentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_comment"
+}
+
+func (r *RunCommentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Posts a comment on a run, for example to record the ticket or release notes a pipeline applied. " +
+			"Comments cannot be edited, so changing any argument posts a new comment. " +
+			"Destroying the resource only removes it from Terraform state; the comment stays on the run.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the comment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"run_id": schema.StringAttribute{
+				Description: "The run to comment on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the run's stack. Defaults to the organization of the provider's API token; set it to the " +
+					"stack's organization_id when the stack belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"body": schema.StringAttribute{
+				Description: "The text of the comment. Markdown is rendered in the Zenfra UI.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				Description: "Optional key/value pairs attached to the comment, such as ticket IDs or release versions.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The stack the run belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"author": schema.StringAttribute{
+				Description: "The user or API token that posted the comment.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the comment was posted.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan warns when the API token may not manage runs.
+func (r *RunCommentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_run_comment", "run", req, resp)
+}
+
+func (r *RunCommentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_run_comment")
+	}
+}
+
+func (r *RunCommentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RunCommentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	createReq := zenfraclient.CreateRunCommentRequest{
+		Body: plan.Body.ValueString(),
+	}
+	if !plan.Metadata.IsNull() {
+		resp.Diagnostics.Append(plan.Metadata.ElementsAs(ctx, &createReq.Metadata, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	runID := plan.RunID.ValueString()
+	comment, err := r.client.CreateRunComment(ctx, runID, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Run Comment", fmt.Sprintf("Could not comment on run %s: %s", runID, err))
+		return
+	}
+
+	state, diags := mapRunCommentToState(ctx, comment)
+	state.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Keep an explicitly empty metadata map as configured.
+	if len(comment.Metadata) == 0 {
+		state.Metadata = plan.Metadata
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *RunCommentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RunCommentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	comment, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.RunComment, error) {
+		return r.client.GetRunComment(ctx, state.RunID.ValueString(), state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Run Comment",
+				fmt.Sprintf("Could not read comment ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Run Comment", fmt.Sprintf("Could not read comment ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	newState, diags := mapRunCommentToState(ctx, comment)
+	newState.OrganizationID = state.OrganizationID
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(comment.Metadata) == 0 && !state.Metadata.IsNull() && len(state.Metadata.Elements()) == 0 {
+		newState.Metadata = state.Metadata
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *RunCommentResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Unexpected Update", "Run comments cannot be edited once posted.")
+}
+
+func (r *RunCommentResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Comments are immutable; the comment stays on the run.
+}
+
+func (r *RunCommentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id := importguard.SplitOrganization(req.ID)
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.HasPrefix(req.ID, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format: [organization_id/]run_id:comment_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+
+	// Comments carry no organization; verify the stack of the run they belong to.
+	lookup := func(ctx context.Context, commentID string) (string, error) {
+		comment, err := r.client.GetRunComment(ctx, parts[0], commentID)
+		if err != nil {
+			return "", err
+		}
+		return importguard.Stack(r.client)(ctx, comment.StackID)
+	}
+	if !importguard.VerifyOrganization(ctx, r.client, "run comment", parts[1], lookup, &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, RunCommentModel{
+		ID:        types.StringValue(parts[1]),
+		RunID:     types.StringValue(parts[0]),
+		Body:      types.StringNull(),
+		Metadata:  types.MapNull(types.StringType),
+		StackID:   types.StringNull(),
+		Author:    types.StringNull(),
+		CreatedAt: timeutil.NewTimestampNull(),
+	})...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+}
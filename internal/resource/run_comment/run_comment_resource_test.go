@@ -0,0 +1,55 @@
+// ABOUTME: Unit tests for the zenfra_run_comment resource.
+// ABOUTME: Verifies mapping of API run comments, with and without metadata, to Terraform state.
+package run_comment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapRunCommentToState(t *testing.T) {
+	comment := &zenfraclient.RunComment{
+		ID:        "cmt-1",
+		RunID:     "run-1",
+		StackID:   "stack-1",
+		Body:      "Release 1.4.0",
+		Metadata:  map[string]string{"ticket": "OPS-12"},
+		Author:    "ci-bot",
+		CreatedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	state, diags := mapRunCommentToState(context.Background(), comment)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if state.ID.ValueString() != "cmt-1" || state.RunID.ValueString() != "run-1" || state.StackID.ValueString() != "stack-1" {
+		t.Errorf("unexpected identifiers: %+v", state)
+	}
+	if state.Body.ValueString() != "Release 1.4.0" || state.Author.ValueString() != "ci-bot" {
+		t.Errorf("unexpected body or author: %+v", state)
+	}
+	if state.CreatedAt.ValueString() != "2026-03-01T10:00:00Z" {
+		t.Errorf("expected created_at 2026-03-01T10:00:00Z, got %s", state.CreatedAt.ValueString())
+	}
+	ticket, ok := state.Metadata.Elements()["ticket"].(types.String)
+	if !ok || ticket.ValueString() != "OPS-12" {
+		t.Errorf("expected metadata ticket OPS-12, got %v", state.Metadata)
+	}
+}
+
+func TestMapRunCommentToState_NoMetadata(t *testing.T) {
+	comment := &zenfraclient.RunComment{ID: "cmt-2", RunID: "run-1", Body: "LGTM"}
+
+	state, diags := mapRunCommentToState(context.Background(), comment)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !state.Metadata.IsNull() {
+		t.Errorf("expected null metadata, got %v", state.Metadata)
+	}
+}
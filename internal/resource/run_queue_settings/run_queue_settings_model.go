@@ -0,0 +1,157 @@
+// ABOUTME: Terraform state model for the zenfra_run_queue_settings resource.
+// ABOUTME: Singleton keyed by organization ID; zero queue limits from the API map to null (unlimited).
+package run_queue_settings
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// RunQueueSettingsModel represents the Terraform state model for the organization's run queue settings.
+type RunQueueSettingsModel struct {
+	ID                    types.String            `tfsdk:"id"`
+	OrganizationID        types.String            `tfsdk:"organization_id"`
+	MaxParallelRuns       types.Int64             `tfsdk:"max_parallel_runs"`
+	MaxQueuedRuns         types.Int64             `tfsdk:"max_queued_runs"`
+	MaxQueuedRunsPerStack types.Int64             `tfsdk:"max_queued_runs_per_stack"`
+	PriorityClasses       types.List              `tfsdk:"priority_classes"`
+	UpdatedAt             timeutil.TimestampValue `tfsdk:"updated_at"`
+	UpdatedBy             types.String            `tfsdk:"updated_by"`
+}
+
+// PriorityClassModel represents one entry of priority_classes.
+type PriorityClassModel struct {
+	Name            types.String `tfsdk:"name"`
+	Priority        types.Int64  `tfsdk:"priority"`
+	MaxParallelRuns types.Int64  `tfsdk:"max_parallel_runs"`
+	SpaceIDs        types.Set    `tfsdk:"space_ids"`
+}
+
+// PriorityClassModelAttrTypes defines the attribute types for PriorityClassModel.
+var PriorityClassModelAttrTypes = map[string]attr.Type{
+	"name":              types.StringType,
+	"priority":          types.Int64Type,
+	"max_parallel_runs": types.Int64Type,
+	"space_ids":         types.SetType{ElemType: types.StringType},
+}
+
+var priorityClassType = types.ObjectType{AttrTypes: PriorityClassModelAttrTypes}
+
+// mapSettingsToState converts the API settings to a RunQueueSettingsModel. An empty list
+// of priority classes maps to null unless prior is an explicitly empty list, and so does
+// a class without spaces unless its space_ids in prior is an explicitly empty set.
+func mapSettingsToState(settings *zenfraclient.RunQueueSettings, prior types.List) RunQueueSettingsModel {
+	model := RunQueueSettingsModel{
+		ID:                    types.StringValue(settings.OrganizationID),
+		OrganizationID:        types.StringValue(settings.OrganizationID),
+		MaxParallelRuns:       types.Int64Value(settings.MaxParallelRuns),
+		MaxQueuedRuns:         unlimitedAsNull(settings.MaxQueuedRuns),
+		MaxQueuedRunsPerStack: unlimitedAsNull(settings.MaxQueuedRunsPerStack),
+		UpdatedAt:             timeutil.Timestamp(settings.UpdatedAt),
+		UpdatedBy:             types.StringNull(),
+	}
+	if settings.UpdatedBy != "" {
+		model.UpdatedBy = types.StringValue(settings.UpdatedBy)
+	}
+
+	if len(settings.PriorityClasses) == 0 {
+		model.PriorityClasses = types.ListNull(priorityClassType)
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			model.PriorityClasses = prior
+		}
+		return model
+	}
+
+	priorSpaceIDs := spaceIDsByClass(prior)
+	elems := make([]attr.Value, 0, len(settings.PriorityClasses))
+	for _, class := range settings.PriorityClasses {
+		spaceIDs := types.SetNull(types.StringType)
+		if prior, ok := priorSpaceIDs[class.Name]; len(class.SpaceIDs) > 0 || (ok && !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0) {
+			ids := make([]attr.Value, 0, len(class.SpaceIDs))
+			for _, id := range class.SpaceIDs {
+				ids = append(ids, types.StringValue(id))
+			}
+			spaceIDs = types.SetValueMust(types.StringType, ids)
+		}
+		elems = append(elems, types.ObjectValueMust(PriorityClassModelAttrTypes, map[string]attr.Value{
+			"name":              types.StringValue(class.Name),
+			"priority":          types.Int64Value(class.Priority),
+			"max_parallel_runs": unlimitedAsNull(class.MaxParallelRuns),
+			"space_ids":         spaceIDs,
+		}))
+	}
+	model.PriorityClasses = types.ListValueMust(priorityClassType, elems)
+	return model
+}
+
+// spaceIDsByClass returns the space_ids of each priority class in classes, by name.
+func spaceIDsByClass(classes types.List) map[string]types.Set {
+	byName := make(map[string]types.Set)
+	if classes.IsNull() || classes.IsUnknown() {
+		return byName
+	}
+	for _, elem := range classes.Elements() {
+		obj, ok := elem.(types.Object)
+		if !ok || obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		name, nameOK := obj.Attributes()["name"].(types.String)
+		spaceIDs, idsOK := obj.Attributes()["space_ids"].(types.Set)
+		if nameOK && idsOK {
+			byName[name.ValueString()] = spaceIDs
+		}
+	}
+	return byName
+}
+
+// buildUpdateRequest converts the planned settings to the API's replace request.
+func buildUpdateRequest(ctx context.Context, plan RunQueueSettingsModel) (zenfraclient.UpdateRunQueueSettingsRequest, diag.Diagnostics) {
+	req := zenfraclient.UpdateRunQueueSettingsRequest{
+		MaxParallelRuns:       plan.MaxParallelRuns.ValueInt64(),
+		MaxQueuedRuns:         plan.MaxQueuedRuns.ValueInt64(),
+		MaxQueuedRunsPerStack: plan.MaxQueuedRunsPerStack.ValueInt64(),
+		PriorityClasses:       []zenfraclient.RunPriorityClass{},
+	}
+
+	classes, diags := priorityClassesFromList(ctx, plan.PriorityClasses)
+	if diags.HasError() {
+		return req, diags
+	}
+	for _, class := range classes {
+		var spaceIDs []string
+		diags.Append(class.SpaceIDs.ElementsAs(ctx, &spaceIDs, false)...)
+		if spaceIDs == nil {
+			spaceIDs = []string{}
+		}
+		req.PriorityClasses = append(req.PriorityClasses, zenfraclient.RunPriorityClass{
+			Name:            class.Name.ValueString(),
+			Priority:        class.Priority.ValueInt64(),
+			SpaceIDs:        spaceIDs,
+			MaxParallelRuns: class.MaxParallelRuns.ValueInt64(),
+		})
+	}
+	return req, diags
+}
+
+// priorityClassesFromList decodes priority_classes; null and unknown lists decode to none.
+func priorityClassesFromList(ctx context.Context, list types.List) ([]PriorityClassModel, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	var classes []PriorityClassModel
+	diags := list.ElementsAs(ctx, &classes, false)
+	return classes, diags
+}
+
+// unlimitedAsNull maps the API's 0 (no limit) to null.
+func unlimitedAsNull(v int64) types.Int64 {
+	if v == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(v)
+}
@@ -0,0 +1,302 @@
+// ABOUTME: Implements the zenfra_run_queue_settings singleton resource for organization run concurrency.
+// ABOUTME: Create and Update replace the settings; Delete restores the platform defaults.
+package run_queue_settings
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &RunQueueSettingsResource{}
+	_ resource.ResourceWithImportState    = &RunQueueSettingsResource{}
+	_ resource.ResourceWithValidateConfig = &RunQueueSettingsResource{}
+	_ resource.ResourceWithModifyPlan     = &RunQueueSettingsResource{}
+)
+
+// NewRunQueueSettingsResource is a constructor for the run queue settings resource.
+func NewRunQueueSettingsResource() resource.Resource {
+	return &RunQueueSettingsResource{}
+}
+
+// RunQueueSettingsResource is the resource implementation.
+type RunQueueSettingsResource struct {
+	client zenfraclient.RunQueueSettingsAPI
+}
+
+func (r *RunQueueSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_run_queue_settings"
+}
+
+func (r *RunQueueSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the organization's run concurrency and queue limits. An organization has exactly one set of run queue settings; " +
+			"declare this resource at most once. Destroying it restores the platform defaults.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the organization the settings belong to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization whose run queue settings to manage. Defaults to the organization of the provider's API token; " +
+					"set it to manage the run queue settings of another organization the token has access to. Changing it forces a new resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_parallel_runs": schema.Int64Attribute{
+				Description: "Maximum number of runs executing at the same time across the organization.",
+				Required:    true,
+			},
+			"max_queued_runs": schema.Int64Attribute{
+				Description: "Maximum number of runs waiting in the organization's queue. New runs are rejected once it is full. Unlimited when not set.",
+				Optional:    true,
+			},
+			"max_queued_runs_per_stack": schema.Int64Attribute{
+				Description: "Maximum number of runs waiting in the queue for any single stack. Unlimited when not set.",
+				Optional:    true,
+			},
+			"priority_classes": schema.ListNestedAttribute{
+				Description: "Priority classes for queued runs. Runs of stacks in a class's spaces are dequeued before runs with a lower priority; " +
+					"stacks outside every class run at priority 0.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Unique name of the priority class.",
+							Required:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Description: "Dequeue priority. Higher values are dequeued first.",
+							Required:    true,
+						},
+						"max_parallel_runs": schema.Int64Attribute{
+							Description: "Maximum number of the class's runs executing at the same time. Must not exceed the organization's max_parallel_runs. Uncapped when not set.",
+							Optional:    true,
+						},
+						"space_ids": schema.SetAttribute{
+							Description: "Spaces whose stacks belong to the class. A space may belong to at most one class.",
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp of the last change to the settings.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+			"updated_by": schema.StringAttribute{
+				Description: "The user or token that last changed the settings.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the limits and priority classes before they reach the API.
+func (r *RunQueueSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config RunQueueSettingsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, v := range map[string]types.Int64{
+		"max_parallel_runs":         config.MaxParallelRuns,
+		"max_queued_runs":           config.MaxQueuedRuns,
+		"max_queued_runs_per_stack": config.MaxQueuedRunsPerStack,
+	} {
+		if !v.IsNull() && !v.IsUnknown() && v.ValueInt64() < 1 {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Invalid Run Queue Limit",
+				fmt.Sprintf("%s must be at least 1, got %d. Leave optional limits unset for no limit.", name, v.ValueInt64()))
+		}
+	}
+
+	classes, diags := priorityClassesFromList(ctx, config.PriorityClasses)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := make(map[string]int, len(classes))
+	spaces := make(map[string]string)
+	for i, class := range classes {
+		classPath := path.Root("priority_classes").AtListIndex(i)
+
+		if !class.Name.IsUnknown() {
+			name := class.Name.ValueString()
+			if first, ok := names[name]; ok {
+				resp.Diagnostics.AddAttributeError(classPath.AtName("name"), "Duplicate Priority Class",
+					fmt.Sprintf("Priority classes %d and %d are both named %q. Class names must be unique.", first, i, name))
+			} else {
+				names[name] = i
+			}
+		}
+
+		if limit := class.MaxParallelRuns; !limit.IsNull() && !limit.IsUnknown() {
+			switch orgLimit := config.MaxParallelRuns; {
+			case limit.ValueInt64() < 1:
+				resp.Diagnostics.AddAttributeError(classPath.AtName("max_parallel_runs"), "Invalid Run Queue Limit",
+					fmt.Sprintf("max_parallel_runs must be at least 1, got %d. Leave it unset to leave the class uncapped.", limit.ValueInt64()))
+			case !orgLimit.IsNull() && !orgLimit.IsUnknown() && limit.ValueInt64() > orgLimit.ValueInt64():
+				resp.Diagnostics.AddAttributeError(classPath.AtName("max_parallel_runs"), "Invalid Run Queue Limit",
+					fmt.Sprintf("The class's max_parallel_runs (%d) exceeds the organization's max_parallel_runs (%d).",
+						limit.ValueInt64(), orgLimit.ValueInt64()))
+			}
+		}
+
+		if class.SpaceIDs.IsNull() || class.SpaceIDs.IsUnknown() || class.Name.IsUnknown() {
+			continue
+		}
+		for _, elem := range class.SpaceIDs.Elements() {
+			id, ok := elem.(types.String)
+			if !ok || id.IsUnknown() || id.IsNull() {
+				continue
+			}
+			if other, ok := spaces[id.ValueString()]; ok && other != class.Name.ValueString() {
+				resp.Diagnostics.AddAttributeError(classPath.AtName("space_ids"), "Space in Multiple Priority Classes",
+					fmt.Sprintf("Space %s is in both priority class %q and %q. A space may belong to at most one class.",
+						id.ValueString(), other, class.Name.ValueString()))
+				continue
+			}
+			spaces[id.ValueString()] = class.Name.ValueString()
+		}
+	}
+}
+
+// ModifyPlan warns when the API token may not manage organization settings.
+func (r *RunQueueSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_run_queue_settings", "organization", req, resp)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RunQueueSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_run_queue_settings")
+	}
+}
+
+func (r *RunQueueSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RunQueueSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, "Error Creating Run Queue Settings")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *RunQueueSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RunQueueSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	settings, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.RunQueueSettings, error) {
+		return r.client.GetRunQueueSettings(ctx)
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Run Queue Settings", fmt.Sprintf("Could not read run queue settings: %s\n\n%s", err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Run Queue Settings", fmt.Sprintf("Could not read run queue settings: %s", err))
+		return
+	}
+
+	newState := mapSettingsToState(settings, state.PriorityClasses)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *RunQueueSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RunQueueSettingsModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, "Error Updating Run Queue Settings")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// apply replaces the organization's settings with plan and returns the resulting state.
+// Create and Update differ only in the summary of the error they report.
+func (r *RunQueueSettingsResource) apply(ctx context.Context, plan RunQueueSettingsModel, summary string) (RunQueueSettingsModel, diag.Diagnostics) {
+	updateReq, diags := buildUpdateRequest(ctx, plan)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+	settings, err := r.client.UpdateRunQueueSettings(ctx, updateReq)
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("Could not update run queue settings: %s", err))
+		return plan, diags
+	}
+	return mapSettingsToState(settings, plan.PriorityClasses), diags
+}
+
+func (r *RunQueueSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RunQueueSettingsModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.ResetRunQueueSettings(ctx)
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Resetting Run Queue Settings", fmt.Sprintf("Could not restore the default run queue settings: %s", err))
+	}
+}
+
+// ImportState accepts the ID of the organization the provider is authenticated to, or
+// of another organization its token has access to.
+func (r *RunQueueSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The settings have no ID of their own, so the import ID is its own owning organization.
+	importguard.PassthroughOrganizationSingleton(ctx, r.client, req, resp)
+}
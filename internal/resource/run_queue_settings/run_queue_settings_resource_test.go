@@ -0,0 +1,246 @@
+// ABOUTME: Unit tests for the zenfra_run_queue_settings resource against the zenfrafake client.
+// ABOUTME: Covers config validation, the update request, state mapping, and import of the organization ID.
+package run_queue_settings
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func priorityClass(name string, priority int64, maxParallel types.Int64, spaceIDs ...string) attr.Value {
+	ids := make([]attr.Value, 0, len(spaceIDs))
+	for _, id := range spaceIDs {
+		ids = append(ids, types.StringValue(id))
+	}
+	return types.ObjectValueMust(PriorityClassModelAttrTypes, map[string]attr.Value{
+		"name":              types.StringValue(name),
+		"priority":          types.Int64Value(priority),
+		"max_parallel_runs": maxParallel,
+		"space_ids":         types.SetValueMust(types.StringType, ids),
+	})
+}
+
+func settingsModel(maxParallel int64, classes ...attr.Value) *RunQueueSettingsModel {
+	list := types.ListNull(priorityClassType)
+	if classes != nil {
+		list = types.ListValueMust(priorityClassType, classes)
+	}
+	return &RunQueueSettingsModel{
+		ID:                    types.StringUnknown(),
+		MaxParallelRuns:       types.Int64Value(maxParallel),
+		MaxQueuedRuns:         types.Int64Null(),
+		MaxQueuedRunsPerStack: types.Int64Null(),
+		PriorityClasses:       list,
+		UpdatedAt:             timeutil.NewTimestampUnknown(),
+		UpdatedBy:             types.StringUnknown(),
+	}
+}
+
+func TestRunQueueSettingsResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	zeroQueue := settingsModel(5)
+	zeroQueue.MaxQueuedRuns = types.Int64Value(0)
+
+	tests := []struct {
+		name    string
+		model   *RunQueueSettingsModel
+		wantErr string
+	}{
+		{name: "no classes", model: settingsModel(5)},
+		{name: "valid classes", model: settingsModel(5,
+			priorityClass("prod", 10, types.Int64Value(5), "space-prod"),
+			priorityClass("dev", 1, types.Int64Null(), "space-dev", "space-sandbox"),
+		)},
+		{name: "zero parallel runs", model: settingsModel(0), wantErr: "Invalid Run Queue Limit"},
+		{name: "zero queue depth", model: zeroQueue, wantErr: "Invalid Run Queue Limit"},
+		{name: "duplicate class name", model: settingsModel(5,
+			priorityClass("prod", 10, types.Int64Null()),
+			priorityClass("prod", 5, types.Int64Null()),
+		), wantErr: "Duplicate Priority Class"},
+		{name: "class limit above organization limit", model: settingsModel(5,
+			priorityClass("prod", 10, types.Int64Value(6)),
+		), wantErr: "Invalid Run Queue Limit"},
+		{name: "space in two classes", model: settingsModel(5,
+			priorityClass("prod", 10, types.Int64Null(), "space-1"),
+			priorityClass("dev", 1, types.Int64Null(), "space-2", "space-1"),
+		), wantErr: "Space in Multiple Priority Classes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RunQueueSettingsResource{}
+			state := zenfrafake.State(t, r, tt.model)
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+
+			if tt.wantErr == "" {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != tt.wantErr {
+				t.Fatalf("expected a single %q error, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRunQueueSettingsResource_Create(t *testing.T) {
+	ctx := context.Background()
+	var got zenfraclient.UpdateRunQueueSettingsRequest
+	fake := &zenfrafake.Client{
+		UpdateRunQueueSettingsFunc: func(_ context.Context, req zenfraclient.UpdateRunQueueSettingsRequest) (*zenfraclient.RunQueueSettings, error) {
+			got = req
+			return &zenfraclient.RunQueueSettings{
+				OrganizationID:  "org-1",
+				MaxParallelRuns: req.MaxParallelRuns,
+				PriorityClasses: req.PriorityClasses,
+				UpdatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			}, nil
+		},
+	}
+	r := &RunQueueSettingsResource{client: fake}
+
+	plan := zenfrafake.State(t, r, settingsModel(8, priorityClass("prod", 10, types.Int64Value(4), "space-1")))
+	resp := &resource.CreateResponse{State: zenfrafake.State(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+
+	if got.MaxParallelRuns != 8 || got.MaxQueuedRuns != 0 || len(got.PriorityClasses) != 1 {
+		t.Fatalf("unexpected update request: %+v", got)
+	}
+	if class := got.PriorityClasses[0]; class.Name != "prod" || class.MaxParallelRuns != 4 || len(class.SpaceIDs) != 1 || class.SpaceIDs[0] != "space-1" {
+		t.Errorf("unexpected priority class: %+v", class)
+	}
+
+	var state RunQueueSettingsModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "org-1" {
+		t.Errorf("expected id org-1, got %s", state.ID)
+	}
+	if !state.MaxQueuedRuns.IsNull() || !state.UpdatedBy.IsNull() {
+		t.Errorf("expected unlimited queue depth and unknown updater to be null, got %s and %s", state.MaxQueuedRuns, state.UpdatedBy)
+	}
+	if len(state.PriorityClasses.Elements()) != 1 {
+		t.Errorf("expected one priority class in state, got %s", state.PriorityClasses)
+	}
+}
+
+// classWithoutSpaces returns a priority class with space_ids left out of the configuration.
+func classWithoutSpaces(name string, priority int64) attr.Value {
+	return types.ObjectValueMust(PriorityClassModelAttrTypes, map[string]attr.Value{
+		"name":              types.StringValue(name),
+		"priority":          types.Int64Value(priority),
+		"max_parallel_runs": types.Int64Null(),
+		"space_ids":         types.SetNull(types.StringType),
+	})
+}
+
+func TestRunQueueSettingsResource_ClassWithoutSpaceIDs(t *testing.T) {
+	ctx := context.Background()
+	// The API reports a class without spaces with no space_ids at all.
+	settings := func() *zenfraclient.RunQueueSettings {
+		return &zenfraclient.RunQueueSettings{
+			OrganizationID:  "org-1",
+			MaxParallelRuns: 8,
+			PriorityClasses: []zenfraclient.RunPriorityClass{{Name: "batch", Priority: 1}, {Name: "empty", Priority: 2}},
+		}
+	}
+	fake := &zenfrafake.Client{
+		UpdateRunQueueSettingsFunc: func(context.Context, zenfraclient.UpdateRunQueueSettingsRequest) (*zenfraclient.RunQueueSettings, error) {
+			return settings(), nil
+		},
+		GetRunQueueSettingsFunc: func(context.Context) (*zenfraclient.RunQueueSettings, error) {
+			return settings(), nil
+		},
+	}
+	r := &RunQueueSettingsResource{client: fake}
+
+	// batch leaves space_ids out; empty sets it to an explicitly empty set.
+	plan := zenfrafake.State(t, r, settingsModel(8, classWithoutSpaces("batch", 1), priorityClass("empty", 2, types.Int64Null())))
+	createResp := &resource.CreateResponse{State: zenfrafake.State(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: plan.Schema, Raw: plan.Raw}}, createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", createResp.Diagnostics)
+	}
+	assertSpaceIDs(t, "after create", createResp.State)
+
+	readResp := &resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	assertSpaceIDs(t, "after read", readResp.State)
+}
+
+// assertSpaceIDs checks that the batch class has null space_ids and the empty class an empty set.
+func assertSpaceIDs(t *testing.T, when string, state tfsdk.State) {
+	t.Helper()
+	var model RunQueueSettingsModel
+	if diags := state.Get(context.Background(), &model); diags.HasError() {
+		t.Fatalf("%s: reading state: %v", when, diags)
+	}
+	classes, diags := priorityClassesFromList(context.Background(), model.PriorityClasses)
+	if diags.HasError() || len(classes) != 2 {
+		t.Fatalf("%s: expected two priority classes, got %s", when, model.PriorityClasses)
+	}
+	if !classes[0].SpaceIDs.IsNull() {
+		t.Errorf("%s: expected null space_ids for a class without them, got %s", when, classes[0].SpaceIDs)
+	}
+	if classes[1].SpaceIDs.IsNull() || len(classes[1].SpaceIDs.Elements()) != 0 {
+		t.Errorf("%s: expected an explicitly empty space_ids to be kept, got %s", when, classes[1].SpaceIDs)
+	}
+}
+
+func TestMapSettingsToState_EmptyPriorityClasses(t *testing.T) {
+	settings := &zenfraclient.RunQueueSettings{OrganizationID: "org-1", MaxParallelRuns: 3}
+
+	if got := mapSettingsToState(settings, types.ListNull(priorityClassType)); !got.PriorityClasses.IsNull() {
+		t.Errorf("expected null priority_classes when unset, got %s", got.PriorityClasses)
+	}
+	empty := types.ListValueMust(priorityClassType, []attr.Value{})
+	if got := mapSettingsToState(settings, empty); got.PriorityClasses.IsNull() || len(got.PriorityClasses.Elements()) != 0 {
+		t.Errorf("expected an explicitly empty list to be kept, got %s", got.PriorityClasses)
+	}
+}
+
+func TestRunQueueSettingsResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetCurrentOrganizationFunc: func(context.Context) (*zenfraclient.Organization, error) {
+			return &zenfraclient.Organization{ID: "org-1"}, nil
+		},
+	}
+	r := &RunQueueSettingsResource{client: fake}
+
+	resp := &resource.ImportStateResponse{State: zenfrafake.State(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "org-2"}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected importing another organization's settings to fail")
+	}
+
+	resp = &resource.ImportStateResponse{State: zenfrafake.State(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "org-1"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState: %v", resp.Diagnostics)
+	}
+	var id, orgID types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("organization_id"), &orgID)...)
+	if id.ValueString() != "org-1" || orgID.ValueString() != "org-1" {
+		t.Errorf("expected id and organization_id org-1, got %s and %s", id, orgID)
+	}
+}
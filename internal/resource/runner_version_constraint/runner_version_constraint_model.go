@@ -0,0 +1,39 @@
+// ABOUTME: Terraform state model for the zenfra_runner_version_constraint resource.
+// ABOUTME: Singleton keyed by organization ID; resolved_version is the catalog version the constraint selects.
+package runner_version_constraint
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// RunnerVersionConstraintModel represents the Terraform state model for the organization's
+// default runner version constraint.
+type RunnerVersionConstraintModel struct {
+	ID              types.String            `tfsdk:"id"`
+	OrganizationID  types.String            `tfsdk:"organization_id"`
+	Constraint      types.String            `tfsdk:"constraint"`
+	ResolvedVersion types.String            `tfsdk:"resolved_version"`
+	UpdatedAt       timeutil.TimestampValue `tfsdk:"updated_at"`
+	UpdatedBy       types.String            `tfsdk:"updated_by"`
+}
+
+// mapConstraintToState converts the API constraint to a RunnerVersionConstraintModel.
+func mapConstraintToState(constraint *zenfraclient.RunnerVersionConstraint) RunnerVersionConstraintModel {
+	model := RunnerVersionConstraintModel{
+		ID:              types.StringValue(constraint.OrganizationID),
+		OrganizationID:  types.StringValue(constraint.OrganizationID),
+		Constraint:      types.StringValue(constraint.Constraint),
+		ResolvedVersion: types.StringNull(),
+		UpdatedAt:       timeutil.Timestamp(constraint.UpdatedAt),
+		UpdatedBy:       types.StringNull(),
+	}
+	if constraint.ResolvedVersion != "" {
+		model.ResolvedVersion = types.StringValue(constraint.ResolvedVersion)
+	}
+	if constraint.UpdatedBy != "" {
+		model.UpdatedBy = types.StringValue(constraint.UpdatedBy)
+	}
+	return model
+}
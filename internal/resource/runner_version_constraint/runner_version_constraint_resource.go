@@ -0,0 +1,232 @@
+// ABOUTME: Implements the zenfra_runner_version_constraint singleton resource for the organization's default runner pin.
+// ABOUTME: The constraint is checked against the runner version catalog at plan time; Delete removes the default.
+package runner_version_constraint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/runnerversion"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &RunnerVersionConstraintResource{}
+	_ resource.ResourceWithImportState    = &RunnerVersionConstraintResource{}
+	_ resource.ResourceWithValidateConfig = &RunnerVersionConstraintResource{}
+	_ resource.ResourceWithModifyPlan     = &RunnerVersionConstraintResource{}
+)
+
+// NewRunnerVersionConstraintResource is a constructor for the runner version constraint resource.
+func NewRunnerVersionConstraintResource() resource.Resource {
+	return &RunnerVersionConstraintResource{}
+}
+
+// RunnerVersionConstraintResource is the resource implementation.
+type RunnerVersionConstraintResource struct {
+	client zenfraclient.RunnerVersionConstraintAPI
+}
+
+func (r *RunnerVersionConstraintResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_runner_version_constraint"
+}
+
+func (r *RunnerVersionConstraintResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the organization's default runner version constraint, which applies to every worker pool without a " +
+			"runner_version_constraint of its own. An organization has exactly one default; declare this resource at most once. " +
+			"Destroying it removes the default, so unpinned pools run the latest runner.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the organization the constraint belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The ID of the organization whose default runner version constraint to manage. Defaults to the organization of the provider's API token; " +
+					"set it to manage the default runner version constraint of another organization the token has access to. Changing it forces a new resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"constraint": schema.StringAttribute{
+				Description: "Version constraint such as '~> 1.4' or '>= 1.4.2, < 1.6'. Clauses are separated by commas and use =, !=, >, >=, <, <=, or ~>. " +
+					"It must match a runner version in the catalog.",
+				Required: true,
+			},
+			"resolved_version": schema.StringAttribute{
+				Description: "The newest catalog runner version the constraint selects.",
+				Computed:    true,
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp of the last change to the constraint.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+			"updated_by": schema.StringAttribute{
+				Description: "The user or token that last changed the constraint.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the constraint syntax; ModifyPlan checks it against the catalog.
+func (r *RunnerVersionConstraintResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var constraint types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("constraint"), &constraint)...)
+	if resp.Diagnostics.HasError() || constraint.IsNull() || constraint.IsUnknown() {
+		return
+	}
+	if _, err := runnerversion.Parse(constraint.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("constraint"), "Invalid Runner Version Constraint", err.Error())
+	}
+}
+
+// ModifyPlan checks a new or changed constraint against the runner version catalog.
+func (r *RunnerVersionConstraintResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_runner_version_constraint", "organization", req, resp)
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("constraint"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("constraint"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() || planned.IsUnknown() || planned.Equal(prior) {
+		return
+	}
+	resp.Diagnostics.Append(runnerversion.Check(ctx, r.client, path.Root("constraint"), planned.ValueString())...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *RunnerVersionConstraintResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_runner_version_constraint")
+	}
+}
+
+func (r *RunnerVersionConstraintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan RunnerVersionConstraintModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, "Error Creating Runner Version Constraint")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *RunnerVersionConstraintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state RunnerVersionConstraintModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	constraint, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.RunnerVersionConstraint, error) {
+		return r.client.GetRunnerVersionConstraint(ctx)
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Runner Version Constraint", fmt.Sprintf("Could not read runner version constraint: %s\n\n%s", err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Runner Version Constraint", fmt.Sprintf("Could not read runner version constraint: %s", err))
+		return
+	}
+
+	// The default was removed outside Terraform.
+	if constraint.Constraint == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, mapConstraintToState(constraint))...)
+}
+
+func (r *RunnerVersionConstraintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RunnerVersionConstraintModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state, diags := r.apply(ctx, plan, "Error Updating Runner Version Constraint")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// apply replaces the organization's default constraint with the planned one.
+func (r *RunnerVersionConstraintResource) apply(ctx context.Context, plan RunnerVersionConstraintModel, summary string) (RunnerVersionConstraintModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+	constraint, err := r.client.UpdateRunnerVersionConstraint(ctx, zenfraclient.UpdateRunnerVersionConstraintRequest{
+		Constraint: plan.Constraint.ValueString(),
+	})
+	if err != nil {
+		diags.AddError(summary, fmt.Sprintf("Could not update runner version constraint: %s", err))
+		return plan, diags
+	}
+	return mapConstraintToState(constraint), diags
+}
+
+func (r *RunnerVersionConstraintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state RunnerVersionConstraintModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.ResetRunnerVersionConstraint(ctx)
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Removing Runner Version Constraint", fmt.Sprintf("Could not remove the default runner version constraint: %s", err))
+	}
+}
+
+// ImportState accepts the ID of the organization the provider is authenticated to, or
+// of another organization its token has access to.
+func (r *RunnerVersionConstraintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Like the run queue settings, the constraint is identified by its organization.
+	importguard.PassthroughOrganizationSingleton(ctx, r.client, req, resp)
+}
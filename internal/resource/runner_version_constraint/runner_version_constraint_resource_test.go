@@ -0,0 +1,123 @@
+// ABOUTME: Unit tests for the zenfra_runner_version_constraint resource against the zenfrafake client.
+// ABOUTME: Covers syntax validation, the catalog check at plan time, create, and removal on an empty read.
+package runner_version_constraint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func constraintModel(constraint string) *RunnerVersionConstraintModel {
+	return &RunnerVersionConstraintModel{
+		ID:              types.StringUnknown(),
+		Constraint:      types.StringValue(constraint),
+		ResolvedVersion: types.StringUnknown(),
+		UpdatedAt:       timeutil.NewTimestampUnknown(),
+		UpdatedBy:       types.StringUnknown(),
+	}
+}
+
+func TestRunnerVersionConstraintResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &RunnerVersionConstraintResource{}
+
+	for constraint, wantErr := range map[string]bool{"~> 1.4": false, ">= 1.4, < 2": false, "latest": true, "": true} {
+		state := zenfrafake.State(t, r, constraintModel(constraint))
+		resp := &resource.ValidateConfigResponse{}
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+		if resp.Diagnostics.HasError() != wantErr {
+			t.Errorf("%q: expected error %v, got %v", constraint, wantErr, resp.Diagnostics)
+		}
+	}
+}
+
+func TestRunnerVersionConstraintResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		ListRunnerVersionsFunc: func(context.Context) ([]zenfraclient.RunnerVersion, error) {
+			return []zenfraclient.RunnerVersion{{Version: "1.4.0"}, {Version: "1.4.3"}}, nil
+		},
+	}
+	r := &RunnerVersionConstraintResource{client: fake}
+
+	plan := zenfrafake.State(t, r, constraintModel("~> 2.0"))
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: zenfrafake.State(t, r, nil)}, resp)
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "No Matching Runner Version" {
+		t.Errorf("expected a No Matching Runner Version error, got %v", resp.Diagnostics)
+	}
+
+	plan = zenfrafake.State(t, r, constraintModel("~> 1.4"))
+	resp = &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: zenfrafake.State(t, r, nil)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v", resp.Diagnostics)
+	}
+}
+
+func TestRunnerVersionConstraintResource_Create(t *testing.T) {
+	ctx := context.Background()
+	var got zenfraclient.UpdateRunnerVersionConstraintRequest
+	fake := &zenfrafake.Client{
+		UpdateRunnerVersionConstraintFunc: func(_ context.Context, req zenfraclient.UpdateRunnerVersionConstraintRequest) (*zenfraclient.RunnerVersionConstraint, error) {
+			got = req
+			return &zenfraclient.RunnerVersionConstraint{
+				OrganizationID:  "org-1",
+				Constraint:      req.Constraint,
+				ResolvedVersion: "1.4.3",
+				UpdatedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			}, nil
+		},
+	}
+	r := &RunnerVersionConstraintResource{client: fake}
+
+	plan := zenfrafake.State(t, r, constraintModel("~> 1.4"))
+	resp := &resource.CreateResponse{State: zenfrafake.State(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+	if got.Constraint != "~> 1.4" {
+		t.Errorf("expected constraint ~> 1.4 in the request, got %q", got.Constraint)
+	}
+
+	var state RunnerVersionConstraintModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "org-1" || state.ResolvedVersion.ValueString() != "1.4.3" || !state.UpdatedBy.IsNull() {
+		t.Errorf("unexpected state: %+v", state)
+	}
+}
+
+func TestRunnerVersionConstraintResource_ReadRemovedDefault(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetRunnerVersionConstraintFunc: func(context.Context) (*zenfraclient.RunnerVersionConstraint, error) {
+			return &zenfraclient.RunnerVersionConstraint{OrganizationID: "org-1"}, nil
+		},
+	}
+	r := &RunnerVersionConstraintResource{client: fake}
+
+	prior := constraintModel("~> 1.4")
+	prior.ID = types.StringValue("org-1")
+	prior.ResolvedVersion = types.StringValue("1.4.3")
+	prior.UpdatedAt = timeutil.NewTimestampValue("2026-01-01T00:00:00Z")
+	prior.UpdatedBy = types.StringNull()
+	state := zenfrafake.State(t, r, prior)
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the resource to be removed when the organization has no default constraint")
+	}
+}
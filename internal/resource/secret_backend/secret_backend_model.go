@@ -0,0 +1,135 @@
+// ABOUTME: Terraform state models for the zenfra_secret_backend resource.
+// ABOUTME: Maps between API SecretBackend types and the nested vault and aws_secrets_manager attributes.
+package secret_backend
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/tfvalue"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// SecretBackendModel represents the Terraform state model for a secret backend.
+type SecretBackendModel struct {
+	ID                types.String            `tfsdk:"id"`
+	OrganizationID    types.String            `tfsdk:"organization_id"`
+	Name              types.String            `tfsdk:"name"`
+	Type              types.String            `tfsdk:"type"`
+	Vault             types.Object            `tfsdk:"vault"`
+	AWSSecretsManager types.Object            `tfsdk:"aws_secrets_manager"`
+	CreatedAt         timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt         timeutil.TimestampValue `tfsdk:"updated_at"`
+}
+
+// VaultModel represents the vault connection settings.
+type VaultModel struct {
+	Address    types.String `tfsdk:"address"`
+	Namespace  types.String `tfsdk:"namespace"`
+	AuthMethod types.String `tfsdk:"auth_method"`
+	AuthMount  types.String `tfsdk:"auth_mount"`
+	Role       types.String `tfsdk:"role"`
+}
+
+// AWSSecretsManagerModel represents the aws_secrets_manager connection settings.
+type AWSSecretsManagerModel struct {
+	Region     types.String `tfsdk:"region"`
+	RoleARN    types.String `tfsdk:"role_arn"`
+	ExternalID types.String `tfsdk:"external_id"`
+}
+
+// VaultModelAttrTypes defines the attribute types for VaultModel.
+var VaultModelAttrTypes = map[string]attr.Type{
+	"address":     types.StringType,
+	"namespace":   types.StringType,
+	"auth_method": types.StringType,
+	"auth_mount":  types.StringType,
+	"role":        types.StringType,
+}
+
+// AWSSecretsManagerModelAttrTypes defines the attribute types for AWSSecretsManagerModel.
+var AWSSecretsManagerModelAttrTypes = map[string]attr.Type{
+	"region":      types.StringType,
+	"role_arn":    types.StringType,
+	"external_id": types.StringType,
+}
+
+// mapSecretBackendToState converts an API SecretBackend to a SecretBackendModel.
+func mapSecretBackendToState(ctx context.Context, backend *zenfraclient.SecretBackend) (SecretBackendModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := SecretBackendModel{
+		ID:                types.StringValue(backend.ID),
+		OrganizationID:    types.StringValue(backend.OrganizationID),
+		Name:              types.StringValue(backend.Name),
+		Type:              types.StringValue(backend.Type),
+		Vault:             types.ObjectNull(VaultModelAttrTypes),
+		AWSSecretsManager: types.ObjectNull(AWSSecretsManagerModelAttrTypes),
+		CreatedAt:         timeutil.Timestamp(backend.CreatedAt),
+		UpdatedAt:         timeutil.Timestamp(backend.UpdatedAt),
+	}
+
+	if v := backend.Vault; v != nil {
+		obj, d := types.ObjectValueFrom(ctx, VaultModelAttrTypes, &VaultModel{
+			Address:    types.StringValue(v.Address),
+			Namespace:  tfvalue.OptionalString(v.Namespace),
+			AuthMethod: types.StringValue(v.AuthMethod),
+			AuthMount:  tfvalue.OptionalString(v.AuthMount),
+			Role:       types.StringValue(v.Role),
+		})
+		diags.Append(d...)
+		model.Vault = obj
+	}
+
+	if a := backend.AWSSecretsManager; a != nil {
+		obj, d := types.ObjectValueFrom(ctx, AWSSecretsManagerModelAttrTypes, &AWSSecretsManagerModel{
+			Region:     types.StringValue(a.Region),
+			RoleARN:    types.StringValue(a.RoleARN),
+			ExternalID: tfvalue.OptionalString(a.ExternalID),
+		})
+		diags.Append(d...)
+		model.AWSSecretsManager = obj
+	}
+
+	return model, diags
+}
+
+// vaultConfig converts the vault attribute to its API form, or nil when it is null.
+func vaultConfig(ctx context.Context, obj types.Object) (*zenfraclient.SecretBackendVaultConfig, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+	var m VaultModel
+	diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &zenfraclient.SecretBackendVaultConfig{
+		Address:    m.Address.ValueString(),
+		Namespace:  m.Namespace.ValueString(),
+		AuthMethod: m.AuthMethod.ValueString(),
+		AuthMount:  m.AuthMount.ValueString(),
+		Role:       m.Role.ValueString(),
+	}, diags
+}
+
+// awsConfig converts the aws_secrets_manager attribute to its API form, or nil when it is null.
+func awsConfig(ctx context.Context, obj types.Object) (*zenfraclient.SecretBackendAWSConfig, diag.Diagnostics) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, nil
+	}
+	var m AWSSecretsManagerModel
+	diags := obj.As(ctx, &m, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &zenfraclient.SecretBackendAWSConfig{
+		Region:     m.Region.ValueString(),
+		RoleARN:    m.RoleARN.ValueString(),
+		ExternalID: m.ExternalID.ValueString(),
+	}, diags
+}
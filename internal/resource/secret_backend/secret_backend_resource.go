@@ -0,0 +1,380 @@
+// ABOUTME: Implements the zenfra_secret_backend Terraform resource with full CRUD lifecycle.
+// ABOUTME: Connects Vault or AWS Secrets Manager through run identities, so no secret store credential is kept in state.
+package secret_backend
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &SecretBackendResource{}
+	_ resource.ResourceWithImportState    = &SecretBackendResource{}
+	_ resource.ResourceWithValidateConfig = &SecretBackendResource{}
+	_ resource.ResourceWithModifyPlan     = &SecretBackendResource{}
+)
+
+// NewSecretBackendResource is a constructor for the secret backend resource.
+func NewSecretBackendResource() resource.Resource {
+	return &SecretBackendResource{}
+}
+
+// SecretBackendResource is the resource implementation.
+type SecretBackendResource struct {
+	client zenfraclient.SecretBackendAPI
+}
+
+func (r *SecretBackendResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret_backend"
+}
+
+func (r *SecretBackendResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a connection to an external secret store, HashiCorp Vault or AWS Secrets Manager. " +
+			"Runs authenticate to the store with the identity Zenfra issues to them, so no store credential is configured here. " +
+			"Expose secrets from the store to runs with zenfra_bundle_secret_reference.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the secret backend.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID this secret backend belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the secret backend in another organization the token has access to. Changing it forces a new secret backend.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the secret backend.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The secret store type: 'vault' or 'aws_secrets_manager'. Changing it forces a new backend.",
+				Required:    true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.SecretBackendVault, zenfraclient.SecretBackendAWSSecretsManager),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vault": schema.SingleNestedAttribute{
+				Description: "Vault connection settings. Required when type is 'vault'.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Description: "The Vault server URL, e.g. 'https://vault.example.com:8200'.",
+						Required:    true,
+					},
+					"namespace": schema.StringAttribute{
+						Description: "The Vault Enterprise namespace to read secrets from.",
+						Optional:    true,
+					},
+					"auth_method": schema.StringAttribute{
+						Description: "How runs log in to Vault: 'jwt' with the run's Zenfra identity token, " +
+							"or 'kubernetes' with the service account of a Kubernetes worker pool.",
+						Required: true,
+						Validators: []validator.String{
+							validators.OneOf(zenfraclient.VaultAuthJWT, zenfraclient.VaultAuthKubernetes),
+						},
+					},
+					"auth_mount": schema.StringAttribute{
+						Description: "The path the auth method is mounted at. Defaults to the auth method's name.",
+						Optional:    true,
+					},
+					"role": schema.StringAttribute{
+						Description: "The Vault role runs log in with. Its policies decide which secrets runs can read.",
+						Required:    true,
+					},
+				},
+			},
+			"aws_secrets_manager": schema.SingleNestedAttribute{
+				Description: "AWS Secrets Manager connection settings. Required when type is 'aws_secrets_manager'.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						Description: "The AWS region of the secrets, e.g. 'eu-west-1'.",
+						Required:    true,
+					},
+					"role_arn": schema.StringAttribute{
+						Description: "The IAM role runs assume with their Zenfra identity token. Its policies decide which secrets runs can read.",
+						Required:    true,
+					},
+					"external_id": schema.StringAttribute{
+						Description: "The external ID required by the role's trust policy, if any.",
+						Optional:    true,
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the secret backend was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the secret backend was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SecretBackendResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SecretBackendModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Type.IsUnknown() {
+		switch config.Type.ValueString() {
+		case zenfraclient.SecretBackendVault:
+			if config.Vault.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("vault"), "Missing Vault Settings",
+					"vault must be set when type is 'vault'.")
+			}
+			if !config.AWSSecretsManager.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("aws_secrets_manager"), "Conflicting Secret Backend Settings",
+					"aws_secrets_manager cannot be set when type is 'vault'.")
+			}
+		case zenfraclient.SecretBackendAWSSecretsManager:
+			if config.AWSSecretsManager.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("aws_secrets_manager"), "Missing AWS Secrets Manager Settings",
+					"aws_secrets_manager must be set when type is 'aws_secrets_manager'.")
+			}
+			if !config.Vault.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("vault"), "Conflicting Secret Backend Settings",
+					"vault cannot be set when type is 'aws_secrets_manager'.")
+			}
+		}
+	}
+
+	if !config.Vault.IsNull() && !config.Vault.IsUnknown() {
+		var vault VaultModel
+		resp.Diagnostics.Append(config.Vault.As(ctx, &vault, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !vault.Address.IsUnknown() {
+			if err := validateVaultAddress(vault.Address.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("vault").AtName("address"), "Invalid Vault Address", err.Error())
+			}
+		}
+	}
+
+	if !config.AWSSecretsManager.IsNull() && !config.AWSSecretsManager.IsUnknown() {
+		var aws AWSSecretsManagerModel
+		resp.Diagnostics.Append(config.AWSSecretsManager.As(ctx, &aws, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !aws.RoleARN.IsUnknown() {
+			if err := validateRoleARN(aws.RoleARN.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("aws_secrets_manager").AtName("role_arn"), "Invalid Role ARN", err.Error())
+			}
+		}
+	}
+}
+
+// validateVaultAddress checks that addr is an absolute http or https URL.
+func validateVaultAddress(addr string) error {
+	u, err := url.Parse(addr)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("address must be an http or https URL such as https://vault.example.com:8200, got: %s", addr)
+	}
+	return nil
+}
+
+// validateRoleARN checks that arn names an IAM role, in any AWS partition.
+func validateRoleARN(arn string) error {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" || parts[2] != "iam" || !strings.HasPrefix(parts[5], "role/") {
+		return fmt.Errorf("role_arn must be an IAM role ARN such as arn:aws:iam::123456789012:role/zenfra-secrets, got: %s", arn)
+	}
+	return nil
+}
+
+// ModifyPlan warns when the API token may not manage secret backends.
+func (r *SecretBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_secret_backend", "secret_backend", req, resp)
+}
+
+func (r *SecretBackendResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_secret_backend")
+	}
+}
+
+func (r *SecretBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SecretBackendModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	vault, diags := vaultConfig(ctx, plan.Vault)
+	resp.Diagnostics.Append(diags...)
+	aws, diags := awsConfig(ctx, plan.AWSSecretsManager)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := zenfraclient.CreateSecretBackendRequest{
+		Name:              plan.Name.ValueString(),
+		Type:              plan.Type.ValueString(),
+		Vault:             vault,
+		AWSSecretsManager: aws,
+	}
+
+	backend, err := r.client.CreateSecretBackend(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Secret Backend", fmt.Sprintf("Could not create secret backend: %s", err))
+		return
+	}
+
+	state, diags := mapSecretBackendToState(ctx, backend)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SecretBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SecretBackendModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	backend, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.SecretBackend, error) {
+		return r.client.GetSecretBackend(ctx, state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Secret Backend",
+				fmt.Sprintf("Could not read secret backend ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Secret Backend",
+			fmt.Sprintf("Could not read secret backend ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	newState, diags := mapSecretBackendToState(ctx, backend)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *SecretBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SecretBackendModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	updateReq := zenfraclient.UpdateSecretBackendRequest{}
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+	if !plan.Vault.Equal(state.Vault) {
+		vault, diags := vaultConfig(ctx, plan.Vault)
+		resp.Diagnostics.Append(diags...)
+		updateReq.Vault = vault
+	}
+	if !plan.AWSSecretsManager.Equal(state.AWSSecretsManager) {
+		aws, diags := awsConfig(ctx, plan.AWSSecretsManager)
+		resp.Diagnostics.Append(diags...)
+		updateReq.AWSSecretsManager = aws
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backend, err := r.client.UpdateSecretBackend(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Secret Backend", fmt.Sprintf("Could not update secret backend: %s", err))
+		return
+	}
+
+	newState, diags := mapSecretBackendToState(ctx, backend)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *SecretBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SecretBackendModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteSecretBackend(ctx, state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		if zenfraclient.IsConflict(err) {
+			resp.Diagnostics.AddError("Error Deleting Secret Backend",
+				fmt.Sprintf("Secret backend ID %s is still used by bundle secret references. Remove them first: %s", state.ID.ValueString(), err))
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Secret Backend",
+			fmt.Sprintf("Could not delete secret backend ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *SecretBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationID(ctx, r.client, "secret backend", importguard.SecretBackend(r.client), req, resp)
+}
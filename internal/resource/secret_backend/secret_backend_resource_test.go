@@ -0,0 +1,117 @@
+// ABOUTME: Unit tests for the zenfra_secret_backend resource.
+// ABOUTME: Verifies state mapping of Vault and AWS Secrets Manager backends and the address and role ARN checks.
+package secret_backend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapSecretBackendToState_Vault(t *testing.T) {
+	ctx := context.Background()
+	backend := &zenfraclient.SecretBackend{
+		ID:             "sb-1",
+		OrganizationID: "org-1",
+		Name:           "prod-vault",
+		Type:           zenfraclient.SecretBackendVault,
+		Vault: &zenfraclient.SecretBackendVaultConfig{
+			Address:    "https://vault.example.com:8200",
+			AuthMethod: zenfraclient.VaultAuthJWT,
+			Role:       "zenfra-runs",
+		},
+		CreatedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC),
+	}
+
+	state, diags := mapSecretBackendToState(ctx, backend)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if state.ID.ValueString() != "sb-1" || state.Type.ValueString() != "vault" || state.UpdatedAt.ValueString() != "2026-03-02T10:00:00Z" {
+		t.Errorf("unexpected state: %+v", state)
+	}
+	if !state.AWSSecretsManager.IsNull() {
+		t.Error("expected null aws_secrets_manager for a Vault backend")
+	}
+
+	var vault VaultModel
+	if d := state.Vault.As(ctx, &vault, basetypes.ObjectAsOptions{}); d.HasError() {
+		t.Fatalf("reading vault: %v", d)
+	}
+	if vault.Address.ValueString() != "https://vault.example.com:8200" || vault.Role.ValueString() != "zenfra-runs" {
+		t.Errorf("unexpected vault settings: %+v", vault)
+	}
+	if !vault.Namespace.IsNull() || !vault.AuthMount.IsNull() {
+		t.Errorf("expected unset namespace and auth_mount to be null, got %s and %s", vault.Namespace, vault.AuthMount)
+	}
+
+	// Round trip back to the API form.
+	cfg, d := vaultConfig(ctx, state.Vault)
+	if d.HasError() {
+		t.Fatalf("vaultConfig: %v", d)
+	}
+	if *cfg != *backend.Vault {
+		t.Errorf("expected %+v, got %+v", backend.Vault, cfg)
+	}
+}
+
+func TestMapSecretBackendToState_AWS(t *testing.T) {
+	ctx := context.Background()
+	backend := &zenfraclient.SecretBackend{
+		ID:   "sb-2",
+		Type: zenfraclient.SecretBackendAWSSecretsManager,
+		AWSSecretsManager: &zenfraclient.SecretBackendAWSConfig{
+			Region:     "eu-west-1",
+			RoleARN:    "arn:aws:iam::123456789012:role/zenfra-secrets",
+			ExternalID: "zenfra-org-1",
+		},
+	}
+
+	state, diags := mapSecretBackendToState(ctx, backend)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !state.Vault.IsNull() {
+		t.Error("expected null vault for an AWS backend")
+	}
+
+	cfg, d := awsConfig(ctx, state.AWSSecretsManager)
+	if d.HasError() {
+		t.Fatalf("awsConfig: %v", d)
+	}
+	if *cfg != *backend.AWSSecretsManager {
+		t.Errorf("expected %+v, got %+v", backend.AWSSecretsManager, cfg)
+	}
+}
+
+func TestValidateVaultAddress(t *testing.T) {
+	for addr, wantErr := range map[string]bool{
+		"https://vault.example.com:8200": false,
+		"http://127.0.0.1:8200":          false,
+		"vault.example.com:8200":         true,
+		"https://":                       true,
+		"":                               true,
+	} {
+		if err := validateVaultAddress(addr); (err != nil) != wantErr {
+			t.Errorf("validateVaultAddress(%q) = %v, want error %v", addr, err, wantErr)
+		}
+	}
+}
+
+func TestValidateRoleARN(t *testing.T) {
+	for arn, wantErr := range map[string]bool{
+		"arn:aws:iam::123456789012:role/zenfra-secrets":        false,
+		"arn:aws-us-gov:iam::123456789012:role/path/to/role":   false,
+		"arn:aws:iam::123456789012:user/zenfra":                true,
+		"arn:aws:sts::123456789012:assumed-role/zenfra/run-42": true,
+		"zenfra-secrets": true,
+	} {
+		if err := validateRoleARN(arn); (err != nil) != wantErr {
+			t.Errorf("validateRoleARN(%q) = %v, want error %v", arn, err, wantErr)
+		}
+	}
+}
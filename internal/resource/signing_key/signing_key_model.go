@@ -0,0 +1,76 @@
+// ABOUTME: Terraform state model for the zenfra_signing_key resource.
+// ABOUTME: Keeps the configured PEM text when the API returns the same key re-wrapped.
+package signing_key
+
+import (
+	"bytes"
+	"encoding/pem"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// SigningKeyModel represents the Terraform state model for a Zenfra signing key.
+type SigningKeyModel struct {
+	ID             types.String            `tfsdk:"id"`
+	OrganizationID types.String            `tfsdk:"organization_id"`
+	Name           types.String            `tfsdk:"name"`
+	PublicKey      types.String            `tfsdk:"public_key"`
+	ExpiresAt      timeutil.TimestampValue `tfsdk:"expires_at"`
+	Algorithm      types.String            `tfsdk:"algorithm"`
+	Fingerprint    types.String            `tfsdk:"fingerprint"`
+	CreatedAt      timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt      timeutil.TimestampValue `tfsdk:"updated_at"`
+}
+
+// mapSigningKeyToState converts an API signing key to a SigningKeyModel. When prior is
+// set, its public_key is kept if the API returned the same key with re-wrapped PEM lines.
+// expires_at needs no such handling; its timestamp type compares instants.
+func mapSigningKeyToState(key *zenfraclient.SigningKey, prior *SigningKeyModel) SigningKeyModel {
+	model := SigningKeyModel{
+		ID:             types.StringValue(key.ID),
+		OrganizationID: types.StringValue(key.OrganizationID),
+		Name:           types.StringValue(key.Name),
+		PublicKey:      types.StringValue(key.PublicKey),
+		ExpiresAt:      timeutil.TimestampPointer(key.ExpiresAt),
+		Algorithm:      types.StringValue(key.Algorithm),
+		Fingerprint:    types.StringValue(key.Fingerprint),
+		CreatedAt:      timeutil.Timestamp(key.CreatedAt),
+		UpdatedAt:      timeutil.Timestamp(key.UpdatedAt),
+	}
+	if prior == nil {
+		return model
+	}
+	if !prior.PublicKey.IsNull() && (key.PublicKey == "" || samePublicKey(prior.PublicKey.ValueString(), key.PublicKey)) {
+		model.PublicKey = prior.PublicKey
+	}
+	return model
+}
+
+// samePublicKey reports whether two PEM documents encode the same key.
+func samePublicKey(a, b string) bool {
+	blockA, _ := pem.Decode([]byte(a))
+	blockB, _ := pem.Decode([]byte(b))
+	if blockA == nil || blockB == nil {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	return blockA.Type == blockB.Type && bytes.Equal(blockA.Bytes, blockB.Bytes)
+}
+
+// validatePublicKey returns a description of what is wrong with a PEM public key, or ""
+// if it is a single PEM block holding a public key.
+func validatePublicKey(value string) string {
+	block, rest := pem.Decode([]byte(value))
+	if block == nil {
+		return "public_key must be a PEM-encoded public key, starting with a line such as -----BEGIN PUBLIC KEY-----."
+	}
+	if !strings.HasSuffix(block.Type, "PUBLIC KEY") {
+		return "public_key holds a PEM block of type " + block.Type + ", not a public key. Never upload a private key."
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return "public_key must contain exactly one PEM block."
+	}
+	return ""
+}
@@ -0,0 +1,260 @@
+// ABOUTME: Implements the zenfra_signing_key Terraform resource with full CRUD lifecycle.
+// ABOUTME: Registers organization public keys that verify module and provider uploads; key material and expiry force replacement.
+package signing_key
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &SigningKeyResource{}
+	_ resource.ResourceWithImportState    = &SigningKeyResource{}
+	_ resource.ResourceWithValidateConfig = &SigningKeyResource{}
+	_ resource.ResourceWithModifyPlan     = &SigningKeyResource{}
+)
+
+// NewSigningKeyResource is a constructor for the signing key resource.
+func NewSigningKeyResource() resource.Resource {
+	return &SigningKeyResource{}
+}
+
+// SigningKeyResource is the resource implementation.
+type SigningKeyResource struct {
+	client zenfraclient.SigningKeyAPI
+}
+
+func (r *SigningKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signing_key"
+}
+
+func (r *SigningKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an organization signing key. Module and provider uploads must be signed by a registered, unexpired key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the signing key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID this key belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the signing key in another organization the token has access to. Changing it forces a new signing key.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the signing key.",
+				Required:    true,
+			},
+			"public_key": schema.StringAttribute{
+				Description: "The PEM-encoded public key. Changing it replaces the key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "When the key stops being accepted, as an RFC 3339 timestamp. Omit for a key that does not expire. Changing it replaces the key.",
+				CustomType:  timeutil.TimestampType{},
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"algorithm": schema.StringAttribute{
+				Description: "The key algorithm detected by the API, such as ed25519 or rsa.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				Description: "The fingerprint of the public key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the key was registered.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the key was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *SigningKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config SigningKeyModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.PublicKey.IsNull() && !config.PublicKey.IsUnknown() {
+		if problem := validatePublicKey(config.PublicKey.ValueString()); problem != "" {
+			resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid Public Key", problem)
+		}
+	}
+
+	if !config.ExpiresAt.IsNull() && !config.ExpiresAt.IsUnknown() {
+		if _, err := config.ExpiresAt.Time(); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid Expiry",
+				fmt.Sprintf("expires_at must be an RFC 3339 timestamp such as 2027-01-01T00:00:00Z: %s", err))
+		}
+	}
+}
+
+// ModifyPlan warns when the API token may not manage signing keys.
+func (r *SigningKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_signing_key", "signing_key", req, resp)
+}
+
+func (r *SigningKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_signing_key")
+	}
+}
+
+func (r *SigningKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SigningKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	createReq := zenfraclient.CreateSigningKeyRequest{
+		Name:      plan.Name.ValueString(),
+		PublicKey: plan.PublicKey.ValueString(),
+	}
+	if !plan.ExpiresAt.IsNull() {
+		expiresAt, err := plan.ExpiresAt.Time()
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("expires_at"), "Invalid Expiry", err.Error())
+			return
+		}
+		createReq.ExpiresAt = &expiresAt
+	}
+
+	key, err := r.client.CreateSigningKey(ctx, createReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Signing Key",
+			fmt.Sprintf("Could not create signing key: %s", err))
+		return
+	}
+
+	state := mapSigningKeyToState(key, &plan)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SigningKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SigningKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	key, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.SigningKey, error) {
+		return r.client.GetSigningKey(ctx, state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Signing Key",
+				fmt.Sprintf("Could not read signing key ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Signing Key",
+			fmt.Sprintf("Could not read signing key ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	newState := mapSigningKeyToState(key, &state)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *SigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state SigningKeyModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	updateReq := zenfraclient.UpdateSigningKeyRequest{}
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		updateReq.Name = &name
+	}
+
+	key, err := r.client.UpdateSigningKey(ctx, state.ID.ValueString(), updateReq)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Signing Key",
+			fmt.Sprintf("Could not update signing key: %s", err))
+		return
+	}
+
+	newState := mapSigningKeyToState(key, &plan)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *SigningKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SigningKeyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteSigningKey(ctx, state.ID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Signing Key",
+			fmt.Sprintf("Could not delete signing key ID %s: %s", state.ID.ValueString(), err))
+	}
+}
+
+func (r *SigningKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationID(ctx, r.client, "signing key", importguard.SigningKey(r.client), req, resp)
+}
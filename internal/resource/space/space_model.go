@@ -4,30 +4,36 @@ package space
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/managedlock"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 // SpaceModel represents the Terraform state model for a Zenfra space.
 type SpaceModel struct {
-	ID             types.String `tfsdk:"id"`
-	OrganizationID types.String `tfsdk:"organization_id"`
-	Name           types.String `tfsdk:"name"`
-	Description    types.String `tfsdk:"description"`
-	ParentSpaceID  types.String `tfsdk:"parent_space_id"`
-	InheritBundles types.Bool   `tfsdk:"inherit_bundles"`
-	CreatedAt      types.String `tfsdk:"created_at"`
-	UpdatedAt      types.String `tfsdk:"updated_at"`
+	ID                 types.String            `tfsdk:"id"`
+	OrganizationID     types.String            `tfsdk:"organization_id"`
+	Name               types.String            `tfsdk:"name"`
+	Description        types.String            `tfsdk:"description"`
+	ParentSpaceID      types.String            `tfsdk:"parent_space_id"`
+	InheritBundles     types.Bool              `tfsdk:"inherit_bundles"`
+	ForceDestroy       types.Bool              `tfsdk:"force_destroy"`
+	ManagedExclusively types.Bool              `tfsdk:"managed_exclusively"`
+	CreatedAt          timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt          timeutil.TimestampValue `tfsdk:"updated_at"`
 }
 
 // mapAPISpaceToModel converts an API Space response to a SpaceModel for Terraform state.
+// ForceDestroy is provider-side only and must be carried over by the caller.
 func mapAPISpaceToModel(space *zenfraclient.Space) SpaceModel {
 	model := SpaceModel{
-		ID:             types.StringValue(space.ID),
-		OrganizationID: types.StringValue(space.OrganizationID),
-		Name:           types.StringValue(space.Name),
-		InheritBundles: types.BoolValue(space.InheritBundles),
-		CreatedAt:      types.StringValue(space.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
-		UpdatedAt:      types.StringValue(space.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
+		ID:                 types.StringValue(space.ID),
+		OrganizationID:     types.StringValue(space.OrganizationID),
+		Name:               types.StringValue(space.Name),
+		InheritBundles:     types.BoolValue(space.InheritBundles),
+		ManagedExclusively: managedlock.Value(space.ManagedLock),
+		CreatedAt:          timeutil.Timestamp(space.CreatedAt),
+		UpdatedAt:          timeutil.Timestamp(space.UpdatedAt),
 	}
 
 	if space.Description != "" {
@@ -4,13 +4,22 @@ package space
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/managedlock"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -18,6 +27,7 @@ import (
 var (
 	_ resource.Resource                = &SpaceResource{}
 	_ resource.ResourceWithImportState = &SpaceResource{}
+	_ resource.ResourceWithModifyPlan  = &SpaceResource{}
 )
 
 // NewSpaceResource is a helper function to simplify the provider implementation.
@@ -27,7 +37,7 @@ func NewSpaceResource() resource.Resource {
 
 // SpaceResource is the resource implementation.
 type SpaceResource struct {
-	client *zenfraclient.Client
+	client zenfraclient.SpaceAPI
 }
 
 // Metadata returns the resource type name.
@@ -48,10 +58,13 @@ func (r *SpaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"organization_id": schema.StringAttribute{
-				Description: "The organization ID this space belongs to.",
-				Computed:    true,
+				Description: "The organization ID this space belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the space in another organization the token has access to. Changing it forces a new space.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -71,8 +84,17 @@ func (r *SpaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				Computed:    true,
 			},
+			"force_destroy": schema.BoolAttribute{
+				Description: "When true, destroying the space also deletes all of its child spaces and stacks. " +
+					"When false, destroy fails while the space still contains them. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"managed_exclusively": managedlock.Attribute("space"),
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the space was created.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -80,28 +102,23 @@ func (r *SpaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"updated_at": schema.StringAttribute{
 				Description: "Timestamp when the space was last updated.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 			},
 		},
 	}
 }
 
+// ModifyPlan warns when the API token may not manage spaces.
+func (r *SpaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_space", "space", req, resp)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *SpaceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_space")
 	}
-
-	r.client = client
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -113,6 +130,8 @@ func (r *SpaceResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
 	// Build the create request
 	createReq := zenfraclient.CreateSpaceRequest{
 		Name: plan.Name.ValueString(),
@@ -144,6 +163,10 @@ func (r *SpaceResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Map response to state
 	state := mapAPISpaceToModel(space)
+	state.ForceDestroy = plan.ForceDestroy
+	state.ManagedExclusively = managedlock.Apply(ctx, r.client.SetSpaceManagedLock, "space", space.ID,
+		plan.ManagedExclusively, state.ManagedExclusively, &resp.Diagnostics)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -157,14 +180,25 @@ func (r *SpaceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	// Get the space from the API
-	space, err := r.client.GetSpace(ctx, state.ID.ValueString())
+	space, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.Space, error) {
+		return r.client.GetSpaceCached(ctx, state.ID.ValueString())
+	})
 	if err != nil {
-		if zenfraclient.IsNotFound(err) {
+		if r.client.IsNotFoundOnRead(err) {
 			// Space no longer exists, remove from state
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError(
+				"Error Reading Space",
+				fmt.Sprintf("Could not read space ID %s: %s\n\n%s", state.ID.ValueString(), err.Error(), zenfraclient.ForbiddenReadHint),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Space",
 			fmt.Sprintf("Could not read space ID %s: %s", state.ID.ValueString(), err.Error()),
@@ -174,6 +208,12 @@ func (r *SpaceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Map response to state
 	newState := mapAPISpaceToModel(space)
+	newState.ForceDestroy = state.ForceDestroy
+	if newState.ForceDestroy.IsNull() {
+		// Imported spaces have no prior value
+		newState.ForceDestroy = types.BoolValue(false)
+	}
+	newState.ManagedExclusively = managedlock.Refresh(space.ManagedLock, state.ManagedExclusively, "space", space.ID, &resp.Diagnostics)
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -189,6 +229,8 @@ func (r *SpaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	// Build the update request with only changed fields
 	updateReq := zenfraclient.UpdateSpaceRequest{}
 
@@ -221,6 +263,10 @@ func (r *SpaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Map response to state
 	newState := mapAPISpaceToModel(space)
+	newState.ForceDestroy = plan.ForceDestroy
+	newState.ManagedExclusively = managedlock.Apply(ctx, r.client.SetSpaceManagedLock, "space", space.ID,
+		plan.ManagedExclusively, state.ManagedExclusively, &resp.Diagnostics)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -234,13 +280,30 @@ func (r *SpaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// Delete the space
-	err := r.client.DeleteSpace(ctx, state.ID.ValueString())
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	// Delete the space, including its contents when force_destroy is set
+	var err error
+	if state.ForceDestroy.ValueBool() {
+		err = r.client.DeleteSpaceRecursive(ctx, state.ID.ValueString())
+	} else {
+		err = r.client.DeleteSpace(ctx, state.ID.ValueString())
+	}
 	if err != nil {
 		if zenfraclient.IsNotFound(err) {
 			// Space already deleted, consider it a success
 			return
 		}
+		var conflictErr *zenfraclient.ConflictError
+		if errors.As(err, &conflictErr) && !state.ForceDestroy.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Space Is Not Empty",
+				fmt.Sprintf("Could not delete space ID %s because it still contains resources:\n%s\n"+
+					"Remove them first, or set force_destroy = true to delete them together with the space.",
+					state.ID.ValueString(), formatBlockingResources(conflictErr)),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Space",
 			fmt.Sprintf("Could not delete space ID %s: %s", state.ID.ValueString(), err.Error()),
@@ -251,6 +314,22 @@ func (r *SpaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 
 // ImportState imports the resource into Terraform state.
 func (r *SpaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Use the ID from the import to set the state
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importguard.PassthroughOrganizationID(ctx, r.client, "space", importguard.Space(r.client), req, resp)
+}
+
+// formatBlockingResources renders the resources listed in a 409 response as a bulleted list.
+func formatBlockingResources(err *zenfraclient.ConflictError) string {
+	if len(err.BlockingResources) == 0 {
+		return "  - " + err.Message
+	}
+
+	lines := make([]string, 0, len(err.BlockingResources))
+	for _, br := range err.BlockingResources {
+		line := fmt.Sprintf("  - %s %s", br.Type, br.ID)
+		if br.Name != "" {
+			line += fmt.Sprintf(" (%s)", br.Name)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
 }
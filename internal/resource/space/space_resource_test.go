@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -44,8 +45,8 @@ func TestMapAPISpaceToModel(t *testing.T) {
 				Name:           types.StringValue("Production"),
 				Description:    types.StringValue("Production environment space"),
 				ParentSpaceID:  types.StringValue("parent-space-123"),
-				CreatedAt:      types.StringValue("2026-02-11T10:00:00Z"),
-				UpdatedAt:      types.StringValue("2026-02-11T12:00:00Z"),
+				CreatedAt:      timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				UpdatedAt:      timeutil.NewTimestampValue("2026-02-11T12:00:00Z"),
 			},
 		},
 		{
@@ -72,8 +73,8 @@ func TestMapAPISpaceToModel(t *testing.T) {
 				Name:           types.StringValue("Development"),
 				Description:    types.StringNull(),
 				ParentSpaceID:  types.StringNull(),
-				CreatedAt:      types.StringValue("2026-02-11T10:00:00Z"),
-				UpdatedAt:      types.StringValue("2026-02-11T12:00:00Z"),
+				CreatedAt:      timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				UpdatedAt:      timeutil.NewTimestampValue("2026-02-11T12:00:00Z"),
 			},
 		},
 		{
@@ -100,8 +101,8 @@ func TestMapAPISpaceToModel(t *testing.T) {
 				Name:           types.StringValue("Staging"),
 				Description:    types.StringValue("Staging environment"),
 				ParentSpaceID:  types.StringNull(),
-				CreatedAt:      types.StringValue("2026-02-11T10:00:00Z"),
-				UpdatedAt:      types.StringValue("2026-02-11T12:00:00Z"),
+				CreatedAt:      timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				UpdatedAt:      timeutil.NewTimestampValue("2026-02-11T12:00:00Z"),
 			},
 		},
 	}
@@ -136,6 +137,27 @@ func TestMapAPISpaceToModel(t *testing.T) {
 	}
 }
 
+func TestFormatBlockingResources(t *testing.T) {
+	err := &zenfraclient.ConflictError{
+		APIError: zenfraclient.APIError{StatusCode: 409, Message: "space has children"},
+		BlockingResources: []zenfraclient.BlockingResource{
+			{Type: "space", ID: "space-2", Name: "child"},
+			{Type: "stack", ID: "stack-1"},
+		},
+	}
+
+	got := formatBlockingResources(err)
+	want := "  - space space-2 (child)\n  - stack stack-1"
+	if got != want {
+		t.Errorf("formatBlockingResources() = %q, want %q", got, want)
+	}
+
+	err.BlockingResources = nil
+	if got := formatBlockingResources(err); got != "  - space has children" {
+		t.Errorf("expected fallback to API message, got %q", got)
+	}
+}
+
 // stringPtr is a helper function to create a pointer to a string.
 func stringPtr(s string) *string {
 	return &s
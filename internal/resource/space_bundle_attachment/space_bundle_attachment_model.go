@@ -0,0 +1,15 @@
+// ABOUTME: Terraform state model for the zenfra_space_bundle_attachment resource.
+// ABOUTME: Uses composite ID format "space_id:bundle_id" for the attachment relationship.
+package space_bundle_attachment
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SpaceBundleAttachmentModel represents the Terraform state model for a bundle-to-space attachment.
+type SpaceBundleAttachmentModel struct {
+	ID             types.String `tfsdk:"id"`
+	SpaceID        types.String `tfsdk:"space_id"`
+	BundleID       types.String `tfsdk:"bundle_id"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
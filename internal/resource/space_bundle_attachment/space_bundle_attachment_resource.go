@@ -0,0 +1,208 @@
+// ABOUTME: Implements the zenfra_space_bundle_attachment Terraform resource for attaching bundles to spaces.
+// ABOUTME: Uses composite ID "space_id:bundle_id" and ForceNew semantics for both IDs.
+package space_bundle_attachment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                = &SpaceBundleAttachmentResource{}
+	_ resource.ResourceWithImportState = &SpaceBundleAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &SpaceBundleAttachmentResource{}
+)
+
+// NewSpaceBundleAttachmentResource is a constructor for the space bundle attachment resource.
+func NewSpaceBundleAttachmentResource() resource.Resource {
+	return &SpaceBundleAttachmentResource{}
+}
+
+// SpaceBundleAttachmentResource is the resource implementation.
+type SpaceBundleAttachmentResource struct {
+	client zenfraclient.SpaceBundleAttachmentAPI
+}
+
+func (r *SpaceBundleAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_bundle_attachment"
+}
+
+func (r *SpaceBundleAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a configuration bundle to a space. Every stack in the space, and in child spaces that inherit bundles, receives the bundle's configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Composite identifier in the format space_id:bundle_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Description: "The space to attach the bundle to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the space and bundle. Defaults to the organization of the provider's API token; set it to the " +
+					"space's organization_id when the space belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"bundle_id": schema.StringAttribute{
+				Description: "The bundle to attach.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan warns when the API token may not manage spaces.
+func (r *SpaceBundleAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_space_bundle_attachment", "space", req, resp)
+}
+
+func (r *SpaceBundleAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_space_bundle_attachment")
+	}
+}
+
+func (r *SpaceBundleAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SpaceBundleAttachmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	spaceID := plan.SpaceID.ValueString()
+	bundleID := plan.BundleID.ValueString()
+
+	err := r.client.AttachSpaceBundle(ctx, spaceID, bundleID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Attaching Space Bundle", fmt.Sprintf("Could not attach bundle %s to space %s: %s", bundleID, spaceID, err))
+		return
+	}
+
+	plan.ID = types.StringValue(spaceID + ":" + bundleID)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SpaceBundleAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SpaceBundleAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	spaceID := state.SpaceID.ValueString()
+	bundleID := state.BundleID.ValueString()
+
+	attachments, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) ([]zenfraclient.SpaceBundleAttachment, error) {
+		return r.client.ListSpaceBundles(ctx, spaceID)
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Space Bundle Attachment", fmt.Sprintf("Could not list bundles for space %s: %s\n\n%s", spaceID, err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Space Bundle Attachment", fmt.Sprintf("Could not list bundles for space %s: %s", spaceID, err))
+		return
+	}
+
+	found := false
+	for _, att := range attachments {
+		if att.BundleID == bundleID {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SpaceBundleAttachmentResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Unexpected Update", "Space bundle attachment does not support in-place updates.")
+}
+
+func (r *SpaceBundleAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SpaceBundleAttachmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DetachSpaceBundle(ctx, state.SpaceID.ValueString(), state.BundleID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Detaching Space Bundle",
+			fmt.Sprintf("Could not detach bundle %s from space %s: %s", state.BundleID.ValueString(), state.SpaceID.ValueString(), err))
+	}
+}
+
+func (r *SpaceBundleAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, id := importguard.SplitOrganization(req.ID)
+	parts := strings.SplitN(id, ":", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.HasPrefix(req.ID, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format: [organization_id/]space_id:bundle_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+
+	if !importguard.VerifyOrganization(ctx, r.client, "space", parts[0], importguard.Space(r.client), &resp.Diagnostics) ||
+		!importguard.VerifyOrganization(ctx, r.client, "bundle", parts[1], importguard.Bundle(r.client), &resp.Diagnostics) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, SpaceBundleAttachmentModel{
+		ID:       types.StringValue(id),
+		SpaceID:  types.StringValue(parts[0]),
+		BundleID: types.StringValue(parts[1]),
+	})...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+}
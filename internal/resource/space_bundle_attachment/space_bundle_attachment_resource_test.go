@@ -0,0 +1,99 @@
+// ABOUTME: Unit tests for the zenfra_space_bundle_attachment resource against the zenfrafake client.
+// ABOUTME: Covers removal of detached bundles on Read and composite import ID parsing, with an optional organization prefix.
+package space_bundle_attachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func TestSpaceBundleAttachmentResource_Read(t *testing.T) {
+	ctx := context.Background()
+	model := &SpaceBundleAttachmentModel{
+		ID:       types.StringValue("space-1:bundle-1"),
+		SpaceID:  types.StringValue("space-1"),
+		BundleID: types.StringValue("bundle-1"),
+	}
+
+	tests := []struct {
+		name        string
+		attachments []zenfraclient.SpaceBundleAttachment
+		err         error
+		wantRemoved bool
+	}{
+		{name: "attached", attachments: []zenfraclient.SpaceBundleAttachment{{SpaceID: "space-1", BundleID: "bundle-1"}}},
+		{name: "detached", attachments: []zenfraclient.SpaceBundleAttachment{{SpaceID: "space-1", BundleID: "bundle-2"}}, wantRemoved: true},
+		{name: "space gone", err: zenfrafake.NotFound(), wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &zenfrafake.Client{
+				ListSpaceBundlesFunc: func(context.Context, string) ([]zenfraclient.SpaceBundleAttachment, error) {
+					return tt.attachments, tt.err
+				},
+			}
+			r := &SpaceBundleAttachmentResource{client: fake}
+
+			state := zenfrafake.State(t, r, model)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Errorf("expected removed=%v, got state %v", tt.wantRemoved, resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestSpaceBundleAttachmentResource_ImportInvalidID(t *testing.T) {
+	r := &SpaceBundleAttachmentResource{client: &zenfrafake.Client{}}
+
+	for _, id := range []string{"space-1", "space-1:", ":bundle-1", "/space-1:bundle-1", "org-2/space-1"} {
+		resp := &resource.ImportStateResponse{State: zenfrafake.State(t, r, nil)}
+		r.ImportState(context.Background(), resource.ImportStateRequest{ID: id}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("expected an error for import ID %q", id)
+		}
+	}
+}
+
+func TestSpaceBundleAttachmentResource_ImportOrganization(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetCurrentOrganizationFunc: func(context.Context) (*zenfraclient.Organization, error) {
+			return &zenfraclient.Organization{ID: "org-2"}, nil
+		},
+		GetSpaceFunc: func(_ context.Context, id string) (*zenfraclient.Space, error) {
+			return &zenfraclient.Space{ID: id, OrganizationID: "org-2"}, nil
+		},
+		GetBundleFunc: func(_ context.Context, id string) (*zenfraclient.Bundle, error) {
+			return &zenfraclient.Bundle{ID: id, OrganizationID: "org-2"}, nil
+		},
+	}
+	r := &SpaceBundleAttachmentResource{client: fake}
+
+	resp := &resource.ImportStateResponse{State: zenfrafake.State(t, r, nil)}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "org-2/space-1:bundle-1"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ImportState: %v", resp.Diagnostics)
+	}
+
+	var state SpaceBundleAttachmentModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("reading state: %v", diags)
+	}
+	if state.ID.ValueString() != "space-1:bundle-1" || state.SpaceID.ValueString() != "space-1" || state.BundleID.ValueString() != "bundle-1" {
+		t.Errorf("expected the IDs without the organization prefix, got %s, %s, %s", state.ID, state.SpaceID, state.BundleID)
+	}
+	if state.OrganizationID.ValueString() != "org-2" {
+		t.Errorf("expected organization_id org-2, got %s", state.OrganizationID)
+	}
+}
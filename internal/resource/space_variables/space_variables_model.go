@@ -0,0 +1,14 @@
+// ABOUTME: Terraform state model for the zenfra_space_variables resource.
+// ABOUTME: Mirrors zenfra_stack_variables: replace-all semantics and secret values the API withholds on read.
+package space_variables
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SpaceVariablesModel represents the Terraform state for all variables on a space.
+type SpaceVariablesModel struct {
+	SpaceID        types.String `tfsdk:"space_id"`
+	Variable       types.Set    `tfsdk:"variable"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
@@ -0,0 +1,235 @@
+// ABOUTME: Implements the zenfra_space_variables Terraform resource with replace-all semantics.
+// ABOUTME: Variables are inherited by all stacks in the space; shares the import guard and secret preservation of stack variables.
+package space_variables
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/payloadsize"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/variables"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                = &SpaceVariablesResource{}
+	_ resource.ResourceWithImportState = &SpaceVariablesResource{}
+	_ resource.ResourceWithModifyPlan  = &SpaceVariablesResource{}
+)
+
+// NewSpaceVariablesResource is a constructor for the space variables resource.
+func NewSpaceVariablesResource() resource.Resource {
+	return &SpaceVariablesResource{}
+}
+
+// SpaceVariablesResource is the resource implementation.
+type SpaceVariablesResource struct {
+	client zenfraclient.SpaceAPI
+}
+
+func (r *SpaceVariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_variables"
+}
+
+func (r *SpaceVariablesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the complete set of variables on a Zenfra space. Every stack in the space, including stacks in child spaces, inherits these variables. " +
+			"When the same key is set in several places, a stack's own variables take precedence, followed by the closest space, then its parent spaces up to the root. " +
+			"Uses replace-all semantics: variables not in the configuration will be deleted.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Description: "The space ID to manage variables for.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the space. Defaults to the organization of the provider's API token; set it to the " +
+					"space's organization_id when the space belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"variable": variables.Block("space"),
+		},
+	}
+}
+
+func (r *SpaceVariablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_space_variables")
+	}
+}
+
+// ModifyPlan checks variable values against the API's size limit and implements the
+// import safety guard. When a space has variables on the remote that are NOT in the
+// config, this emits an error to prevent accidental deletion.
+func (r *SpaceVariablesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_space_variables", "space", req, resp)
+
+	if !req.Plan.Raw.IsNull() {
+		var vars types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("variable"), &vars)...)
+		payloadsize.CheckVariables(path.Root("variable"), vars, &resp.Diagnostics)
+	}
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if r.client == nil {
+		return
+	}
+
+	var plan SpaceVariablesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceID := plan.SpaceID.ValueString()
+	if spaceID == "" {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	remoteVars, err := r.client.GetSpaceVariablesCached(ctx, spaceID)
+	if err != nil {
+		return
+	}
+
+	if missing := variables.MissingKeys(ctx, remoteVars, plan.Variable, &resp.Diagnostics); len(missing) > 0 {
+		resp.Diagnostics.AddError(
+			"Space Has Variables Not In Configuration",
+			fmt.Sprintf(
+				"Space %s has variables not in your configuration that will be deleted: [%s]. "+
+					"These variables are inherited by every stack in the space. "+
+					"Add all variables to your configuration or they will be removed.",
+				spaceID, strings.Join(missing, ", "),
+			),
+		)
+	}
+}
+
+func (r *SpaceVariablesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan SpaceVariablesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	apiVars := variables.ToAPI(ctx, plan.Variable, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SetSpaceVariables(ctx, plan.SpaceID.ValueString(), apiVars)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Setting Space Variables", fmt.Sprintf("Could not set variables: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SpaceVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state SpaceVariablesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	remoteVars, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) ([]zenfraclient.StackVariable, error) {
+		return r.client.GetSpaceVariables(ctx, state.SpaceID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Space Variables",
+				fmt.Sprintf("Could not read variables for space %s: %s\n\n%s", state.SpaceID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Space Variables",
+			fmt.Sprintf("Could not read variables for space %s: %s", state.SpaceID.ValueString(), err))
+		return
+	}
+
+	priorSecrets := variables.PriorSecrets(ctx, state.Variable, &resp.Diagnostics)
+	varSet, diags := variables.FromAPI(remoteVars, priorSecrets)
+	resp.Diagnostics.Append(diags...)
+	state.Variable = varSet
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SpaceVariablesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan SpaceVariablesModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	apiVars := variables.ToAPI(ctx, plan.Variable, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SetSpaceVariables(ctx, plan.SpaceID.ValueString(), apiVars)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Space Variables", fmt.Sprintf("Could not update variables: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SpaceVariablesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state SpaceVariablesModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	_, err := r.client.SetSpaceVariables(ctx, state.SpaceID.ValueString(), []zenfraclient.StackVariable{})
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Deleting Space Variables",
+			fmt.Sprintf("Could not clear variables for space %s: %s", state.SpaceID.ValueString(), err))
+	}
+}
+
+func (r *SpaceVariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationAttribute(ctx, r.client, "space", path.Root("space_id"), importguard.Space(r.client), req, resp)
+}
@@ -0,0 +1,54 @@
+// ABOUTME: Plan modifier that refuses to switch a stack's IaC engine unless explicitly allowed.
+// ABOUTME: Switching between terraform and opentofu migrates the stack's state on the server.
+package stack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.String = engineMigrationGuard{}
+
+// engineMigrationGuard fails the plan when iac.engine changes on an existing stack and
+// allow_engine_migration is not true.
+type engineMigrationGuard struct{}
+
+func (m engineMigrationGuard) Description(_ context.Context) string {
+	return "Requires allow_engine_migration = true to change the engine of an existing stack."
+}
+
+func (m engineMigrationGuard) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m engineMigrationGuard) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to migrate on create or destroy, and unknown engines are checked at apply.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.StateValue.IsNull() || req.PlanValue.IsUnknown() || req.PlanValue.IsNull() {
+		return
+	}
+	from, to := req.StateValue.ValueString(), req.PlanValue.ValueString()
+	if strings.EqualFold(from, to) {
+		return
+	}
+
+	var allow types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_engine_migration"), &allow)...)
+	if resp.Diagnostics.HasError() || allow.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(req.Path, "IaC Engine Change Requires Migration",
+		fmt.Sprintf("Changing iac.engine from %q to %q migrates the stack's state to the new engine on the server, "+
+			"and the migration cannot be undone from Terraform.\n\n"+
+			"To migrate:\n"+
+			"  1. Download a backup of the stack's current state.\n"+
+			"  2. Check that the stack's configuration and providers are supported by %s at the configured iac.version.\n"+
+			"  3. Set allow_engine_migration = true on this stack and apply.\n"+
+			"  4. Remove allow_engine_migration once the stack's first run on the new engine succeeds.\n\n"+
+			"If the engine change is unintended, restore iac.engine to %q.", from, to, to, from))
+}
@@ -0,0 +1,83 @@
+// ABOUTME: Unit tests for the plan modifier guarding iac.engine changes on zenfra_stack.
+// ABOUTME: Builds plans against the real stack schema with only the attributes the guard reads set.
+package stack
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// stackPlan returns a plan for the stack schema with allow_engine_migration set to
+// allow and every other attribute null.
+func stackPlan(t *testing.T, allow tftypes.Value) tfsdk.Plan {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&StackResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, typ := range objType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["allow_engine_migration"] = allow
+	return tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, values)}
+}
+
+func TestEngineMigrationGuard(t *testing.T) {
+	ctx := context.Background()
+	unset := tftypes.NewValue(tftypes.Bool, nil)
+
+	tests := []struct {
+		name    string
+		state   types.String
+		plan    types.String
+		allow   tftypes.Value
+		create  bool
+		wantErr bool
+	}{
+		{name: "unchanged", state: types.StringValue("terraform"), plan: types.StringValue("terraform"), allow: unset},
+		{name: "case only", state: types.StringValue("opentofu"), plan: types.StringValue("OpenTofu"), allow: unset},
+		{name: "switch without opt-in", state: types.StringValue("terraform"), plan: types.StringValue("opentofu"), allow: unset, wantErr: true},
+		{name: "switch with opt-in false", state: types.StringValue("terraform"), plan: types.StringValue("opentofu"), allow: tftypes.NewValue(tftypes.Bool, false), wantErr: true},
+		{name: "switch allowed", state: types.StringValue("opentofu"), plan: types.StringValue("terraform"), allow: tftypes.NewValue(tftypes.Bool, true)},
+		{name: "unknown engine", state: types.StringValue("terraform"), plan: types.StringUnknown(), allow: unset},
+		{name: "create", state: types.StringNull(), plan: types.StringValue("opentofu"), allow: unset, create: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := stackPlan(t, tt.allow)
+			state := tfsdk.State{Schema: plan.Schema, Raw: plan.Raw}
+			if tt.create {
+				state.Raw = tftypes.NewValue(plan.Raw.Type(), nil)
+			}
+
+			req := planmodifier.StringRequest{
+				Path:       path.Root("iac").AtName("engine"),
+				Plan:       plan,
+				State:      state,
+				StateValue: tt.state,
+				PlanValue:  tt.plan,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: tt.plan}
+			engineMigrationGuard{}.PlanModifyString(ctx, req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Fatalf("expected error=%v, got %v", tt.wantErr, resp.Diagnostics)
+			}
+			if tt.wantErr && !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), "allow_engine_migration = true") {
+				t.Errorf("expected migration guidance, got %q", resp.Diagnostics.Errors()[0].Detail())
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// ABOUTME: Reads the health of a stack (ok, drifted, failed, locked) from the stack or its status endpoint.
+// ABOUTME: Health is informational, so failing to read it warns instead of failing the refresh.
+package stack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// readHealth sets health and drift_detected_at on model from stack, or from the stack's
+// status endpoint when the API left health out of stack. A refresh with bulk refresh
+// enabled does not call the endpoint and keeps the values already in model, so
+// refreshing many stacks stays a single list call. An API without the endpoint leaves
+// both null; any other error keeps the values already in model and adds a warning.
+func (r *StackResource) readHealth(ctx context.Context, stack *zenfraclient.Stack, refresh bool, model *StackModel, diags *diag.Diagnostics) {
+	health := stack.Health
+	if health == nil {
+		if refresh && r.client.IsBulkRefresh() {
+			return
+		}
+		var err error
+		health, err = r.client.GetStackHealth(ctx, stack.ID)
+		if err != nil {
+			if zenfraclient.IsNotFound(err) {
+				model.Health, model.DriftDetectedAt = types.StringNull(), timeutil.NewTimestampNull()
+				return
+			}
+			diags.AddWarning("Unable to Read Stack Health",
+				fmt.Sprintf("Could not read the health of stack ID %s, so health and drift_detected_at may be out of date: %s", stack.ID, err))
+			return
+		}
+	}
+	model.Health = types.StringValue(health.Status)
+	model.DriftDetectedAt = timeutil.TimestampPointer(health.DriftDetectedAt)
+}
@@ -0,0 +1,80 @@
+// ABOUTME: Unit tests for refreshing zenfra_stack health and drift_detected_at against the zenfrafake client.
+// ABOUTME: Covers a drifted stack, an API without the status endpoint, a failed health read, and health from the stack or bulk refresh.
+package stack
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func TestStackResource_ReadHealth(t *testing.T) {
+	ctx := context.Background()
+	drifted := time.Date(2026, 3, 1, 4, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		health    *zenfraclient.StackHealth
+		err       error
+		embedded  *zenfraclient.StackHealth // health included in the stack itself
+		bulk      bool
+		wantState string
+		wantDrift string
+		wantWarn  bool
+	}{
+		{name: "drifted", health: &zenfraclient.StackHealth{Status: zenfraclient.StackHealthDrifted, DriftDetectedAt: &drifted},
+			wantState: zenfraclient.StackHealthDrifted, wantDrift: "2026-03-01T04:00:00Z"},
+		{name: "ok", health: &zenfraclient.StackHealth{Status: zenfraclient.StackHealthOK}, wantState: zenfraclient.StackHealthOK},
+		{name: "endpoint not available", err: zenfrafake.NotFound()},
+		{name: "read fails", err: errors.New("connection reset"), wantState: zenfraclient.StackHealthLocked, wantWarn: true},
+		{name: "included in the stack", embedded: &zenfraclient.StackHealth{Status: zenfraclient.StackHealthFailed},
+			err: errors.New("unexpected status read"), wantState: zenfraclient.StackHealthFailed},
+		{name: "included in the bulk refresh list", embedded: &zenfraclient.StackHealth{Status: zenfraclient.StackHealthOK},
+			bulk: true, err: errors.New("unexpected status read"), wantState: zenfraclient.StackHealthOK},
+		{name: "bulk refresh keeps prior values", bulk: true, err: errors.New("unexpected status read"),
+			wantState: zenfraclient.StackHealthLocked},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stack := updateTestStack()
+			stack.Health = tt.embedded
+			fake := &zenfrafake.Client{
+				GetStackCachedFunc: func(context.Context, string) (*zenfraclient.Stack, error) { return stack, nil },
+				GetStackHealthFunc: func(context.Context, string) (*zenfraclient.StackHealth, error) { return tt.health, tt.err },
+			}
+			fake.BulkRefresh = tt.bulk
+			r := &StackResource{client: fake}
+
+			prior := stackState(t, updateTestStack(), func(m *StackModel) {
+				m.Health = types.StringValue(zenfraclient.StackHealthLocked)
+			})
+			resp := &resource.ReadResponse{State: prior}
+			r.Read(ctx, resource.ReadRequest{State: prior}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarn {
+				t.Errorf("expected warning = %v, got %v", tt.wantWarn, resp.Diagnostics)
+			}
+
+			var got StackModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.Health.ValueString() != tt.wantState {
+				t.Errorf("expected health %q, got %s", tt.wantState, got.Health)
+			}
+			if got.DriftDetectedAt.ValueString() != tt.wantDrift {
+				t.Errorf("expected drift_detected_at %q, got %s", tt.wantDrift, got.DriftDetectedAt)
+			}
+			if calledHealth := slices.Contains(fake.Calls(), "GetStackHealth"); calledHealth != (tt.embedded == nil && !tt.bulk) {
+				t.Errorf("expected GetStackHealth to be called only without embedded health or bulk refresh, got calls %v", fake.Calls())
+			}
+		})
+	}
+}
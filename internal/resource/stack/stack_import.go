@@ -0,0 +1,88 @@
+// ABOUTME: Parses zenfra_stack import IDs with an optional include query, e.g. stack-123?include=variables,bundles.
+// ABOUTME: Generates import blocks for the stack's variables and bundle attachments so they can be imported too.
+package stack
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+const (
+	includeVariables = "variables"
+	includeBundles   = "bundles"
+)
+
+// parseImportID splits an import ID of the form <stack_id>[?include=a,b] into the stack
+// ID and the requested companion resources.
+func parseImportID(id string) (string, []string, error) {
+	stackID, rawQuery, hasQuery := strings.Cut(id, "?")
+	if stackID == "" {
+		return "", nil, fmt.Errorf("missing stack ID in import ID %q", id)
+	}
+	if !hasQuery {
+		return stackID, nil, nil
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return "", nil, fmt.Errorf("parsing import ID %q: %w", id, err)
+	}
+
+	var include []string
+	for key, values := range query {
+		if key != "include" {
+			return "", nil, fmt.Errorf("unknown import option %q in %q; only include is supported", key, id)
+		}
+		for _, v := range values {
+			for item := range strings.SplitSeq(v, ",") {
+				item = strings.TrimSpace(item)
+				switch item {
+				case includeVariables, includeBundles:
+					if !slices.Contains(include, item) {
+						include = append(include, item)
+					}
+				default:
+					return "", nil, fmt.Errorf("unknown include %q in %q; expected %s or %s", item, id, includeVariables, includeBundles)
+				}
+			}
+		}
+	}
+	return stackID, include, nil
+}
+
+// companionImportBlocks renders import blocks for the stack's variables (when hasVariables)
+// and its bundle attachments. It returns "" when there is nothing to import.
+func companionImportBlocks(stackID string, hasVariables bool, attachments []zenfraclient.BundleAttachment) string {
+	var b strings.Builder
+	label := resourceLabel(stackID)
+
+	if hasVariables {
+		fmt.Fprintf(&b, "import {\n  to = zenfra_stack_variables.%s\n  id = %q\n}\n", label, stackID)
+	}
+	for _, a := range attachments {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "import {\n  to = zenfra_bundle_attachment.%s_%s\n  id = %q\n}\n",
+			label, resourceLabel(a.BundleID), stackID+":"+a.BundleID)
+	}
+	return b.String()
+}
+
+// resourceLabel turns an API ID into a valid Terraform resource name.
+func resourceLabel(id string) string {
+	label := strings.Map(func(r rune) rune {
+		if r == '_' || r == '-' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, id)
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "id_" + label
+	}
+	return label
+}
@@ -0,0 +1,61 @@
+// ABOUTME: Unit tests for zenfra_stack import ID parsing and companion import block generation.
+// ABOUTME: Covers the include query syntax and resource label sanitization.
+package stack
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		id          string
+		wantStack   string
+		wantInclude []string
+		wantErr     bool
+	}{
+		{id: "stack-123", wantStack: "stack-123"},
+		{id: "stack-123?include=variables,bundles", wantStack: "stack-123", wantInclude: []string{"variables", "bundles"}},
+		{id: "stack-123?include=bundles&include=bundles", wantStack: "stack-123", wantInclude: []string{"bundles"}},
+		{id: "stack-123?include=outputs", wantErr: true},
+		{id: "stack-123?expand=variables", wantErr: true},
+		{id: "?include=variables", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			stackID, include, err := parseImportID(tt.id)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for %q", tt.id)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if stackID != tt.wantStack || !slices.Equal(include, tt.wantInclude) {
+				t.Errorf("got (%q, %v), want (%q, %v)", stackID, include, tt.wantStack, tt.wantInclude)
+			}
+		})
+	}
+}
+
+func TestCompanionImportBlocks(t *testing.T) {
+	if got := companionImportBlocks("stack-1", false, nil); got != "" {
+		t.Errorf("expected no blocks, got %q", got)
+	}
+
+	got := companionImportBlocks("stack-1", true, []zenfraclient.BundleAttachment{{BundleID: "9f1c.2"}})
+	for _, want := range []string{
+		"to = zenfra_stack_variables.stack-1\n  id = \"stack-1\"",
+		"to = zenfra_bundle_attachment.stack-1_id_9f1c_2\n  id = \"stack-1:9f1c.2\"",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected blocks to contain %q, got:\n%s", want, got)
+		}
+	}
+}
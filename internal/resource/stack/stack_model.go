@@ -5,51 +5,167 @@ package stack
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 )
 
 // StackModel represents the Terraform state model for a Zenfra stack.
 type StackModel struct {
-	ID              types.String `tfsdk:"id"`
-	OrganizationID  types.String `tfsdk:"organization_id"`
-	SpaceID         types.String `tfsdk:"space_id"`
-	Name            types.String `tfsdk:"name"`
-	WorkerPoolID    types.String `tfsdk:"worker_pool_id"`
-	AllowPublicPool types.Bool   `tfsdk:"allow_public_pool"`
-	IAC             types.Object `tfsdk:"iac"`
-	Source          types.Object `tfsdk:"source"`
-	Triggers        types.Object `tfsdk:"triggers"`
-	CreatedAt       types.String `tfsdk:"created_at"`
-	UpdatedAt       types.String `tfsdk:"updated_at"`
-	CreatedBy       types.String `tfsdk:"created_by"`
-	UpdatedBy       types.String `tfsdk:"updated_by"`
+	ID                 types.String            `tfsdk:"id"`
+	OrganizationID     types.String            `tfsdk:"organization_id"`
+	SpaceID            types.String            `tfsdk:"space_id"`
+	Name               types.String            `tfsdk:"name"`
+	WorkerPoolID       types.String            `tfsdk:"worker_pool_id"`
+	AllowPublicPool    types.Bool              `tfsdk:"allow_public_pool"`
+	IAC                types.Object            `tfsdk:"iac"`
+	Source             types.Object            `tfsdk:"source"`
+	TemplateID         types.String            `tfsdk:"template_id"`
+	Triggers           types.Object            `tfsdk:"triggers"`
+	RunnerImage        types.String            `tfsdk:"runner_image"`
+	BeforeInit         types.List              `tfsdk:"before_init"`
+	BeforePlan         types.List              `tfsdk:"before_plan"`
+	AfterApply         types.List              `tfsdk:"after_apply"`
+	Environment        types.Map               `tfsdk:"environment"`
+	RequiredChecks     types.List              `tfsdk:"required_checks_before_destroy"`
+	EnvironmentType    types.String            `tfsdk:"environment_type"`
+	OwnerTeamID        types.String            `tfsdk:"owner_team_id"`
+	Collaborators      types.Set               `tfsdk:"collaborator_team_ids"`
+	RunRetention       types.Int64             `tfsdk:"run_retention_days"`
+	LogRetention       types.Int64             `tfsdk:"log_retention_days"`
+	StateSharing       types.Object            `tfsdk:"state_sharing"`
+	ManagedExclusively types.Bool              `tfsdk:"managed_exclusively"`
+	Status             types.String            `tfsdk:"status"`
+	SourceCommit       types.String            `tfsdk:"source_commit"`
+	SourceSyncedAt     timeutil.TimestampValue `tfsdk:"source_synced_at"`
+	Health             types.String            `tfsdk:"health"`
+	DriftDetectedAt    timeutil.TimestampValue `tfsdk:"drift_detected_at"`
+	CreatedAt          timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt          timeutil.TimestampValue `tfsdk:"updated_at"`
+	CreatedBy          types.String            `tfsdk:"created_by"`
+	UpdatedBy          types.String            `tfsdk:"updated_by"`
+
+	WaitForReady             types.Bool  `tfsdk:"wait_for_ready"`
+	ReadyTimeoutSeconds      types.Int64 `tfsdk:"ready_timeout_seconds"`
+	ReadyPollIntervalSeconds types.Int64 `tfsdk:"ready_poll_interval_seconds"`
+	ForceDelete              types.Bool  `tfsdk:"force_delete"`
+	DetachBundlesOnDelete    types.Bool  `tfsdk:"detach_bundles_on_delete"`
+	AllowEngineMigration     types.Bool  `tfsdk:"allow_engine_migration"`
+	WaitForIdle              types.Bool  `tfsdk:"wait_for_idle"`
+	IdleTimeoutSeconds       types.Int64 `tfsdk:"idle_timeout_seconds"`
+
+	// Write-only source credentials: source_ssh_key is only ever set in the configuration.
+	SourceSSHKey        types.String `tfsdk:"source_ssh_key"`
+	SourceSSHKeyVersion types.Int64  `tfsdk:"source_ssh_key_version"`
+}
+
+// copyWaitSettings carries the provider-side readiness, idle, delete, and migration settings from src,
+// since mapStackToState only knows about fields returned by the API.
+func (m *StackModel) copyWaitSettings(src *StackModel) {
+	m.WaitForReady = src.WaitForReady
+	m.ReadyTimeoutSeconds = src.ReadyTimeoutSeconds
+	m.ReadyPollIntervalSeconds = src.ReadyPollIntervalSeconds
+	m.ForceDelete = src.ForceDelete
+	m.DetachBundlesOnDelete = src.DetachBundlesOnDelete
+	m.AllowEngineMigration = src.AllowEngineMigration
+	m.WaitForIdle = src.WaitForIdle
+	m.IdleTimeoutSeconds = src.IdleTimeoutSeconds
+}
+
+// keepEmptyCollections keeps an explicitly empty required_checks_before_destroy, hook
+// list, environment, collaborator_team_ids, state_sharing, or
+// state_sharing.allowed_stack_ids from src, which the API reports the same as an unset one.
+func (m *StackModel) keepEmptyCollections(src *StackModel) {
+	lists := []struct {
+		dst *types.List
+		src types.List
+	}{
+		{&m.RequiredChecks, src.RequiredChecks},
+		{&m.BeforeInit, src.BeforeInit},
+		{&m.BeforePlan, src.BeforePlan},
+		{&m.AfterApply, src.AfterApply},
+	}
+	for _, l := range lists {
+		if l.dst.IsNull() && isKnown(l.src) && len(l.src.Elements()) == 0 {
+			*l.dst = l.src
+		}
+	}
+	if m.Environment.IsNull() && isKnown(src.Environment) && len(src.Environment.Elements()) == 0 {
+		m.Environment = src.Environment
+	}
+	if m.Collaborators.IsNull() && isKnown(src.Collaborators) && len(src.Collaborators.Elements()) == 0 {
+		m.Collaborators = src.Collaborators
+	}
+	switch {
+	case m.StateSharing.IsNull() && sharesWithNoOne(src.StateSharing):
+		m.StateSharing = src.StateSharing
+	case !m.StateSharing.IsNull() && !m.StateSharing.IsUnknown() && hasEmptyAllowedStackIDs(src.StateSharing):
+		attrs := m.StateSharing.Attributes()
+		if stackIDs, _ := attrs["allowed_stack_ids"].(types.Set); stackIDs.IsNull() {
+			attrs["allowed_stack_ids"] = types.SetValueMust(types.StringType, nil)
+			m.StateSharing = types.ObjectValueMust(StateSharingModelAttrTypes, attrs)
+		}
+	}
+}
+
+// isKnown reports whether v is neither null nor unknown.
+func isKnown(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
+// hasEmptyAllowedStackIDs reports whether sharing is a known state_sharing whose
+// allowed_stack_ids is set to an empty set.
+func hasEmptyAllowedStackIDs(sharing types.Object) bool {
+	if sharing.IsNull() || sharing.IsUnknown() {
+		return false
+	}
+	stackIDs, _ := sharing.Attributes()["allowed_stack_ids"].(types.Set)
+	return !stackIDs.IsNull() && !stackIDs.IsUnknown() && len(stackIDs.Elements()) == 0
+}
+
+// sharesWithNoOne reports whether sharing is a known state_sharing that shares with no
+// other stack.
+func sharesWithNoOne(sharing types.Object) bool {
+	if sharing.IsNull() || sharing.IsUnknown() {
+		return false
+	}
+	attrs := sharing.Attributes()
+	withSpace, _ := attrs["share_with_space"].(types.Bool)
+	stackIDs, _ := attrs["allowed_stack_ids"].(types.Set)
+	return !withSpace.IsUnknown() && !withSpace.ValueBool() && !stackIDs.IsUnknown() && len(stackIDs.Elements()) == 0
 }
 
 // IACModel represents the IAC configuration.
 type IACModel struct {
-	Engine  types.String `tfsdk:"engine"`
-	Version types.String `tfsdk:"version"`
+	Engine  types.String    `tfsdk:"engine"`
+	Version IACVersionValue `tfsdk:"version"`
 }
 
 // RefModel represents a source reference (branch, tag, or commit).
 type RefModel struct {
 	Type types.String `tfsdk:"type"`
-	Name types.String `tfsdk:"name"`
+	Name RefNameValue `tfsdk:"name"`
 }
 
-// RawGitModel represents a raw HTTPS git source.
+// RawGitModel represents a git source cloned by URL.
 type RawGitModel struct {
-	URL  types.String `tfsdk:"url"`
-	Ref  types.Object `tfsdk:"ref"`
-	Path types.String `tfsdk:"path"`
+	URL              types.String    `tfsdk:"url"`
+	Ref              types.Object    `tfsdk:"ref"`
+	Path             SourcePathValue `tfsdk:"path"`
+	HTTPSCredentials types.Object    `tfsdk:"https_credentials"`
+}
+
+// HTTPSCredentialsModel represents the username and token of a private HTTPS raw_git source.
+type HTTPSCredentialsModel struct {
+	Username types.String `tfsdk:"username"`
+	Token    types.String `tfsdk:"token"`
 }
 
 // VCSModel represents an integration-backed VCS source.
 type VCSModel struct {
-	Provider      types.String `tfsdk:"provider"`
-	IntegrationID types.String `tfsdk:"integration_id"`
-	RepositoryID  types.String `tfsdk:"repository_id"`
-	Ref           types.Object `tfsdk:"ref"`
-	Path          types.String `tfsdk:"path"`
+	Provider      types.String    `tfsdk:"provider"`
+	IntegrationID types.String    `tfsdk:"integration_id"`
+	RepositoryID  types.String    `tfsdk:"repository_id"`
+	Ref           types.Object    `tfsdk:"ref"`
+	Path          SourcePathValue `tfsdk:"path"`
 }
 
 // SourceModel represents the stack source configuration.
@@ -61,32 +177,64 @@ type SourceModel struct {
 
 // OnPushModel represents the on_push trigger configuration.
 type OnPushModel struct {
-	Enabled types.Bool `tfsdk:"enabled"`
-	Paths   types.List `tfsdk:"paths"`
+	Enabled     types.Bool `tfsdk:"enabled"`
+	Paths       types.List `tfsdk:"paths"`
+	Branches    types.Set  `tfsdk:"branches"`
+	Tags        types.Set  `tfsdk:"tags"`
+	IgnorePaths types.Set  `tfsdk:"ignore_paths"`
+}
+
+// OnPullRequestModel represents the on_pull_request trigger configuration.
+type OnPullRequestModel struct {
+	Enabled         types.Bool `tfsdk:"enabled"`
+	SpeculativeOnly types.Bool `tfsdk:"speculative_only"`
+	CommentResults  types.Bool `tfsdk:"comment_results"`
+	Paths           types.Set  `tfsdk:"paths"`
+}
+
+// OnScheduleModel represents an entry of the on_schedule trigger list.
+type OnScheduleModel struct {
+	Cron    types.String `tfsdk:"cron"`
+	RunType types.String `tfsdk:"run_type"`
+}
+
+// StateSharingModel represents the stacks that may read the stack's outputs and state.
+type StateSharingModel struct {
+	ShareWithSpace  types.Bool `tfsdk:"share_with_space"`
+	AllowedStackIDs types.Set  `tfsdk:"allowed_stack_ids"`
 }
 
 // TriggersModel represents the stack trigger configuration.
 type TriggersModel struct {
-	OnPush types.Object `tfsdk:"on_push"`
+	OnPush        types.Object `tfsdk:"on_push"`
+	OnPullRequest types.Object `tfsdk:"on_pull_request"`
+	OnSchedule    types.List   `tfsdk:"on_schedule"`
 }
 
 // IACModelAttrTypes defines the attribute types for IACModel.
 var IACModelAttrTypes = map[string]attr.Type{
 	"engine":  types.StringType,
-	"version": types.StringType,
+	"version": IACVersionType{},
 }
 
 // RefModelAttrTypes defines the attribute types for RefModel.
 var RefModelAttrTypes = map[string]attr.Type{
 	"type": types.StringType,
-	"name": types.StringType,
+	"name": RefNameType{},
+}
+
+// HTTPSCredentialsModelAttrTypes defines the attribute types for HTTPSCredentialsModel.
+var HTTPSCredentialsModelAttrTypes = map[string]attr.Type{
+	"username": types.StringType,
+	"token":    types.StringType,
 }
 
 // RawGitModelAttrTypes defines the attribute types for RawGitModel.
 var RawGitModelAttrTypes = map[string]attr.Type{
-	"url":  types.StringType,
-	"ref":  types.ObjectType{AttrTypes: RefModelAttrTypes},
-	"path": types.StringType,
+	"url":               types.StringType,
+	"ref":               types.ObjectType{AttrTypes: RefModelAttrTypes},
+	"path":              SourcePathType{},
+	"https_credentials": types.ObjectType{AttrTypes: HTTPSCredentialsModelAttrTypes},
 }
 
 // VCSModelAttrTypes defines the attribute types for VCSModel.
@@ -95,7 +243,7 @@ var VCSModelAttrTypes = map[string]attr.Type{
 	"integration_id": types.StringType,
 	"repository_id":  types.StringType,
 	"ref":            types.ObjectType{AttrTypes: RefModelAttrTypes},
-	"path":           types.StringType,
+	"path":           SourcePathType{},
 }
 
 // SourceModelAttrTypes defines the attribute types for SourceModel.
@@ -107,11 +255,36 @@ var SourceModelAttrTypes = map[string]attr.Type{
 
 // OnPushModelAttrTypes defines the attribute types for OnPushModel.
 var OnPushModelAttrTypes = map[string]attr.Type{
-	"enabled": types.BoolType,
-	"paths":   types.ListType{ElemType: types.StringType},
+	"enabled":      types.BoolType,
+	"paths":        types.ListType{ElemType: types.StringType},
+	"branches":     types.SetType{ElemType: types.StringType},
+	"tags":         types.SetType{ElemType: types.StringType},
+	"ignore_paths": types.SetType{ElemType: types.StringType},
+}
+
+// OnPullRequestModelAttrTypes defines the attribute types for OnPullRequestModel.
+var OnPullRequestModelAttrTypes = map[string]attr.Type{
+	"enabled":          types.BoolType,
+	"speculative_only": types.BoolType,
+	"comment_results":  types.BoolType,
+	"paths":            types.SetType{ElemType: types.StringType},
+}
+
+// OnScheduleModelAttrTypes defines the attribute types for OnScheduleModel.
+var OnScheduleModelAttrTypes = map[string]attr.Type{
+	"cron":     types.StringType,
+	"run_type": types.StringType,
 }
 
 // TriggersModelAttrTypes defines the attribute types for TriggersModel.
 var TriggersModelAttrTypes = map[string]attr.Type{
-	"on_push": types.ObjectType{AttrTypes: OnPushModelAttrTypes},
+	"on_push":         types.ObjectType{AttrTypes: OnPushModelAttrTypes},
+	"on_pull_request": types.ObjectType{AttrTypes: OnPullRequestModelAttrTypes},
+	"on_schedule":     types.ListType{ElemType: types.ObjectType{AttrTypes: OnScheduleModelAttrTypes}},
+}
+
+// StateSharingModelAttrTypes defines the attribute types for StateSharingModel.
+var StateSharingModelAttrTypes = map[string]attr.Type{
+	"share_with_space":  types.BoolType,
+	"allowed_stack_ids": types.SetType{ElemType: types.StringType},
 }
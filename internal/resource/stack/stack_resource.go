@@ -5,23 +5,45 @@ package stack
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/idlewait"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/managedlock"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/retention"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &StackResource{}
-	_ resource.ResourceWithImportState = &StackResource{}
+	_ resource.Resource                   = &StackResource{}
+	_ resource.ResourceWithImportState    = &StackResource{}
+	_ resource.ResourceWithValidateConfig = &StackResource{}
+	_ resource.ResourceWithModifyPlan     = &StackResource{}
+)
+
+const (
+	defaultReadyTimeout      = 10 * time.Minute
+	defaultReadyPollInterval = 5 * time.Second
 )
 
 // NewStackResource is a helper function to simplify the provider implementation.
@@ -31,7 +53,7 @@ func NewStackResource() resource.Resource {
 
 // StackResource is the resource implementation.
 type StackResource struct {
-	client *zenfraclient.Client
+	client zenfraclient.StackAPI
 }
 
 // Metadata returns the resource type name.
@@ -52,10 +74,13 @@ func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 			"organization_id": schema.StringAttribute{
-				Description: "The organization ID this stack belongs to.",
-				Computed:    true,
+				Description: "The organization ID this stack belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the stack in another organization the token has access to. Changing it forces a new stack.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"space_id": schema.StringAttribute{
@@ -83,31 +108,64 @@ func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 				Attributes: map[string]schema.Attribute{
 					"engine": schema.StringAttribute{
-						Description: "IaC engine (e.g., 'terraform', 'opentofu').",
-						Required:    true,
+						Description: "IaC engine: 'terraform' or 'opentofu', in any letter case. Changing the engine of an existing stack migrates its state " +
+							"and requires allow_engine_migration = true.",
+						Required: true,
+						Validators: []validator.String{
+							validators.OneOfCaseInsensitive(zenfraclient.IACEngineTerraform, zenfraclient.IACEngineOpenTofu),
+						},
+						PlanModifiers: []planmodifier.String{
+							engineMigrationGuard{},
+						},
 					},
 					"version": schema.StringAttribute{
-						Description: "IaC engine version.",
+						Description: "IaC engine version. Equivalent spellings such as '1.6', 'v1.6', and '1.6.0' do not produce a diff.",
+						CustomType:  IACVersionType{},
 						Required:    true,
 					},
 				},
 			},
 			"source": schema.SingleNestedAttribute{
-				Description: "Stack source configuration (raw_git or vcs).",
-				Required:    true,
+				Description: "Stack source configuration (raw_git or vcs). Required unless template_id is set, in which case the template's source is used.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
 						Description: "Source type: 'raw_git' or 'vcs'.",
 						Required:    true,
+						Validators: []validator.String{
+							validators.OneOf(zenfraclient.StackSourceTypeRawGit, zenfraclient.StackSourceTypeVCS),
+						},
 					},
 					"raw_git": schema.SingleNestedAttribute{
-						Description: "Raw HTTPS git source configuration.",
-						Optional:    true,
+						Description: "Git source cloned by URL, without a VCS integration. Public repositories need no credentials; " +
+							"private ones set https_credentials, or source_ssh_key with an SSH url.",
+						Optional: true,
 						Attributes: map[string]schema.Attribute{
 							"url": schema.StringAttribute{
-								Description: "Git repository HTTPS URL.",
+								Description: "Git repository URL: an HTTPS URL, or with source_ssh_key an SSH URL such as 'git@github.com:org/repo.git'.",
 								Required:    true,
 							},
+							"https_credentials": schema.SingleNestedAttribute{
+								Description: "Username and access token to clone a private repository from an HTTPS url. " +
+									"The API never returns the token, so changes to it made outside Terraform are not detected. " +
+									"Conflicts with source_ssh_key.",
+								Optional: true,
+								Attributes: map[string]schema.Attribute{
+									"username": schema.StringAttribute{
+										Description: "Username to authenticate as. Git hosts that authenticate by token alone accept any non-empty username.",
+										Required:    true,
+									},
+									"token": schema.StringAttribute{
+										Description: "Access token or password.",
+										Required:    true,
+										Sensitive:   true,
+									},
+								},
+							},
 							"ref": schema.SingleNestedAttribute{
 								Description: "Git reference (branch, tag, or commit).",
 								Required:    true,
@@ -115,15 +173,20 @@ func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 									"type": schema.StringAttribute{
 										Description: "Reference type: 'branch', 'tag', or 'commit'.",
 										Required:    true,
+										Validators: []validator.String{
+											validators.OneOf(zenfraclient.SourceRefBranch, zenfraclient.SourceRefTag, zenfraclient.SourceRefCommit),
+										},
 									},
 									"name": schema.StringAttribute{
-										Description: "Reference name (branch name, tag name, or commit SHA).",
+										Description: "Reference name (branch name, tag name, or commit SHA). Compared case-insensitively.",
+										CustomType:  RefNameType{},
 										Required:    true,
 									},
 								},
 							},
 							"path": schema.StringAttribute{
-								Description: "Optional path within the repository.",
+								Description: "Optional path within the repository. Leading './' and surrounding slashes are ignored when comparing; omit to use the repository root.",
+								CustomType:  SourcePathType{},
 								Optional:    true,
 							},
 						},
@@ -135,6 +198,9 @@ func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 							"provider": schema.StringAttribute{
 								Description: "VCS provider: 'github' or 'gitlab'.",
 								Required:    true,
+								Validators: []validator.String{
+									validators.OneOf(zenfraclient.VCSProviderGitHub, zenfraclient.VCSProviderGitLab),
+								},
 							},
 							"integration_id": schema.StringAttribute{
 								Description: "VCS integration ID.",
@@ -151,47 +217,306 @@ func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 									"type": schema.StringAttribute{
 										Description: "Reference type: 'branch', 'tag', or 'commit'.",
 										Required:    true,
+										Validators: []validator.String{
+											validators.OneOf(zenfraclient.SourceRefBranch, zenfraclient.SourceRefTag, zenfraclient.SourceRefCommit),
+										},
 									},
 									"name": schema.StringAttribute{
-										Description: "Reference name (branch name, tag name, or commit SHA).",
+										Description: "Reference name (branch name, tag name, or commit SHA). Compared case-insensitively.",
+										CustomType:  RefNameType{},
 										Required:    true,
 									},
 								},
 							},
 							"path": schema.StringAttribute{
-								Description: "Optional path within the repository.",
+								Description: "Optional path within the repository. Leading './' and surrounding slashes are ignored when comparing; omit to use the repository root.",
+								CustomType:  SourcePathType{},
 								Optional:    true,
 							},
 						},
 					},
 				},
 			},
+			"source_ssh_key": schema.StringAttribute{
+				Description: "Private SSH key, in OpenSSH or PEM format, to clone a private raw_git repository from an SSH url. " +
+					"Write-only: Terraform never stores it in plan or state, so a changed key is only sent when source or " +
+					"source_ssh_key_version changes. Requires Terraform 1.11 or later. Conflicts with source.raw_git.https_credentials.",
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"source_ssh_key_version": schema.Int64Attribute{
+				Description: "Version of source_ssh_key. Changing it sends source_ssh_key to the API again, e.g. to rotate the key.",
+				Optional:    true,
+			},
 			"triggers": schema.SingleNestedAttribute{
 				Description: "Stack trigger configuration.",
 				Optional:    true,
 				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
 				Attributes: map[string]schema.Attribute{
 					"on_push": schema.SingleNestedAttribute{
 						Description: "Push-based trigger configuration.",
 						Optional:    true,
 						Computed:    true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
 						Attributes: map[string]schema.Attribute{
 							"enabled": schema.BoolAttribute{
 								Description: "Whether push triggers are enabled.",
 								Optional:    true,
 								Computed:    true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
 							},
 							"paths": schema.ListAttribute{
 								Description: "Optional list of paths to watch for changes.",
 								Optional:    true,
 								ElementType: types.StringType,
 							},
+							"branches": schema.SetAttribute{
+								Description: "Optional set of branch glob patterns that trigger runs (e.g., 'main', 'release/*').",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"tags": schema.SetAttribute{
+								Description: "Optional set of tag glob patterns that trigger runs (e.g., 'v*').",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+							"ignore_paths": schema.SetAttribute{
+								Description: "Optional set of path glob patterns whose changes never trigger runs.",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+						},
+					},
+					"on_pull_request": schema.SingleNestedAttribute{
+						Description: "Pull request (merge request) trigger configuration.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"enabled": schema.BoolAttribute{
+								Description: "Whether pull request triggers are enabled.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"speculative_only": schema.BoolAttribute{
+								Description: "Whether pull request runs are limited to speculative plans that never apply.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"comment_results": schema.BoolAttribute{
+								Description: "Whether run results are posted back to the pull request as a comment.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"paths": schema.SetAttribute{
+								Description: "Optional set of path glob patterns a pull request must touch to trigger a run.",
+								Optional:    true,
+								ElementType: types.StringType,
+							},
+						},
+					},
+					"on_schedule": schema.ListNestedAttribute{
+						Description: "Optional schedules on which the stack runs periodically, e.g. to re-apply it and correct drift.",
+						Optional:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"cron": schema.StringAttribute{
+									Description: "Five-field cron expression (minute hour day-of-month month day-of-week) of the times a run starts, in UTC, e.g. '0 3 * * MON-FRI'.",
+									Required:    true,
+									Validators: []validator.String{
+										validators.Cron(),
+									},
+								},
+								"run_type": schema.StringAttribute{
+									Description: "The run to start: 'plan' for a plan only, 'apply' for a plan that is applied like a tracked run.",
+									Required:    true,
+									Validators: []validator.String{
+										validators.OneOf(zenfraclient.ScheduleRunTypePlan, zenfraclient.ScheduleRunTypeApply),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
+			"template_id": schema.StringAttribute{
+				Description: "ID of a stack template to initialize the stack from, see the zenfra_stack_templates data source. " +
+					"The template supplies the stack's source. Conflicts with source. Changing it recreates the stack.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"runner_image": schema.StringAttribute{
+				Description: "Optional container image used to execute runs, replacing the default Zenfra runner image. Cannot be empty.",
+				Optional:    true,
+			},
+			"before_init": schema.ListAttribute{
+				Description: "Optional shell commands executed, in order, before the IaC engine is initialized.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"before_plan": schema.ListAttribute{
+				Description: "Optional shell commands executed, in order, before planning (e.g., 'tfsec .').",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"after_apply": schema.ListAttribute{
+				Description: "Optional shell commands executed, in order, after a successful apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"environment": schema.MapAttribute{
+				Description: "Optional non-secret environment variables set for every run and hook. Use zenfra_stack_variables for secrets.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"environment_type": schema.StringAttribute{
+				Description: "Optional environment tier of the stack, such as \"production\", \"staging\", or \"development\". " +
+					"Any value is accepted; filter on it with the zenfra_stacks data source. " +
+					"Not to be confused with environment, which sets run environment variables.",
+				Optional: true,
+			},
+			"owner_team_id": schema.StringAttribute{
+				Description: "Optional ID of the team that owns the stack and is paged when its runs fail. " +
+					"Stacks without an owner get a warning at plan time, since failed runs on them reach no one.",
+				Optional: true,
+			},
+			"collaborator_team_ids": schema.SetAttribute{
+				Description: "Optional IDs of further teams notified about the stack's failed runs.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"required_checks_before_destroy": schema.ListAttribute{
+				Description: "Optional policy IDs and run types that must have passed on the stack's latest run before Zenfra accepts a destroy run, " +
+					"protecting production stacks from being destroyed while checks are failing. Remove it to lift the protection.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"run_retention_days": schema.Int64Attribute{
+				Description: "Optional number of days the stack's runs are kept, overriding the organization's zenfra_retention_settings. " +
+					"Must not exceed the retention limit of the organization's plan. Remove it to use the organization's setting.",
+				Optional: true,
+			},
+			"log_retention_days": schema.Int64Attribute{
+				Description: "Optional number of days the stack's run logs are kept, overriding the organization's zenfra_retention_settings. " +
+					"Must not exceed the retention limit of the organization's plan. Remove it to use the organization's setting.",
+				Optional: true,
+			},
+			"state_sharing": schema.SingleNestedAttribute{
+				Description: "Optional other stacks that may read the stack's outputs and state, e.g. through a terraform_remote_state " +
+					"data source or zenfra_output_subscription. Remove it to share with no other stack.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"share_with_space": schema.BoolAttribute{
+						Description: "Let every stack in the same space read the state. Defaults to false.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"allowed_stack_ids": schema.SetAttribute{
+						Description: "IDs of further stacks, in any space, that may read the state.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
+				},
+			},
+			"managed_exclusively": managedlock.Attribute("stack"),
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "Wait after creating the stack until Zenfra has cloned and validated its source, so runs can be triggered " +
+					"immediately. Creation fails, leaving the stack tainted, if the stack reports a failed status or the timeout elapses.",
+				Optional: true,
+			},
+			"ready_timeout_seconds": schema.Int64Attribute{
+				Description: "Maximum time to wait for the stack to become ready when wait_for_ready is true. Defaults to 600.",
+				Optional:    true,
+			},
+			"ready_poll_interval_seconds": schema.Int64Attribute{
+				Description: "Interval between status checks when wait_for_ready is true. Defaults to 5.",
+				Optional:    true,
+			},
+			"force_delete": schema.BoolAttribute{
+				Description: "Cancel queued and running runs when the stack is destroyed. Without it, destroying a stack with active runs fails. " +
+					"Set it and apply before destroying for it to take effect. Defaults to false.",
+				Optional: true,
+			},
+			"detach_bundles_on_delete": schema.BoolAttribute{
+				Description: "Detach attached configuration bundles when the stack is destroyed, instead of failing while bundles are still attached. " +
+					"Set it and apply before destroying for it to take effect. Defaults to false.",
+				Optional: true,
+			},
+			"allow_engine_migration": schema.BoolAttribute{
+				Description: "Permit changing iac.engine on an existing stack, which migrates the stack's state to the new engine on the server. " +
+					"Without it, an engine change fails at plan time. Defaults to false.",
+				Optional: true,
+			},
+			idlewait.EnabledAttribute: schema.BoolAttribute{
+				Description: "When a change to the stack's source or triggers is rejected because a run is in progress, wait until the " +
+					"stack has no queued or running runs and retry it, instead of failing the apply. Defaults to false.",
+				Optional: true,
+			},
+			idlewait.TimeoutAttribute: schema.Int64Attribute{
+				Description: "Maximum time an update waits for the stack's runs to finish when wait_for_idle is true. Defaults to 1800.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Readiness status of the stack: pending while its source is cloned and validated, then ready or failed.",
+				Computed:    true,
+			},
+			"source_commit": schema.StringAttribute{
+				Description: "The commit SHA the source ref resolved to when Zenfra last synced the stack's source, as of the last refresh. " +
+					"For a branch, it changes when the stack picks up a new commit. Null until the first sync.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_synced_at": schema.StringAttribute{
+				Description: "Timestamp when Zenfra last synced the stack's source, as of the last refresh. Null until the first sync.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"health": schema.StringAttribute{
+				Description: "Operational state of the stack as of the last refresh: ok, drifted when drift detection found changes, " +
+					"failed when the latest run failed, or locked. Null if the API does not report it.",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"drift_detected_at": schema.StringAttribute{
+				Description: "Timestamp when drift detection last found changes, as of the last refresh. Null once a run reconciles the drift.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the stack was created.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -199,6 +524,7 @@ func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			},
 			"updated_at": schema.StringAttribute{
 				Description: "Timestamp when the stack was last updated.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 			},
 			"created_by": schema.StringAttribute{
@@ -216,22 +542,138 @@ func (r *StackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *StackResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ValidateConfig checks that the stack has exactly one of source and template_id, the
+// readiness polling settings, the required destroy checks, the runner image, the
+// environment type, the owning and collaborating teams, and the retention periods.
+func (r *StackResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config StackModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	switch {
+	case !config.Source.IsNull() && !config.TemplateID.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("template_id"), "Conflicting Stack Source",
+			"source and template_id cannot both be set; the template supplies the stack's source.")
+	case config.Source.IsNull() && config.TemplateID.IsNull():
+		resp.Diagnostics.AddAttributeError(path.Root("source"), "Missing Stack Source",
+			"Either source or template_id must be set.")
+	}
+
+	if !config.ReadyTimeoutSeconds.IsNull() && !config.ReadyTimeoutSeconds.IsUnknown() && config.ReadyTimeoutSeconds.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("ready_timeout_seconds"), "Invalid Ready Timeout",
+			"ready_timeout_seconds must be at least 1.")
+	}
+	if !config.ReadyPollIntervalSeconds.IsNull() && !config.ReadyPollIntervalSeconds.IsUnknown() && config.ReadyPollIntervalSeconds.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("ready_poll_interval_seconds"), "Invalid Ready Poll Interval",
+			"ready_poll_interval_seconds must be at least 1.")
+	}
+	idlewait.ValidateConfig(config.IdleTimeoutSeconds, &resp.Diagnostics)
+	validateSourceCredentials(ctx, config.Source, config.SourceSSHKey, &resp.Diagnostics)
+
+	var checks []types.String
+	if !config.RequiredChecks.IsNull() && !config.RequiredChecks.IsUnknown() {
+		resp.Diagnostics.Append(config.RequiredChecks.ElementsAs(ctx, &checks, false)...)
+	}
+	seen := map[string]bool{}
+	for i, check := range checks {
+		if check.IsNull() || check.IsUnknown() {
+			continue
+		}
+		p := path.Root("required_checks_before_destroy").AtListIndex(i)
+		switch name := check.ValueString(); {
+		case strings.TrimSpace(name) == "":
+			resp.Diagnostics.AddAttributeError(p, "Invalid Required Check", "Required checks cannot be empty.")
+		case seen[name]:
+			resp.Diagnostics.AddAttributeError(p, "Duplicate Required Check",
+				fmt.Sprintf("%q is listed more than once in required_checks_before_destroy.", name))
+		}
+		seen[check.ValueString()] = true
+	}
+
+	if !config.RunnerImage.IsNull() && !config.RunnerImage.IsUnknown() && strings.TrimSpace(config.RunnerImage.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("runner_image"), "Invalid Runner Image",
+			"runner_image cannot be empty; remove the attribute to run on the default Zenfra runner image.")
+	}
+
+	if !config.EnvironmentType.IsNull() && !config.EnvironmentType.IsUnknown() && strings.TrimSpace(config.EnvironmentType.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("environment_type"), "Invalid Environment Type",
+			"environment_type cannot be empty; remove the attribute to leave the stack without an environment type.")
+	}
+
+	if !config.OwnerTeamID.IsNull() && !config.OwnerTeamID.IsUnknown() && strings.TrimSpace(config.OwnerTeamID.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("owner_team_id"), "Invalid Owner Team",
+			"owner_team_id cannot be empty; remove the attribute to leave the stack without an owner.")
+	}
+
+	var collaborators []types.String
+	if !config.Collaborators.IsNull() && !config.Collaborators.IsUnknown() {
+		resp.Diagnostics.Append(config.Collaborators.ElementsAs(ctx, &collaborators, false)...)
+	}
+	for _, team := range collaborators {
+		if team.IsNull() || team.IsUnknown() {
+			continue
+		}
+		p := path.Root("collaborator_team_ids").AtSetValue(team)
+		switch id := team.ValueString(); {
+		case strings.TrimSpace(id) == "":
+			resp.Diagnostics.AddAttributeError(p, "Invalid Collaborator Team", "Collaborator team IDs cannot be empty.")
+		case id == config.OwnerTeamID.ValueString():
+			resp.Diagnostics.AddAttributeError(p, "Owner Listed As Collaborator",
+				fmt.Sprintf("Team %q already owns the stack; remove it from collaborator_team_ids.", id))
+		}
+	}
+
+	retention.ValidateConfig(config.RunRetention, config.LogRetention, &resp.Diagnostics)
+}
+
+// ModifyPlan warns when the API token may not manage stacks and when a stack being
+// created or changed has no owning team. Unchanged stacks are not warned about, so
+// plans stay quiet for stacks no one is touching. New retention periods are checked
+// against the plan of the stack's organization.
+func (r *StackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_stack", "stack", req, resp)
+
+	if req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
 		return
 	}
+	planSourceSync(ctx, req, resp)
+	var orgID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization_id"), &orgID)...)
+	retention.CheckPlanLimits(zenfraclient.WithOrganization(ctx, orgID.ValueString()), r.client, req, resp)
+	var owner types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("owner_team_id"), &owner)...)
+	if resp.Diagnostics.HasError() || !owner.IsNull() {
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(path.Root("owner_team_id"), "Stack Has No Owner",
+		"This stack has no owner_team_id, so no team is paged when its runs fail. "+
+			"Set owner_team_id to the team responsible for the stack.")
+}
 
-	r.client = client
+// planSourceSync marks source_commit and source_synced_at unknown when the plan changes
+// the stack's source, which makes Zenfra sync it again. Otherwise both keep their prior
+// values and are refreshed on read.
+func planSourceSync(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var planned, prior types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("source"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("source"), &prior)...)
+	if resp.Diagnostics.HasError() || planned.Equal(prior) {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_commit"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_synced_at"), timeutil.NewTimestampUnknown())...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *StackResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_stack")
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -243,6 +685,8 @@ func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
 	// Extract IAC configuration
 	var iacModel IACModel
 	diags = plan.IAC.As(ctx, &iacModel, basetypes.ObjectAsOptions{})
@@ -251,20 +695,6 @@ func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Extract source configuration
-	var sourceModel SourceModel
-	diags = plan.Source.As(ctx, &sourceModel, basetypes.ObjectAsOptions{})
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	source, diags := buildSourceFromModel(ctx, &sourceModel)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
 	// Build create request
 	createReq := zenfraclient.CreateStackRequest{
 		SpaceID:         plan.SpaceID.ValueString(),
@@ -274,7 +704,29 @@ func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest,
 			Engine:  iacModel.Engine.ValueString(),
 			Version: iacModel.Version.ValueString(),
 		},
-		Source: *source,
+	}
+
+	// Extract source configuration; stacks created from a template take the template's source.
+	if !plan.Source.IsNull() && !plan.Source.IsUnknown() {
+		var sourceModel SourceModel
+		diags = plan.Source.As(ctx, &sourceModel, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		source, diags := buildSourceFromModel(ctx, &sourceModel)
+		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.Append(setSourceSSHKey(ctx, req.Config, source)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		createReq.Source = source
+	}
+
+	if !plan.TemplateID.IsNull() {
+		templateID := plan.TemplateID.ValueString()
+		createReq.TemplateID = &templateID
 	}
 
 	if !plan.WorkerPoolID.IsNull() {
@@ -282,6 +734,35 @@ func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest,
 		createReq.WorkerPoolID = &poolID
 	}
 
+	if !plan.RunnerImage.IsNull() {
+		image := plan.RunnerImage.ValueString()
+		createReq.RunnerImage = &image
+	}
+
+	hooks, diags := buildHooksFromModel(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.Hooks = hooks
+
+	diags = plan.Environment.ElementsAs(ctx, &createReq.Environment, false)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.RequiredChecks.ElementsAs(ctx, &createReq.RequiredChecksBeforeDestroy, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createReq.EnvironmentType = plan.EnvironmentType.ValueString()
+	createReq.OwnerTeamID = plan.OwnerTeamID.ValueString()
+	createReq.RunRetentionDays = plan.RunRetention.ValueInt64()
+	createReq.LogRetentionDays = plan.LogRetention.ValueInt64()
+	createReq.StateSharing, diags = buildStateSharingFromModel(ctx, plan.StateSharing)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(plan.Collaborators.ElementsAs(ctx, &createReq.CollaboratorTeamIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Create the stack
 	stack, err := r.client.CreateStack(ctx, createReq)
 	if err != nil {
@@ -307,7 +788,9 @@ func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 
-		err = r.client.SetStackTriggers(ctx, stack.ID, *triggers)
+		err = idlewait.Do(ctx, r.client, stack.ID, plan.WaitForIdle, plan.IdleTimeoutSeconds, func(ctx context.Context) error {
+			return r.client.SetStackTriggers(ctx, stack.ID, *triggers)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Setting Stack Triggers",
@@ -317,15 +800,43 @@ func (r *StackResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 	}
 
+	var waitErr error
+	if plan.WaitForReady.ValueBool() {
+		timeout, interval := readyWaitSettings(&plan)
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		ready, err := r.client.WaitForStackReady(waitCtx, stack.ID, interval)
+		cancel()
+		if ready != nil {
+			stack = ready
+		}
+		waitErr = err
+	}
+
 	// Map response to state
 	state, diags := mapStackToState(ctx, stack)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	state.copyWaitSettings(&plan)
+	state.keepEmptyCollections(&plan)
+	resp.Diagnostics.Append(state.keepSourceCredentials(ctx, &plan)...)
+	r.readHealth(ctx, stack, false, state, &resp.Diagnostics)
+	state.ManagedExclusively = managedlock.Apply(ctx, r.client.SetStackManagedLock, "stack", stack.ID,
+		plan.ManagedExclusively, state.ManagedExclusively, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 
+	// Save state even if the stack never became ready, so Terraform taints it instead of losing track of it.
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
+
+	if waitErr != nil {
+		resp.Diagnostics.AddError(
+			"Error Waiting for Stack",
+			fmt.Sprintf("Stack %s was created but did not become ready: %s", stack.ID, waitErr.Error()),
+		)
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -337,14 +848,25 @@ func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	// Get the stack from the API
-	stack, err := r.client.GetStack(ctx, state.ID.ValueString())
+	stack, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.Stack, error) {
+		return r.client.GetStackCached(ctx, state.ID.ValueString())
+	})
 	if err != nil {
-		if zenfraclient.IsNotFound(err) {
+		if r.client.IsNotFoundOnRead(err) {
 			// Stack no longer exists, remove from state
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError(
+				"Error Reading Stack",
+				fmt.Sprintf("Could not read stack ID %s: %s\n\n%s", state.ID.ValueString(), err.Error(), zenfraclient.ForbiddenReadHint),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Stack",
 			fmt.Sprintf("Could not read stack ID %s: %s", state.ID.ValueString(), err.Error()),
@@ -358,6 +880,12 @@ func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	newState.copyWaitSettings(&state)
+	newState.keepEmptyCollections(&state)
+	resp.Diagnostics.Append(newState.keepSourceCredentials(ctx, &state)...)
+	newState.Health, newState.DriftDetectedAt = state.Health, state.DriftDetectedAt
+	r.readHealth(ctx, stack, true, newState, &resp.Diagnostics)
+	newState.ManagedExclusively = managedlock.Refresh(stack.ManagedLock, state.ManagedExclusively, "stack", stack.ID, &resp.Diagnostics)
 
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
@@ -365,7 +893,9 @@ func (r *StackResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 // Update updates the resource and sets the updated Terraform state on success.
 //
-//nolint:gocognit,gocyclo // Terraform CRUD with source, triggers, and base field updates
+// The new state is composed from the responses of the endpoints actually called:
+// UpdateStack returns the whole stack, so it is only read back after a triggers-only
+// change, and a change to provider-side settings alone makes no API calls at all.
 func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state StackModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -376,60 +906,137 @@ func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Check for source changes
-	if !plan.Source.Equal(state.Source) {
-		var sourceModel SourceModel
-		diags = plan.Source.As(ctx, &sourceModel, basetypes.ObjectAsOptions{})
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	updateReq, hasChanges, diags := buildStackUpdate(ctx, &plan, &state)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(setSourceSSHKey(ctx, req.Config, updateReq.Source)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Triggers have their own endpoint. Setting them first lets the UpdateStack
+	// response below reflect them.
+	plan.Triggers, diags = withPriorTriggers(ctx, plan.Triggers, state.Triggers)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	triggersChanged := !plan.Triggers.IsUnknown() && !plan.Triggers.Equal(state.Triggers)
+	if triggersChanged {
+		var triggersModel TriggersModel
+		diags = plan.Triggers.As(ctx, &triggersModel, basetypes.ObjectAsOptions{})
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		source, diags := buildSourceFromModel(ctx, &sourceModel)
+		triggers, diags := buildTriggersFromModel(ctx, &triggersModel)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		err := r.client.SetStackSource(ctx, state.ID.ValueString(), *source)
+		err := idlewait.Do(ctx, r.client, state.ID.ValueString(), plan.WaitForIdle, plan.IdleTimeoutSeconds, func(ctx context.Context) error {
+			return r.client.SetStackTriggers(ctx, state.ID.ValueString(), *triggers)
+		})
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Error Updating Stack Source",
-				fmt.Sprintf("Could not update stack source: %s", err.Error()),
+				"Error Updating Stack Triggers",
+				fmt.Sprintf("Could not update stack triggers: %s", err.Error()),
 			)
 			return
 		}
 	}
 
-	// Check for trigger changes
-	if !plan.Triggers.IsUnknown() && !plan.Triggers.Equal(state.Triggers) {
-		var triggersModel TriggersModel
-		diags = plan.Triggers.As(ctx, &triggersModel, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-
-		triggers, diags := buildTriggersFromModel(ctx, &triggersModel)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+	var stack *zenfraclient.Stack
+	switch {
+	case hasChanges:
+		var updated *zenfraclient.Stack
+		err := idlewait.Do(ctx, r.client, state.ID.ValueString(), plan.WaitForIdle, plan.IdleTimeoutSeconds, func(ctx context.Context) error {
+			var err error
+			updated, err = r.client.UpdateStack(ctx, state.ID.ValueString(), updateReq)
+			return err
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Stack",
+				fmt.Sprintf("Could not update stack ID %s: %s", state.ID.ValueString(), err.Error()),
+			)
 			return
 		}
-
-		err := r.client.SetStackTriggers(ctx, state.ID.ValueString(), *triggers)
+		stack = updated
+	case triggersChanged:
+		// The triggers endpoint returns no body, so read the stack back.
+		current, err := r.client.GetStack(ctx, state.ID.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
-				"Error Updating Stack Triggers",
-				fmt.Sprintf("Could not update stack triggers: %s", err.Error()),
+				"Error Reading Updated Stack",
+				fmt.Sprintf("Could not read stack ID %s: %s", state.ID.ValueString(), err.Error()),
 			)
 			return
 		}
+		stack = current
+	}
+
+	// Only provider-side settings changed: the stack is as last read.
+	newState := &state
+	if stack != nil {
+		newState, diags = mapStackToState(ctx, stack)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	}
+	if updateReq.IAC == nil {
+		// Keep the configured spelling of an unchanged engine and version.
+		newState.IAC = plan.IAC
 	}
+	newState.copyWaitSettings(&plan)
+	newState.keepEmptyCollections(&plan)
+	resp.Diagnostics.Append(newState.keepSourceCredentials(ctx, &plan)...)
+	// An update does not change the stack's health; it is refreshed on the next read.
+	newState.Health, newState.DriftDetectedAt = state.Health, state.DriftDetectedAt
+	// Neither does it sync an unchanged source; see planSourceSync.
+	if plan.Source.Equal(state.Source) {
+		newState.SourceCommit, newState.SourceSyncedAt = state.SourceCommit, state.SourceSyncedAt
+	}
+	newState.ManagedExclusively = managedlock.Apply(ctx, r.client.SetStackManagedLock, "stack", state.ID.ValueString(),
+		plan.ManagedExclusively, state.ManagedExclusively, &resp.Diagnostics)
+
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
 
-	// Build update request for changed base fields
+// buildStackUpdate returns the UpdateStack request for the fields that differ between
+// plan and state, and whether there are any. The source is sent along with the base
+// fields rather than through its own endpoint, so one call covers both.
+//
+//nolint:gocognit,gocyclo // one branch per updatable field
+func buildStackUpdate(ctx context.Context, plan, state *StackModel) (zenfraclient.UpdateStackRequest, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	updateReq := zenfraclient.UpdateStackRequest{}
 	hasChanges := false
 
+	// A new source_ssh_key_version resends the source so it carries the current key.
+	sourceChanged := !plan.Source.Equal(state.Source) || !plan.SourceSSHKeyVersion.Equal(state.SourceSSHKeyVersion)
+	if !plan.Source.IsUnknown() && !plan.Source.IsNull() && sourceChanged {
+		var sourceModel SourceModel
+		diags.Append(plan.Source.As(ctx, &sourceModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return updateReq, false, diags
+		}
+
+		source, d := buildSourceFromModel(ctx, &sourceModel)
+		diags.Append(d...)
+		if diags.HasError() {
+			return updateReq, false, diags
+		}
+		updateReq.Source = source
+		hasChanges = true
+	}
+
 	if !plan.Name.Equal(state.Name) {
 		name := plan.Name.ValueString()
 		updateReq.Name = &name
@@ -454,52 +1061,119 @@ func (r *StackResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	if !plan.IAC.Equal(state.IAC) {
-		var iacModel IACModel
-		diags = plan.IAC.As(ctx, &iacModel, basetypes.ObjectAsOptions{})
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
+		var planned, prior IACModel
+		diags.Append(plan.IAC.As(ctx, &planned, basetypes.ObjectAsOptions{})...)
+		diags.Append(state.IAC.As(ctx, &prior, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true})...)
+		if diags.HasError() {
+			return updateReq, false, diags
 		}
 
-		iacConfig := zenfraclient.IACConfig{
-			Engine:  iacModel.Engine.ValueString(),
-			Version: iacModel.Version.ValueString(),
+		// Another spelling of the same engine and version, such as " v1.6" for 1.6.0, is not a change.
+		if !iacEquivalent(planned, prior) {
+			updateReq.IAC = &zenfraclient.IACConfig{
+				Engine:  planned.Engine.ValueString(),
+				Version: planned.Version.ValueString(),
+			}
+			hasChanges = true
 		}
-		updateReq.IAC = &iacConfig
+	}
+
+	if !plan.RunnerImage.Equal(state.RunnerImage) {
+		image := plan.RunnerImage.ValueString()
+		updateReq.RunnerImage = &image
 		hasChanges = true
 	}
 
-	// Update the stack if there are changes
-	if hasChanges {
-		_, err := r.client.UpdateStack(ctx, state.ID.ValueString(), updateReq)
-		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error Updating Stack",
-				fmt.Sprintf("Could not update stack ID %s: %s", state.ID.ValueString(), err.Error()),
-			)
-			return
+	if !plan.BeforeInit.Equal(state.BeforeInit) || !plan.BeforePlan.Equal(state.BeforePlan) || !plan.AfterApply.Equal(state.AfterApply) {
+		hooks, d := buildHooksFromModel(ctx, plan)
+		diags.Append(d...)
+		if diags.HasError() {
+			return updateReq, false, diags
+		}
+		if hooks == nil {
+			// All hooks removed from config
+			hooks = &zenfraclient.StackHooks{}
 		}
+		updateReq.Hooks = hooks
+		hasChanges = true
 	}
 
-	// Read back the updated stack
-	stack, err := r.client.GetStack(ctx, state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading Updated Stack",
-			fmt.Sprintf("Could not read stack ID %s: %s", state.ID.ValueString(), err.Error()),
-		)
-		return
+	if !plan.Environment.Equal(state.Environment) {
+		env := map[string]string{}
+		diags.Append(plan.Environment.ElementsAs(ctx, &env, false)...)
+		if diags.HasError() {
+			return updateReq, false, diags
+		}
+		updateReq.Environment = &env
+		hasChanges = true
 	}
 
-	// Map response to state
-	newState, diags := mapStackToState(ctx, stack)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if !plan.RequiredChecks.Equal(state.RequiredChecks) {
+		checks := []string{}
+		diags.Append(plan.RequiredChecks.ElementsAs(ctx, &checks, false)...)
+		if diags.HasError() {
+			return updateReq, false, diags
+		}
+		updateReq.RequiredChecksBeforeDestroy = &checks
+		hasChanges = true
 	}
 
-	diags = resp.State.Set(ctx, newState)
-	resp.Diagnostics.Append(diags...)
+	if !plan.EnvironmentType.Equal(state.EnvironmentType) {
+		environmentType := plan.EnvironmentType.ValueString()
+		updateReq.EnvironmentType = &environmentType
+		hasChanges = true
+	}
+
+	if !plan.OwnerTeamID.Equal(state.OwnerTeamID) {
+		owner := plan.OwnerTeamID.ValueString()
+		updateReq.OwnerTeamID = &owner
+		hasChanges = true
+	}
+
+	if !plan.Collaborators.Equal(state.Collaborators) {
+		teams := []string{}
+		diags.Append(plan.Collaborators.ElementsAs(ctx, &teams, false)...)
+		if diags.HasError() {
+			return updateReq, false, diags
+		}
+		updateReq.CollaboratorTeamIDs = &teams
+		hasChanges = true
+	}
+
+	if !plan.RunRetention.Equal(state.RunRetention) {
+		days := plan.RunRetention.ValueInt64()
+		updateReq.RunRetentionDays = &days
+		hasChanges = true
+	}
+
+	if !plan.LogRetention.Equal(state.LogRetention) {
+		days := plan.LogRetention.ValueInt64()
+		updateReq.LogRetentionDays = &days
+		hasChanges = true
+	}
+
+	if !plan.StateSharing.Equal(state.StateSharing) {
+		sharing, d := buildStateSharingFromModel(ctx, plan.StateSharing)
+		diags.Append(d...)
+		if diags.HasError() {
+			return updateReq, false, diags
+		}
+		if sharing == nil {
+			// state_sharing removed from config
+			sharing = &zenfraclient.StackStateSharing{}
+		}
+		updateReq.StateSharing = sharing
+		hasChanges = true
+	}
+
+	return updateReq, hasChanges, diags
+}
+
+// iacEquivalent reports whether two IAC blocks name the same engine and version. Engines
+// compare case-insensitively, as the engine migration guard does.
+func iacEquivalent(a, b IACModel) bool {
+	return strings.EqualFold(strings.TrimSpace(a.Engine.ValueString()), strings.TrimSpace(b.Engine.ValueString())) &&
+		normalizeIACVersion(a.Version.ValueString()) == normalizeIACVersion(b.Version.ValueString())
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -511,25 +1185,100 @@ func (r *StackResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	// Delete the stack
-	err := r.client.DeleteStack(ctx, state.ID.ValueString())
+	opts := &zenfraclient.DeleteStackOptions{
+		Force:         state.ForceDelete.ValueBool(),
+		DetachBundles: state.DetachBundlesOnDelete.ValueBool(),
+	}
+	err := r.client.DeleteStack(ctx, state.ID.ValueString(), opts)
 	if err != nil {
 		if zenfraclient.IsNotFound(err) {
 			// Stack already deleted, consider it a success
 			return
 		}
+		detail := fmt.Sprintf("Could not delete stack ID %s: %s", state.ID.ValueString(), err.Error())
+		if zenfraclient.IsConflict(err) && (!opts.Force || !opts.DetachBundles) {
+			detail += "\n\nThe stack may have active runs or attached bundles. Set force_delete and detach_bundles_on_delete, " +
+				"apply, and destroy again to delete it anyway."
+		}
+		resp.Diagnostics.AddError("Error Deleting Stack", detail)
+		return
+	}
+}
+
+// ImportState imports the resource into Terraform state. An import ID of the form
+// <stack_id>?include=variables,bundles also looks up the stack's variables and bundle
+// attachments and reports import blocks for them as a warning, since a resource can
+// only import its own state. Prefixing the ID with <organization_id>/ imports a stack
+// from another organization.
+func (r *StackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, importID := importguard.SplitOrganization(req.ID)
+	stackID, include, err := parseImportID(importID)
+	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Deleting Stack",
-			fmt.Sprintf("Could not delete stack ID %s: %s", state.ID.ValueString(), err.Error()),
+			"Invalid Import ID",
+			fmt.Sprintf("Expected format: [organization_id/]stack_id or [organization_id/]stack_id?include=variables,bundles: %s", err),
 		)
 		return
 	}
+
+	ctx = zenfraclient.WithOrganization(ctx, orgID)
+	if !importguard.VerifyOrganization(ctx, r.client, "stack", stackID, importguard.Stack(r.client), &resp.Diagnostics) {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), stackID)...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+	if len(include) == 0 || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hasVariables bool
+	var attachments []zenfraclient.BundleAttachment
+	if slices.Contains(include, includeVariables) {
+		vars, err := r.client.GetStackVariables(ctx, stackID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Importing Stack", fmt.Sprintf("Could not read variables of stack %s: %s", stackID, err))
+			return
+		}
+		hasVariables = len(vars) > 0
+	}
+	if slices.Contains(include, includeBundles) {
+		attachments, err = r.client.ListStackBundles(ctx, stackID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Importing Stack", fmt.Sprintf("Could not read bundle attachments of stack %s: %s", stackID, err))
+			return
+		}
+	}
+
+	blocks := companionImportBlocks(stackID, hasVariables, attachments)
+	if blocks == "" {
+		resp.Diagnostics.AddWarning(
+			"No Companion Resources to Import",
+			fmt.Sprintf("Stack %s has no variables or bundle attachments matching include=%s.", stackID, strings.Join(include, ",")),
+		)
+		return
+	}
+	resp.Diagnostics.AddWarning(
+		"Companion Resources to Import",
+		fmt.Sprintf("Stack %s was imported. Add these import blocks, with matching resource blocks, "+
+			"to import its variables and bundle attachments as well:\n\n%s", stackID, blocks),
+	)
 }
 
-// ImportState imports the resource into Terraform state.
-func (r *StackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Use the ID from the import to set the state
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// readyWaitSettings returns the configured readiness timeout and poll interval, or their defaults.
+func readyWaitSettings(model *StackModel) (time.Duration, time.Duration) {
+	timeout, interval := defaultReadyTimeout, defaultReadyPollInterval
+	if !model.ReadyTimeoutSeconds.IsNull() {
+		timeout = time.Duration(model.ReadyTimeoutSeconds.ValueInt64()) * time.Second
+	}
+	if !model.ReadyPollIntervalSeconds.IsNull() {
+		interval = time.Duration(model.ReadyPollIntervalSeconds.ValueInt64()) * time.Second
+	}
+	return timeout, interval
 }
 
 // mapStackToState converts an API Stack response to a StackModel for Terraform state.
@@ -539,7 +1288,7 @@ func mapStackToState(ctx context.Context, stack *zenfraclient.Stack) (*StackMode
 	// Map IAC config
 	iacObj, d := types.ObjectValueFrom(ctx, IACModelAttrTypes, &IACModel{
 		Engine:  types.StringValue(stack.IAC.Engine),
-		Version: types.StringValue(stack.IAC.Version),
+		Version: NewIACVersionValue(stack.IAC.Version),
 	})
 	diags.Append(d...)
 
@@ -547,7 +1296,7 @@ func mapStackToState(ctx context.Context, stack *zenfraclient.Stack) (*StackMode
 	mapRef := func(ref zenfraclient.StackSourceRef) (types.Object, diag.Diagnostics) {
 		return types.ObjectValueFrom(ctx, RefModelAttrTypes, &RefModel{
 			Type: types.StringValue(ref.Type),
-			Name: types.StringValue(ref.Name),
+			Name: NewRefNameValue(ref.Name),
 		})
 	}
 
@@ -557,10 +1306,15 @@ func mapStackToState(ctx context.Context, stack *zenfraclient.Stack) (*StackMode
 		refObj, d := mapRef(stack.Source.RawGit.Ref)
 		diags.Append(d...)
 
+		credsObj, d := mapHTTPSCredentials(ctx, stack.Source.RawGit.HTTPSCredentials)
+		diags.Append(d...)
+
+		// The API never returns the token; keepSourceCredentials restores it.
 		rawGitObj, d := types.ObjectValueFrom(ctx, RawGitModelAttrTypes, &RawGitModel{
-			URL:  types.StringValue(stack.Source.RawGit.URL),
-			Ref:  refObj,
-			Path: types.StringValue(stack.Source.RawGit.Path),
+			URL:              types.StringValue(stack.Source.RawGit.URL),
+			Ref:              refObj,
+			Path:             NewSourcePathValue(stack.Source.RawGit.Path),
+			HTTPSCredentials: credsObj,
 		})
 		diags.Append(d...)
 
@@ -579,7 +1333,7 @@ func mapStackToState(ctx context.Context, stack *zenfraclient.Stack) (*StackMode
 			IntegrationID: types.StringValue(stack.Source.VCS.IntegrationID),
 			RepositoryID:  types.StringValue(stack.Source.VCS.RepositoryID),
 			Ref:           refObj,
-			Path:          types.StringValue(stack.Source.VCS.Path),
+			Path:          NewSourcePathValue(stack.Source.VCS.Path),
 		})
 		diags.Append(d...)
 
@@ -595,30 +1349,96 @@ func mapStackToState(ctx context.Context, stack *zenfraclient.Stack) (*StackMode
 	paths, d := types.ListValueFrom(ctx, types.StringType, stack.Triggers.OnPush.Paths)
 	diags.Append(d...)
 
+	branches, d := types.SetValueFrom(ctx, types.StringType, stack.Triggers.OnPush.Branches)
+	diags.Append(d...)
+
+	tags, d := types.SetValueFrom(ctx, types.StringType, stack.Triggers.OnPush.Tags)
+	diags.Append(d...)
+
+	ignorePaths, d := types.SetValueFrom(ctx, types.StringType, stack.Triggers.OnPush.IgnorePaths)
+	diags.Append(d...)
+
 	onPushObj, d := types.ObjectValueFrom(ctx, OnPushModelAttrTypes, &OnPushModel{
-		Enabled: types.BoolValue(stack.Triggers.OnPush.Enabled),
-		Paths:   paths,
+		Enabled:     types.BoolValue(stack.Triggers.OnPush.Enabled),
+		Paths:       paths,
+		Branches:    branches,
+		Tags:        tags,
+		IgnorePaths: ignorePaths,
+	})
+	diags.Append(d...)
+
+	prPaths, d := types.SetValueFrom(ctx, types.StringType, stack.Triggers.OnPullRequest.Paths)
+	diags.Append(d...)
+
+	onPullRequestObj, d := types.ObjectValueFrom(ctx, OnPullRequestModelAttrTypes, &OnPullRequestModel{
+		Enabled:         types.BoolValue(stack.Triggers.OnPullRequest.Enabled),
+		SpeculativeOnly: types.BoolValue(stack.Triggers.OnPullRequest.SpeculativeOnly),
+		CommentResults:  types.BoolValue(stack.Triggers.OnPullRequest.CommentResults),
+		Paths:           prPaths,
 	})
 	diags.Append(d...)
 
+	// No schedules map to null, so a configuration without on_schedule shows no diff.
+	onScheduleList := types.ListNull(types.ObjectType{AttrTypes: OnScheduleModelAttrTypes})
+	if len(stack.Triggers.OnSchedule) > 0 {
+		schedules := make([]OnScheduleModel, 0, len(stack.Triggers.OnSchedule))
+		for _, sch := range stack.Triggers.OnSchedule {
+			schedules = append(schedules, OnScheduleModel{
+				Cron:    types.StringValue(sch.Cron),
+				RunType: types.StringValue(sch.RunType),
+			})
+		}
+		onScheduleList, d = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: OnScheduleModelAttrTypes}, schedules)
+		diags.Append(d...)
+	}
+
 	triggersObj, d := types.ObjectValueFrom(ctx, TriggersModelAttrTypes, &TriggersModel{
-		OnPush: onPushObj,
+		OnPush:        onPushObj,
+		OnPullRequest: onPullRequestObj,
+		OnSchedule:    onScheduleList,
 	})
 	diags.Append(d...)
 
+	// Map run environment
+	beforeInit, d := types.ListValueFrom(ctx, types.StringType, stack.Hooks.BeforeInit)
+	diags.Append(d...)
+
+	beforePlan, d := types.ListValueFrom(ctx, types.StringType, stack.Hooks.BeforePlan)
+	diags.Append(d...)
+
+	afterApply, d := types.ListValueFrom(ctx, types.StringType, stack.Hooks.AfterApply)
+	diags.Append(d...)
+
+	environment, d := types.MapValueFrom(ctx, types.StringType, stack.Environment)
+	diags.Append(d...)
+
 	model := &StackModel{
-		ID:              types.StringValue(stack.ID),
-		OrganizationID:  types.StringValue(stack.OrganizationID),
-		SpaceID:         types.StringValue(stack.SpaceID),
-		Name:            types.StringValue(stack.Name),
-		AllowPublicPool: types.BoolValue(stack.AllowPublicPool),
-		IAC:             iacObj,
-		Source:          sourceObj,
-		Triggers:        triggersObj,
-		CreatedAt:       types.StringValue(stack.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
-		UpdatedAt:       types.StringValue(stack.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
-		CreatedBy:       types.StringValue(stack.CreatedBy),
-		UpdatedBy:       types.StringValue(stack.UpdatedBy),
+		ID:                 types.StringValue(stack.ID),
+		OrganizationID:     types.StringValue(stack.OrganizationID),
+		SpaceID:            types.StringValue(stack.SpaceID),
+		Name:               types.StringValue(stack.Name),
+		AllowPublicPool:    types.BoolValue(stack.AllowPublicPool),
+		IAC:                iacObj,
+		Source:             sourceObj,
+		Triggers:           triggersObj,
+		BeforeInit:         beforeInit,
+		BeforePlan:         beforePlan,
+		AfterApply:         afterApply,
+		Environment:        environment,
+		Status:             types.StringValue(stack.Status),
+		SourceCommit:       types.StringNull(),
+		SourceSyncedAt:     timeutil.TimestampPointer(stack.SourceSyncedAt),
+		Health:             types.StringNull(),
+		DriftDetectedAt:    timeutil.NewTimestampNull(),
+		CreatedAt:          timeutil.Timestamp(stack.CreatedAt),
+		UpdatedAt:          timeutil.Timestamp(stack.UpdatedAt),
+		CreatedBy:          types.StringValue(stack.CreatedBy),
+		UpdatedBy:          types.StringValue(stack.UpdatedBy),
+		ManagedExclusively: managedlock.Value(stack.ManagedLock),
+	}
+
+	if stack.SourceCommit != "" {
+		model.SourceCommit = types.StringValue(stack.SourceCommit)
 	}
 
 	if stack.WorkerPoolID != nil {
@@ -627,6 +1447,68 @@ func mapStackToState(ctx context.Context, stack *zenfraclient.Stack) (*StackMode
 		model.WorkerPoolID = types.StringNull()
 	}
 
+	if stack.RunnerImage != nil && *stack.RunnerImage != "" {
+		model.RunnerImage = types.StringValue(*stack.RunnerImage)
+	} else {
+		model.RunnerImage = types.StringNull()
+	}
+
+	// An empty list and no protection are the same to the API; keep the attribute unset.
+	model.RequiredChecks = types.ListNull(types.StringType)
+	if len(stack.RequiredChecksBeforeDestroy) > 0 {
+		model.RequiredChecks, d = types.ListValueFrom(ctx, types.StringType, stack.RequiredChecksBeforeDestroy)
+		diags.Append(d...)
+	}
+
+	if stack.TemplateID != "" {
+		model.TemplateID = types.StringValue(stack.TemplateID)
+	} else {
+		model.TemplateID = types.StringNull()
+	}
+
+	if stack.EnvironmentType != "" {
+		model.EnvironmentType = types.StringValue(stack.EnvironmentType)
+	} else {
+		model.EnvironmentType = types.StringNull()
+	}
+
+	if stack.OwnerTeamID != "" {
+		model.OwnerTeamID = types.StringValue(stack.OwnerTeamID)
+	} else {
+		model.OwnerTeamID = types.StringNull()
+	}
+
+	model.Collaborators = types.SetNull(types.StringType)
+	if len(stack.CollaboratorTeamIDs) > 0 {
+		model.Collaborators, d = types.SetValueFrom(ctx, types.StringType, stack.CollaboratorTeamIDs)
+		diags.Append(d...)
+	}
+
+	// Zero means the organization's retention applies.
+	model.RunRetention = types.Int64Null()
+	if stack.RunRetentionDays > 0 {
+		model.RunRetention = types.Int64Value(stack.RunRetentionDays)
+	}
+	model.LogRetention = types.Int64Null()
+	if stack.LogRetentionDays > 0 {
+		model.LogRetention = types.Int64Value(stack.LogRetentionDays)
+	}
+
+	// Sharing with no other stack is the default; keep the attribute unset.
+	model.StateSharing = types.ObjectNull(StateSharingModelAttrTypes)
+	if sharing := stack.StateSharing; sharing.ShareWithSpace || len(sharing.AllowedStackIDs) > 0 {
+		stackIDs := types.SetNull(types.StringType)
+		if len(sharing.AllowedStackIDs) > 0 {
+			stackIDs, d = types.SetValueFrom(ctx, types.StringType, sharing.AllowedStackIDs)
+			diags.Append(d...)
+		}
+		model.StateSharing, d = types.ObjectValueFrom(ctx, StateSharingModelAttrTypes, &StateSharingModel{
+			ShareWithSpace:  types.BoolValue(sharing.ShareWithSpace),
+			AllowedStackIDs: stackIDs,
+		})
+		diags.Append(d...)
+	}
+
 	return model, diags
 }
 
@@ -661,6 +1543,17 @@ func buildSourceFromModel(ctx context.Context, model *SourceModel) (*zenfraclien
 			},
 			Path: rawGitModel.Path.ValueString(),
 		}
+		if !rawGitModel.HTTPSCredentials.IsNull() {
+			var credsModel HTTPSCredentialsModel
+			diags.Append(rawGitModel.HTTPSCredentials.As(ctx, &credsModel, basetypes.ObjectAsOptions{})...)
+			if diags.HasError() {
+				return nil, diags
+			}
+			source.RawGit.HTTPSCredentials = &zenfraclient.StackSourceHTTPSCredentials{
+				Username: credsModel.Username.ValueString(),
+				Token:    credsModel.Token.ValueString(),
+			}
+		}
 	} else if model.Type.ValueString() == "vcs" && !model.VCS.IsNull() {
 		var vcsModel VCSModel
 		d := model.VCS.As(ctx, &vcsModel, basetypes.ObjectAsOptions{})
@@ -697,7 +1590,7 @@ func buildTriggersFromModel(ctx context.Context, model *TriggersModel) (*zenfrac
 
 	triggers := &zenfraclient.StackTriggers{}
 
-	if !model.OnPush.IsNull() {
+	if !model.OnPush.IsNull() && !model.OnPush.IsUnknown() {
 		var onPushModel OnPushModel
 		d := model.OnPush.As(ctx, &onPushModel, basetypes.ObjectAsOptions{})
 		diags.Append(d...)
@@ -705,18 +1598,127 @@ func buildTriggersFromModel(ctx context.Context, model *TriggersModel) (*zenfrac
 			return nil, diags
 		}
 
-		var paths []string
+		var paths, branches, tags, ignorePaths []string
 		d = onPushModel.Paths.ElementsAs(ctx, &paths, false)
 		diags.Append(d...)
+		d = onPushModel.Branches.ElementsAs(ctx, &branches, false)
+		diags.Append(d...)
+		d = onPushModel.Tags.ElementsAs(ctx, &tags, false)
+		diags.Append(d...)
+		d = onPushModel.IgnorePaths.ElementsAs(ctx, &ignorePaths, false)
+		diags.Append(d...)
 		if diags.HasError() {
 			return nil, diags
 		}
 
 		triggers.OnPush = zenfraclient.StackTriggerOnPush{
-			Enabled: onPushModel.Enabled.ValueBool(),
-			Paths:   paths,
+			Enabled:     onPushModel.Enabled.ValueBool(),
+			Paths:       paths,
+			Branches:    branches,
+			Tags:        tags,
+			IgnorePaths: ignorePaths,
+		}
+	}
+
+	if !model.OnPullRequest.IsNull() && !model.OnPullRequest.IsUnknown() {
+		var onPullRequestModel OnPullRequestModel
+		d := model.OnPullRequest.As(ctx, &onPullRequestModel, basetypes.ObjectAsOptions{})
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var paths []string
+		d = onPullRequestModel.Paths.ElementsAs(ctx, &paths, false)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		triggers.OnPullRequest = zenfraclient.StackTriggerOnPullRequest{
+			Enabled:         onPullRequestModel.Enabled.ValueBool(),
+			SpeculativeOnly: onPullRequestModel.SpeculativeOnly.ValueBool(),
+			CommentResults:  onPullRequestModel.CommentResults.ValueBool(),
+			Paths:           paths,
+		}
+	}
+
+	if !model.OnSchedule.IsNull() && !model.OnSchedule.IsUnknown() {
+		var schedules []OnScheduleModel
+		d := model.OnSchedule.ElementsAs(ctx, &schedules, false)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		triggers.OnSchedule = make([]zenfraclient.StackTriggerOnSchedule, 0, len(schedules))
+		for _, sch := range schedules {
+			triggers.OnSchedule = append(triggers.OnSchedule, zenfraclient.StackTriggerOnSchedule{
+				Cron:    sch.Cron.ValueString(),
+				RunType: sch.RunType.ValueString(),
+			})
 		}
 	}
 
 	return triggers, diags
 }
+
+// withPriorTriggers replaces unknown on_push and on_pull_request blocks of planned with
+// their prior values. The triggers endpoint replaces every trigger, so an unknown block
+// would otherwise be sent as disabled.
+func withPriorTriggers(ctx context.Context, planned, prior types.Object) (types.Object, diag.Diagnostics) {
+	if planned.IsNull() || planned.IsUnknown() || prior.IsNull() || prior.IsUnknown() {
+		return planned, nil
+	}
+	var plan, state TriggersModel
+	diags := planned.As(ctx, &plan, basetypes.ObjectAsOptions{})
+	diags.Append(prior.As(ctx, &state, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || (!plan.OnPush.IsUnknown() && !plan.OnPullRequest.IsUnknown()) {
+		return planned, diags
+	}
+	if plan.OnPush.IsUnknown() {
+		plan.OnPush = state.OnPush
+	}
+	if plan.OnPullRequest.IsUnknown() {
+		plan.OnPullRequest = state.OnPullRequest
+	}
+	obj, d := types.ObjectValueFrom(ctx, TriggersModelAttrTypes, &plan)
+	diags.Append(d...)
+	return obj, diags
+}
+
+// buildStateSharingFromModel extracts the state sharing settings from Terraform model. It
+// returns nil for an unset state_sharing.
+func buildStateSharingFromModel(ctx context.Context, sharing types.Object) (*zenfraclient.StackStateSharing, diag.Diagnostics) {
+	if sharing.IsNull() || sharing.IsUnknown() {
+		return nil, nil
+	}
+	var model StateSharingModel
+	diags := sharing.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	result := &zenfraclient.StackStateSharing{ShareWithSpace: model.ShareWithSpace.ValueBool()}
+	diags.Append(model.AllowedStackIDs.ElementsAs(ctx, &result.AllowedStackIDs, false)...)
+	return result, diags
+}
+
+// buildHooksFromModel extracts run hooks from the Terraform model.
+// Returns nil when no hook list is configured.
+func buildHooksFromModel(ctx context.Context, model *StackModel) (*zenfraclient.StackHooks, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if model.BeforeInit.IsNull() && model.BeforePlan.IsNull() && model.AfterApply.IsNull() {
+		return nil, diags
+	}
+
+	hooks := &zenfraclient.StackHooks{}
+	diags.Append(model.BeforeInit.ElementsAs(ctx, &hooks.BeforeInit, false)...)
+	diags.Append(model.BeforePlan.ElementsAs(ctx, &hooks.BeforePlan, false)...)
+	diags.Append(model.AfterApply.ElementsAs(ctx, &hooks.AfterApply, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return hooks, diags
+}
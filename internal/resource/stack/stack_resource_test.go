@@ -7,8 +7,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
@@ -46,8 +50,11 @@ func TestMapStackToState_RawGitSource(t *testing.T) {
 		},
 		Triggers: zenfraclient.StackTriggers{
 			OnPush: zenfraclient.StackTriggerOnPush{
-				Enabled: true,
-				Paths:   []string{"infra/**", "modules/**"},
+				Enabled:     true,
+				Paths:       []string{"infra/**", "modules/**"},
+				Branches:    []string{"main", "release/*"},
+				Tags:        []string{"v*"},
+				IgnorePaths: []string{"docs/**"},
 			},
 		},
 		CreatedBy: "user-111",
@@ -161,6 +168,33 @@ func TestMapStackToState_RawGitSource(t *testing.T) {
 	if paths[1] != "modules/**" {
 		t.Errorf("expected second path 'modules/**', got %s", paths[1])
 	}
+
+	var branches []string
+	diags = onPushModel.Branches.ElementsAs(ctx, &branches, false)
+	if diags.HasError() {
+		t.Fatalf("failed to extract branches: %v", diags.Errors())
+	}
+	if len(branches) != 2 {
+		t.Fatalf("expected 2 branches, got %d", len(branches))
+	}
+
+	var tags []string
+	diags = onPushModel.Tags.ElementsAs(ctx, &tags, false)
+	if diags.HasError() {
+		t.Fatalf("failed to extract tags: %v", diags.Errors())
+	}
+	if len(tags) != 1 || tags[0] != "v*" {
+		t.Errorf("expected tags [v*], got %v", tags)
+	}
+
+	var ignorePaths []string
+	diags = onPushModel.IgnorePaths.ElementsAs(ctx, &ignorePaths, false)
+	if diags.HasError() {
+		t.Fatalf("failed to extract ignore_paths: %v", diags.Errors())
+	}
+	if len(ignorePaths) != 1 || ignorePaths[0] != "docs/**" {
+		t.Errorf("expected ignore_paths [docs/**], got %v", ignorePaths)
+	}
 }
 
 func TestMapStackToState_VCSSource(t *testing.T) {
@@ -258,13 +292,14 @@ func TestBuildSourceFromModel_RawGit(t *testing.T) {
 	// Create a Terraform source model for raw_git
 	refObj, _ := types.ObjectValueFrom(ctx, RefModelAttrTypes, &RefModel{
 		Type: types.StringValue("branch"),
-		Name: types.StringValue("develop"),
+		Name: NewRefNameValue("develop"),
 	})
 
 	rawGitObj, _ := types.ObjectValueFrom(ctx, RawGitModelAttrTypes, &RawGitModel{
-		URL:  types.StringValue("https://github.com/test/repo.git"),
-		Ref:  refObj,
-		Path: types.StringValue("stacks/prod"),
+		URL:              types.StringValue("https://github.com/test/repo.git"),
+		Ref:              refObj,
+		Path:             NewSourcePathValue("stacks/prod"),
+		HTTPSCredentials: types.ObjectNull(HTTPSCredentialsModelAttrTypes),
 	})
 
 	sourceObj, _ := types.ObjectValueFrom(ctx, SourceModelAttrTypes, &SourceModel{
@@ -307,14 +342,21 @@ func TestBuildTriggersFromModel(t *testing.T) {
 
 	// Create a Terraform triggers model
 	paths, _ := types.ListValueFrom(ctx, types.StringType, []string{"src/**", "config/**"})
+	branches, _ := types.SetValueFrom(ctx, types.StringType, []string{"main"})
+	tags, _ := types.SetValueFrom(ctx, types.StringType, []string{"v*", "release-*"})
 
 	onPushObj, _ := types.ObjectValueFrom(ctx, OnPushModelAttrTypes, &OnPushModel{
-		Enabled: types.BoolValue(true),
-		Paths:   paths,
+		Enabled:     types.BoolValue(true),
+		Paths:       paths,
+		Branches:    branches,
+		Tags:        tags,
+		IgnorePaths: types.SetNull(types.StringType),
 	})
 
 	triggersObj, _ := types.ObjectValueFrom(ctx, TriggersModelAttrTypes, &TriggersModel{
-		OnPush: onPushObj,
+		OnPush:        onPushObj,
+		OnPullRequest: types.ObjectNull(OnPullRequestModelAttrTypes),
+		OnSchedule:    types.ListNull(types.ObjectType{AttrTypes: OnScheduleModelAttrTypes}),
 	})
 
 	var triggersModel TriggersModel
@@ -338,9 +380,573 @@ func TestBuildTriggersFromModel(t *testing.T) {
 	if triggers.OnPush.Paths[1] != "config/**" {
 		t.Errorf("expected second path 'config/**', got %s", triggers.OnPush.Paths[1])
 	}
+	if len(triggers.OnPush.Branches) != 1 || triggers.OnPush.Branches[0] != "main" {
+		t.Errorf("expected branches [main], got %v", triggers.OnPush.Branches)
+	}
+	if len(triggers.OnPush.Tags) != 2 {
+		t.Errorf("expected 2 tags, got %d", len(triggers.OnPush.Tags))
+	}
+	if triggers.OnPush.IgnorePaths != nil {
+		t.Errorf("expected nil ignore_paths for null set, got %v", triggers.OnPush.IgnorePaths)
+	}
+}
+
+func TestPullRequestTrigger_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	apiStack := &zenfraclient.Stack{
+		ID: "stack-123",
+		Source: zenfraclient.StackSource{
+			Type: sourceTypeRawGit,
+			RawGit: &zenfraclient.StackSourceRawGit{
+				URL: "https://github.com/example/repo.git",
+				Ref: zenfraclient.StackSourceRef{Type: "branch", Name: "main"},
+			},
+		},
+		Triggers: zenfraclient.StackTriggers{
+			OnPullRequest: zenfraclient.StackTriggerOnPullRequest{
+				Enabled:         true,
+				SpeculativeOnly: true,
+				CommentResults:  true,
+				Paths:           []string{"infra/**"},
+			},
+		},
+	}
+
+	model, diags := mapStackToState(ctx, apiStack)
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+
+	var triggersModel TriggersModel
+	diags = model.Triggers.As(ctx, &triggersModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		t.Fatalf("failed to extract triggers model: %v", diags.Errors())
+	}
+
+	var prModel OnPullRequestModel
+	diags = triggersModel.OnPullRequest.As(ctx, &prModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		t.Fatalf("failed to extract on_pull_request model: %v", diags.Errors())
+	}
+	if !prModel.Enabled.ValueBool() || !prModel.SpeculativeOnly.ValueBool() || !prModel.CommentResults.ValueBool() {
+		t.Errorf("expected all on_pull_request flags true, got %+v", prModel)
+	}
+
+	triggers, diags := buildTriggersFromModel(ctx, &triggersModel)
+	if diags.HasError() {
+		t.Fatalf("buildTriggersFromModel returned errors: %v", diags.Errors())
+	}
+
+	pr := triggers.OnPullRequest
+	if !pr.Enabled || !pr.SpeculativeOnly || !pr.CommentResults {
+		t.Errorf("expected all on_pull_request flags true after round trip, got %+v", pr)
+	}
+	if len(pr.Paths) != 1 || pr.Paths[0] != "infra/**" {
+		t.Errorf("expected paths [infra/**], got %v", pr.Paths)
+	}
+	if triggers.OnPush.Enabled {
+		t.Error("expected on_push to remain disabled")
+	}
+}
+
+func TestMapStackToState_RunEnvironment(t *testing.T) {
+	ctx := context.Background()
+
+	apiStack := &zenfraclient.Stack{
+		ID:          "stack-123",
+		RunnerImage: strPtr("ghcr.io/example/runner:1.2"),
+		Hooks: zenfraclient.StackHooks{
+			BeforePlan: []string{"tfsec .", "infracost breakdown --path ."},
+		},
+		Environment: map[string]string{"TF_LOG": "info"},
+	}
+
+	model, diags := mapStackToState(ctx, apiStack)
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+
+	if model.RunnerImage.ValueString() != "ghcr.io/example/runner:1.2" {
+		t.Errorf("expected runner image, got %v", model.RunnerImage)
+	}
+	if !model.BeforeInit.IsNull() || !model.AfterApply.IsNull() {
+		t.Errorf("expected unset hooks to be null, got before_init=%v after_apply=%v", model.BeforeInit, model.AfterApply)
+	}
+
+	hooks, diags := buildHooksFromModel(ctx, model)
+	if diags.HasError() {
+		t.Fatalf("buildHooksFromModel returned errors: %v", diags.Errors())
+	}
+	if len(hooks.BeforePlan) != 2 || hooks.BeforePlan[1] != "infracost breakdown --path ." {
+		t.Errorf("expected before_plan hooks to round trip in order, got %v", hooks.BeforePlan)
+	}
+
+	var env map[string]string
+	diags = model.Environment.ElementsAs(ctx, &env, false)
+	if diags.HasError() {
+		t.Fatalf("failed to extract environment: %v", diags.Errors())
+	}
+	if env["TF_LOG"] != "info" {
+		t.Errorf("expected TF_LOG=info, got %v", env)
+	}
+
+	// A stack without any run customization maps to nulls and no hooks payload
+	model, diags = mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-456"})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	if !model.RunnerImage.IsNull() || !model.Environment.IsNull() {
+		t.Errorf("expected null runner_image and environment, got %v, %v", model.RunnerImage, model.Environment)
+	}
+	hooks, _ = buildHooksFromModel(ctx, model)
+	if hooks != nil {
+		t.Errorf("expected nil hooks, got %+v", hooks)
+	}
+}
+
+func TestScheduleTrigger_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	apiStack := &zenfraclient.Stack{
+		ID: "stack-123",
+		Source: zenfraclient.StackSource{
+			Type: sourceTypeRawGit,
+			RawGit: &zenfraclient.StackSourceRawGit{
+				URL: "https://github.com/example/repo.git",
+				Ref: zenfraclient.StackSourceRef{Type: "branch", Name: "main"},
+			},
+		},
+		Triggers: zenfraclient.StackTriggers{
+			OnSchedule: []zenfraclient.StackTriggerOnSchedule{
+				{Cron: "0 3 * * MON-FRI", RunType: zenfraclient.ScheduleRunTypeApply},
+				{Cron: "*/30 * * * *", RunType: zenfraclient.ScheduleRunTypePlan},
+			},
+		},
+	}
+
+	model, diags := mapStackToState(ctx, apiStack)
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+
+	var triggersModel TriggersModel
+	diags = model.Triggers.As(ctx, &triggersModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		t.Fatalf("failed to extract triggers model: %v", diags.Errors())
+	}
+	if len(triggersModel.OnSchedule.Elements()) != 2 {
+		t.Fatalf("expected 2 schedules, got %s", triggersModel.OnSchedule)
+	}
+
+	triggers, diags := buildTriggersFromModel(ctx, &triggersModel)
+	if diags.HasError() {
+		t.Fatalf("buildTriggersFromModel returned errors: %v", diags.Errors())
+	}
+	if len(triggers.OnSchedule) != 2 || triggers.OnSchedule[0] != apiStack.Triggers.OnSchedule[0] || triggers.OnSchedule[1] != apiStack.Triggers.OnSchedule[1] {
+		t.Errorf("expected schedules %v after round trip, got %v", apiStack.Triggers.OnSchedule, triggers.OnSchedule)
+	}
+
+	// A stack without schedules maps to null, matching a configuration without on_schedule.
+	apiStack.Triggers.OnSchedule = nil
+	model, diags = mapStackToState(ctx, apiStack)
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	_ = model.Triggers.As(ctx, &triggersModel, basetypes.ObjectAsOptions{})
+	if !triggersModel.OnSchedule.IsNull() {
+		t.Errorf("expected on_schedule to be null, got %s", triggersModel.OnSchedule)
+	}
 }
 
 // Helper function to create string pointers
 func strPtr(s string) *string {
 	return &s
 }
+
+func TestReadyWaitSettings(t *testing.T) {
+	model := &StackModel{
+		ReadyTimeoutSeconds:      types.Int64Null(),
+		ReadyPollIntervalSeconds: types.Int64Null(),
+	}
+	timeout, interval := readyWaitSettings(model)
+	if timeout != defaultReadyTimeout || interval != defaultReadyPollInterval {
+		t.Errorf("expected defaults, got %s and %s", timeout, interval)
+	}
+
+	model.ReadyTimeoutSeconds = types.Int64Value(120)
+	model.ReadyPollIntervalSeconds = types.Int64Value(2)
+	timeout, interval = readyWaitSettings(model)
+	if timeout != 2*time.Minute || interval != 2*time.Second {
+		t.Errorf("expected 2m and 2s, got %s and %s", timeout, interval)
+	}
+}
+
+func TestMapStackToState_TemplateID(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1", TemplateID: "tpl-1"})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	if model.TemplateID.ValueString() != "tpl-1" {
+		t.Errorf("expected template_id tpl-1, got %v", model.TemplateID)
+	}
+
+	model, _ = mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-2"})
+	if !model.TemplateID.IsNull() {
+		t.Errorf("expected null template_id, got %v", model.TemplateID)
+	}
+}
+
+func TestMapStackToState_RequiredChecksBeforeDestroy(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1", RequiredChecksBeforeDestroy: []string{"pol-1", "plan"}})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	var checks []string
+	model.RequiredChecks.ElementsAs(ctx, &checks, false)
+	if len(checks) != 2 || checks[0] != "pol-1" || checks[1] != "plan" {
+		t.Errorf("expected checks in API order, got %v", checks)
+	}
+
+	// The API reports no checks for both an empty list and an unset attribute.
+	model, _ = mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-2"})
+	if !model.RequiredChecks.IsNull() {
+		t.Fatalf("expected null required checks, got %v", model.RequiredChecks)
+	}
+	model.keepEmptyCollections(&StackModel{RequiredChecks: types.ListValueMust(types.StringType, nil)})
+	if model.RequiredChecks.IsNull() || len(model.RequiredChecks.Elements()) != 0 {
+		t.Errorf("expected the configured empty list to be kept, got %v", model.RequiredChecks)
+	}
+}
+
+func TestValidateConfig_RequiredChecksBeforeDestroy(t *testing.T) {
+	ctx := context.Background()
+	str := func(v string) tftypes.Value { return tftypes.NewValue(tftypes.String, v) }
+
+	tests := []struct {
+		name    string
+		checks  []tftypes.Value
+		unknown bool
+		want    string
+	}{
+		{name: "distinct", checks: []tftypes.Value{str("pol-1"), str("plan")}},
+		{name: "duplicate", checks: []tftypes.Value{str("pol-1"), str("pol-1")}, want: "Duplicate Required Check"},
+		{name: "empty", checks: []tftypes.Value{str(" ")}, want: "Invalid Required Check"},
+		{name: "not yet known", unknown: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := stackPlan(t, tftypes.NewValue(tftypes.Bool, nil))
+			objType := plan.Raw.Type().(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			if err := plan.Raw.As(&values); err != nil {
+				t.Fatal(err)
+			}
+			values["required_checks_before_destroy"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tt.checks)
+			if tt.unknown {
+				values["required_checks_before_destroy"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)
+			}
+			values["template_id"] = str("tpl-1")
+			config := tfsdk.Config{Schema: plan.Schema, Raw: tftypes.NewValue(objType, values)}
+
+			resp := &resource.ValidateConfigResponse{}
+			(&StackResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+			var got []string
+			for _, d := range resp.Diagnostics.Errors() {
+				got = append(got, d.Summary())
+			}
+			if (tt.want == "" && len(got) != 0) || (tt.want != "" && (len(got) != 1 || got[0] != tt.want)) {
+				t.Errorf("expected error %q, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestEnvironmentType(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1", EnvironmentType: zenfraclient.StackEnvironmentProduction})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	if model.EnvironmentType.ValueString() != "production" {
+		t.Errorf("expected environment_type production, got %v", model.EnvironmentType)
+	}
+	model, _ = mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-2"})
+	if !model.EnvironmentType.IsNull() {
+		t.Errorf("expected null environment_type, got %v", model.EnvironmentType)
+	}
+
+	for value, want := range map[string]string{"qa": "", "": "Invalid Environment Type"} {
+		plan := stackPlan(t, tftypes.NewValue(tftypes.Bool, nil))
+		values := map[string]tftypes.Value{}
+		if err := plan.Raw.As(&values); err != nil {
+			t.Fatal(err)
+		}
+		values["environment_type"] = tftypes.NewValue(tftypes.String, value)
+		values["template_id"] = tftypes.NewValue(tftypes.String, "tpl-1")
+		config := tfsdk.Config{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), values)}
+
+		resp := &resource.ValidateConfigResponse{}
+		(&StackResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+		var got []string
+		for _, d := range resp.Diagnostics.Errors() {
+			got = append(got, d.Summary())
+		}
+		if (want == "" && len(got) != 0) || (want != "" && (len(got) != 1 || got[0] != want)) {
+			t.Errorf("environment_type %q: expected error %q, got %v", value, want, got)
+		}
+	}
+}
+
+func TestKeepEmptyCollections_HooksAndEnvironment(t *testing.T) {
+	ctx := context.Background()
+
+	// The API omits empty hook lists and environments, so they read back as null.
+	model, diags := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1"})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	emptyList := types.ListValueMust(types.StringType, nil)
+	emptyMap := types.MapValueMust(types.StringType, map[string]attr.Value{})
+	model.keepEmptyCollections(&StackModel{
+		BeforeInit:  emptyList,
+		BeforePlan:  emptyList,
+		AfterApply:  emptyList,
+		Environment: emptyMap,
+	})
+	for name, got := range map[string]interface {
+		IsNull() bool
+		String() string
+	}{
+		"before_init": model.BeforeInit,
+		"before_plan": model.BeforePlan,
+		"after_apply": model.AfterApply,
+		"environment": model.Environment,
+	} {
+		if got.IsNull() {
+			t.Errorf("%s: expected the configured empty value to be kept, got %s", name, got)
+		}
+	}
+
+	// Unset ones stay null.
+	model, _ = mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-2"})
+	model.keepEmptyCollections(&StackModel{
+		BeforeInit:  types.ListNull(types.StringType),
+		Environment: types.MapNull(types.StringType),
+	})
+	if !model.BeforeInit.IsNull() || !model.Environment.IsNull() {
+		t.Errorf("expected null hooks and environment, got %s and %s", model.BeforeInit, model.Environment)
+	}
+}
+
+func TestValidateConfig_RunnerImage(t *testing.T) {
+	ctx := context.Background()
+
+	for value, want := range map[string]string{"ghcr.io/example/runner:1.4": "", "": "Invalid Runner Image", " ": "Invalid Runner Image"} {
+		plan := stackPlan(t, tftypes.NewValue(tftypes.Bool, nil))
+		values := map[string]tftypes.Value{}
+		if err := plan.Raw.As(&values); err != nil {
+			t.Fatal(err)
+		}
+		values["runner_image"] = tftypes.NewValue(tftypes.String, value)
+		values["template_id"] = tftypes.NewValue(tftypes.String, "tpl-1")
+		config := tfsdk.Config{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), values)}
+
+		resp := &resource.ValidateConfigResponse{}
+		(&StackResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, resp)
+
+		var got []string
+		for _, d := range resp.Diagnostics.Errors() {
+			got = append(got, d.Summary())
+		}
+		if (want == "" && len(got) != 0) || (want != "" && (len(got) != 1 || got[0] != want)) {
+			t.Errorf("runner_image %q: expected error %q, got %v", value, want, got)
+		}
+	}
+}
+
+func TestOwnerTeams(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1", OwnerTeamID: "team-sre", CollaboratorTeamIDs: []string{"team-data", "team-web"}})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	if model.OwnerTeamID.ValueString() != "team-sre" || len(model.Collaborators.Elements()) != 2 {
+		t.Errorf("expected the owner and both collaborators, got %v and %v", model.OwnerTeamID, model.Collaborators)
+	}
+	model, _ = mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-2"})
+	if !model.OwnerTeamID.IsNull() || !model.Collaborators.IsNull() {
+		t.Errorf("expected null owner and collaborators, got %v and %v", model.OwnerTeamID, model.Collaborators)
+	}
+
+	tests := []struct {
+		name                 string
+		owner                tftypes.Value
+		collaborators        []tftypes.Value
+		collaboratorsUnknown bool
+		wantError            string
+		wantWarning          bool
+	}{
+		{name: "owned", owner: tftypes.NewValue(tftypes.String, "team-sre"), collaborators: []tftypes.Value{tftypes.NewValue(tftypes.String, "team-web")}},
+		{name: "no owner", owner: tftypes.NewValue(tftypes.String, nil), wantWarning: true},
+		{name: "owner not yet known", owner: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+		{name: "empty owner", owner: tftypes.NewValue(tftypes.String, ""), wantError: "Invalid Owner Team"},
+		{name: "collaborators not yet known", owner: tftypes.NewValue(tftypes.String, "team-sre"), collaboratorsUnknown: true},
+		{
+			name:          "owner listed as collaborator",
+			owner:         tftypes.NewValue(tftypes.String, "team-sre"),
+			collaborators: []tftypes.Value{tftypes.NewValue(tftypes.String, "team-sre")},
+			wantError:     "Owner Listed As Collaborator",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := stackPlan(t, tftypes.NewValue(tftypes.Bool, nil))
+			values := map[string]tftypes.Value{}
+			if err := plan.Raw.As(&values); err != nil {
+				t.Fatal(err)
+			}
+			values["template_id"] = tftypes.NewValue(tftypes.String, "tpl-1")
+			values["owner_team_id"] = tt.owner
+			if tt.collaborators != nil {
+				values["collaborator_team_ids"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tt.collaborators)
+			}
+			if tt.collaboratorsUnknown {
+				values["collaborator_team_ids"] = tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue)
+			}
+			plan.Raw = tftypes.NewValue(plan.Raw.Type(), values)
+
+			validateResp := &resource.ValidateConfigResponse{}
+			(&StackResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config(plan)}, validateResp)
+			var errs []string
+			for _, d := range validateResp.Diagnostics.Errors() {
+				errs = append(errs, d.Summary())
+			}
+			if (tt.wantError == "" && len(errs) != 0) || (tt.wantError != "" && (len(errs) != 1 || errs[0] != tt.wantError)) {
+				t.Errorf("expected error %q, got %v", tt.wantError, errs)
+			}
+
+			state := tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}
+			planResp := &resource.ModifyPlanResponse{Plan: plan}
+			(&StackResource{}).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, planResp)
+			if got := len(planResp.Diagnostics.Warnings()) == 1; got != tt.wantWarning {
+				t.Errorf("expected ownership warning %v, got %v", tt.wantWarning, planResp.Diagnostics)
+			}
+
+			// A stack the plan leaves unchanged is not warned about.
+			planResp = &resource.ModifyPlanResponse{Plan: plan}
+			(&StackResource{}).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: tfsdk.State(plan)}, planResp)
+			if len(planResp.Diagnostics) != 0 {
+				t.Errorf("expected no diagnostics for an unchanged stack, got %v", planResp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRetentionPeriods(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1", RunRetentionDays: 30})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	if model.RunRetention.ValueInt64() != 30 || !model.LogRetention.IsNull() {
+		t.Errorf("expected run retention 30 and the organization's log retention, got %v and %v", model.RunRetention, model.LogRetention)
+	}
+
+	// Removing an override sends 0, which restores the organization's retention.
+	plan := *model
+	plan.RunRetention = types.Int64Null()
+	plan.LogRetention = types.Int64Value(7)
+	req, changed, diags := buildStackUpdate(ctx, &plan, model)
+	if diags.HasError() {
+		t.Fatalf("buildStackUpdate returned errors: %v", diags.Errors())
+	}
+	if !changed || req.RunRetentionDays == nil || *req.RunRetentionDays != 0 || req.LogRetentionDays == nil || *req.LogRetentionDays != 7 {
+		t.Errorf("unexpected update request: %+v", req)
+	}
+}
+
+func TestStateSharing(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1", StateSharing: zenfraclient.StackStateSharing{
+		ShareWithSpace:  true,
+		AllowedStackIDs: []string{"stack-app"},
+	}})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	sharing, diags := buildStateSharingFromModel(ctx, model.StateSharing)
+	if diags.HasError() {
+		t.Fatalf("buildStateSharingFromModel returned errors: %v", diags.Errors())
+	}
+	if sharing == nil || !sharing.ShareWithSpace || len(sharing.AllowedStackIDs) != 1 || sharing.AllowedStackIDs[0] != "stack-app" {
+		t.Errorf("expected sharing to round-trip, got %+v", sharing)
+	}
+
+	// Removing state_sharing sends the zero value, which stops sharing.
+	plan := *model
+	plan.StateSharing = types.ObjectNull(StateSharingModelAttrTypes)
+	req, changed, diags := buildStackUpdate(ctx, &plan, model)
+	if diags.HasError() {
+		t.Fatalf("buildStackUpdate returned errors: %v", diags.Errors())
+	}
+	if !changed || req.StateSharing == nil || req.StateSharing.ShareWithSpace || len(req.StateSharing.AllowedStackIDs) != 0 {
+		t.Errorf("unexpected update request: %+v", req.StateSharing)
+	}
+
+	// A stack sharing with no one maps to null, but a configured empty state_sharing is kept.
+	unshared, _ := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-2"})
+	if !unshared.StateSharing.IsNull() {
+		t.Errorf("expected null state_sharing, got %v", unshared.StateSharing)
+	}
+	empty := types.ObjectValueMust(StateSharingModelAttrTypes, map[string]attr.Value{
+		"share_with_space":  types.BoolValue(false),
+		"allowed_stack_ids": types.SetValueMust(types.StringType, nil),
+	})
+	unshared.keepEmptyCollections(&StackModel{StateSharing: empty})
+	if !unshared.StateSharing.Equal(empty) {
+		t.Errorf("expected the configured empty state_sharing to be kept, got %v", unshared.StateSharing)
+	}
+}
+
+func TestStateSharing_KeepsEmptyAllowedStackIDs(t *testing.T) {
+	ctx := context.Background()
+
+	// state_sharing { share_with_space = true, allowed_stack_ids = [] }
+	configured := types.ObjectValueMust(StateSharingModelAttrTypes, map[string]attr.Value{
+		"share_with_space":  types.BoolValue(true),
+		"allowed_stack_ids": types.SetValueMust(types.StringType, nil),
+	})
+
+	model, diags := mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1", StateSharing: zenfraclient.StackStateSharing{ShareWithSpace: true}})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState returned errors: %v", diags.Errors())
+	}
+	model.keepEmptyCollections(&StackModel{StateSharing: configured})
+	if !model.StateSharing.Equal(configured) {
+		t.Errorf("expected the configured empty allowed_stack_ids to be kept, got %v", model.StateSharing)
+	}
+
+	// Stacks added outside Terraform still show as drift.
+	model, _ = mapStackToState(ctx, &zenfraclient.Stack{ID: "stack-1", StateSharing: zenfraclient.StackStateSharing{
+		ShareWithSpace:  true,
+		AllowedStackIDs: []string{"stack-app"},
+	}})
+	model.keepEmptyCollections(&StackModel{StateSharing: configured})
+	var sharing StateSharingModel
+	model.StateSharing.As(ctx, &sharing, basetypes.ObjectAsOptions{})
+	if len(sharing.AllowedStackIDs.Elements()) != 1 {
+		t.Errorf("expected the stack reported by the API, got %v", model.StateSharing)
+	}
+}
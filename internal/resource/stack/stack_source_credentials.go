@@ -0,0 +1,140 @@
+// ABOUTME: Credentials of private raw_git sources: the write-only source_ssh_key or an HTTPS username and token.
+// ABOUTME: The API never returns the token, so state keeps it from the plan or prior state; the key is never stored.
+package stack
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// mapHTTPSCredentials maps the credentials the API reports, which carry no token, to
+// state. The token is null until keepSourceCredentials restores it.
+func mapHTTPSCredentials(ctx context.Context, creds *zenfraclient.StackSourceHTTPSCredentials) (types.Object, diag.Diagnostics) {
+	if creds == nil {
+		return types.ObjectNull(HTTPSCredentialsModelAttrTypes), nil
+	}
+	return types.ObjectValueFrom(ctx, HTTPSCredentialsModelAttrTypes, &HTTPSCredentialsModel{
+		Username: types.StringValue(creds.Username),
+		Token:    types.StringNull(),
+	})
+}
+
+// setSourceSSHKey adds the write-only source_ssh_key from config to a raw_git source
+// about to be sent. Plan and state never hold the key, so it is read from the
+// configuration on every create and update that sends the source.
+func setSourceSSHKey(ctx context.Context, config tfsdk.Config, source *zenfraclient.StackSource) diag.Diagnostics {
+	if source == nil || source.RawGit == nil {
+		return nil
+	}
+	var key types.String
+	diags := config.GetAttribute(ctx, path.Root("source_ssh_key"), &key)
+	source.RawGit.SSHKey = key.ValueString()
+	return diags
+}
+
+// keepSourceCredentials carries source_ssh_key_version from src and copies the
+// https_credentials token from it, the plan after a write or the prior state on read.
+// The token is only restored next to credentials the API reports, so credentials
+// removed outside Terraform show as drift.
+func (m *StackModel) keepSourceCredentials(ctx context.Context, src *StackModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m.SourceSSHKeyVersion = src.SourceSSHKeyVersion
+	if m.Source.IsNull() || m.Source.IsUnknown() || src.Source.IsNull() || src.Source.IsUnknown() {
+		return diags
+	}
+
+	var source, prior SourceModel
+	diags.Append(m.Source.As(ctx, &source, basetypes.ObjectAsOptions{})...)
+	diags.Append(src.Source.As(ctx, &prior, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || source.RawGit.IsNull() || prior.RawGit.IsNull() || prior.RawGit.IsUnknown() {
+		return diags
+	}
+
+	var rawGit, priorRawGit RawGitModel
+	diags.Append(source.RawGit.As(ctx, &rawGit, basetypes.ObjectAsOptions{})...)
+	diags.Append(prior.RawGit.As(ctx, &priorRawGit, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() || rawGit.HTTPSCredentials.IsNull() ||
+		priorRawGit.HTTPSCredentials.IsNull() || priorRawGit.HTTPSCredentials.IsUnknown() {
+		return diags
+	}
+
+	var creds, priorCreds HTTPSCredentialsModel
+	diags.Append(rawGit.HTTPSCredentials.As(ctx, &creds, basetypes.ObjectAsOptions{})...)
+	diags.Append(priorRawGit.HTTPSCredentials.As(ctx, &priorCreds, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+	creds.Token = priorCreds.Token
+
+	credsObj, d := types.ObjectValueFrom(ctx, HTTPSCredentialsModelAttrTypes, &creds)
+	diags.Append(d...)
+	rawGit.HTTPSCredentials = credsObj
+	rawGitObj, d := types.ObjectValueFrom(ctx, RawGitModelAttrTypes, &rawGit)
+	diags.Append(d...)
+	source.RawGit = rawGitObj
+	sourceObj, d := types.ObjectValueFrom(ctx, SourceModelAttrTypes, &source)
+	diags.Append(d...)
+	if !diags.HasError() {
+		m.Source = sourceObj
+	}
+	return diags
+}
+
+// validateSourceCredentials checks that the stack sets at most one kind of git
+// credentials, and the kind that matches its raw_git URL.
+func validateSourceCredentials(ctx context.Context, sourceObj types.Object, sshKey types.String, diags *diag.Diagnostics) {
+	hasSSHKey := !sshKey.IsNull()
+	if sourceObj.IsUnknown() {
+		return
+	}
+	var source SourceModel
+	if !sourceObj.IsNull() {
+		diags.Append(sourceObj.As(ctx, &source, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return
+		}
+	}
+	if sourceObj.IsNull() || source.RawGit.IsNull() {
+		if hasSSHKey {
+			diags.AddAttributeError(path.Root("source_ssh_key"), "SSH Key Without Raw Git Source",
+				"source_ssh_key is only used by a raw_git source; remove it or configure source.raw_git with an SSH url.")
+		}
+		return
+	}
+	if source.RawGit.IsUnknown() {
+		return
+	}
+	var rawGit RawGitModel
+	diags.Append(source.RawGit.As(ctx, &rawGit, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() {
+		return
+	}
+
+	hasHTTPS := !rawGit.HTTPSCredentials.IsNull()
+	if hasSSHKey && hasHTTPS {
+		diags.AddAttributeError(path.Root("source_ssh_key"), "Conflicting Git Credentials",
+			"source_ssh_key and source.raw_git.https_credentials cannot both be set; use source_ssh_key with an SSH url "+
+				"and https_credentials with an HTTPS url.")
+		return
+	}
+	if rawGit.URL.IsNull() || rawGit.URL.IsUnknown() {
+		return
+	}
+
+	isHTTPS := strings.HasPrefix(strings.ToLower(rawGit.URL.ValueString()), "https://")
+	switch {
+	case hasSSHKey && isHTTPS:
+		diags.AddAttributeError(path.Root("source_ssh_key"), "SSH Key With HTTPS URL",
+			"source_ssh_key needs an SSH url such as 'git@github.com:org/repo.git'; use https_credentials for an HTTPS url.")
+	case hasHTTPS && !isHTTPS:
+		diags.AddAttributeError(path.Root("source").AtName("raw_git").AtName("https_credentials"), "HTTPS Credentials Without HTTPS URL",
+			"https_credentials need an https:// url; use source_ssh_key for an SSH url.")
+	}
+}
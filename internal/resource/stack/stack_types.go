@@ -0,0 +1,292 @@
+// ABOUTME: Custom string types for zenfra_stack attributes the API normalizes on write.
+// ABOUTME: Semantic equality keeps the configured spelling in state so refresh does not produce no-op diffs.
+package stack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = SourcePathType{}
+	_ basetypes.StringValuableWithSemanticEquals = SourcePathValue{}
+	_ xattr.ValidateableAttribute                = SourcePathValue{}
+	_ basetypes.StringTypable                    = RefNameType{}
+	_ basetypes.StringValuableWithSemanticEquals = RefNameValue{}
+	_ basetypes.StringTypable                    = IACVersionType{}
+	_ basetypes.StringValuableWithSemanticEquals = IACVersionValue{}
+)
+
+// --- Source path ---
+
+// SourcePathType is the type of a path within a source repository.
+type SourcePathType struct {
+	basetypes.StringType
+}
+
+func (t SourcePathType) String() string {
+	return "stack.SourcePathType"
+}
+
+func (t SourcePathType) Equal(o attr.Type) bool {
+	other, ok := o.(SourcePathType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SourcePathType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SourcePathValue{StringValue: in}, nil
+}
+
+func (t SourcePathType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	stringValue, err := stringFromTerraform(ctx, t.StringType, in)
+	if err != nil {
+		return nil, err
+	}
+	return SourcePathValue{StringValue: stringValue}, nil
+}
+
+func (t SourcePathType) ValueType(_ context.Context) attr.Value {
+	return SourcePathValue{}
+}
+
+// SourcePathValue is a repository path where "./infra/", "infra/", and "infra" are equal.
+type SourcePathValue struct {
+	basetypes.StringValue
+}
+
+// NewSourcePathValue returns a known SourcePathValue, or null for an empty path
+// since the API reports the repository root as "".
+func NewSourcePathValue(value string) SourcePathValue {
+	if value == "" {
+		return SourcePathValue{StringValue: basetypes.NewStringNull()}
+	}
+	return SourcePathValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v SourcePathValue) Type(_ context.Context) attr.Type {
+	return SourcePathType{}
+}
+
+func (v SourcePathValue) Equal(o attr.Value) bool {
+	other, ok := o.(SourcePathValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SourcePathValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SourcePathValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected SourcePathValue, got: %T", newValuable))
+		return false, diags
+	}
+
+	return normalizeSourcePath(v.ValueString()) == normalizeSourcePath(newValue.ValueString()), diags
+}
+
+// ValidateAttribute rejects an explicit empty path, which the API cannot distinguish from an omitted one.
+func (v SourcePathValue) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+	if normalizeSourcePath(v.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Source Path",
+			"Path must not be empty or refer to the repository root. Omit path to use the repository root.",
+		)
+	}
+}
+
+func normalizeSourcePath(p string) string {
+	p = strings.TrimSpace(p)
+	for strings.HasPrefix(p, "./") {
+		p = strings.TrimPrefix(p, "./")
+	}
+	p = strings.Trim(p, "/")
+	if p == "." {
+		return ""
+	}
+	return p
+}
+
+// --- Ref name ---
+
+// RefNameType is the type of a git reference name.
+type RefNameType struct {
+	basetypes.StringType
+}
+
+func (t RefNameType) String() string {
+	return "stack.RefNameType"
+}
+
+func (t RefNameType) Equal(o attr.Type) bool {
+	other, ok := o.(RefNameType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t RefNameType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return RefNameValue{StringValue: in}, nil
+}
+
+func (t RefNameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	stringValue, err := stringFromTerraform(ctx, t.StringType, in)
+	if err != nil {
+		return nil, err
+	}
+	return RefNameValue{StringValue: stringValue}, nil
+}
+
+func (t RefNameType) ValueType(_ context.Context) attr.Value {
+	return RefNameValue{}
+}
+
+// RefNameValue is a branch, tag, or commit name compared case-insensitively,
+// matching how the API resolves refs.
+type RefNameValue struct {
+	basetypes.StringValue
+}
+
+// NewRefNameValue returns a known RefNameValue.
+func NewRefNameValue(value string) RefNameValue {
+	return RefNameValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v RefNameValue) Type(_ context.Context) attr.Type {
+	return RefNameType{}
+}
+
+func (v RefNameValue) Equal(o attr.Value) bool {
+	other, ok := o.(RefNameValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v RefNameValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(RefNameValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected RefNameValue, got: %T", newValuable))
+		return false, diags
+	}
+
+	return strings.EqualFold(strings.TrimSpace(v.ValueString()), strings.TrimSpace(newValue.ValueString())), diags
+}
+
+// --- IAC version ---
+
+// IACVersionType is the type of an IaC engine version.
+type IACVersionType struct {
+	basetypes.StringType
+}
+
+func (t IACVersionType) String() string {
+	return "stack.IACVersionType"
+}
+
+func (t IACVersionType) Equal(o attr.Type) bool {
+	other, ok := o.(IACVersionType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t IACVersionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return IACVersionValue{StringValue: in}, nil
+}
+
+func (t IACVersionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	stringValue, err := stringFromTerraform(ctx, t.StringType, in)
+	if err != nil {
+		return nil, err
+	}
+	return IACVersionValue{StringValue: stringValue}, nil
+}
+
+func (t IACVersionType) ValueType(_ context.Context) attr.Value {
+	return IACVersionValue{}
+}
+
+// IACVersionValue is an engine version where "v1.6", "1.6", and "1.6.0" are equal.
+type IACVersionValue struct {
+	basetypes.StringValue
+}
+
+// NewIACVersionValue returns a known IACVersionValue.
+func NewIACVersionValue(value string) IACVersionValue {
+	return IACVersionValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v IACVersionValue) Type(_ context.Context) attr.Type {
+	return IACVersionType{}
+}
+
+func (v IACVersionValue) Equal(o attr.Value) bool {
+	other, ok := o.(IACVersionValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v IACVersionValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(IACVersionValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected IACVersionValue, got: %T", newValuable))
+		return false, diags
+	}
+
+	return normalizeIACVersion(v.ValueString()) == normalizeIACVersion(newValue.ValueString()), diags
+}
+
+// normalizeIACVersion strips a leading "v" and pads plain numeric versions to
+// major.minor.patch. Versions with pre-release or build suffixes are only trimmed.
+func normalizeIACVersion(version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	if strings.ContainsAny(version, "-+") {
+		return version
+	}
+
+	parts := strings.Split(version, ".")
+	for len(parts) < 3 {
+		parts = append(parts, "0")
+	}
+	return strings.Join(parts, ".")
+}
+
+// stringFromTerraform converts a Terraform value using the embedded base string type.
+func stringFromTerraform(ctx context.Context, base basetypes.StringType, in tftypes.Value) (basetypes.StringValue, error) {
+	attrValue, err := base.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return basetypes.StringValue{}, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return basetypes.StringValue{}, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return stringValue, nil
+}
@@ -0,0 +1,117 @@
+// ABOUTME: Unit tests for the zenfra_stack custom string types.
+// ABOUTME: Verifies semantic equality rules for source paths, ref names, and IaC versions.
+package stack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSourcePathValue_SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		prior, proposed string
+		want            bool
+	}{
+		{"infra", "infra", true},
+		{"infra", "./infra", true},
+		{"infra", "infra/", true},
+		{"infra/prod", "/infra/prod/", true},
+		{"infra", "Infra", false},
+		{"infra", "infra/prod", false},
+	}
+
+	for _, tt := range tests {
+		got, diags := NewSourcePathValue(tt.prior).StringSemanticEquals(ctx, NewSourcePathValue(tt.proposed))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags.Errors())
+		}
+		if got != tt.want {
+			t.Errorf("%q vs %q: expected %v, got %v", tt.prior, tt.proposed, tt.want, got)
+		}
+	}
+}
+
+func TestNewSourcePathValue_EmptyIsNull(t *testing.T) {
+	if !NewSourcePathValue("").IsNull() {
+		t.Error("expected empty API path to map to null")
+	}
+	if NewSourcePathValue("infra").IsNull() {
+		t.Error("expected non-empty path to be known")
+	}
+}
+
+func TestSourcePathValue_ValidateAttribute(t *testing.T) {
+	ctx := context.Background()
+
+	for _, value := range []string{"", ".", "./", "/"} {
+		resp := &xattr.ValidateAttributeResponse{}
+		v := SourcePathValue{StringValue: types.StringValue(value)}
+		v.ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: path.Root("path")}, resp)
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("expected error for path %q", value)
+		}
+	}
+
+	resp := &xattr.ValidateAttributeResponse{}
+	NewSourcePathValue("infra").ValidateAttribute(ctx, xattr.ValidateAttributeRequest{Path: path.Root("path")}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("unexpected error for valid path: %v", resp.Diagnostics.Errors())
+	}
+}
+
+func TestRefNameValue_SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		prior, proposed string
+		want            bool
+	}{
+		{"main", "main", true},
+		{"main", "Main", true},
+		{"release/V1", "release/v1", true},
+		{"main", "develop", false},
+	}
+
+	for _, tt := range tests {
+		got, diags := NewRefNameValue(tt.prior).StringSemanticEquals(ctx, NewRefNameValue(tt.proposed))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags.Errors())
+		}
+		if got != tt.want {
+			t.Errorf("%q vs %q: expected %v, got %v", tt.prior, tt.proposed, tt.want, got)
+		}
+	}
+}
+
+func TestIACVersionValue_SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		prior, proposed string
+		want            bool
+	}{
+		{"1.6.0", "1.6.0", true},
+		{"1.6.0", "v1.6.0", true},
+		{"1.6.0", "1.6", true},
+		{"1.0.0", "1", true},
+		{"1.6.0", "1.6.1", false},
+		{"1.7.0-rc1", "v1.7.0-rc1", true},
+		{"1.7.0-rc1", "1.7.0", false},
+	}
+
+	for _, tt := range tests {
+		got, diags := NewIACVersionValue(tt.prior).StringSemanticEquals(ctx, NewIACVersionValue(tt.proposed))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags.Errors())
+		}
+		if got != tt.want {
+			t.Errorf("%q vs %q: expected %v, got %v", tt.prior, tt.proposed, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,349 @@
+// ABOUTME: Unit tests for zenfra_stack Update against the zenfrafake client.
+// ABOUTME: Checks which endpoints each combination of changes calls and that state comes from their responses.
+package stack
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func updateTestStack() *zenfraclient.Stack {
+	return &zenfraclient.Stack{
+		ID:             "stack-1",
+		OrganizationID: "org-1",
+		SpaceID:        "space-1",
+		Name:           "network",
+		IAC:            zenfraclient.IACConfig{Engine: "terraform", Version: "1.6.0"},
+		Source: zenfraclient.StackSource{
+			Type: sourceTypeRawGit,
+			RawGit: &zenfraclient.StackSourceRawGit{
+				URL: "https://github.com/example/infra.git",
+				Ref: zenfraclient.StackSourceRef{Type: "branch", Name: "main"},
+			},
+		},
+		Environment: map[string]string{"TF_LOG": "info"},
+		Status:      zenfraclient.StackStatusReady,
+		CreatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		UpdatedAt:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// stackState returns a state for the stack schema set to the mapping of stack, with
+// the provider-side settings left at their defaults.
+func stackState(t *testing.T, stack *zenfraclient.Stack, tweak func(*StackModel)) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	model, diags := mapStackToState(ctx, stack)
+	if diags.HasError() {
+		t.Fatalf("mapStackToState: %v", diags)
+	}
+	model.WaitForReady = types.BoolValue(false)
+	model.ForceDelete = types.BoolValue(false)
+	model.DetachBundlesOnDelete = types.BoolValue(false)
+	if tweak != nil {
+		tweak(model)
+	}
+
+	schemaResp := &resource.SchemaResponse{}
+	(&StackResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("setting state: %v", diags)
+	}
+	return state
+}
+
+func TestStackResource_UpdateCalls(t *testing.T) {
+	ctx := context.Background()
+
+	rename := func(s *zenfraclient.Stack) { s.Name = "network-v2" }
+	retrigger := func(s *zenfraclient.Stack) {
+		s.Triggers.OnPush = zenfraclient.StackTriggerOnPush{Enabled: true, Branches: []string{"main"}}
+	}
+	rebranch := func(s *zenfraclient.Stack) { s.Source.RawGit.Ref.Name = "release" }
+
+	tests := []struct {
+		name      string
+		change    []func(*zenfraclient.Stack)
+		tweak     func(*StackModel)
+		wantCalls []string
+		wantIAC   bool
+		wantSrc   bool
+	}{
+		{name: "provider settings only", tweak: func(m *StackModel) { m.ForceDelete = types.BoolValue(true) }},
+		{name: "name", change: []func(*zenfraclient.Stack){rename}, wantCalls: []string{"UpdateStack"}},
+		{name: "triggers", change: []func(*zenfraclient.Stack){retrigger}, wantCalls: []string{"SetStackTriggers", "GetStack"}},
+		{name: "source", change: []func(*zenfraclient.Stack){rebranch}, wantCalls: []string{"UpdateStack"}, wantSrc: true},
+		{name: "source and name", change: []func(*zenfraclient.Stack){rebranch, rename}, wantCalls: []string{"UpdateStack"}, wantSrc: true},
+		{name: "triggers and name", change: []func(*zenfraclient.Stack){retrigger, rename}, wantCalls: []string{"SetStackTriggers", "UpdateStack"}},
+		{
+			name:      "triggers, source, and environment",
+			change:    []func(*zenfraclient.Stack){retrigger, rebranch, func(s *zenfraclient.Stack) { s.Environment = nil }},
+			wantCalls: []string{"SetStackTriggers", "UpdateStack"},
+			wantSrc:   true,
+		},
+		{name: "iac version spelling", change: []func(*zenfraclient.Stack){func(s *zenfraclient.Stack) { s.IAC.Version = " v1.6 " }}},
+		{name: "iac engine case", change: []func(*zenfraclient.Stack){func(s *zenfraclient.Stack) { s.IAC.Engine = "Terraform" }}},
+		{name: "iac version", change: []func(*zenfraclient.Stack){func(s *zenfraclient.Stack) { s.IAC.Version = "1.7.0" }}, wantCalls: []string{"UpdateStack"}, wantIAC: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			planned := updateTestStack()
+			for _, change := range tt.change {
+				change(planned)
+			}
+			// What the API reports after the update: the planned stack, normalized and newly stamped.
+			after := updateTestStack()
+			for _, change := range tt.change {
+				change(after)
+			}
+			if !tt.wantIAC {
+				after.IAC = updateTestStack().IAC
+			}
+			after.UpdatedAt = after.UpdatedAt.Add(time.Hour)
+
+			var gotReq *zenfraclient.UpdateStackRequest
+			fake := &zenfrafake.Client{
+				SetStackTriggersFunc: func(context.Context, string, zenfraclient.StackTriggers) error { return nil },
+				UpdateStackFunc: func(_ context.Context, _ string, req zenfraclient.UpdateStackRequest) (*zenfraclient.Stack, error) {
+					gotReq = &req
+					return after, nil
+				},
+				GetStackFunc: func(context.Context, string) (*zenfraclient.Stack, error) { return after, nil },
+			}
+			r := &StackResource{client: fake}
+
+			prior := stackState(t, updateTestStack(), nil)
+			plan := stackState(t, planned, tt.tweak)
+			resp := &resource.UpdateResponse{State: prior}
+			r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: prior, Config: tfsdk.Config(plan)}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update: %v", resp.Diagnostics)
+			}
+
+			if calls := fake.Calls(); !slices.Equal(calls, tt.wantCalls) {
+				t.Errorf("expected calls %v, got %v", tt.wantCalls, calls)
+			}
+			if gotReq != nil && (gotReq.IAC != nil) != tt.wantIAC {
+				t.Errorf("expected iac sent = %v, got %+v", tt.wantIAC, gotReq.IAC)
+			}
+			if gotReq != nil && (gotReq.Source != nil) != tt.wantSrc {
+				t.Errorf("expected source sent = %v, got %+v", tt.wantSrc, gotReq.Source)
+			}
+
+			var got, want StackModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			resp.Diagnostics.Append(plan.Get(ctx, &want)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("reading state: %v", resp.Diagnostics)
+			}
+			for attr, pair := range map[string][2]interface{ String() string }{
+				"name":         {got.Name, want.Name},
+				"iac":          {got.IAC, want.IAC},
+				"source":       {got.Source, want.Source},
+				"triggers":     {got.Triggers, want.Triggers},
+				"environment":  {got.Environment, want.Environment},
+				"force_delete": {got.ForceDelete, want.ForceDelete},
+			} {
+				if pair[0].String() != pair[1].String() {
+					t.Errorf("%s: expected %s in state, got %s", attr, pair[1], pair[0])
+				}
+			}
+
+			wantUpdatedAt := "2026-01-01T00:00:00Z"
+			if len(tt.wantCalls) > 0 {
+				wantUpdatedAt = "2026-01-01T01:00:00Z"
+			}
+			if got.UpdatedAt.ValueString() != wantUpdatedAt {
+				t.Errorf("expected updated_at %s from the last response, got %s", wantUpdatedAt, got.UpdatedAt)
+			}
+		})
+	}
+}
+
+func TestStackResource_UpdateKeepsPullRequestTriggers(t *testing.T) {
+	ctx := context.Background()
+	withPRTriggers := func() *zenfraclient.Stack {
+		stack := updateTestStack()
+		stack.Triggers.OnPush = zenfraclient.StackTriggerOnPush{Enabled: true}
+		stack.Triggers.OnPullRequest = zenfraclient.StackTriggerOnPullRequest{Enabled: true, CommentResults: true}
+		return stack
+	}
+	renamed := withPRTriggers()
+	renamed.Name = "network-v2"
+
+	var sent *zenfraclient.StackTriggers
+	fake := &zenfrafake.Client{
+		SetStackTriggersFunc: func(_ context.Context, _ string, triggers zenfraclient.StackTriggers) error {
+			sent = &triggers
+			return nil
+		},
+		UpdateStackFunc: func(context.Context, string, zenfraclient.UpdateStackRequest) (*zenfraclient.Stack, error) {
+			return renamed, nil
+		},
+	}
+	r := &StackResource{client: fake}
+
+	// Only on_push is configured, so on_pull_request is unknown in the plan of a rename.
+	prior := stackState(t, withPRTriggers(), nil)
+	plan := stackState(t, renamed, func(m *StackModel) {
+		var triggers TriggersModel
+		m.Triggers.As(ctx, &triggers, basetypes.ObjectAsOptions{})
+		triggers.OnPullRequest = types.ObjectUnknown(OnPullRequestModelAttrTypes)
+		m.Triggers, _ = types.ObjectValueFrom(ctx, TriggersModelAttrTypes, &triggers)
+	})
+	resp := &resource.UpdateResponse{State: prior}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: prior, Config: tfsdk.Config(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+
+	if calls := fake.Calls(); !slices.Equal(calls, []string{"UpdateStack"}) {
+		t.Errorf("expected only UpdateStack, got %v (triggers sent: %+v)", calls, sent)
+	}
+	var state StackModel
+	var triggers TriggersModel
+	var pr OnPullRequestModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(state.Triggers.As(ctx, &triggers, basetypes.ObjectAsOptions{})...)
+	resp.Diagnostics.Append(triggers.OnPullRequest.As(ctx, &pr, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("reading state: %v", resp.Diagnostics)
+	}
+	if !pr.Enabled.ValueBool() || !pr.CommentResults.ValueBool() {
+		t.Errorf("expected pull request triggers to survive the rename, got %+v", pr)
+	}
+}
+
+func TestWithPriorTriggers(t *testing.T) {
+	ctx := context.Background()
+	prior, diags := mapStackToState(ctx, &zenfraclient.Stack{Triggers: zenfraclient.StackTriggers{
+		OnPullRequest: zenfraclient.StackTriggerOnPullRequest{Enabled: true, SpeculativeOnly: true},
+	}})
+	if diags.HasError() {
+		t.Fatalf("mapStackToState: %v", diags)
+	}
+	planned, _ := types.ObjectValueFrom(ctx, TriggersModelAttrTypes, &TriggersModel{
+		OnPush:        types.ObjectUnknown(OnPushModelAttrTypes),
+		OnPullRequest: types.ObjectUnknown(OnPullRequestModelAttrTypes),
+		OnSchedule:    types.ListNull(types.ObjectType{AttrTypes: OnScheduleModelAttrTypes}),
+	})
+
+	got, diags := withPriorTriggers(ctx, planned, prior.Triggers)
+	if diags.HasError() {
+		t.Fatalf("withPriorTriggers: %v", diags)
+	}
+	var model TriggersModel
+	got.As(ctx, &model, basetypes.ObjectAsOptions{})
+	built, diags := buildTriggersFromModel(ctx, &model)
+	if diags.HasError() {
+		t.Fatalf("buildTriggersFromModel: %v", diags)
+	}
+	if !built.OnPullRequest.Enabled || !built.OnPullRequest.SpeculativeOnly {
+		t.Errorf("expected the prior pull request triggers to be sent, got %+v", built.OnPullRequest)
+	}
+}
+
+func TestStackResource_UpdateSourceSync(t *testing.T) {
+	ctx := context.Background()
+	syncedAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	resyncedAt := syncedAt.Add(time.Hour)
+
+	tests := []struct {
+		name       string
+		change     func(*zenfraclient.Stack)
+		wantCommit string
+	}{
+		{name: "name", change: func(s *zenfraclient.Stack) { s.Name = "network-v2" }, wantCommit: "3f9c2ab"},
+		{name: "source", change: func(s *zenfraclient.Stack) { s.Source.RawGit.Ref.Name = "release" }, wantCommit: "7d41e0c"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := updateTestStack()
+			before.SourceCommit, before.SourceSyncedAt = "3f9c2ab", &syncedAt
+			planned := updateTestStack()
+			tt.change(planned)
+			// The API has synced the source again by the time it answers, whatever changed.
+			after := updateTestStack()
+			tt.change(after)
+			after.SourceCommit, after.SourceSyncedAt = "7d41e0c", &resyncedAt
+
+			fake := &zenfrafake.Client{
+				UpdateStackFunc: func(context.Context, string, zenfraclient.UpdateStackRequest) (*zenfraclient.Stack, error) {
+					return after, nil
+				},
+			}
+			r := &StackResource{client: fake}
+
+			prior := stackState(t, before, nil)
+			plan := stackState(t, planned, func(m *StackModel) {
+				m.SourceCommit, m.SourceSyncedAt = types.StringValue("3f9c2ab"), timeutil.Timestamp(syncedAt)
+			})
+			modifyResp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+			planSourceSync(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: prior}, modifyResp)
+
+			resp := &resource.UpdateResponse{State: prior}
+			r.Update(ctx, resource.UpdateRequest{Plan: modifyResp.Plan, State: prior, Config: tfsdk.Config(modifyResp.Plan)}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update: %v", resp.Diagnostics)
+			}
+
+			var got StackModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.SourceCommit.ValueString() != tt.wantCommit {
+				t.Errorf("expected source_commit %s, got %s", tt.wantCommit, got.SourceCommit)
+			}
+		})
+	}
+}
+
+func TestStackResource_UpdateWaitsForIdle(t *testing.T) {
+	ctx := context.Background()
+	planned := updateTestStack()
+	planned.Source.RawGit.Ref.Name = "release"
+
+	updates := 0
+	fake := &zenfrafake.Client{
+		UpdateStackFunc: func(context.Context, string, zenfraclient.UpdateStackRequest) (*zenfraclient.Stack, error) {
+			updates++
+			if updates == 1 {
+				return nil, zenfrafake.Conflict("stack has a run in progress")
+			}
+			return planned, nil
+		},
+		ListActiveStackRunsFunc: func(context.Context, string) ([]zenfraclient.ActiveRun, error) {
+			return []zenfraclient.ActiveRun{{ID: "run-1", Status: "applying"}}, nil
+		},
+		WaitForStackIdleFunc: func(context.Context, string, time.Duration) error { return nil },
+	}
+	r := &StackResource{client: fake}
+
+	prior := stackState(t, updateTestStack(), nil)
+	plan := stackState(t, planned, func(m *StackModel) { m.WaitForIdle = types.BoolValue(true) })
+	resp := &resource.UpdateResponse{State: prior}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(plan), State: prior, Config: tfsdk.Config(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Update: %v", resp.Diagnostics)
+	}
+
+	want := []string{"UpdateStack", "ListActiveStackRuns", "WaitForStackIdle", "UpdateStack"}
+	if calls := fake.Calls(); !slices.Equal(calls, want) {
+		t.Errorf("expected calls %v, got %v", want, calls)
+	}
+}
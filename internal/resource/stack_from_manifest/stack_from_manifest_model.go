@@ -0,0 +1,68 @@
+// ABOUTME: Terraform state model for the zenfra_stack_from_manifest resource.
+// ABOUTME: Maps a stack and its normalized manifest to state, expanding the parsed fields into computed attributes.
+package stack_from_manifest
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/tfvalue"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// StackFromManifestModel represents the Terraform state model for a stack defined by a manifest.
+type StackFromManifestModel struct {
+	ID                 types.String            `tfsdk:"id"`
+	SpaceID            types.String            `tfsdk:"space_id"`
+	Manifest           types.String            `tfsdk:"manifest"`
+	NormalizedManifest types.String            `tfsdk:"normalized_manifest"`
+	Name               types.String            `tfsdk:"name"`
+	IACEngine          types.String            `tfsdk:"iac_engine"`
+	IACVersion         types.String            `tfsdk:"iac_version"`
+	SourceType         types.String            `tfsdk:"source_type"`
+	SourceRefType      types.String            `tfsdk:"source_ref_type"`
+	SourceRefName      types.String            `tfsdk:"source_ref_name"`
+	SourcePath         types.String            `tfsdk:"source_path"`
+	EnvironmentType    types.String            `tfsdk:"environment_type"`
+	Status             types.String            `tfsdk:"status"`
+	CreatedAt          timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt          timeutil.TimestampValue `tfsdk:"updated_at"`
+	OrganizationID     types.String            `tfsdk:"organization_id"`
+}
+
+// mapManifestToState converts a stack and its normalized manifest to state. The
+// configured manifest text is kept as is, since the API only returns the normalized form.
+func mapManifestToState(m *zenfraclient.StackManifest, manifest types.String) StackFromManifestModel {
+	model := StackFromManifestModel{
+		ID:                 types.StringValue(m.Stack.ID),
+		SpaceID:            types.StringValue(m.Stack.SpaceID),
+		Manifest:           manifest,
+		NormalizedManifest: types.StringValue(m.Normalized),
+		Status:             types.StringValue(m.Stack.Status),
+		CreatedAt:          timeutil.Timestamp(m.Stack.CreatedAt),
+		UpdatedAt:          timeutil.Timestamp(m.Stack.UpdatedAt),
+	}
+	setExpandedFields(&model, &m.Stack)
+	return model
+}
+
+// setExpandedFields sets the computed attributes parsed from the manifest. Fields the
+// manifest leaves unset map to null.
+func setExpandedFields(model *StackFromManifestModel, stack *zenfraclient.Stack) {
+	model.Name = types.StringValue(stack.Name)
+	model.IACEngine = tfvalue.OptionalString(stack.IAC.Engine)
+	model.IACVersion = tfvalue.OptionalString(stack.IAC.Version)
+	model.SourceType = tfvalue.OptionalString(stack.Source.Type)
+	model.EnvironmentType = tfvalue.OptionalString(stack.EnvironmentType)
+
+	var ref zenfraclient.StackSourceRef
+	var sourcePath string
+	switch {
+	case stack.Source.RawGit != nil:
+		ref, sourcePath = stack.Source.RawGit.Ref, stack.Source.RawGit.Path
+	case stack.Source.VCS != nil:
+		ref, sourcePath = stack.Source.VCS.Ref, stack.Source.VCS.Path
+	}
+	model.SourceRefType = tfvalue.OptionalString(ref.Type)
+	model.SourceRefName = tfvalue.OptionalString(ref.Name)
+	model.SourcePath = tfvalue.OptionalString(sourcePath)
+}
@@ -0,0 +1,342 @@
+// ABOUTME: Implements the zenfra_stack_from_manifest Terraform resource with full CRUD lifecycle.
+// ABOUTME: Manages a stack from a YAML or JSON manifest, validated and expanded by the API at plan time.
+package stack_from_manifest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &StackFromManifestResource{}
+	_ resource.ResourceWithImportState    = &StackFromManifestResource{}
+	_ resource.ResourceWithValidateConfig = &StackFromManifestResource{}
+	_ resource.ResourceWithModifyPlan     = &StackFromManifestResource{}
+)
+
+// NewStackFromManifestResource is a constructor for the stack from manifest resource.
+func NewStackFromManifestResource() resource.Resource {
+	return &StackFromManifestResource{}
+}
+
+// StackFromManifestResource is the resource implementation.
+type StackFromManifestResource struct {
+	client zenfraclient.StackManifestAPI
+}
+
+func (r *StackFromManifestResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_stack_from_manifest"
+}
+
+func (r *StackFromManifestResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a stack described by a Zenfra stack manifest instead of discrete attributes, " +
+			"for example the zenfra.yaml kept in an application repository and read with file(). " +
+			"The manifest is validated by the API at plan time, and the fields it sets are exposed as computed attributes. " +
+			"Settings the manifest leaves out are reset to their defaults on every apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the stack.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Description: "The space ID this stack belongs to.",
+				Required:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID this stack belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to the space's organization_id when the space belongs to another organization the token has access to. " +
+					"Changing it forces a new stack.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"manifest": schema.StringAttribute{
+				Description: "The stack manifest, as YAML or JSON. Formatting and key order do not matter to the stack, " +
+					"so a reformatted manifest plans an update that leaves normalized_manifest unchanged. " +
+					"When the stack is changed outside Terraform, or after an import, this holds the normalized manifest " +
+					"so the plan shows the difference.",
+				Required: true,
+			},
+			"normalized_manifest": schema.StringAttribute{
+				Description: "The manifest as canonical JSON, with defaulted fields filled in.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the stack, from the manifest.",
+				Computed:    true,
+			},
+			"iac_engine": schema.StringAttribute{
+				Description: "The IaC engine, from the manifest.",
+				Computed:    true,
+			},
+			"iac_version": schema.StringAttribute{
+				Description: "The IaC engine version, from the manifest.",
+				Computed:    true,
+			},
+			"source_type": schema.StringAttribute{
+				Description: "The source type, raw_git or vcs, from the manifest.",
+				Computed:    true,
+			},
+			"source_ref_type": schema.StringAttribute{
+				Description: "What the source checks out, branch, tag, or commit, from the manifest.",
+				Computed:    true,
+			},
+			"source_ref_name": schema.StringAttribute{
+				Description: "The branch, tag, or commit the source checks out, from the manifest.",
+				Computed:    true,
+			},
+			"source_path": schema.StringAttribute{
+				Description: "The directory within the repository that holds the stack's code, from the manifest.",
+				Computed:    true,
+			},
+			"environment_type": schema.StringAttribute{
+				Description: "The environment tier of the stack, from the manifest.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The current status of the stack.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the stack was created.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Description: "Timestamp when the stack was last updated.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *StackFromManifestResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_stack_from_manifest")
+	}
+}
+
+// ValidateConfig rejects a blank manifest, which the API would otherwise read as an
+// empty stack with every setting at its default.
+func (r *StackFromManifestResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config StackFromManifestModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Manifest.IsNull() && !config.Manifest.IsUnknown() && strings.TrimSpace(config.Manifest.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Empty Stack Manifest",
+			"manifest must describe the stack. Read it from the application repository, for example with file(\"zenfra.yaml\").")
+	}
+}
+
+// ModifyPlan validates a new or changed manifest with the API and fills in the computed
+// attributes it expands to, so the plan shows what the stack will look like. Each
+// problem found in the manifest fails the plan. If the API cannot be asked, the plan
+// gets a warning and the computed attributes stay unknown until apply.
+func (r *StackFromManifestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_stack_from_manifest", "stack", req, resp)
+
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan StackFromManifestModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Manifest.IsUnknown() || plan.SpaceID.IsUnknown() {
+		return
+	}
+
+	validateReq := zenfraclient.ValidateStackManifestRequest{
+		Manifest: plan.Manifest.ValueString(),
+		SpaceID:  plan.SpaceID.ValueString(),
+	}
+	if !req.State.Raw.IsNull() {
+		var state StackFromManifestModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Manifest.Equal(state.Manifest) && plan.SpaceID.Equal(state.SpaceID) {
+			return
+		}
+		validateReq.StackID = state.ID.ValueString()
+	}
+
+	result, err := r.client.ValidateStackManifest(zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString()), validateReq)
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable to Validate Stack Manifest",
+			fmt.Sprintf("Could not check the stack manifest before apply, so problems with it will only surface when the stack is saved: %s", err))
+		return
+	}
+	if len(result.Errors) > 0 {
+		for _, e := range result.Errors {
+			resp.Diagnostics.AddAttributeError(path.Root("manifest"), "Invalid Stack Manifest", manifestErrorDetail(e))
+		}
+		return
+	}
+	if result.Stack == nil {
+		return
+	}
+
+	plan.NormalizedManifest = types.StringValue(result.Normalized)
+	setExpandedFields(&plan, result.Stack)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// manifestErrorDetail describes a manifest problem with the line and field it is at,
+// when the API reports them.
+func manifestErrorDetail(e zenfraclient.StackManifestError) string {
+	var where []string
+	if e.Line > 0 {
+		where = append(where, fmt.Sprintf("line %d", e.Line))
+	}
+	if e.Path != "" {
+		where = append(where, e.Path)
+	}
+	if len(where) == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(where, ", "), e.Message)
+}
+
+func (r *StackFromManifestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StackFromManifestModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	manifest, err := r.client.CreateStackFromManifest(ctx, zenfraclient.StackManifestRequest{
+		Manifest: plan.Manifest.ValueString(),
+		SpaceID:  plan.SpaceID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Creating Stack",
+			fmt.Sprintf("Could not create stack from manifest: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapManifestToState(manifest, plan.Manifest)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+// Read refreshes the stack. When its normalized manifest no longer matches the one in
+// state, the stack was changed outside Terraform, and manifest is replaced with the
+// normalized manifest so the next plan shows the drift and puts the configured one back.
+func (r *StackFromManifestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StackFromManifestModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	manifest, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.StackManifest, error) {
+		return r.client.GetStackManifest(ctx, state.ID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Stack",
+				fmt.Sprintf("Could not read stack ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Stack",
+			fmt.Sprintf("Could not read stack ID %s: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	text := state.Manifest
+	if text.IsNull() || manifest.Normalized != state.NormalizedManifest.ValueString() {
+		text = types.StringValue(manifest.Normalized)
+	}
+	newState := mapManifestToState(manifest, text)
+	newState.OrganizationID = state.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *StackFromManifestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state StackFromManifestModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	manifest, err := r.client.UpdateStackFromManifest(ctx, state.ID.ValueString(), zenfraclient.StackManifestRequest{
+		Manifest: plan.Manifest.ValueString(),
+		SpaceID:  plan.SpaceID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Stack",
+			fmt.Sprintf("Could not update stack ID %s from manifest: %s", state.ID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapManifestToState(manifest, plan.Manifest)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *StackFromManifestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state StackFromManifestModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteStack(ctx, state.ID.ValueString(), nil)
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		detail := fmt.Sprintf("Could not delete stack ID %s: %s", state.ID.ValueString(), err)
+		if zenfraclient.IsConflict(err) {
+			detail += "\n\nThe stack may have active runs or attached bundles. Wait for its runs to finish and detach its bundles, " +
+				"then destroy again."
+		}
+		resp.Diagnostics.AddError("Error Deleting Stack", detail)
+	}
+}
+
+func (r *StackFromManifestResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationID(ctx, r.client, "stack", importguard.Stack(r.client), req, resp)
+}
@@ -0,0 +1,204 @@
+// ABOUTME: Unit tests for the zenfra_stack_from_manifest resource against the zenfrafake client.
+// ABOUTME: Covers the plan-time validation and expansion of the manifest and how drift is surfaced on read.
+package stack_from_manifest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+const appManifest = `name: app
+iac:
+  engine: opentofu
+source:
+  raw_git:
+    url: https://github.com/acme/app.git
+    branch: main
+    path: infra
+`
+
+func plannedModel(manifest string) *StackFromManifestModel {
+	return &StackFromManifestModel{
+		ID:                 types.StringUnknown(),
+		SpaceID:            types.StringValue("space-1"),
+		Manifest:           types.StringValue(manifest),
+		NormalizedManifest: types.StringUnknown(),
+		Name:               types.StringUnknown(),
+		IACEngine:          types.StringUnknown(),
+		IACVersion:         types.StringUnknown(),
+		SourceType:         types.StringUnknown(),
+		SourceRefType:      types.StringUnknown(),
+		SourceRefName:      types.StringUnknown(),
+		SourcePath:         types.StringUnknown(),
+		EnvironmentType:    types.StringUnknown(),
+		Status:             types.StringUnknown(),
+		CreatedAt:          timeutil.NewTimestampUnknown(),
+		UpdatedAt:          timeutil.NewTimestampUnknown(),
+	}
+}
+
+func appStack() zenfraclient.Stack {
+	return zenfraclient.Stack{
+		ID:      "stack-1",
+		SpaceID: "space-1",
+		Name:    "app",
+		IAC:     zenfraclient.IACConfig{Engine: "opentofu", Version: "1.8.0"},
+		Source: zenfraclient.StackSource{
+			Type: zenfraclient.StackSourceTypeRawGit,
+			RawGit: &zenfraclient.StackSourceRawGit{
+				URL:  "https://github.com/acme/app.git",
+				Ref:  zenfraclient.StackSourceRef{Type: zenfraclient.SourceRefBranch, Name: "main"},
+				Path: "infra",
+			},
+		},
+		Status: "idle",
+	}
+}
+
+func TestStackFromManifestResource_ValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	r := &StackFromManifestResource{}
+
+	for _, tt := range []struct {
+		name       string
+		manifest   string
+		wantErrors int
+	}{
+		{name: "manifest", manifest: appManifest},
+		{name: "blank", manifest: " \n\t", wantErrors: 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			state := zenfrafake.State(t, r, plannedModel(tt.manifest))
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: state.Schema, Raw: state.Raw}}, resp)
+			if resp.Diagnostics.ErrorsCount() != tt.wantErrors {
+				t.Errorf("expected %d errors, got %v", tt.wantErrors, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestStackFromManifestResource_ModifyPlanExpandsManifest(t *testing.T) {
+	ctx := context.Background()
+	var got zenfraclient.ValidateStackManifestRequest
+	stack := appStack()
+	fake := &zenfrafake.Client{
+		ValidateStackManifestFunc: func(_ context.Context, req zenfraclient.ValidateStackManifestRequest) (*zenfraclient.StackManifestValidation, error) {
+			got = req
+			return &zenfraclient.StackManifestValidation{Normalized: `{"name":"app"}`, Stack: &stack}, nil
+		},
+	}
+	r := &StackFromManifestResource{client: fake}
+
+	plan := zenfrafake.State(t, r, plannedModel(appManifest))
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: zenfrafake.State(t, r, nil)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan: %v", resp.Diagnostics)
+	}
+	if got.Manifest != appManifest || got.SpaceID != "space-1" || got.StackID != "" {
+		t.Errorf("unexpected validate request: %+v", got)
+	}
+
+	var planned StackFromManifestModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &planned)...)
+	if planned.Name.ValueString() != "app" || planned.IACEngine.ValueString() != "opentofu" ||
+		planned.SourceRefType.ValueString() != "branch" || planned.SourceRefName.ValueString() != "main" ||
+		planned.SourcePath.ValueString() != "infra" || planned.NormalizedManifest.ValueString() != `{"name":"app"}` {
+		t.Errorf("expected the manifest to be expanded into the plan, got %+v", planned)
+	}
+	if !planned.EnvironmentType.IsNull() {
+		t.Errorf("expected a null environment_type for a manifest without one, got %v", planned.EnvironmentType)
+	}
+	if !planned.Status.IsUnknown() {
+		t.Errorf("expected status to stay unknown until apply, got %v", planned.Status)
+	}
+}
+
+func TestStackFromManifestResource_ModifyPlanInvalidManifest(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		ValidateStackManifestFunc: func(context.Context, zenfraclient.ValidateStackManifestRequest) (*zenfraclient.StackManifestValidation, error) {
+			return &zenfraclient.StackManifestValidation{Errors: []zenfraclient.StackManifestError{
+				{Path: "iac.engine", Line: 3, Message: `unknown engine "tofu"`},
+				{Message: "name is required"},
+			}}, nil
+		},
+	}
+	r := &StackFromManifestResource{client: fake}
+
+	plan := zenfrafake.State(t, r, plannedModel(appManifest))
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan), State: zenfrafake.State(t, r, nil)}, resp)
+	if resp.Diagnostics.ErrorsCount() != 2 {
+		t.Fatalf("expected one error per manifest problem, got %v", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); detail != `line 3, iac.engine: unknown engine "tofu"` {
+		t.Errorf("unexpected detail %q", detail)
+	}
+}
+
+func TestStackFromManifestResource_ModifyPlanUnchangedManifest(t *testing.T) {
+	ctx := context.Background()
+	var validations int
+	fake := &zenfrafake.Client{
+		ValidateStackManifestFunc: func(context.Context, zenfraclient.ValidateStackManifestRequest) (*zenfraclient.StackManifestValidation, error) {
+			validations++
+			return &zenfraclient.StackManifestValidation{}, nil
+		},
+	}
+	r := &StackFromManifestResource{client: fake}
+
+	prior := mapManifestToState(&zenfraclient.StackManifest{Stack: appStack(), Normalized: `{"name":"app"}`}, types.StringValue(appManifest))
+	state := zenfrafake.State(t, r, &prior)
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(state)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(state), State: state}, resp)
+	if resp.Diagnostics.HasError() || validations != 0 {
+		t.Errorf("expected an unchanged manifest not to be validated, got %d validations and %v", validations, resp.Diagnostics)
+	}
+}
+
+func TestStackFromManifestResource_ReadDrift(t *testing.T) {
+	ctx := context.Background()
+	remote := `{"name":"app"}`
+	fake := &zenfrafake.Client{
+		GetStackManifestFunc: func(_ context.Context, id string) (*zenfraclient.StackManifest, error) {
+			return &zenfraclient.StackManifest{Stack: appStack(), Normalized: remote}, nil
+		},
+	}
+	r := &StackFromManifestResource{client: fake}
+	prior := mapManifestToState(&zenfraclient.StackManifest{Stack: appStack(), Normalized: `{"name":"app"}`}, types.StringValue(appManifest))
+
+	for _, tt := range []struct {
+		name         string
+		remote       string
+		wantManifest string
+	}{
+		{name: "in sync keeps the configured text", remote: `{"name":"app"}`, wantManifest: appManifest},
+		{name: "changed outside terraform", remote: `{"name":"app-renamed"}`, wantManifest: `{"name":"app-renamed"}`},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			remote = tt.remote
+			state := zenfrafake.State(t, r, &prior)
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", resp.Diagnostics)
+			}
+
+			var got StackFromManifestModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if got.Manifest.ValueString() != tt.wantManifest || got.NormalizedManifest.ValueString() != tt.remote {
+				t.Errorf("expected manifest %q and normalized %q, got %q and %q",
+					tt.wantManifest, tt.remote, got.Manifest.ValueString(), got.NormalizedManifest.ValueString())
+			}
+		})
+	}
+}
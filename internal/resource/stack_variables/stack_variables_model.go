@@ -1,5 +1,5 @@
 // ABOUTME: Terraform state model for the zenfra_stack_variables resource.
-// ABOUTME: Handles replace-all semantics and secret value masking ("****") from API.
+// ABOUTME: Handles replace-all semantics and secret values the API withholds on read.
 package stack_variables
 
 import (
@@ -8,13 +8,9 @@ import (
 
 // StackVariablesModel represents the Terraform state for all variables on a stack.
 type StackVariablesModel struct {
-	StackID  types.String `tfsdk:"stack_id"`
-	Variable types.Set    `tfsdk:"variable"`
-}
-
-// VariableModel represents a single variable block.
-type VariableModel struct {
-	Key    types.String `tfsdk:"key"`
-	Value  types.String `tfsdk:"value"`
-	Secret types.Bool   `tfsdk:"secret"`
+	StackID            types.String `tfsdk:"stack_id"`
+	Variable           types.Set    `tfsdk:"variable"`
+	OrganizationID     types.String `tfsdk:"organization_id"`
+	WaitForIdle        types.Bool   `tfsdk:"wait_for_idle"`
+	IdleTimeoutSeconds types.Int64  `tfsdk:"idle_timeout_seconds"`
 }
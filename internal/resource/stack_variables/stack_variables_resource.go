@@ -5,25 +5,29 @@ package stack_variables
 import (
 	"context"
 	"fmt"
-	"sort"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/idlewait"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/payloadsize"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/variables"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 var (
-	_ resource.Resource                = &StackVariablesResource{}
-	_ resource.ResourceWithImportState = &StackVariablesResource{}
-	_ resource.ResourceWithModifyPlan  = &StackVariablesResource{}
+	_ resource.Resource                   = &StackVariablesResource{}
+	_ resource.ResourceWithImportState    = &StackVariablesResource{}
+	_ resource.ResourceWithModifyPlan     = &StackVariablesResource{}
+	_ resource.ResourceWithValidateConfig = &StackVariablesResource{}
 )
 
 // NewStackVariablesResource is a constructor for the stack variables resource.
@@ -33,7 +37,7 @@ func NewStackVariablesResource() resource.Resource {
 
 // StackVariablesResource is the resource implementation.
 type StackVariablesResource struct {
-	client *zenfraclient.Client
+	client zenfraclient.StackAPI
 }
 
 func (r *StackVariablesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -51,52 +55,58 @@ func (r *StackVariablesResource) Schema(_ context.Context, _ resource.SchemaRequ
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-		},
-		Blocks: map[string]schema.Block{
-			"variable": schema.SetNestedBlock{
-				Description: "A variable to set on the stack.",
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"key": schema.StringAttribute{
-							Description: "The variable name.",
-							Required:    true,
-						},
-						"value": schema.StringAttribute{
-							Description: "The variable value.",
-							Required:    true,
-							Sensitive:   true,
-						},
-						"secret": schema.BoolAttribute{
-							Description: "Whether this is a secret variable. Secret values are write-only.",
-							Optional:    true,
-							Computed:    true,
-							Default:     booldefault.StaticBool(false),
-						},
-					},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the stack. Defaults to the organization of the provider's API token; set it to the " +
+					"stack's organization_id when the stack belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			idlewait.EnabledAttribute: schema.BoolAttribute{
+				Description: "When setting the variables is rejected because one of the stack's runs is queued or running, wait for " +
+					"the stack's runs to finish and set them again, instead of failing the apply. Defaults to false.",
+				Optional: true,
+			},
+			idlewait.TimeoutAttribute: schema.Int64Attribute{
+				Description: "Maximum time to wait for the stack's runs to finish when wait_for_idle is true. Defaults to 1800.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"variable": variables.Block("stack"),
 		},
 	}
 }
 
 func (r *StackVariablesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_stack_variables")
 	}
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T.", req.ProviderData),
-		)
+}
+
+func (r *StackVariablesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config StackVariablesModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	r.client = client
+	idlewait.ValidateConfig(config.IdleTimeoutSeconds, &resp.Diagnostics)
 }
 
-// ModifyPlan implements the import safety guard. When a stack has variables on the remote
-// that are NOT in the config, this emits an error to prevent accidental deletion.
+// ModifyPlan checks variable values against the API's size limit and implements the
+// import safety guard. When a stack has variables on the remote that are NOT in the
+// config, this emits an error to prevent accidental deletion.
 func (r *StackVariablesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_stack_variables", "stack", req, resp)
+
+	if !req.Plan.Raw.IsNull() {
+		var vars types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("variable"), &vars)...)
+		payloadsize.CheckVariables(path.Root("variable"), vars, &resp.Diagnostics)
+	}
+
 	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
 	}
@@ -116,29 +126,16 @@ func (r *StackVariablesResource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 
-	remoteVars, err := r.client.GetStackVariables(ctx, stackID)
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	// Read has usually just fetched these during refresh; reuse that result.
+	remoteVars, err := r.client.GetStackVariablesCached(ctx, stackID)
 	if err != nil {
 		return
 	}
 
-	configKeys := make(map[string]bool)
-	if !plan.Variable.IsNull() {
-		var vars []VariableModel
-		resp.Diagnostics.Append(plan.Variable.ElementsAs(ctx, &vars, false)...)
-		for _, v := range vars {
-			configKeys[v.Key.ValueString()] = true
-		}
-	}
-
-	var missing []string
-	for _, rv := range remoteVars {
-		if !configKeys[rv.Key] {
-			missing = append(missing, rv.Key)
-		}
-	}
-
+	missing := variables.MissingKeys(ctx, remoteVars, plan.Variable, &resp.Diagnostics)
 	if len(missing) > 0 {
-		sort.Strings(missing)
 		resp.Diagnostics.AddError(
 			"Stack Has Variables Not In Configuration",
 			fmt.Sprintf(
@@ -157,21 +154,23 @@ func (r *StackVariablesResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	apiVars := planToAPIVars(ctx, plan, &resp.Diagnostics)
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	apiVars := variables.ToAPI(ctx, plan.Variable, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, err := r.client.SetStackVariables(ctx, plan.StackID.ValueString(), apiVars)
+	err := r.setVariables(ctx, &plan, apiVars)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Setting Stack Variables", fmt.Sprintf("Could not set variables: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
-//nolint:gocognit // Terraform CRUD with secret value preservation
 func (r *StackVariablesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state StackVariablesModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -179,53 +178,30 @@ func (r *StackVariablesResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	remoteVars, err := r.client.GetStackVariables(ctx, state.StackID.ValueString())
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	remoteVars, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) ([]zenfraclient.StackVariable, error) {
+		return r.client.GetStackVariables(ctx, state.StackID.ValueString())
+	})
 	if err != nil {
-		if zenfraclient.IsNotFound(err) {
+		if r.client.IsNotFoundOnRead(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Stack Variables",
+				fmt.Sprintf("Could not read variables for stack %s: %s\n\n%s", state.StackID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
 		resp.Diagnostics.AddError("Error Reading Stack Variables",
 			fmt.Sprintf("Could not read variables for stack %s: %s", state.StackID.ValueString(), err))
 		return
 	}
 
-	// Build prior secret values from state
-	priorSecrets := make(map[string]string)
-	if !state.Variable.IsNull() {
-		var stateVars []VariableModel
-		resp.Diagnostics.Append(state.Variable.ElementsAs(ctx, &stateVars, false)...)
-		for _, sv := range stateVars {
-			if sv.Secret.ValueBool() {
-				priorSecrets[sv.Key.ValueString()] = sv.Value.ValueString()
-			}
-		}
-	}
-
-	varObjType := types.ObjectType{AttrTypes: variableAttrTypes()}
-	if len(remoteVars) > 0 {
-		var varObjects []attr.Value
-		for _, rv := range remoteVars {
-			value := rv.Value
-			if rv.Secret && value == "****" {
-				if prior, ok := priorSecrets[rv.Key]; ok {
-					value = prior
-				}
-			}
-			obj, diags := types.ObjectValue(variableAttrTypes(), map[string]attr.Value{
-				"key":    types.StringValue(rv.Key),
-				"value":  types.StringValue(value),
-				"secret": types.BoolValue(rv.Secret),
-			})
-			resp.Diagnostics.Append(diags...)
-			varObjects = append(varObjects, obj)
-		}
-		varSet, diags := types.SetValue(varObjType, varObjects)
-		resp.Diagnostics.Append(diags...)
-		state.Variable = varSet
-	} else {
-		state.Variable = types.SetNull(varObjType)
-	}
+	priorSecrets := variables.PriorSecrets(ctx, state.Variable, &resp.Diagnostics)
+	varSet, diags := variables.FromAPI(remoteVars, priorSecrets)
+	resp.Diagnostics.Append(diags...)
+	state.Variable = varSet
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -237,17 +213,20 @@ func (r *StackVariablesResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	apiVars := planToAPIVars(ctx, plan, &resp.Diagnostics)
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	apiVars := variables.ToAPI(ctx, plan.Variable, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	_, err := r.client.SetStackVariables(ctx, plan.StackID.ValueString(), apiVars)
+	err := r.setVariables(ctx, &plan, apiVars)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Updating Stack Variables", fmt.Sprintf("Could not update variables: %s", err))
 		return
 	}
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -258,7 +237,9 @@ func (r *StackVariablesResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	_, err := r.client.SetStackVariables(ctx, state.StackID.ValueString(), []zenfraclient.StackVariable{})
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.setVariables(ctx, &state, []zenfraclient.StackVariable{})
 	if err != nil {
 		if zenfraclient.IsNotFound(err) {
 			return
@@ -269,33 +250,15 @@ func (r *StackVariablesResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *StackVariablesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("stack_id"), req, resp)
-}
-
-// variableAttrTypes returns the attribute types for a variable object.
-func variableAttrTypes() map[string]attr.Type {
-	return map[string]attr.Type{
-		"key":    types.StringType,
-		"value":  types.StringType,
-		"secret": types.BoolType,
-	}
+	importguard.PassthroughOrganizationAttribute(ctx, r.client, "stack", path.Root("stack_id"), importguard.Stack(r.client), req, resp)
 }
 
-// planToAPIVars converts plan variable blocks into API StackVariable structs.
-func planToAPIVars(ctx context.Context, plan StackVariablesModel, diags *diag.Diagnostics) []zenfraclient.StackVariable {
-	var result []zenfraclient.StackVariable
-	if plan.Variable.IsNull() {
-		return result
-	}
-
-	var vars []VariableModel
-	diags.Append(plan.Variable.ElementsAs(ctx, &vars, false)...)
-	for _, v := range vars {
-		result = append(result, zenfraclient.StackVariable{
-			Key:    v.Key.ValueString(),
-			Value:  v.Value.ValueString(),
-			Secret: v.Secret.ValueBool(),
-		})
-	}
-	return result
+// setVariables replaces the stack's variables with vars, waiting out runs in progress
+// when the model sets wait_for_idle.
+func (r *StackVariablesResource) setVariables(ctx context.Context, model *StackVariablesModel, vars []zenfraclient.StackVariable) error {
+	stackID := model.StackID.ValueString()
+	return idlewait.Do(ctx, r.client, stackID, model.WaitForIdle, model.IdleTimeoutSeconds, func(ctx context.Context) error {
+		_, err := r.client.SetStackVariables(ctx, stackID, vars)
+		return err
+	})
 }
@@ -1,30 +1,82 @@
-// ABOUTME: Unit tests for the zenfra_stack_variables resource model.
-// ABOUTME: Verifies variable attribute types and model structure.
+// ABOUTME: Unit tests for the zenfra_stack_variables resource against the zenfrafake client.
+// ABOUTME: Verifies that Read keeps secrets the API withholds and that the import guard reports variables missing from the configuration.
 package stack_variables
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/variables"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
 )
 
-func TestVariableAttrTypes(t *testing.T) {
-	attrTypes := variableAttrTypes()
+// stackModel returns a stack with the variables vars.
+func stackModel(t *testing.T, vars ...zenfraclient.StackVariable) *StackVariablesModel {
+	t.Helper()
+	set, diags := variables.FromAPI(vars, nil)
+	if diags.HasError() {
+		t.Fatalf("FromAPI: %v", diags)
+	}
+	return &StackVariablesModel{
+		StackID:  types.StringValue("stack-1"),
+		Variable: set,
+	}
+}
+
+func TestStackVariablesResource_ReadKeepsSecrets(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetStackVariablesFunc: func(context.Context, string) ([]zenfraclient.StackVariable, error) {
+			return []zenfraclient.StackVariable{
+				{Key: "REGION", Value: "eu-west-2"},
+				{Key: "DB_PASSWORD", Value: "****", Secret: true, ValueMasked: true},
+			}, nil
+		},
+	}
+	r := &StackVariablesResource{client: fake}
 
-	expected := []string{"key", "value", "secret"}
-	for _, key := range expected {
-		if _, ok := attrTypes[key]; !ok {
-			t.Errorf("missing expected attribute type: %s", key)
-		}
+	prior := zenfrafake.State(t, r, stackModel(t,
+		zenfraclient.StackVariable{Key: "REGION", Value: "eu-west-1"},
+		zenfraclient.StackVariable{Key: "DB_PASSWORD", Value: "hunter2", Secret: true},
+	))
+	resp := &resource.ReadResponse{State: prior}
+	r.Read(ctx, resource.ReadRequest{State: prior}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
 	}
 
-	if attrTypes["key"] != types.StringType {
-		t.Errorf("key should be StringType, got %v", attrTypes["key"])
+	var state StackVariablesModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	got := variables.ToAPI(ctx, state.Variable, &resp.Diagnostics)
+	values := make(map[string]string, len(got))
+	for _, v := range got {
+		values[v.Key] = v.Value
 	}
-	if attrTypes["value"] != types.StringType {
-		t.Errorf("value should be StringType, got %v", attrTypes["value"])
+	if values["REGION"] != "eu-west-2" || values["DB_PASSWORD"] != "hunter2" {
+		t.Errorf("expected the API's REGION and the prior DB_PASSWORD, got %v", values)
 	}
-	if attrTypes["secret"] != types.BoolType {
-		t.Errorf("secret should be BoolType, got %v", attrTypes["secret"])
+}
+
+func TestStackVariablesResource_ModifyPlanMissingVariables(t *testing.T) {
+	ctx := context.Background()
+	fake := &zenfrafake.Client{
+		GetStackVariablesCachedFunc: func(context.Context, string) ([]zenfraclient.StackVariable, error) {
+			return []zenfraclient.StackVariable{{Key: "REGION"}, {Key: "LOG_LEVEL"}}, nil
+		},
+	}
+	r := &StackVariablesResource{client: fake}
+
+	state := zenfrafake.State(t, r, stackModel(t, zenfraclient.StackVariable{Key: "REGION", Value: "eu-west-1"}))
+	resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(state)}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(state), State: state}, resp)
+
+	errs := resp.Diagnostics.Errors()
+	if len(errs) != 1 || !strings.Contains(errs[0].Detail(), "[LOG_LEVEL]") {
+		t.Errorf("expected an error naming LOG_LEVEL, got %v", resp.Diagnostics)
 	}
 }
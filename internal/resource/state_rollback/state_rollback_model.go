@@ -0,0 +1,32 @@
+// ABOUTME: Terraform state model for the zenfra_state_rollback resource.
+// ABOUTME: Records the snapshot that was restored and the new snapshot the restore produced.
+package state_rollback
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// StateRollbackModel represents the Terraform state model for a state rollback.
+type StateRollbackModel struct {
+	ID                    types.String            `tfsdk:"id"`
+	StackID               types.String            `tfsdk:"stack_id"`
+	SnapshotID            types.String            `tfsdk:"snapshot_id"`
+	ConfirmStackID        types.String            `tfsdk:"confirm_stack_id"`
+	ExpectedCurrentSerial types.Int64             `tfsdk:"expected_current_serial"`
+	Reason                types.String            `tfsdk:"reason"`
+	NewSnapshotID         types.String            `tfsdk:"new_snapshot_id"`
+	NewSerial             types.Int64             `tfsdk:"new_serial"`
+	CreatedAt             timeutil.TimestampValue `tfsdk:"created_at"`
+	OrganizationID        types.String            `tfsdk:"organization_id"`
+}
+
+// mapRollbackToState fills the computed attributes of plan from an API StateRollback.
+func mapRollbackToState(plan StateRollbackModel, rollback *zenfraclient.StateRollback) StateRollbackModel {
+	plan.ID = types.StringValue(rollback.ID)
+	plan.NewSnapshotID = types.StringValue(rollback.NewSnapshotID)
+	plan.NewSerial = types.Int64Value(rollback.NewSerial)
+	plan.CreatedAt = timeutil.Timestamp(rollback.CreatedAt)
+	return plan
+}
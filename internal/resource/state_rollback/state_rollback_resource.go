@@ -0,0 +1,274 @@
+// ABOUTME: Implements the zenfra_state_rollback Terraform resource, which restores a stack state snapshot on create.
+// ABOUTME: Requires the stack ID to be repeated and can pin the expected current serial to avoid clobbering newer applies.
+package state_rollback
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                   = &StateRollbackResource{}
+	_ resource.ResourceWithValidateConfig = &StateRollbackResource{}
+	_ resource.ResourceWithModifyPlan     = &StateRollbackResource{}
+)
+
+// NewStateRollbackResource is a constructor for the state rollback resource.
+func NewStateRollbackResource() resource.Resource {
+	return &StateRollbackResource{}
+}
+
+// StateRollbackResource is the resource implementation.
+type StateRollbackResource struct {
+	client zenfraclient.StackAPI
+}
+
+func (r *StateRollbackResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_state_rollback"
+}
+
+func (r *StateRollbackResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores a stack's Terraform state to a previous snapshot when the resource is created. " +
+			"Changing stack_id, snapshot_id, expected_current_serial, or reason performs another rollback. " +
+			"Destroying the resource only removes it from Terraform state; it does not undo the restore.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the rollback.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"stack_id": schema.StringAttribute{
+				Description: "The stack whose state to restore.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the stack. Defaults to the organization of the provider's API token; set it to the " +
+					"stack's organization_id when the stack belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The snapshot to restore, as listed by the zenfra_state_snapshots data source.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"confirm_stack_id": schema.StringAttribute{
+				Description: "Must be set to the same value as stack_id to confirm the rollback.",
+				Required:    true,
+			},
+			"expected_current_serial": schema.Int64Attribute{
+				Description: "If set, the rollback is refused unless the stack's newest snapshot has this serial. " +
+					"Use it to avoid overwriting state written by an apply that ran after the rollback was planned.",
+				Optional: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				Description: "Reason for the rollback, recorded in the stack's audit log.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"new_snapshot_id": schema.StringAttribute{
+				Description: "The snapshot written by the rollback, which is now the stack's current state.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"new_serial": schema.Int64Attribute{
+				Description: "The state serial of new_snapshot_id.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp when the rollback was performed.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *StateRollbackResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config StateRollbackModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.StackID.IsUnknown() || config.ConfirmStackID.IsUnknown() {
+		return
+	}
+	if config.ConfirmStackID.ValueString() != config.StackID.ValueString() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("confirm_stack_id"),
+			"Rollback Not Confirmed",
+			fmt.Sprintf("confirm_stack_id must equal stack_id (%q) to restore a state snapshot.", config.StackID.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan warns when the API token may not manage stacks.
+func (r *StateRollbackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_state_rollback", "stack", req, resp)
+}
+
+func (r *StateRollbackResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_state_rollback")
+	}
+}
+
+func (r *StateRollbackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan StateRollbackModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	stackID := plan.StackID.ValueString()
+	snapshots, err := r.client.ListStateSnapshots(ctx, stackID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Rolling Back State", fmt.Sprintf("Could not list state snapshots for stack %s: %s", stackID, err))
+		return
+	}
+
+	var expectedSerial *int64
+	if !plan.ExpectedCurrentSerial.IsNull() {
+		v := plan.ExpectedCurrentSerial.ValueInt64()
+		expectedSerial = &v
+	}
+	if err := checkRollbackPreconditions(snapshots, plan.SnapshotID.ValueString(), expectedSerial); err != nil {
+		resp.Diagnostics.AddError("Error Rolling Back State", err.Error())
+		return
+	}
+
+	rollbackReq := zenfraclient.RollbackStateRequest{
+		SnapshotID: plan.SnapshotID.ValueString(),
+	}
+	if !plan.Reason.IsNull() {
+		rollbackReq.Reason = plan.Reason.ValueString()
+	}
+
+	rollback, err := r.client.RollbackState(ctx, stackID, rollbackReq)
+	if err != nil {
+		if zenfraclient.IsConflict(err) {
+			resp.Diagnostics.AddError("Error Rolling Back State",
+				fmt.Sprintf("Stack %s state is locked, most likely by an active run. Wait for the run to finish and apply again: %s", stackID, err))
+			return
+		}
+		resp.Diagnostics.AddError("Error Rolling Back State", fmt.Sprintf("Could not restore snapshot: %s", err))
+		return
+	}
+
+	state := mapRollbackToState(plan, rollback)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *StateRollbackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state StateRollbackModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	// A rollback is a one-off action; only drop it from state once its stack is gone.
+	_, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.Stack, error) {
+		return r.client.GetStackCached(ctx, state.StackID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading State Rollback",
+				fmt.Sprintf("Could not read stack ID %s: %s\n\n%s", state.StackID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading State Rollback",
+			fmt.Sprintf("Could not read stack ID %s: %s", state.StackID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *StateRollbackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state StateRollbackModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only confirm_stack_id can change in place; it does not trigger another rollback.
+	state.ConfirmStackID = plan.ConfirmStackID
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *StateRollbackResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// Nothing to undo remotely; the restored state stays in place.
+}
+
+// checkRollbackPreconditions verifies that snapshotID exists in snapshots (newest first)
+// and, when expectedSerial is set, that the newest snapshot still has that serial.
+func checkRollbackPreconditions(snapshots []zenfraclient.StateSnapshot, snapshotID string, expectedSerial *int64) error {
+	found := false
+	for i := range snapshots {
+		if snapshots[i].ID == snapshotID {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("snapshot %s does not exist for this stack", snapshotID)
+	}
+
+	if expectedSerial != nil && snapshots[0].Serial != *expectedSerial {
+		return fmt.Errorf("the stack's current state serial is %d, but expected_current_serial is %d; the state changed since the rollback was planned",
+			snapshots[0].Serial, *expectedSerial)
+	}
+
+	if snapshots[0].ID == snapshotID {
+		return fmt.Errorf("snapshot %s is already the stack's current state", snapshotID)
+	}
+
+	return nil
+}
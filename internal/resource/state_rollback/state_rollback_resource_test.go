@@ -0,0 +1,73 @@
+// ABOUTME: Unit tests for the zenfra_state_rollback resource.
+// ABOUTME: Verifies the snapshot and serial checks made before a rollback and the state mapping.
+package state_rollback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestCheckRollbackPreconditions(t *testing.T) {
+	snapshots := []zenfraclient.StateSnapshot{
+		{ID: "snap-3", Serial: 12},
+		{ID: "snap-2", Serial: 11},
+		{ID: "snap-1", Serial: 10},
+	}
+	serial := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name           string
+		snapshotID     string
+		expectedSerial *int64
+		wantErr        bool
+	}{
+		{name: "older snapshot", snapshotID: "snap-1", wantErr: false},
+		{name: "matching serial", snapshotID: "snap-2", expectedSerial: serial(12), wantErr: false},
+		{name: "stale serial", snapshotID: "snap-2", expectedSerial: serial(11), wantErr: true},
+		{name: "unknown snapshot", snapshotID: "snap-9", wantErr: true},
+		{name: "current snapshot", snapshotID: "snap-3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkRollbackPreconditions(snapshots, tt.snapshotID, tt.expectedSerial)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMapRollbackToState(t *testing.T) {
+	plan := StateRollbackModel{
+		StackID:        types.StringValue("stack-1"),
+		SnapshotID:     types.StringValue("snap-1"),
+		ConfirmStackID: types.StringValue("stack-1"),
+		Reason:         types.StringValue("bad apply"),
+	}
+	rollback := &zenfraclient.StateRollback{
+		ID:            "rb-1",
+		StackID:       "stack-1",
+		SnapshotID:    "snap-1",
+		NewSnapshotID: "snap-4",
+		NewSerial:     13,
+		CreatedAt:     time.Date(2026, 3, 1, 11, 0, 0, 0, time.UTC),
+	}
+
+	state := mapRollbackToState(plan, rollback)
+	if state.ID.ValueString() != "rb-1" {
+		t.Errorf("expected ID 'rb-1', got %s", state.ID.ValueString())
+	}
+	if state.NewSnapshotID.ValueString() != "snap-4" || state.NewSerial.ValueInt64() != 13 {
+		t.Errorf("unexpected new snapshot: %s serial %d", state.NewSnapshotID.ValueString(), state.NewSerial.ValueInt64())
+	}
+	if state.CreatedAt.ValueString() != "2026-03-01T11:00:00Z" {
+		t.Errorf("unexpected created_at: %s", state.CreatedAt.ValueString())
+	}
+	if state.Reason.ValueString() != "bad apply" {
+		t.Errorf("expected reason to be preserved, got %s", state.Reason.ValueString())
+	}
+}
@@ -4,21 +4,24 @@ package vcs_integration
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 // VCSIntegrationModel represents the Terraform state model for a VCS integration.
 type VCSIntegrationModel struct {
-	ID                  types.String `tfsdk:"id"`
-	OrganizationID      types.String `tfsdk:"organization_id"`
-	Name                types.String `tfsdk:"name"`
-	ProviderType        types.String `tfsdk:"provider_type"`
-	PersonalAccessToken types.String `tfsdk:"personal_access_token"`
-	APIURL              types.String `tfsdk:"api_url"`
-	InstallationID      types.Int64  `tfsdk:"installation_id"`
-	Status              types.String `tfsdk:"status"`
-	CreatedAt           types.String `tfsdk:"created_at"`
-	UpdatedAt           types.String `tfsdk:"updated_at"`
+	ID                    types.String            `tfsdk:"id"`
+	OrganizationID        types.String            `tfsdk:"organization_id"`
+	Name                  types.String            `tfsdk:"name"`
+	ProviderType          types.String            `tfsdk:"provider_type"`
+	PersonalAccessToken   types.String            `tfsdk:"personal_access_token"`
+	TokenType             types.String            `tfsdk:"token_type"`
+	RotateTokenOnChangeOf types.Map               `tfsdk:"rotate_token_on_change_of"`
+	APIURL                types.String            `tfsdk:"api_url"`
+	InstallationID        types.Int64             `tfsdk:"installation_id"`
+	Status                types.String            `tfsdk:"status"`
+	CreatedAt             timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt             timeutil.TimestampValue `tfsdk:"updated_at"`
 }
 
 // mapVCSIntegrationToState converts an API VCSIntegration response to a VCSIntegrationModel.
@@ -29,8 +32,8 @@ func mapVCSIntegrationToState(vcs *zenfraclient.VCSIntegration) VCSIntegrationMo
 		Name:           types.StringValue(vcs.DisplayName),
 		ProviderType:   types.StringValue(vcs.Provider),
 		Status:         types.StringValue(vcs.Status),
-		CreatedAt:      types.StringValue(vcs.CreatedAt),
-		UpdatedAt:      types.StringValue(vcs.UpdatedAt),
+		CreatedAt:      timeutil.NormalizedTimestamp(vcs.CreatedAt),
+		UpdatedAt:      timeutil.NormalizedTimestamp(vcs.UpdatedAt),
 	}
 
 	if vcs.GitHub != nil {
@@ -45,5 +48,16 @@ func mapVCSIntegrationToState(vcs *zenfraclient.VCSIntegration) VCSIntegrationMo
 		model.APIURL = types.StringNull()
 	}
 
+	// Integrations created before group access tokens were supported report no token type.
+	switch {
+	case vcs.GitLab != nil && vcs.GitLab.TokenType != "":
+		model.TokenType = types.StringValue(vcs.GitLab.TokenType)
+	case vcs.Provider == zenfraclient.VCSProviderGitLab:
+		model.TokenType = types.StringValue(tokenTypePersonal)
+	default:
+		model.TokenType = types.StringNull()
+	}
+	model.RotateTokenOnChangeOf = types.MapNull(types.StringType)
+
 	return model
 }
@@ -1,5 +1,5 @@
 // ABOUTME: Implements the zenfra_vcs_integration Terraform resource with full CRUD lifecycle.
-// ABOUTME: Supports GitHub (app installation) and GitLab (personal access token) VCS providers.
+// ABOUTME: Supports GitHub (app installation) and GitLab (personal or group access token, with rotation) VCS providers.
 package vcs_integration
 
 import (
@@ -9,14 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 var (
-	_ resource.Resource                = &VCSIntegrationResource{}
-	_ resource.ResourceWithImportState = &VCSIntegrationResource{}
+	_ resource.Resource                   = &VCSIntegrationResource{}
+	_ resource.ResourceWithImportState    = &VCSIntegrationResource{}
+	_ resource.ResourceWithModifyPlan     = &VCSIntegrationResource{}
+	_ resource.ResourceWithValidateConfig = &VCSIntegrationResource{}
+)
+
+// GitLab access token types.
+const (
+	tokenTypePersonal = "personal"
+	tokenTypeGroup    = "group"
 )
 
 // NewVCSIntegrationResource is a constructor for the VCS integration resource.
@@ -26,7 +43,7 @@ func NewVCSIntegrationResource() resource.Resource {
 
 // VCSIntegrationResource is the resource implementation.
 type VCSIntegrationResource struct {
-	client *zenfraclient.Client
+	client zenfraclient.VCSIntegrationAPI
 }
 
 func (r *VCSIntegrationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,10 +62,13 @@ func (r *VCSIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 			"organization_id": schema.StringAttribute{
-				Description: "The organization ID this integration belongs to.",
-				Computed:    true,
+				Description: "The organization ID this integration belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the integration in another organization the token has access to. Changing it forces a new integration.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -58,27 +78,57 @@ func (r *VCSIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"provider_type": schema.StringAttribute{
 				Description: "The VCS provider type. Must be 'github' or 'gitlab'.",
 				Required:    true,
+				Validators: []validator.String{
+					validators.OneOf(zenfraclient.VCSProviderGitHub, zenfraclient.VCSProviderGitLab),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"personal_access_token": schema.StringAttribute{
-				Description: "Personal access token for GitLab integration. Only used when provider_type is 'gitlab'.",
+				Description: "Access token for GitLab integration, of the kind given by token_type. Only used when provider_type is 'gitlab'. " +
+					"Changing it replaces the token the integration uses.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_type": schema.StringAttribute{
+				Description: "Kind of GitLab access token: 'personal' or 'group'. A group access token is tied to a GitLab group " +
+					"rather than a user, so the integration keeps working when the user leaves. Only used when provider_type is 'gitlab'. " +
+					"Defaults to 'personal'.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					validators.OneOf(tokenTypePersonal, tokenTypeGroup),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotate_token_on_change_of": schema.MapAttribute{
+				Description: "Arbitrary values that send personal_access_token to the API again when any of them changes, " +
+					"such as the expiry date of a token read from a secret store. Only used when provider_type is 'gitlab'.",
 				Optional:    true,
-				Sensitive:   true,
+				ElementType: types.StringType,
 			},
 			"api_url": schema.StringAttribute{
-				Description: "API URL for GitLab integration (e.g., 'https://gitlab.com'). Only used when provider_type is 'gitlab'.",
-				Optional:    true,
-				Computed:    true,
+				Description: "API URL for GitLab integration (e.g., 'https://gitlab.com'). Only used when provider_type is 'gitlab'. " +
+					"The API cannot move an integration to another GitLab instance, so changing it forces a new integration.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"installation_id": schema.Int64Attribute{
-				Description: "GitHub App installation ID. Only used when provider_type is 'github'.",
-				Optional:    true,
-				Computed:    true,
+				Description: "GitHub App installation ID. Only used when provider_type is 'github'. " +
+					"An installation belongs to one GitHub account, so changing it forces a new integration.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
 			},
 			"status": schema.StringAttribute{
 				Description: "The current status of the integration.",
@@ -86,6 +136,7 @@ func (r *VCSIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequ
 			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the integration was created.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -93,25 +144,57 @@ func (r *VCSIntegrationResource) Schema(_ context.Context, _ resource.SchemaRequ
 			},
 			"updated_at": schema.StringAttribute{
 				Description: "Timestamp when the integration was last updated.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 			},
 		},
 	}
 }
 
-func (r *VCSIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ValidateConfig checks that the GitLab token attributes are not set on other providers.
+func (r *VCSIntegrationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config VCSIntegrationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T.", req.ProviderData),
-		)
+
+	if config.ProviderType.IsUnknown() || config.ProviderType.ValueString() == zenfraclient.VCSProviderGitLab {
+		return
+	}
+	for name, set := range map[string]bool{
+		"token_type":                !config.TokenType.IsNull(),
+		"rotate_token_on_change_of": !config.RotateTokenOnChangeOf.IsNull(),
+	} {
+		if set {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Unsupported Attribute",
+				fmt.Sprintf("%s is only used when provider_type is 'gitlab'.", name))
+		}
+	}
+}
+
+// ModifyPlan warns when the API token may not manage VCS integrations, and when a
+// changed installation_id or api_url replaces the integration.
+func (r *VCSIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_vcs_integration", "vcs_integration", req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
 		return
 	}
-	r.client = client
+	for _, name := range []string{"installation_id", "api_url"} {
+		if resp.RequiresReplace.Contains(path.Root(name)) {
+			resp.Diagnostics.AddAttributeWarning(path.Root(name), "VCS Integration Will Be Replaced",
+				fmt.Sprintf("The API cannot change %s of an existing integration, so this plan deletes the integration and creates a new one "+
+					"with a new ID. Stacks that reference the integration through this resource are updated with it; "+
+					"stacks that hold the old ID as a literal value lose access to their repository.", name))
+		}
+	}
+}
+
+func (r *VCSIntegrationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_vcs_integration")
+	}
 }
 
 func (r *VCSIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -121,13 +204,15 @@ func (r *VCSIntegrationResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
 	createReq := zenfraclient.CreateVCSIntegrationRequest{
 		Provider:    plan.ProviderType.ValueString(),
 		DisplayName: plan.Name.ValueString(),
 	}
 
 	switch plan.ProviderType.ValueString() {
-	case "github":
+	case zenfraclient.VCSProviderGitHub:
 		if plan.InstallationID.IsNull() || plan.InstallationID.IsUnknown() {
 			resp.Diagnostics.AddError("Missing Installation ID",
 				"installation_id is required for GitHub integrations.")
@@ -136,7 +221,7 @@ func (r *VCSIntegrationResource) Create(ctx context.Context, req resource.Create
 		createReq.GitHub = &zenfraclient.CreateVCSGitHubRequest{
 			InstallationID: plan.InstallationID.ValueInt64(),
 		}
-	case "gitlab":
+	case zenfraclient.VCSProviderGitLab:
 		if plan.PersonalAccessToken.IsNull() {
 			resp.Diagnostics.AddError("Missing Personal Access Token",
 				"personal_access_token is required for GitLab integrations.")
@@ -144,6 +229,7 @@ func (r *VCSIntegrationResource) Create(ctx context.Context, req resource.Create
 		}
 		gitlabReq := &zenfraclient.CreateVCSGitLabRequest{
 			AccessToken: plan.PersonalAccessToken.ValueString(),
+			TokenType:   plan.TokenType.ValueString(),
 		}
 		if !plan.APIURL.IsNull() && !plan.APIURL.IsUnknown() {
 			gitlabReq.BaseURL = plan.APIURL.ValueString()
@@ -165,7 +251,9 @@ func (r *VCSIntegrationResource) Create(ctx context.Context, req resource.Create
 	state := mapVCSIntegrationToState(vcs)
 	// Preserve the sensitive PAT from plan (API won't return it)
 	state.PersonalAccessToken = plan.PersonalAccessToken
+	state.RotateTokenOnChangeOf = plan.RotateTokenOnChangeOf
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
@@ -176,12 +264,21 @@ func (r *VCSIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	vcs, err := r.client.GetVCSIntegration(ctx, state.ID.ValueString())
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	vcs, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.VCSIntegration, error) {
+		return r.client.GetVCSIntegration(ctx, state.ID.ValueString())
+	})
 	if err != nil {
-		if zenfraclient.IsNotFound(err) {
+		if r.client.IsNotFoundOnRead(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading VCS Integration",
+				fmt.Sprintf("Could not read VCS integration ID %s: %s\n\n%s", state.ID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
 		resp.Diagnostics.AddError("Error Reading VCS Integration",
 			fmt.Sprintf("Could not read VCS integration ID %s: %s", state.ID.ValueString(), err))
 		return
@@ -190,10 +287,23 @@ func (r *VCSIntegrationResource) Read(ctx context.Context, req resource.ReadRequ
 	newState := mapVCSIntegrationToState(vcs)
 	// Preserve the sensitive PAT from current state (API won't return it)
 	newState.PersonalAccessToken = state.PersonalAccessToken
+	newState.RotateTokenOnChangeOf = state.RotateTokenOnChangeOf
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
+// rotatesToken reports whether applying plan over state sends the GitLab access token
+// to the API again.
+func rotatesToken(plan, state VCSIntegrationModel) bool {
+	if plan.ProviderType.ValueString() != zenfraclient.VCSProviderGitLab {
+		return false
+	}
+	tokenTypeChanged := !plan.TokenType.IsUnknown() && !plan.TokenType.Equal(state.TokenType)
+	return !plan.PersonalAccessToken.Equal(state.PersonalAccessToken) ||
+		!plan.RotateTokenOnChangeOf.Equal(state.RotateTokenOnChangeOf) ||
+		tokenTypeChanged
+}
+
 func (r *VCSIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan, state VCSIntegrationModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -202,22 +312,52 @@ func (r *VCSIntegrationResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	updateReq := zenfraclient.UpdateVCSIntegrationRequest{}
-	if !plan.Name.Equal(state.Name) {
-		name := plan.Name.ValueString()
-		updateReq.DisplayName = &name
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	var vcs *zenfraclient.VCSIntegration
+	if rotatesToken(plan, state) {
+		if plan.PersonalAccessToken.IsNull() {
+			resp.Diagnostics.AddError("Missing Personal Access Token",
+				"personal_access_token is required for GitLab integrations.")
+			return
+		}
+		credentials := &zenfraclient.UpdateVCSGitLabCredentials{
+			AccessToken: plan.PersonalAccessToken.ValueString(),
+		}
+		if !plan.TokenType.IsUnknown() {
+			credentials.TokenType = plan.TokenType.ValueString()
+		}
+		var err error
+		vcs, err = r.client.UpdateVCSIntegrationCredentials(ctx, state.ID.ValueString(),
+			zenfraclient.UpdateVCSCredentialsRequest{GitLab: credentials})
+		if err != nil {
+			resp.Diagnostics.AddError("Error Rotating VCS Integration Token",
+				fmt.Sprintf("Could not replace the access token of VCS integration ID %s: %s", state.ID.ValueString(), err))
+			return
+		}
 	}
 
-	vcs, err := r.client.UpdateVCSIntegration(ctx, state.ID.ValueString(), updateReq)
-	if err != nil {
-		resp.Diagnostics.AddError("Error Updating VCS Integration",
-			fmt.Sprintf("Could not update VCS integration: %s", err))
-		return
+	if vcs == nil || !plan.Name.Equal(state.Name) {
+		updateReq := zenfraclient.UpdateVCSIntegrationRequest{}
+		if !plan.Name.Equal(state.Name) {
+			name := plan.Name.ValueString()
+			updateReq.DisplayName = &name
+		}
+
+		var err error
+		vcs, err = r.client.UpdateVCSIntegration(ctx, state.ID.ValueString(), updateReq)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Updating VCS Integration",
+				fmt.Sprintf("Could not update VCS integration: %s", err))
+			return
+		}
 	}
 
 	newState := mapVCSIntegrationToState(vcs)
 	newState.PersonalAccessToken = plan.PersonalAccessToken
+	newState.RotateTokenOnChangeOf = plan.RotateTokenOnChangeOf
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
@@ -228,6 +368,8 @@ func (r *VCSIntegrationResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	err := r.client.DeleteVCSIntegration(ctx, state.ID.ValueString())
 	if err != nil {
 		if zenfraclient.IsNotFound(err) {
@@ -239,5 +381,5 @@ func (r *VCSIntegrationResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *VCSIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importguard.PassthroughOrganizationID(ctx, r.client, "VCS integration", importguard.VCSIntegration(r.client), req, resp)
 }
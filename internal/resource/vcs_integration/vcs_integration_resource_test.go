@@ -1,12 +1,21 @@
 // ABOUTME: Unit tests for the zenfra_vcs_integration resource model mapping.
-// ABOUTME: Verifies correct conversion for both GitHub and GitLab provider types.
+// ABOUTME: Verifies correct conversion for both GitHub and GitLab provider types, and which updates rotate the GitLab token.
 package vcs_integration
 
 import (
+	"context"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
 )
 
 func TestMapVCSIntegrationToState(t *testing.T) {
@@ -34,9 +43,10 @@ func TestMapVCSIntegrationToState(t *testing.T) {
 				ProviderType:   types.StringValue("github"),
 				InstallationID: types.Int64Value(12345),
 				APIURL:         types.StringNull(),
+				TokenType:      types.StringNull(),
 				Status:         types.StringValue("active"),
-				CreatedAt:      types.StringValue("2026-02-11T10:00:00Z"),
-				UpdatedAt:      types.StringValue("2026-02-11T12:00:00Z"),
+				CreatedAt:      timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				UpdatedAt:      timeutil.NewTimestampValue("2026-02-11T12:00:00Z"),
 			},
 		},
 		{
@@ -58,9 +68,10 @@ func TestMapVCSIntegrationToState(t *testing.T) {
 				ProviderType:   types.StringValue("gitlab"),
 				InstallationID: types.Int64Null(),
 				APIURL:         types.StringValue("https://gitlab.example.com"),
+				TokenType:      types.StringValue("personal"),
 				Status:         types.StringValue("active"),
-				CreatedAt:      types.StringValue("2026-02-11T10:00:00Z"),
-				UpdatedAt:      types.StringValue("2026-02-11T12:00:00Z"),
+				CreatedAt:      timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				UpdatedAt:      timeutil.NewTimestampValue("2026-02-11T12:00:00Z"),
 			},
 		},
 		{
@@ -81,9 +92,10 @@ func TestMapVCSIntegrationToState(t *testing.T) {
 				ProviderType:   types.StringValue("github"),
 				InstallationID: types.Int64Null(),
 				APIURL:         types.StringNull(),
+				TokenType:      types.StringNull(),
 				Status:         types.StringValue("pending"),
-				CreatedAt:      types.StringValue("2026-02-11T10:00:00Z"),
-				UpdatedAt:      types.StringValue("2026-02-11T10:00:00Z"),
+				CreatedAt:      timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
+				UpdatedAt:      timeutil.NewTimestampValue("2026-02-11T10:00:00Z"),
 			},
 		},
 	}
@@ -110,6 +122,9 @@ func TestMapVCSIntegrationToState(t *testing.T) {
 			if !result.APIURL.Equal(tt.expected.APIURL) {
 				t.Errorf("APIURL: got %v, want %v", result.APIURL, tt.expected.APIURL)
 			}
+			if !result.TokenType.Equal(tt.expected.TokenType) {
+				t.Errorf("TokenType: got %v, want %v", result.TokenType, tt.expected.TokenType)
+			}
 			if !result.Status.Equal(tt.expected.Status) {
 				t.Errorf("Status: got %v, want %v", result.Status, tt.expected.Status)
 			}
@@ -120,3 +135,119 @@ func TestMapVCSIntegrationToState(t *testing.T) {
 		})
 	}
 }
+
+func gitlabModel() *VCSIntegrationModel {
+	return &VCSIntegrationModel{
+		ID:                    types.StringValue("vcs-1"),
+		OrganizationID:        types.StringValue("org-1"),
+		Name:                  types.StringValue("GitLab"),
+		ProviderType:          types.StringValue("gitlab"),
+		PersonalAccessToken:   types.StringValue("glpat-old"),
+		TokenType:             types.StringValue("personal"),
+		RotateTokenOnChangeOf: types.MapNull(types.StringType),
+		APIURL:                types.StringValue("https://gitlab.com"),
+		InstallationID:        types.Int64Null(),
+		Status:                types.StringValue("active"),
+		CreatedAt:             timeutil.NewTimestampValue("2026-03-01T12:00:00Z"),
+		UpdatedAt:             timeutil.NewTimestampValue("2026-03-01T12:00:00Z"),
+	}
+}
+
+func TestVCSIntegrationResource_Update(t *testing.T) {
+	ctx := context.Background()
+	triggers := types.MapValueMust(types.StringType, map[string]attr.Value{"expires": types.StringValue("2026-09-01")})
+
+	tests := []struct {
+		name          string
+		change        func(*VCSIntegrationModel)
+		wantCalls     []string
+		wantToken     string
+		wantTokenType string
+	}{
+		{name: "name",
+			change:    func(m *VCSIntegrationModel) { m.Name = types.StringValue("GitLab EU") },
+			wantCalls: []string{"UpdateVCSIntegration"}},
+		{name: "token",
+			change:    func(m *VCSIntegrationModel) { m.PersonalAccessToken = types.StringValue("glpat-new") },
+			wantCalls: []string{"UpdateVCSIntegrationCredentials"}, wantToken: "glpat-new", wantTokenType: "personal"},
+		{name: "rotation trigger",
+			change:    func(m *VCSIntegrationModel) { m.RotateTokenOnChangeOf = triggers },
+			wantCalls: []string{"UpdateVCSIntegrationCredentials"}, wantToken: "glpat-old", wantTokenType: "personal"},
+		{name: "group token and name",
+			change: func(m *VCSIntegrationModel) {
+				m.Name = types.StringValue("GitLab EU")
+				m.PersonalAccessToken = types.StringValue("glgat-new")
+				m.TokenType = types.StringValue("group")
+			},
+			wantCalls: []string{"UpdateVCSIntegrationCredentials", "UpdateVCSIntegration"}, wantToken: "glgat-new", wantTokenType: "group"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotCredentials *zenfraclient.UpdateVCSGitLabCredentials
+			integration := func(id, name, tokenType string) *zenfraclient.VCSIntegration {
+				return &zenfraclient.VCSIntegration{ID: id, OrganizationID: "org-1", Provider: "gitlab", DisplayName: name, Status: "active",
+					GitLab: &zenfraclient.VCSGitLabConfig{BaseURL: "https://gitlab.com", TokenType: tokenType}}
+			}
+			plan := *gitlabModel()
+			tt.change(&plan)
+			fake := &zenfrafake.Client{
+				UpdateVCSIntegrationCredentialsFunc: func(_ context.Context, id string, req zenfraclient.UpdateVCSCredentialsRequest) (*zenfraclient.VCSIntegration, error) {
+					gotCredentials = req.GitLab
+					return integration(id, "GitLab", req.GitLab.TokenType), nil
+				},
+				UpdateVCSIntegrationFunc: func(_ context.Context, id string, req zenfraclient.UpdateVCSIntegrationRequest) (*zenfraclient.VCSIntegration, error) {
+					return integration(id, *req.DisplayName, plan.TokenType.ValueString()), nil
+				},
+			}
+			r := &VCSIntegrationResource{client: fake}
+
+			resp := &resource.UpdateResponse{State: zenfrafake.State(t, r, gitlabModel())}
+			r.Update(ctx, resource.UpdateRequest{
+				Plan:  tfsdk.Plan(zenfrafake.State(t, r, &plan)),
+				State: zenfrafake.State(t, r, gitlabModel()),
+			}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update: %v", resp.Diagnostics)
+			}
+			if !slices.Equal(fake.Calls(), tt.wantCalls) {
+				t.Errorf("expected calls %v, got %v", tt.wantCalls, fake.Calls())
+			}
+			if tt.wantToken != "" && (gotCredentials == nil || gotCredentials.AccessToken != tt.wantToken || gotCredentials.TokenType != tt.wantTokenType) {
+				t.Errorf("expected token %s of type %s, got %+v", tt.wantToken, tt.wantTokenType, gotCredentials)
+			}
+
+			var got VCSIntegrationModel
+			resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+			if !got.Name.Equal(plan.Name) || !got.PersonalAccessToken.Equal(plan.PersonalAccessToken) ||
+				!got.TokenType.Equal(plan.TokenType) || !got.RotateTokenOnChangeOf.Equal(plan.RotateTokenOnChangeOf) {
+				t.Errorf("expected the planned arguments in state, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestVCSIntegrationResource_ModifyPlan_ReplacementWarning(t *testing.T) {
+	ctx := context.Background()
+	r := &VCSIntegrationResource{}
+
+	prior := gitlabModel()
+	plan := *prior
+	plan.APIURL = types.StringValue("https://gitlab.example.com")
+
+	resp := &resource.ModifyPlanResponse{
+		Plan:            tfsdk.Plan(zenfrafake.State(t, r, &plan)),
+		RequiresReplace: path.Paths{path.Root("api_url")},
+	}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan(zenfrafake.State(t, r, &plan)),
+		State: zenfrafake.State(t, r, prior),
+	}, resp)
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || warnings[0].Summary() != "VCS Integration Will Be Replaced" {
+		t.Fatalf("expected a replacement warning, got %v", resp.Diagnostics)
+	}
+	if !strings.Contains(warnings[0].Detail(), "api_url") {
+		t.Errorf("expected the warning to name api_url, got %q", warnings[0].Detail())
+	}
+}
@@ -0,0 +1,50 @@
+// ABOUTME: Terraform state model for the zenfra_webhook_secret_rotation resource.
+// ABOUTME: Maps a rotation response to state and detects rotations made outside Terraform.
+package webhook_secret_rotation
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// WebhookSecretRotationModel represents the Terraform state model for a webhook secret rotation.
+type WebhookSecretRotationModel struct {
+	ID                      types.String            `tfsdk:"id"`
+	WebhookEndpointID       types.String            `tfsdk:"webhook_endpoint_id"`
+	OrganizationID          types.String            `tfsdk:"organization_id"`
+	RotationTriggers        types.Map               `tfsdk:"rotation_triggers"`
+	Secret                  types.String            `tfsdk:"secret"`
+	RotatedAt               timeutil.TimestampValue `tfsdk:"rotated_at"`
+	PreviousSecretExpiresAt timeutil.TimestampValue `tfsdk:"previous_secret_expires_at"`
+}
+
+// mapRotationToState converts a rotation response to state, keeping the configured
+// organization and triggers from plan.
+func mapRotationToState(rotation *zenfraclient.WebhookSecretRotation, plan WebhookSecretRotationModel) WebhookSecretRotationModel {
+	return WebhookSecretRotationModel{
+		ID:                      types.StringValue(rotation.WebhookEndpointID),
+		WebhookEndpointID:       types.StringValue(rotation.WebhookEndpointID),
+		OrganizationID:          plan.OrganizationID,
+		RotationTriggers:        plan.RotationTriggers,
+		Secret:                  types.StringValue(rotation.Secret),
+		RotatedAt:               timeutil.Timestamp(rotation.RotatedAt),
+		PreviousSecretExpiresAt: timeutil.TimestampPointer(rotation.PreviousSecretExpiresAt),
+	}
+}
+
+// rotatedElsewhere reports whether endpoint's secret was rotated after rotatedAt, so the
+// secret in state no longer signs deliveries. The comparison is at second
+// precision, the precision rotated_at is stored with.
+func rotatedElsewhere(endpoint *zenfraclient.WebhookEndpoint, rotatedAt timeutil.TimestampValue) bool {
+	if endpoint.SecretRotatedAt == nil {
+		return false
+	}
+	ours, err := rotatedAt.Time()
+	if err != nil {
+		return false
+	}
+	return endpoint.SecretRotatedAt.Truncate(time.Second).After(ours)
+}
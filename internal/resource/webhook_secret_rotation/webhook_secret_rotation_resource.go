@@ -0,0 +1,203 @@
+// ABOUTME: Implements the zenfra_webhook_secret_rotation resource, which rotates a webhook endpoint's signing secret.
+// ABOUTME: Creating it rotates the secret and stores it write-once; changing rotation_triggers rotates again.
+package webhook_secret_rotation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                = &WebhookSecretRotationResource{}
+	_ resource.ResourceWithImportState = &WebhookSecretRotationResource{}
+	_ resource.ResourceWithModifyPlan  = &WebhookSecretRotationResource{}
+)
+
+// NewWebhookSecretRotationResource is a constructor for the webhook secret rotation resource.
+func NewWebhookSecretRotationResource() resource.Resource {
+	return &WebhookSecretRotationResource{}
+}
+
+// WebhookSecretRotationResource is the resource implementation.
+type WebhookSecretRotationResource struct {
+	client zenfraclient.WebhookSecretRotationAPI
+}
+
+func (r *WebhookSecretRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook_secret_rotation"
+}
+
+func (r *WebhookSecretRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates the signing secret of an existing webhook endpoint, such as one read with the zenfra_webhook_endpoint data source. " +
+			"Creating the resource rotates the secret and stores the new one in state; the API never returns it again. " +
+			"Changing rotation_triggers rotates it again. If the secret is rotated outside Terraform, the resource is planned for " +
+			"creation so the next apply rotates it and state holds a secret that works. Destroying the resource does not change the endpoint.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the webhook endpoint.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_endpoint_id": schema.StringAttribute{
+				Description: "The webhook endpoint whose secret to rotate. Changing it rotates the secret of the new endpoint.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the webhook endpoint. Defaults to the organization of the provider's API token; set it to " +
+					"the endpoint's organization_id when the endpoint belongs to another organization the token has access to. " +
+					"Changing it rotates the secret of the endpoint in that organization.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Description: "Arbitrary values that rotate the secret again when any of them changes, such as the id of a time_rotating resource.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret": schema.StringAttribute{
+				Description: "The new signing secret. Only known after the rotation that created this resource; null after import.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rotated_at": schema.StringAttribute{
+				Description: "Timestamp of the rotation.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"previous_secret_expires_at": schema.StringAttribute{
+				Description: "Until when deliveries are also signed with the previous secret, giving receivers time to switch over. " +
+					"Null if the endpoint had no previous secret or after import.",
+				CustomType: timeutil.TimestampType{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan warns when the API token may not manage webhook endpoints.
+func (r *WebhookSecretRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_webhook_secret_rotation", "webhook_endpoint", req, resp)
+}
+
+func (r *WebhookSecretRotationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_webhook_secret_rotation")
+	}
+}
+
+func (r *WebhookSecretRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WebhookSecretRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	endpointID := plan.WebhookEndpointID.ValueString()
+	rotation, err := r.client.RotateWebhookEndpointSecret(ctx, endpointID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Rotating Webhook Secret",
+			fmt.Sprintf("Could not rotate the secret of webhook endpoint %s: %s", endpointID, err))
+		return
+	}
+
+	state := mapRotationToState(rotation, plan)
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *WebhookSecretRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WebhookSecretRotationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	endpointID := state.WebhookEndpointID.ValueString()
+	endpoint, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.WebhookEndpoint, error) {
+		return r.client.GetWebhookEndpoint(ctx, endpointID)
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Webhook Endpoint",
+				fmt.Sprintf("Could not read webhook endpoint ID %s: %s\n\n%s", endpointID, err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Webhook Endpoint",
+			fmt.Sprintf("Could not read webhook endpoint ID %s: %s", endpointID, err))
+		return
+	}
+
+	// An imported rotation only knows the endpoint; take the time of its last rotation.
+	if state.RotatedAt.IsNull() {
+		state.ID = types.StringValue(endpoint.ID)
+		state.RotatedAt = timeutil.TimestampPointer(endpoint.SecretRotatedAt)
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+		return
+	}
+
+	if rotatedElsewhere(endpoint, state.RotatedAt) {
+		resp.State.RemoveResource(ctx)
+	}
+}
+
+func (r *WebhookSecretRotationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute forces replacement, so there is nothing to send.
+	var plan WebhookSecretRotationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *WebhookSecretRotationResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+	// A rotation cannot be undone; the endpoint keeps its current secret.
+}
+
+// ImportState accepts a webhook endpoint ID, optionally prefixed with organization_id/.
+// The secret cannot be read back, so it stays null until the next rotation.
+func (r *WebhookSecretRotationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationAttribute(ctx, r.client, "webhook endpoint", path.Root("webhook_endpoint_id"), importguard.WebhookEndpoint(r.client), req, resp)
+}
@@ -0,0 +1,133 @@
+// ABOUTME: Unit tests for the zenfra_webhook_secret_rotation resource against the zenfrafake client.
+// ABOUTME: Covers rotation on create, removal after a rotation outside Terraform, and reads after import.
+package webhook_secret_rotation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func rotatedModel(rotatedAt string) *WebhookSecretRotationModel {
+	return &WebhookSecretRotationModel{
+		ID:                      types.StringValue("wh-1"),
+		WebhookEndpointID:       types.StringValue("wh-1"),
+		RotationTriggers:        types.MapNull(types.StringType),
+		Secret:                  types.StringValue("whsec_new"),
+		RotatedAt:               timeutil.NewTimestampValue(rotatedAt),
+		PreviousSecretExpiresAt: timeutil.NewTimestampNull(),
+	}
+}
+
+func TestWebhookSecretRotationResource_Create(t *testing.T) {
+	ctx := context.Background()
+	rotatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	overlap := rotatedAt.Add(24 * time.Hour)
+	fake := &zenfrafake.Client{
+		RotateWebhookEndpointSecretFunc: func(_ context.Context, id string) (*zenfraclient.WebhookSecretRotation, error) {
+			return &zenfraclient.WebhookSecretRotation{WebhookEndpointID: id, Secret: "whsec_new", RotatedAt: rotatedAt, PreviousSecretExpiresAt: &overlap}, nil
+		},
+	}
+	r := &WebhookSecretRotationResource{client: fake}
+
+	plan := zenfrafake.State(t, r, &WebhookSecretRotationModel{
+		ID:                      types.StringUnknown(),
+		WebhookEndpointID:       types.StringValue("wh-1"),
+		RotationTriggers:        types.MapValueMust(types.StringType, map[string]attr.Value{"quarter": types.StringValue("2026-Q1")}),
+		Secret:                  types.StringUnknown(),
+		RotatedAt:               timeutil.NewTimestampUnknown(),
+		PreviousSecretExpiresAt: timeutil.NewTimestampUnknown(),
+	})
+	resp := &resource.CreateResponse{State: zenfrafake.State(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(plan)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+
+	var state WebhookSecretRotationModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "wh-1" || state.Secret.ValueString() != "whsec_new" ||
+		state.RotatedAt.ValueString() != "2026-03-01T12:00:00Z" || state.PreviousSecretExpiresAt.ValueString() != "2026-03-02T12:00:00Z" {
+		t.Errorf("unexpected state: %+v", state)
+	}
+	if len(state.RotationTriggers.Elements()) != 1 {
+		t.Errorf("expected the configured rotation_triggers kept, got %s", state.RotationTriggers)
+	}
+}
+
+func TestWebhookSecretRotationResource_Read(t *testing.T) {
+	ctx := context.Background()
+	ours := "2026-03-01T12:00:00Z"
+
+	tests := []struct {
+		name        string
+		rotatedAt   *time.Time
+		wantRemoved bool
+	}{
+		{name: "same rotation, sub-second precision", rotatedAt: ptr(time.Date(2026, 3, 1, 12, 0, 0, 400_000_000, time.UTC))},
+		{name: "rotated outside terraform", rotatedAt: ptr(time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)), wantRemoved: true},
+		{name: "no rotation reported"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &zenfrafake.Client{
+				GetWebhookEndpointFunc: func(_ context.Context, id string) (*zenfraclient.WebhookEndpoint, error) {
+					return &zenfraclient.WebhookEndpoint{ID: id, SecretRotatedAt: tt.rotatedAt}, nil
+				},
+			}
+			r := &WebhookSecretRotationResource{client: fake}
+
+			state := zenfrafake.State(t, r, rotatedModel(ours))
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != tt.wantRemoved {
+				t.Errorf("expected removed = %v, got state %v", tt.wantRemoved, resp.State.Raw)
+			}
+		})
+	}
+}
+
+func TestWebhookSecretRotationResource_ReadAfterImport(t *testing.T) {
+	ctx := context.Background()
+	rotatedAt := time.Date(2026, 2, 1, 8, 30, 0, 0, time.UTC)
+	fake := &zenfrafake.Client{
+		GetWebhookEndpointFunc: func(_ context.Context, id string) (*zenfraclient.WebhookEndpoint, error) {
+			return &zenfraclient.WebhookEndpoint{ID: id, SecretRotatedAt: &rotatedAt}, nil
+		},
+	}
+	r := &WebhookSecretRotationResource{client: fake}
+
+	imported := &WebhookSecretRotationModel{
+		ID:                      types.StringNull(),
+		WebhookEndpointID:       types.StringValue("wh-1"),
+		RotationTriggers:        types.MapNull(types.StringType),
+		Secret:                  types.StringNull(),
+		RotatedAt:               timeutil.NewTimestampNull(),
+		PreviousSecretExpiresAt: timeutil.NewTimestampNull(),
+	}
+	state := zenfrafake.State(t, r, imported)
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+
+	var got WebhookSecretRotationModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &got)...)
+	if got.ID.ValueString() != "wh-1" || got.RotatedAt.ValueString() != "2026-02-01T08:30:00Z" || !got.Secret.IsNull() {
+		t.Errorf("unexpected state after import: %+v", got)
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
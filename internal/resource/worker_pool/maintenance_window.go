@@ -0,0 +1,87 @@
+// ABOUTME: Plan-time parsing and overlap checks for zenfra_worker_pool maintenance windows.
+// ABOUTME: Windows are a five-field cron start schedule in a time zone plus a duration in minutes.
+package worker_pool
+
+import (
+	"fmt"
+	"sort"
+	"time"
+	_ "time/tzdata" // time zones must resolve on hosts without a zoneinfo database
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/cronexpr"
+)
+
+// maxWindowMinutes caps a single maintenance window at one week.
+const maxWindowMinutes = 7 * 24 * 60
+
+// overlapHorizonStart and overlapHorizonDays bound the period over which windows are
+// compared. A leap year covers every month length and weekday alignment that matters
+// in practice, and a fixed year keeps the check independent of when the plan runs.
+var overlapHorizonStart = time.Date(2028, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+const overlapHorizonDays = 366
+
+// maintenanceWindow is a validated window ready for overlap checks.
+type maintenanceWindow struct {
+	schedule *cronexpr.Schedule
+	location *time.Location
+	duration time.Duration
+}
+
+// parseMaintenanceWindow validates one window's settings. An empty timezone means UTC.
+func parseMaintenanceWindow(cron string, durationMinutes int64, timezone string) (*maintenanceWindow, error) {
+	schedule, err := cronexpr.Parse(cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", cron, err)
+	}
+	if durationMinutes < 1 || durationMinutes > maxWindowMinutes {
+		return nil, fmt.Errorf("duration_minutes must be between 1 and %d, got %d", maxWindowMinutes, durationMinutes)
+	}
+	loc := time.UTC
+	if timezone != "" {
+		if loc, err = time.LoadLocation(timezone); err != nil {
+			return nil, fmt.Errorf("unknown time zone %q; use an IANA name such as Europe/Berlin", timezone)
+		}
+	}
+	return &maintenanceWindow{schedule: schedule, location: loc, duration: time.Duration(durationMinutes) * time.Minute}, nil
+}
+
+// windowOverlap describes two windows, by index, that are open at the same time.
+type windowOverlap struct {
+	first, second int
+	at            time.Time
+}
+
+// findWindowOverlap returns the first time two different windows are open at once
+// within the overlap horizon. Windows that only touch, one ending as the next
+// starts, do not overlap. Nil entries are skipped.
+func findWindowOverlap(windows []*maintenanceWindow) *windowOverlap {
+	type interval struct {
+		window     int
+		start, end time.Time
+	}
+
+	var intervals []interval
+	for i, w := range windows {
+		if w == nil {
+			continue
+		}
+		for _, start := range w.schedule.Starts(w.location, overlapHorizonStart, overlapHorizonDays) {
+			intervals = append(intervals, interval{window: i, start: start, end: start.Add(w.duration)})
+		}
+	}
+	sort.SliceStable(intervals, func(a, b int) bool { return intervals[a].start.Before(intervals[b].start) })
+
+	// Every window has a fixed duration, so its most recent start also ends last.
+	lastEnd := make([]time.Time, len(windows))
+	for _, iv := range intervals {
+		for other, end := range lastEnd {
+			if other != iv.window && end.After(iv.start) {
+				first, second := min(other, iv.window), max(other, iv.window)
+				return &windowOverlap{first: first, second: second, at: iv.start.UTC()}
+			}
+		}
+		lastEnd[iv.window] = iv.end
+	}
+	return nil
+}
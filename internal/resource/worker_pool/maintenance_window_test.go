@@ -0,0 +1,106 @@
+// ABOUTME: Unit tests for zenfra_worker_pool maintenance window parsing and overlap checks.
+// ABOUTME: Covers cron errors, durations, time zones, and windows that touch but do not overlap.
+package worker_pool
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseMaintenanceWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		cron     string
+		duration int64
+		timezone string
+		wantErr  string
+	}{
+		{name: "weekly", cron: "0 2 * * SUN", duration: 120},
+		{name: "lists ranges and steps", cron: "0,30 1-5/2 1,15 jan-mar mon-fri", duration: 30},
+		{name: "sunday as 7", cron: "0 3 * * 7", duration: 60, timezone: "Europe/Berlin"},
+		{name: "one week", cron: "0 0 * * 0", duration: maxWindowMinutes},
+		{name: "too few fields", cron: "0 2 * *", duration: 60, wantErr: "expected 5 fields"},
+		{name: "minute out of range", cron: "60 2 * * *", duration: 60, wantErr: "outside 0-59"},
+		{name: "unknown name", cron: "0 2 * * FUNDAY", duration: 60, wantErr: "invalid value"},
+		{name: "zero duration", cron: "0 2 * * *", duration: 0, wantErr: "duration_minutes must be between"},
+		{name: "longer than a week", cron: "0 2 * * *", duration: maxWindowMinutes + 1, wantErr: "duration_minutes must be between"},
+		{name: "unknown time zone", cron: "0 2 * * *", duration: 60, timezone: "Mars/Olympus", wantErr: "unknown time zone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseMaintenanceWindow(tt.cron, tt.duration, tt.timezone)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFindWindowOverlap(t *testing.T) {
+	window := func(cron string, minutes int64, timezone string) *maintenanceWindow {
+		t.Helper()
+		w, err := parseMaintenanceWindow(cron, minutes, timezone)
+		if err != nil {
+			t.Fatalf("parseMaintenanceWindow(%q): %v", cron, err)
+		}
+		return w
+	}
+
+	tests := []struct {
+		name    string
+		windows []*maintenanceWindow
+		want    *windowOverlap
+	}{
+		{
+			name:    "different days",
+			windows: []*maintenanceWindow{window("0 2 * * SAT", 120, ""), window("0 2 * * SUN", 120, "")},
+		},
+		{
+			name:    "touching",
+			windows: []*maintenanceWindow{window("0 2 * * *", 60, ""), window("0 3 * * *", 60, "")},
+		},
+		{
+			name:    "overlapping",
+			windows: []*maintenanceWindow{window("0 2 * * *", 90, ""), window("0 3 * * *", 60, "")},
+			want:    &windowOverlap{first: 0, second: 1, at: time.Date(2028, time.January, 1, 3, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:    "long window runs into the next day",
+			windows: []*maintenanceWindow{window("0 22 * * SAT", 300, ""), window("0 1 * * SUN", 60, "")},
+			want:    &windowOverlap{first: 0, second: 1, at: time.Date(2028, time.January, 2, 1, 0, 0, 0, time.UTC)},
+		},
+		{
+			// 02:00 in Berlin is 01:00 UTC in winter.
+			name:    "time zones",
+			windows: []*maintenanceWindow{window("0 2 * * *", 30, "Europe/Berlin"), window("15 1 * * *", 30, "")},
+			want:    &windowOverlap{first: 0, second: 1, at: time.Date(2028, time.January, 1, 1, 15, 0, 0, time.UTC)},
+		},
+		{
+			name:    "nil entries skipped",
+			windows: []*maintenanceWindow{nil, window("0 2 * * *", 60, "")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findWindowOverlap(tt.windows)
+			switch {
+			case tt.want == nil && got != nil:
+				t.Fatalf("expected no overlap, got windows %d and %d at %s", got.first, got.second, got.at)
+			case tt.want != nil && got == nil:
+				t.Fatal("expected an overlap, got none")
+			case tt.want != nil && (got.first != tt.want.first || got.second != tt.want.second || !got.at.Equal(tt.want.at)):
+				t.Fatalf("expected windows %d and %d at %s, got %d and %d at %s",
+					tt.want.first, tt.want.second, tt.want.at, got.first, got.second, got.at)
+			}
+		})
+	}
+}
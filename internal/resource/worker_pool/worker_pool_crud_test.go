@@ -0,0 +1,335 @@
+// ABOUTME: Unit tests for zenfra_worker_pool CRUD logic against the zenfrafake client.
+// ABOUTME: Covers the write-once api_key, removal on 404, draining, empty allowed_space_ids, and simulated API errors.
+package worker_pool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient/zenfrafake"
+)
+
+func testPoolModel() *WorkerPoolModel {
+	return &WorkerPoolModel{
+		ID:                 types.StringValue("pool-1"),
+		OrganizationID:     types.StringValue("org-1"),
+		Name:               types.StringValue("private"),
+		APIKey:             types.StringValue("secret-key"),
+		APIKeyID:           types.StringNull(),
+		KeyVersion:         types.Int64Value(1),
+		Active:             types.BoolValue(true),
+		ActiveWorkersCount: types.Int64Value(0),
+		Drain:              types.BoolValue(false),
+		DrainTimeout:       types.Int64Null(),
+		InFlightRuns:       types.Int64Value(0),
+		AllowedSpaceIDs:    types.SetNull(types.StringType),
+		MaintenanceWindows: types.ListNull(maintenanceWindowType),
+		CreatedAt:          timeutil.NewTimestampValue("2026-01-01T00:00:00Z"),
+		UpdatedAt:          timeutil.NewTimestampValue("2026-01-01T00:00:00Z"),
+		LastUsedAt:         timeutil.NewTimestampNull(),
+
+		RunnerVersionConstraint: types.StringNull(),
+	}
+}
+
+func TestWorkerPoolResource_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var gotReq zenfraclient.CreateWorkerPoolRequest
+	fake := &zenfrafake.Client{
+		CreateWorkerPoolFunc: func(_ context.Context, req zenfraclient.CreateWorkerPoolRequest) (*zenfraclient.CreateWorkerPoolResponse, error) {
+			gotReq = req
+			return &zenfraclient.CreateWorkerPoolResponse{
+				Pool:   zenfraclient.WorkerPool{ID: "pool-1", OrganizationID: "org-1", Name: req.Name, KeyVersion: 1, Active: true, CreatedAt: now, UpdatedAt: now},
+				APIKey: "secret-key",
+			}, nil
+		},
+	}
+	r := &WorkerPoolResource{client: fake}
+
+	plan := testPoolModel()
+	plan.ID = types.StringUnknown()
+	plan.APIKey = types.StringUnknown()
+	planState := zenfrafake.State(t, r, plan)
+
+	resp := &resource.CreateResponse{State: zenfrafake.State(t, r, nil)}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan(planState)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+
+	var got WorkerPoolModel
+	resp.State.Get(ctx, &got)
+	if gotReq.Name != "private" {
+		t.Errorf("expected create request name private, got %q", gotReq.Name)
+	}
+	if got.ID.ValueString() != "pool-1" || got.APIKey.ValueString() != "secret-key" {
+		t.Errorf("expected id and api_key from the create response, got %s and %s", got.ID, got.APIKey)
+	}
+}
+
+func TestWorkerPoolResource_ReadRemovesMissingPool(t *testing.T) {
+	ctx := context.Background()
+
+	fake := &zenfrafake.Client{
+		GetWorkerPoolCachedFunc: func(context.Context, string) (*zenfraclient.WorkerPool, error) {
+			return nil, zenfrafake.NotFound()
+		},
+	}
+	r := &WorkerPoolResource{client: fake}
+
+	state := zenfrafake.State(t, r, testPoolModel())
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("expected the pool to be removed from state")
+	}
+}
+
+func TestWorkerPoolResource_ReadForbidden(t *testing.T) {
+	ctx := context.Background()
+
+	fake := &zenfrafake.Client{
+		GetWorkerPoolCachedFunc: func(context.Context, string) (*zenfraclient.WorkerPool, error) {
+			return nil, zenfrafake.Forbidden()
+		},
+	}
+	r := &WorkerPoolResource{client: fake}
+
+	state := zenfrafake.State(t, r, testPoolModel())
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a forbidden read")
+	}
+
+	fake.TreatForbiddenAsNotFound = true
+	resp = &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() || !resp.State.Raw.IsNull() {
+		t.Errorf("expected removal with treat_forbidden_as_not_found, got %v", resp.Diagnostics)
+	}
+}
+
+func TestWorkerPoolResource_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{name: "deleted", err: nil},
+		{name: "already gone", err: zenfrafake.NotFound()},
+		{name: "api error", err: errors.New("connection reset"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &zenfrafake.Client{
+				DeleteWorkerPoolFunc: func(context.Context, string) error { return tt.err },
+			}
+			r := &WorkerPoolResource{client: fake}
+
+			state := zenfrafake.State(t, r, testPoolModel())
+			resp := &resource.DeleteResponse{State: state}
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if calls := fake.Calls(); len(calls) != 1 || calls[0] != "DeleteWorkerPool" {
+				t.Errorf("unexpected API calls: %v", calls)
+			}
+		})
+	}
+}
+
+func TestWorkerPoolResource_UpdateDrain(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		current  bool
+		drain    types.Bool
+		wantCall string
+	}{
+		{name: "drain", drain: types.BoolValue(true), wantCall: "DrainWorkerPool"},
+		{name: "resume", current: true, drain: types.BoolValue(false), wantCall: "ResumeWorkerPool"},
+		{name: "already draining", current: true, drain: types.BoolValue(true)},
+		{name: "not configured", current: true, drain: types.BoolUnknown()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pool := func(draining bool) *zenfraclient.WorkerPool {
+				return &zenfraclient.WorkerPool{ID: "pool-1", OrganizationID: "org-1", Name: "private", KeyVersion: 1, Active: true, Draining: draining}
+			}
+			fake := &zenfrafake.Client{
+				UpdateWorkerPoolFunc: func(context.Context, string, zenfraclient.UpdateWorkerPoolRequest) (*zenfraclient.WorkerPool, error) {
+					return pool(tt.current), nil
+				},
+				DrainWorkerPoolFunc:  func(context.Context, string) (*zenfraclient.WorkerPool, error) { return pool(true), nil },
+				ResumeWorkerPoolFunc: func(context.Context, string) (*zenfraclient.WorkerPool, error) { return pool(false), nil },
+			}
+			r := &WorkerPoolResource{client: fake}
+
+			prior := testPoolModel()
+			prior.Drain = types.BoolValue(tt.current)
+			plan := testPoolModel()
+			plan.Drain = tt.drain
+
+			state := zenfrafake.State(t, r, prior)
+			planState := zenfrafake.State(t, r, plan)
+			resp := &resource.UpdateResponse{State: state}
+			r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan(planState), State: state}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Update: %v", resp.Diagnostics)
+			}
+
+			calls := fake.Calls()
+			if tt.wantCall == "" && len(calls) != 1 || tt.wantCall != "" && (len(calls) != 2 || calls[1] != tt.wantCall) {
+				t.Errorf("expected UpdateWorkerPool followed by %q, got %v", tt.wantCall, calls)
+			}
+			var got WorkerPoolModel
+			resp.State.Get(ctx, &got)
+			want := tt.current
+			if tt.wantCall != "" {
+				want = tt.drain.ValueBool()
+			}
+			if got.Drain.ValueBool() != want {
+				t.Errorf("expected drain=%v in state, got %s", want, got.Drain)
+			}
+		})
+	}
+}
+
+func TestWorkerPoolResource_ValidateConfigAllowedSpaces(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		spaces  types.Set
+		wantErr bool
+	}{
+		{name: "unset", spaces: types.SetNull(types.StringType)},
+		{name: "not yet known", spaces: types.SetUnknown(types.StringType)},
+		{name: "restricted", spaces: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("space-1")})},
+		{name: "empty", spaces: types.SetValueMust(types.StringType, []attr.Value{}), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &WorkerPoolResource{}
+			model := testPoolModel()
+			model.AllowedSpaceIDs = tt.spaces
+
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config(zenfrafake.State(t, r, model))}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("expected error %v, got %v", tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestWorkerPoolResource_ModifyPlanChecksRunnerVersion(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		prior       types.String
+		planned     types.String
+		wantCatalog bool
+		wantError   bool
+	}{
+		{name: "unset", prior: types.StringNull(), planned: types.StringNull()},
+		{name: "unchanged", prior: types.StringValue("~> 9.0"), planned: types.StringValue("~> 9.0")},
+		{name: "matching", prior: types.StringNull(), planned: types.StringValue("~> 1.4"), wantCatalog: true},
+		{name: "no match", prior: types.StringValue("~> 1.4"), planned: types.StringValue("~> 9.0"), wantCatalog: true, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &zenfrafake.Client{
+				ListRunnerVersionsFunc: func(context.Context) ([]zenfraclient.RunnerVersion, error) {
+					return []zenfraclient.RunnerVersion{{Version: "1.4.0"}, {Version: "1.5.1"}}, nil
+				},
+			}
+			r := &WorkerPoolResource{client: fake}
+
+			prior := testPoolModel()
+			prior.RunnerVersionConstraint = tt.prior
+			plan := testPoolModel()
+			plan.RunnerVersionConstraint = tt.planned
+
+			planState := zenfrafake.State(t, r, plan)
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan(planState)}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: tfsdk.Plan(planState), State: zenfrafake.State(t, r, prior)}, resp)
+
+			if got := len(fake.Calls()) > 0; got != tt.wantCatalog {
+				t.Errorf("expected catalog read %v, got calls %v", tt.wantCatalog, fake.Calls())
+			}
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error %v, got %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestWorkerPoolResource_DeleteWaitsForDrain(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		waitErr    error
+		wantDelete bool
+	}{
+		{name: "drained", wantDelete: true},
+		{name: "already gone", waitErr: zenfrafake.NotFound(), wantDelete: true},
+		{name: "timed out", waitErr: context.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deadline time.Time
+			fake := &zenfrafake.Client{
+				WaitForWorkerPoolDrainedFunc: func(ctx context.Context, _ string, _ time.Duration) (*zenfraclient.WorkerPool, error) {
+					deadline, _ = ctx.Deadline()
+					return nil, tt.waitErr
+				},
+				DeleteWorkerPoolFunc: func(context.Context, string) error { return nil },
+			}
+			r := &WorkerPoolResource{client: fake}
+
+			model := testPoolModel()
+			model.Drain = types.BoolValue(true)
+			model.DrainTimeout = types.Int64Value(60)
+			state := zenfrafake.State(t, r, model)
+			resp := &resource.DeleteResponse{State: state}
+			start := time.Now()
+			r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+			if resp.Diagnostics.HasError() == tt.wantDelete {
+				t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if deleted := len(fake.Calls()) == 2; deleted != tt.wantDelete {
+				t.Errorf("expected delete=%v, got calls %v", tt.wantDelete, fake.Calls())
+			}
+			if wait := deadline.Sub(start); wait < 59*time.Second || wait > 61*time.Second {
+				t.Errorf("expected the wait to be bounded by drain_timeout_seconds, got %s", wait)
+			}
+		})
+	}
+}
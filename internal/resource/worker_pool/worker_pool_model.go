@@ -3,28 +3,54 @@
 package worker_pool
 
 import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 // WorkerPoolModel represents the Terraform state model for a Zenfra worker pool.
 type WorkerPoolModel struct {
-	ID                 types.String `tfsdk:"id"`
-	OrganizationID     types.String `tfsdk:"organization_id"`
-	Name               types.String `tfsdk:"name"`
-	APIKey             types.String `tfsdk:"api_key"`
-	APIKeyID           types.String `tfsdk:"api_key_id"`
-	KeyVersion         types.Int64  `tfsdk:"key_version"`
-	Active             types.Bool   `tfsdk:"active"`
-	ActiveWorkersCount types.Int64  `tfsdk:"active_workers_count"`
-	CreatedAt          types.String `tfsdk:"created_at"`
-	UpdatedAt          types.String `tfsdk:"updated_at"`
-	LastUsedAt         types.String `tfsdk:"last_used_at"`
+	ID                      types.String            `tfsdk:"id"`
+	OrganizationID          types.String            `tfsdk:"organization_id"`
+	Name                    types.String            `tfsdk:"name"`
+	APIKey                  types.String            `tfsdk:"api_key"`
+	APIKeyID                types.String            `tfsdk:"api_key_id"`
+	KeyVersion              types.Int64             `tfsdk:"key_version"`
+	Active                  types.Bool              `tfsdk:"active"`
+	ActiveWorkersCount      types.Int64             `tfsdk:"active_workers_count"`
+	Drain                   types.Bool              `tfsdk:"drain"`
+	DrainTimeout            types.Int64             `tfsdk:"drain_timeout_seconds"`
+	InFlightRuns            types.Int64             `tfsdk:"in_flight_runs"`
+	AllowedSpaceIDs         types.Set               `tfsdk:"allowed_space_ids"`
+	MaintenanceWindows      types.List              `tfsdk:"maintenance_windows"`
+	RunnerVersionConstraint types.String            `tfsdk:"runner_version_constraint"`
+	CreatedAt               timeutil.TimestampValue `tfsdk:"created_at"`
+	UpdatedAt               timeutil.TimestampValue `tfsdk:"updated_at"`
+	LastUsedAt              timeutil.TimestampValue `tfsdk:"last_used_at"`
+}
+
+// MaintenanceWindowModel represents one entry of maintenance_windows.
+type MaintenanceWindowModel struct {
+	Cron            types.String `tfsdk:"cron"`
+	DurationMinutes types.Int64  `tfsdk:"duration_minutes"`
+	Timezone        types.String `tfsdk:"timezone"`
+}
+
+// MaintenanceWindowModelAttrTypes defines the attribute types for MaintenanceWindowModel.
+var MaintenanceWindowModelAttrTypes = map[string]attr.Type{
+	"cron":             types.StringType,
+	"duration_minutes": types.Int64Type,
+	"timezone":         types.StringType,
 }
 
 // mapPoolToState converts an API WorkerPool response to a WorkerPoolModel for Terraform state.
 // Note: This does NOT set the api_key field - caller must handle that separately since
-// it's only available at creation time.
+// it's only available at creation time. drain_timeout_seconds is provider-side and
+// also left to the caller.
 func mapPoolToState(pool *zenfraclient.WorkerPool) WorkerPoolModel {
 	model := WorkerPoolModel{
 		ID:                 types.StringValue(pool.ID),
@@ -33,8 +59,14 @@ func mapPoolToState(pool *zenfraclient.WorkerPool) WorkerPoolModel {
 		KeyVersion:         types.Int64Value(int64(pool.KeyVersion)),
 		Active:             types.BoolValue(pool.Active),
 		ActiveWorkersCount: types.Int64Value(pool.ActiveWorkersCount),
-		CreatedAt:          types.StringValue(pool.CreatedAt.Format("2006-01-02T15:04:05Z07:00")),
-		UpdatedAt:          types.StringValue(pool.UpdatedAt.Format("2006-01-02T15:04:05Z07:00")),
+		Drain:              types.BoolValue(pool.Draining),
+		DrainTimeout:       types.Int64Null(),
+		InFlightRuns:       types.Int64Value(pool.InFlightRuns),
+		AllowedSpaceIDs:    allowedSpaceIDsValue(pool.AllowedSpaceIDs, types.SetNull(types.StringType)),
+		MaintenanceWindows: maintenanceWindowsValue(pool.MaintenanceWindows, types.ListNull(maintenanceWindowType)),
+		CreatedAt:          timeutil.Timestamp(pool.CreatedAt),
+		UpdatedAt:          timeutil.Timestamp(pool.UpdatedAt),
+		LastUsedAt:         timeutil.TimestampPointer(pool.LastUsedAt),
 	}
 
 	if pool.APIKeyID != nil && *pool.APIKeyID != "" {
@@ -43,11 +75,92 @@ func mapPoolToState(pool *zenfraclient.WorkerPool) WorkerPoolModel {
 		model.APIKeyID = types.StringNull()
 	}
 
-	if pool.LastUsedAt != nil {
-		model.LastUsedAt = types.StringValue(pool.LastUsedAt.Format("2006-01-02T15:04:05Z07:00"))
+	if pool.RunnerVersionConstraint != "" {
+		model.RunnerVersionConstraint = types.StringValue(pool.RunnerVersionConstraint)
 	} else {
-		model.LastUsedAt = types.StringNull()
+		model.RunnerVersionConstraint = types.StringNull()
 	}
 
 	return model
 }
+
+// allowedSpaceIDsValue converts the API's allowed space IDs to a set. The API does not
+// distinguish an empty restriction from none, so an empty list maps to null unless prior
+// (the plan or prior state) is an explicitly empty set.
+func allowedSpaceIDsValue(ids []string, prior types.Set) types.Set {
+	if len(ids) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			return prior
+		}
+		return types.SetNull(types.StringType)
+	}
+
+	elems := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		elems = append(elems, types.StringValue(id))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// allowedSpaceIDsFromSet converts a planned allowed_space_ids set to the API's list.
+func allowedSpaceIDsFromSet(set types.Set) []string {
+	if set.IsNull() || set.IsUnknown() {
+		return nil
+	}
+	ids := make([]string, 0, len(set.Elements()))
+	for _, v := range set.Elements() {
+		if s, ok := v.(types.String); ok {
+			ids = append(ids, s.ValueString())
+		}
+	}
+	return ids
+}
+
+// maintenanceWindowType is the element type of maintenance_windows.
+var maintenanceWindowType = types.ObjectType{AttrTypes: MaintenanceWindowModelAttrTypes}
+
+// maintenanceWindowsValue converts the API's maintenance windows to a list. Like
+// allowedSpaceIDsValue, no windows map to null unless prior is an explicitly empty list.
+func maintenanceWindowsValue(windows []zenfraclient.MaintenanceWindow, prior types.List) types.List {
+	if len(windows) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			return prior
+		}
+		return types.ListNull(maintenanceWindowType)
+	}
+
+	elems := make([]attr.Value, 0, len(windows))
+	for _, w := range windows {
+		timezone := types.StringNull()
+		if w.Timezone != "" {
+			timezone = types.StringValue(w.Timezone)
+		}
+		elems = append(elems, types.ObjectValueMust(MaintenanceWindowModelAttrTypes, map[string]attr.Value{
+			"cron":             types.StringValue(w.Cron),
+			"duration_minutes": types.Int64Value(w.DurationMinutes),
+			"timezone":         timezone,
+		}))
+	}
+	return types.ListValueMust(maintenanceWindowType, elems)
+}
+
+// maintenanceWindowsFromList converts planned maintenance_windows to the API's list.
+func maintenanceWindowsFromList(ctx context.Context, list types.List) ([]zenfraclient.MaintenanceWindow, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+	var models []MaintenanceWindowModel
+	diags := list.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	windows := make([]zenfraclient.MaintenanceWindow, 0, len(models))
+	for _, m := range models {
+		windows = append(windows, zenfraclient.MaintenanceWindow{
+			Cron:            m.Cron.ValueString(),
+			DurationMinutes: m.DurationMinutes.ValueInt64(),
+			Timezone:        m.Timezone.ValueString(),
+		})
+	}
+	return windows, diags
+}
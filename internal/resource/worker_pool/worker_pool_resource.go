@@ -5,6 +5,7 @@ package worker_pool
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,14 +13,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/cronexpr"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/runnerversion"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
 	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &WorkerPoolResource{}
-	_ resource.ResourceWithImportState = &WorkerPoolResource{}
+	_ resource.Resource                   = &WorkerPoolResource{}
+	_ resource.ResourceWithImportState    = &WorkerPoolResource{}
+	_ resource.ResourceWithValidateConfig = &WorkerPoolResource{}
+	_ resource.ResourceWithModifyPlan     = &WorkerPoolResource{}
+)
+
+const (
+	defaultDrainTimeout = 30 * time.Minute
+	drainPollInterval   = 10 * time.Second
 )
 
 // NewWorkerPoolResource is a helper function to simplify the provider implementation.
@@ -29,7 +46,7 @@ func NewWorkerPoolResource() resource.Resource {
 
 // WorkerPoolResource is the resource implementation.
 type WorkerPoolResource struct {
-	client *zenfraclient.Client
+	client zenfraclient.WorkerPoolAPI
 }
 
 // Metadata returns the resource type name.
@@ -50,10 +67,13 @@ func (r *WorkerPoolResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"organization_id": schema.StringAttribute{
-				Description: "The organization ID this worker pool belongs to.",
-				Computed:    true,
+				Description: "The organization ID this worker pool belongs to. Defaults to the organization of the provider's API token; " +
+					"set it to manage the worker pool in another organization the token has access to. Changing it forces a new worker pool.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -87,12 +107,63 @@ func (r *WorkerPoolResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:    true,
 				Computed:    true,
 			},
+			"allowed_space_ids": schema.SetAttribute{
+				Description: "IDs of the spaces whose stacks may schedule runs on this pool. When unset, every space in the organization may use it. Cannot be empty.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"maintenance_windows": schema.ListNestedAttribute{
+				Description: "Recurring periods during which no new runs are scheduled on this pool, e.g. for OS patching. " +
+					"Runs already in progress when a window opens are allowed to finish. Windows must not overlap.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cron": schema.StringAttribute{
+							Description: "Five-field cron expression (minute hour day-of-month month day-of-week) of the times the window opens, e.g. '0 2 * * SUN'.",
+							Required:    true,
+							Validators: []validator.String{
+								validators.Cron(),
+							},
+						},
+						"duration_minutes": schema.Int64Attribute{
+							Description: fmt.Sprintf("How long the window stays open, in minutes. Between 1 and %d (one week).", maxWindowMinutes),
+							Required:    true,
+						},
+						"timezone": schema.StringAttribute{
+							Description: "IANA time zone the cron expression is evaluated in, e.g. 'Europe/Berlin'. Defaults to UTC.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"runner_version_constraint": schema.StringAttribute{
+				Description: "Pins the runner version the pool's workers run, e.g. '~> 1.4' or '>= 1.4.2, < 1.6'. " +
+					"When unset, the organization default from zenfra_runner_version_constraint applies. " +
+					"The constraint must match a runner version in the catalog; pinning pools one at a time rolls out runner upgrades gradually.",
+				Optional: true,
+			},
 			"active_workers_count": schema.Int64Attribute{
 				Description: "The number of active workers in the pool.",
 				Computed:    true,
 			},
+			"drain": schema.BoolAttribute{
+				Description: "Stop scheduling new runs on the pool while letting runs already executing on it finish. " +
+					"To decommission a pool, set drain = true and apply before destroying it; the destroy then waits for in-flight runs. " +
+					"Set it back to false to resume scheduling.",
+				Optional: true,
+				Computed: true,
+			},
+			"drain_timeout_seconds": schema.Int64Attribute{
+				Description: "Maximum time destroying a draining pool waits for its in-flight runs to finish before failing. Defaults to 1800.",
+				Optional:    true,
+			},
+			"in_flight_runs": schema.Int64Attribute{
+				Description: "The number of runs currently executing on the pool.",
+				Computed:    true,
+			},
 			"created_at": schema.StringAttribute{
 				Description: "Timestamp when the worker pool was created.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -100,32 +171,118 @@ func (r *WorkerPoolResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"updated_at": schema.StringAttribute{
 				Description: "Timestamp when the worker pool was last updated.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 			},
 			"last_used_at": schema.StringAttribute{
 				Description: "Timestamp when the worker pool was last used.",
+				CustomType:  timeutil.TimestampType{},
 				Computed:    true,
 			},
 		},
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *WorkerPoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ValidateConfig checks the drain timeout, that allowed_space_ids is not empty, the
+// runner version constraint syntax, maintenance window schedules, and that no two
+// windows overlap.
+func (r *WorkerPoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config WorkerPoolModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*zenfraclient.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *zenfraclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
+	if !config.DrainTimeout.IsNull() && !config.DrainTimeout.IsUnknown() && config.DrainTimeout.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("drain_timeout_seconds"), "Invalid Drain Timeout",
+			"drain_timeout_seconds must be at least 1.")
+	}
+
+	// The API treats an empty list as no restriction, so [] would open the pool to
+	// every space rather than close it to all of them.
+	if !config.AllowedSpaceIDs.IsNull() && !config.AllowedSpaceIDs.IsUnknown() && len(config.AllowedSpaceIDs.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("allowed_space_ids"), "Empty Allowed Spaces",
+			"allowed_space_ids cannot be empty: the API treats an empty list as no restriction. "+
+				"Remove the attribute to let every space use the pool, or set active = false to stop scheduling runs on it.")
+	}
+
+	if v := config.RunnerVersionConstraint; !v.IsNull() && !v.IsUnknown() {
+		if _, err := runnerversion.Parse(v.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("runner_version_constraint"), "Invalid Runner Version Constraint", err.Error())
+		}
+	}
+
+	if config.MaintenanceWindows.IsNull() || config.MaintenanceWindows.IsUnknown() {
+		return
+	}
+
+	var models []MaintenanceWindowModel
+	resp.Diagnostics.Append(config.MaintenanceWindows.ElementsAs(ctx, &models, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Overlaps are only checked once every window is known and valid.
+	windows := make([]*maintenanceWindow, len(models))
+	complete := true
+	for i, m := range models {
+		if m.Cron.IsUnknown() || m.DurationMinutes.IsUnknown() || m.Timezone.IsUnknown() {
+			complete = false
+			continue
+		}
+		if _, err := cronexpr.Parse(m.Cron.ValueString()); err != nil {
+			// Reported against the cron attribute by its validator.
+			complete = false
+			continue
+		}
+		w, err := parseMaintenanceWindow(m.Cron.ValueString(), m.DurationMinutes.ValueInt64(), m.Timezone.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("maintenance_windows").AtListIndex(i), "Invalid Maintenance Window", err.Error())
+			complete = false
+			continue
+		}
+		windows[i] = w
+	}
+	if !complete {
 		return
 	}
 
-	r.client = client
+	if overlap := findWindowOverlap(windows); overlap != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("maintenance_windows").AtListIndex(overlap.second), "Overlapping Maintenance Windows",
+			fmt.Sprintf("Maintenance windows %d and %d are both open at %s. Merge them into one window or move one of them.",
+				overlap.first, overlap.second, overlap.at.Format(time.RFC3339)))
+	}
+}
+
+// ModifyPlan checks a new or changed runner_version_constraint against the runner version
+// catalog, so a pin no runner satisfies fails the plan rather than the apply.
+func (r *WorkerPoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_worker_pool", "worker_pool", req, resp)
+
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var planned, prior types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("runner_version_constraint"), &planned)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("runner_version_constraint"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() || planned.IsNull() || planned.IsUnknown() || planned.Equal(prior) {
+		return
+	}
+
+	var organizationID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("organization_id"), &organizationID)...)
+	ctx = zenfraclient.WithOrganization(ctx, organizationID.ValueString())
+	resp.Diagnostics.Append(runnerversion.Check(ctx, r.client, path.Root("runner_version_constraint"), planned.ValueString())...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *WorkerPoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_worker_pool")
+	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -137,9 +294,21 @@ func (r *WorkerPoolResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	windows, diags := maintenanceWindowsFromList(ctx, plan.MaintenanceWindows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build the create request
 	createReq := zenfraclient.CreateWorkerPoolRequest{
-		Name: plan.Name.ValueString(),
+		Name:               plan.Name.ValueString(),
+		AllowedSpaceIDs:    allowedSpaceIDsFromSet(plan.AllowedSpaceIDs),
+		MaintenanceWindows: windows,
+
+		RunnerVersionConstraint: plan.RunnerVersionConstraint.ValueString(),
 	}
 
 	// Create the worker pool
@@ -152,12 +321,28 @@ func (r *WorkerPoolResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	pool := &createResp.Pool
+	if plan.Drain.ValueBool() {
+		pool, err = r.client.DrainWorkerPool(ctx, pool.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Draining Worker Pool",
+				fmt.Sprintf("Worker pool %s was created but could not be drained: %s", createResp.Pool.ID, err.Error()),
+			)
+			return
+		}
+	}
+
 	// Map response to state
-	state := mapPoolToState(&createResp.Pool)
+	state := mapPoolToState(pool)
+	state.DrainTimeout = plan.DrainTimeout
+	state.AllowedSpaceIDs = allowedSpaceIDsValue(pool.AllowedSpaceIDs, plan.AllowedSpaceIDs)
+	state.MaintenanceWindows = maintenanceWindowsValue(pool.MaintenanceWindows, plan.MaintenanceWindows)
 
 	// CRITICAL: Set the api_key from the create response - this is the ONLY time it's available
 	state.APIKey = types.StringValue(createResp.APIKey)
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -171,14 +356,25 @@ func (r *WorkerPoolResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	// Get the worker pool from the API
-	pool, err := r.client.GetWorkerPool(ctx, state.ID.ValueString())
+	pool, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.WorkerPool, error) {
+		return r.client.GetWorkerPoolCached(ctx, state.ID.ValueString())
+	})
 	if err != nil {
-		if zenfraclient.IsNotFound(err) {
+		if r.client.IsNotFoundOnRead(err) {
 			// Worker pool no longer exists, remove from state
 			resp.State.RemoveResource(ctx)
 			return
 		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError(
+				"Error Reading Worker Pool",
+				fmt.Sprintf("Could not read worker pool ID %s: %s\n\n%s", state.ID.ValueString(), err.Error(), zenfraclient.ForbiddenReadHint),
+			)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading Worker Pool",
 			fmt.Sprintf("Could not read worker pool ID %s: %s", state.ID.ValueString(), err.Error()),
@@ -188,6 +384,9 @@ func (r *WorkerPoolResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Map response to new state
 	newState := mapPoolToState(pool)
+	newState.AllowedSpaceIDs = allowedSpaceIDsValue(pool.AllowedSpaceIDs, state.AllowedSpaceIDs)
+	newState.MaintenanceWindows = maintenanceWindowsValue(pool.MaintenanceWindows, state.MaintenanceWindows)
+	newState.DrainTimeout = state.DrainTimeout
 
 	// CRITICAL: Preserve api_key from prior state since it's not returned by Read
 	var existingAPIKey types.String
@@ -213,6 +412,8 @@ func (r *WorkerPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
 	// Build the update request with only changed fields
 	updateReq := zenfraclient.UpdateWorkerPoolRequest{}
 
@@ -226,6 +427,31 @@ func (r *WorkerPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		updateReq.Active = &v
 	}
 
+	if !plan.AllowedSpaceIDs.Equal(state.AllowedSpaceIDs) {
+		ids := allowedSpaceIDsFromSet(plan.AllowedSpaceIDs)
+		if ids == nil {
+			ids = []string{}
+		}
+		updateReq.AllowedSpaceIDs = &ids
+	}
+
+	if !plan.MaintenanceWindows.Equal(state.MaintenanceWindows) {
+		windows, diags := maintenanceWindowsFromList(ctx, plan.MaintenanceWindows)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if windows == nil {
+			windows = []zenfraclient.MaintenanceWindow{}
+		}
+		updateReq.MaintenanceWindows = &windows
+	}
+
+	if !plan.RunnerVersionConstraint.Equal(state.RunnerVersionConstraint) {
+		constraint := plan.RunnerVersionConstraint.ValueString()
+		updateReq.RunnerVersionConstraint = &constraint
+	}
+
 	// Update the worker pool
 	pool, err := r.client.UpdateWorkerPool(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
@@ -236,12 +462,32 @@ func (r *WorkerPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	// An unknown drain means it is not configured; leave the pool as it is.
+	if !plan.Drain.IsUnknown() && !plan.Drain.IsNull() && plan.Drain.ValueBool() != pool.Draining {
+		action, call := "drain", r.client.DrainWorkerPool
+		if !plan.Drain.ValueBool() {
+			action, call = "resume", r.client.ResumeWorkerPool
+		}
+		pool, err = call(ctx, state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Worker Pool",
+				fmt.Sprintf("Could not %s worker pool ID %s: %s", action, state.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
 	// Map response to new state
 	newState := mapPoolToState(pool)
+	newState.AllowedSpaceIDs = allowedSpaceIDsValue(pool.AllowedSpaceIDs, plan.AllowedSpaceIDs)
+	newState.MaintenanceWindows = maintenanceWindowsValue(pool.MaintenanceWindows, plan.MaintenanceWindows)
+	newState.DrainTimeout = plan.DrainTimeout
 
 	// CRITICAL: Preserve api_key from prior state
 	newState.APIKey = state.APIKey
 
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
 	diags = resp.State.Set(ctx, newState)
 	resp.Diagnostics.Append(diags...)
 }
@@ -255,6 +501,27 @@ func (r *WorkerPoolResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	// A draining pool is being decommissioned: let its in-flight runs finish first.
+	if state.Drain.ValueBool() {
+		timeout := defaultDrainTimeout
+		if !state.DrainTimeout.IsNull() {
+			timeout = time.Duration(state.DrainTimeout.ValueInt64()) * time.Second
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, timeout)
+		_, err := r.client.WaitForWorkerPoolDrained(waitCtx, state.ID.ValueString(), drainPollInterval)
+		cancel()
+		if err != nil && !zenfraclient.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Worker Pool",
+				fmt.Sprintf("Worker pool ID %s did not finish draining, so it was not deleted: %s\n\n"+
+					"Wait for its runs to finish and destroy it again, or raise drain_timeout_seconds.", state.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+	}
+
 	// Delete the worker pool
 	err := r.client.DeleteWorkerPool(ctx, state.ID.ValueString())
 	if err != nil {
@@ -272,7 +539,6 @@ func (r *WorkerPoolResource) Delete(ctx context.Context, req resource.DeleteRequ
 
 // ImportState imports the resource into Terraform state.
 func (r *WorkerPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Use the ID from the import to set the state
 	// Note: api_key will be unknown/null after import since it's only available at creation
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importguard.PassthroughOrganizationID(ctx, r.client, "worker pool", importguard.WorkerPool(r.client), req, resp)
 }
@@ -191,6 +191,26 @@ func TestMapPoolToState_PreservesAPIKey(t *testing.T) {
 	}
 }
 
+func TestAllowedSpaceIDsValue(t *testing.T) {
+	empty := types.SetValueMust(types.StringType, nil)
+	null := types.SetNull(types.StringType)
+
+	if got := allowedSpaceIDsValue(nil, null); !got.IsNull() {
+		t.Errorf("expected null for unrestricted pool, got %s", got)
+	}
+	if got := allowedSpaceIDsValue(nil, empty); got.IsNull() || len(got.Elements()) != 0 {
+		t.Errorf("expected explicitly empty set to be kept, got %s", got)
+	}
+
+	got := allowedSpaceIDsValue([]string{"space-1", "space-2"}, null)
+	if ids := allowedSpaceIDsFromSet(got); len(ids) != 2 {
+		t.Errorf("expected 2 space IDs, got %v", ids)
+	}
+	if ids := allowedSpaceIDsFromSet(null); ids != nil {
+		t.Errorf("expected nil for null set, got %v", ids)
+	}
+}
+
 // Helper function to get pointer to string
 func strPtr(s string) *string {
 	return &s
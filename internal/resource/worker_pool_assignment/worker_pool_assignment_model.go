@@ -0,0 +1,32 @@
+// ABOUTME: Terraform state model for the zenfra_worker_pool_assignment resource.
+// ABOUTME: Keyed by space_id since a space has at most one default worker pool.
+package worker_pool_assignment
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// WorkerPoolAssignmentModel represents the Terraform state model for a space's default worker pool.
+type WorkerPoolAssignmentModel struct {
+	ID             types.String            `tfsdk:"id"`
+	SpaceID        types.String            `tfsdk:"space_id"`
+	WorkerPoolID   types.String            `tfsdk:"worker_pool_id"`
+	AllowOverride  types.Bool              `tfsdk:"allow_override"`
+	AssignedAt     timeutil.TimestampValue `tfsdk:"assigned_at"`
+	AssignedBy     types.String            `tfsdk:"assigned_by"`
+	OrganizationID types.String            `tfsdk:"organization_id"`
+}
+
+// mapAssignmentToState converts an API WorkerPoolAssignment to a WorkerPoolAssignmentModel.
+func mapAssignmentToState(assignment *zenfraclient.WorkerPoolAssignment) WorkerPoolAssignmentModel {
+	return WorkerPoolAssignmentModel{
+		ID:            types.StringValue(assignment.SpaceID),
+		SpaceID:       types.StringValue(assignment.SpaceID),
+		WorkerPoolID:  types.StringValue(assignment.WorkerPoolID),
+		AllowOverride: types.BoolValue(assignment.AllowOverride),
+		AssignedAt:    timeutil.Timestamp(assignment.AssignedAt),
+		AssignedBy:    types.StringValue(assignment.AssignedBy),
+	}
+}
@@ -0,0 +1,207 @@
+// ABOUTME: Implements the zenfra_worker_pool_assignment Terraform resource.
+// ABOUTME: Sets the default worker pool used by all stacks in a space unless they override it.
+package worker_pool_assignment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/zenfra/terraform-provider-zenfra/internal/importguard"
+	"github.com/zenfra/terraform-provider-zenfra/internal/permcheck"
+	"github.com/zenfra/terraform-provider-zenfra/internal/providerdata"
+	"github.com/zenfra/terraform-provider-zenfra/internal/readgrace"
+	"github.com/zenfra/terraform-provider-zenfra/internal/timeutil"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+var (
+	_ resource.Resource                = &WorkerPoolAssignmentResource{}
+	_ resource.ResourceWithImportState = &WorkerPoolAssignmentResource{}
+	_ resource.ResourceWithModifyPlan  = &WorkerPoolAssignmentResource{}
+)
+
+// NewWorkerPoolAssignmentResource is a constructor for the worker pool assignment resource.
+func NewWorkerPoolAssignmentResource() resource.Resource {
+	return &WorkerPoolAssignmentResource{}
+}
+
+// WorkerPoolAssignmentResource is the resource implementation.
+type WorkerPoolAssignmentResource struct {
+	client zenfraclient.WorkerPoolAssignmentAPI
+}
+
+func (r *WorkerPoolAssignmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_worker_pool_assignment"
+}
+
+func (r *WorkerPoolAssignmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Assigns a default worker pool to a space. Stacks in the space run on this pool unless they set their own worker_pool_id.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the assignment (same as space_id).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Description: "The space to assign the default worker pool to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "The organization ID of the space. Defaults to the organization of the provider's API token; set it to the " +
+					"space's organization_id when the space belongs to another organization the token has access to. Changing it forces " +
+					"a new resource.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"worker_pool_id": schema.StringAttribute{
+				Description: "The worker pool used by default for stacks in the space.",
+				Required:    true,
+			},
+			"allow_override": schema.BoolAttribute{
+				Description: "Whether stacks in the space may set their own worker_pool_id. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"assigned_at": schema.StringAttribute{
+				Description: "Timestamp when the worker pool was last assigned.",
+				CustomType:  timeutil.TimestampType{},
+				Computed:    true,
+			},
+			"assigned_by": schema.StringAttribute{
+				Description: "User who last assigned the worker pool.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan warns when the API token may not manage worker pools.
+func (r *WorkerPoolAssignmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	permcheck.Check(ctx, r.client, "zenfra_worker_pool_assignment", "worker_pool", req, resp)
+}
+
+func (r *WorkerPoolAssignmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if data := providerdata.FromResource(req, resp); data != nil {
+		r.client = data.Client.ForResource("zenfra_worker_pool_assignment")
+	}
+}
+
+func (r *WorkerPoolAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan WorkerPoolAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	assignment, err := r.client.SetWorkerPoolAssignment(ctx, plan.SpaceID.ValueString(), zenfraclient.SetWorkerPoolAssignmentRequest{
+		WorkerPoolID:  plan.WorkerPoolID.ValueString(),
+		AllowOverride: plan.AllowOverride.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Assigning Worker Pool",
+			fmt.Sprintf("Could not assign worker pool %s to space %s: %s", plan.WorkerPoolID.ValueString(), plan.SpaceID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapAssignmentToState(assignment)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *WorkerPoolAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state WorkerPoolAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	assignment, err := readgrace.Get(ctx, req.Private, func(ctx context.Context) (*zenfraclient.WorkerPoolAssignment, error) {
+		return r.client.GetWorkerPoolAssignment(ctx, state.SpaceID.ValueString())
+	})
+	if err != nil {
+		if r.client.IsNotFoundOnRead(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if zenfraclient.IsForbidden(err) {
+			resp.Diagnostics.AddError("Error Reading Worker Pool Assignment",
+				fmt.Sprintf("Could not read worker pool assignment for space %s: %s\n\n%s", state.SpaceID.ValueString(), err, zenfraclient.ForbiddenReadHint))
+			return
+		}
+		resp.Diagnostics.AddError("Error Reading Worker Pool Assignment",
+			fmt.Sprintf("Could not read worker pool assignment for space %s: %s", state.SpaceID.ValueString(), err))
+		return
+	}
+
+	newState := mapAssignmentToState(assignment)
+	newState.OrganizationID = state.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *WorkerPoolAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan WorkerPoolAssignmentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, plan.OrganizationID.ValueString())
+
+	assignment, err := r.client.SetWorkerPoolAssignment(ctx, plan.SpaceID.ValueString(), zenfraclient.SetWorkerPoolAssignmentRequest{
+		WorkerPoolID:  plan.WorkerPoolID.ValueString(),
+		AllowOverride: plan.AllowOverride.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error Updating Worker Pool Assignment",
+			fmt.Sprintf("Could not update worker pool assignment for space %s: %s", plan.SpaceID.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(readgrace.MarkWritten(ctx, resp.Private)...)
+	newState := mapAssignmentToState(assignment)
+	newState.OrganizationID = plan.OrganizationID
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+}
+
+func (r *WorkerPoolAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state WorkerPoolAssignmentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = zenfraclient.WithOrganization(ctx, state.OrganizationID.ValueString())
+
+	err := r.client.DeleteWorkerPoolAssignment(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		if zenfraclient.IsNotFound(err) {
+			return
+		}
+		resp.Diagnostics.AddError("Error Removing Worker Pool Assignment",
+			fmt.Sprintf("Could not remove worker pool assignment from space %s: %s", state.SpaceID.ValueString(), err))
+	}
+}
+
+func (r *WorkerPoolAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importguard.PassthroughOrganizationAttribute(ctx, r.client, "space", path.Root("space_id"), importguard.Space(r.client), req, resp)
+}
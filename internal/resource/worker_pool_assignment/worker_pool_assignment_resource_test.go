@@ -0,0 +1,41 @@
+// ABOUTME: Unit tests for the zenfra_worker_pool_assignment resource model mapping.
+// ABOUTME: Verifies the space-keyed ID and field conversion from the API type.
+package worker_pool_assignment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestMapAssignmentToState(t *testing.T) {
+	assignedAt := time.Date(2026, 3, 4, 9, 30, 0, 0, time.UTC)
+
+	model := mapAssignmentToState(&zenfraclient.WorkerPoolAssignment{
+		SpaceID:       "space-123",
+		WorkerPoolID:  "pool-456",
+		AllowOverride: false,
+		AssignedAt:    assignedAt,
+		AssignedBy:    "user-789",
+	})
+
+	if model.ID.ValueString() != "space-123" {
+		t.Errorf("expected ID space-123, got %s", model.ID.ValueString())
+	}
+	if model.SpaceID.ValueString() != "space-123" {
+		t.Errorf("expected SpaceID space-123, got %s", model.SpaceID.ValueString())
+	}
+	if model.WorkerPoolID.ValueString() != "pool-456" {
+		t.Errorf("expected WorkerPoolID pool-456, got %s", model.WorkerPoolID.ValueString())
+	}
+	if model.AllowOverride.ValueBool() {
+		t.Error("expected AllowOverride false")
+	}
+	if model.AssignedAt.ValueString() != "2026-03-04T09:30:00Z" {
+		t.Errorf("expected AssignedAt 2026-03-04T09:30:00Z, got %s", model.AssignedAt.ValueString())
+	}
+	if model.AssignedBy.ValueString() != "user-789" {
+		t.Errorf("expected AssignedBy user-789, got %s", model.AssignedBy.ValueString())
+	}
+}
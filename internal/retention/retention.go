@@ -0,0 +1,85 @@
+// ABOUTME: Validation of run_retention_days and log_retention_days shared by zenfra_stack and zenfra_retention_settings.
+// ABOUTME: Checks the periods at plan time against the retention limits of the organization's plan.
+
+// Package retention validates run and log retention periods. Each plan allows runs and
+// logs to be kept for a limited number of days, reported in the organization's billing
+// details. The API rejects longer periods on apply; checking them at plan time reports
+// the limit before any other change of the apply has been made.
+package retention
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Attribute names of the retention periods.
+const (
+	RunAttribute = "run_retention_days"
+	LogAttribute = "log_retention_days"
+)
+
+// ValidateConfig reports configured retention periods shorter than a day.
+func ValidateConfig(runDays, logDays types.Int64, diags *diag.Diagnostics) {
+	for name, v := range map[string]types.Int64{RunAttribute: runDays, LogAttribute: logDays} {
+		if !v.IsNull() && !v.IsUnknown() && v.ValueInt64() < 1 {
+			diags.AddAttributeError(path.Root(name), "Invalid Retention Period",
+				fmt.Sprintf("%s must be at least 1, got %d.", name, v.ValueInt64()))
+		}
+	}
+}
+
+// CheckPlanLimits adds an error to resp for every retention period the plan sets or
+// changes beyond the limit of the organization's plan. Unchanged periods are not
+// checked, so a plan that lowered its limits does not block unrelated changes. When the
+// organization cannot be read the plan is left alone and the API enforces the limits.
+func CheckPlanLimits(ctx context.Context, client zenfraclient.OrganizationAPI, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	changed := map[string]int64{}
+	for _, name := range []string{RunAttribute, LogAttribute} {
+		var planned, prior types.Int64
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &planned)...)
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &prior)...)
+		}
+		if planned.IsNull() || planned.IsUnknown() || planned.Equal(prior) {
+			continue
+		}
+		changed[name] = planned.ValueInt64()
+	}
+	if resp.Diagnostics.HasError() || len(changed) == 0 {
+		return
+	}
+
+	org, err := client.GetCurrentOrganization(ctx)
+	if err != nil || org.Billing == nil {
+		return
+	}
+	limits := map[string]int64{
+		RunAttribute: int64(org.Billing.MaxRunRetentionDays),
+		LogAttribute: int64(org.Billing.MaxLogRetentionDays),
+	}
+	for _, name := range []string{RunAttribute, LogAttribute} {
+		days, ok := changed[name]
+		if limit := limits[name]; ok && limit > 0 && days > limit {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Retention Exceeds Plan Limit",
+				fmt.Sprintf("%s is %d, but the organization's %s plan allows at most %d days. "+
+					"Lower the retention period or upgrade the plan.", name, days, planName(org.Billing.Plan), limit))
+		}
+	}
+}
+
+func planName(plan string) string {
+	if plan == "" {
+		return "current"
+	}
+	return plan
+}
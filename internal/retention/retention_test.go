@@ -0,0 +1,120 @@
+// ABOUTME: Unit tests for the retention period validation and plan limit checks.
+// ABOUTME: Uses a two-attribute schema and a stub organization source; no test talks to the API.
+package retention
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+type organizationFunc func(ctx context.Context) (*zenfraclient.Organization, error)
+
+func (f organizationFunc) GetCurrentOrganization(ctx context.Context) (*zenfraclient.Organization, error) {
+	return f(ctx)
+}
+
+func TestValidateConfig(t *testing.T) {
+	var diags diag.Diagnostics
+	ValidateConfig(types.Int64Value(30), types.Int64Null(), &diags)
+	ValidateConfig(types.Int64Unknown(), types.Int64Value(1), &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	ValidateConfig(types.Int64Value(0), types.Int64Value(-5), &diags)
+	if diags.ErrorsCount() != 2 || diags.Errors()[0].Summary() != "Invalid Retention Period" {
+		t.Errorf("expected two Invalid Retention Period errors, got %v", diags)
+	}
+}
+
+func TestCheckPlanLimits(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{Attributes: map[string]schema.Attribute{
+		RunAttribute: schema.Int64Attribute{Optional: true},
+		LogAttribute: schema.Int64Attribute{Optional: true},
+	}}
+	objType := s.Type().TerraformType(ctx)
+	days := func(n int64) *int64 { return &n }
+	value := func(run, log *int64, exists bool) tftypes.Value {
+		if !exists {
+			return tftypes.NewValue(objType, nil)
+		}
+		attr := func(v *int64) tftypes.Value {
+			if v == nil {
+				return tftypes.NewValue(tftypes.Number, nil)
+			}
+			return tftypes.NewValue(tftypes.Number, *v)
+		}
+		return tftypes.NewValue(objType, map[string]tftypes.Value{RunAttribute: attr(run), LogAttribute: attr(log)})
+	}
+
+	calls := 0
+	limited := organizationFunc(func(context.Context) (*zenfraclient.Organization, error) {
+		calls++
+		return &zenfraclient.Organization{Billing: &zenfraclient.OrganizationBilling{Plan: "team", MaxRunRetentionDays: 90, MaxLogRetentionDays: 30}}, nil
+	})
+	unlimited := organizationFunc(func(context.Context) (*zenfraclient.Organization, error) {
+		return &zenfraclient.Organization{Billing: &zenfraclient.OrganizationBilling{Plan: "enterprise"}}, nil
+	})
+	unavailable := organizationFunc(func(context.Context) (*zenfraclient.Organization, error) {
+		return nil, errors.New("forbidden")
+	})
+
+	tests := []struct {
+		name      string
+		client    zenfraclient.OrganizationAPI
+		prior     tftypes.Value
+		plan      tftypes.Value
+		wantErrs  []string
+		wantCalls int
+	}{
+		{name: "within limits", client: limited, prior: value(nil, nil, false), plan: value(days(90), days(30), true), wantCalls: 1},
+		{name: "run retention above limit", client: limited, prior: value(nil, nil, false), plan: value(days(365), nil, true),
+			wantErrs: []string{RunAttribute}, wantCalls: 1},
+		{name: "both above limit", client: limited, prior: value(days(30), days(7), true), plan: value(days(91), days(31), true),
+			wantErrs: []string{RunAttribute, LogAttribute}, wantCalls: 1},
+		{name: "unchanged period above limit", client: limited, prior: value(days(365), days(7), true), plan: value(days(365), days(14), true), wantCalls: 1},
+		{name: "nothing set", client: limited, prior: value(nil, nil, false), plan: value(nil, nil, true)},
+		{name: "destroy", client: limited, prior: value(days(365), nil, true), plan: value(nil, nil, false)},
+		{name: "no plan limit", client: unlimited, prior: value(nil, nil, false), plan: value(days(3650), days(3650), true)},
+		{name: "organization unavailable", client: unavailable, prior: value(nil, nil, false), plan: value(days(3650), nil, true)},
+		{name: "unconfigured", client: nil, prior: value(nil, nil, false), plan: value(days(3650), nil, true)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = 0
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: tt.plan},
+				State: tfsdk.State{Schema: s, Raw: tt.prior},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			CheckPlanLimits(ctx, tt.client, req, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != len(tt.wantErrs) || len(resp.Diagnostics) != len(errs) {
+				t.Fatalf("expected errors on %v, got %v", tt.wantErrs, resp.Diagnostics)
+			}
+			for i, want := range tt.wantErrs {
+				if errs[i].Summary() != "Retention Exceeds Plan Limit" {
+					t.Errorf("unexpected summary %q", errs[i].Summary())
+				}
+				if d, ok := errs[i].(diag.DiagnosticWithPath); !ok || !d.Path().Equal(path.Root(want)) {
+					t.Errorf("expected error %d on %s, got %v", i, want, errs[i])
+				}
+			}
+			if tt.client != nil && calls != tt.wantCalls {
+				t.Errorf("expected %d organization reads, got %d", tt.wantCalls, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+// ABOUTME: Parses runner version constraints such as "~> 1.4" and checks them against the runner catalog.
+// ABOUTME: Shared by zenfra_worker_pool and zenfra_runner_version_constraint so both accept the same syntax.
+
+// Package runnerversion implements the version constraint syntax of runner pins. A
+// constraint is a comma-separated list of clauses that must all hold, each an operator
+// followed by a version: =, !=, >, >=, <, <=, or ~>. The pessimistic operator ~> allows
+// only the rightmost given component to increase, so "~> 1.4" allows 1.4.0 up to but
+// excluding 2.0.0, and "~> 1.4.2" allows 1.4.2 up to but excluding 1.5.0. A version
+// without an operator means =. Pre-releases only match clauses that name a pre-release
+// of the same version, so "~> 1.4" never selects 1.5.0-rc1.
+package runnerversion
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Constraint is a parsed version constraint.
+type Constraint []clause
+
+type clause struct {
+	op      string
+	version version
+}
+
+type version struct {
+	parts []int // major, minor, patch as given; missing components compare as 0
+	pre   string
+}
+
+// operators are tried longest first so ">=" is not read as ">".
+var operators = []string{"~>", ">=", "<=", "!=", "=", ">", "<"}
+
+// Parse parses a constraint such as ">= 1.2, < 2".
+func Parse(s string) (Constraint, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, fmt.Errorf("constraint is empty")
+	}
+	var c Constraint
+	for _, raw := range strings.Split(s, ",") {
+		raw = strings.TrimSpace(raw)
+		op := "="
+		for _, candidate := range operators {
+			if strings.HasPrefix(raw, candidate) {
+				op = candidate
+				raw = strings.TrimSpace(raw[len(candidate):])
+				break
+			}
+		}
+		v, err := parseVersion(raw)
+		if err != nil {
+			return nil, err
+		}
+		c = append(c, clause{op: op, version: v})
+	}
+	return c, nil
+}
+
+func parseVersion(s string) (version, error) {
+	core, pre, _ := strings.Cut(strings.TrimPrefix(s, "v"), "-")
+	if core == "" {
+		return version{}, fmt.Errorf("missing version in %q", s)
+	}
+	fields := strings.Split(core, ".")
+	if len(fields) > 3 {
+		return version{}, fmt.Errorf("version %q has more than three components", s)
+	}
+	v := version{pre: pre}
+	for _, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 0 {
+			return version{}, fmt.Errorf("version %q is not of the form major.minor.patch", s)
+		}
+		v.parts = append(v.parts, n)
+	}
+	return v, nil
+}
+
+// part returns component i, or 0 if it was not given.
+func (v version) part(i int) int {
+	if i < len(v.parts) {
+		return v.parts[i]
+	}
+	return 0
+}
+
+// compare orders versions; a release is newer than its pre-releases.
+func (v version) compare(o version) int {
+	for i := range 3 {
+		if d := v.part(i) - o.part(i); d != 0 {
+			return d
+		}
+	}
+	switch {
+	case v.pre == o.pre:
+		return 0
+	case v.pre == "":
+		return 1
+	case o.pre == "":
+		return -1
+	}
+	return strings.Compare(v.pre, o.pre)
+}
+
+func (v version) sameCore(o version) bool {
+	return v.part(0) == o.part(0) && v.part(1) == o.part(1) && v.part(2) == o.part(2)
+}
+
+// Allows reports whether version satisfies every clause of c. Unparseable versions are
+// never allowed.
+func (c Constraint) Allows(s string) bool {
+	v, err := parseVersion(s)
+	if err != nil {
+		return false
+	}
+	if v.pre != "" {
+		named := false
+		for _, cl := range c {
+			named = named || (cl.version.pre != "" && cl.version.sameCore(v))
+		}
+		if !named {
+			return false
+		}
+	}
+	for _, cl := range c {
+		if !cl.allows(v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (cl clause) allows(v version) bool {
+	cmp := v.compare(cl.version)
+	switch cl.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+
+	// ~>: at least the given version, below the next increment of the second to last
+	// given component. A lone major version allows any later minor and patch.
+	if cmp < 0 {
+		return false
+	}
+	fixed := len(cl.version.parts) - 1
+	if fixed == 0 {
+		fixed = 1
+	}
+	for i := range fixed {
+		if v.part(i) != cl.version.part(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// Catalog lists the runner versions constraints are checked against.
+type Catalog interface {
+	ListRunnerVersions(ctx context.Context) ([]zenfraclient.RunnerVersion, error)
+}
+
+// Check verifies that constraint selects at least one version of the runner catalog,
+// reporting problems on the attribute at p. Only matching deprecated versions is a
+// warning; so is a catalog that cannot be read, since the API checks the constraint
+// again on apply.
+func Check(ctx context.Context, catalog Catalog, p path.Path, constraint string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	c, err := Parse(constraint)
+	if err != nil {
+		diags.AddAttributeError(p, "Invalid Runner Version Constraint", err.Error())
+		return diags
+	}
+
+	versions, err := catalog.ListRunnerVersions(ctx)
+	if err != nil {
+		diags.AddAttributeWarning(p, "Unable to Check Runner Version Constraint",
+			fmt.Sprintf("Could not read the runner version catalog to check %q: %s", constraint, err))
+		return diags
+	}
+
+	var deprecated, available []string
+	for _, v := range versions {
+		if !v.Deprecated {
+			available = append(available, v.Version)
+		}
+		if !c.Allows(v.Version) {
+			continue
+		}
+		if !v.Deprecated {
+			return diags
+		}
+		deprecated = append(deprecated, v.Version)
+	}
+
+	if len(deprecated) > 0 {
+		diags.AddAttributeWarning(p, "Deprecated Runner Version",
+			fmt.Sprintf("%q only matches deprecated runner versions (%s). Move to a supported version: %s.",
+				constraint, strings.Join(deprecated, ", "), strings.Join(available, ", ")))
+		return diags
+	}
+	diags.AddAttributeError(p, "No Matching Runner Version",
+		fmt.Sprintf("No runner version matches %q. Available versions: %s.", constraint, strings.Join(available, ", ")))
+	return diags
+}
@@ -0,0 +1,100 @@
+// ABOUTME: Unit tests for runner version constraint parsing, matching, and the catalog check.
+// ABOUTME: The catalog is a function stub; no test talks to the API.
+package runnerversion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestAllows(t *testing.T) {
+	tests := []struct {
+		constraint string
+		allowed    []string
+		rejected   []string
+	}{
+		{constraint: "1.4.2", allowed: []string{"1.4.2", "v1.4.2"}, rejected: []string{"1.4.3"}},
+		{constraint: "~> 1.4", allowed: []string{"1.4.0", "1.9.3"}, rejected: []string{"1.3.9", "2.0.0", "1.5.0-rc1"}},
+		{constraint: "~> 1.4.2", allowed: []string{"1.4.2", "1.4.9"}, rejected: []string{"1.4.1", "1.5.0"}},
+		{constraint: "~> 1", allowed: []string{"1.0.0", "1.12.0"}, rejected: []string{"2.0.0"}},
+		{constraint: ">= 1.2, < 2, != 1.3.0", allowed: []string{"1.2.0", "1.9.9"}, rejected: []string{"1.1.9", "1.3.0", "2.0.0"}},
+		{constraint: ">= 1.5.0-rc1", allowed: []string{"1.5.0-rc1", "1.5.0-rc2", "1.5.0"}, rejected: []string{"1.6.0-beta"}},
+	}
+
+	for _, tt := range tests {
+		c, err := Parse(tt.constraint)
+		if err != nil {
+			t.Fatalf("Parse(%q): %v", tt.constraint, err)
+		}
+		for _, v := range tt.allowed {
+			if !c.Allows(v) {
+				t.Errorf("%q should allow %s", tt.constraint, v)
+			}
+		}
+		for _, v := range tt.rejected {
+			if c.Allows(v) {
+				t.Errorf("%q should not allow %s", tt.constraint, v)
+			}
+		}
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, s := range []string{"", " ", ">=", "~> latest", "1.2.3.4", ">= 1,", "1.-2"} {
+		if _, err := Parse(s); err == nil {
+			t.Errorf("Parse(%q) succeeded, expected an error", s)
+		}
+	}
+}
+
+type catalogFunc func(ctx context.Context) ([]zenfraclient.RunnerVersion, error)
+
+func (f catalogFunc) ListRunnerVersions(ctx context.Context) ([]zenfraclient.RunnerVersion, error) {
+	return f(ctx)
+}
+
+func TestCheck(t *testing.T) {
+	catalog := catalogFunc(func(context.Context) ([]zenfraclient.RunnerVersion, error) {
+		return []zenfraclient.RunnerVersion{
+			{Version: "1.3.0", Deprecated: true},
+			{Version: "1.4.0"},
+			{Version: "1.4.1"},
+		}, nil
+	})
+	p := path.Root("runner_version_constraint")
+
+	tests := []struct {
+		constraint   string
+		wantError    string
+		wantWarning  string
+		catalogError bool
+	}{
+		{constraint: "~> 1.4"},
+		{constraint: "~> 1.3.0", wantWarning: "Deprecated Runner Version"},
+		{constraint: "~> 2.0", wantError: "No Matching Runner Version"},
+		{constraint: "latest", wantError: "Invalid Runner Version Constraint"},
+		{constraint: "~> 2.0", wantWarning: "Unable to Check Runner Version Constraint", catalogError: true},
+	}
+	for _, tt := range tests {
+		var c Catalog = catalog
+		if tt.catalogError {
+			c = catalogFunc(func(context.Context) ([]zenfraclient.RunnerVersion, error) { return nil, errors.New("boom") })
+		}
+		diags := Check(context.Background(), c, p, tt.constraint)
+
+		var gotError, gotWarning string
+		if errs := diags.Errors(); len(errs) > 0 {
+			gotError = errs[0].Summary()
+		}
+		if warnings := diags.Warnings(); len(warnings) > 0 {
+			gotWarning = warnings[0].Summary()
+		}
+		if gotError != tt.wantError || gotWarning != tt.wantWarning {
+			t.Errorf("Check(%q): got error %q and warning %q, want %q and %q", tt.constraint, gotError, gotWarning, tt.wantError, tt.wantWarning)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// ABOUTME: Import ID formats accepted by each importable resource, for the schema catalog.
+// ABOUTME: Kept in step with the resources' ImportState methods and examples/resources/*/import.sh by the package tests.
+
+package schemaexport
+
+// importIDFormats lists, per resource type, the import IDs its ImportState accepts.
+// Resources with an organization_id attribute also accept their ID prefixed with
+// organization_id/.
+var importIDFormats = map[string][]string{
+	"zenfra_api_token":                         {"token_id", "organization_id/token_id"},
+	"zenfra_bundle":                            {"bundle_id", "organization_id/bundle_id"},
+	"zenfra_bundle_attachment":                 {"stack_id:bundle_id", "organization_id/stack_id:bundle_id"},
+	"zenfra_bundle_secret_reference":           {"bundle_id:reference_id", "organization_id/bundle_id:reference_id"},
+	"zenfra_configuration_bundle":              {"bundle_id", "organization_id/bundle_id"},
+	"zenfra_environment_variable_set":          {"variable_set_id", "organization_id/variable_set_id"},
+	"zenfra_environment_variable_set_scope":    {"variable_set_id:scope_id", "organization_id/variable_set_id:scope_id"},
+	"zenfra_environment_variable_set_variable": {"variable_set_id:key", "organization_id/variable_set_id:key"},
+	"zenfra_membership_invitation":             {"invitation_id", "organization_id/invitation_id"},
+	"zenfra_organization_domain":               {"domain_id", "organization_id/domain_id"},
+	"zenfra_organization_domain_verification":  {"domain_id", "organization_id/domain_id"},
+	"zenfra_output_subscription":               {"stack_id:subscription_id", "organization_id/stack_id:subscription_id"},
+	"zenfra_rate_limit_policy":                 {"policy_id", "organization_id/policy_id"},
+	"zenfra_retention_settings":                {"organization_id"},
+	"zenfra_run_comment":                       {"run_id:comment_id", "organization_id/run_id:comment_id"},
+	"zenfra_run_queue_settings":                {"organization_id"},
+	"zenfra_runner_version_constraint":         {"organization_id"},
+	"zenfra_secret_backend":                    {"secret_backend_id", "organization_id/secret_backend_id"},
+	"zenfra_signing_key":                       {"signing_key_id", "organization_id/signing_key_id"},
+	"zenfra_space":                             {"space_id", "organization_id/space_id"},
+	"zenfra_space_bundle_attachment":           {"space_id:bundle_id", "organization_id/space_id:bundle_id"},
+	"zenfra_space_variables":                   {"space_id", "organization_id/space_id"},
+	"zenfra_stack": {
+		"stack_id",
+		"organization_id/stack_id",
+		"stack_id?include=variables,bundles",
+		"organization_id/stack_id?include=variables,bundles",
+	},
+	"zenfra_stack_from_manifest":     {"stack_id", "organization_id/stack_id"},
+	"zenfra_stack_variables":         {"stack_id", "organization_id/stack_id"},
+	"zenfra_vcs_integration":         {"vcs_integration_id", "organization_id/vcs_integration_id"},
+	"zenfra_webhook_secret_rotation": {"webhook_endpoint_id", "organization_id/webhook_endpoint_id"},
+	"zenfra_worker_pool":             {"worker_pool_id", "organization_id/worker_pool_id"},
+	"zenfra_worker_pool_assignment":  {"space_id", "organization_id/space_id"},
+}
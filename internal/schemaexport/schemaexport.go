@@ -0,0 +1,385 @@
+// ABOUTME: Builds a machine-readable catalog of the provider's resource, data source, and provider schemas.
+// ABOUTME: Adds validator descriptions, replacement markers, and import ID formats to what terraform providers schema reports.
+
+// Package schemaexport describes the provider's schemas for catalog and policy tooling.
+// Unlike terraform providers schema -json, the catalog includes each attribute's
+// validators and plan modifiers, whether changing it replaces the resource, and the
+// import ID formats each resource accepts.
+package schemaexport
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// FormatVersion is the version of the catalog format. It changes only when a field
+// is removed or changes meaning; new fields may be added at any time.
+const FormatVersion = "1"
+
+// Catalog is the exported description of the provider.
+type Catalog struct {
+	FormatVersion   string              `json:"format_version"`
+	ProviderName    string              `json:"provider_name"`
+	ProviderVersion string              `json:"provider_version"`
+	Provider        Schema              `json:"provider"`
+	Resources       map[string]Resource `json:"resources"`
+	DataSources     map[string]Schema   `json:"data_sources"`
+}
+
+// Resource is the schema of a managed resource and how it is imported.
+type Resource struct {
+	Schema
+	SchemaVersion int64 `json:"schema_version"`
+	// Import is nil for resources that cannot be imported.
+	Import *Import `json:"import,omitempty"`
+}
+
+// Import lists the import ID formats a resource accepts, e.g. "stack_id:bundle_id".
+type Import struct {
+	IDFormats []string `json:"id_formats"`
+}
+
+// Schema is the top-level block of a provider, resource, or data source schema.
+type Schema struct {
+	Description        string               `json:"description,omitempty"`
+	DeprecationMessage string               `json:"deprecation_message,omitempty"`
+	Attributes         map[string]Attribute `json:"attributes,omitempty"`
+	Blocks             map[string]Block     `json:"blocks,omitempty"`
+}
+
+// Attribute describes one schema attribute. Type is the attribute's Terraform type in
+// the notation of terraform providers schema -json.
+type Attribute struct {
+	Type               any    `json:"type"`
+	Description        string `json:"description,omitempty"`
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+	Required           bool   `json:"required,omitempty"`
+	Optional           bool   `json:"optional,omitempty"`
+	Computed           bool   `json:"computed,omitempty"`
+	Sensitive          bool   `json:"sensitive,omitempty"`
+	WriteOnly          bool   `json:"write_only,omitempty"`
+	// RequiresReplace is set when changing the attribute destroys and recreates the
+	// resource. PlanModifiers describes any condition on the replacement.
+	RequiresReplace bool     `json:"requires_replace,omitempty"`
+	Validators      []string `json:"validators,omitempty"`
+	PlanModifiers   []string `json:"plan_modifiers,omitempty"`
+	// NestingMode and Attributes are set for nested attributes.
+	NestingMode string               `json:"nesting_mode,omitempty"`
+	Attributes  map[string]Attribute `json:"attributes,omitempty"`
+}
+
+// Block describes a nested block.
+type Block struct {
+	NestingMode        string               `json:"nesting_mode"`
+	Description        string               `json:"description,omitempty"`
+	DeprecationMessage string               `json:"deprecation_message,omitempty"`
+	RequiresReplace    bool                 `json:"requires_replace,omitempty"`
+	Validators         []string             `json:"validators,omitempty"`
+	PlanModifiers      []string             `json:"plan_modifiers,omitempty"`
+	Attributes         map[string]Attribute `json:"attributes,omitempty"`
+	Blocks             map[string]Block     `json:"blocks,omitempty"`
+}
+
+// Export describes the provider p, reporting version as its version. It fails if an
+// importable resource has no entry in importIDFormats.
+func Export(ctx context.Context, p provider.Provider, version string) (*Catalog, error) {
+	var meta provider.MetadataResponse
+	p.Metadata(ctx, provider.MetadataRequest{}, &meta)
+
+	var providerSchema provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &providerSchema)
+	if providerSchema.Diagnostics.HasError() {
+		return nil, fmt.Errorf("provider schema: %v", providerSchema.Diagnostics)
+	}
+
+	catalog := &Catalog{
+		FormatVersion:   FormatVersion,
+		ProviderName:    meta.TypeName,
+		ProviderVersion: version,
+		Provider: Schema{
+			Description:        description(providerSchema.Schema.Description, providerSchema.Schema.MarkdownDescription),
+			DeprecationMessage: providerSchema.Schema.DeprecationMessage,
+			Attributes:         attributes(ctx, providerSchema.Schema.Attributes),
+			Blocks:             blocks(ctx, providerSchema.Schema.Blocks),
+		},
+		Resources:   map[string]Resource{},
+		DataSources: map[string]Schema{},
+	}
+
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var md resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: meta.TypeName}, &md)
+		var sr resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &sr)
+		if sr.Diagnostics.HasError() {
+			return nil, fmt.Errorf("schema of %s: %v", md.TypeName, sr.Diagnostics)
+		}
+
+		res := Resource{
+			Schema: Schema{
+				Description:        description(sr.Schema.Description, sr.Schema.MarkdownDescription),
+				DeprecationMessage: sr.Schema.DeprecationMessage,
+				Attributes:         attributes(ctx, sr.Schema.Attributes),
+				Blocks:             blocks(ctx, sr.Schema.Blocks),
+			},
+			SchemaVersion: sr.Schema.Version,
+		}
+		if _, ok := r.(resource.ResourceWithImportState); ok {
+			formats, ok := importIDFormats[md.TypeName]
+			if !ok {
+				return nil, fmt.Errorf("%s is importable but has no import ID formats", md.TypeName)
+			}
+			res.Import = &Import{IDFormats: formats}
+		}
+		catalog.Resources[md.TypeName] = res
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		var md datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: meta.TypeName}, &md)
+		var sr datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &sr)
+		if sr.Diagnostics.HasError() {
+			return nil, fmt.Errorf("schema of %s: %v", md.TypeName, sr.Diagnostics)
+		}
+		catalog.DataSources[md.TypeName] = Schema{
+			Description:        description(sr.Schema.Description, sr.Schema.MarkdownDescription),
+			DeprecationMessage: sr.Schema.DeprecationMessage,
+			Attributes:         attributes(ctx, sr.Schema.Attributes),
+			Blocks:             blocks(ctx, sr.Schema.Blocks),
+		}
+	}
+
+	return catalog, nil
+}
+
+// attributes describes the attributes of a provider, resource, or data source schema,
+// all of which share the framework's attribute interface.
+func attributes[A schema.Attribute](ctx context.Context, attrs map[string]A) map[string]Attribute {
+	if len(attrs) == 0 {
+		return nil
+	}
+	out := make(map[string]Attribute, len(attrs))
+	for name, a := range attrs {
+		out[name] = attribute(ctx, a)
+	}
+	return out
+}
+
+func attribute(ctx context.Context, a schema.Attribute) Attribute {
+	modifiers := planModifiers(a)
+	out := Attribute{
+		Type:               terraformType(a.GetType().TerraformType(ctx)),
+		Description:        description(a.GetDescription(), a.GetMarkdownDescription()),
+		DeprecationMessage: a.GetDeprecationMessage(),
+		Required:           a.IsRequired(),
+		Optional:           a.IsOptional(),
+		Computed:           a.IsComputed(),
+		Sensitive:          a.IsSensitive(),
+		WriteOnly:          a.IsWriteOnly(),
+		RequiresReplace:    slices.ContainsFunc(modifiers, requiresReplace),
+		Validators:         describe(ctx, validators(a)),
+		PlanModifiers:      describe(ctx, modifiers),
+	}
+	if nested, ok := a.(schema.NestedAttribute); ok {
+		out.NestingMode = nestingMode(a.GetType())
+		out.Attributes = attributes(ctx, nested.GetNestedObject().GetAttributes())
+	}
+	return out
+}
+
+func blocks[B schema.Block](ctx context.Context, bs map[string]B) map[string]Block {
+	if len(bs) == 0 {
+		return nil
+	}
+	out := make(map[string]Block, len(bs))
+	for name, b := range bs {
+		modifiers := planModifiers(b)
+		object := b.GetNestedObject()
+		out[name] = Block{
+			NestingMode:        nestingMode(b.Type()),
+			Description:        description(b.GetDescription(), b.GetMarkdownDescription()),
+			DeprecationMessage: b.GetDeprecationMessage(),
+			RequiresReplace:    slices.ContainsFunc(modifiers, requiresReplace),
+			Validators:         describe(ctx, validators(b)),
+			PlanModifiers:      describe(ctx, modifiers),
+			Attributes:         attributes(ctx, object.GetAttributes()),
+			Blocks:             blocks(ctx, object.GetBlocks()),
+		}
+	}
+	return out
+}
+
+// description prefers the Markdown description, which most of the provider's schemas set.
+func description(plain, markdown string) string {
+	if markdown != "" {
+		return markdown
+	}
+	return plain
+}
+
+// terraformType renders t in the type notation of terraform providers schema -json,
+// e.g. "string" or ["list","string"].
+func terraformType(t tftypes.Type) any {
+	switch t := t.(type) {
+	case tftypes.List:
+		return []any{"list", terraformType(t.ElementType)}
+	case tftypes.Set:
+		return []any{"set", terraformType(t.ElementType)}
+	case tftypes.Map:
+		return []any{"map", terraformType(t.ElementType)}
+	case tftypes.Object:
+		attrs := make(map[string]any, len(t.AttributeTypes))
+		for name, at := range t.AttributeTypes {
+			attrs[name] = terraformType(at)
+		}
+		return []any{"object", attrs}
+	case tftypes.Tuple:
+		elems := make([]any, 0, len(t.ElementTypes))
+		for _, et := range t.ElementTypes {
+			elems = append(elems, terraformType(et))
+		}
+		return []any{"tuple", elems}
+	}
+	switch {
+	case t.Is(tftypes.String):
+		return "string"
+	case t.Is(tftypes.Number):
+		return "number"
+	case t.Is(tftypes.Bool):
+		return "bool"
+	default:
+		return "dynamic"
+	}
+}
+
+func nestingMode(t attr.Type) string {
+	switch t.(type) {
+	case basetypes.ListTypable:
+		return "list"
+	case basetypes.SetTypable:
+		return "set"
+	case basetypes.MapTypable:
+		return "map"
+	default:
+		return "single"
+	}
+}
+
+// describer is the Description method shared by validators and plan modifiers.
+type describer interface {
+	Description(ctx context.Context) string
+}
+
+func describe[D describer](ctx context.Context, items []D) []string {
+	if len(items) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		out = append(out, item.Description(ctx))
+	}
+	return out
+}
+
+// validators returns the validators of an attribute or block of any value kind.
+//
+//nolint:gocyclo // one case per value kind
+func validators(a any) []validator.Describer {
+	var out []validator.Describer
+	switch a := a.(type) {
+	case interface{ StringValidators() []validator.String }:
+		out = appendAll(out, a.StringValidators())
+	case interface{ BoolValidators() []validator.Bool }:
+		out = appendAll(out, a.BoolValidators())
+	case interface{ Int64Validators() []validator.Int64 }:
+		out = appendAll(out, a.Int64Validators())
+	case interface{ Int32Validators() []validator.Int32 }:
+		out = appendAll(out, a.Int32Validators())
+	case interface{ Float64Validators() []validator.Float64 }:
+		out = appendAll(out, a.Float64Validators())
+	case interface{ Float32Validators() []validator.Float32 }:
+		out = appendAll(out, a.Float32Validators())
+	case interface{ NumberValidators() []validator.Number }:
+		out = appendAll(out, a.NumberValidators())
+	case interface{ ListValidators() []validator.List }:
+		out = appendAll(out, a.ListValidators())
+	case interface{ SetValidators() []validator.Set }:
+		out = appendAll(out, a.SetValidators())
+	case interface{ MapValidators() []validator.Map }:
+		out = appendAll(out, a.MapValidators())
+	case interface{ ObjectValidators() []validator.Object }:
+		out = appendAll(out, a.ObjectValidators())
+	case interface{ DynamicValidators() []validator.Dynamic }:
+		out = appendAll(out, a.DynamicValidators())
+	}
+	return out
+}
+
+// planModifiers returns the plan modifiers of a resource attribute or block of any
+// value kind. Provider and data source attributes have none.
+//
+//nolint:gocyclo // one case per value kind
+func planModifiers(a any) []planmodifier.Describer {
+	var out []planmodifier.Describer
+	switch a := a.(type) {
+	case interface{ StringPlanModifiers() []planmodifier.String }:
+		out = appendAll(out, a.StringPlanModifiers())
+	case interface{ BoolPlanModifiers() []planmodifier.Bool }:
+		out = appendAll(out, a.BoolPlanModifiers())
+	case interface{ Int64PlanModifiers() []planmodifier.Int64 }:
+		out = appendAll(out, a.Int64PlanModifiers())
+	case interface{ Int32PlanModifiers() []planmodifier.Int32 }:
+		out = appendAll(out, a.Int32PlanModifiers())
+	case interface{ Float64PlanModifiers() []planmodifier.Float64 }:
+		out = appendAll(out, a.Float64PlanModifiers())
+	case interface{ Float32PlanModifiers() []planmodifier.Float32 }:
+		out = appendAll(out, a.Float32PlanModifiers())
+	case interface{ NumberPlanModifiers() []planmodifier.Number }:
+		out = appendAll(out, a.NumberPlanModifiers())
+	case interface{ ListPlanModifiers() []planmodifier.List }:
+		out = appendAll(out, a.ListPlanModifiers())
+	case interface{ SetPlanModifiers() []planmodifier.Set }:
+		out = appendAll(out, a.SetPlanModifiers())
+	case interface{ MapPlanModifiers() []planmodifier.Map }:
+		out = appendAll(out, a.MapPlanModifiers())
+	case interface{ ObjectPlanModifiers() []planmodifier.Object }:
+		out = appendAll(out, a.ObjectPlanModifiers())
+	case interface{ DynamicPlanModifiers() []planmodifier.Dynamic }:
+		out = appendAll(out, a.DynamicPlanModifiers())
+	}
+	return out
+}
+
+func appendAll[D any, T any](out []D, items []T) []D {
+	for _, item := range items {
+		if d, ok := any(item).(D); ok {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// requiresReplace reports whether m is one of the framework's RequiresReplace,
+// RequiresReplaceIf, or RequiresReplaceIfConfigured modifiers. They share one
+// unexported type in each of the framework's *planmodifier packages.
+func requiresReplace(m planmodifier.Describer) bool {
+	t := reflect.TypeOf(m)
+	return t.Name() == "requiresReplaceIfModifier" &&
+		strings.HasPrefix(t.PkgPath(), "github.com/hashicorp/terraform-plugin-framework/resource/schema/")
+}
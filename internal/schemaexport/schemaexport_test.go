@@ -0,0 +1,123 @@
+// ABOUTME: Tests for the schema catalog export against the real provider.
+// ABOUTME: Covers replacement markers, validators, nested blocks, and import formats matching the import.sh examples.
+
+package schemaexport
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/provider"
+)
+
+func exportProvider(t *testing.T) *Catalog {
+	t.Helper()
+	catalog, err := Export(context.Background(), provider.New("test")(), "test")
+	if err != nil {
+		t.Fatalf("Export: %v", err)
+	}
+	return catalog
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	catalog := exportProvider(t)
+	if catalog.ProviderName != "zenfra" || catalog.ProviderVersion != "test" {
+		t.Errorf("provider = %q %q, want zenfra test", catalog.ProviderName, catalog.ProviderVersion)
+	}
+	if _, ok := catalog.Provider.Attributes["api_token"]; !ok {
+		t.Error("expected the provider's api_token attribute")
+	}
+	if _, ok := catalog.DataSources["zenfra_stack"]; !ok {
+		t.Error("expected the zenfra_stack data source")
+	}
+
+	attachment := catalog.Resources["zenfra_bundle_attachment"]
+	stackID := attachment.Attributes["stack_id"]
+	if !stackID.Required || !stackID.RequiresReplace {
+		t.Errorf("stack_id = %+v, want required and requires_replace", stackID)
+	}
+	if stackID.Type != "string" {
+		t.Errorf("stack_id type = %v, want string", stackID.Type)
+	}
+	wantFormats := []string{"stack_id:bundle_id", "organization_id/stack_id:bundle_id"}
+	if attachment.Import == nil || !slices.Equal(attachment.Import.IDFormats, wantFormats) {
+		t.Errorf("import = %+v, want %v", attachment.Import, wantFormats)
+	}
+
+	if rollback := catalog.Resources["zenfra_state_rollback"]; rollback.Import != nil {
+		t.Errorf("zenfra_state_rollback is not importable, got import %+v", rollback.Import)
+	}
+
+	variable, ok := catalog.Resources["zenfra_space_variables"].Blocks["variable"]
+	if !ok || variable.NestingMode != "set" {
+		t.Fatalf("variable block = %+v, want set nesting", variable)
+	}
+	if !variable.Attributes["value"].Sensitive {
+		t.Error("expected variable.value to be sensitive")
+	}
+
+	windows := catalog.Resources["zenfra_worker_pool"].Attributes["maintenance_windows"]
+	if windows.NestingMode != "list" {
+		t.Errorf("maintenance_windows nesting = %q, want list", windows.NestingMode)
+	}
+	if cron := windows.Attributes["cron"]; len(cron.Validators) != 1 {
+		t.Errorf("maintenance_windows.cron validators = %v, want the cron validator", cron.Validators)
+	}
+
+	if _, err := json.Marshal(catalog); err != nil {
+		t.Fatalf("marshaling catalog: %v", err)
+	}
+}
+
+func TestImportIDFormats_NoStaleEntries(t *testing.T) {
+	t.Parallel()
+
+	catalog := exportProvider(t)
+	for name := range importIDFormats {
+		if res, ok := catalog.Resources[name]; !ok || res.Import == nil {
+			t.Errorf("importIDFormats has %s, which is not an importable resource", name)
+		}
+	}
+}
+
+// TestImportIDFormats_MatchExamples checks that every import.sh example uses a listed
+// format, reading $STACK_ID as stack_id. Examples with literal parts are skipped.
+func TestImportIDFormats_MatchExamples(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob("../../examples/resources/*/import.sh")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no import.sh examples found: %v", err)
+	}
+	variable := regexp.MustCompile(`\$([A-Z_]+)`)
+	for _, file := range files {
+		name := filepath.Base(filepath.Dir(file))
+		content, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range strings.Split(string(content), "\n") {
+			fields := strings.Fields(line)
+			if len(fields) != 4 || fields[0] != "terraform" || fields[1] != "import" {
+				continue
+			}
+			id := variable.ReplaceAllStringFunc(strings.Trim(fields[3], `"`), func(v string) string {
+				return strings.ToLower(v[1:])
+			})
+			if strings.ToLower(id) != id {
+				continue
+			}
+			if !slices.Contains(importIDFormats[name], id) {
+				t.Errorf("%s: example import ID %q is not in %v", file, id, importIDFormats[name])
+			}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// ABOUTME: Counting semaphore bounding concurrent work, waiting for a free slot or a done context.
+// ABOUTME: Used by the API client for requests in flight and by the provider for its own fan-out.
+
+// Package semaphore provides the counting semaphore that bounds both the API client's
+// requests in flight and the provider's own operations, each sized by
+// max_concurrent_operations.
+package semaphore
+
+import "context"
+
+// Semaphore is a counting semaphore. The nil Semaphore does not limit.
+type Semaphore chan struct{}
+
+// New returns a semaphore with n slots, or nil for n <= 0.
+func New(n int) Semaphore {
+	if n <= 0 {
+		return nil
+	}
+	return make(Semaphore, n)
+}
+
+// Acquire waits for a free slot or for ctx to be done.
+func (s Semaphore) Acquire(ctx context.Context) error {
+	if s == nil {
+		return nil
+	}
+	select {
+	case s <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a slot taken by Acquire.
+func (s Semaphore) Release() {
+	if s != nil {
+		<-s
+	}
+}
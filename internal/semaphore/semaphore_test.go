@@ -0,0 +1,36 @@
+// ABOUTME: Unit tests for the counting semaphore.
+// ABOUTME: Covers the unlimited nil semaphore and waiting for a free slot until the context is done.
+package semaphore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSemaphore(t *testing.T) {
+	ctx := context.Background()
+
+	var unlimited Semaphore
+	for range 3 {
+		if err := unlimited.Acquire(ctx); err != nil {
+			t.Fatalf("nil semaphore: %v", err)
+		}
+	}
+	unlimited.Release()
+
+	s := New(1)
+	if err := s.Acquire(ctx); err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := s.Acquire(waitCtx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a full semaphore to wait until the deadline, got %v", err)
+	}
+	s.Release()
+	if err := s.Acquire(ctx); err != nil {
+		t.Errorf("expected a released slot to be free, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+// ABOUTME: Cycle detection over the dependency edges between stacks.
+// ABOUTME: Shared by the zenfra_stack_dependency_graph data source and plan-time checks of new edges.
+
+// Package stackgraph analyses the dependency graph between stacks reported by the API.
+package stackgraph
+
+import (
+	"slices"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// FindCycles returns the groups of stacks that depend on each other, directly or
+// transitively. Each group is a strongly connected component with more than one
+// stack, or a single stack that depends on itself. Stack IDs within a group are
+// sorted, and groups are ordered by their first stack ID, so the result is stable.
+func FindCycles(edges []zenfraclient.StackDependencyEdge) [][]string {
+	adjacency := make(map[string][]string)
+	selfLoop := make(map[string]bool)
+	var vertices []string
+	seen := make(map[string]bool)
+	addVertex := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			vertices = append(vertices, id)
+		}
+	}
+	for _, e := range edges {
+		addVertex(e.FromStackID)
+		addVertex(e.ToStackID)
+		adjacency[e.FromStackID] = append(adjacency[e.FromStackID], e.ToStackID)
+		if e.FromStackID == e.ToStackID {
+			selfLoop[e.FromStackID] = true
+		}
+	}
+	slices.Sort(vertices)
+
+	// Tarjan's algorithm.
+	index := make(map[string]int)
+	lowlink := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var cycles [][]string
+	next := 0
+
+	var connect func(v string)
+	connect = func(v string) {
+		index[v] = next
+		lowlink[v] = next
+		next++
+		stack = append(stack, v)
+		onStack[v] = true
+
+		for _, w := range adjacency[v] {
+			if _, visited := index[w]; !visited {
+				connect(w)
+				lowlink[v] = min(lowlink[v], lowlink[w])
+			} else if onStack[w] {
+				lowlink[v] = min(lowlink[v], index[w])
+			}
+		}
+
+		if lowlink[v] != index[v] {
+			return
+		}
+		var component []string
+		for {
+			w := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[w] = false
+			component = append(component, w)
+			if w == v {
+				break
+			}
+		}
+		if len(component) > 1 || selfLoop[v] {
+			slices.Sort(component)
+			cycles = append(cycles, component)
+		}
+	}
+
+	for _, v := range vertices {
+		if _, visited := index[v]; !visited {
+			connect(v)
+		}
+	}
+
+	slices.SortFunc(cycles, func(a, b []string) int {
+		return slices.Compare(a, b)
+	})
+	return cycles
+}
+
+// CycleWith returns the cycle that adding edge to edges would close, as sorted stack
+// IDs, or nil if the new edge does not make any stack depend on itself.
+func CycleWith(edges []zenfraclient.StackDependencyEdge, edge zenfraclient.StackDependencyEdge) []string {
+	for _, cycle := range FindCycles(append(slices.Clip(edges), edge)) {
+		if slices.Contains(cycle, edge.ToStackID) && slices.Contains(cycle, edge.FromStackID) {
+			return cycle
+		}
+	}
+	return nil
+}
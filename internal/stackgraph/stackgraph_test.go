@@ -0,0 +1,79 @@
+// ABOUTME: Unit tests for cycle detection in the stack dependency graph.
+// ABOUTME: Covers chains, self references, and several separate cycles.
+package stackgraph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func edge(from, to string) zenfraclient.StackDependencyEdge {
+	return zenfraclient.StackDependencyEdge{FromStackID: from, ToStackID: to, Kind: zenfraclient.StackDependencyRunTrigger}
+}
+
+func TestFindCycles(t *testing.T) {
+	tests := []struct {
+		name  string
+		edges []zenfraclient.StackDependencyEdge
+		want  [][]string
+	}{
+		{
+			name: "no edges",
+		},
+		{
+			name:  "chain",
+			edges: []zenfraclient.StackDependencyEdge{edge("net", "db"), edge("db", "app"), edge("net", "app")},
+		},
+		{
+			name:  "two stack cycle",
+			edges: []zenfraclient.StackDependencyEdge{edge("net", "app"), edge("app", "net")},
+			want:  [][]string{{"app", "net"}},
+		},
+		{
+			name:  "self reference",
+			edges: []zenfraclient.StackDependencyEdge{edge("net", "net"), edge("net", "app")},
+			want:  [][]string{{"net"}},
+		},
+		{
+			name: "separate cycles",
+			edges: []zenfraclient.StackDependencyEdge{
+				edge("c", "d"), edge("d", "e"), edge("e", "c"),
+				edge("a", "b"), edge("b", "a"),
+				edge("b", "c"),
+			},
+			want: [][]string{{"a", "b"}, {"c", "d", "e"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FindCycles(tt.edges)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindCycles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCycleWith(t *testing.T) {
+	edges := []zenfraclient.StackDependencyEdge{edge("net", "db"), edge("db", "app"), edge("x", "y"), edge("y", "x")}
+
+	if got := CycleWith(edges, edge("net", "app")); got != nil {
+		t.Errorf("expected no cycle for a shortcut edge, got %v", got)
+	}
+	if got := CycleWith(edges, edge("app", "net")); !reflect.DeepEqual(got, []string{"app", "db", "net"}) {
+		t.Errorf("expected cycle app, db, net, got %v", got)
+	}
+	if got := CycleWith(edges, edge("app", "app")); !reflect.DeepEqual(got, []string{"app"}) {
+		t.Errorf("expected self reference, got %v", got)
+	}
+	// A cycle elsewhere in the graph is not one the new edge closes.
+	if got := CycleWith(edges, edge("app", "x")); got != nil {
+		t.Errorf("expected no cycle through the new edge, got %v", got)
+	}
+	if len(edges) != 4 {
+		t.Errorf("expected edges to be left unchanged, got %v", edges)
+	}
+}
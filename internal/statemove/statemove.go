@@ -0,0 +1,57 @@
+// ABOUTME: State movers for renamed resource types, so `moved` blocks can migrate state between types.
+// ABOUTME: Renamed copies the state of the old type unchanged into the new type with the same schema.
+
+// Package statemove lets Terraform 1.8 and later move state from a resource type of this
+// provider that was renamed to its new name, with a moved block:
+//
+//	moved {
+//	  from = zenfra_configuration_bundle.common
+//	  to   = zenfra_bundle.common
+//	}
+//
+// A rename keeps the old type registered, marked deprecated, until the next major
+// version; the new type lists the old name in its MoveState.
+package statemove
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// providerAddressSuffix ends the source address of every Zenfra provider install,
+// whatever registry host or mirror it came from.
+const providerAddressSuffix = "/zenfra/zenfra"
+
+// Renamed returns a StateMover for a resource type that used to be called sourceType.
+// The old and new types must share target's schema, which the state is copied with.
+// Moves from any other type or provider are left to other movers.
+func Renamed(sourceType string, target schema.Schema) resource.StateMover {
+	return resource.StateMover{
+		SourceSchema: &target,
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if req.SourceTypeName != sourceType || !strings.HasSuffix(req.SourceProviderAddress, providerAddressSuffix) {
+				return
+			}
+			if req.SourceSchemaVersion != target.Version {
+				resp.Diagnostics.AddError("Unable to Move Resource State",
+					fmt.Sprintf("The %s state has schema version %d, but this provider moves version %d. "+
+						"Run terraform apply with this provider version before moving the resource.",
+						sourceType, req.SourceSchemaVersion, target.Version))
+				return
+			}
+			if req.SourceState == nil {
+				resp.Diagnostics.AddError("Unable to Move Resource State",
+					fmt.Sprintf("The %s state does not match its schema and cannot be moved. "+
+						"Run terraform apply with this provider version before moving the resource.", sourceType))
+				return
+			}
+
+			resp.TargetState.Raw = req.SourceState.Raw.Copy()
+			resp.TargetPrivate = req.SourcePrivate
+		},
+	}
+}
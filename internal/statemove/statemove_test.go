@@ -0,0 +1,86 @@
+// ABOUTME: Unit tests for the state movers of renamed resource types.
+// ABOUTME: Covers moving matching state, skipping other types and providers, and rejecting other schema versions.
+package statemove
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRenamed(t *testing.T) {
+	ctx := context.Background()
+	target := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+		},
+	}
+	objectType := target.Type().TerraformType(ctx)
+	source := &tfsdk.State{
+		Schema: target,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "bundle-1"),
+			"name": tftypes.NewValue(tftypes.String, "common"),
+		}),
+	}
+	mover := Renamed("zenfra_configuration_bundle", target)
+
+	for _, tt := range []struct {
+		name      string
+		req       resource.MoveStateRequest
+		wantMoved bool
+		wantError bool
+	}{
+		{
+			name:      "renamed type",
+			req:       resource.MoveStateRequest{SourceTypeName: "zenfra_configuration_bundle", SourceProviderAddress: "registry.terraform.io/zenfra/zenfra", SourceState: source},
+			wantMoved: true,
+		},
+		{
+			name: "other type",
+			req:  resource.MoveStateRequest{SourceTypeName: "zenfra_space", SourceProviderAddress: "registry.terraform.io/zenfra/zenfra", SourceState: source},
+		},
+		{
+			name: "other provider",
+			req:  resource.MoveStateRequest{SourceTypeName: "zenfra_configuration_bundle", SourceProviderAddress: "registry.terraform.io/example/zenfra", SourceState: source},
+		},
+		{
+			name:      "other schema version",
+			req:       resource.MoveStateRequest{SourceTypeName: "zenfra_configuration_bundle", SourceProviderAddress: "registry.terraform.io/zenfra/zenfra", SourceSchemaVersion: 1, SourceState: source},
+			wantError: true,
+		},
+		{
+			name:      "unreadable state",
+			req:       resource.MoveStateRequest{SourceTypeName: "zenfra_configuration_bundle", SourceProviderAddress: "registry.terraform.io/zenfra/zenfra"},
+			wantError: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resource.MoveStateResponse{
+				TargetState: tfsdk.State{Schema: target, Raw: tftypes.NewValue(objectType, nil)},
+			}
+			mover.StateMover(ctx, tt.req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("expected error %t, got %v", tt.wantError, resp.Diagnostics)
+			}
+			if moved := !resp.TargetState.Raw.IsNull(); moved != tt.wantMoved {
+				t.Fatalf("expected moved %t, got state %v", tt.wantMoved, resp.TargetState.Raw)
+			}
+			if !tt.wantMoved {
+				return
+			}
+			var name string
+			resp.Diagnostics.Append(resp.TargetState.GetAttribute(ctx, path.Root("name"), &name)...)
+			if name != "common" {
+				t.Errorf("expected name common, got %q", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+// ABOUTME: Conversions from API values to Terraform framework values shared across resources and data sources.
+// ABOUTME: The API reports an unset optional string as "", which state stores as null.
+
+// Package tfvalue converts API values to framework values where the conversion is not a
+// plain constructor call.
+package tfvalue
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// OptionalString maps an empty API string to null, so an optional attribute the
+// configuration omits does not read back as "".
+func OptionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
@@ -0,0 +1,18 @@
+// ABOUTME: Tests for the shared API-to-framework value conversions.
+// ABOUTME: Covers the empty and non-empty cases of OptionalString.
+package tfvalue
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOptionalString(t *testing.T) {
+	if got := OptionalString(""); !got.IsNull() {
+		t.Errorf("OptionalString(\"\") = %v, want null", got)
+	}
+	if got := OptionalString("x"); !got.Equal(types.StringValue("x")) {
+		t.Errorf("OptionalString(\"x\") = %v, want \"x\"", got)
+	}
+}
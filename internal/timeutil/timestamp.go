@@ -0,0 +1,138 @@
+// ABOUTME: Custom string type for timestamp attributes of managed resources.
+// ABOUTME: Semantic equality compares instants, so another offset or precision for the same time is not a diff.
+package timeutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = TimestampType{}
+	_ basetypes.StringValuableWithSemanticEquals = TimestampValue{}
+)
+
+// TimestampType is the type of an RFC 3339 timestamp attribute.
+type TimestampType struct {
+	basetypes.StringType
+}
+
+func (t TimestampType) String() string {
+	return "timeutil.TimestampType"
+}
+
+func (t TimestampType) Equal(o attr.Type) bool {
+	other, ok := o.(TimestampType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t TimestampType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TimestampValue{StringValue: in}, nil
+}
+
+func (t TimestampType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return TimestampValue{StringValue: stringValue}, nil
+}
+
+func (t TimestampType) ValueType(_ context.Context) attr.Value {
+	return TimestampValue{}
+}
+
+// TimestampValue is an RFC 3339 timestamp where "2026-03-01T13:00:00+01:00" and
+// "2026-03-01T12:00:00Z" are equal.
+type TimestampValue struct {
+	basetypes.StringValue
+}
+
+// NewTimestampValue returns a known TimestampValue holding s as given.
+func NewTimestampValue(s string) TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringValue(s)}
+}
+
+// NewTimestampNull returns a null TimestampValue.
+func NewTimestampNull() TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringNull()}
+}
+
+// NewTimestampUnknown returns an unknown TimestampValue.
+func NewTimestampUnknown() TimestampValue {
+	return TimestampValue{StringValue: basetypes.NewStringUnknown()}
+}
+
+// Timestamp returns t formatted by Format as a known TimestampValue.
+func Timestamp(t time.Time) TimestampValue {
+	return NewTimestampValue(Format(t))
+}
+
+// TimestampPointer returns t formatted by Format, or null if t is nil.
+func TimestampPointer(t *time.Time) TimestampValue {
+	if t == nil {
+		return NewTimestampNull()
+	}
+	return Timestamp(*t)
+}
+
+// NormalizedTimestamp returns s normalized by Normalize, or null if s is empty.
+func NormalizedTimestamp(s string) TimestampValue {
+	if s == "" {
+		return NewTimestampNull()
+	}
+	return NewTimestampValue(Normalize(s))
+}
+
+func (v TimestampValue) Type(_ context.Context) attr.Type {
+	return TimestampType{}
+}
+
+func (v TimestampValue) Equal(o attr.Value) bool {
+	other, ok := o.(TimestampValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values are the same instant to the second,
+// the precision Format keeps. Values that do not parse are only equal to the identical
+// string.
+func (v TimestampValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimestampValue)
+	if !ok {
+		diags.AddError("Semantic Equality Check Error", fmt.Sprintf("Expected TimestampValue, got: %T", newValuable))
+		return false, diags
+	}
+
+	a, errA := Parse(v.ValueString())
+	b, errB := Parse(newValue.ValueString())
+	if errA != nil || errB != nil {
+		return v.ValueString() == newValue.ValueString(), diags
+	}
+	return a.Truncate(time.Second).Equal(b.Truncate(time.Second)), diags
+}
+
+// Time parses the value. It fails for null, unknown, and malformed values.
+func (v TimestampValue) Time() (time.Time, error) {
+	if v.IsNull() || v.IsUnknown() {
+		return time.Time{}, fmt.Errorf("timestamp is not known")
+	}
+	return Parse(v.ValueString())
+}
@@ -0,0 +1,47 @@
+// ABOUTME: One place to turn API times into Terraform strings: UTC, RFC 3339, second precision.
+// ABOUTME: Also normalizes timestamps the API already returns as strings so state never depends on the server's offset.
+
+// Package timeutil formats and parses the timestamps the provider stores in state. The
+// API may report the same instant with different offsets or fractional seconds
+// depending on the replica that answers; every timestamp attribute goes through Format
+// or Normalize so state holds one spelling per instant.
+package timeutil
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Format returns t in UTC as RFC 3339, e.g. "2026-03-01T12:00:00Z".
+func Format(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// Parse parses an RFC 3339 timestamp, with or without fractional seconds.
+func Parse(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339, s)
+}
+
+// Normalize reformats an RFC 3339 timestamp the way Format does. Empty strings and
+// values that do not parse are returned unchanged.
+func Normalize(s string) string {
+	t, err := Parse(s)
+	if err != nil {
+		return s
+	}
+	return Format(t)
+}
+
+// String returns t formatted by Format as a known string.
+func String(t time.Time) types.String {
+	return types.StringValue(Format(t))
+}
+
+// StringPointer returns t formatted by Format, or null if t is nil.
+func StringPointer(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return String(*t)
+}
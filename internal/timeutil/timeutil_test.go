@@ -0,0 +1,84 @@
+// ABOUTME: Unit tests for timestamp formatting, normalization, and the timestamp attribute type.
+// ABOUTME: Verifies that one instant always formats the same way and that offsets are not a diff.
+package timeutil
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFormat(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+	got := Format(time.Date(2026, 3, 1, 13, 0, 0, 500_000_000, berlin))
+	if got != "2026-03-01T12:00:00Z" {
+		t.Errorf("expected UTC at second precision, got %s", got)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"2026-03-01T13:00:00+01:00", "2026-03-01T12:00:00Z"},
+		{"2026-03-01T12:00:00.123456Z", "2026-03-01T12:00:00Z"},
+		{"2026-03-01T12:00:00Z", "2026-03-01T12:00:00Z"},
+		{"", ""},
+		{"yesterday", "yesterday"},
+	}
+
+	for _, tt := range tests {
+		if got := Normalize(tt.in); got != tt.want {
+			t.Errorf("Normalize(%q): expected %q, got %q", tt.in, tt.want, got)
+		}
+	}
+}
+
+func TestStringPointer(t *testing.T) {
+	if got := StringPointer(nil); !got.IsNull() {
+		t.Errorf("expected null for a nil time, got %s", got)
+	}
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	if got := StringPointer(&at); got.ValueString() != "2026-03-01T12:00:00Z" {
+		t.Errorf("expected the formatted time, got %s", got)
+	}
+}
+
+func TestTimestampValue_SemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		prior, proposed string
+		want            bool
+	}{
+		{"2026-03-01T12:00:00Z", "2026-03-01T12:00:00Z", true},
+		{"2026-03-01T13:00:00+01:00", "2026-03-01T12:00:00Z", true},
+		{"2026-03-01T12:00:00.400Z", "2026-03-01T12:00:00Z", true},
+		{"2026-03-01T12:00:00Z", "2026-03-01T12:00:01Z", false},
+		{"yesterday", "yesterday", true},
+		{"yesterday", "2026-03-01T12:00:00Z", false},
+	}
+
+	for _, tt := range tests {
+		got, diags := NewTimestampValue(tt.prior).StringSemanticEquals(ctx, NewTimestampValue(tt.proposed))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags.Errors())
+		}
+		if got != tt.want {
+			t.Errorf("%q vs %q: expected %v, got %v", tt.prior, tt.proposed, tt.want, got)
+		}
+	}
+}
+
+func TestTimestampValue_Time(t *testing.T) {
+	if _, err := NewTimestampNull().Time(); err == nil {
+		t.Error("expected an error for a null timestamp")
+	}
+	got, err := NewTimestampValue("2026-03-01T13:00:00+01:00").Time()
+	if err != nil {
+		t.Fatalf("Time: %v", err)
+	}
+	if !got.Equal(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected time %s", got)
+	}
+}
@@ -0,0 +1,141 @@
+// ABOUTME: Reusable schema validators for string attributes: enums, non-empty values, slugs, cron expressions, and CIDRs.
+// ABOUTME: Attached through the Validators field of an attribute so invalid values fail at plan time.
+
+// Package validators checks the format of string attribute values at plan time. Values
+// the API would reject on apply are reported against the attribute, before any other
+// change of the apply has been made. Null and unknown values are not checked.
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/zenfra/terraform-provider-zenfra/internal/cronexpr"
+)
+
+// stringCheck is a validator.String built from a description and a check that returns
+// the problem with a value, or an empty string if it is valid.
+type stringCheck struct {
+	summary     string
+	description string
+	check       func(value string) string
+}
+
+var _ validator.String = stringCheck{}
+
+func (v stringCheck) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v stringCheck) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v stringCheck) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if problem := v.check(req.ConfigValue.ValueString()); problem != "" {
+		resp.Diagnostics.AddAttributeError(req.Path, v.summary, fmt.Sprintf("%s %s", req.Path, problem))
+	}
+}
+
+// OneOf accepts exactly one of values.
+func OneOf(values ...string) validator.String {
+	return oneOf(values, func(value string) bool { return slices.Contains(values, value) })
+}
+
+// OneOfCaseInsensitive accepts one of values in any letter case, for attributes the API
+// matches without regard to case, such as a stack's iac.engine.
+func OneOfCaseInsensitive(values ...string) validator.String {
+	return oneOf(values, func(value string) bool {
+		return slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, value) })
+	})
+}
+
+func oneOf(values []string, accepts func(value string) bool) validator.String {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	list := strings.Join(quoted, ", ")
+	return stringCheck{
+		summary:     "Invalid Attribute Value",
+		description: "value must be one of: " + list,
+		check: func(value string) string {
+			if accepts(value) {
+				return ""
+			}
+			return fmt.Sprintf("must be one of %s, got %q.", list, value)
+		},
+	}
+}
+
+// NotEmpty rejects the empty string, for optional attributes the API does not tell apart
+// from unset: "" would be read back as null and show a diff on every plan.
+func NotEmpty() validator.String {
+	return stringCheck{
+		summary:     "Empty Attribute Value",
+		description: "value must not be empty",
+		check: func(value string) string {
+			if value != "" {
+				return ""
+			}
+			return "cannot be empty; omit the attribute to leave it unset."
+		},
+	}
+}
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Slug accepts URL-friendly identifiers: lowercase letters and digits, with single
+// hyphens between them.
+func Slug() validator.String {
+	return stringCheck{
+		summary:     "Invalid Slug",
+		description: "value must be lowercase letters and digits separated by single hyphens",
+		check: func(value string) string {
+			if slugPattern.MatchString(value) {
+				return ""
+			}
+			return fmt.Sprintf("must be lowercase letters and digits separated by single hyphens, such as \"aws-credentials\", got %q.", value)
+		},
+	}
+}
+
+// Cron accepts five-field cron expressions (minute hour day-of-month month day-of-week).
+func Cron() validator.String {
+	return stringCheck{
+		summary:     "Invalid Cron Expression",
+		description: "value must be a five-field cron expression",
+		check: func(value string) string {
+			if _, err := cronexpr.Parse(value); err != nil {
+				return fmt.Sprintf("is not a valid cron expression %q: %s.", value, err)
+			}
+			return ""
+		},
+	}
+}
+
+// CIDR accepts IPv4 and IPv6 networks in CIDR notation, such as 10.0.0.0/16.
+func CIDR() validator.String {
+	return stringCheck{
+		summary:     "Invalid CIDR Block",
+		description: "value must be a network in CIDR notation",
+		check: func(value string) string {
+			ip, network, err := net.ParseCIDR(value)
+			switch {
+			case err != nil:
+				return fmt.Sprintf("must be a network in CIDR notation, such as \"10.0.0.0/16\", got %q.", value)
+			case !ip.Equal(network.IP):
+				return fmt.Sprintf("has host bits set: %q is inside network %s.", value, network)
+			}
+			return ""
+		},
+	}
+}
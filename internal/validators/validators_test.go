@@ -0,0 +1,62 @@
+// ABOUTME: Unit tests for the shared string attribute validators.
+// ABOUTME: Runs each validator on valid, invalid, null, and unknown values.
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidators(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator validator.String
+		value     types.String
+		wantErr   string
+	}{
+		{name: "one of", validator: OneOf("github", "gitlab"), value: types.StringValue("gitlab")},
+		{name: "one of other", validator: OneOf("github", "gitlab"), value: types.StringValue("GitHub"), wantErr: `must be one of "github", "gitlab", got "GitHub"`},
+		{name: "one of any case", validator: OneOfCaseInsensitive("terraform", "opentofu"), value: types.StringValue("OpenTofu")},
+		{name: "one of any case other", validator: OneOfCaseInsensitive("terraform", "opentofu"), value: types.StringValue("pulumi"), wantErr: `must be one of "terraform", "opentofu", got "pulumi"`},
+		{name: "not empty", validator: NotEmpty(), value: types.StringValue(" ")},
+		{name: "empty", validator: NotEmpty(), value: types.StringValue(""), wantErr: "cannot be empty"},
+		{name: "slug", validator: Slug(), value: types.StringValue("aws-credentials-2")},
+		{name: "slug uppercase", validator: Slug(), value: types.StringValue("AWS"), wantErr: "lowercase letters and digits"},
+		{name: "slug double hyphen", validator: Slug(), value: types.StringValue("aws--credentials"), wantErr: "single hyphens"},
+		{name: "slug trailing hyphen", validator: Slug(), value: types.StringValue("aws-"), wantErr: "single hyphens"},
+		{name: "cron", validator: Cron(), value: types.StringValue("0 2 * * SUN")},
+		{name: "cron invalid", validator: Cron(), value: types.StringValue("0 25 * * *"), wantErr: "hour: value 25 is outside 0-23"},
+		{name: "cidr", validator: CIDR(), value: types.StringValue("10.0.0.0/16")},
+		{name: "cidr ipv6", validator: CIDR(), value: types.StringValue("2001:db8::/32")},
+		{name: "cidr address", validator: CIDR(), value: types.StringValue("10.0.0.1"), wantErr: "CIDR notation"},
+		{name: "cidr host bits", validator: CIDR(), value: types.StringValue("10.0.0.1/16"), wantErr: "inside network 10.0.0.0/16"},
+		{name: "null", validator: Slug(), value: types.StringNull()},
+		{name: "unknown", validator: Cron(), value: types.StringUnknown()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("attr"), ConfigValue: tt.value}
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), req, resp)
+
+			errs := resp.Diagnostics.Errors()
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Fatalf("expected no error, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0].Detail(), tt.wantErr) {
+				t.Fatalf("expected one error containing %q, got %v", tt.wantErr, resp.Diagnostics)
+			}
+			if !strings.HasPrefix(errs[0].Detail(), "attr ") {
+				t.Errorf("expected the detail to name the attribute, got %q", errs[0].Detail())
+			}
+		})
+	}
+}
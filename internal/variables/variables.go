@@ -0,0 +1,180 @@
+// ABOUTME: Variable blocks shared by zenfra_stack_variables and zenfra_space_variables.
+// ABOUTME: Schema, state model, conversion to and from the API, and the keys the import guard reports.
+
+// Package variables holds the variable block of the replace-all variable resources.
+// zenfra_stack_variables and zenfra_space_variables manage the same kind of variables on
+// different owners, so they share the block's schema and its conversions: the API
+// withholds secret values on read, and both restore them from the prior state.
+package variables
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/tfvalue"
+	"github.com/zenfra/terraform-provider-zenfra/internal/validators"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Model represents a single variable block.
+type Model struct {
+	Key              types.String `tfsdk:"key"`
+	Value            types.String `tfsdk:"value"`
+	Secret           types.Bool   `tfsdk:"secret"`
+	Description      types.String `tfsdk:"description"`
+	SensitiveDisplay types.Bool   `tfsdk:"sensitive_display"`
+}
+
+// AttrTypes returns the attribute types for a variable object.
+func AttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"key":               types.StringType,
+		"value":             types.StringType,
+		"secret":            types.BoolType,
+		"description":       types.StringType,
+		"sensitive_display": types.BoolType,
+	}
+}
+
+// Block returns the schema of the variable block. owner names what the variables are
+// set on, e.g. "stack".
+func Block(owner string) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		Description: "A variable to set on the " + owner + ".",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"key": schema.StringAttribute{
+					Description: "The variable name.",
+					Required:    true,
+				},
+				"value": schema.StringAttribute{
+					Description: "The variable value.",
+					Required:    true,
+					Sensitive:   true,
+				},
+				"secret": schema.BoolAttribute{
+					Description: "Whether this is a secret variable. Secret values are write-only.",
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(false),
+				},
+				"description": schema.StringAttribute{
+					Description: "What the variable is for, shown in the Zenfra UI and generated environment documentation. " +
+						"Cannot be empty.",
+					Optional: true,
+					Validators: []validator.String{
+						validators.NotEmpty(),
+					},
+				},
+				"sensitive_display": schema.BoolAttribute{
+					Description: "Whether the Zenfra UI and generated documentation mask the value. Unlike secret, the value " +
+						"stays readable through the API.",
+					Optional: true,
+					Computed: true,
+					Default:  booldefault.StaticBool(false),
+				},
+			},
+		},
+	}
+}
+
+// PriorSecrets returns the values of the secret variables in set, by key, so Read can
+// restore the values the API withholds.
+func PriorSecrets(ctx context.Context, set types.Set, diags *diag.Diagnostics) map[string]string {
+	priorSecrets := make(map[string]string)
+	if set.IsNull() {
+		return priorSecrets
+	}
+
+	var vars []Model
+	diags.Append(set.ElementsAs(ctx, &vars, false)...)
+	for _, v := range vars {
+		if v.Secret.ValueBool() {
+			priorSecrets[v.Key.ValueString()] = v.Value.ValueString()
+		}
+	}
+	return priorSecrets
+}
+
+// FromAPI converts API variables to the variable set. A value the API withheld is
+// replaced by the prior value from priorSecrets, whatever placeholder the API sent in
+// its place. An empty variable list maps to a null set.
+func FromAPI(remoteVars []zenfraclient.StackVariable, priorSecrets map[string]string) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	varObjType := types.ObjectType{AttrTypes: AttrTypes()}
+	if len(remoteVars) == 0 {
+		return types.SetNull(varObjType), diags
+	}
+
+	varObjects := make([]attr.Value, 0, len(remoteVars))
+	for _, rv := range remoteVars {
+		value := rv.Value
+		if rv.ValueMasked {
+			if prior, ok := priorSecrets[rv.Key]; ok {
+				value = prior
+			}
+		}
+		obj, d := types.ObjectValue(AttrTypes(), map[string]attr.Value{
+			"key":               types.StringValue(rv.Key),
+			"value":             types.StringValue(value),
+			"secret":            types.BoolValue(rv.Secret),
+			"description":       tfvalue.OptionalString(rv.Description),
+			"sensitive_display": types.BoolValue(rv.SensitiveDisplay),
+		})
+		diags.Append(d...)
+		varObjects = append(varObjects, obj)
+	}
+
+	varSet, d := types.SetValue(varObjType, varObjects)
+	diags.Append(d...)
+	return varSet, diags
+}
+
+// ToAPI converts the variable blocks in set into API variables.
+func ToAPI(ctx context.Context, set types.Set, diags *diag.Diagnostics) []zenfraclient.StackVariable {
+	var result []zenfraclient.StackVariable
+	if set.IsNull() {
+		return result
+	}
+
+	var vars []Model
+	diags.Append(set.ElementsAs(ctx, &vars, false)...)
+	for _, v := range vars {
+		result = append(result, zenfraclient.StackVariable{
+			Key:              v.Key.ValueString(),
+			Value:            v.Value.ValueString(),
+			Secret:           v.Secret.ValueBool(),
+			Description:      v.Description.ValueString(),
+			SensitiveDisplay: v.SensitiveDisplay.ValueBool(),
+		})
+	}
+	return result
+}
+
+// MissingKeys returns the sorted keys of remoteVars that set does not configure, the
+// variables an apply would delete.
+func MissingKeys(ctx context.Context, remoteVars []zenfraclient.StackVariable, set types.Set, diags *diag.Diagnostics) []string {
+	configKeys := make(map[string]bool)
+	if !set.IsNull() {
+		var vars []Model
+		diags.Append(set.ElementsAs(ctx, &vars, false)...)
+		for _, v := range vars {
+			configKeys[v.Key.ValueString()] = true
+		}
+	}
+
+	var missing []string
+	for _, rv := range remoteVars {
+		if !configKeys[rv.Key] {
+			missing = append(missing, rv.Key)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
@@ -0,0 +1,217 @@
+// ABOUTME: Unit tests for the variable blocks shared by the stack and space variables resources.
+// ABOUTME: Verifies attribute types, description mapping, secrets the API withholds keeping their prior value, and missing key detection.
+package variables
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+func TestAttrTypes(t *testing.T) {
+	attrTypes := AttrTypes()
+
+	expected := []string{"key", "value", "secret", "description", "sensitive_display"}
+	for _, key := range expected {
+		if _, ok := attrTypes[key]; !ok {
+			t.Errorf("missing expected attribute type: %s", key)
+		}
+	}
+
+	if attrTypes["key"] != types.StringType {
+		t.Errorf("key should be StringType, got %v", attrTypes["key"])
+	}
+	if attrTypes["value"] != types.StringType {
+		t.Errorf("value should be StringType, got %v", attrTypes["value"])
+	}
+	if attrTypes["secret"] != types.BoolType {
+		t.Errorf("secret should be BoolType, got %v", attrTypes["secret"])
+	}
+}
+
+func TestFromAPI_MaskedSecrets(t *testing.T) {
+	// Each response withholds DB_PASSWORD in one of the ways the API does.
+	tests := []struct {
+		name     string
+		response string
+	}{
+		{name: "sentinel", response: `[{"key":"DB_PASSWORD","value":"****","secret":true}]`},
+		{name: "empty value", response: `[{"key":"DB_PASSWORD","value":"","secret":true}]`},
+		{name: "masked flag", response: `[{"key":"DB_PASSWORD","value":"<redacted>","secret":true,"masked":true}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var remote []zenfraclient.StackVariable
+			if err := json.Unmarshal([]byte(`[{"key":"REGION","value":"eu-west-1","secret":false}]`), &remote); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			var masked []zenfraclient.StackVariable
+			if err := json.Unmarshal([]byte(tt.response), &masked); err != nil {
+				t.Fatalf("decoding response: %v", err)
+			}
+			remote = append(remote, masked...)
+
+			set, diags := FromAPI(remote, map[string]string{"DB_PASSWORD": "hunter2"})
+			if diags.HasError() {
+				t.Fatalf("FromAPI returned errors: %v", diags.Errors())
+			}
+
+			var vars []Model
+			if diags := set.ElementsAs(context.Background(), &vars, false); diags.HasError() {
+				t.Fatalf("ElementsAs returned errors: %v", diags.Errors())
+			}
+			values := make(map[string]string, len(vars))
+			for _, v := range vars {
+				values[v.Key.ValueString()] = v.Value.ValueString()
+			}
+			if values["DB_PASSWORD"] != "hunter2" {
+				t.Errorf("expected DB_PASSWORD to be restored from state, got %q", values["DB_PASSWORD"])
+			}
+			if values["REGION"] != "eu-west-1" {
+				t.Errorf("expected REGION 'eu-west-1', got %q", values["REGION"])
+			}
+		})
+	}
+}
+
+func TestFromAPI_UnmaskedSecretWins(t *testing.T) {
+	// A secret the API returns in clear text is the real value, even if state differs.
+	remote := []zenfraclient.StackVariable{{Key: "DB_PASSWORD", Value: "rotated", Secret: true}}
+
+	set, diags := FromAPI(remote, map[string]string{"DB_PASSWORD": "hunter2"})
+	if diags.HasError() {
+		t.Fatalf("FromAPI returned errors: %v", diags.Errors())
+	}
+	var vars []Model
+	set.ElementsAs(context.Background(), &vars, false)
+	if len(vars) != 1 || vars[0].Value.ValueString() != "rotated" {
+		t.Errorf("expected the API value to be kept, got %+v", vars)
+	}
+}
+
+func TestFromAPI_DescriptionAndSensitiveDisplay(t *testing.T) {
+	var remote []zenfraclient.StackVariable
+	response := `[{"key":"REGION","value":"eu-west-1","description":"Region the stack deploys to","sensitive_display":true},` +
+		`{"key":"DB_PASSWORD","value":"","secret":true,"masked":true,"description":"Database password"},` +
+		`{"key":"LOG_LEVEL","value":"info"}]`
+	if err := json.Unmarshal([]byte(response), &remote); err != nil {
+		t.Fatalf("decoding response: %v", err)
+	}
+
+	set, diags := FromAPI(remote, map[string]string{"DB_PASSWORD": "hunter2"})
+	if diags.HasError() {
+		t.Fatalf("FromAPI returned errors: %v", diags.Errors())
+	}
+	var vars []Model
+	set.ElementsAs(context.Background(), &vars, false)
+	byKey := make(map[string]Model, len(vars))
+	for _, v := range vars {
+		byKey[v.Key.ValueString()] = v
+	}
+
+	if v := byKey["REGION"]; v.Description.ValueString() != "Region the stack deploys to" || !v.SensitiveDisplay.ValueBool() {
+		t.Errorf("expected REGION's description and sensitive_display, got %+v", v)
+	}
+	if v := byKey["DB_PASSWORD"]; v.Description.ValueString() != "Database password" {
+		t.Errorf("expected the masked secret to keep its description, got %+v", v)
+	}
+	if v := byKey["LOG_LEVEL"]; !v.Description.IsNull() || v.SensitiveDisplay.ValueBool() {
+		t.Errorf("expected a null description and sensitive_display false, got %+v", v)
+	}
+}
+
+func TestToAPI_DescriptionAndSensitiveDisplay(t *testing.T) {
+	obj, diags := types.ObjectValue(AttrTypes(), map[string]attr.Value{
+		"key":               types.StringValue("REGION"),
+		"value":             types.StringValue("eu-west-1"),
+		"secret":            types.BoolValue(false),
+		"description":       types.StringValue("Region the stack deploys to"),
+		"sensitive_display": types.BoolValue(true),
+	})
+	if diags.HasError() {
+		t.Fatalf("ObjectValue returned errors: %v", diags.Errors())
+	}
+	set, diags := types.SetValue(types.ObjectType{AttrTypes: AttrTypes()}, []attr.Value{obj})
+	if diags.HasError() {
+		t.Fatalf("SetValue returned errors: %v", diags.Errors())
+	}
+
+	vars := ToAPI(context.Background(), set, &diags)
+	if diags.HasError() {
+		t.Fatalf("ToAPI returned errors: %v", diags.Errors())
+	}
+	want := zenfraclient.StackVariable{Key: "REGION", Value: "eu-west-1", Description: "Region the stack deploys to", SensitiveDisplay: true}
+	if len(vars) != 1 || vars[0] != want {
+		t.Errorf("ToAPI = %+v, want [%+v]", vars, want)
+	}
+}
+
+func TestFromAPI_PreservesSecrets(t *testing.T) {
+	remote := []zenfraclient.StackVariable{
+		{Key: "REGION", Value: "eu-west-1"},
+		{Key: "DB_PASSWORD", Value: "****", Secret: true, ValueMasked: true},
+		{Key: "NEW_SECRET", Value: "****", Secret: true, ValueMasked: true},
+	}
+	prior := map[string]string{"DB_PASSWORD": "hunter2"}
+
+	set, diags := FromAPI(remote, prior)
+	if diags.HasError() {
+		t.Fatalf("FromAPI returned errors: %v", diags.Errors())
+	}
+
+	var vars []Model
+	diags = set.ElementsAs(context.Background(), &vars, false)
+	if diags.HasError() {
+		t.Fatalf("ElementsAs returned errors: %v", diags.Errors())
+	}
+
+	values := make(map[string]string, len(vars))
+	for _, v := range vars {
+		values[v.Key.ValueString()] = v.Value.ValueString()
+	}
+	if values["REGION"] != "eu-west-1" {
+		t.Errorf("expected REGION 'eu-west-1', got %q", values["REGION"])
+	}
+	if values["DB_PASSWORD"] != "hunter2" {
+		t.Errorf("expected DB_PASSWORD to be restored from state, got %q", values["DB_PASSWORD"])
+	}
+	if values["NEW_SECRET"] != "****" {
+		t.Errorf("expected NEW_SECRET to stay masked without prior state, got %q", values["NEW_SECRET"])
+	}
+}
+
+func TestFromAPI_EmptyIsNull(t *testing.T) {
+	set, diags := FromAPI(nil, nil)
+	if diags.HasError() {
+		t.Fatalf("FromAPI returned errors: %v", diags.Errors())
+	}
+	if !set.IsNull() {
+		t.Error("expected null set for no variables")
+	}
+}
+
+func TestMissingKeys(t *testing.T) {
+	remote := []zenfraclient.StackVariable{{Key: "B"}, {Key: "A"}, {Key: "C"}}
+	obj, diags := types.ObjectValue(AttrTypes(), map[string]attr.Value{
+		"key":               types.StringValue("C"),
+		"value":             types.StringValue("3"),
+		"secret":            types.BoolValue(false),
+		"description":       types.StringNull(),
+		"sensitive_display": types.BoolValue(false),
+	})
+	if diags.HasError() {
+		t.Fatalf("ObjectValue returned errors: %v", diags.Errors())
+	}
+	config := types.SetValueMust(types.ObjectType{AttrTypes: AttrTypes()}, []attr.Value{obj})
+
+	got := MissingKeys(context.Background(), remote, config, &diags)
+	if !slices.Equal(got, []string{"A", "B"}) {
+		t.Errorf("expected [A B], got %v", got)
+	}
+}
@@ -0,0 +1,320 @@
+// ABOUTME: Per-domain interfaces over Client consumed by the Terraform resources.
+// ABOUTME: Lets resource CRUD logic be unit tested against fakes such as zenfrafake.Client.
+
+package zenfraclient
+
+import (
+	"context"
+	"time"
+)
+
+// OrganizationAPI reads the organization of the API token.
+type OrganizationAPI interface {
+	GetCurrentOrganization(ctx context.Context) (*Organization, error)
+}
+
+// ResourceAPI is the part of the client every resource uses: import verification reads
+// the current organization, Read applies the configured 403 handling, and ModifyPlan
+// checks the token's permissions, read-only mode, and destroy protection.
+type ResourceAPI interface {
+	OrganizationAPI
+	IsNotFoundOnRead(err error) bool
+	GetTokenPermissionsCached(ctx context.Context) (*TokenPermissions, error)
+	IsReadOnly() bool
+	IsDestroyProtected(typeName string) bool
+}
+
+// SpaceAPI covers spaces, their variables, and their managed lock.
+type SpaceAPI interface {
+	ResourceAPI
+	CreateSpace(ctx context.Context, req CreateSpaceRequest) (*Space, error)
+	GetSpace(ctx context.Context, id string) (*Space, error)
+	GetSpaceCached(ctx context.Context, id string) (*Space, error)
+	UpdateSpace(ctx context.Context, id string, req UpdateSpaceRequest) (*Space, error)
+	DeleteSpace(ctx context.Context, id string) error
+	DeleteSpaceRecursive(ctx context.Context, id string) error
+	GetSpaceVariables(ctx context.Context, spaceID string) ([]StackVariable, error)
+	GetSpaceVariablesCached(ctx context.Context, spaceID string) ([]StackVariable, error)
+	SetSpaceVariables(ctx context.Context, spaceID string, vars []StackVariable) ([]StackVariable, error)
+	SetSpaceManagedLock(ctx context.Context, spaceID string, enabled bool) (*ManagedLock, error)
+}
+
+// StackAPI covers stacks, their variables, source, triggers, active runs, state, and managed lock.
+type StackAPI interface {
+	ResourceAPI
+	CreateStack(ctx context.Context, req CreateStackRequest) (*Stack, error)
+	GetStack(ctx context.Context, id string) (*Stack, error)
+	GetStackCached(ctx context.Context, id string) (*Stack, error)
+	GetStackHealth(ctx context.Context, id string) (*StackHealth, error)
+	IsBulkRefresh() bool
+	WaitForStackReady(ctx context.Context, id string, interval time.Duration) (*Stack, error)
+	UpdateStack(ctx context.Context, id string, req UpdateStackRequest) (*Stack, error)
+	DeleteStack(ctx context.Context, id string, opts *DeleteStackOptions) error
+	GetStackVariables(ctx context.Context, stackID string) ([]StackVariable, error)
+	GetStackVariablesCached(ctx context.Context, stackID string) ([]StackVariable, error)
+	SetStackVariables(ctx context.Context, stackID string, vars []StackVariable) ([]StackVariable, error)
+	SetStackSource(ctx context.Context, stackID string, source StackSource) error
+	SetStackTriggers(ctx context.Context, stackID string, triggers StackTriggers) error
+	ListActiveStackRuns(ctx context.Context, stackID string) ([]ActiveRun, error)
+	WaitForStackIdle(ctx context.Context, stackID string, interval time.Duration) error
+	ListStackBundles(ctx context.Context, stackID string) ([]BundleAttachment, error)
+	ListStateSnapshots(ctx context.Context, stackID string) ([]StateSnapshot, error)
+	RollbackState(ctx context.Context, stackID string, req RollbackStateRequest) (*StateRollback, error)
+	SetStackManagedLock(ctx context.Context, stackID string, enabled bool) (*ManagedLock, error)
+}
+
+// StackManifestAPI covers stacks managed through a manifest. It includes GetStack so
+// imports can verify the stack's organization.
+type StackManifestAPI interface {
+	ResourceAPI
+	ValidateStackManifest(ctx context.Context, req ValidateStackManifestRequest) (*StackManifestValidation, error)
+	CreateStackFromManifest(ctx context.Context, req StackManifestRequest) (*StackManifest, error)
+	GetStackManifest(ctx context.Context, stackID string) (*StackManifest, error)
+	UpdateStackFromManifest(ctx context.Context, stackID string, req StackManifestRequest) (*StackManifest, error)
+	DeleteStack(ctx context.Context, id string, opts *DeleteStackOptions) error
+	GetStack(ctx context.Context, id string) (*Stack, error)
+}
+
+// BundleAPI covers configuration bundles, their content, and their managed lock.
+type BundleAPI interface {
+	ResourceAPI
+	CreateBundle(ctx context.Context, req CreateBundleRequest) (*Bundle, error)
+	GetBundle(ctx context.Context, id string) (*Bundle, error)
+	UpdateBundle(ctx context.Context, id string, req UpdateBundleRequest) (*Bundle, error)
+	UpdateBundleContent(ctx context.Context, id string, req UpdateBundleContentRequest) (*UpdateBundleContentResponse, error)
+	ValidateBundleContent(ctx context.Context, req ValidateBundleContentRequest) (*BundleContentValidation, error)
+	DeleteBundle(ctx context.Context, id string) error
+	SetBundleManagedLock(ctx context.Context, id string, enabled bool) (*ManagedLock, error)
+}
+
+// BundleAttachmentAPI covers links between stacks and bundles. It includes GetStack and
+// GetBundle so imports can verify both ends.
+type BundleAttachmentAPI interface {
+	ResourceAPI
+	AttachBundle(ctx context.Context, stackID, bundleID string) error
+	DetachBundle(ctx context.Context, stackID, bundleID string) error
+	ListStackBundles(ctx context.Context, stackID string) ([]BundleAttachment, error)
+	GetStack(ctx context.Context, id string) (*Stack, error)
+	GetBundle(ctx context.Context, id string) (*Bundle, error)
+}
+
+// SpaceBundleAttachmentAPI covers links between spaces and bundles. It includes GetSpace
+// and GetBundle so imports can verify both ends.
+type SpaceBundleAttachmentAPI interface {
+	ResourceAPI
+	AttachSpaceBundle(ctx context.Context, spaceID, bundleID string) error
+	DetachSpaceBundle(ctx context.Context, spaceID, bundleID string) error
+	ListSpaceBundles(ctx context.Context, spaceID string) ([]SpaceBundleAttachment, error)
+	GetSpace(ctx context.Context, id string) (*Space, error)
+	GetBundle(ctx context.Context, id string) (*Bundle, error)
+}
+
+// SecretBackendAPI covers connections to external secret stores.
+type SecretBackendAPI interface {
+	ResourceAPI
+	CreateSecretBackend(ctx context.Context, req CreateSecretBackendRequest) (*SecretBackend, error)
+	GetSecretBackend(ctx context.Context, id string) (*SecretBackend, error)
+	UpdateSecretBackend(ctx context.Context, id string, req UpdateSecretBackendRequest) (*SecretBackend, error)
+	DeleteSecretBackend(ctx context.Context, id string) error
+}
+
+// BundleSecretReferenceAPI covers secrets exposed to runs through bundles. It includes
+// GetBundle so imports can verify the bundle.
+type BundleSecretReferenceAPI interface {
+	ResourceAPI
+	CreateBundleSecretReference(ctx context.Context, bundleID string, req BundleSecretReferenceRequest) (*BundleSecretReference, error)
+	GetBundleSecretReference(ctx context.Context, bundleID, id string) (*BundleSecretReference, error)
+	UpdateBundleSecretReference(ctx context.Context, bundleID, id string, req BundleSecretReferenceRequest) (*BundleSecretReference, error)
+	DeleteBundleSecretReference(ctx context.Context, bundleID, id string) error
+	GetBundle(ctx context.Context, id string) (*Bundle, error)
+}
+
+// VariableSetAPI covers organization-wide variable sets, their variables, and the
+// scopes that select the stacks they apply to.
+type VariableSetAPI interface {
+	ResourceAPI
+	CreateVariableSet(ctx context.Context, req CreateVariableSetRequest) (*VariableSet, error)
+	GetVariableSet(ctx context.Context, id string) (*VariableSet, error)
+	UpdateVariableSet(ctx context.Context, id string, req UpdateVariableSetRequest) (*VariableSet, error)
+	DeleteVariableSet(ctx context.Context, id string) error
+	CreateVariableSetVariable(ctx context.Context, setID string, req VariableSetVariableRequest) (*VariableSetVariable, error)
+	GetVariableSetVariable(ctx context.Context, setID, key string) (*VariableSetVariable, error)
+	UpdateVariableSetVariable(ctx context.Context, setID, key string, req VariableSetVariableRequest) (*VariableSetVariable, error)
+	DeleteVariableSetVariable(ctx context.Context, setID, key string) error
+	CreateVariableSetScope(ctx context.Context, setID string, req CreateVariableSetScopeRequest) (*VariableSetScope, error)
+	GetVariableSetScope(ctx context.Context, setID, id string) (*VariableSetScope, error)
+	DeleteVariableSetScope(ctx context.Context, setID, id string) error
+}
+
+// WorkerPoolAPI covers worker pools.
+type WorkerPoolAPI interface {
+	ResourceAPI
+	CreateWorkerPool(ctx context.Context, req CreateWorkerPoolRequest) (*CreateWorkerPoolResponse, error)
+	GetWorkerPool(ctx context.Context, id string) (*WorkerPool, error)
+	GetWorkerPoolCached(ctx context.Context, id string) (*WorkerPool, error)
+	UpdateWorkerPool(ctx context.Context, id string, req UpdateWorkerPoolRequest) (*WorkerPool, error)
+	DrainWorkerPool(ctx context.Context, id string) (*WorkerPool, error)
+	ResumeWorkerPool(ctx context.Context, id string) (*WorkerPool, error)
+	WaitForWorkerPoolDrained(ctx context.Context, id string, interval time.Duration) (*WorkerPool, error)
+	DeleteWorkerPool(ctx context.Context, id string) error
+	ListRunnerVersions(ctx context.Context) ([]RunnerVersion, error)
+}
+
+// WorkerPoolAssignmentAPI covers a space's default worker pool. It includes GetSpace so
+// imports can verify the space.
+type WorkerPoolAssignmentAPI interface {
+	ResourceAPI
+	GetWorkerPoolAssignment(ctx context.Context, spaceID string) (*WorkerPoolAssignment, error)
+	SetWorkerPoolAssignment(ctx context.Context, spaceID string, req SetWorkerPoolAssignmentRequest) (*WorkerPoolAssignment, error)
+	DeleteWorkerPoolAssignment(ctx context.Context, spaceID string) error
+	GetSpace(ctx context.Context, id string) (*Space, error)
+}
+
+// TokenAPI covers API tokens.
+type TokenAPI interface {
+	ResourceAPI
+	CreateToken(ctx context.Context, req CreateTokenRequest) (*CreateTokenResponse, error)
+	GetToken(ctx context.Context, id string) (*Token, error)
+	DeleteToken(ctx context.Context, id string) error
+}
+
+// SigningKeyAPI covers signing keys.
+type SigningKeyAPI interface {
+	ResourceAPI
+	CreateSigningKey(ctx context.Context, req CreateSigningKeyRequest) (*SigningKey, error)
+	GetSigningKey(ctx context.Context, id string) (*SigningKey, error)
+	UpdateSigningKey(ctx context.Context, id string, req UpdateSigningKeyRequest) (*SigningKey, error)
+	DeleteSigningKey(ctx context.Context, id string) error
+}
+
+// MembershipInvitationAPI covers invitations to join the organization. It includes
+// FindMember to tell an accepted invitation from a revoked one once the API no longer
+// returns it.
+type MembershipInvitationAPI interface {
+	ResourceAPI
+	CreateInvitation(ctx context.Context, req CreateInvitationRequest) (*Invitation, error)
+	GetInvitation(ctx context.Context, id string) (*Invitation, error)
+	UpdateInvitation(ctx context.Context, id string, req UpdateInvitationRequest) (*Invitation, error)
+	ResendInvitation(ctx context.Context, id string, req ResendInvitationRequest) (*Invitation, error)
+	RevokeInvitation(ctx context.Context, id string) error
+	FindMember(ctx context.Context, email string) (*Member, error)
+}
+
+// OrganizationDomainAPI covers the email domains the organization has claimed.
+type OrganizationDomainAPI interface {
+	ResourceAPI
+	CreateOrganizationDomain(ctx context.Context, req CreateOrganizationDomainRequest) (*OrganizationDomain, error)
+	GetOrganizationDomain(ctx context.Context, id string) (*OrganizationDomain, error)
+	UpdateOrganizationDomain(ctx context.Context, id string, req UpdateOrganizationDomainRequest) (*OrganizationDomain, error)
+	DeleteOrganizationDomain(ctx context.Context, id string) error
+}
+
+// OrganizationDomainVerificationAPI covers checking and waiting for the DNS verification
+// of an organization domain.
+type OrganizationDomainVerificationAPI interface {
+	ResourceAPI
+	GetOrganizationDomain(ctx context.Context, id string) (*OrganizationDomain, error)
+	VerifyOrganizationDomain(ctx context.Context, id string) (*OrganizationDomain, error)
+	WaitForOrganizationDomainVerified(ctx context.Context, id string, interval time.Duration) (*OrganizationDomain, error)
+}
+
+// RunCommentAPI covers comments on runs. It includes GetStack so imports can verify the
+// stack of the commented run.
+type RunCommentAPI interface {
+	ResourceAPI
+	CreateRunComment(ctx context.Context, runID string, req CreateRunCommentRequest) (*RunComment, error)
+	GetRunComment(ctx context.Context, runID, commentID string) (*RunComment, error)
+	GetStack(ctx context.Context, id string) (*Stack, error)
+}
+
+// OutputSubscriptionAPI covers subscriptions of stacks to the outputs of other stacks. It
+// includes GetStack so imports can verify the subscribing stack, and the dependency graph
+// so plans can report subscriptions that would queue runs in a cycle.
+type OutputSubscriptionAPI interface {
+	ResourceAPI
+	CreateOutputSubscription(ctx context.Context, stackID string, req CreateOutputSubscriptionRequest) (*OutputSubscription, error)
+	GetOutputSubscription(ctx context.Context, stackID, id string) (*OutputSubscription, error)
+	UpdateOutputSubscription(ctx context.Context, stackID, id string, req UpdateOutputSubscriptionRequest) (*OutputSubscription, error)
+	DeleteOutputSubscription(ctx context.Context, stackID, id string) error
+	GetStack(ctx context.Context, id string) (*Stack, error)
+	GetStackDependencyGraph(ctx context.Context, opts *StackDependencyGraphOptions) (*StackDependencyGraph, error)
+}
+
+// RateLimitPolicyAPI covers the organization's API rate limit policies. It includes
+// GetRateLimitBounds so plans can check limits against what the organization may grant.
+type RateLimitPolicyAPI interface {
+	ResourceAPI
+	CreateRateLimitPolicy(ctx context.Context, req CreateRateLimitPolicyRequest) (*RateLimitPolicy, error)
+	GetRateLimitPolicy(ctx context.Context, id string) (*RateLimitPolicy, error)
+	UpdateRateLimitPolicy(ctx context.Context, id string, req UpdateRateLimitPolicyRequest) (*RateLimitPolicy, error)
+	DeleteRateLimitPolicy(ctx context.Context, id string) error
+	GetRateLimitBounds(ctx context.Context) (*RateLimitBounds, error)
+}
+
+// RunQueueSettingsAPI covers the organization's run concurrency and queue settings.
+type RunQueueSettingsAPI interface {
+	ResourceAPI
+	GetRunQueueSettings(ctx context.Context) (*RunQueueSettings, error)
+	UpdateRunQueueSettings(ctx context.Context, req UpdateRunQueueSettingsRequest) (*RunQueueSettings, error)
+	ResetRunQueueSettings(ctx context.Context) error
+}
+
+// RetentionSettingsAPI covers how long the organization keeps runs and run logs.
+type RetentionSettingsAPI interface {
+	ResourceAPI
+	GetRetentionSettings(ctx context.Context) (*RetentionSettings, error)
+	UpdateRetentionSettings(ctx context.Context, req UpdateRetentionSettingsRequest) (*RetentionSettings, error)
+	ResetRetentionSettings(ctx context.Context) error
+}
+
+// RunnerVersionConstraintAPI covers the organization's default runner version constraint
+// and the catalog it is checked against.
+type RunnerVersionConstraintAPI interface {
+	ResourceAPI
+	GetRunnerVersionConstraint(ctx context.Context) (*RunnerVersionConstraint, error)
+	UpdateRunnerVersionConstraint(ctx context.Context, req UpdateRunnerVersionConstraintRequest) (*RunnerVersionConstraint, error)
+	ResetRunnerVersionConstraint(ctx context.Context) error
+	ListRunnerVersions(ctx context.Context) ([]RunnerVersion, error)
+}
+
+// VCSIntegrationAPI covers VCS integrations.
+type VCSIntegrationAPI interface {
+	ResourceAPI
+	CreateVCSIntegration(ctx context.Context, req CreateVCSIntegrationRequest) (*VCSIntegration, error)
+	GetVCSIntegration(ctx context.Context, id string) (*VCSIntegration, error)
+	UpdateVCSIntegration(ctx context.Context, id string, req UpdateVCSIntegrationRequest) (*VCSIntegration, error)
+	UpdateVCSIntegrationCredentials(ctx context.Context, id string, req UpdateVCSCredentialsRequest) (*VCSIntegration, error)
+	DeleteVCSIntegration(ctx context.Context, id string) error
+}
+
+// WebhookSecretRotationAPI covers rotating the signing secret of a webhook endpoint.
+type WebhookSecretRotationAPI interface {
+	ResourceAPI
+	GetWebhookEndpoint(ctx context.Context, id string) (*WebhookEndpoint, error)
+	RotateWebhookEndpointSecret(ctx context.Context, id string) (*WebhookSecretRotation, error)
+}
+
+// Ensure Client implements every domain interface.
+var (
+	_ SpaceAPI                          = (*Client)(nil)
+	_ StackAPI                          = (*Client)(nil)
+	_ StackManifestAPI                  = (*Client)(nil)
+	_ BundleAPI                         = (*Client)(nil)
+	_ BundleAttachmentAPI               = (*Client)(nil)
+	_ SecretBackendAPI                  = (*Client)(nil)
+	_ BundleSecretReferenceAPI          = (*Client)(nil)
+	_ VariableSetAPI                    = (*Client)(nil)
+	_ WorkerPoolAPI                     = (*Client)(nil)
+	_ WorkerPoolAssignmentAPI           = (*Client)(nil)
+	_ TokenAPI                          = (*Client)(nil)
+	_ SigningKeyAPI                     = (*Client)(nil)
+	_ MembershipInvitationAPI           = (*Client)(nil)
+	_ OrganizationDomainAPI             = (*Client)(nil)
+	_ OrganizationDomainVerificationAPI = (*Client)(nil)
+	_ OutputSubscriptionAPI             = (*Client)(nil)
+	_ RateLimitPolicyAPI                = (*Client)(nil)
+	_ RunCommentAPI                     = (*Client)(nil)
+	_ RunnerVersionConstraintAPI        = (*Client)(nil)
+	_ VCSIntegrationAPI                 = (*Client)(nil)
+	_ WebhookSecretRotationAPI          = (*Client)(nil)
+)
@@ -1,5 +1,5 @@
 // ABOUTME: Bundle attachment methods for the Zenfra API client.
-// ABOUTME: Implements AttachBundle, DetachBundle, and ListStackBundles for stack-bundle linking.
+// ABOUTME: Implements attach, detach, and list for bundles linked to stacks and to spaces, and the reverse lookup.
 
 package zenfraclient
 
@@ -39,3 +39,56 @@ func (c *Client) ListStackBundles(ctx context.Context, stackID string) ([]Bundle
 	}
 	return resp.Attachments, nil
 }
+
+// AttachSpaceBundle attaches a bundle to a space. Stacks in the space, and in child spaces
+// that inherit bundles, receive the bundle's configuration.
+func (c *Client) AttachSpaceBundle(ctx context.Context, spaceID, bundleID string) error {
+	req := AttachBundleRequest{BundleID: bundleID}
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/spaces/"+spaceID+"/bundles", req, nil); err != nil {
+		return fmt.Errorf("attach space bundle: %w", err)
+	}
+	return nil
+}
+
+// DetachSpaceBundle detaches a bundle from a space.
+func (c *Client) DetachSpaceBundle(ctx context.Context, spaceID, bundleID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/spaces/"+spaceID+"/bundles/"+bundleID, nil)
+	if err != nil {
+		return fmt.Errorf("detach space bundle: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // best-effort close
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf("detach space bundle: %w", err)
+	}
+	return nil
+}
+
+// ListSpaceBundles returns the bundles attached directly to a space. Bundles inherited
+// from parent spaces are not included.
+func (c *Client) ListSpaceBundles(ctx context.Context, spaceID string) ([]SpaceBundleAttachment, error) {
+	var resp ListSpaceAttachmentsResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/spaces/"+spaceID+"/bundles", nil, &resp); err != nil {
+		return nil, fmt.Errorf("list space bundles: %w", err)
+	}
+	return resp.Attachments, nil
+}
+
+// ListBundleAttachedStacks returns every stack that receives a bundle's configuration,
+// whether attached directly or through a space it inherits bundles from.
+func (c *Client) ListBundleAttachedStacks(ctx context.Context, bundleID string) ([]BundleAttachedStack, error) {
+	resp, err := c.apiListBundleAttachedStacks(ctx, bundleID)
+	if err != nil {
+		return nil, fmt.Errorf("list bundle attached stacks: %w", err)
+	}
+	return resp.Stacks, nil
+}
+
+// ResolveStackBundles returns every bundle a stack receives, from its own attachments and
+// those inherited from its space and parent spaces, in the order they are applied.
+func (c *Client) ResolveStackBundles(ctx context.Context, stackID string) ([]EffectiveBundle, error) {
+	resp, err := c.apiResolveStackBundles(ctx, stackID)
+	if err != nil {
+		return nil, fmt.Errorf("resolve stack bundles: %w", err)
+	}
+	return resp.Bundles, nil
+}
@@ -1,5 +1,5 @@
 // ABOUTME: Bundle CRUD methods for the Zenfra API client.
-// ABOUTME: Implements bundle lifecycle including content updates with optimistic locking.
+// ABOUTME: Implements bundle lifecycle including content updates with optimistic locking and dry-run content validation.
 
 package zenfraclient
 
@@ -27,12 +27,12 @@ func (c *Client) GetBundle(ctx context.Context, id string) (*Bundle, error) {
 	return &bundle, nil
 }
 
-// ListBundles returns all bundles in the organization.
-func (c *Client) ListBundles(ctx context.Context) ([]Bundle, error) {
+// ListBundles returns all bundles in the organization. opts may be nil.
+func (c *Client) ListBundles(ctx context.Context, opts *ListOptions) ([]Bundle, error) {
 	var resp struct {
 		Bundles []Bundle `json:"bundles"`
 	}
-	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/bundles", nil, &resp); err != nil {
+	if err := c.doJSON(ctx, http.MethodGet, listPath("/api/v1/bundles", opts), nil, &resp); err != nil {
 		return nil, fmt.Errorf("list bundles: %w", err)
 	}
 	return resp.Bundles, nil
@@ -56,6 +56,16 @@ func (c *Client) UpdateBundleContent(ctx context.Context, id string, req UpdateB
 	return &resp, nil
 }
 
+// ValidateBundleContent checks bundle content against the API's syntax, size, and path
+// rules without storing it.
+func (c *Client) ValidateBundleContent(ctx context.Context, req ValidateBundleContentRequest) (*BundleContentValidation, error) {
+	result, err := c.apiValidateBundleContent(asRead(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("validate bundle content: %w", err)
+	}
+	return result, nil
+}
+
 // DeleteBundle deletes a bundle by ID.
 func (c *Client) DeleteBundle(ctx context.Context, id string) error {
 	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/bundles/"+id, nil)
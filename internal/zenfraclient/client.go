@@ -10,22 +10,79 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/semaphore"
 )
 
 const (
-	defaultTimeout   = 30 * time.Second
-	defaultUserAgent = "terraform-provider-zenfra/0.1.0"
+	defaultTimeout         = 30 * time.Second
+	defaultUserAgentPrefix = "terraform-provider-zenfra"
+	defaultVersion         = "dev"
 )
 
 // ClientConfig holds configuration for creating a new Client.
 type ClientConfig struct {
-	Endpoint   string        // Required: Zenfra API base URL (e.g., "https://api.zenfra.io")
-	APIToken   string        // Required: Bearer token for authentication
-	UserAgent  string        // Optional: defaults to "terraform-provider-zenfra/<version>"
-	Timeout    time.Duration // Optional: HTTP client timeout, defaults to 30s
-	MaxRetries int           // Optional: max retry attempts, defaults to 3
+	Endpoint       string // Required: Zenfra API base URL (e.g., "https://api.zenfra.io")
+	APIToken       string // Required: Bearer token for authentication
+	UserAgent      string // Optional: defaults to "terraform-provider-zenfra/<version>"
+	Version        string // Optional: provider version reported in the default User-Agent, defaults to "dev"
+	UserAgentExtra string // Optional: appended to the User-Agent to identify the calling pipeline in audit logs
+	// DisableTelemetry leaves the version out of the default User-Agent, so the API
+	// only learns that the provider made a request, not which release.
+	DisableTelemetry bool
+	Timeout          time.Duration // Optional: HTTP client timeout, defaults to 30s
+	MaxRetries       int           // Optional: max retry attempts, defaults to 3
+
+	// RetryPolicy, if set, decides which responses are retried in place of
+	// DefaultRetryPolicy.
+	RetryPolicy RetryPolicy
+
+	// MaxConcurrentRequests, if positive, bounds how many API requests are in flight at
+	// once across all callers of the client. Further requests wait for a free slot.
+	MaxConcurrentRequests int
+
+	// TracerProvider, if set, records a client span for every API call and propagates
+	// its trace context to the API in the traceparent header.
+	TracerProvider trace.TracerProvider
+
+	// ExtraHeaders are sent on every API request, e.g. for an access gateway in front
+	// of a self-hosted API. They cannot replace the headers the client sets itself.
+	ExtraHeaders map[string]string
+
+	// Connection pooling. Zero values use the defaults in transport.go.
+	MaxIdleConnsPerHost int           // Optional: idle connections kept open to the API, defaults to 32
+	IdleConnTimeout     time.Duration // Optional: how long an idle connection is kept, defaults to 90s
+	DisableKeepAlives   bool          // Optional: open a new connection for every request
+	DisableHTTP2        bool          // Optional: use HTTP/1.1 even if the API offers HTTP/2
+
+	// RecordPath, if set, appends every API interaction to this file with credentials
+	// and secret values redacted, for attaching to bug reports.
+	RecordPath string
+
+	// BulkRefresh makes GetStackCached, GetSpaceCached, and GetWorkerPoolCached serve
+	// refresh reads from one list call per object kind instead of a GET per object.
+	BulkRefresh bool
+
+	// TreatForbiddenAsNotFound makes IsNotFoundOnRead report 403 responses as not found,
+	// for tokens that can only see part of the organization.
+	TreatForbiddenAsNotFound bool
+
+	// ReadOnly makes the client refuse every request that could change objects in
+	// Zenfra with ErrReadOnly, for plans run with production credentials.
+	ReadOnly bool
+
+	// ProtectedResourceTypes are the resource types, e.g. "zenfra_stack", whose objects
+	// plans may not destroy or replace. See IsDestroyProtected.
+	ProtectedResourceTypes []string
+
+	// ManagedBy, if set, is sent in the X-Zenfra-Managed-By header of every request,
+	// e.g. "terraform/production". See Client.ForResource.
+	ManagedBy string
 }
 
 // Client is the Zenfra API client.
@@ -33,8 +90,25 @@ type Client struct {
 	baseURL    string
 	apiToken   string
 	userAgent  string
+	headers    http.Header // extra headers sent on every request
+	tracing    tracing
 	httpClient *http.Client
+	recorder   *recordingTransport // nil unless ClientConfig.RecordPath is set
 	retry      retryConfig
+	limiter    semaphore.Semaphore  // bounds the requests in flight across every caller
+	variables  *stackVariablesCache // keyed by stack ID
+
+	spaceVariables           *stackVariablesCache // keyed by space ID
+	permissions              *tokenPermissionsCache
+	treatForbiddenAsNotFound bool
+	readOnly                 bool
+	protectedTypes           map[string]bool
+	managedBy                string
+
+	// Bulk refresh snapshots; nil unless ClientConfig.BulkRefresh is set.
+	stacks      *snapshot[Stack]
+	spaces      *snapshot[Space]
+	workerPools *snapshot[WorkerPool]
 }
 
 // NewClient creates a new Zenfra API client.
@@ -46,9 +120,23 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 		return nil, fmt.Errorf("api_token is required")
 	}
 
+	headers, err := extraHeaders(cfg.ExtraHeaders)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ContainsAny(cfg.ManagedBy, "\r\n") {
+		return nil, fmt.Errorf("managed-by label %q contains a line break", cfg.ManagedBy)
+	}
+
 	userAgent := cfg.UserAgent
 	if userAgent == "" {
-		userAgent = defaultUserAgent
+		userAgent = defaultUserAgent(cfg.Version)
+		if cfg.DisableTelemetry {
+			userAgent = defaultUserAgentPrefix
+		}
+	}
+	if extra := strings.TrimSpace(cfg.UserAgentExtra); extra != "" {
+		userAgent += " " + extra
 	}
 
 	timeout := cfg.Timeout
@@ -60,23 +148,121 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 	if cfg.MaxRetries > 0 {
 		retryCfg.maxRetries = cfg.MaxRetries
 	}
+	if cfg.RetryPolicy != nil {
+		retryCfg.policy = cfg.RetryPolicy
+	}
 
-	return &Client{
-		baseURL:   strings.TrimRight(cfg.Endpoint, "/"),
-		apiToken:  cfg.APIToken,
-		userAgent: userAgent,
-		httpClient: &http.Client{
-			Timeout: timeout,
-		},
-		retry: retryCfg,
-	}, nil
+	httpClient := &http.Client{
+		Timeout:   timeout,
+		Transport: newTransport(cfg),
+	}
+	var recorder *recordingTransport
+	if cfg.RecordPath != "" {
+		var err error
+		recorder, err = newRecordingTransport(httpClient.Transport, cfg.RecordPath)
+		if err != nil {
+			return nil, err
+		}
+		httpClient.Transport = recorder
+	}
+
+	c := &Client{
+		baseURL:    strings.TrimRight(cfg.Endpoint, "/"),
+		apiToken:   cfg.APIToken,
+		userAgent:  userAgent,
+		headers:    headers,
+		tracing:    newTracing(cfg.TracerProvider),
+		httpClient: httpClient,
+		recorder:   recorder,
+		retry:      retryCfg,
+		limiter:    semaphore.New(cfg.MaxConcurrentRequests),
+		variables:  newStackVariablesCache(),
+
+		spaceVariables:           newStackVariablesCache(),
+		permissions:              &tokenPermissionsCache{},
+		treatForbiddenAsNotFound: cfg.TreatForbiddenAsNotFound,
+		readOnly:                 cfg.ReadOnly,
+		protectedTypes:           make(map[string]bool, len(cfg.ProtectedResourceTypes)),
+		managedBy:                cfg.ManagedBy,
+	}
+	for _, typeName := range cfg.ProtectedResourceTypes {
+		c.protectedTypes[typeName] = true
+	}
+	if cfg.BulkRefresh {
+		c.stacks = newSnapshot(c.loadStacks)
+		c.spaces = newSnapshot(c.loadSpaces)
+		c.workerPools = newSnapshot(c.loadWorkerPools)
+	}
+	return c, nil
+}
+
+// Close closes the idle connections to the API and the file the client records
+// interactions to, if any. Copies of the client share both, so closing any of them
+// closes them for all; requests made afterwards still work but are no longer recorded.
+func (c *Client) Close() error {
+	c.httpClient.CloseIdleConnections()
+	if c.recorder == nil {
+		return nil
+	}
+	return c.recorder.close()
+}
+
+// reservedHeaders are set by the client on every request and cannot be overridden
+// through ClientConfig.ExtraHeaders.
+var reservedHeaders = []string{"Authorization", "User-Agent", "Content-Type", "Accept", ManagedByHeader}
+
+// extraHeaders validates the configured extra headers and returns them in canonical form.
+func extraHeaders(extra map[string]string) (http.Header, error) {
+	headers := make(http.Header, len(extra))
+	for name, value := range extra {
+		if !validHeaderName(name) {
+			return nil, fmt.Errorf("extra header %q is not a valid HTTP header name", name)
+		}
+		canonical := http.CanonicalHeaderKey(name)
+		if slices.Contains(reservedHeaders, canonical) {
+			return nil, fmt.Errorf("extra header %q is set by the provider and cannot be overridden", name)
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return nil, fmt.Errorf("extra header %q has a value containing a line break", name)
+		}
+		headers.Set(canonical, value)
+	}
+	return headers, nil
+}
+
+// validHeaderName reports whether name is a non-empty RFC 9110 token.
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, r := range name {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			continue
+		}
+		if !strings.ContainsRune("!#$%&'*+-.^_`|~", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// defaultUserAgent returns the User-Agent reported for the given provider version.
+func defaultUserAgent(version string) string {
+	if version == "" {
+		version = defaultVersion
+	}
+	return defaultUserAgentPrefix + "/" + version
 }
 
 // doRequest executes an HTTP request with retry logic and returns the raw response.
-// The caller is responsible for closing the response body.
+// The caller is responsible for closing the response body. All attempts share one span.
 //
 //nolint:gocognit,gocyclo // retry loop with error handling is inherently complex
-func (c *Client) doRequest(ctx context.Context, method, path string, body any) (*http.Response, error) {
+func (c *Client) doRequest(ctx context.Context, method, path string, body any) (result *http.Response, err error) {
+	if err := c.checkReadOnly(ctx, method, path); err != nil {
+		return nil, err
+	}
+
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBytes, err := json.Marshal(body)
@@ -88,6 +274,9 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any) (
 
 	url := c.baseURL + path
 
+	ctx, span := c.tracing.startSpan(ctx, method, url)
+	defer func() { endSpan(span, result, err) }()
+
 	var lastResp *http.Response
 	var lastErr error
 
@@ -106,15 +295,30 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any) (
 			return nil, fmt.Errorf("creating request: %w", err)
 		}
 
+		for name, values := range c.headers {
+			req.Header[name] = values
+		}
 		req.Header.Set("Authorization", "Bearer "+c.apiToken)
 		req.Header.Set("User-Agent", c.userAgent)
 		if body != nil {
 			req.Header.Set("Content-Type", "application/json")
 		}
 		req.Header.Set("Accept", "application/json")
+		if orgID := organizationFromContext(ctx); orgID != "" {
+			req.Header.Set(OrganizationHeader, orgID)
+		}
+		if c.managedBy != "" {
+			req.Header.Set(ManagedByHeader, c.managedBy)
+		}
+		c.tracing.inject(ctx, req)
 
+		if err := c.limiter.Acquire(ctx); err != nil {
+			return nil, fmt.Errorf("waiting for a free request slot: %w", err)
+		}
 		resp, err := c.httpClient.Do(req)
+		recordAttempt(span, attempt, resp, err)
 		if err != nil {
+			c.limiter.Release()
 			lastErr = fmt.Errorf("executing request: %w", err)
 			if ctx.Err() != nil {
 				return nil, lastErr
@@ -129,13 +333,17 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body any) (
 			return nil, lastErr
 		}
 
-		if !isRetryableStatus(resp.StatusCode) {
+		if !c.retry.policy(method, resp.StatusCode, req.Header) {
+			if c.limiter != nil {
+				resp.Body = &releasingBody{ReadCloser: resp.Body, release: c.limiter.Release}
+			}
 			return resp, nil
 		}
 
-		// Close body before retry.
+		// Close body and give up the slot before retry.
 		lastResp = resp
 		_ = resp.Body.Close()
+		c.limiter.Release()
 
 		if attempt < c.retry.maxRetries {
 			if sleepErr := sleepWithContext(ctx, retryDelay(c.retry, attempt, resp)); sleepErr != nil {
@@ -198,9 +406,10 @@ func checkResponse(resp *http.Response) error {
 
 	// Try to parse error message from response body.
 	var errBody struct {
-		Error   string            `json:"error"`
-		Message string            `json:"message"`
-		Fields  map[string]string `json:"fields,omitempty"`
+		Error             string             `json:"error"`
+		Message           string             `json:"message"`
+		Fields            map[string]string  `json:"fields,omitempty"`
+		BlockingResources []BlockingResource `json:"blocking_resources,omitempty"`
 	}
 	if json.Unmarshal(bodyBytes, &errBody) == nil {
 		if errBody.Message != "" {
@@ -218,7 +427,7 @@ func checkResponse(resp *http.Response) error {
 	case http.StatusNotFound:
 		return &NotFoundError{APIError: apiErr}
 	case http.StatusConflict:
-		return &ConflictError{APIError: apiErr}
+		return &ConflictError{APIError: apiErr, BlockingResources: errBody.BlockingResources}
 	case http.StatusUnauthorized:
 		return &UnauthorizedError{APIError: apiErr}
 	case http.StatusForbidden:
@@ -0,0 +1,53 @@
+// ABOUTME: Compliance report methods for the Zenfra API client.
+// ABOUTME: Reports are generated asynchronously; WaitForComplianceReport polls until the export is ready.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CreateComplianceReport requests a new evidence export. The report starts out
+// pending; wait for it with WaitForComplianceReport.
+func (c *Client) CreateComplianceReport(ctx context.Context, req CreateComplianceReportRequest) (*ComplianceReport, error) {
+	// Exporting evidence reads audit records; the report itself is not an object the caller manages.
+	report, err := c.apiCreateComplianceReport(asRead(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("create compliance report: %w", err)
+	}
+	return report, nil
+}
+
+// GetComplianceReport retrieves a compliance report by ID.
+func (c *Client) GetComplianceReport(ctx context.Context, id string) (*ComplianceReport, error) {
+	report, err := c.apiGetComplianceReport(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get compliance report: %w", err)
+	}
+	return report, nil
+}
+
+// WaitForComplianceReport polls a compliance report every interval until it is ready
+// and returns it. It fails if the report fails or ctx is done first; bound the wait
+// with a context deadline.
+func (c *Client) WaitForComplianceReport(ctx context.Context, id string, interval time.Duration) (*ComplianceReport, error) {
+	for {
+		report, err := c.GetComplianceReport(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("wait for compliance report: %w", err)
+		}
+
+		switch report.Status {
+		case ComplianceReportStatusReady:
+			return report, nil
+		case ComplianceReportStatusFailed:
+			return report, fmt.Errorf("wait for compliance report: report %s failed: %s", id, report.StatusReason)
+		}
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return report, fmt.Errorf("wait for compliance report: report %s still %s: %w", id, report.Status, err)
+		}
+	}
+}
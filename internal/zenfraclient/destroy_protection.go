@@ -0,0 +1,10 @@
+// ABOUTME: Destroy protection, under which plans may not destroy or replace objects of the protected resource types.
+// ABOUTME: Enforced at plan time by permcheck; the client itself still sends deletes.
+
+package zenfraclient
+
+// IsDestroyProtected reports whether typeName, a resource type such as "zenfra_stack",
+// is one of ClientConfig.ProtectedResourceTypes.
+func (c *Client) IsDestroyProtected(typeName string) bool {
+	return c.protectedTypes[typeName]
+}
@@ -0,0 +1,85 @@
+// ABOUTME: Regional API endpoints and endpoint discovery via /.well-known/zenfra.json.
+// ABOUTME: Resolves a region name (us, eu, gov) to the API endpoint the provider should use.
+
+package zenfraclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+)
+
+// discoveryPath is served by every regional Zenfra deployment and describes its API.
+const discoveryPath = "/.well-known/zenfra.json"
+
+// discoveryTimeout bounds the discovery request, which runs before any API call.
+const discoveryTimeout = 10 * time.Second
+
+// regionEndpoints are the well-known base URLs of each Zenfra region.
+var regionEndpoints = map[string]string{
+	"us":  "https://api.zenfra.cloud",
+	"eu":  "https://api.eu.zenfra.cloud",
+	"gov": "https://api.gov.zenfra.cloud",
+}
+
+// Regions returns the supported region names, sorted.
+func Regions() []string {
+	regions := make([]string, 0, len(regionEndpoints))
+	for r := range regionEndpoints {
+		regions = append(regions, r)
+	}
+	slices.Sort(regions)
+	return regions
+}
+
+// RegionEndpoint returns the base URL of a region and whether the region is known.
+func RegionEndpoint(region string) (string, bool) {
+	endpoint, ok := regionEndpoints[region]
+	return endpoint, ok
+}
+
+// Discovery is the document served at /.well-known/zenfra.json.
+type Discovery struct {
+	Region      string `json:"region"`
+	APIEndpoint string `json:"api_endpoint"`
+}
+
+// DiscoverEndpoint fetches the discovery document from baseURL and returns the API
+// endpoint it advertises. A document without api_endpoint means baseURL is itself the API.
+// httpClient may be nil to use a default client.
+func DiscoverEndpoint(ctx context.Context, httpClient *http.Client, baseURL string) (string, error) {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: discoveryTimeout}
+	}
+	baseURL = strings.TrimRight(baseURL, "/")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+discoveryPath, nil)
+	if err != nil {
+		return "", fmt.Errorf("discover endpoint: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("discover endpoint: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // best-effort close
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("discover endpoint: %s%s returned %s", baseURL, discoveryPath, resp.Status)
+	}
+
+	var doc Discovery
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<20)).Decode(&doc); err != nil {
+		return "", fmt.Errorf("discover endpoint: decoding %s%s: %w", baseURL, discoveryPath, err)
+	}
+	if doc.APIEndpoint == "" {
+		return baseURL, nil
+	}
+	return strings.TrimRight(doc.APIEndpoint, "/"), nil
+}
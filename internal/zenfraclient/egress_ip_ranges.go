@@ -0,0 +1,19 @@
+// ABOUTME: Egress IP range methods for the Zenfra API client.
+// ABOUTME: Wraps the generated apiGetEgressIPRanges; the EgressIPRanges types are generated too.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetEgressIPRanges returns the static IP ranges, per region, that Zenfra runners use
+// for outbound connections.
+func (c *Client) GetEgressIPRanges(ctx context.Context) (*EgressIPRanges, error) {
+	ranges, err := c.apiGetEgressIPRanges(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get egress ip ranges: %w", err)
+	}
+	return ranges, nil
+}
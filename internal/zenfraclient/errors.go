@@ -31,6 +31,15 @@ type NotFoundError struct {
 // ConflictError indicates a conflict with existing state (HTTP 409).
 type ConflictError struct {
 	APIError
+	BlockingResources []BlockingResource `json:"blocking_resources,omitempty"`
+}
+
+// BlockingResource identifies a resource that prevents an operation from completing,
+// such as a child space or stack that keeps a space from being deleted.
+type BlockingResource struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
 }
 
 // UnauthorizedError indicates missing or invalid authentication (HTTP 401).
@@ -72,3 +81,17 @@ func IsForbidden(err error) bool {
 	var fe *ForbiddenError
 	return errors.As(err, &fe)
 }
+
+// ForbiddenReadHint is appended to Read errors caused by a 403 so users know how to recover.
+const ForbiddenReadHint = "The API token is not permitted to read this object. Grant the token access to it, " +
+	"or set treat_forbidden_as_not_found = true in the provider configuration to remove objects the token cannot see from state."
+
+// IsNotFoundOnRead reports whether a Read should remove a resource from state. This is
+// the case for a NotFoundError, and for a ForbiddenError when the client was configured
+// with TreatForbiddenAsNotFound.
+func (c *Client) IsNotFoundOnRead(err error) bool {
+	if IsNotFound(err) {
+		return true
+	}
+	return c.treatForbiddenAsNotFound && IsForbidden(err)
+}
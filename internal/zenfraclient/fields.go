@@ -0,0 +1,44 @@
+// ABOUTME: Sparse fieldsets for GET requests, in the style of JSON:API's fields parameter.
+// ABOUTME: Lets callers that only need a few attributes skip transferring full nested objects.
+
+package zenfraclient
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Fields names the top-level attributes a GET response should include, by their JSON
+// names (e.g. "id", "name"). Nil or empty requests full objects. Attributes left out
+// decode to their zero value, so a partial object must not be written back to the API
+// or served from a refresh snapshot.
+type Fields []string
+
+// ListOptions are optional query parameters for list endpoints without filters.
+type ListOptions struct {
+	Fields Fields
+}
+
+// set adds the fields parameter to query unless f is empty.
+func (f Fields) set(query url.Values) {
+	if len(f) > 0 {
+		query.Set("fields", strings.Join(f, ","))
+	}
+}
+
+// listPath returns path with the fields of opts, if any, as its query string.
+func listPath(path string, opts *ListOptions) string {
+	if opts == nil {
+		return path
+	}
+	return withQuery(path, opts.Fields, url.Values{})
+}
+
+// withQuery appends query, plus fields, to path. path must not have a query string.
+func withQuery(path string, fields Fields, query url.Values) string {
+	fields.set(query)
+	if len(query) == 0 {
+		return path
+	}
+	return path + "?" + query.Encode()
+}
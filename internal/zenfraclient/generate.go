@@ -0,0 +1,9 @@
+// ABOUTME: go:generate directive that regenerates zz_generated.go from openapi.json.
+// ABOUTME: openapi.json holds the part of the Zenfra OpenAPI document the client is generated from.
+
+package zenfraclient
+
+// A type or Client method declared both here by hand and in openapi.json fails
+// generation: delete the hand-written one to switch to the generated one. New endpoints
+// should wrap the generated api* methods instead of calling doJSON.
+//go:generate go run ../gen/cmd/openapigen -spec openapi.json -out zz_generated.go
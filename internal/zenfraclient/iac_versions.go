@@ -0,0 +1,23 @@
+// ABOUTME: IaC version catalog methods for the Zenfra API client.
+// ABOUTME: Implements reading the terraform and opentofu versions stacks can run.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// GetIACVersions returns the version catalog for an IaC engine, such as "terraform" or "opentofu".
+func (c *Client) GetIACVersions(ctx context.Context, engine string) (*IACVersionCatalog, error) {
+	query := url.Values{}
+	query.Set("engine", engine)
+
+	var catalog IACVersionCatalog
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/iac-versions?"+query.Encode(), nil, &catalog); err != nil {
+		return nil, fmt.Errorf("get iac versions: %w", err)
+	}
+	return &catalog, nil
+}
@@ -0,0 +1,70 @@
+// ABOUTME: Membership invitation and member lookup methods for the Zenfra API client.
+// ABOUTME: Invitations are emailed offers to join the organization; accepted ones may disappear from the API.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// CreateInvitation invites someone to the organization and emails them the invitation.
+func (c *Client) CreateInvitation(ctx context.Context, req CreateInvitationRequest) (*Invitation, error) {
+	invitation, err := c.apiCreateInvitation(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("create invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// GetInvitation retrieves an invitation by ID.
+func (c *Client) GetInvitation(ctx context.Context, id string) (*Invitation, error) {
+	invitation, err := c.apiGetInvitation(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// UpdateInvitation changes a pending invitation.
+func (c *Client) UpdateInvitation(ctx context.Context, id string, req UpdateInvitationRequest) (*Invitation, error) {
+	invitation, err := c.apiUpdateInvitation(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("update invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// ResendInvitation emails a pending invitation again and restarts its expiry.
+func (c *Client) ResendInvitation(ctx context.Context, id string, req ResendInvitationRequest) (*Invitation, error) {
+	invitation, err := c.apiResendInvitation(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("resend invitation: %w", err)
+	}
+	return invitation, nil
+}
+
+// RevokeInvitation withdraws a pending invitation, so its link stops working.
+func (c *Client) RevokeInvitation(ctx context.Context, id string) error {
+	if err := c.apiRevokeInvitation(ctx, id); err != nil {
+		return fmt.Errorf("revoke invitation: %w", err)
+	}
+	return nil
+}
+
+// FindMember returns the organization member with the given email address, compared
+// case-insensitively, or nil if there is none.
+func (c *Client) FindMember(ctx context.Context, email string) (*Member, error) {
+	resp, err := c.apiListMembers(ctx, url.Values{"email": {email}})
+	if err != nil {
+		return nil, fmt.Errorf("find member: %w", err)
+	}
+	for i := range resp.Items {
+		if strings.EqualFold(resp.Items[i].Email, email) {
+			return &resp.Items[i], nil
+		}
+	}
+	return nil, nil
+}
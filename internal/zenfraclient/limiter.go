@@ -0,0 +1,23 @@
+// ABOUTME: Response body wrapper that holds a slot of the client's request limiter.
+// ABOUTME: A slot is held from sending a request until its response body is closed.
+
+package zenfraclient
+
+import (
+	"io"
+	"sync"
+)
+
+// releasingBody releases a limiter slot when the response body is closed, so a slot
+// stays taken while the response is still being read.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,21 @@
+// ABOUTME: X-Zenfra-Managed-By header attributing API calls to the configuration and resource type making them.
+// ABOUTME: ClientConfig.ManagedBy labels the configuration; ForResource adds the resource type per resource.
+
+package zenfraclient
+
+// ManagedByHeader is the request header that names the tool, configuration, and
+// resource type making a request, so the API audit log can attribute each change.
+const ManagedByHeader = "X-Zenfra-Managed-By"
+
+// ForResource returns a client whose requests name typeName after the ManagedBy label
+// in the X-Zenfra-Managed-By header, e.g. "terraform/production/zenfra_stack". The
+// returned client shares its connections, caches, and concurrency limit with c. A client
+// without a ManagedBy label is returned unchanged.
+func (c *Client) ForResource(typeName string) *Client {
+	if c.managedBy == "" {
+		return c
+	}
+	clone := *c
+	clone.managedBy = c.managedBy + "/" + typeName
+	return &clone
+}
@@ -0,0 +1,81 @@
+// ABOUTME: Organization domain methods for the Zenfra API client.
+// ABOUTME: Domains are verified through a DNS TXT record; WaitForOrganizationDomainVerified polls until the check passes.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CreateOrganizationDomain claims an email domain for the organization. The domain starts
+// out pending until its verification TXT record is found.
+func (c *Client) CreateOrganizationDomain(ctx context.Context, req CreateOrganizationDomainRequest) (*OrganizationDomain, error) {
+	domain, err := c.apiCreateOrganizationDomain(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("create organization domain: %w", err)
+	}
+	return domain, nil
+}
+
+// GetOrganizationDomain retrieves an organization domain by ID.
+func (c *Client) GetOrganizationDomain(ctx context.Context, id string) (*OrganizationDomain, error) {
+	domain, err := c.apiGetOrganizationDomain(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get organization domain: %w", err)
+	}
+	return domain, nil
+}
+
+// UpdateOrganizationDomain changes the auto-join settings of an organization domain.
+func (c *Client) UpdateOrganizationDomain(ctx context.Context, id string, req UpdateOrganizationDomainRequest) (*OrganizationDomain, error) {
+	domain, err := c.apiUpdateOrganizationDomain(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("update organization domain: %w", err)
+	}
+	return domain, nil
+}
+
+// DeleteOrganizationDomain releases an organization domain by ID. Members who joined
+// through it stay in the organization.
+func (c *Client) DeleteOrganizationDomain(ctx context.Context, id string) error {
+	if err := c.apiDeleteOrganizationDomain(ctx, id); err != nil {
+		return fmt.Errorf("delete organization domain: %w", err)
+	}
+	return nil
+}
+
+// VerifyOrganizationDomain asks the API to look up the domain's verification TXT record
+// now rather than at its next scheduled check. The returned domain may still be
+// pending while the lookup runs.
+func (c *Client) VerifyOrganizationDomain(ctx context.Context, id string) (*OrganizationDomain, error) {
+	domain, err := c.apiVerifyOrganizationDomain(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("verify organization domain: %w", err)
+	}
+	return domain, nil
+}
+
+// WaitForOrganizationDomainVerified polls an organization domain every interval until it
+// is verified and returns it. It fails if verification fails or ctx is done first;
+// bound the wait with a context deadline.
+func (c *Client) WaitForOrganizationDomainVerified(ctx context.Context, id string, interval time.Duration) (*OrganizationDomain, error) {
+	for {
+		domain, err := c.GetOrganizationDomain(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("wait for organization domain verified: %w", err)
+		}
+
+		switch domain.Status {
+		case DomainStatusVerified:
+			return domain, nil
+		case DomainStatusFailed:
+			return domain, fmt.Errorf("wait for organization domain verified: domain %s failed verification: %s", domain.Domain, domain.StatusReason)
+		}
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return domain, fmt.Errorf("wait for organization domain verified: domain %s still %s: %w", domain.Domain, domain.Status, err)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// ABOUTME: Per-request organization override for API tokens with access to several organizations.
+// ABOUTME: The organization travels in the context and is sent as the X-Zenfra-Organization-ID header.
+
+package zenfraclient
+
+import "context"
+
+// OrganizationHeader is the request header that selects the organization a request acts
+// on. Without it, the API uses the organization the API token belongs to.
+const OrganizationHeader = "X-Zenfra-Organization-ID"
+
+type organizationKey struct{}
+
+// WithOrganization returns a context whose requests act on the organization orgID. An
+// empty orgID clears any override, so requests use the token's own organization.
+func WithOrganization(ctx context.Context, orgID string) context.Context {
+	return context.WithValue(ctx, organizationKey{}, orgID)
+}
+
+// organizationFromContext returns the organization override of ctx, or "" if none.
+func organizationFromContext(ctx context.Context) string {
+	orgID, _ := ctx.Value(organizationKey{}).(string)
+	return orgID
+}
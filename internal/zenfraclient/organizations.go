@@ -1,5 +1,5 @@
 // ABOUTME: Organization methods for the Zenfra API client.
-// ABOUTME: Implements GetCurrentOrganization for reading the authenticated user's organization.
+// ABOUTME: Implements GetCurrentOrganization and GetOrganizationUsage for the authenticated user's organization.
 
 package zenfraclient
 
@@ -17,3 +17,13 @@ func (c *Client) GetCurrentOrganization(ctx context.Context) (*Organization, err
 	}
 	return &org, nil
 }
+
+// GetOrganizationUsage retrieves API quota, run minute, and worker slot usage for the
+// authenticated user's organization.
+func (c *Client) GetOrganizationUsage(ctx context.Context) (*OrganizationUsage, error) {
+	var usage OrganizationUsage
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/organizations/current/usage", nil, &usage); err != nil {
+		return nil, fmt.Errorf("get organization usage: %w", err)
+	}
+	return &usage, nil
+}
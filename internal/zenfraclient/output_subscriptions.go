@@ -0,0 +1,44 @@
+// ABOUTME: Output subscription methods for the Zenfra API client.
+// ABOUTME: A subscription makes Zenfra queue a run of a stack when outputs of another stack change.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// CreateOutputSubscription subscribes a stack to the outputs of another stack.
+func (c *Client) CreateOutputSubscription(ctx context.Context, stackID string, req CreateOutputSubscriptionRequest) (*OutputSubscription, error) {
+	sub, err := c.apiCreateOutputSubscription(ctx, stackID, req)
+	if err != nil {
+		return nil, fmt.Errorf("create output subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// GetOutputSubscription retrieves a stack's output subscription by ID.
+func (c *Client) GetOutputSubscription(ctx context.Context, stackID, id string) (*OutputSubscription, error) {
+	sub, err := c.apiGetOutputSubscription(ctx, stackID, id)
+	if err != nil {
+		return nil, fmt.Errorf("get output subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// UpdateOutputSubscription changes which outputs of the source stack a subscription watches.
+func (c *Client) UpdateOutputSubscription(ctx context.Context, stackID, id string, req UpdateOutputSubscriptionRequest) (*OutputSubscription, error) {
+	sub, err := c.apiUpdateOutputSubscription(ctx, stackID, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("update output subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// DeleteOutputSubscription removes a stack's output subscription.
+func (c *Client) DeleteOutputSubscription(ctx context.Context, stackID, id string) error {
+	if err := c.apiDeleteOutputSubscription(ctx, stackID, id); err != nil {
+		return fmt.Errorf("delete output subscription: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// ABOUTME: Rate limit policy methods for the Zenfra API client.
+// ABOUTME: A policy overrides the organization's API rate limit for one API token or one source network.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetRateLimitBounds retrieves the organization's default API rate limit and the highest
+// limit a policy may grant.
+func (c *Client) GetRateLimitBounds(ctx context.Context) (*RateLimitBounds, error) {
+	bounds, err := c.apiGetRateLimitBounds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get rate limit bounds: %w", err)
+	}
+	return bounds, nil
+}
+
+// ListRateLimitPolicies lists the organization's rate limit policies.
+func (c *Client) ListRateLimitPolicies(ctx context.Context) ([]RateLimitPolicy, error) {
+	resp, err := c.apiListRateLimitPolicies(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list rate limit policies: %w", err)
+	}
+	return resp.Items, nil
+}
+
+// CreateRateLimitPolicy creates a rate limit policy for an API token or a source network.
+func (c *Client) CreateRateLimitPolicy(ctx context.Context, req CreateRateLimitPolicyRequest) (*RateLimitPolicy, error) {
+	policy, err := c.apiCreateRateLimitPolicy(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("create rate limit policy: %w", err)
+	}
+	return policy, nil
+}
+
+// GetRateLimitPolicy retrieves a rate limit policy by ID.
+func (c *Client) GetRateLimitPolicy(ctx context.Context, id string) (*RateLimitPolicy, error) {
+	policy, err := c.apiGetRateLimitPolicy(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get rate limit policy: %w", err)
+	}
+	return policy, nil
+}
+
+// UpdateRateLimitPolicy changes the limits or description of a rate limit policy.
+func (c *Client) UpdateRateLimitPolicy(ctx context.Context, id string, req UpdateRateLimitPolicyRequest) (*RateLimitPolicy, error) {
+	policy, err := c.apiUpdateRateLimitPolicy(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("update rate limit policy: %w", err)
+	}
+	return policy, nil
+}
+
+// DeleteRateLimitPolicy deletes a rate limit policy by ID. Requests it covered fall back
+// to the organization's default limit.
+func (c *Client) DeleteRateLimitPolicy(ctx context.Context, id string) error {
+	if err := c.apiDeleteRateLimitPolicy(ctx, id); err != nil {
+		return fmt.Errorf("delete rate limit policy: %w", err)
+	}
+	return nil
+}
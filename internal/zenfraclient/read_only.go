@@ -0,0 +1,41 @@
+// ABOUTME: Read-only mode, in which the client refuses every request that could change objects in Zenfra.
+// ABOUTME: POST endpoints that only compute a result are marked as reads so data sources keep working.
+
+package zenfraclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrReadOnly is returned for a request that could change objects in Zenfra while
+// ClientConfig.ReadOnly is set. Resources fail the plan before getting this far; the
+// client check is the backstop.
+var ErrReadOnly = errors.New("the client is read-only and does not send requests that change objects")
+
+type readRequestKey struct{}
+
+// asRead marks requests made with ctx as reads although they use a method other than
+// GET, for endpoints that compute a result without storing anything of the caller's.
+func asRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readRequestKey{}, true)
+}
+
+// IsReadOnly reports whether the client was configured with ClientConfig.ReadOnly.
+func (c *Client) IsReadOnly() bool {
+	return c.readOnly
+}
+
+// checkReadOnly returns ErrReadOnly for a request that may change objects while the
+// client is read-only.
+func (c *Client) checkReadOnly(ctx context.Context, method, path string) error {
+	if !c.readOnly || method == http.MethodGet || method == http.MethodHead {
+		return nil
+	}
+	if read, _ := ctx.Value(readRequestKey{}).(bool); read {
+		return nil
+	}
+	return fmt.Errorf("%s %s: %w", method, path, ErrReadOnly)
+}
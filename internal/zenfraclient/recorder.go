@@ -0,0 +1,285 @@
+// ABOUTME: HTTP transports that record sanitized API interactions to a cassette file and replay them.
+// ABOUTME: Recording is enabled with ClientConfig.RecordPath (ZENFRA_RECORD); replay backs fixture-based tests.
+
+package zenfraclient
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// redactedValue replaces sensitive values in recorded interactions.
+const redactedValue = "REDACTED"
+
+// sensitiveJSONKeys are JSON object keys whose string values are always redacted.
+// "secret" holds a webhook signing secret; as the boolean flag of a variable it is
+// left alone and redacts the variable's value instead. private_key and client_secret
+// are the credentials of VCS app and OAuth integrations.
+var sensitiveJSONKeys = map[string]bool{
+	"token":         true,
+	"access_token":  true,
+	"api_key":       true,
+	"api_token":     true,
+	"password":      true,
+	"ssh_key":       true,
+	"secret":        true,
+	"private_key":   true,
+	"client_secret": true,
+}
+
+// recordedHeaders are the headers kept in recorded interactions. Everything else,
+// including Authorization, is dropped.
+var recordedHeaders = []string{"Content-Type", "Retry-After", "X-Request-ID"}
+
+// Interaction is a single recorded request/response pair. A cassette file holds one
+// JSON-encoded Interaction per line, in the order the requests were made.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is the sanitized request half of an Interaction.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Body   string `json:"body,omitempty"`
+}
+
+// RecordedResponse is the sanitized response half of an Interaction.
+type RecordedResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// recordingTransport passes requests to next and appends each sanitized exchange to a cassette.
+type recordingTransport struct {
+	next http.RoundTripper
+
+	mu  sync.Mutex
+	out *os.File // nil once closed
+}
+
+// newRecordingTransport opens path for appending and returns a transport that records to it.
+func newRecordingTransport(next http.RoundTripper, path string) (*recordingTransport, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("opening recording file: %w", err)
+	}
+	return &recordingTransport{next: next, out: f}, nil
+}
+
+// close flushes the cassette to disk and closes it. Requests made afterwards are no
+// longer recorded.
+func (t *recordingTransport) close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.out == nil {
+		return nil
+	}
+	syncErr := t.out.Sync()
+	closeErr := t.out.Close()
+	t.out = nil
+	if err := errors.Join(syncErr, closeErr); err != nil {
+		return fmt.Errorf("closing recording file: %w", err)
+	}
+	return nil
+}
+
+// CloseIdleConnections closes the idle connections of the wrapped transport, so
+// http.Client.CloseIdleConnections reaches it.
+func (t *recordingTransport) CloseIdleConnections() {
+	if c, ok := t.next.(interface{ CloseIdleConnections() }); ok {
+		c.CloseIdleConnections()
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.RequestURI(),
+			Body:   sanitizeBody(reqBody),
+		},
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Headers:    recordHeaders(resp.Header),
+			Body:       sanitizeBody(respBody),
+		},
+	}
+
+	// Recording is best effort; a failed write must not fail the API call.
+	line, err := json.Marshal(interaction)
+	if err == nil {
+		t.mu.Lock()
+		if t.out != nil {
+			_, _ = t.out.Write(append(line, '\n'))
+		}
+		t.mu.Unlock()
+	}
+
+	return resp, nil
+}
+
+func recordHeaders(h http.Header) map[string]string {
+	headers := make(map[string]string)
+	for _, name := range recordedHeaders {
+		if v := h.Get(name); v != "" {
+			headers[name] = v
+		}
+	}
+	if len(headers) == 0 {
+		return nil
+	}
+	return headers
+}
+
+// sanitizeBody redacts credentials and secret values from a JSON body. Non-JSON
+// bodies are recorded as-is.
+func sanitizeBody(body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return string(body)
+	}
+
+	sanitized, err := json.Marshal(sanitizeValue(v))
+	if err != nil {
+		return string(body)
+	}
+	return string(sanitized)
+}
+
+func sanitizeValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		// Variables, env vars, and mounted files flag their own secret values.
+		secret, _ := val["secret"].(bool)
+		for k, child := range val {
+			switch {
+			case sensitiveJSONKeys[strings.ToLower(k)]:
+				if _, ok := child.(string); ok {
+					val[k] = redactedValue
+				}
+			case secret && (k == "value" || k == "content"):
+				val[k] = redactedValue
+			default:
+				val[k] = sanitizeValue(child)
+			}
+		}
+		return val
+	case []any:
+		for i := range val {
+			val[i] = sanitizeValue(val[i])
+		}
+		return val
+	default:
+		return v
+	}
+}
+
+// replayTransport serves responses from recorded interactions instead of the network.
+// Requests are matched on method and path; repeated requests to the same endpoint
+// receive the recorded responses in order.
+type replayTransport struct {
+	mu      sync.Mutex
+	pending map[string][]RecordedResponse
+}
+
+// newReplayTransport loads a cassette written by a recordingTransport.
+func newReplayTransport(path string) (*replayTransport, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening cassette: %w", err)
+	}
+	defer f.Close() //nolint:errcheck // read-only file
+
+	t := &replayTransport{pending: make(map[string][]RecordedResponse)}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var interaction Interaction
+		if err := json.Unmarshal(line, &interaction); err != nil {
+			return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+		}
+		key := interaction.Request.Method + " " + interaction.Request.Path
+		t.pending[key] = append(t.pending[key], interaction.Response)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading cassette %s: %w", path, err)
+	}
+	return t, nil
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	key := req.Method + " " + req.URL.RequestURI()
+
+	t.mu.Lock()
+	responses := t.pending[key]
+	if len(responses) == 0 {
+		t.mu.Unlock()
+		return nil, fmt.Errorf("no recorded interaction for %s", key)
+	}
+	recorded := responses[0]
+	t.pending[key] = responses[1:]
+	t.mu.Unlock()
+
+	header := make(http.Header)
+	for k, v := range recorded.Headers {
+		header.Set(k, v)
+	}
+
+	return &http.Response{
+		StatusCode:    recorded.StatusCode,
+		Status:        fmt.Sprintf("%d %s", recorded.StatusCode, http.StatusText(recorded.StatusCode)),
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(recorded.Body)),
+		ContentLength: int64(len(recorded.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,141 @@
+// ABOUTME: Bulk refresh snapshots that load every stack, space, or worker pool with one list call.
+// ABOUTME: Enabled with ClientConfig.BulkRefresh; the Get*Cached methods serve refresh reads from them.
+
+package zenfraclient
+
+import (
+	"context"
+	"sync"
+)
+
+// listPageSize is the page size used to list every stack into a snapshot.
+const listPageSize = 100
+
+// snapshot lazily lists all objects of one kind on first use and serves lookups by ID
+// from the result. A provider process lives for a single plan or apply, so the list is
+// made at most once per operation. A nil snapshot is disabled and never has an entry.
+type snapshot[T any] struct {
+	load func(ctx context.Context) (map[string]T, error)
+
+	mu     sync.Mutex
+	loaded bool
+	items  map[string]T
+}
+
+func newSnapshot[T any](load func(ctx context.Context) (map[string]T, error)) *snapshot[T] {
+	return &snapshot[T]{load: load}
+}
+
+// get returns the object with the given ID. Concurrent callers wait for the first one
+// to load the snapshot. If loading fails, the snapshot stays empty and every lookup
+// misses, so callers fall back to reading objects individually.
+func (s *snapshot[T]) get(ctx context.Context, id string) (*T, bool) {
+	if s == nil {
+		return nil, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.loaded {
+		s.loaded = true
+		// The snapshot holds the token's own organization. Objects of other organizations
+		// miss and are read individually with the caller's organization override.
+		if items, err := s.load(WithOrganization(ctx, "")); err == nil {
+			s.items = items
+		}
+	}
+
+	item, ok := s.items[id]
+	if !ok {
+		return nil, false
+	}
+	return &item, true
+}
+
+// invalidate drops the object with the given ID after it was changed or deleted.
+func (s *snapshot[T]) invalidate(id string) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.items, id)
+}
+
+// loadStacks lists every stack in the organization, page by page.
+func (c *Client) loadStacks(ctx context.Context) (map[string]Stack, error) {
+	items := make(map[string]Stack)
+	limit := listPageSize
+	for offset := 0; ; offset += limit {
+		page, err := c.ListStacks(ctx, &ListStacksOptions{Limit: &limit, Offset: &offset})
+		if err != nil {
+			return nil, err
+		}
+		for _, stack := range page {
+			items[stack.ID] = stack
+		}
+		if len(page) < limit {
+			return items, nil
+		}
+	}
+}
+
+// loadSpaces lists every space in the organization.
+func (c *Client) loadSpaces(ctx context.Context) (map[string]Space, error) {
+	spaces, err := c.ListSpaces(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[string]Space, len(spaces))
+	for _, space := range spaces {
+		items[space.ID] = space
+	}
+	return items, nil
+}
+
+// loadWorkerPools lists every worker pool in the organization.
+func (c *Client) loadWorkerPools(ctx context.Context) (map[string]WorkerPool, error) {
+	pools, err := c.ListWorkerPools(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[string]WorkerPool, len(pools))
+	for _, pool := range pools {
+		items[pool.ID] = pool
+	}
+	return items, nil
+}
+
+// IsBulkRefresh reports whether the client was configured with ClientConfig.BulkRefresh.
+func (c *Client) IsBulkRefresh() bool {
+	return c.stacks != nil
+}
+
+// GetStackCached returns a stack from the bulk refresh snapshot when BulkRefresh is
+// enabled and the snapshot has it, and otherwise calls GetStack. Use it only for
+// refresh, where data as old as the start of the operation is acceptable.
+func (c *Client) GetStackCached(ctx context.Context, id string) (*Stack, error) {
+	if stack, ok := c.stacks.get(ctx, id); ok {
+		return stack, nil
+	}
+	return c.GetStack(ctx, id)
+}
+
+// GetSpaceCached is the space counterpart of GetStackCached.
+func (c *Client) GetSpaceCached(ctx context.Context, id string) (*Space, error) {
+	if space, ok := c.spaces.get(ctx, id); ok {
+		return space, nil
+	}
+	return c.GetSpace(ctx, id)
+}
+
+// GetWorkerPoolCached is the worker pool counterpart of GetStackCached.
+func (c *Client) GetWorkerPoolCached(ctx context.Context, id string) (*WorkerPool, error) {
+	if pool, ok := c.workerPools.get(ctx, id); ok {
+		return pool, nil
+	}
+	return c.GetWorkerPool(ctx, id)
+}
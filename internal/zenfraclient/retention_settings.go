@@ -0,0 +1,35 @@
+// ABOUTME: Retention settings methods for the Zenfra API client.
+// ABOUTME: Implements reading, replacing, and resetting how long the organization keeps runs and logs.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetRetentionSettings retrieves the run and log retention of the authenticated user's organization.
+func (c *Client) GetRetentionSettings(ctx context.Context) (*RetentionSettings, error) {
+	settings, err := c.apiGetRetentionSettings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get retention settings: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdateRetentionSettings replaces the retention settings of the organization.
+func (c *Client) UpdateRetentionSettings(ctx context.Context, req UpdateRetentionSettingsRequest) (*RetentionSettings, error) {
+	settings, err := c.apiUpdateRetentionSettings(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("update retention settings: %w", err)
+	}
+	return settings, nil
+}
+
+// ResetRetentionSettings restores the default retention of the organization's plan.
+func (c *Client) ResetRetentionSettings(ctx context.Context) error {
+	if err := c.apiResetRetentionSettings(ctx); err != nil {
+		return fmt.Errorf("reset retention settings: %w", err)
+	}
+	return nil
+}
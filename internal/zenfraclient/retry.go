@@ -1,5 +1,5 @@
 // ABOUTME: Retry logic for transient HTTP failures with exponential backoff.
-// ABOUTME: Retries on 429 (respecting Retry-After), 502, 503, 504 status codes.
+// ABOUTME: Retries 429 (respecting Retry-After), 502, 503, 504, and 500/408 for idempotent requests.
 
 package zenfraclient
 
@@ -18,11 +18,17 @@ const (
 	defaultMaxRetries = 3
 )
 
+// RetryPolicy decides whether a request that got a response with statusCode is sent
+// again. method and header are those of the request. Network errors are always
+// retried, and the number of attempts is bounded by the configured maximum retries.
+type RetryPolicy func(method string, statusCode int, header http.Header) bool
+
 // retryConfig holds retry parameters.
 type retryConfig struct {
 	baseDelay  time.Duration
 	maxDelay   time.Duration
 	maxRetries int
+	policy     RetryPolicy
 }
 
 // defaultRetryConfig returns the default retry configuration.
@@ -31,10 +37,37 @@ func defaultRetryConfig() retryConfig {
 		baseDelay:  defaultBaseDelay,
 		maxDelay:   defaultMaxDelay,
 		maxRetries: defaultMaxRetries,
+		policy:     DefaultRetryPolicy,
+	}
+}
+
+// DefaultRetryPolicy retries rate limits (429) and gateway errors (502, 503, 504) for
+// every request. A 500 or 408 may come after the API has applied the request, so
+// those are only retried for idempotent methods, never for a POST or PATCH.
+func DefaultRetryPolicy(method string, statusCode int, _ http.Header) bool {
+	if isRetryableStatus(statusCode) {
+		return true
+	}
+	switch statusCode {
+	case http.StatusInternalServerError, http.StatusRequestTimeout:
+		return isIdempotent(method)
+	default:
+		return false
+	}
+}
+
+// isIdempotent reports whether repeating the request has the same effect as sending
+// it once.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
 	}
 }
 
-// isRetryableStatus returns true if the HTTP status code is retryable.
+// isRetryableStatus returns true if the HTTP status code is retryable for any method.
 func isRetryableStatus(statusCode int) bool {
 	switch statusCode {
 	case http.StatusTooManyRequests, // 429
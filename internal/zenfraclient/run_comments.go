@@ -0,0 +1,28 @@
+// ABOUTME: Run comment methods for the Zenfra API client.
+// ABOUTME: Comments annotate a run with free text and metadata and cannot be edited or deleted once posted.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateRunComment posts a comment on a run.
+func (c *Client) CreateRunComment(ctx context.Context, runID string, req CreateRunCommentRequest) (*RunComment, error) {
+	var comment RunComment
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/runs/"+runID+"/comments", req, &comment); err != nil {
+		return nil, fmt.Errorf("create run comment: %w", err)
+	}
+	return &comment, nil
+}
+
+// GetRunComment retrieves a comment on a run by ID.
+func (c *Client) GetRunComment(ctx context.Context, runID, commentID string) (*RunComment, error) {
+	var comment RunComment
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/runs/"+runID+"/comments/"+commentID, nil, &comment); err != nil {
+		return nil, fmt.Errorf("get run comment: %w", err)
+	}
+	return &comment, nil
+}
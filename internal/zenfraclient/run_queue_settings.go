@@ -0,0 +1,43 @@
+// ABOUTME: Run queue settings methods for the Zenfra API client.
+// ABOUTME: Implements reading, replacing, and resetting the organization's run concurrency limits.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const runQueueSettingsPath = "/api/v1/organizations/current/run-queue-settings"
+
+// GetRunQueueSettings retrieves the run queue settings of the authenticated user's organization.
+func (c *Client) GetRunQueueSettings(ctx context.Context) (*RunQueueSettings, error) {
+	var settings RunQueueSettings
+	if err := c.doJSON(ctx, http.MethodGet, runQueueSettingsPath, nil, &settings); err != nil {
+		return nil, fmt.Errorf("get run queue settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// UpdateRunQueueSettings replaces the run queue settings of the organization.
+func (c *Client) UpdateRunQueueSettings(ctx context.Context, req UpdateRunQueueSettingsRequest) (*RunQueueSettings, error) {
+	var settings RunQueueSettings
+	if err := c.doJSON(ctx, http.MethodPut, runQueueSettingsPath, req, &settings); err != nil {
+		return nil, fmt.Errorf("update run queue settings: %w", err)
+	}
+	return &settings, nil
+}
+
+// ResetRunQueueSettings restores the platform's default run queue settings for the organization.
+func (c *Client) ResetRunQueueSettings(ctx context.Context) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, runQueueSettingsPath, nil)
+	if err != nil {
+		return fmt.Errorf("reset run queue settings: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // best-effort close
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf("reset run queue settings: %w", err)
+	}
+	return nil
+}
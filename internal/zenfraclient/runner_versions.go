@@ -0,0 +1,45 @@
+// ABOUTME: Runner version methods for the Zenfra API client.
+// ABOUTME: Implements the runner version catalog and the organization's default version constraint.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListRunnerVersions returns the runner versions workers can be pinned to.
+func (c *Client) ListRunnerVersions(ctx context.Context) ([]RunnerVersion, error) {
+	resp, err := c.apiListRunnerVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list runner versions: %w", err)
+	}
+	return resp.Versions, nil
+}
+
+// GetRunnerVersionConstraint retrieves the organization's default runner version constraint.
+func (c *Client) GetRunnerVersionConstraint(ctx context.Context) (*RunnerVersionConstraint, error) {
+	constraint, err := c.apiGetRunnerVersionConstraint(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get runner version constraint: %w", err)
+	}
+	return constraint, nil
+}
+
+// UpdateRunnerVersionConstraint replaces the organization's default runner version constraint.
+func (c *Client) UpdateRunnerVersionConstraint(ctx context.Context, req UpdateRunnerVersionConstraintRequest) (*RunnerVersionConstraint, error) {
+	constraint, err := c.apiUpdateRunnerVersionConstraint(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("update runner version constraint: %w", err)
+	}
+	return constraint, nil
+}
+
+// ResetRunnerVersionConstraint removes the organization's default constraint, so pools
+// without a pin of their own run the latest runner.
+func (c *Client) ResetRunnerVersionConstraint(ctx context.Context) error {
+	if err := c.apiResetRunnerVersionConstraint(ctx); err != nil {
+		return fmt.Errorf("reset runner version constraint: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// ABOUTME: Run methods for the Zenfra API client.
+// ABOUTME: Reads a run's plan, cost estimate, policy checks, and log output, and lists or waits out a stack's active runs.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// GetRunPlan retrieves the structured plan for a run, including resource changes
+// and the raw plan JSON as produced by the IaC engine.
+func (c *Client) GetRunPlan(ctx context.Context, runID string) (*RunPlan, error) {
+	var plan RunPlan
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/runs/"+runID+"/plan", nil, &plan); err != nil {
+		return nil, fmt.Errorf("get run plan: %w", err)
+	}
+	return &plan, nil
+}
+
+// GetRunCostEstimate retrieves the cost estimate of a run's plan.
+func (c *Client) GetRunCostEstimate(ctx context.Context, runID string) (*RunCostEstimate, error) {
+	var estimate RunCostEstimate
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/runs/"+runID+"/cost-estimate", nil, &estimate); err != nil {
+		return nil, fmt.Errorf("get run cost estimate: %w", err)
+	}
+	return &estimate, nil
+}
+
+// ListRunPolicyResults returns the result of every policy evaluated against a run.
+func (c *Client) ListRunPolicyResults(ctx context.Context, runID string) ([]RunPolicyResult, error) {
+	var resp struct {
+		Items []RunPolicyResult `json:"items"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/runs/"+runID+"/policy-results", nil, &resp); err != nil {
+		return nil, fmt.Errorf("list run policy results: %w", err)
+	}
+	return resp.Items, nil
+}
+
+// GetRunLogs returns the page of a run's log lines following cursor. An empty cursor
+// reads from the start of the log.
+func (c *Client) GetRunLogs(ctx context.Context, runID, cursor string) (*RunLogPage, error) {
+	query := url.Values{}
+	if cursor != "" {
+		query.Set("cursor", cursor)
+	}
+	page, err := c.apiGetRunLogs(ctx, runID, query)
+	if err != nil {
+		return nil, fmt.Errorf("get run logs: %w", err)
+	}
+	return page, nil
+}
+
+// StreamRunLogs calls fn with each new batch of a run's log lines, polling every
+// interval while the run is still writing its log, and returns once the log is
+// complete. It stops early with fn's error or when ctx is done.
+func (c *Client) StreamRunLogs(ctx context.Context, runID string, interval time.Duration, fn func([]RunLogLine) error) error {
+	cursor := ""
+	for {
+		page, err := c.GetRunLogs(ctx, runID, cursor)
+		if err != nil {
+			return fmt.Errorf("stream run logs: %w", err)
+		}
+		if len(page.Lines) > 0 {
+			if err := fn(page.Lines); err != nil {
+				return err
+			}
+		}
+		if page.NextCursor != "" {
+			cursor = page.NextCursor
+		}
+		if page.Complete {
+			return nil
+		}
+		// A full page means more lines are waiting; only wait once caught up.
+		if len(page.Lines) == 0 {
+			if err := sleepWithContext(ctx, interval); err != nil {
+				return fmt.Errorf("stream run logs: %w", err)
+			}
+		}
+	}
+}
+
+// TailRunLogs returns the last n lines a run has logged so far in phase, or in any
+// phase if phase is empty, and whether its log is complete. It reads the log without
+// waiting for the run to finish.
+func (c *Client) TailRunLogs(ctx context.Context, runID, phase string, n int) ([]RunLogLine, bool, error) {
+	var tail []RunLogLine
+	cursor := ""
+	for {
+		page, err := c.GetRunLogs(ctx, runID, cursor)
+		if err != nil {
+			return nil, false, fmt.Errorf("tail run logs: %w", err)
+		}
+		for _, line := range page.Lines {
+			if phase == "" || strings.EqualFold(line.Phase, phase) {
+				tail = append(tail, line)
+			}
+		}
+		if len(tail) > n {
+			tail = tail[len(tail)-n:]
+		}
+		if page.Complete || len(page.Lines) == 0 || page.NextCursor == "" || page.NextCursor == cursor {
+			return tail, page.Complete, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// ListActiveStackRuns returns a stack's queued and running runs, oldest first.
+func (c *Client) ListActiveStackRuns(ctx context.Context, stackID string) ([]ActiveRun, error) {
+	resp, err := c.apiListStackRuns(ctx, stackID, url.Values{"state": {"active"}})
+	if err != nil {
+		return nil, fmt.Errorf("list active stack runs: %w", err)
+	}
+	return resp.Items, nil
+}
+
+// WaitForStackIdle polls a stack's active runs every interval until it has none. It
+// fails if ctx is done first; bound the wait with a context deadline.
+func (c *Client) WaitForStackIdle(ctx context.Context, stackID string, interval time.Duration) error {
+	for {
+		runs, err := c.ListActiveStackRuns(ctx, stackID)
+		if err != nil {
+			return fmt.Errorf("wait for stack idle: %w", err)
+		}
+		if len(runs) == 0 {
+			return nil
+		}
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return fmt.Errorf("wait for stack idle: stack %s still has run %s %s: %w", stackID, runs[0].ID, runs[0].Status, err)
+		}
+	}
+}
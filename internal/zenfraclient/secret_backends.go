@@ -0,0 +1,91 @@
+// ABOUTME: Secret backend and bundle secret reference methods for the Zenfra API client.
+// ABOUTME: Backends connect Vault or AWS Secrets Manager; references expose their secrets to runs through bundles.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateSecretBackend creates a new secret backend.
+func (c *Client) CreateSecretBackend(ctx context.Context, req CreateSecretBackendRequest) (*SecretBackend, error) {
+	var backend SecretBackend
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/secret-backends", req, &backend); err != nil {
+		return nil, fmt.Errorf("create secret backend: %w", err)
+	}
+	return &backend, nil
+}
+
+// GetSecretBackend retrieves a secret backend by ID.
+func (c *Client) GetSecretBackend(ctx context.Context, id string) (*SecretBackend, error) {
+	var backend SecretBackend
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/secret-backends/"+id, nil, &backend); err != nil {
+		return nil, fmt.Errorf("get secret backend: %w", err)
+	}
+	return &backend, nil
+}
+
+// UpdateSecretBackend updates an existing secret backend.
+func (c *Client) UpdateSecretBackend(ctx context.Context, id string, req UpdateSecretBackendRequest) (*SecretBackend, error) {
+	var backend SecretBackend
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v1/secret-backends/"+id, req, &backend); err != nil {
+		return nil, fmt.Errorf("update secret backend: %w", err)
+	}
+	return &backend, nil
+}
+
+// DeleteSecretBackend deletes a secret backend by ID. The API rejects the request
+// with a ConflictError while bundle secret references still use the backend.
+func (c *Client) DeleteSecretBackend(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/secret-backends/"+id, nil)
+	if err != nil {
+		return fmt.Errorf("delete secret backend: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // best-effort close
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf("delete secret backend: %w", err)
+	}
+	return nil
+}
+
+// CreateBundleSecretReference adds a secret reference to a bundle.
+func (c *Client) CreateBundleSecretReference(ctx context.Context, bundleID string, req BundleSecretReferenceRequest) (*BundleSecretReference, error) {
+	var ref BundleSecretReference
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/bundles/"+bundleID+"/secret-references", req, &ref); err != nil {
+		return nil, fmt.Errorf("create bundle secret reference: %w", err)
+	}
+	return &ref, nil
+}
+
+// GetBundleSecretReference retrieves a secret reference of a bundle by ID.
+func (c *Client) GetBundleSecretReference(ctx context.Context, bundleID, id string) (*BundleSecretReference, error) {
+	var ref BundleSecretReference
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/bundles/"+bundleID+"/secret-references/"+id, nil, &ref); err != nil {
+		return nil, fmt.Errorf("get bundle secret reference: %w", err)
+	}
+	return &ref, nil
+}
+
+// UpdateBundleSecretReference replaces a secret reference of a bundle.
+func (c *Client) UpdateBundleSecretReference(ctx context.Context, bundleID, id string, req BundleSecretReferenceRequest) (*BundleSecretReference, error) {
+	var ref BundleSecretReference
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/bundles/"+bundleID+"/secret-references/"+id, req, &ref); err != nil {
+		return nil, fmt.Errorf("update bundle secret reference: %w", err)
+	}
+	return &ref, nil
+}
+
+// DeleteBundleSecretReference removes a secret reference from a bundle.
+func (c *Client) DeleteBundleSecretReference(ctx context.Context, bundleID, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/bundles/"+bundleID+"/secret-references/"+id, nil)
+	if err != nil {
+		return fmt.Errorf("delete bundle secret reference: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // best-effort close
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf("delete bundle secret reference: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// ABOUTME: Signing key CRUD methods for the Zenfra API client.
+// ABOUTME: Signing keys are organization public keys used to verify module and provider uploads.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// CreateSigningKey registers a new signing key.
+func (c *Client) CreateSigningKey(ctx context.Context, req CreateSigningKeyRequest) (*SigningKey, error) {
+	var key SigningKey
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/signing-keys", req, &key); err != nil {
+		return nil, fmt.Errorf("create signing key: %w", err)
+	}
+	return &key, nil
+}
+
+// GetSigningKey retrieves a signing key by ID.
+func (c *Client) GetSigningKey(ctx context.Context, id string) (*SigningKey, error) {
+	var key SigningKey
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/signing-keys/"+id, nil, &key); err != nil {
+		return nil, fmt.Errorf("get signing key: %w", err)
+	}
+	return &key, nil
+}
+
+// ListSigningKeys returns all signing keys in the organization.
+func (c *Client) ListSigningKeys(ctx context.Context) ([]SigningKey, error) {
+	var resp struct {
+		Items []SigningKey `json:"items"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/signing-keys", nil, &resp); err != nil {
+		return nil, fmt.Errorf("list signing keys: %w", err)
+	}
+	return resp.Items, nil
+}
+
+// UpdateSigningKey updates an existing signing key.
+func (c *Client) UpdateSigningKey(ctx context.Context, id string, req UpdateSigningKeyRequest) (*SigningKey, error) {
+	var key SigningKey
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v1/signing-keys/"+id, req, &key); err != nil {
+		return nil, fmt.Errorf("update signing key: %w", err)
+	}
+	return &key, nil
+}
+
+// DeleteSigningKey deletes a signing key by ID.
+func (c *Client) DeleteSigningKey(ctx context.Context, id string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/signing-keys/"+id, nil)
+	if err != nil {
+		return fmt.Errorf("delete signing key: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // best-effort close
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf("delete signing key: %w", err)
+	}
+	return nil
+}
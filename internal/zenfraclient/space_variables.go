@@ -0,0 +1,41 @@
+// ABOUTME: Space variable methods for the Zenfra API client.
+// ABOUTME: Space variables are inherited by every stack in the space; stack variables with the same key win.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetSpaceVariables retrieves the variables set directly on a space. Variables inherited
+// from parent spaces are not included. Secret values are withheld, as for GetStackVariables.
+func (c *Client) GetSpaceVariables(ctx context.Context, spaceID string) ([]StackVariable, error) {
+	var resp GetSpaceVariablesResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/spaces/"+spaceID+"/variables", nil, &resp); err != nil {
+		return nil, fmt.Errorf("get space variables: %w", err)
+	}
+	c.spaceVariables.put(spaceID, resp.Variables)
+	return resp.Variables, nil
+}
+
+// GetSpaceVariablesCached is the space counterpart of GetStackVariablesCached.
+func (c *Client) GetSpaceVariablesCached(ctx context.Context, spaceID string) ([]StackVariable, error) {
+	if vars, ok := c.spaceVariables.get(spaceID); ok {
+		return vars, nil
+	}
+	return c.GetSpaceVariables(ctx, spaceID)
+}
+
+// SetSpaceVariables replaces all variables set directly on a space.
+// This is a replace-all operation; missing keys are deleted.
+func (c *Client) SetSpaceVariables(ctx context.Context, spaceID string, vars []StackVariable) ([]StackVariable, error) {
+	req := SetSpaceVariablesRequest{Variables: vars}
+	var resp GetSpaceVariablesResponse
+	defer c.spaceVariables.invalidate(spaceID)
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/spaces/"+spaceID+"/variables", req, &resp); err != nil {
+		return nil, fmt.Errorf("set space variables: %w", err)
+	}
+	return resp.Variables, nil
+}
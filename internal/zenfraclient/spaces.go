@@ -1,5 +1,5 @@
 // ABOUTME: Space CRUD methods for the Zenfra API client.
-// ABOUTME: Implements CreateSpace, GetSpace, ListSpaces, UpdateSpace, DeleteSpace.
+// ABOUTME: Implements CreateSpace, GetSpace, ListSpaces, UpdateSpace, DeleteSpace, DeleteSpaceRecursive.
 
 package zenfraclient
 
@@ -27,12 +27,12 @@ func (c *Client) GetSpace(ctx context.Context, id string) (*Space, error) {
 	return &space, nil
 }
 
-// ListSpaces returns all spaces in the organization.
-func (c *Client) ListSpaces(ctx context.Context) ([]Space, error) {
+// ListSpaces returns all spaces in the organization. opts may be nil.
+func (c *Client) ListSpaces(ctx context.Context, opts *ListOptions) ([]Space, error) {
 	var resp struct {
 		Items []Space `json:"items"`
 	}
-	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/spaces", nil, &resp); err != nil {
+	if err := c.doJSON(ctx, http.MethodGet, listPath("/api/v1/spaces", opts), nil, &resp); err != nil {
 		return nil, fmt.Errorf("list spaces: %w", err)
 	}
 	return resp.Items, nil
@@ -41,15 +41,28 @@ func (c *Client) ListSpaces(ctx context.Context) ([]Space, error) {
 // UpdateSpace updates an existing space.
 func (c *Client) UpdateSpace(ctx context.Context, id string, req UpdateSpaceRequest) (*Space, error) {
 	var space Space
+	defer c.spaces.invalidate(id)
 	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/spaces/"+id, req, &space); err != nil {
 		return nil, fmt.Errorf("update space: %w", err)
 	}
 	return &space, nil
 }
 
-// DeleteSpace deletes a space by ID.
+// DeleteSpace deletes a space by ID. The API rejects the request with a
+// ConflictError listing the blockers when the space still has children or stacks.
 func (c *Client) DeleteSpace(ctx context.Context, id string) error {
-	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/spaces/"+id, nil)
+	defer c.spaces.invalidate(id)
+	return c.deleteSpace(ctx, "/api/v1/spaces/"+id)
+}
+
+// DeleteSpaceRecursive deletes a space by ID together with all of its child spaces and stacks.
+func (c *Client) DeleteSpaceRecursive(ctx context.Context, id string) error {
+	defer c.spaces.invalidate(id)
+	return c.deleteSpace(ctx, "/api/v1/spaces/"+id+"?recursive=true")
+}
+
+func (c *Client) deleteSpace(ctx context.Context, path string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return fmt.Errorf("delete space: %w", err)
 	}
@@ -0,0 +1,42 @@
+// ABOUTME: Stack dependency graph methods for the Zenfra API client.
+// ABOUTME: Implements reading the run trigger and kv reference edges between stacks.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// StackDependencyGraphOptions are optional query parameters for reading the dependency graph.
+type StackDependencyGraphOptions struct {
+	// SpaceID limits the graph to stacks in a space and the stacks they are connected to.
+	SpaceID *string
+	// StackID limits the graph to the stacks reachable from a stack, in either direction.
+	StackID *string
+}
+
+// GetStackDependencyGraph returns the dependency graph between stacks, optionally filtered.
+func (c *Client) GetStackDependencyGraph(ctx context.Context, opts *StackDependencyGraphOptions) (*StackDependencyGraph, error) {
+	path := "/api/v1/stacks/dependency-graph"
+	query := url.Values{}
+	if opts != nil {
+		if opts.SpaceID != nil {
+			query.Set("space_id", *opts.SpaceID)
+		}
+		if opts.StackID != nil {
+			query.Set("stack_id", *opts.StackID)
+		}
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	var graph StackDependencyGraph
+	if err := c.doJSON(ctx, http.MethodGet, path, nil, &graph); err != nil {
+		return nil, fmt.Errorf("get stack dependency graph: %w", err)
+	}
+	return &graph, nil
+}
@@ -0,0 +1,48 @@
+// ABOUTME: Stack manifest methods for the Zenfra API client.
+// ABOUTME: A manifest is the YAML or JSON description of a stack that app repositories keep as zenfra.yaml.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// ValidateStackManifest parses and checks a stack manifest without creating or changing
+// a stack, and returns its normalized form and the stack it describes.
+func (c *Client) ValidateStackManifest(ctx context.Context, req ValidateStackManifestRequest) (*StackManifestValidation, error) {
+	result, err := c.apiValidateStackManifest(asRead(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("validate stack manifest: %w", err)
+	}
+	return result, nil
+}
+
+// CreateStackFromManifest creates a stack from a manifest.
+func (c *Client) CreateStackFromManifest(ctx context.Context, req StackManifestRequest) (*StackManifest, error) {
+	manifest, err := c.apiCreateStackFromManifest(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("create stack from manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// GetStackManifest retrieves a stack with the normalized manifest that describes its
+// current settings, including changes made outside the manifest.
+func (c *Client) GetStackManifest(ctx context.Context, stackID string) (*StackManifest, error) {
+	manifest, err := c.apiGetStackManifest(ctx, stackID)
+	if err != nil {
+		return nil, fmt.Errorf("get stack manifest: %w", err)
+	}
+	return manifest, nil
+}
+
+// UpdateStackFromManifest replaces a stack's settings with those of a manifest.
+// Settings the manifest leaves out are reset to their defaults.
+func (c *Client) UpdateStackFromManifest(ctx context.Context, stackID string, req StackManifestRequest) (*StackManifest, error) {
+	manifest, err := c.apiUpdateStackFromManifest(ctx, stackID, req)
+	if err != nil {
+		return nil, fmt.Errorf("update stack from manifest: %w", err)
+	}
+	return manifest, nil
+}
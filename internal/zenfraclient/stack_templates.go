@@ -0,0 +1,21 @@
+// ABOUTME: Stack template methods for the Zenfra API client.
+// ABOUTME: Implements listing the blueprint templates new stacks can be initialized from.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListStackTemplates returns the stack templates available to the organization.
+func (c *Client) ListStackTemplates(ctx context.Context) ([]StackTemplate, error) {
+	var resp struct {
+		Items []StackTemplate `json:"items"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/stack-templates", nil, &resp); err != nil {
+		return nil, fmt.Errorf("list stack templates: %w", err)
+	}
+	return resp.Items, nil
+}
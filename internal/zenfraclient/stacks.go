@@ -7,6 +7,9 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 )
 
 // CreateStack creates a new stack.
@@ -20,37 +23,81 @@ func (c *Client) CreateStack(ctx context.Context, req CreateStackRequest) (*Stac
 
 // GetStack retrieves a stack by ID.
 func (c *Client) GetStack(ctx context.Context, id string) (*Stack, error) {
+	return c.GetStackFields(ctx, id, nil)
+}
+
+// GetStackFields retrieves a stack by ID with only the given fields populated. See Fields.
+func (c *Client) GetStackFields(ctx context.Context, id string, fields Fields) (*Stack, error) {
 	var stack Stack
-	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/stacks/"+id, nil, &stack); err != nil {
+	if err := c.doJSON(ctx, http.MethodGet, withQuery("/api/v1/stacks/"+id, fields, url.Values{}), nil, &stack); err != nil {
 		return nil, fmt.Errorf("get stack: %w", err)
 	}
 	return &stack, nil
 }
 
+// GetStackHealth retrieves the health of a stack: whether it has drifted, its latest
+// run failed, or it is locked.
+func (c *Client) GetStackHealth(ctx context.Context, id string) (*StackHealth, error) {
+	health, err := c.apiGetStackHealth(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get stack health: %w", err)
+	}
+	return health, nil
+}
+
+// WaitForStackReady polls a stack every interval until its status is ready and
+// returns the ready stack. It fails if the stack reports a failed status or ctx
+// is done first; bound the wait with a context deadline. Stacks created by an
+// API that does not report a status are treated as ready.
+func (c *Client) WaitForStackReady(ctx context.Context, id string, interval time.Duration) (*Stack, error) {
+	for {
+		stack, err := c.GetStack(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("wait for stack ready: %w", err)
+		}
+
+		switch stack.Status {
+		case StackStatusReady, "":
+			return stack, nil
+		case StackStatusFailed:
+			return stack, fmt.Errorf("wait for stack ready: stack %s failed: %s", id, stack.StatusReason)
+		}
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return stack, fmt.Errorf("wait for stack ready: stack %s still %s: %w", id, stack.Status, err)
+		}
+	}
+}
+
 // ListStacksOptions are optional query parameters for listing stacks.
 type ListStacksOptions struct {
-	SpaceID *string
-	Limit   *int
-	Offset  *int
+	SpaceID         *string
+	EnvironmentType *string
+	Limit           *int
+	Offset          *int
+	Fields          Fields
 }
 
 // ListStacks returns stacks in the organization, optionally filtered.
 func (c *Client) ListStacks(ctx context.Context, opts *ListStacksOptions) ([]Stack, error) {
-	path := "/api/v1/stacks"
-	sep := "?"
+	query := url.Values{}
+	var fields Fields
 	if opts != nil {
 		if opts.SpaceID != nil {
-			path += sep + "space_id=" + *opts.SpaceID
-			sep = "&"
+			query.Set("space_id", *opts.SpaceID)
+		}
+		if opts.EnvironmentType != nil {
+			query.Set("environment_type", *opts.EnvironmentType)
 		}
 		if opts.Limit != nil {
-			path += sep + fmt.Sprintf("limit=%d", *opts.Limit)
-			sep = "&"
+			query.Set("limit", strconv.Itoa(*opts.Limit))
 		}
 		if opts.Offset != nil {
-			path += sep + fmt.Sprintf("offset=%d", *opts.Offset)
+			query.Set("offset", strconv.Itoa(*opts.Offset))
 		}
+		fields = opts.Fields
 	}
+	path := withQuery("/api/v1/stacks", fields, query)
 
 	var resp struct {
 		Items []Stack `json:"items"`
@@ -64,15 +111,38 @@ func (c *Client) ListStacks(ctx context.Context, opts *ListStacksOptions) ([]Sta
 // UpdateStack updates an existing stack.
 func (c *Client) UpdateStack(ctx context.Context, id string, req UpdateStackRequest) (*Stack, error) {
 	var stack Stack
+	defer c.stacks.invalidate(id)
 	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/stacks/"+id, req, &stack); err != nil {
 		return nil, fmt.Errorf("update stack: %w", err)
 	}
 	return &stack, nil
 }
 
-// DeleteStack deletes a stack by ID.
-func (c *Client) DeleteStack(ctx context.Context, id string) error {
-	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/stacks/"+id, nil)
+// DeleteStackOptions are optional query parameters for deleting a stack.
+type DeleteStackOptions struct {
+	// Force cancels queued and running runs instead of refusing to delete the stack.
+	Force bool
+	// DetachBundles detaches attached configuration bundles as part of the delete.
+	DetachBundles bool
+}
+
+// DeleteStack deletes a stack by ID. Without options, the API refuses (409) to delete a
+// stack that has active runs or attached bundles.
+func (c *Client) DeleteStack(ctx context.Context, id string, opts *DeleteStackOptions) error {
+	path := "/api/v1/stacks/" + id
+	sep := "?"
+	if opts != nil {
+		if opts.Force {
+			path += sep + "force=true"
+			sep = "&"
+		}
+		if opts.DetachBundles {
+			path += sep + "detach_bundles=true"
+		}
+	}
+
+	defer c.stacks.invalidate(id)
+	resp, err := c.doRequest(ctx, http.MethodDelete, path, nil)
 	if err != nil {
 		return fmt.Errorf("delete stack: %w", err)
 	}
@@ -84,12 +154,13 @@ func (c *Client) DeleteStack(ctx context.Context, id string) error {
 }
 
 // GetStackVariables retrieves the environment variables for a stack.
-// Secret values are returned masked as "****".
+// Secret values are withheld; see StackVariable.ValueMasked.
 func (c *Client) GetStackVariables(ctx context.Context, stackID string) ([]StackVariable, error) {
 	var resp GetStackVariablesResponse
 	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/stacks/"+stackID+"/variables", nil, &resp); err != nil {
 		return nil, fmt.Errorf("get stack variables: %w", err)
 	}
+	c.variables.put(stackID, resp.Variables)
 	return resp.Variables, nil
 }
 
@@ -98,6 +169,7 @@ func (c *Client) GetStackVariables(ctx context.Context, stackID string) ([]Stack
 func (c *Client) SetStackVariables(ctx context.Context, stackID string, vars []StackVariable) ([]StackVariable, error) {
 	req := SetStackVariablesRequest{Variables: vars}
 	var resp GetStackVariablesResponse
+	defer c.variables.invalidate(stackID)
 	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/stacks/"+stackID+"/variables", req, &resp); err != nil {
 		return nil, fmt.Errorf("set stack variables: %w", err)
 	}
@@ -106,6 +178,7 @@ func (c *Client) SetStackVariables(ctx context.Context, stackID string, vars []S
 
 // SetStackSource updates the source configuration for a stack.
 func (c *Client) SetStackSource(ctx context.Context, stackID string, source StackSource) error {
+	defer c.stacks.invalidate(stackID)
 	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/stacks/"+stackID+"/source", source, nil); err != nil {
 		return fmt.Errorf("set stack source: %w", err)
 	}
@@ -114,6 +187,7 @@ func (c *Client) SetStackSource(ctx context.Context, stackID string, source Stac
 
 // SetStackTriggers updates the trigger configuration for a stack.
 func (c *Client) SetStackTriggers(ctx context.Context, stackID string, triggers StackTriggers) error {
+	defer c.stacks.invalidate(stackID)
 	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/stacks/"+stackID+"/triggers", triggers, nil); err != nil {
 		return fmt.Errorf("set stack triggers: %w", err)
 	}
@@ -0,0 +1,31 @@
+// ABOUTME: Stack state snapshot methods for the Zenfra API client.
+// ABOUTME: Implements listing stored state versions and restoring a stack to a previous snapshot.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ListStateSnapshots returns the stored state snapshots for a stack, newest first.
+func (c *Client) ListStateSnapshots(ctx context.Context, stackID string) ([]StateSnapshot, error) {
+	var resp struct {
+		Items []StateSnapshot `json:"items"`
+	}
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/stacks/"+stackID+"/state/snapshots", nil, &resp); err != nil {
+		return nil, fmt.Errorf("list state snapshots: %w", err)
+	}
+	return resp.Items, nil
+}
+
+// RollbackState restores a stack's state to the given snapshot. The API rejects the
+// request with a conflict while a run holds the stack's state lock.
+func (c *Client) RollbackState(ctx context.Context, stackID string, req RollbackStateRequest) (*StateRollback, error) {
+	var rollback StateRollback
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/stacks/"+stackID+"/state/rollback", req, &rollback); err != nil {
+		return nil, fmt.Errorf("rollback state: %w", err)
+	}
+	return &rollback, nil
+}
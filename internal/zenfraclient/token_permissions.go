@@ -0,0 +1,43 @@
+// ABOUTME: Token permission methods for the Zenfra API client.
+// ABOUTME: The permissions are read once per client so resources can check them at plan time without extra calls.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// tokenPermissionsCache holds the first result of reading the token's permissions,
+// including a failure: an API without the permissions endpoint will not grow one
+// during a Terraform operation.
+type tokenPermissionsCache struct {
+	once        sync.Once
+	permissions *TokenPermissions
+	err         error
+}
+
+// CanManage reports whether the token may create, update, and delete objects of kind.
+func (p *TokenPermissions) CanManage(kind string) bool {
+	return slices.Contains(p.Manage, kind) || slices.Contains(p.Manage, "*")
+}
+
+// GetTokenPermissions reads the role and permissions of the client's API token.
+func (c *Client) GetTokenPermissions(ctx context.Context) (*TokenPermissions, error) {
+	permissions, err := c.apiGetTokenPermissions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get token permissions: %w", err)
+	}
+	return permissions, nil
+}
+
+// GetTokenPermissionsCached returns the token's permissions, reading them on the first
+// call only, so the first plan-time permission check reads them for every resource.
+func (c *Client) GetTokenPermissionsCached(ctx context.Context) (*TokenPermissions, error) {
+	c.permissions.once.Do(func() {
+		c.permissions.permissions, c.permissions.err = c.GetTokenPermissions(ctx)
+	})
+	return c.permissions.permissions, c.permissions.err
+}
@@ -0,0 +1,92 @@
+// ABOUTME: OpenTelemetry instrumentation for Zenfra API calls, enabled with ClientConfig.TracerProvider.
+// ABOUTME: Starts a client span per API call and propagates its W3C trace context to the API.
+
+package zenfraclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// tracerName is the instrumentation scope reported on spans.
+const tracerName = "github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+
+// Span attribute keys, following the OpenTelemetry HTTP client conventions where one exists.
+const (
+	attrHTTPMethod      = attribute.Key("http.request.method")
+	attrHTTPStatusCode  = attribute.Key("http.response.status_code")
+	attrHTTPResendCount = attribute.Key("http.request.resend_count")
+	attrServerAddress   = attribute.Key("server.address")
+	attrURLPath         = attribute.Key("url.path")
+	attrRequestID       = attribute.Key("zenfra.request_id")
+)
+
+// tracing holds the tracer and propagator used by a Client. The zero value traces nothing.
+type tracing struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+// newTracing returns the tracing setup for tp. A nil tp disables tracing.
+func newTracing(tp trace.TracerProvider) tracing {
+	if tp == nil {
+		return tracing{tracer: noop.NewTracerProvider().Tracer(tracerName)}
+	}
+	return tracing{
+		tracer:     tp.Tracer(tracerName),
+		propagator: propagation.TraceContext{},
+	}
+}
+
+// startSpan starts the client span covering one API call, including its retries.
+func (t tracing) startSpan(ctx context.Context, method, rawURL string) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{attrHTTPMethod.String(method)}
+	if u, err := url.Parse(rawURL); err == nil {
+		attrs = append(attrs, attrServerAddress.String(u.Hostname()), attrURLPath.String(u.Path))
+	}
+	return t.tracer.Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// inject adds the traceparent header for the span in ctx to req.
+func (t tracing) inject(ctx context.Context, req *http.Request) {
+	if t.propagator != nil {
+		t.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+	}
+}
+
+// recordAttempt records the outcome of one attempt on span. Later attempts overwrite
+// the attributes of earlier ones, so the span ends up describing the last attempt.
+func recordAttempt(span trace.Span, attempt int, resp *http.Response, err error) {
+	if attempt > 0 {
+		span.SetAttributes(attrHTTPResendCount.Int(attempt))
+	}
+	if err != nil {
+		return
+	}
+	span.SetAttributes(attrHTTPStatusCode.Int(resp.StatusCode))
+	if id := resp.Header.Get("X-Request-ID"); id != "" {
+		span.SetAttributes(attrRequestID.String(id))
+	}
+}
+
+// endSpan sets the status of span from the final outcome of the call and ends it.
+// A span's status cannot be cleared once set, so it is only set here.
+func endSpan(span trace.Span, resp *http.Response, err error) {
+	switch {
+	case err != nil:
+		span.SetStatus(codes.Error, err.Error())
+	case resp.StatusCode >= http.StatusBadRequest:
+		span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+	}
+	span.End()
+}
@@ -0,0 +1,46 @@
+// ABOUTME: Builds the HTTP transport used by the client, with tunable connection pooling.
+// ABOUTME: Keeps connections to the API alive and reused across the many requests of a large apply.
+
+package zenfraclient
+
+import (
+	"net/http"
+	"time"
+)
+
+const (
+	// The net/http default of 2 idle connections per host forces most concurrent
+	// requests of a large apply to open new TLS connections.
+	defaultMaxIdleConnsPerHost = 32
+	defaultIdleConnTimeout     = 90 * time.Second
+)
+
+// newTransport returns a copy of http.DefaultTransport tuned by cfg.
+func newTransport(cfg ClientConfig) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	maxIdle := cfg.MaxIdleConnsPerHost
+	if maxIdle <= 0 {
+		maxIdle = defaultMaxIdleConnsPerHost
+	}
+	t.MaxIdleConnsPerHost = maxIdle
+	if t.MaxIdleConns < maxIdle {
+		t.MaxIdleConns = maxIdle
+	}
+
+	t.IdleConnTimeout = cfg.IdleConnTimeout
+	if t.IdleConnTimeout <= 0 {
+		t.IdleConnTimeout = defaultIdleConnTimeout
+	}
+
+	t.DisableKeepAlives = cfg.DisableKeepAlives
+
+	if cfg.DisableHTTP2 {
+		protocols := new(http.Protocols)
+		protocols.SetHTTP1(true)
+		t.Protocols = protocols
+		t.ForceAttemptHTTP2 = false
+	}
+
+	return t
+}
@@ -3,7 +3,10 @@
 
 package zenfraclient
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 // --- Space types ---
 
@@ -24,6 +27,9 @@ type Space struct {
 	UpdatedAt      time.Time  `json:"updated_at"`
 	UpdatedBy      string     `json:"updated_by"`
 	DeletedAt      *time.Time `json:"deleted_at,omitempty"`
+
+	// ManagedLock is nil when the space has never been locked.
+	ManagedLock *ManagedLock `json:"managed_lock,omitempty"`
 }
 
 // CreateSpaceRequest is the request body for creating a space.
@@ -45,6 +51,25 @@ type UpdateSpaceRequest struct {
 
 // --- Stack types ---
 
+// IaC engines a stack can run.
+const (
+	IACEngineTerraform = "terraform"
+	IACEngineOpenTofu  = "opentofu"
+)
+
+// Stack source types.
+const (
+	StackSourceTypeRawGit = "raw_git"
+	StackSourceTypeVCS    = "vcs"
+)
+
+// Source ref types: what a stack's source checks out.
+const (
+	SourceRefBranch = "branch"
+	SourceRefTag    = "tag"
+	SourceRefCommit = "commit"
+)
+
 // IACConfig represents the Infrastructure as Code tool configuration.
 type IACConfig struct {
 	Engine  string `json:"engine"`
@@ -57,11 +82,23 @@ type StackSourceRef struct {
 	Name string `json:"name"`
 }
 
-// StackSourceRawGit is a public HTTPS git source.
+// StackSourceRawGit is a git source cloned by URL, either public or authenticated with
+// an SSH key or HTTPS credentials.
 type StackSourceRawGit struct {
 	URL  string         `json:"url"`
 	Ref  StackSourceRef `json:"ref"`
 	Path string         `json:"path,omitempty"`
+
+	// SSHKey is the private key to clone an SSH URL with. The API never returns it.
+	SSHKey string `json:"ssh_key,omitempty"`
+	// HTTPSCredentials authenticate an HTTPS URL. The API returns only the username.
+	HTTPSCredentials *StackSourceHTTPSCredentials `json:"https_credentials,omitempty"`
+}
+
+// StackSourceHTTPSCredentials are a username and access token for cloning over HTTPS.
+type StackSourceHTTPSCredentials struct {
+	Username string `json:"username"`
+	Token    string `json:"token,omitempty"`
 }
 
 // StackSourceVCS is an integration-backed VCS source.
@@ -82,13 +119,46 @@ type StackSource struct {
 
 // StackTriggerOnPush configures push-based automation triggers.
 type StackTriggerOnPush struct {
-	Enabled bool     `json:"enabled"`
-	Paths   []string `json:"paths,omitempty"`
+	Enabled     bool     `json:"enabled"`
+	Paths       []string `json:"paths,omitempty"`
+	Branches    []string `json:"branches,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	IgnorePaths []string `json:"ignore_paths,omitempty"`
+}
+
+// StackTriggerOnPullRequest configures pull/merge request automation triggers.
+type StackTriggerOnPullRequest struct {
+	Enabled         bool     `json:"enabled"`
+	SpeculativeOnly bool     `json:"speculative_only"`
+	CommentResults  bool     `json:"comment_results"`
+	Paths           []string `json:"paths,omitempty"`
+}
+
+// Run types of a scheduled trigger.
+const (
+	ScheduleRunTypePlan  = "plan"
+	ScheduleRunTypeApply = "apply"
+)
+
+// StackTriggerOnSchedule starts a run of RunType at the times of a five-field cron
+// expression, evaluated in UTC.
+type StackTriggerOnSchedule struct {
+	Cron    string `json:"cron"`
+	RunType string `json:"run_type"`
 }
 
 // StackTriggers configures what events can automatically create runs.
 type StackTriggers struct {
-	OnPush StackTriggerOnPush `json:"on_push"`
+	OnPush        StackTriggerOnPush        `json:"on_push"`
+	OnPullRequest StackTriggerOnPullRequest `json:"on_pull_request"`
+	OnSchedule    []StackTriggerOnSchedule  `json:"on_schedule,omitempty"`
+}
+
+// StackHooks lists shell commands executed at fixed points of a run.
+type StackHooks struct {
+	BeforeInit []string `json:"before_init,omitempty"`
+	BeforePlan []string `json:"before_plan,omitempty"`
+	AfterApply []string `json:"after_apply,omitempty"`
 }
 
 // LastRunInfo contains summary information about the most recent run.
@@ -103,47 +173,176 @@ type LastRunInfo struct {
 
 // Stack represents an IaC stack resource.
 type Stack struct {
-	ID              string        `json:"id"`
-	OrganizationID  string        `json:"organization_id"`
-	SpaceID         string        `json:"space_id"`
-	Name            string        `json:"name"`
-	WorkerPoolID    *string       `json:"worker_pool_id,omitempty"`
-	AllowPublicPool bool          `json:"allow_public_pool"`
-	IAC             IACConfig     `json:"iac"`
-	Source          StackSource   `json:"source"`
-	Triggers        StackTriggers `json:"triggers"`
-	LastRun         *LastRunInfo  `json:"last_run,omitempty"`
-	CreatedBy       string        `json:"created_by"`
-	CreatedAt       time.Time     `json:"created_at"`
-	UpdatedAt       time.Time     `json:"updated_at"`
-	UpdatedBy       string        `json:"updated_by"`
-	DeletedAt       *time.Time    `json:"deleted_at,omitempty"`
+	ID              string            `json:"id"`
+	OrganizationID  string            `json:"organization_id"`
+	SpaceID         string            `json:"space_id"`
+	Name            string            `json:"name"`
+	WorkerPoolID    *string           `json:"worker_pool_id,omitempty"`
+	AllowPublicPool bool              `json:"allow_public_pool"`
+	IAC             IACConfig         `json:"iac"`
+	Source          StackSource       `json:"source"`
+	Triggers        StackTriggers     `json:"triggers"`
+	RunnerImage     *string           `json:"runner_image,omitempty"`
+	Hooks           StackHooks        `json:"hooks"`
+	Environment     map[string]string `json:"environment,omitempty"`
+	LastRun         *LastRunInfo      `json:"last_run,omitempty"`
+	Status          string            `json:"status,omitempty"`
+	StatusReason    string            `json:"status_reason,omitempty"`
+	TemplateID      string            `json:"template_id,omitempty"`
+	CreatedBy       string            `json:"created_by"`
+	CreatedAt       time.Time         `json:"created_at"`
+	UpdatedAt       time.Time         `json:"updated_at"`
+	UpdatedBy       string            `json:"updated_by"`
+	DeletedAt       *time.Time        `json:"deleted_at,omitempty"`
+
+	// RequiredChecksBeforeDestroy lists the policy IDs and run types that must have
+	// passed on the stack's latest run before a destroy run is accepted.
+	RequiredChecksBeforeDestroy []string `json:"required_checks_before_destroy,omitempty"`
+
+	// EnvironmentType tiers the stack, e.g. "production". It is free-form; the
+	// StackEnvironment constants are the values the Zenfra UI suggests.
+	EnvironmentType string `json:"environment_type,omitempty"`
+
+	// OwnerTeamID is the team that owns the stack and is paged for its failed runs;
+	// CollaboratorTeamIDs are further teams notified about them.
+	OwnerTeamID         string   `json:"owner_team_id,omitempty"`
+	CollaboratorTeamIDs []string `json:"collaborator_team_ids,omitempty"`
+
+	// RunRetentionDays and LogRetentionDays are how long the stack's runs and run logs
+	// are kept. Zero means the organization's retention settings apply.
+	RunRetentionDays int64 `json:"run_retention_days,omitempty"`
+	LogRetentionDays int64 `json:"log_retention_days,omitempty"`
+
+	// StateSharing controls which other stacks may read the stack's outputs and state.
+	StateSharing StackStateSharing `json:"state_sharing"`
+
+	// ManagedLock is nil when the stack has never been locked.
+	ManagedLock *ManagedLock `json:"managed_lock,omitempty"`
+
+	// SourceCommit is the commit the stack's source ref resolved to when Zenfra last
+	// synced the source, at SourceSyncedAt. Both are empty until the first sync.
+	SourceCommit   string     `json:"source_commit,omitempty"`
+	SourceSyncedAt *time.Time `json:"source_synced_at,omitempty"`
+
+	// Health is the stack's operational state, as GetStackHealth returns it. It is nil
+	// when the API leaves it out of the stack, so reading it takes GetStackHealth.
+	Health *StackHealth `json:"health,omitempty"`
 }
 
+// Suggested stack environment types.
+const (
+	StackEnvironmentProduction  = "production"
+	StackEnvironmentStaging     = "staging"
+	StackEnvironmentDevelopment = "development"
+)
+
+// Stack status values. A new stack is pending while its source is cloned and
+// validated, and cannot run until it is ready.
+const (
+	StackStatusPending = "pending"
+	StackStatusReady   = "ready"
+	StackStatusFailed  = "failed"
+)
+
+// Stack health values: ok, drifted when drift detection found changes, failed when
+// the latest run failed, and locked while the stack is locked against runs.
+const (
+	StackHealthOK      = "ok"
+	StackHealthDrifted = "drifted"
+	StackHealthFailed  = "failed"
+	StackHealthLocked  = "locked"
+)
+
 // CreateStackRequest is the request body for creating a stack.
 type CreateStackRequest struct {
-	SpaceID         string      `json:"space_id"`
-	Name            string      `json:"name"`
-	WorkerPoolID    *string     `json:"worker_pool_id,omitempty"`
-	AllowPublicPool bool        `json:"allow_public_pool"`
-	IAC             IACConfig   `json:"iac"`
-	Source          StackSource `json:"source"`
+	SpaceID         string            `json:"space_id"`
+	Name            string            `json:"name"`
+	WorkerPoolID    *string           `json:"worker_pool_id,omitempty"`
+	AllowPublicPool bool              `json:"allow_public_pool"`
+	IAC             IACConfig         `json:"iac"`
+	Source          *StackSource      `json:"source,omitempty"`      // Optional when TemplateID is set
+	TemplateID      *string           `json:"template_id,omitempty"` // Initializes the stack from a stack template
+	RunnerImage     *string           `json:"runner_image,omitempty"`
+	Hooks           *StackHooks       `json:"hooks,omitempty"`
+	Environment     map[string]string `json:"environment,omitempty"`
+
+	RequiredChecksBeforeDestroy []string `json:"required_checks_before_destroy,omitempty"`
+	EnvironmentType             string   `json:"environment_type,omitempty"`
+	OwnerTeamID                 string   `json:"owner_team_id,omitempty"`
+	CollaboratorTeamIDs         []string `json:"collaborator_team_ids,omitempty"`
+	RunRetentionDays            int64    `json:"run_retention_days,omitempty"`
+	LogRetentionDays            int64    `json:"log_retention_days,omitempty"`
+
+	StateSharing *StackStateSharing `json:"state_sharing,omitempty"`
+}
+
+// StackStateSharing lists the stacks, besides the stack itself, that may read a stack's
+// outputs and state, e.g. through a terraform_remote_state data source or an output
+// subscription. The zero value shares with no other stack.
+type StackStateSharing struct {
+	// ShareWithSpace lets every stack in the same space read the state.
+	ShareWithSpace bool `json:"share_with_space"`
+	// AllowedStackIDs are further stacks, in any space, that may read the state.
+	AllowedStackIDs []string `json:"allowed_stack_ids,omitempty"`
 }
 
 // UpdateStackRequest is the request body for updating a stack.
 type UpdateStackRequest struct {
-	Name            *string      `json:"name,omitempty"`
-	WorkerPoolID    *string      `json:"worker_pool_id,omitempty"`
-	AllowPublicPool *bool        `json:"allow_public_pool,omitempty"`
-	IAC             *IACConfig   `json:"iac,omitempty"`
-	Source          *StackSource `json:"source,omitempty"`
+	Name            *string            `json:"name,omitempty"`
+	WorkerPoolID    *string            `json:"worker_pool_id,omitempty"`
+	AllowPublicPool *bool              `json:"allow_public_pool,omitempty"`
+	IAC             *IACConfig         `json:"iac,omitempty"`
+	Source          *StackSource       `json:"source,omitempty"`
+	RunnerImage     *string            `json:"runner_image,omitempty"` // Empty string resets to the default image
+	Hooks           *StackHooks        `json:"hooks,omitempty"`
+	Environment     *map[string]string `json:"environment,omitempty"` // Non-nil empty map clears all entries
+
+	RequiredChecksBeforeDestroy *[]string `json:"required_checks_before_destroy,omitempty"` // Non-nil empty slice removes the protection
+	EnvironmentType             *string   `json:"environment_type,omitempty"`               // Empty string clears the environment type
+	OwnerTeamID                 *string   `json:"owner_team_id,omitempty"`                  // Empty string leaves the stack without an owner
+	CollaboratorTeamIDs         *[]string `json:"collaborator_team_ids,omitempty"`          // Non-nil empty slice removes all collaborators
+	RunRetentionDays            *int64    `json:"run_retention_days,omitempty"`             // Zero restores the organization's retention
+	LogRetentionDays            *int64    `json:"log_retention_days,omitempty"`             // Zero restores the organization's retention
+
+	StateSharing *StackStateSharing `json:"state_sharing,omitempty"` // Zero value stops sharing with other stacks
 }
 
+// MaskedValue is the placeholder the API has historically returned in place of a secret
+// variable's value.
+const MaskedValue = "****"
+
 // StackVariable represents a single environment variable on a stack.
 type StackVariable struct {
 	Key    string `json:"key"`
 	Value  string `json:"value"`
 	Secret bool   `json:"secret"`
+
+	// Description says what the variable is for, for generated environment documentation.
+	Description string `json:"description,omitempty"`
+	// SensitiveDisplay masks the value in the UI and generated documentation. Unlike
+	// Secret, the value is still returned by the API.
+	SensitiveDisplay bool `json:"sensitive_display,omitempty"`
+
+	// ValueMasked reports that the API withheld Value, so it is not the variable's real
+	// value. It is set when decoding a response and never sent.
+	ValueMasked bool `json:"-"`
+}
+
+// UnmarshalJSON decodes a variable and sets ValueMasked. Depending on the endpoint and
+// API version, a withheld secret comes back as "masked": true, as MaskedValue, or as an
+// empty value.
+func (v *StackVariable) UnmarshalJSON(data []byte) error {
+	type variable StackVariable
+	var raw struct {
+		variable
+		Masked bool `json:"masked"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*v = StackVariable(raw.variable)
+	v.ValueMasked = raw.Masked || (v.Secret && (v.Value == MaskedValue || v.Value == ""))
+	return nil
 }
 
 // GetStackVariablesResponse is the response for GET /stacks/:id/variables.
@@ -156,6 +355,63 @@ type SetStackVariablesRequest struct {
 	Variables []StackVariable `json:"variables"`
 }
 
+// GetSpaceVariablesResponse is the response for GET /spaces/:id/variables.
+// Space variables use the same shape as stack variables.
+type GetSpaceVariablesResponse struct {
+	Variables []StackVariable `json:"variables"`
+}
+
+// SetSpaceVariablesRequest is the request for PUT /spaces/:id/variables.
+type SetSpaceVariablesRequest struct {
+	Variables []StackVariable `json:"variables"`
+}
+
+// --- Stack Template types ---
+
+// StackTemplate is a blueprint that new stacks can be initialized from.
+type StackTemplate struct {
+	ID          string      `json:"id"`
+	Name        string      `json:"name"`
+	Slug        string      `json:"slug"`
+	Description string      `json:"description,omitempty"`
+	IAC         IACConfig   `json:"iac"`
+	Source      StackSource `json:"source"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+// --- Stack Dependency types ---
+
+// Stack dependency kinds.
+const (
+	StackDependencyRunTrigger         = "run_trigger"
+	StackDependencyKVReference        = "kv_reference"
+	StackDependencyOutputSubscription = "output_subscription"
+)
+
+// StackDependencyNode is a stack that appears in the dependency graph.
+type StackDependencyNode struct {
+	StackID string `json:"stack_id"`
+	Name    string `json:"name"`
+	SpaceID string `json:"space_id"`
+}
+
+// StackDependencyEdge records that ToStackID depends on FromStackID, either because
+// a run of FromStackID triggers ToStackID or because ToStackID reads one of its outputs.
+type StackDependencyEdge struct {
+	FromStackID string `json:"from_stack_id"`
+	ToStackID   string `json:"to_stack_id"`
+	Kind        string `json:"kind"`
+	// Key is the referenced output for kv_reference edges.
+	Key string `json:"key,omitempty"`
+}
+
+// StackDependencyGraph is the dependency graph between the organization's stacks.
+type StackDependencyGraph struct {
+	Nodes []StackDependencyNode `json:"nodes"`
+	Edges []StackDependencyEdge `json:"edges"`
+}
+
 // --- Worker Pool types ---
 
 // PoolCapacity shows org-level slot capacity.
@@ -165,31 +421,58 @@ type PoolCapacity struct {
 	OnlineWorkers int `json:"online_workers"`
 }
 
+// MaintenanceWindow is a recurring period during which no runs are scheduled on a
+// worker pool. Cron is a five-field expression of the window's start times, evaluated
+// in Timezone (an IANA name; empty means UTC).
+type MaintenanceWindow struct {
+	Cron            string `json:"cron"`
+	DurationMinutes int64  `json:"duration_minutes"`
+	Timezone        string `json:"timezone,omitempty"`
+}
+
 // WorkerPool represents a worker pool resource.
 type WorkerPool struct {
-	ID                 string        `json:"id"`
-	OrganizationID     string        `json:"organization_id"`
-	Name               string        `json:"name"`
-	PoolType           string        `json:"pool_type"`
-	APIKeyID           *string       `json:"api_key_id,omitempty"`
-	KeyVersion         int           `json:"key_version"`
-	Active             bool          `json:"active"`
-	ActiveWorkersCount int64         `json:"active_workers_count"`
-	Capacity           *PoolCapacity `json:"capacity,omitempty"`
-	CreatedAt          time.Time     `json:"created_at"`
-	UpdatedAt          time.Time     `json:"updated_at"`
-	LastUsedAt         *time.Time    `json:"last_used_at,omitempty"`
+	ID                 string              `json:"id"`
+	OrganizationID     string              `json:"organization_id"`
+	Name               string              `json:"name"`
+	PoolType           string              `json:"pool_type"`
+	APIKeyID           *string             `json:"api_key_id,omitempty"`
+	KeyVersion         int                 `json:"key_version"`
+	Active             bool                `json:"active"`
+	ActiveWorkersCount int64               `json:"active_workers_count"`
+	Draining           bool                `json:"draining"`       // no new runs are scheduled; in-flight runs finish
+	InFlightRuns       int64               `json:"in_flight_runs"` // runs currently executing on the pool
+	Capacity           *PoolCapacity       `json:"capacity,omitempty"`
+	AllowedSpaceIDs    []string            `json:"allowed_space_ids,omitempty"` // empty means every space may use the pool
+	MaintenanceWindows []MaintenanceWindow `json:"maintenance_windows,omitempty"`
+	CreatedAt          time.Time           `json:"created_at"`
+	UpdatedAt          time.Time           `json:"updated_at"`
+	LastUsedAt         *time.Time          `json:"last_used_at,omitempty"`
+
+	// RunnerVersionConstraint pins the runner version the pool's workers run, e.g.
+	// "~> 1.4". Empty means the organization's default constraint applies.
+	RunnerVersionConstraint string `json:"runner_version_constraint,omitempty"`
 }
 
 // CreateWorkerPoolRequest is the request body for creating a worker pool.
 type CreateWorkerPoolRequest struct {
-	Name string `json:"name"`
+	Name                    string              `json:"name"`
+	AllowedSpaceIDs         []string            `json:"allowed_space_ids,omitempty"`
+	MaintenanceWindows      []MaintenanceWindow `json:"maintenance_windows,omitempty"`
+	RunnerVersionConstraint string              `json:"runner_version_constraint,omitempty"`
 }
 
 // UpdateWorkerPoolRequest is the request body for updating a worker pool.
 type UpdateWorkerPoolRequest struct {
 	Name   *string `json:"name,omitempty"`
 	Active *bool   `json:"active,omitempty"`
+	// AllowedSpaceIDs replaces the pool's space restriction; an empty slice removes it.
+	AllowedSpaceIDs *[]string `json:"allowed_space_ids,omitempty"`
+	// MaintenanceWindows replaces the pool's maintenance windows; an empty slice removes them.
+	MaintenanceWindows *[]MaintenanceWindow `json:"maintenance_windows,omitempty"`
+	// RunnerVersionConstraint replaces the pool's pin; an empty string falls back to the
+	// organization's default.
+	RunnerVersionConstraint *string `json:"runner_version_constraint,omitempty"`
 }
 
 // CreateWorkerPoolResponse includes the pool and the write-once API key.
@@ -198,6 +481,23 @@ type CreateWorkerPoolResponse struct {
 	APIKey string     `json:"api_key"`
 }
 
+// --- Worker Pool Assignment types ---
+
+// WorkerPoolAssignment is the default worker pool configured for a space.
+type WorkerPoolAssignment struct {
+	SpaceID       string    `json:"space_id"`
+	WorkerPoolID  string    `json:"worker_pool_id"`
+	AllowOverride bool      `json:"allow_override"`
+	AssignedAt    time.Time `json:"assigned_at"`
+	AssignedBy    string    `json:"assigned_by"`
+}
+
+// SetWorkerPoolAssignmentRequest is the request body for setting a space's default worker pool.
+type SetWorkerPoolAssignmentRequest struct {
+	WorkerPoolID  string `json:"worker_pool_id"`
+	AllowOverride bool   `json:"allow_override"`
+}
+
 // --- Bundle types ---
 
 // EnvVariable represents an environment variable in a bundle.
@@ -233,6 +533,9 @@ type Bundle struct {
 	UpdatedAt            time.Time     `json:"updated_at"`
 	CreatedBy            string        `json:"created_by"`
 	UpdatedBy            string        `json:"updated_by"`
+
+	// ManagedLock is nil when the bundle has never been locked.
+	ManagedLock *ManagedLock `json:"managed_lock,omitempty"`
 }
 
 // CreateBundleRequest is the request body for creating a bundle.
@@ -263,6 +566,20 @@ type UpdateBundleContentResponse struct {
 	WasDeduplicated bool   `json:"was_deduplicated"`
 }
 
+// Request size limits of the API. Larger payloads are rejected with a 413 or 422 that
+// does not say which entry is too large.
+const (
+	MaxBundleContentBytes = 1 << 20   // Environment variable values and mounted file contents of a bundle, together
+	MaxVariableValueBytes = 256 << 10 // The value of a single stack or space variable
+)
+
+// Rules a bundle content violation can break.
+const (
+	BundleContentRuleSyntax        = "syntax"
+	BundleContentRuleSizeLimit     = "size_limit"
+	BundleContentRuleForbiddenPath = "forbidden_path"
+)
+
 // --- Bundle Attachment types ---
 
 // BundleAttachment represents a bundle attached to a stack.
@@ -276,6 +593,12 @@ type BundleAttachment struct {
 	AttachedBy     string    `json:"attached_by"`
 }
 
+// Ways a stack can receive a bundle, see BundleAttachedStack.
+const (
+	BundleAttachedViaStack = "stack"
+	BundleAttachedViaSpace = "space"
+)
+
 // AttachBundleRequest is the request body for attaching a bundle to a stack.
 type AttachBundleRequest struct {
 	BundleID string `json:"bundle_id"`
@@ -287,8 +610,32 @@ type ListAttachmentsResponse struct {
 	Total       int                `json:"total"`
 }
 
+// SpaceBundleAttachment represents a bundle attached to a space.
+type SpaceBundleAttachment struct {
+	ID             string    `json:"id"`
+	OrganizationID string    `json:"organization_id"`
+	SpaceID        string    `json:"space_id"`
+	BundleID       string    `json:"bundle_id"`
+	Priority       int       `json:"priority"`
+	AttachedAt     time.Time `json:"attached_at"`
+	AttachedBy     string    `json:"attached_by"`
+}
+
+// ListSpaceAttachmentsResponse is the response for listing space bundle attachments.
+type ListSpaceAttachmentsResponse struct {
+	Attachments []SpaceBundleAttachment `json:"attachments"`
+	Total       int                     `json:"total"`
+}
+
 // --- API Token types ---
 
+// API token roles.
+const (
+	TokenRoleRead  = "read"
+	TokenRoleWrite = "write"
+	TokenRoleAdmin = "admin"
+)
+
 // Token represents an API token resource.
 type Token struct {
 	ID          string     `json:"id"`
@@ -318,6 +665,34 @@ type CreateTokenResponse struct {
 	TokenObj Token  `json:"token_obj"`
 }
 
+// --- Signing Key types ---
+
+// SigningKey is an organization public key used to verify module and provider uploads.
+type SigningKey struct {
+	ID             string     `json:"id"`
+	OrganizationID string     `json:"organization_id"`
+	Name           string     `json:"name"`
+	PublicKey      string     `json:"public_key"`
+	Algorithm      string     `json:"algorithm"`
+	Fingerprint    string     `json:"fingerprint"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// CreateSigningKeyRequest is the request body for registering a signing key.
+type CreateSigningKeyRequest struct {
+	Name      string     `json:"name"`
+	PublicKey string     `json:"public_key"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// UpdateSigningKeyRequest is the request body for renaming a signing key.
+// The key material and expiry cannot be changed.
+type UpdateSigningKeyRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
 // --- Organization types ---
 
 // IACToolConfig represents the default IaC tool configuration.
@@ -341,6 +716,11 @@ type OrganizationBilling struct {
 	SlotsUsed       int    `json:"slots_used"`
 	SlotsAvailable  int    `json:"slots_available"`
 	EnforcementMode string `json:"enforcement_mode"`
+
+	// MaxRunRetentionDays and MaxLogRetentionDays are the longest retention periods the
+	// plan allows, for the organization and for any single stack. Zero means no limit.
+	MaxRunRetentionDays int `json:"max_run_retention_days,omitempty"`
+	MaxLogRetentionDays int `json:"max_log_retention_days,omitempty"`
 }
 
 // Organization represents the current user's organization.
@@ -354,8 +734,75 @@ type Organization struct {
 	UpdatedAt string               `json:"updated_at,omitempty"`
 }
 
+// APIRateLimitUsage is the request quota of the API token making the call.
+type APIRateLimitUsage struct {
+	Limit     int64     `json:"limit"`
+	Remaining int64     `json:"remaining"`
+	ResetAt   time.Time `json:"reset_at"`
+}
+
+// RunMinutesUsage is the run minutes consumed in the current billing period.
+type RunMinutesUsage struct {
+	Used        int64     `json:"used"`
+	Included    int64     `json:"included"`
+	PeriodStart time.Time `json:"period_start"`
+	PeriodEnd   time.Time `json:"period_end"`
+}
+
+// WorkerSlotUsage is the organization's worker slot consumption.
+type WorkerSlotUsage struct {
+	Limit     int64 `json:"limit"`
+	Used      int64 `json:"used"`
+	Available int64 `json:"available"`
+}
+
+// OrganizationUsage represents the organization's current API and capacity usage.
+type OrganizationUsage struct {
+	APIRateLimit APIRateLimitUsage `json:"api_rate_limit"`
+	RunMinutes   RunMinutesUsage   `json:"run_minutes"`
+	WorkerSlots  WorkerSlotUsage   `json:"worker_slots"`
+}
+
+// --- Run Queue Settings types ---
+
+// RunPriorityClass gives runs of stacks in the listed spaces a scheduling priority.
+// Higher priorities are dequeued first.
+type RunPriorityClass struct {
+	Name     string   `json:"name"`
+	Priority int64    `json:"priority"`
+	SpaceIDs []string `json:"space_ids"`
+	// MaxParallelRuns caps the class's share of the organization's parallel runs; 0 means no cap.
+	MaxParallelRuns int64 `json:"max_parallel_runs,omitempty"`
+}
+
+// RunQueueSettings is the organization-wide run concurrency and queueing configuration.
+// Zero queue limits mean unlimited.
+type RunQueueSettings struct {
+	OrganizationID        string             `json:"organization_id"`
+	MaxParallelRuns       int64              `json:"max_parallel_runs"`
+	MaxQueuedRuns         int64              `json:"max_queued_runs"`
+	MaxQueuedRunsPerStack int64              `json:"max_queued_runs_per_stack"`
+	PriorityClasses       []RunPriorityClass `json:"priority_classes"`
+	UpdatedAt             time.Time          `json:"updated_at"`
+	UpdatedBy             string             `json:"updated_by,omitempty"`
+}
+
+// UpdateRunQueueSettingsRequest replaces the organization's run queue settings.
+type UpdateRunQueueSettingsRequest struct {
+	MaxParallelRuns       int64              `json:"max_parallel_runs"`
+	MaxQueuedRuns         int64              `json:"max_queued_runs"`
+	MaxQueuedRunsPerStack int64              `json:"max_queued_runs_per_stack"`
+	PriorityClasses       []RunPriorityClass `json:"priority_classes"`
+}
+
 // --- VCS Integration types ---
 
+// VCS providers.
+const (
+	VCSProviderGitHub = "github"
+	VCSProviderGitLab = "gitlab"
+)
+
 // VCSExternalAccount holds provider account info.
 type VCSExternalAccount struct {
 	ID    string `json:"id"`
@@ -370,7 +817,8 @@ type VCSGitHubConfig struct {
 
 // VCSGitLabConfig is the response for GitLab config (no sensitive fields).
 type VCSGitLabConfig struct {
-	BaseURL string `json:"base_url"`
+	BaseURL   string `json:"base_url"`
+	TokenType string `json:"token_type,omitempty"`
 }
 
 // VCSIntegration represents a VCS integration resource.
@@ -399,6 +847,7 @@ type CreateVCSIntegrationRequest struct {
 type CreateVCSGitLabRequest struct {
 	BaseURL     string `json:"base_url"`
 	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type,omitempty"`
 }
 
 // CreateVCSGitHubRequest contains GitHub-specific configuration.
@@ -412,6 +861,322 @@ type UpdateVCSIntegrationRequest struct {
 	Status      *string `json:"status,omitempty"`
 }
 
+// VCSRef is a branch or tag resolved to the commit it currently points at.
+type VCSRef struct {
+	RepositoryID string `json:"repository_id"`
+	Ref          string `json:"ref"`
+	Type         string `json:"type"`
+	CommitSHA    string `json:"commit_sha"`
+	CommittedAt  string `json:"committed_at"`
+}
+
+// --- IaC Version types ---
+
+// IACVersion is one release of an IaC engine in the platform's version catalog.
+type IACVersion struct {
+	Version    string `json:"version"`
+	Prerelease bool   `json:"prerelease"`
+	Deprecated bool   `json:"deprecated"`
+}
+
+// IACVersionCatalog lists the versions of one IaC engine that stacks can run.
+type IACVersionCatalog struct {
+	Engine       string       `json:"engine"`
+	LatestStable string       `json:"latest_stable"`
+	Versions     []IACVersion `json:"versions"`
+}
+
+// --- Secret Backend types ---
+
+// Secret backend types.
+const (
+	SecretBackendVault             = "vault"
+	SecretBackendAWSSecretsManager = "aws_secrets_manager"
+)
+
+// Vault auth methods. Both use an identity Zenfra issues to the run, so no Vault
+// credential is stored in Zenfra or in Terraform state.
+const (
+	VaultAuthJWT        = "jwt"
+	VaultAuthKubernetes = "kubernetes"
+)
+
+// SecretBackendVaultConfig connects a secret backend to HashiCorp Vault.
+type SecretBackendVaultConfig struct {
+	Address    string `json:"address"`
+	Namespace  string `json:"namespace,omitempty"`
+	AuthMethod string `json:"auth_method"`
+	AuthMount  string `json:"auth_mount,omitempty"`
+	Role       string `json:"role"`
+}
+
+// SecretBackendAWSConfig connects a secret backend to AWS Secrets Manager through an
+// IAM role that runs assume with their Zenfra identity token.
+type SecretBackendAWSConfig struct {
+	Region     string `json:"region"`
+	RoleARN    string `json:"role_arn"`
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// SecretBackend is a connection to an external secret store that runs read secrets from.
+type SecretBackend struct {
+	ID                string                    `json:"id"`
+	OrganizationID    string                    `json:"organization_id"`
+	Name              string                    `json:"name"`
+	Type              string                    `json:"type"`
+	Vault             *SecretBackendVaultConfig `json:"vault,omitempty"`
+	AWSSecretsManager *SecretBackendAWSConfig   `json:"aws_secrets_manager,omitempty"`
+	CreatedAt         time.Time                 `json:"created_at"`
+	UpdatedAt         time.Time                 `json:"updated_at"`
+}
+
+// CreateSecretBackendRequest is the request body for creating a secret backend.
+// Exactly one of Vault and AWSSecretsManager is set, matching Type.
+type CreateSecretBackendRequest struct {
+	Name              string                    `json:"name"`
+	Type              string                    `json:"type"`
+	Vault             *SecretBackendVaultConfig `json:"vault,omitempty"`
+	AWSSecretsManager *SecretBackendAWSConfig   `json:"aws_secrets_manager,omitempty"`
+}
+
+// UpdateSecretBackendRequest is the request body for updating a secret backend.
+// A set connection config replaces the stored one; the type cannot change.
+type UpdateSecretBackendRequest struct {
+	Name              *string                   `json:"name,omitempty"`
+	Vault             *SecretBackendVaultConfig `json:"vault,omitempty"`
+	AWSSecretsManager *SecretBackendAWSConfig   `json:"aws_secrets_manager,omitempty"`
+}
+
+// BundleSecretReference exposes a secret from a secret backend to runs as an
+// environment variable of a bundle. The secret value is read by the runner when the
+// run starts and is never returned by the API.
+type BundleSecretReference struct {
+	ID        string    `json:"id"`
+	BundleID  string    `json:"bundle_id"`
+	Name      string    `json:"name"`
+	BackendID string    `json:"backend_id"`
+	Path      string    `json:"path"`
+	Key       string    `json:"key,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BundleSecretReferenceRequest is the request body for creating or replacing a bundle
+// secret reference.
+type BundleSecretReferenceRequest struct {
+	Name      string `json:"name"`
+	BackendID string `json:"backend_id"`
+	Path      string `json:"path"`
+	Key       string `json:"key,omitempty"`
+}
+
+// --- Variable Set types ---
+
+// VariableSetVariable is an environment variable of a variable set. As in bundles,
+// the API returns an empty Value for a secret variable.
+type VariableSetVariable struct {
+	VariableSetID string    `json:"variable_set_id"`
+	Key           string    `json:"key"`
+	Value         string    `json:"value"`
+	Secret        bool      `json:"secret"`
+	Description   string    `json:"description,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+
+	// ValueMasked reports that the API withheld Value, as for StackVariable.ValueMasked.
+	ValueMasked bool `json:"-"`
+}
+
+// UnmarshalJSON decodes a variable and sets ValueMasked from any of the forms a withheld
+// secret comes back in, as StackVariable.UnmarshalJSON does.
+func (v *VariableSetVariable) UnmarshalJSON(data []byte) error {
+	type variable VariableSetVariable
+	var raw struct {
+		variable
+		Masked bool `json:"masked"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*v = VariableSetVariable(raw.variable)
+	v.ValueMasked = raw.Masked || (v.Secret && (v.Value == MaskedValue || v.Value == ""))
+	return nil
+}
+
+// --- Run types ---
+
+// RunPlanSummary counts the planned resource actions in a run.
+type RunPlanSummary struct {
+	Add     int `json:"add"`
+	Change  int `json:"change"`
+	Destroy int `json:"destroy"`
+	Replace int `json:"replace"`
+	Import  int `json:"import"`
+}
+
+// RunPlanResourceChange describes the planned actions for a single resource instance.
+type RunPlanResourceChange struct {
+	Address       string   `json:"address"`
+	ModuleAddress string   `json:"module_address,omitempty"`
+	Type          string   `json:"type"`
+	Name          string   `json:"name"`
+	Actions       []string `json:"actions"`
+}
+
+// RunPlan is the structured plan produced by a run.
+type RunPlan struct {
+	RunID           string                  `json:"run_id"`
+	StackID         string                  `json:"stack_id"`
+	Status          string                  `json:"status"`
+	Summary         RunPlanSummary          `json:"summary"`
+	ResourceChanges []RunPlanResourceChange `json:"resource_changes"`
+	PlanJSON        json.RawMessage         `json:"plan_json,omitempty"`
+}
+
+// Cost estimate statuses. Only a finished estimate carries costs.
+const (
+	CostEstimateStatusPending  = "pending"
+	CostEstimateStatusFinished = "finished"
+	CostEstimateStatusErrored  = "errored"
+	CostEstimateStatusSkipped  = "skipped"
+)
+
+// RunCostEstimate summarizes the monthly cost impact of a run's plan. Costs are in Currency.
+type RunCostEstimate struct {
+	RunID               string  `json:"run_id"`
+	StackID             string  `json:"stack_id"`
+	Status              string  `json:"status"`
+	Currency            string  `json:"currency"`
+	PriorMonthlyCost    float64 `json:"prior_monthly_cost"`
+	ProposedMonthlyCost float64 `json:"proposed_monthly_cost"`
+	DeltaMonthlyCost    float64 `json:"delta_monthly_cost"`
+	ResourcesPriced     int     `json:"resources_priced"`
+	ResourcesUnpriced   int     `json:"resources_unpriced"`
+}
+
+// Policy enforcement levels. Advisory and soft-mandatory failures let a run continue;
+// hard-mandatory failures block it.
+const (
+	PolicyEnforcementAdvisory      = "advisory"
+	PolicyEnforcementSoftMandatory = "soft_mandatory"
+	PolicyEnforcementHardMandatory = "hard_mandatory"
+)
+
+// Policy result outcomes.
+const (
+	PolicyOutcomePassed = "passed"
+	PolicyOutcomeFailed = "failed"
+)
+
+// RunPolicyViolation is a single rule violated during a policy check.
+type RunPolicyViolation struct {
+	Rule            string `json:"rule"`
+	Message         string `json:"message"`
+	ResourceAddress string `json:"resource_address,omitempty"`
+}
+
+// RunPolicyResult is the outcome of evaluating one policy against a run.
+type RunPolicyResult struct {
+	PolicyID         string               `json:"policy_id"`
+	PolicyName       string               `json:"policy_name"`
+	EnforcementLevel string               `json:"enforcement_level"`
+	Outcome          string               `json:"outcome"`
+	Violations       []RunPolicyViolation `json:"violations"`
+	EvaluatedAt      time.Time            `json:"evaluated_at"`
+}
+
+// --- Run Comment types ---
+
+// RunComment is a comment posted on a run. Comments are immutable.
+type RunComment struct {
+	ID        string            `json:"id"`
+	RunID     string            `json:"run_id"`
+	StackID   string            `json:"stack_id"`
+	Body      string            `json:"body"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Author    string            `json:"author"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// CreateRunCommentRequest is the request body for posting a comment on a run.
+type CreateRunCommentRequest struct {
+	Body     string            `json:"body"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// --- State Snapshot types ---
+
+// StateSnapshot is a stored version of a stack's Terraform state.
+type StateSnapshot struct {
+	ID        string    `json:"id"`
+	StackID   string    `json:"stack_id"`
+	Serial    int64     `json:"serial"`
+	Lineage   string    `json:"lineage"`
+	SizeBytes int64     `json:"size_bytes"`
+	RunID     *string   `json:"run_id,omitempty"`
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// RollbackStateRequest is the request body for restoring a state snapshot.
+type RollbackStateRequest struct {
+	SnapshotID string `json:"snapshot_id"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// StateRollback records a completed state restore. The restored state is written
+// as a new snapshot, so NewSnapshotID and NewSerial identify the stack's current state.
+type StateRollback struct {
+	ID            string    `json:"id"`
+	StackID       string    `json:"stack_id"`
+	SnapshotID    string    `json:"snapshot_id"`
+	NewSnapshotID string    `json:"new_snapshot_id"`
+	NewSerial     int64     `json:"new_serial"`
+	Reason        string    `json:"reason,omitempty"`
+	CreatedBy     string    `json:"created_by"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Compliance report statuses.
+const (
+	ComplianceReportStatusPending = "pending"
+	ComplianceReportStatusReady   = "ready"
+	ComplianceReportStatusFailed  = "failed"
+)
+
+// Compliance report sections: the kinds of evidence an export can contain.
+const (
+	ComplianceSectionRuns      = "runs"
+	ComplianceSectionApprovals = "approvals"
+	ComplianceSectionPolicies  = "policies"
+)
+
+// --- Membership types ---
+
+// Invitation status values. The API may delete an invitation once it is accepted
+// instead of reporting InvitationStatusAccepted.
+const (
+	InvitationStatusPending  = "pending"
+	InvitationStatusAccepted = "accepted"
+	InvitationStatusExpired  = "expired"
+	InvitationStatusRevoked  = "revoked"
+)
+
+// Organization roles of members, invitations, and domain auto-join.
+const (
+	OrganizationRoleRead  = "read"
+	OrganizationRoleWrite = "write"
+	OrganizationRoleAdmin = "admin"
+)
+
+// Organization domain status values. A verified domain whose TXT record disappears
+// goes back to pending at the API's next check.
+const (
+	DomainStatusPending  = "pending"
+	DomainStatusVerified = "verified"
+	DomainStatusFailed   = "failed"
+)
+
 // --- Paginated response wrapper ---
 
 // PaginatedResponse wraps paginated list responses from the API.
@@ -0,0 +1,108 @@
+// ABOUTME: Variable set methods for the Zenfra API client.
+// ABOUTME: Manages organization-wide variable sets, their environment variables, and the scopes that pick their stacks.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// CreateVariableSet creates a new variable set in the organization.
+func (c *Client) CreateVariableSet(ctx context.Context, req CreateVariableSetRequest) (*VariableSet, error) {
+	set, err := c.apiCreateVariableSet(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("create variable set: %w", err)
+	}
+	return set, nil
+}
+
+// GetVariableSet retrieves a variable set by ID.
+func (c *Client) GetVariableSet(ctx context.Context, id string) (*VariableSet, error) {
+	set, err := c.apiGetVariableSet(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get variable set: %w", err)
+	}
+	return set, nil
+}
+
+// UpdateVariableSet updates the name, description, or reach of a variable set.
+func (c *Client) UpdateVariableSet(ctx context.Context, id string, req UpdateVariableSetRequest) (*VariableSet, error) {
+	set, err := c.apiUpdateVariableSet(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("update variable set: %w", err)
+	}
+	return set, nil
+}
+
+// DeleteVariableSet deletes a variable set with its variables and scopes.
+func (c *Client) DeleteVariableSet(ctx context.Context, id string) error {
+	if err := c.apiDeleteVariableSet(ctx, id); err != nil {
+		return fmt.Errorf("delete variable set: %w", err)
+	}
+	return nil
+}
+
+// CreateVariableSetVariable adds an environment variable to a variable set. The API
+// answers with a conflict if the set already has a variable with the same key.
+func (c *Client) CreateVariableSetVariable(ctx context.Context, setID string, req VariableSetVariableRequest) (*VariableSetVariable, error) {
+	variable, err := c.apiCreateVariableSetVariable(ctx, setID, req)
+	if err != nil {
+		return nil, fmt.Errorf("create variable set variable: %w", err)
+	}
+	return variable, nil
+}
+
+// GetVariableSetVariable retrieves a variable of a variable set by key.
+func (c *Client) GetVariableSetVariable(ctx context.Context, setID, key string) (*VariableSetVariable, error) {
+	variable, err := c.apiGetVariableSetVariable(ctx, setID, url.PathEscape(key))
+	if err != nil {
+		return nil, fmt.Errorf("get variable set variable: %w", err)
+	}
+	return variable, nil
+}
+
+// UpdateVariableSetVariable replaces the value, secrecy, and description of a variable
+// of a variable set. req.Key must match key.
+func (c *Client) UpdateVariableSetVariable(ctx context.Context, setID, key string, req VariableSetVariableRequest) (*VariableSetVariable, error) {
+	variable, err := c.apiUpdateVariableSetVariable(ctx, setID, url.PathEscape(key), req)
+	if err != nil {
+		return nil, fmt.Errorf("update variable set variable: %w", err)
+	}
+	return variable, nil
+}
+
+// DeleteVariableSetVariable removes a variable from a variable set.
+func (c *Client) DeleteVariableSetVariable(ctx context.Context, setID, key string) error {
+	if err := c.apiDeleteVariableSetVariable(ctx, setID, url.PathEscape(key)); err != nil {
+		return fmt.Errorf("delete variable set variable: %w", err)
+	}
+	return nil
+}
+
+// CreateVariableSetScope adds a scope to a variable set.
+func (c *Client) CreateVariableSetScope(ctx context.Context, setID string, req CreateVariableSetScopeRequest) (*VariableSetScope, error) {
+	scope, err := c.apiCreateVariableSetScope(ctx, setID, req)
+	if err != nil {
+		return nil, fmt.Errorf("create variable set scope: %w", err)
+	}
+	return scope, nil
+}
+
+// GetVariableSetScope retrieves a scope of a variable set by ID.
+func (c *Client) GetVariableSetScope(ctx context.Context, setID, id string) (*VariableSetScope, error) {
+	scope, err := c.apiGetVariableSetScope(ctx, setID, id)
+	if err != nil {
+		return nil, fmt.Errorf("get variable set scope: %w", err)
+	}
+	return scope, nil
+}
+
+// DeleteVariableSetScope removes a scope from a variable set.
+func (c *Client) DeleteVariableSetScope(ctx context.Context, setID, id string) error {
+	if err := c.apiDeleteVariableSetScope(ctx, setID, id); err != nil {
+		return fmt.Errorf("delete variable set scope: %w", err)
+	}
+	return nil
+}
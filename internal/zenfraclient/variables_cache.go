@@ -0,0 +1,76 @@
+// ABOUTME: Short-lived memoization of stack variable lists keyed by stack ID.
+// ABOUTME: Lets the plan-time import guard reuse the variables fetched during refresh.
+
+package zenfraclient
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// stackVariablesCacheTTL bounds how long a fetched variable list may be reused.
+// A provider process lives for a single plan or apply, so this only needs to
+// cover the gap between a resource's refresh and its plan.
+const stackVariablesCacheTTL = 5 * time.Minute
+
+type stackVariablesEntry struct {
+	vars      []StackVariable
+	fetchedAt time.Time
+}
+
+// stackVariablesCache stores the most recent variable list seen for each stack.
+type stackVariablesCache struct {
+	mu      sync.Mutex
+	entries map[string]stackVariablesEntry
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+func newStackVariablesCache() *stackVariablesCache {
+	return &stackVariablesCache{
+		entries: make(map[string]stackVariablesEntry),
+		ttl:     stackVariablesCacheTTL,
+		now:     time.Now,
+	}
+}
+
+func (c *stackVariablesCache) get(stackID string) ([]StackVariable, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[stackID]
+	if !ok {
+		return nil, false
+	}
+	if c.now().Sub(entry.fetchedAt) > c.ttl {
+		delete(c.entries, stackID)
+		return nil, false
+	}
+	return slices.Clone(entry.vars), true
+}
+
+func (c *stackVariablesCache) put(stackID string, vars []StackVariable) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[stackID] = stackVariablesEntry{vars: slices.Clone(vars), fetchedAt: c.now()}
+}
+
+func (c *stackVariablesCache) invalidate(stackID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, stackID)
+}
+
+// GetStackVariablesCached returns the variables for a stack, reusing the result of a
+// recent GetStackVariables call for the same stack when one is available. Use it for
+// read-only checks that can tolerate data as old as the current plan.
+func (c *Client) GetStackVariablesCached(ctx context.Context, stackID string) ([]StackVariable, error) {
+	if vars, ok := c.variables.get(stackID); ok {
+		return vars, nil
+	}
+	return c.GetStackVariables(ctx, stackID)
+}
@@ -1,5 +1,5 @@
 // ABOUTME: VCS Integration CRUD methods for the Zenfra API client.
-// ABOUTME: Implements lifecycle for GitHub App and GitLab PAT integrations.
+// ABOUTME: Implements lifecycle for GitHub App and GitLab token integrations, credential rotation, and ref resolution.
 
 package zenfraclient
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 // CreateVCSIntegration creates a new VCS integration.
@@ -45,6 +46,16 @@ func (c *Client) UpdateVCSIntegration(ctx context.Context, id string, req Update
 	return &integration, nil
 }
 
+// UpdateVCSIntegrationCredentials replaces the credentials an integration uses to reach
+// the VCS provider. Credentials cannot be changed through UpdateVCSIntegration.
+func (c *Client) UpdateVCSIntegrationCredentials(ctx context.Context, id string, req UpdateVCSCredentialsRequest) (*VCSIntegration, error) {
+	integration, err := c.apiUpdateVCSIntegrationCredentials(ctx, id, req)
+	if err != nil {
+		return nil, fmt.Errorf("update vcs integration credentials: %w", err)
+	}
+	return integration, nil
+}
+
 // DeleteVCSIntegration deletes a VCS integration by ID.
 func (c *Client) DeleteVCSIntegration(ctx context.Context, id string) error {
 	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/vcs/integrations/"+id, nil)
@@ -57,3 +68,22 @@ func (c *Client) DeleteVCSIntegration(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// ResolveVCSRef resolves a branch or tag in a repository reachable through the integration
+// to the commit it currently points at. refType may be empty, in which case the API
+// looks the ref up as a branch first and then as a tag.
+func (c *Client) ResolveVCSRef(ctx context.Context, integrationID, repositoryID, ref, refType string) (*VCSRef, error) {
+	query := url.Values{}
+	query.Set("repository_id", repositoryID)
+	query.Set("ref", ref)
+	if refType != "" {
+		query.Set("type", refType)
+	}
+
+	var resolved VCSRef
+	path := "/api/v1/vcs/integrations/" + integrationID + "/refs/resolve?" + query.Encode()
+	if err := c.doJSON(ctx, http.MethodGet, path, nil, &resolved); err != nil {
+		return nil, fmt.Errorf("resolve vcs ref: %w", err)
+	}
+	return &resolved, nil
+}
@@ -0,0 +1,38 @@
+// ABOUTME: Webhook endpoint methods for the Zenfra API client.
+// ABOUTME: Endpoints are read-only here apart from rotating their signing secret.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+)
+
+// GetWebhookEndpoint retrieves a webhook endpoint by ID.
+func (c *Client) GetWebhookEndpoint(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	endpoint, err := c.apiGetWebhookEndpoint(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get webhook endpoint: %w", err)
+	}
+	return endpoint, nil
+}
+
+// ListWebhookEndpoints returns all webhook endpoints in the organization.
+func (c *Client) ListWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error) {
+	resp, err := c.apiListWebhookEndpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook endpoints: %w", err)
+	}
+	return resp.Items, nil
+}
+
+// RotateWebhookEndpointSecret replaces the signing secret of a webhook endpoint and
+// returns the new one. The previous secret keeps signing deliveries until
+// PreviousSecretExpiresAt.
+func (c *Client) RotateWebhookEndpointSecret(ctx context.Context, id string) (*WebhookSecretRotation, error) {
+	rotation, err := c.apiRotateWebhookEndpointSecret(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("rotate webhook endpoint secret: %w", err)
+	}
+	return rotation, nil
+}
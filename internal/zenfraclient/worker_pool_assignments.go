@@ -0,0 +1,41 @@
+// ABOUTME: Space-level default worker pool methods for the Zenfra API client.
+// ABOUTME: Implements GetWorkerPoolAssignment, SetWorkerPoolAssignment, and DeleteWorkerPoolAssignment.
+
+package zenfraclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetWorkerPoolAssignment retrieves the default worker pool assigned to a space.
+func (c *Client) GetWorkerPoolAssignment(ctx context.Context, spaceID string) (*WorkerPoolAssignment, error) {
+	var assignment WorkerPoolAssignment
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/spaces/"+spaceID+"/worker-pool", nil, &assignment); err != nil {
+		return nil, fmt.Errorf("get worker pool assignment: %w", err)
+	}
+	return &assignment, nil
+}
+
+// SetWorkerPoolAssignment creates or replaces the default worker pool for a space.
+func (c *Client) SetWorkerPoolAssignment(ctx context.Context, spaceID string, req SetWorkerPoolAssignmentRequest) (*WorkerPoolAssignment, error) {
+	var assignment WorkerPoolAssignment
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/spaces/"+spaceID+"/worker-pool", req, &assignment); err != nil {
+		return nil, fmt.Errorf("set worker pool assignment: %w", err)
+	}
+	return &assignment, nil
+}
+
+// DeleteWorkerPoolAssignment removes the default worker pool from a space.
+func (c *Client) DeleteWorkerPoolAssignment(ctx context.Context, spaceID string) error {
+	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/spaces/"+spaceID+"/worker-pool", nil)
+	if err != nil {
+		return fmt.Errorf("delete worker pool assignment: %w", err)
+	}
+	defer resp.Body.Close() //nolint:errcheck // best-effort close
+	if err := checkResponse(resp); err != nil {
+		return fmt.Errorf("delete worker pool assignment: %w", err)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 // CreateWorkerPool creates a new worker pool.
@@ -28,12 +29,12 @@ func (c *Client) GetWorkerPool(ctx context.Context, id string) (*WorkerPool, err
 	return &pool, nil
 }
 
-// ListWorkerPools returns all worker pools in the organization.
-func (c *Client) ListWorkerPools(ctx context.Context) ([]WorkerPool, error) {
+// ListWorkerPools returns all worker pools in the organization. opts may be nil.
+func (c *Client) ListWorkerPools(ctx context.Context, opts *ListOptions) ([]WorkerPool, error) {
 	var resp struct {
 		Pools []WorkerPool `json:"pools"`
 	}
-	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/worker-pools", nil, &resp); err != nil {
+	if err := c.doJSON(ctx, http.MethodGet, listPath("/api/v1/worker-pools", opts), nil, &resp); err != nil {
 		return nil, fmt.Errorf("list worker pools: %w", err)
 	}
 	return resp.Pools, nil
@@ -42,14 +43,56 @@ func (c *Client) ListWorkerPools(ctx context.Context) ([]WorkerPool, error) {
 // UpdateWorkerPool updates an existing worker pool.
 func (c *Client) UpdateWorkerPool(ctx context.Context, id string, req UpdateWorkerPoolRequest) (*WorkerPool, error) {
 	var pool WorkerPool
+	defer c.workerPools.invalidate(id)
 	if err := c.doJSON(ctx, http.MethodPatch, "/api/v1/worker-pools/"+id, req, &pool); err != nil {
 		return nil, fmt.Errorf("update worker pool: %w", err)
 	}
 	return &pool, nil
 }
 
+// DrainWorkerPool stops scheduling new runs on a worker pool. Runs already executing on
+// the pool are allowed to finish.
+func (c *Client) DrainWorkerPool(ctx context.Context, id string) (*WorkerPool, error) {
+	var pool WorkerPool
+	defer c.workerPools.invalidate(id)
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/worker-pools/"+id+"/drain", nil, &pool); err != nil {
+		return nil, fmt.Errorf("drain worker pool: %w", err)
+	}
+	return &pool, nil
+}
+
+// ResumeWorkerPool cancels a drain so the pool accepts new runs again.
+func (c *Client) ResumeWorkerPool(ctx context.Context, id string) (*WorkerPool, error) {
+	var pool WorkerPool
+	defer c.workerPools.invalidate(id)
+	if err := c.doJSON(ctx, http.MethodDelete, "/api/v1/worker-pools/"+id+"/drain", nil, &pool); err != nil {
+		return nil, fmt.Errorf("resume worker pool: %w", err)
+	}
+	return &pool, nil
+}
+
+// WaitForWorkerPoolDrained polls a draining pool every interval until no runs are
+// executing on it and returns the drained pool. It fails if ctx is done first; bound
+// the wait with a context deadline.
+func (c *Client) WaitForWorkerPoolDrained(ctx context.Context, id string, interval time.Duration) (*WorkerPool, error) {
+	for {
+		pool, err := c.GetWorkerPool(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("wait for worker pool drained: %w", err)
+		}
+		if pool.InFlightRuns == 0 {
+			return pool, nil
+		}
+
+		if err := sleepWithContext(ctx, interval); err != nil {
+			return pool, fmt.Errorf("wait for worker pool drained: pool %s still has %d runs in flight: %w", id, pool.InFlightRuns, err)
+		}
+	}
+}
+
 // DeleteWorkerPool deletes a worker pool by ID.
 func (c *Client) DeleteWorkerPool(ctx context.Context, id string) error {
+	defer c.workerPools.invalidate(id)
 	resp, err := c.doRequest(ctx, http.MethodDelete, "/api/v1/worker-pools/"+id, nil)
 	if err != nil {
 		return fmt.Errorf("delete worker pool: %w", err)
@@ -0,0 +1,1287 @@
+// ABOUTME: Fake implementation of the zenfraclient domain interfaces for resource unit tests.
+// ABOUTME: Each method delegates to an optional function field and records the call.
+
+// Code generated by gen.go from ../api.go; DO NOT EDIT.
+
+package zenfrafake
+
+import (
+	"context"
+	"time"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Ensure Client implements every domain interface.
+var (
+	_ zenfraclient.SpaceAPI                          = (*Client)(nil)
+	_ zenfraclient.StackAPI                          = (*Client)(nil)
+	_ zenfraclient.StackManifestAPI                  = (*Client)(nil)
+	_ zenfraclient.BundleAPI                         = (*Client)(nil)
+	_ zenfraclient.BundleAttachmentAPI               = (*Client)(nil)
+	_ zenfraclient.SpaceBundleAttachmentAPI          = (*Client)(nil)
+	_ zenfraclient.SecretBackendAPI                  = (*Client)(nil)
+	_ zenfraclient.BundleSecretReferenceAPI          = (*Client)(nil)
+	_ zenfraclient.VariableSetAPI                    = (*Client)(nil)
+	_ zenfraclient.WorkerPoolAPI                     = (*Client)(nil)
+	_ zenfraclient.WorkerPoolAssignmentAPI           = (*Client)(nil)
+	_ zenfraclient.TokenAPI                          = (*Client)(nil)
+	_ zenfraclient.SigningKeyAPI                     = (*Client)(nil)
+	_ zenfraclient.MembershipInvitationAPI           = (*Client)(nil)
+	_ zenfraclient.OrganizationDomainAPI             = (*Client)(nil)
+	_ zenfraclient.OrganizationDomainVerificationAPI = (*Client)(nil)
+	_ zenfraclient.RunCommentAPI                     = (*Client)(nil)
+	_ zenfraclient.OutputSubscriptionAPI             = (*Client)(nil)
+	_ zenfraclient.RateLimitPolicyAPI                = (*Client)(nil)
+	_ zenfraclient.RunQueueSettingsAPI               = (*Client)(nil)
+	_ zenfraclient.RetentionSettingsAPI              = (*Client)(nil)
+	_ zenfraclient.RunnerVersionConstraintAPI        = (*Client)(nil)
+	_ zenfraclient.VCSIntegrationAPI                 = (*Client)(nil)
+	_ zenfraclient.WebhookSecretRotationAPI          = (*Client)(nil)
+)
+
+// Client is a fake Zenfra API client. The zero value answers every call with an error.
+type Client struct {
+	state
+
+	GetCurrentOrganizationFunc            func(ctx context.Context) (*zenfraclient.Organization, error)
+	GetTokenPermissionsCachedFunc         func(ctx context.Context) (*zenfraclient.TokenPermissions, error)
+	CreateSpaceFunc                       func(ctx context.Context, req zenfraclient.CreateSpaceRequest) (*zenfraclient.Space, error)
+	GetSpaceFunc                          func(ctx context.Context, id string) (*zenfraclient.Space, error)
+	GetSpaceCachedFunc                    func(ctx context.Context, id string) (*zenfraclient.Space, error)
+	UpdateSpaceFunc                       func(ctx context.Context, id string, req zenfraclient.UpdateSpaceRequest) (*zenfraclient.Space, error)
+	DeleteSpaceFunc                       func(ctx context.Context, id string) error
+	DeleteSpaceRecursiveFunc              func(ctx context.Context, id string) error
+	GetSpaceVariablesFunc                 func(ctx context.Context, spaceID string) ([]zenfraclient.StackVariable, error)
+	GetSpaceVariablesCachedFunc           func(ctx context.Context, spaceID string) ([]zenfraclient.StackVariable, error)
+	SetSpaceVariablesFunc                 func(ctx context.Context, spaceID string, vars []zenfraclient.StackVariable) ([]zenfraclient.StackVariable, error)
+	SetSpaceManagedLockFunc               func(ctx context.Context, spaceID string, enabled bool) (*zenfraclient.ManagedLock, error)
+	CreateStackFunc                       func(ctx context.Context, req zenfraclient.CreateStackRequest) (*zenfraclient.Stack, error)
+	GetStackFunc                          func(ctx context.Context, id string) (*zenfraclient.Stack, error)
+	GetStackCachedFunc                    func(ctx context.Context, id string) (*zenfraclient.Stack, error)
+	GetStackHealthFunc                    func(ctx context.Context, id string) (*zenfraclient.StackHealth, error)
+	WaitForStackReadyFunc                 func(ctx context.Context, id string, interval time.Duration) (*zenfraclient.Stack, error)
+	UpdateStackFunc                       func(ctx context.Context, id string, req zenfraclient.UpdateStackRequest) (*zenfraclient.Stack, error)
+	DeleteStackFunc                       func(ctx context.Context, id string, opts *zenfraclient.DeleteStackOptions) error
+	GetStackVariablesFunc                 func(ctx context.Context, stackID string) ([]zenfraclient.StackVariable, error)
+	GetStackVariablesCachedFunc           func(ctx context.Context, stackID string) ([]zenfraclient.StackVariable, error)
+	SetStackVariablesFunc                 func(ctx context.Context, stackID string, vars []zenfraclient.StackVariable) ([]zenfraclient.StackVariable, error)
+	SetStackSourceFunc                    func(ctx context.Context, stackID string, source zenfraclient.StackSource) error
+	SetStackTriggersFunc                  func(ctx context.Context, stackID string, triggers zenfraclient.StackTriggers) error
+	ListActiveStackRunsFunc               func(ctx context.Context, stackID string) ([]zenfraclient.ActiveRun, error)
+	WaitForStackIdleFunc                  func(ctx context.Context, stackID string, interval time.Duration) error
+	ListStackBundlesFunc                  func(ctx context.Context, stackID string) ([]zenfraclient.BundleAttachment, error)
+	ListStateSnapshotsFunc                func(ctx context.Context, stackID string) ([]zenfraclient.StateSnapshot, error)
+	RollbackStateFunc                     func(ctx context.Context, stackID string, req zenfraclient.RollbackStateRequest) (*zenfraclient.StateRollback, error)
+	SetStackManagedLockFunc               func(ctx context.Context, stackID string, enabled bool) (*zenfraclient.ManagedLock, error)
+	ValidateStackManifestFunc             func(ctx context.Context, req zenfraclient.ValidateStackManifestRequest) (*zenfraclient.StackManifestValidation, error)
+	CreateStackFromManifestFunc           func(ctx context.Context, req zenfraclient.StackManifestRequest) (*zenfraclient.StackManifest, error)
+	GetStackManifestFunc                  func(ctx context.Context, stackID string) (*zenfraclient.StackManifest, error)
+	UpdateStackFromManifestFunc           func(ctx context.Context, stackID string, req zenfraclient.StackManifestRequest) (*zenfraclient.StackManifest, error)
+	CreateBundleFunc                      func(ctx context.Context, req zenfraclient.CreateBundleRequest) (*zenfraclient.Bundle, error)
+	GetBundleFunc                         func(ctx context.Context, id string) (*zenfraclient.Bundle, error)
+	UpdateBundleFunc                      func(ctx context.Context, id string, req zenfraclient.UpdateBundleRequest) (*zenfraclient.Bundle, error)
+	UpdateBundleContentFunc               func(ctx context.Context, id string, req zenfraclient.UpdateBundleContentRequest) (*zenfraclient.UpdateBundleContentResponse, error)
+	ValidateBundleContentFunc             func(ctx context.Context, req zenfraclient.ValidateBundleContentRequest) (*zenfraclient.BundleContentValidation, error)
+	DeleteBundleFunc                      func(ctx context.Context, id string) error
+	SetBundleManagedLockFunc              func(ctx context.Context, id string, enabled bool) (*zenfraclient.ManagedLock, error)
+	AttachBundleFunc                      func(ctx context.Context, stackID string, bundleID string) error
+	DetachBundleFunc                      func(ctx context.Context, stackID string, bundleID string) error
+	AttachSpaceBundleFunc                 func(ctx context.Context, spaceID string, bundleID string) error
+	DetachSpaceBundleFunc                 func(ctx context.Context, spaceID string, bundleID string) error
+	ListSpaceBundlesFunc                  func(ctx context.Context, spaceID string) ([]zenfraclient.SpaceBundleAttachment, error)
+	CreateSecretBackendFunc               func(ctx context.Context, req zenfraclient.CreateSecretBackendRequest) (*zenfraclient.SecretBackend, error)
+	GetSecretBackendFunc                  func(ctx context.Context, id string) (*zenfraclient.SecretBackend, error)
+	UpdateSecretBackendFunc               func(ctx context.Context, id string, req zenfraclient.UpdateSecretBackendRequest) (*zenfraclient.SecretBackend, error)
+	DeleteSecretBackendFunc               func(ctx context.Context, id string) error
+	CreateBundleSecretReferenceFunc       func(ctx context.Context, bundleID string, req zenfraclient.BundleSecretReferenceRequest) (*zenfraclient.BundleSecretReference, error)
+	GetBundleSecretReferenceFunc          func(ctx context.Context, bundleID string, id string) (*zenfraclient.BundleSecretReference, error)
+	UpdateBundleSecretReferenceFunc       func(ctx context.Context, bundleID string, id string, req zenfraclient.BundleSecretReferenceRequest) (*zenfraclient.BundleSecretReference, error)
+	DeleteBundleSecretReferenceFunc       func(ctx context.Context, bundleID string, id string) error
+	CreateVariableSetFunc                 func(ctx context.Context, req zenfraclient.CreateVariableSetRequest) (*zenfraclient.VariableSet, error)
+	GetVariableSetFunc                    func(ctx context.Context, id string) (*zenfraclient.VariableSet, error)
+	UpdateVariableSetFunc                 func(ctx context.Context, id string, req zenfraclient.UpdateVariableSetRequest) (*zenfraclient.VariableSet, error)
+	DeleteVariableSetFunc                 func(ctx context.Context, id string) error
+	CreateVariableSetVariableFunc         func(ctx context.Context, setID string, req zenfraclient.VariableSetVariableRequest) (*zenfraclient.VariableSetVariable, error)
+	GetVariableSetVariableFunc            func(ctx context.Context, setID string, key string) (*zenfraclient.VariableSetVariable, error)
+	UpdateVariableSetVariableFunc         func(ctx context.Context, setID string, key string, req zenfraclient.VariableSetVariableRequest) (*zenfraclient.VariableSetVariable, error)
+	DeleteVariableSetVariableFunc         func(ctx context.Context, setID string, key string) error
+	CreateVariableSetScopeFunc            func(ctx context.Context, setID string, req zenfraclient.CreateVariableSetScopeRequest) (*zenfraclient.VariableSetScope, error)
+	GetVariableSetScopeFunc               func(ctx context.Context, setID string, id string) (*zenfraclient.VariableSetScope, error)
+	DeleteVariableSetScopeFunc            func(ctx context.Context, setID string, id string) error
+	CreateWorkerPoolFunc                  func(ctx context.Context, req zenfraclient.CreateWorkerPoolRequest) (*zenfraclient.CreateWorkerPoolResponse, error)
+	GetWorkerPoolFunc                     func(ctx context.Context, id string) (*zenfraclient.WorkerPool, error)
+	GetWorkerPoolCachedFunc               func(ctx context.Context, id string) (*zenfraclient.WorkerPool, error)
+	UpdateWorkerPoolFunc                  func(ctx context.Context, id string, req zenfraclient.UpdateWorkerPoolRequest) (*zenfraclient.WorkerPool, error)
+	DrainWorkerPoolFunc                   func(ctx context.Context, id string) (*zenfraclient.WorkerPool, error)
+	ResumeWorkerPoolFunc                  func(ctx context.Context, id string) (*zenfraclient.WorkerPool, error)
+	WaitForWorkerPoolDrainedFunc          func(ctx context.Context, id string, interval time.Duration) (*zenfraclient.WorkerPool, error)
+	DeleteWorkerPoolFunc                  func(ctx context.Context, id string) error
+	ListRunnerVersionsFunc                func(ctx context.Context) ([]zenfraclient.RunnerVersion, error)
+	GetWorkerPoolAssignmentFunc           func(ctx context.Context, spaceID string) (*zenfraclient.WorkerPoolAssignment, error)
+	SetWorkerPoolAssignmentFunc           func(ctx context.Context, spaceID string, req zenfraclient.SetWorkerPoolAssignmentRequest) (*zenfraclient.WorkerPoolAssignment, error)
+	DeleteWorkerPoolAssignmentFunc        func(ctx context.Context, spaceID string) error
+	CreateTokenFunc                       func(ctx context.Context, req zenfraclient.CreateTokenRequest) (*zenfraclient.CreateTokenResponse, error)
+	GetTokenFunc                          func(ctx context.Context, id string) (*zenfraclient.Token, error)
+	DeleteTokenFunc                       func(ctx context.Context, id string) error
+	CreateSigningKeyFunc                  func(ctx context.Context, req zenfraclient.CreateSigningKeyRequest) (*zenfraclient.SigningKey, error)
+	GetSigningKeyFunc                     func(ctx context.Context, id string) (*zenfraclient.SigningKey, error)
+	UpdateSigningKeyFunc                  func(ctx context.Context, id string, req zenfraclient.UpdateSigningKeyRequest) (*zenfraclient.SigningKey, error)
+	DeleteSigningKeyFunc                  func(ctx context.Context, id string) error
+	CreateInvitationFunc                  func(ctx context.Context, req zenfraclient.CreateInvitationRequest) (*zenfraclient.Invitation, error)
+	GetInvitationFunc                     func(ctx context.Context, id string) (*zenfraclient.Invitation, error)
+	UpdateInvitationFunc                  func(ctx context.Context, id string, req zenfraclient.UpdateInvitationRequest) (*zenfraclient.Invitation, error)
+	ResendInvitationFunc                  func(ctx context.Context, id string, req zenfraclient.ResendInvitationRequest) (*zenfraclient.Invitation, error)
+	RevokeInvitationFunc                  func(ctx context.Context, id string) error
+	FindMemberFunc                        func(ctx context.Context, email string) (*zenfraclient.Member, error)
+	CreateOrganizationDomainFunc          func(ctx context.Context, req zenfraclient.CreateOrganizationDomainRequest) (*zenfraclient.OrganizationDomain, error)
+	GetOrganizationDomainFunc             func(ctx context.Context, id string) (*zenfraclient.OrganizationDomain, error)
+	UpdateOrganizationDomainFunc          func(ctx context.Context, id string, req zenfraclient.UpdateOrganizationDomainRequest) (*zenfraclient.OrganizationDomain, error)
+	DeleteOrganizationDomainFunc          func(ctx context.Context, id string) error
+	VerifyOrganizationDomainFunc          func(ctx context.Context, id string) (*zenfraclient.OrganizationDomain, error)
+	WaitForOrganizationDomainVerifiedFunc func(ctx context.Context, id string, interval time.Duration) (*zenfraclient.OrganizationDomain, error)
+	CreateRunCommentFunc                  func(ctx context.Context, runID string, req zenfraclient.CreateRunCommentRequest) (*zenfraclient.RunComment, error)
+	GetRunCommentFunc                     func(ctx context.Context, runID string, commentID string) (*zenfraclient.RunComment, error)
+	CreateOutputSubscriptionFunc          func(ctx context.Context, stackID string, req zenfraclient.CreateOutputSubscriptionRequest) (*zenfraclient.OutputSubscription, error)
+	GetOutputSubscriptionFunc             func(ctx context.Context, stackID string, id string) (*zenfraclient.OutputSubscription, error)
+	UpdateOutputSubscriptionFunc          func(ctx context.Context, stackID string, id string, req zenfraclient.UpdateOutputSubscriptionRequest) (*zenfraclient.OutputSubscription, error)
+	DeleteOutputSubscriptionFunc          func(ctx context.Context, stackID string, id string) error
+	GetStackDependencyGraphFunc           func(ctx context.Context, opts *zenfraclient.StackDependencyGraphOptions) (*zenfraclient.StackDependencyGraph, error)
+	CreateRateLimitPolicyFunc             func(ctx context.Context, req zenfraclient.CreateRateLimitPolicyRequest) (*zenfraclient.RateLimitPolicy, error)
+	GetRateLimitPolicyFunc                func(ctx context.Context, id string) (*zenfraclient.RateLimitPolicy, error)
+	UpdateRateLimitPolicyFunc             func(ctx context.Context, id string, req zenfraclient.UpdateRateLimitPolicyRequest) (*zenfraclient.RateLimitPolicy, error)
+	DeleteRateLimitPolicyFunc             func(ctx context.Context, id string) error
+	GetRateLimitBoundsFunc                func(ctx context.Context) (*zenfraclient.RateLimitBounds, error)
+	GetRunQueueSettingsFunc               func(ctx context.Context) (*zenfraclient.RunQueueSettings, error)
+	UpdateRunQueueSettingsFunc            func(ctx context.Context, req zenfraclient.UpdateRunQueueSettingsRequest) (*zenfraclient.RunQueueSettings, error)
+	ResetRunQueueSettingsFunc             func(ctx context.Context) error
+	GetRetentionSettingsFunc              func(ctx context.Context) (*zenfraclient.RetentionSettings, error)
+	UpdateRetentionSettingsFunc           func(ctx context.Context, req zenfraclient.UpdateRetentionSettingsRequest) (*zenfraclient.RetentionSettings, error)
+	ResetRetentionSettingsFunc            func(ctx context.Context) error
+	GetRunnerVersionConstraintFunc        func(ctx context.Context) (*zenfraclient.RunnerVersionConstraint, error)
+	UpdateRunnerVersionConstraintFunc     func(ctx context.Context, req zenfraclient.UpdateRunnerVersionConstraintRequest) (*zenfraclient.RunnerVersionConstraint, error)
+	ResetRunnerVersionConstraintFunc      func(ctx context.Context) error
+	CreateVCSIntegrationFunc              func(ctx context.Context, req zenfraclient.CreateVCSIntegrationRequest) (*zenfraclient.VCSIntegration, error)
+	GetVCSIntegrationFunc                 func(ctx context.Context, id string) (*zenfraclient.VCSIntegration, error)
+	UpdateVCSIntegrationFunc              func(ctx context.Context, id string, req zenfraclient.UpdateVCSIntegrationRequest) (*zenfraclient.VCSIntegration, error)
+	UpdateVCSIntegrationCredentialsFunc   func(ctx context.Context, id string, req zenfraclient.UpdateVCSCredentialsRequest) (*zenfraclient.VCSIntegration, error)
+	DeleteVCSIntegrationFunc              func(ctx context.Context, id string) error
+	GetWebhookEndpointFunc                func(ctx context.Context, id string) (*zenfraclient.WebhookEndpoint, error)
+	RotateWebhookEndpointSecretFunc       func(ctx context.Context, id string) (*zenfraclient.WebhookSecretRotation, error)
+}
+
+// GetCurrentOrganization calls GetCurrentOrganizationFunc.
+func (f *Client) GetCurrentOrganization(ctx context.Context) (*zenfraclient.Organization, error) {
+	f.record("GetCurrentOrganization")
+	if f.GetCurrentOrganizationFunc == nil {
+		return nil, notStubbed("GetCurrentOrganization")
+	}
+	return f.GetCurrentOrganizationFunc(ctx)
+}
+
+// GetTokenPermissionsCached calls GetTokenPermissionsCachedFunc.
+func (f *Client) GetTokenPermissionsCached(ctx context.Context) (*zenfraclient.TokenPermissions, error) {
+	f.record("GetTokenPermissionsCached")
+	if f.GetTokenPermissionsCachedFunc == nil {
+		return nil, notStubbed("GetTokenPermissionsCached")
+	}
+	return f.GetTokenPermissionsCachedFunc(ctx)
+}
+
+// CreateSpace calls CreateSpaceFunc.
+func (f *Client) CreateSpace(ctx context.Context, req zenfraclient.CreateSpaceRequest) (*zenfraclient.Space, error) {
+	f.record("CreateSpace")
+	if f.CreateSpaceFunc == nil {
+		return nil, notStubbed("CreateSpace")
+	}
+	return f.CreateSpaceFunc(ctx, req)
+}
+
+// GetSpace calls GetSpaceFunc.
+func (f *Client) GetSpace(ctx context.Context, id string) (*zenfraclient.Space, error) {
+	f.record("GetSpace")
+	if f.GetSpaceFunc == nil {
+		return nil, notStubbed("GetSpace")
+	}
+	return f.GetSpaceFunc(ctx, id)
+}
+
+// GetSpaceCached calls GetSpaceCachedFunc.
+func (f *Client) GetSpaceCached(ctx context.Context, id string) (*zenfraclient.Space, error) {
+	f.record("GetSpaceCached")
+	if f.GetSpaceCachedFunc == nil {
+		return nil, notStubbed("GetSpaceCached")
+	}
+	return f.GetSpaceCachedFunc(ctx, id)
+}
+
+// UpdateSpace calls UpdateSpaceFunc.
+func (f *Client) UpdateSpace(ctx context.Context, id string, req zenfraclient.UpdateSpaceRequest) (*zenfraclient.Space, error) {
+	f.record("UpdateSpace")
+	if f.UpdateSpaceFunc == nil {
+		return nil, notStubbed("UpdateSpace")
+	}
+	return f.UpdateSpaceFunc(ctx, id, req)
+}
+
+// DeleteSpace calls DeleteSpaceFunc.
+func (f *Client) DeleteSpace(ctx context.Context, id string) error {
+	f.record("DeleteSpace")
+	if f.DeleteSpaceFunc == nil {
+		return notStubbed("DeleteSpace")
+	}
+	return f.DeleteSpaceFunc(ctx, id)
+}
+
+// DeleteSpaceRecursive calls DeleteSpaceRecursiveFunc.
+func (f *Client) DeleteSpaceRecursive(ctx context.Context, id string) error {
+	f.record("DeleteSpaceRecursive")
+	if f.DeleteSpaceRecursiveFunc == nil {
+		return notStubbed("DeleteSpaceRecursive")
+	}
+	return f.DeleteSpaceRecursiveFunc(ctx, id)
+}
+
+// GetSpaceVariables calls GetSpaceVariablesFunc.
+func (f *Client) GetSpaceVariables(ctx context.Context, spaceID string) ([]zenfraclient.StackVariable, error) {
+	f.record("GetSpaceVariables")
+	if f.GetSpaceVariablesFunc == nil {
+		return nil, notStubbed("GetSpaceVariables")
+	}
+	return f.GetSpaceVariablesFunc(ctx, spaceID)
+}
+
+// GetSpaceVariablesCached calls GetSpaceVariablesCachedFunc.
+func (f *Client) GetSpaceVariablesCached(ctx context.Context, spaceID string) ([]zenfraclient.StackVariable, error) {
+	f.record("GetSpaceVariablesCached")
+	if f.GetSpaceVariablesCachedFunc == nil {
+		return nil, notStubbed("GetSpaceVariablesCached")
+	}
+	return f.GetSpaceVariablesCachedFunc(ctx, spaceID)
+}
+
+// SetSpaceVariables calls SetSpaceVariablesFunc.
+func (f *Client) SetSpaceVariables(ctx context.Context, spaceID string, vars []zenfraclient.StackVariable) ([]zenfraclient.StackVariable, error) {
+	f.record("SetSpaceVariables")
+	if f.SetSpaceVariablesFunc == nil {
+		return nil, notStubbed("SetSpaceVariables")
+	}
+	return f.SetSpaceVariablesFunc(ctx, spaceID, vars)
+}
+
+// SetSpaceManagedLock calls SetSpaceManagedLockFunc.
+func (f *Client) SetSpaceManagedLock(ctx context.Context, spaceID string, enabled bool) (*zenfraclient.ManagedLock, error) {
+	f.record("SetSpaceManagedLock")
+	if f.SetSpaceManagedLockFunc == nil {
+		return nil, notStubbed("SetSpaceManagedLock")
+	}
+	return f.SetSpaceManagedLockFunc(ctx, spaceID, enabled)
+}
+
+// CreateStack calls CreateStackFunc.
+func (f *Client) CreateStack(ctx context.Context, req zenfraclient.CreateStackRequest) (*zenfraclient.Stack, error) {
+	f.record("CreateStack")
+	if f.CreateStackFunc == nil {
+		return nil, notStubbed("CreateStack")
+	}
+	return f.CreateStackFunc(ctx, req)
+}
+
+// GetStack calls GetStackFunc.
+func (f *Client) GetStack(ctx context.Context, id string) (*zenfraclient.Stack, error) {
+	f.record("GetStack")
+	if f.GetStackFunc == nil {
+		return nil, notStubbed("GetStack")
+	}
+	return f.GetStackFunc(ctx, id)
+}
+
+// GetStackCached calls GetStackCachedFunc.
+func (f *Client) GetStackCached(ctx context.Context, id string) (*zenfraclient.Stack, error) {
+	f.record("GetStackCached")
+	if f.GetStackCachedFunc == nil {
+		return nil, notStubbed("GetStackCached")
+	}
+	return f.GetStackCachedFunc(ctx, id)
+}
+
+// GetStackHealth calls GetStackHealthFunc.
+func (f *Client) GetStackHealth(ctx context.Context, id string) (*zenfraclient.StackHealth, error) {
+	f.record("GetStackHealth")
+	if f.GetStackHealthFunc == nil {
+		return nil, notStubbed("GetStackHealth")
+	}
+	return f.GetStackHealthFunc(ctx, id)
+}
+
+// WaitForStackReady calls WaitForStackReadyFunc.
+func (f *Client) WaitForStackReady(ctx context.Context, id string, interval time.Duration) (*zenfraclient.Stack, error) {
+	f.record("WaitForStackReady")
+	if f.WaitForStackReadyFunc == nil {
+		return nil, notStubbed("WaitForStackReady")
+	}
+	return f.WaitForStackReadyFunc(ctx, id, interval)
+}
+
+// UpdateStack calls UpdateStackFunc.
+func (f *Client) UpdateStack(ctx context.Context, id string, req zenfraclient.UpdateStackRequest) (*zenfraclient.Stack, error) {
+	f.record("UpdateStack")
+	if f.UpdateStackFunc == nil {
+		return nil, notStubbed("UpdateStack")
+	}
+	return f.UpdateStackFunc(ctx, id, req)
+}
+
+// DeleteStack calls DeleteStackFunc.
+func (f *Client) DeleteStack(ctx context.Context, id string, opts *zenfraclient.DeleteStackOptions) error {
+	f.record("DeleteStack")
+	if f.DeleteStackFunc == nil {
+		return notStubbed("DeleteStack")
+	}
+	return f.DeleteStackFunc(ctx, id, opts)
+}
+
+// GetStackVariables calls GetStackVariablesFunc.
+func (f *Client) GetStackVariables(ctx context.Context, stackID string) ([]zenfraclient.StackVariable, error) {
+	f.record("GetStackVariables")
+	if f.GetStackVariablesFunc == nil {
+		return nil, notStubbed("GetStackVariables")
+	}
+	return f.GetStackVariablesFunc(ctx, stackID)
+}
+
+// GetStackVariablesCached calls GetStackVariablesCachedFunc.
+func (f *Client) GetStackVariablesCached(ctx context.Context, stackID string) ([]zenfraclient.StackVariable, error) {
+	f.record("GetStackVariablesCached")
+	if f.GetStackVariablesCachedFunc == nil {
+		return nil, notStubbed("GetStackVariablesCached")
+	}
+	return f.GetStackVariablesCachedFunc(ctx, stackID)
+}
+
+// SetStackVariables calls SetStackVariablesFunc.
+func (f *Client) SetStackVariables(ctx context.Context, stackID string, vars []zenfraclient.StackVariable) ([]zenfraclient.StackVariable, error) {
+	f.record("SetStackVariables")
+	if f.SetStackVariablesFunc == nil {
+		return nil, notStubbed("SetStackVariables")
+	}
+	return f.SetStackVariablesFunc(ctx, stackID, vars)
+}
+
+// SetStackSource calls SetStackSourceFunc.
+func (f *Client) SetStackSource(ctx context.Context, stackID string, source zenfraclient.StackSource) error {
+	f.record("SetStackSource")
+	if f.SetStackSourceFunc == nil {
+		return notStubbed("SetStackSource")
+	}
+	return f.SetStackSourceFunc(ctx, stackID, source)
+}
+
+// SetStackTriggers calls SetStackTriggersFunc.
+func (f *Client) SetStackTriggers(ctx context.Context, stackID string, triggers zenfraclient.StackTriggers) error {
+	f.record("SetStackTriggers")
+	if f.SetStackTriggersFunc == nil {
+		return notStubbed("SetStackTriggers")
+	}
+	return f.SetStackTriggersFunc(ctx, stackID, triggers)
+}
+
+// ListActiveStackRuns calls ListActiveStackRunsFunc.
+func (f *Client) ListActiveStackRuns(ctx context.Context, stackID string) ([]zenfraclient.ActiveRun, error) {
+	f.record("ListActiveStackRuns")
+	if f.ListActiveStackRunsFunc == nil {
+		return nil, notStubbed("ListActiveStackRuns")
+	}
+	return f.ListActiveStackRunsFunc(ctx, stackID)
+}
+
+// WaitForStackIdle calls WaitForStackIdleFunc.
+func (f *Client) WaitForStackIdle(ctx context.Context, stackID string, interval time.Duration) error {
+	f.record("WaitForStackIdle")
+	if f.WaitForStackIdleFunc == nil {
+		return notStubbed("WaitForStackIdle")
+	}
+	return f.WaitForStackIdleFunc(ctx, stackID, interval)
+}
+
+// ListStackBundles calls ListStackBundlesFunc.
+func (f *Client) ListStackBundles(ctx context.Context, stackID string) ([]zenfraclient.BundleAttachment, error) {
+	f.record("ListStackBundles")
+	if f.ListStackBundlesFunc == nil {
+		return nil, notStubbed("ListStackBundles")
+	}
+	return f.ListStackBundlesFunc(ctx, stackID)
+}
+
+// ListStateSnapshots calls ListStateSnapshotsFunc.
+func (f *Client) ListStateSnapshots(ctx context.Context, stackID string) ([]zenfraclient.StateSnapshot, error) {
+	f.record("ListStateSnapshots")
+	if f.ListStateSnapshotsFunc == nil {
+		return nil, notStubbed("ListStateSnapshots")
+	}
+	return f.ListStateSnapshotsFunc(ctx, stackID)
+}
+
+// RollbackState calls RollbackStateFunc.
+func (f *Client) RollbackState(ctx context.Context, stackID string, req zenfraclient.RollbackStateRequest) (*zenfraclient.StateRollback, error) {
+	f.record("RollbackState")
+	if f.RollbackStateFunc == nil {
+		return nil, notStubbed("RollbackState")
+	}
+	return f.RollbackStateFunc(ctx, stackID, req)
+}
+
+// SetStackManagedLock calls SetStackManagedLockFunc.
+func (f *Client) SetStackManagedLock(ctx context.Context, stackID string, enabled bool) (*zenfraclient.ManagedLock, error) {
+	f.record("SetStackManagedLock")
+	if f.SetStackManagedLockFunc == nil {
+		return nil, notStubbed("SetStackManagedLock")
+	}
+	return f.SetStackManagedLockFunc(ctx, stackID, enabled)
+}
+
+// ValidateStackManifest calls ValidateStackManifestFunc.
+func (f *Client) ValidateStackManifest(ctx context.Context, req zenfraclient.ValidateStackManifestRequest) (*zenfraclient.StackManifestValidation, error) {
+	f.record("ValidateStackManifest")
+	if f.ValidateStackManifestFunc == nil {
+		return nil, notStubbed("ValidateStackManifest")
+	}
+	return f.ValidateStackManifestFunc(ctx, req)
+}
+
+// CreateStackFromManifest calls CreateStackFromManifestFunc.
+func (f *Client) CreateStackFromManifest(ctx context.Context, req zenfraclient.StackManifestRequest) (*zenfraclient.StackManifest, error) {
+	f.record("CreateStackFromManifest")
+	if f.CreateStackFromManifestFunc == nil {
+		return nil, notStubbed("CreateStackFromManifest")
+	}
+	return f.CreateStackFromManifestFunc(ctx, req)
+}
+
+// GetStackManifest calls GetStackManifestFunc.
+func (f *Client) GetStackManifest(ctx context.Context, stackID string) (*zenfraclient.StackManifest, error) {
+	f.record("GetStackManifest")
+	if f.GetStackManifestFunc == nil {
+		return nil, notStubbed("GetStackManifest")
+	}
+	return f.GetStackManifestFunc(ctx, stackID)
+}
+
+// UpdateStackFromManifest calls UpdateStackFromManifestFunc.
+func (f *Client) UpdateStackFromManifest(ctx context.Context, stackID string, req zenfraclient.StackManifestRequest) (*zenfraclient.StackManifest, error) {
+	f.record("UpdateStackFromManifest")
+	if f.UpdateStackFromManifestFunc == nil {
+		return nil, notStubbed("UpdateStackFromManifest")
+	}
+	return f.UpdateStackFromManifestFunc(ctx, stackID, req)
+}
+
+// CreateBundle calls CreateBundleFunc.
+func (f *Client) CreateBundle(ctx context.Context, req zenfraclient.CreateBundleRequest) (*zenfraclient.Bundle, error) {
+	f.record("CreateBundle")
+	if f.CreateBundleFunc == nil {
+		return nil, notStubbed("CreateBundle")
+	}
+	return f.CreateBundleFunc(ctx, req)
+}
+
+// GetBundle calls GetBundleFunc.
+func (f *Client) GetBundle(ctx context.Context, id string) (*zenfraclient.Bundle, error) {
+	f.record("GetBundle")
+	if f.GetBundleFunc == nil {
+		return nil, notStubbed("GetBundle")
+	}
+	return f.GetBundleFunc(ctx, id)
+}
+
+// UpdateBundle calls UpdateBundleFunc.
+func (f *Client) UpdateBundle(ctx context.Context, id string, req zenfraclient.UpdateBundleRequest) (*zenfraclient.Bundle, error) {
+	f.record("UpdateBundle")
+	if f.UpdateBundleFunc == nil {
+		return nil, notStubbed("UpdateBundle")
+	}
+	return f.UpdateBundleFunc(ctx, id, req)
+}
+
+// UpdateBundleContent calls UpdateBundleContentFunc.
+func (f *Client) UpdateBundleContent(ctx context.Context, id string, req zenfraclient.UpdateBundleContentRequest) (*zenfraclient.UpdateBundleContentResponse, error) {
+	f.record("UpdateBundleContent")
+	if f.UpdateBundleContentFunc == nil {
+		return nil, notStubbed("UpdateBundleContent")
+	}
+	return f.UpdateBundleContentFunc(ctx, id, req)
+}
+
+// ValidateBundleContent calls ValidateBundleContentFunc.
+func (f *Client) ValidateBundleContent(ctx context.Context, req zenfraclient.ValidateBundleContentRequest) (*zenfraclient.BundleContentValidation, error) {
+	f.record("ValidateBundleContent")
+	if f.ValidateBundleContentFunc == nil {
+		return nil, notStubbed("ValidateBundleContent")
+	}
+	return f.ValidateBundleContentFunc(ctx, req)
+}
+
+// DeleteBundle calls DeleteBundleFunc.
+func (f *Client) DeleteBundle(ctx context.Context, id string) error {
+	f.record("DeleteBundle")
+	if f.DeleteBundleFunc == nil {
+		return notStubbed("DeleteBundle")
+	}
+	return f.DeleteBundleFunc(ctx, id)
+}
+
+// SetBundleManagedLock calls SetBundleManagedLockFunc.
+func (f *Client) SetBundleManagedLock(ctx context.Context, id string, enabled bool) (*zenfraclient.ManagedLock, error) {
+	f.record("SetBundleManagedLock")
+	if f.SetBundleManagedLockFunc == nil {
+		return nil, notStubbed("SetBundleManagedLock")
+	}
+	return f.SetBundleManagedLockFunc(ctx, id, enabled)
+}
+
+// AttachBundle calls AttachBundleFunc.
+func (f *Client) AttachBundle(ctx context.Context, stackID string, bundleID string) error {
+	f.record("AttachBundle")
+	if f.AttachBundleFunc == nil {
+		return notStubbed("AttachBundle")
+	}
+	return f.AttachBundleFunc(ctx, stackID, bundleID)
+}
+
+// DetachBundle calls DetachBundleFunc.
+func (f *Client) DetachBundle(ctx context.Context, stackID string, bundleID string) error {
+	f.record("DetachBundle")
+	if f.DetachBundleFunc == nil {
+		return notStubbed("DetachBundle")
+	}
+	return f.DetachBundleFunc(ctx, stackID, bundleID)
+}
+
+// AttachSpaceBundle calls AttachSpaceBundleFunc.
+func (f *Client) AttachSpaceBundle(ctx context.Context, spaceID string, bundleID string) error {
+	f.record("AttachSpaceBundle")
+	if f.AttachSpaceBundleFunc == nil {
+		return notStubbed("AttachSpaceBundle")
+	}
+	return f.AttachSpaceBundleFunc(ctx, spaceID, bundleID)
+}
+
+// DetachSpaceBundle calls DetachSpaceBundleFunc.
+func (f *Client) DetachSpaceBundle(ctx context.Context, spaceID string, bundleID string) error {
+	f.record("DetachSpaceBundle")
+	if f.DetachSpaceBundleFunc == nil {
+		return notStubbed("DetachSpaceBundle")
+	}
+	return f.DetachSpaceBundleFunc(ctx, spaceID, bundleID)
+}
+
+// ListSpaceBundles calls ListSpaceBundlesFunc.
+func (f *Client) ListSpaceBundles(ctx context.Context, spaceID string) ([]zenfraclient.SpaceBundleAttachment, error) {
+	f.record("ListSpaceBundles")
+	if f.ListSpaceBundlesFunc == nil {
+		return nil, notStubbed("ListSpaceBundles")
+	}
+	return f.ListSpaceBundlesFunc(ctx, spaceID)
+}
+
+// CreateSecretBackend calls CreateSecretBackendFunc.
+func (f *Client) CreateSecretBackend(ctx context.Context, req zenfraclient.CreateSecretBackendRequest) (*zenfraclient.SecretBackend, error) {
+	f.record("CreateSecretBackend")
+	if f.CreateSecretBackendFunc == nil {
+		return nil, notStubbed("CreateSecretBackend")
+	}
+	return f.CreateSecretBackendFunc(ctx, req)
+}
+
+// GetSecretBackend calls GetSecretBackendFunc.
+func (f *Client) GetSecretBackend(ctx context.Context, id string) (*zenfraclient.SecretBackend, error) {
+	f.record("GetSecretBackend")
+	if f.GetSecretBackendFunc == nil {
+		return nil, notStubbed("GetSecretBackend")
+	}
+	return f.GetSecretBackendFunc(ctx, id)
+}
+
+// UpdateSecretBackend calls UpdateSecretBackendFunc.
+func (f *Client) UpdateSecretBackend(ctx context.Context, id string, req zenfraclient.UpdateSecretBackendRequest) (*zenfraclient.SecretBackend, error) {
+	f.record("UpdateSecretBackend")
+	if f.UpdateSecretBackendFunc == nil {
+		return nil, notStubbed("UpdateSecretBackend")
+	}
+	return f.UpdateSecretBackendFunc(ctx, id, req)
+}
+
+// DeleteSecretBackend calls DeleteSecretBackendFunc.
+func (f *Client) DeleteSecretBackend(ctx context.Context, id string) error {
+	f.record("DeleteSecretBackend")
+	if f.DeleteSecretBackendFunc == nil {
+		return notStubbed("DeleteSecretBackend")
+	}
+	return f.DeleteSecretBackendFunc(ctx, id)
+}
+
+// CreateBundleSecretReference calls CreateBundleSecretReferenceFunc.
+func (f *Client) CreateBundleSecretReference(ctx context.Context, bundleID string, req zenfraclient.BundleSecretReferenceRequest) (*zenfraclient.BundleSecretReference, error) {
+	f.record("CreateBundleSecretReference")
+	if f.CreateBundleSecretReferenceFunc == nil {
+		return nil, notStubbed("CreateBundleSecretReference")
+	}
+	return f.CreateBundleSecretReferenceFunc(ctx, bundleID, req)
+}
+
+// GetBundleSecretReference calls GetBundleSecretReferenceFunc.
+func (f *Client) GetBundleSecretReference(ctx context.Context, bundleID string, id string) (*zenfraclient.BundleSecretReference, error) {
+	f.record("GetBundleSecretReference")
+	if f.GetBundleSecretReferenceFunc == nil {
+		return nil, notStubbed("GetBundleSecretReference")
+	}
+	return f.GetBundleSecretReferenceFunc(ctx, bundleID, id)
+}
+
+// UpdateBundleSecretReference calls UpdateBundleSecretReferenceFunc.
+func (f *Client) UpdateBundleSecretReference(ctx context.Context, bundleID string, id string, req zenfraclient.BundleSecretReferenceRequest) (*zenfraclient.BundleSecretReference, error) {
+	f.record("UpdateBundleSecretReference")
+	if f.UpdateBundleSecretReferenceFunc == nil {
+		return nil, notStubbed("UpdateBundleSecretReference")
+	}
+	return f.UpdateBundleSecretReferenceFunc(ctx, bundleID, id, req)
+}
+
+// DeleteBundleSecretReference calls DeleteBundleSecretReferenceFunc.
+func (f *Client) DeleteBundleSecretReference(ctx context.Context, bundleID string, id string) error {
+	f.record("DeleteBundleSecretReference")
+	if f.DeleteBundleSecretReferenceFunc == nil {
+		return notStubbed("DeleteBundleSecretReference")
+	}
+	return f.DeleteBundleSecretReferenceFunc(ctx, bundleID, id)
+}
+
+// CreateVariableSet calls CreateVariableSetFunc.
+func (f *Client) CreateVariableSet(ctx context.Context, req zenfraclient.CreateVariableSetRequest) (*zenfraclient.VariableSet, error) {
+	f.record("CreateVariableSet")
+	if f.CreateVariableSetFunc == nil {
+		return nil, notStubbed("CreateVariableSet")
+	}
+	return f.CreateVariableSetFunc(ctx, req)
+}
+
+// GetVariableSet calls GetVariableSetFunc.
+func (f *Client) GetVariableSet(ctx context.Context, id string) (*zenfraclient.VariableSet, error) {
+	f.record("GetVariableSet")
+	if f.GetVariableSetFunc == nil {
+		return nil, notStubbed("GetVariableSet")
+	}
+	return f.GetVariableSetFunc(ctx, id)
+}
+
+// UpdateVariableSet calls UpdateVariableSetFunc.
+func (f *Client) UpdateVariableSet(ctx context.Context, id string, req zenfraclient.UpdateVariableSetRequest) (*zenfraclient.VariableSet, error) {
+	f.record("UpdateVariableSet")
+	if f.UpdateVariableSetFunc == nil {
+		return nil, notStubbed("UpdateVariableSet")
+	}
+	return f.UpdateVariableSetFunc(ctx, id, req)
+}
+
+// DeleteVariableSet calls DeleteVariableSetFunc.
+func (f *Client) DeleteVariableSet(ctx context.Context, id string) error {
+	f.record("DeleteVariableSet")
+	if f.DeleteVariableSetFunc == nil {
+		return notStubbed("DeleteVariableSet")
+	}
+	return f.DeleteVariableSetFunc(ctx, id)
+}
+
+// CreateVariableSetVariable calls CreateVariableSetVariableFunc.
+func (f *Client) CreateVariableSetVariable(ctx context.Context, setID string, req zenfraclient.VariableSetVariableRequest) (*zenfraclient.VariableSetVariable, error) {
+	f.record("CreateVariableSetVariable")
+	if f.CreateVariableSetVariableFunc == nil {
+		return nil, notStubbed("CreateVariableSetVariable")
+	}
+	return f.CreateVariableSetVariableFunc(ctx, setID, req)
+}
+
+// GetVariableSetVariable calls GetVariableSetVariableFunc.
+func (f *Client) GetVariableSetVariable(ctx context.Context, setID string, key string) (*zenfraclient.VariableSetVariable, error) {
+	f.record("GetVariableSetVariable")
+	if f.GetVariableSetVariableFunc == nil {
+		return nil, notStubbed("GetVariableSetVariable")
+	}
+	return f.GetVariableSetVariableFunc(ctx, setID, key)
+}
+
+// UpdateVariableSetVariable calls UpdateVariableSetVariableFunc.
+func (f *Client) UpdateVariableSetVariable(ctx context.Context, setID string, key string, req zenfraclient.VariableSetVariableRequest) (*zenfraclient.VariableSetVariable, error) {
+	f.record("UpdateVariableSetVariable")
+	if f.UpdateVariableSetVariableFunc == nil {
+		return nil, notStubbed("UpdateVariableSetVariable")
+	}
+	return f.UpdateVariableSetVariableFunc(ctx, setID, key, req)
+}
+
+// DeleteVariableSetVariable calls DeleteVariableSetVariableFunc.
+func (f *Client) DeleteVariableSetVariable(ctx context.Context, setID string, key string) error {
+	f.record("DeleteVariableSetVariable")
+	if f.DeleteVariableSetVariableFunc == nil {
+		return notStubbed("DeleteVariableSetVariable")
+	}
+	return f.DeleteVariableSetVariableFunc(ctx, setID, key)
+}
+
+// CreateVariableSetScope calls CreateVariableSetScopeFunc.
+func (f *Client) CreateVariableSetScope(ctx context.Context, setID string, req zenfraclient.CreateVariableSetScopeRequest) (*zenfraclient.VariableSetScope, error) {
+	f.record("CreateVariableSetScope")
+	if f.CreateVariableSetScopeFunc == nil {
+		return nil, notStubbed("CreateVariableSetScope")
+	}
+	return f.CreateVariableSetScopeFunc(ctx, setID, req)
+}
+
+// GetVariableSetScope calls GetVariableSetScopeFunc.
+func (f *Client) GetVariableSetScope(ctx context.Context, setID string, id string) (*zenfraclient.VariableSetScope, error) {
+	f.record("GetVariableSetScope")
+	if f.GetVariableSetScopeFunc == nil {
+		return nil, notStubbed("GetVariableSetScope")
+	}
+	return f.GetVariableSetScopeFunc(ctx, setID, id)
+}
+
+// DeleteVariableSetScope calls DeleteVariableSetScopeFunc.
+func (f *Client) DeleteVariableSetScope(ctx context.Context, setID string, id string) error {
+	f.record("DeleteVariableSetScope")
+	if f.DeleteVariableSetScopeFunc == nil {
+		return notStubbed("DeleteVariableSetScope")
+	}
+	return f.DeleteVariableSetScopeFunc(ctx, setID, id)
+}
+
+// CreateWorkerPool calls CreateWorkerPoolFunc.
+func (f *Client) CreateWorkerPool(ctx context.Context, req zenfraclient.CreateWorkerPoolRequest) (*zenfraclient.CreateWorkerPoolResponse, error) {
+	f.record("CreateWorkerPool")
+	if f.CreateWorkerPoolFunc == nil {
+		return nil, notStubbed("CreateWorkerPool")
+	}
+	return f.CreateWorkerPoolFunc(ctx, req)
+}
+
+// GetWorkerPool calls GetWorkerPoolFunc.
+func (f *Client) GetWorkerPool(ctx context.Context, id string) (*zenfraclient.WorkerPool, error) {
+	f.record("GetWorkerPool")
+	if f.GetWorkerPoolFunc == nil {
+		return nil, notStubbed("GetWorkerPool")
+	}
+	return f.GetWorkerPoolFunc(ctx, id)
+}
+
+// GetWorkerPoolCached calls GetWorkerPoolCachedFunc.
+func (f *Client) GetWorkerPoolCached(ctx context.Context, id string) (*zenfraclient.WorkerPool, error) {
+	f.record("GetWorkerPoolCached")
+	if f.GetWorkerPoolCachedFunc == nil {
+		return nil, notStubbed("GetWorkerPoolCached")
+	}
+	return f.GetWorkerPoolCachedFunc(ctx, id)
+}
+
+// UpdateWorkerPool calls UpdateWorkerPoolFunc.
+func (f *Client) UpdateWorkerPool(ctx context.Context, id string, req zenfraclient.UpdateWorkerPoolRequest) (*zenfraclient.WorkerPool, error) {
+	f.record("UpdateWorkerPool")
+	if f.UpdateWorkerPoolFunc == nil {
+		return nil, notStubbed("UpdateWorkerPool")
+	}
+	return f.UpdateWorkerPoolFunc(ctx, id, req)
+}
+
+// DrainWorkerPool calls DrainWorkerPoolFunc.
+func (f *Client) DrainWorkerPool(ctx context.Context, id string) (*zenfraclient.WorkerPool, error) {
+	f.record("DrainWorkerPool")
+	if f.DrainWorkerPoolFunc == nil {
+		return nil, notStubbed("DrainWorkerPool")
+	}
+	return f.DrainWorkerPoolFunc(ctx, id)
+}
+
+// ResumeWorkerPool calls ResumeWorkerPoolFunc.
+func (f *Client) ResumeWorkerPool(ctx context.Context, id string) (*zenfraclient.WorkerPool, error) {
+	f.record("ResumeWorkerPool")
+	if f.ResumeWorkerPoolFunc == nil {
+		return nil, notStubbed("ResumeWorkerPool")
+	}
+	return f.ResumeWorkerPoolFunc(ctx, id)
+}
+
+// WaitForWorkerPoolDrained calls WaitForWorkerPoolDrainedFunc.
+func (f *Client) WaitForWorkerPoolDrained(ctx context.Context, id string, interval time.Duration) (*zenfraclient.WorkerPool, error) {
+	f.record("WaitForWorkerPoolDrained")
+	if f.WaitForWorkerPoolDrainedFunc == nil {
+		return nil, notStubbed("WaitForWorkerPoolDrained")
+	}
+	return f.WaitForWorkerPoolDrainedFunc(ctx, id, interval)
+}
+
+// DeleteWorkerPool calls DeleteWorkerPoolFunc.
+func (f *Client) DeleteWorkerPool(ctx context.Context, id string) error {
+	f.record("DeleteWorkerPool")
+	if f.DeleteWorkerPoolFunc == nil {
+		return notStubbed("DeleteWorkerPool")
+	}
+	return f.DeleteWorkerPoolFunc(ctx, id)
+}
+
+// ListRunnerVersions calls ListRunnerVersionsFunc.
+func (f *Client) ListRunnerVersions(ctx context.Context) ([]zenfraclient.RunnerVersion, error) {
+	f.record("ListRunnerVersions")
+	if f.ListRunnerVersionsFunc == nil {
+		return nil, notStubbed("ListRunnerVersions")
+	}
+	return f.ListRunnerVersionsFunc(ctx)
+}
+
+// GetWorkerPoolAssignment calls GetWorkerPoolAssignmentFunc.
+func (f *Client) GetWorkerPoolAssignment(ctx context.Context, spaceID string) (*zenfraclient.WorkerPoolAssignment, error) {
+	f.record("GetWorkerPoolAssignment")
+	if f.GetWorkerPoolAssignmentFunc == nil {
+		return nil, notStubbed("GetWorkerPoolAssignment")
+	}
+	return f.GetWorkerPoolAssignmentFunc(ctx, spaceID)
+}
+
+// SetWorkerPoolAssignment calls SetWorkerPoolAssignmentFunc.
+func (f *Client) SetWorkerPoolAssignment(ctx context.Context, spaceID string, req zenfraclient.SetWorkerPoolAssignmentRequest) (*zenfraclient.WorkerPoolAssignment, error) {
+	f.record("SetWorkerPoolAssignment")
+	if f.SetWorkerPoolAssignmentFunc == nil {
+		return nil, notStubbed("SetWorkerPoolAssignment")
+	}
+	return f.SetWorkerPoolAssignmentFunc(ctx, spaceID, req)
+}
+
+// DeleteWorkerPoolAssignment calls DeleteWorkerPoolAssignmentFunc.
+func (f *Client) DeleteWorkerPoolAssignment(ctx context.Context, spaceID string) error {
+	f.record("DeleteWorkerPoolAssignment")
+	if f.DeleteWorkerPoolAssignmentFunc == nil {
+		return notStubbed("DeleteWorkerPoolAssignment")
+	}
+	return f.DeleteWorkerPoolAssignmentFunc(ctx, spaceID)
+}
+
+// CreateToken calls CreateTokenFunc.
+func (f *Client) CreateToken(ctx context.Context, req zenfraclient.CreateTokenRequest) (*zenfraclient.CreateTokenResponse, error) {
+	f.record("CreateToken")
+	if f.CreateTokenFunc == nil {
+		return nil, notStubbed("CreateToken")
+	}
+	return f.CreateTokenFunc(ctx, req)
+}
+
+// GetToken calls GetTokenFunc.
+func (f *Client) GetToken(ctx context.Context, id string) (*zenfraclient.Token, error) {
+	f.record("GetToken")
+	if f.GetTokenFunc == nil {
+		return nil, notStubbed("GetToken")
+	}
+	return f.GetTokenFunc(ctx, id)
+}
+
+// DeleteToken calls DeleteTokenFunc.
+func (f *Client) DeleteToken(ctx context.Context, id string) error {
+	f.record("DeleteToken")
+	if f.DeleteTokenFunc == nil {
+		return notStubbed("DeleteToken")
+	}
+	return f.DeleteTokenFunc(ctx, id)
+}
+
+// CreateSigningKey calls CreateSigningKeyFunc.
+func (f *Client) CreateSigningKey(ctx context.Context, req zenfraclient.CreateSigningKeyRequest) (*zenfraclient.SigningKey, error) {
+	f.record("CreateSigningKey")
+	if f.CreateSigningKeyFunc == nil {
+		return nil, notStubbed("CreateSigningKey")
+	}
+	return f.CreateSigningKeyFunc(ctx, req)
+}
+
+// GetSigningKey calls GetSigningKeyFunc.
+func (f *Client) GetSigningKey(ctx context.Context, id string) (*zenfraclient.SigningKey, error) {
+	f.record("GetSigningKey")
+	if f.GetSigningKeyFunc == nil {
+		return nil, notStubbed("GetSigningKey")
+	}
+	return f.GetSigningKeyFunc(ctx, id)
+}
+
+// UpdateSigningKey calls UpdateSigningKeyFunc.
+func (f *Client) UpdateSigningKey(ctx context.Context, id string, req zenfraclient.UpdateSigningKeyRequest) (*zenfraclient.SigningKey, error) {
+	f.record("UpdateSigningKey")
+	if f.UpdateSigningKeyFunc == nil {
+		return nil, notStubbed("UpdateSigningKey")
+	}
+	return f.UpdateSigningKeyFunc(ctx, id, req)
+}
+
+// DeleteSigningKey calls DeleteSigningKeyFunc.
+func (f *Client) DeleteSigningKey(ctx context.Context, id string) error {
+	f.record("DeleteSigningKey")
+	if f.DeleteSigningKeyFunc == nil {
+		return notStubbed("DeleteSigningKey")
+	}
+	return f.DeleteSigningKeyFunc(ctx, id)
+}
+
+// CreateInvitation calls CreateInvitationFunc.
+func (f *Client) CreateInvitation(ctx context.Context, req zenfraclient.CreateInvitationRequest) (*zenfraclient.Invitation, error) {
+	f.record("CreateInvitation")
+	if f.CreateInvitationFunc == nil {
+		return nil, notStubbed("CreateInvitation")
+	}
+	return f.CreateInvitationFunc(ctx, req)
+}
+
+// GetInvitation calls GetInvitationFunc.
+func (f *Client) GetInvitation(ctx context.Context, id string) (*zenfraclient.Invitation, error) {
+	f.record("GetInvitation")
+	if f.GetInvitationFunc == nil {
+		return nil, notStubbed("GetInvitation")
+	}
+	return f.GetInvitationFunc(ctx, id)
+}
+
+// UpdateInvitation calls UpdateInvitationFunc.
+func (f *Client) UpdateInvitation(ctx context.Context, id string, req zenfraclient.UpdateInvitationRequest) (*zenfraclient.Invitation, error) {
+	f.record("UpdateInvitation")
+	if f.UpdateInvitationFunc == nil {
+		return nil, notStubbed("UpdateInvitation")
+	}
+	return f.UpdateInvitationFunc(ctx, id, req)
+}
+
+// ResendInvitation calls ResendInvitationFunc.
+func (f *Client) ResendInvitation(ctx context.Context, id string, req zenfraclient.ResendInvitationRequest) (*zenfraclient.Invitation, error) {
+	f.record("ResendInvitation")
+	if f.ResendInvitationFunc == nil {
+		return nil, notStubbed("ResendInvitation")
+	}
+	return f.ResendInvitationFunc(ctx, id, req)
+}
+
+// RevokeInvitation calls RevokeInvitationFunc.
+func (f *Client) RevokeInvitation(ctx context.Context, id string) error {
+	f.record("RevokeInvitation")
+	if f.RevokeInvitationFunc == nil {
+		return notStubbed("RevokeInvitation")
+	}
+	return f.RevokeInvitationFunc(ctx, id)
+}
+
+// FindMember calls FindMemberFunc.
+func (f *Client) FindMember(ctx context.Context, email string) (*zenfraclient.Member, error) {
+	f.record("FindMember")
+	if f.FindMemberFunc == nil {
+		return nil, notStubbed("FindMember")
+	}
+	return f.FindMemberFunc(ctx, email)
+}
+
+// CreateOrganizationDomain calls CreateOrganizationDomainFunc.
+func (f *Client) CreateOrganizationDomain(ctx context.Context, req zenfraclient.CreateOrganizationDomainRequest) (*zenfraclient.OrganizationDomain, error) {
+	f.record("CreateOrganizationDomain")
+	if f.CreateOrganizationDomainFunc == nil {
+		return nil, notStubbed("CreateOrganizationDomain")
+	}
+	return f.CreateOrganizationDomainFunc(ctx, req)
+}
+
+// GetOrganizationDomain calls GetOrganizationDomainFunc.
+func (f *Client) GetOrganizationDomain(ctx context.Context, id string) (*zenfraclient.OrganizationDomain, error) {
+	f.record("GetOrganizationDomain")
+	if f.GetOrganizationDomainFunc == nil {
+		return nil, notStubbed("GetOrganizationDomain")
+	}
+	return f.GetOrganizationDomainFunc(ctx, id)
+}
+
+// UpdateOrganizationDomain calls UpdateOrganizationDomainFunc.
+func (f *Client) UpdateOrganizationDomain(ctx context.Context, id string, req zenfraclient.UpdateOrganizationDomainRequest) (*zenfraclient.OrganizationDomain, error) {
+	f.record("UpdateOrganizationDomain")
+	if f.UpdateOrganizationDomainFunc == nil {
+		return nil, notStubbed("UpdateOrganizationDomain")
+	}
+	return f.UpdateOrganizationDomainFunc(ctx, id, req)
+}
+
+// DeleteOrganizationDomain calls DeleteOrganizationDomainFunc.
+func (f *Client) DeleteOrganizationDomain(ctx context.Context, id string) error {
+	f.record("DeleteOrganizationDomain")
+	if f.DeleteOrganizationDomainFunc == nil {
+		return notStubbed("DeleteOrganizationDomain")
+	}
+	return f.DeleteOrganizationDomainFunc(ctx, id)
+}
+
+// VerifyOrganizationDomain calls VerifyOrganizationDomainFunc.
+func (f *Client) VerifyOrganizationDomain(ctx context.Context, id string) (*zenfraclient.OrganizationDomain, error) {
+	f.record("VerifyOrganizationDomain")
+	if f.VerifyOrganizationDomainFunc == nil {
+		return nil, notStubbed("VerifyOrganizationDomain")
+	}
+	return f.VerifyOrganizationDomainFunc(ctx, id)
+}
+
+// WaitForOrganizationDomainVerified calls WaitForOrganizationDomainVerifiedFunc.
+func (f *Client) WaitForOrganizationDomainVerified(ctx context.Context, id string, interval time.Duration) (*zenfraclient.OrganizationDomain, error) {
+	f.record("WaitForOrganizationDomainVerified")
+	if f.WaitForOrganizationDomainVerifiedFunc == nil {
+		return nil, notStubbed("WaitForOrganizationDomainVerified")
+	}
+	return f.WaitForOrganizationDomainVerifiedFunc(ctx, id, interval)
+}
+
+// CreateRunComment calls CreateRunCommentFunc.
+func (f *Client) CreateRunComment(ctx context.Context, runID string, req zenfraclient.CreateRunCommentRequest) (*zenfraclient.RunComment, error) {
+	f.record("CreateRunComment")
+	if f.CreateRunCommentFunc == nil {
+		return nil, notStubbed("CreateRunComment")
+	}
+	return f.CreateRunCommentFunc(ctx, runID, req)
+}
+
+// GetRunComment calls GetRunCommentFunc.
+func (f *Client) GetRunComment(ctx context.Context, runID string, commentID string) (*zenfraclient.RunComment, error) {
+	f.record("GetRunComment")
+	if f.GetRunCommentFunc == nil {
+		return nil, notStubbed("GetRunComment")
+	}
+	return f.GetRunCommentFunc(ctx, runID, commentID)
+}
+
+// CreateOutputSubscription calls CreateOutputSubscriptionFunc.
+func (f *Client) CreateOutputSubscription(ctx context.Context, stackID string, req zenfraclient.CreateOutputSubscriptionRequest) (*zenfraclient.OutputSubscription, error) {
+	f.record("CreateOutputSubscription")
+	if f.CreateOutputSubscriptionFunc == nil {
+		return nil, notStubbed("CreateOutputSubscription")
+	}
+	return f.CreateOutputSubscriptionFunc(ctx, stackID, req)
+}
+
+// GetOutputSubscription calls GetOutputSubscriptionFunc.
+func (f *Client) GetOutputSubscription(ctx context.Context, stackID string, id string) (*zenfraclient.OutputSubscription, error) {
+	f.record("GetOutputSubscription")
+	if f.GetOutputSubscriptionFunc == nil {
+		return nil, notStubbed("GetOutputSubscription")
+	}
+	return f.GetOutputSubscriptionFunc(ctx, stackID, id)
+}
+
+// UpdateOutputSubscription calls UpdateOutputSubscriptionFunc.
+func (f *Client) UpdateOutputSubscription(ctx context.Context, stackID string, id string, req zenfraclient.UpdateOutputSubscriptionRequest) (*zenfraclient.OutputSubscription, error) {
+	f.record("UpdateOutputSubscription")
+	if f.UpdateOutputSubscriptionFunc == nil {
+		return nil, notStubbed("UpdateOutputSubscription")
+	}
+	return f.UpdateOutputSubscriptionFunc(ctx, stackID, id, req)
+}
+
+// DeleteOutputSubscription calls DeleteOutputSubscriptionFunc.
+func (f *Client) DeleteOutputSubscription(ctx context.Context, stackID string, id string) error {
+	f.record("DeleteOutputSubscription")
+	if f.DeleteOutputSubscriptionFunc == nil {
+		return notStubbed("DeleteOutputSubscription")
+	}
+	return f.DeleteOutputSubscriptionFunc(ctx, stackID, id)
+}
+
+// GetStackDependencyGraph calls GetStackDependencyGraphFunc.
+func (f *Client) GetStackDependencyGraph(ctx context.Context, opts *zenfraclient.StackDependencyGraphOptions) (*zenfraclient.StackDependencyGraph, error) {
+	f.record("GetStackDependencyGraph")
+	if f.GetStackDependencyGraphFunc == nil {
+		return nil, notStubbed("GetStackDependencyGraph")
+	}
+	return f.GetStackDependencyGraphFunc(ctx, opts)
+}
+
+// CreateRateLimitPolicy calls CreateRateLimitPolicyFunc.
+func (f *Client) CreateRateLimitPolicy(ctx context.Context, req zenfraclient.CreateRateLimitPolicyRequest) (*zenfraclient.RateLimitPolicy, error) {
+	f.record("CreateRateLimitPolicy")
+	if f.CreateRateLimitPolicyFunc == nil {
+		return nil, notStubbed("CreateRateLimitPolicy")
+	}
+	return f.CreateRateLimitPolicyFunc(ctx, req)
+}
+
+// GetRateLimitPolicy calls GetRateLimitPolicyFunc.
+func (f *Client) GetRateLimitPolicy(ctx context.Context, id string) (*zenfraclient.RateLimitPolicy, error) {
+	f.record("GetRateLimitPolicy")
+	if f.GetRateLimitPolicyFunc == nil {
+		return nil, notStubbed("GetRateLimitPolicy")
+	}
+	return f.GetRateLimitPolicyFunc(ctx, id)
+}
+
+// UpdateRateLimitPolicy calls UpdateRateLimitPolicyFunc.
+func (f *Client) UpdateRateLimitPolicy(ctx context.Context, id string, req zenfraclient.UpdateRateLimitPolicyRequest) (*zenfraclient.RateLimitPolicy, error) {
+	f.record("UpdateRateLimitPolicy")
+	if f.UpdateRateLimitPolicyFunc == nil {
+		return nil, notStubbed("UpdateRateLimitPolicy")
+	}
+	return f.UpdateRateLimitPolicyFunc(ctx, id, req)
+}
+
+// DeleteRateLimitPolicy calls DeleteRateLimitPolicyFunc.
+func (f *Client) DeleteRateLimitPolicy(ctx context.Context, id string) error {
+	f.record("DeleteRateLimitPolicy")
+	if f.DeleteRateLimitPolicyFunc == nil {
+		return notStubbed("DeleteRateLimitPolicy")
+	}
+	return f.DeleteRateLimitPolicyFunc(ctx, id)
+}
+
+// GetRateLimitBounds calls GetRateLimitBoundsFunc.
+func (f *Client) GetRateLimitBounds(ctx context.Context) (*zenfraclient.RateLimitBounds, error) {
+	f.record("GetRateLimitBounds")
+	if f.GetRateLimitBoundsFunc == nil {
+		return nil, notStubbed("GetRateLimitBounds")
+	}
+	return f.GetRateLimitBoundsFunc(ctx)
+}
+
+// GetRunQueueSettings calls GetRunQueueSettingsFunc.
+func (f *Client) GetRunQueueSettings(ctx context.Context) (*zenfraclient.RunQueueSettings, error) {
+	f.record("GetRunQueueSettings")
+	if f.GetRunQueueSettingsFunc == nil {
+		return nil, notStubbed("GetRunQueueSettings")
+	}
+	return f.GetRunQueueSettingsFunc(ctx)
+}
+
+// UpdateRunQueueSettings calls UpdateRunQueueSettingsFunc.
+func (f *Client) UpdateRunQueueSettings(ctx context.Context, req zenfraclient.UpdateRunQueueSettingsRequest) (*zenfraclient.RunQueueSettings, error) {
+	f.record("UpdateRunQueueSettings")
+	if f.UpdateRunQueueSettingsFunc == nil {
+		return nil, notStubbed("UpdateRunQueueSettings")
+	}
+	return f.UpdateRunQueueSettingsFunc(ctx, req)
+}
+
+// ResetRunQueueSettings calls ResetRunQueueSettingsFunc.
+func (f *Client) ResetRunQueueSettings(ctx context.Context) error {
+	f.record("ResetRunQueueSettings")
+	if f.ResetRunQueueSettingsFunc == nil {
+		return notStubbed("ResetRunQueueSettings")
+	}
+	return f.ResetRunQueueSettingsFunc(ctx)
+}
+
+// GetRetentionSettings calls GetRetentionSettingsFunc.
+func (f *Client) GetRetentionSettings(ctx context.Context) (*zenfraclient.RetentionSettings, error) {
+	f.record("GetRetentionSettings")
+	if f.GetRetentionSettingsFunc == nil {
+		return nil, notStubbed("GetRetentionSettings")
+	}
+	return f.GetRetentionSettingsFunc(ctx)
+}
+
+// UpdateRetentionSettings calls UpdateRetentionSettingsFunc.
+func (f *Client) UpdateRetentionSettings(ctx context.Context, req zenfraclient.UpdateRetentionSettingsRequest) (*zenfraclient.RetentionSettings, error) {
+	f.record("UpdateRetentionSettings")
+	if f.UpdateRetentionSettingsFunc == nil {
+		return nil, notStubbed("UpdateRetentionSettings")
+	}
+	return f.UpdateRetentionSettingsFunc(ctx, req)
+}
+
+// ResetRetentionSettings calls ResetRetentionSettingsFunc.
+func (f *Client) ResetRetentionSettings(ctx context.Context) error {
+	f.record("ResetRetentionSettings")
+	if f.ResetRetentionSettingsFunc == nil {
+		return notStubbed("ResetRetentionSettings")
+	}
+	return f.ResetRetentionSettingsFunc(ctx)
+}
+
+// GetRunnerVersionConstraint calls GetRunnerVersionConstraintFunc.
+func (f *Client) GetRunnerVersionConstraint(ctx context.Context) (*zenfraclient.RunnerVersionConstraint, error) {
+	f.record("GetRunnerVersionConstraint")
+	if f.GetRunnerVersionConstraintFunc == nil {
+		return nil, notStubbed("GetRunnerVersionConstraint")
+	}
+	return f.GetRunnerVersionConstraintFunc(ctx)
+}
+
+// UpdateRunnerVersionConstraint calls UpdateRunnerVersionConstraintFunc.
+func (f *Client) UpdateRunnerVersionConstraint(ctx context.Context, req zenfraclient.UpdateRunnerVersionConstraintRequest) (*zenfraclient.RunnerVersionConstraint, error) {
+	f.record("UpdateRunnerVersionConstraint")
+	if f.UpdateRunnerVersionConstraintFunc == nil {
+		return nil, notStubbed("UpdateRunnerVersionConstraint")
+	}
+	return f.UpdateRunnerVersionConstraintFunc(ctx, req)
+}
+
+// ResetRunnerVersionConstraint calls ResetRunnerVersionConstraintFunc.
+func (f *Client) ResetRunnerVersionConstraint(ctx context.Context) error {
+	f.record("ResetRunnerVersionConstraint")
+	if f.ResetRunnerVersionConstraintFunc == nil {
+		return notStubbed("ResetRunnerVersionConstraint")
+	}
+	return f.ResetRunnerVersionConstraintFunc(ctx)
+}
+
+// CreateVCSIntegration calls CreateVCSIntegrationFunc.
+func (f *Client) CreateVCSIntegration(ctx context.Context, req zenfraclient.CreateVCSIntegrationRequest) (*zenfraclient.VCSIntegration, error) {
+	f.record("CreateVCSIntegration")
+	if f.CreateVCSIntegrationFunc == nil {
+		return nil, notStubbed("CreateVCSIntegration")
+	}
+	return f.CreateVCSIntegrationFunc(ctx, req)
+}
+
+// GetVCSIntegration calls GetVCSIntegrationFunc.
+func (f *Client) GetVCSIntegration(ctx context.Context, id string) (*zenfraclient.VCSIntegration, error) {
+	f.record("GetVCSIntegration")
+	if f.GetVCSIntegrationFunc == nil {
+		return nil, notStubbed("GetVCSIntegration")
+	}
+	return f.GetVCSIntegrationFunc(ctx, id)
+}
+
+// UpdateVCSIntegration calls UpdateVCSIntegrationFunc.
+func (f *Client) UpdateVCSIntegration(ctx context.Context, id string, req zenfraclient.UpdateVCSIntegrationRequest) (*zenfraclient.VCSIntegration, error) {
+	f.record("UpdateVCSIntegration")
+	if f.UpdateVCSIntegrationFunc == nil {
+		return nil, notStubbed("UpdateVCSIntegration")
+	}
+	return f.UpdateVCSIntegrationFunc(ctx, id, req)
+}
+
+// UpdateVCSIntegrationCredentials calls UpdateVCSIntegrationCredentialsFunc.
+func (f *Client) UpdateVCSIntegrationCredentials(ctx context.Context, id string, req zenfraclient.UpdateVCSCredentialsRequest) (*zenfraclient.VCSIntegration, error) {
+	f.record("UpdateVCSIntegrationCredentials")
+	if f.UpdateVCSIntegrationCredentialsFunc == nil {
+		return nil, notStubbed("UpdateVCSIntegrationCredentials")
+	}
+	return f.UpdateVCSIntegrationCredentialsFunc(ctx, id, req)
+}
+
+// DeleteVCSIntegration calls DeleteVCSIntegrationFunc.
+func (f *Client) DeleteVCSIntegration(ctx context.Context, id string) error {
+	f.record("DeleteVCSIntegration")
+	if f.DeleteVCSIntegrationFunc == nil {
+		return notStubbed("DeleteVCSIntegration")
+	}
+	return f.DeleteVCSIntegrationFunc(ctx, id)
+}
+
+// GetWebhookEndpoint calls GetWebhookEndpointFunc.
+func (f *Client) GetWebhookEndpoint(ctx context.Context, id string) (*zenfraclient.WebhookEndpoint, error) {
+	f.record("GetWebhookEndpoint")
+	if f.GetWebhookEndpointFunc == nil {
+		return nil, notStubbed("GetWebhookEndpoint")
+	}
+	return f.GetWebhookEndpointFunc(ctx, id)
+}
+
+// RotateWebhookEndpointSecret calls RotateWebhookEndpointSecretFunc.
+func (f *Client) RotateWebhookEndpointSecret(ctx context.Context, id string) (*zenfraclient.WebhookSecretRotation, error) {
+	f.record("RotateWebhookEndpointSecret")
+	if f.RotateWebhookEndpointSecretFunc == nil {
+		return nil, notStubbed("RotateWebhookEndpointSecret")
+	}
+	return f.RotateWebhookEndpointSecretFunc(ctx, id)
+}
@@ -0,0 +1,115 @@
+// ABOUTME: Hand-written support for the generated fake client: call recording, API error constructors, and test state.
+// ABOUTME: fake.go is regenerated from ../api.go by gen.go; edit this file for anything else.
+
+// Package zenfrafake provides a configurable fake of the Zenfra API client for resource
+// unit tests. Set the function field for each call a test expects; calls without one
+// fail with an error naming the method, so unexpected API calls surface as test failures.
+package zenfrafake
+
+//go:generate go run gen.go
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// state is embedded in Client and holds everything that is not a stubbed method.
+type state struct {
+	// TreatForbiddenAsNotFound mirrors zenfraclient.ClientConfig.TreatForbiddenAsNotFound
+	// for IsNotFoundOnRead.
+	TreatForbiddenAsNotFound bool
+	// ReadOnly is reported by IsReadOnly, as for a provider configured with read_only.
+	ReadOnly bool
+	// ProtectedResourceTypes are reported by IsDestroyProtected, as for a provider
+	// configured with protect_resource_types.
+	ProtectedResourceTypes []string
+	// BulkRefresh is reported by IsBulkRefresh, as for a provider configured with
+	// bulk_refresh.
+	BulkRefresh bool
+
+	mu    sync.Mutex
+	calls []string
+}
+
+// Calls returns the names of the methods called so far, in order.
+func (s *state) Calls() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.calls...)
+}
+
+func (s *state) record(method string) {
+	s.mu.Lock()
+	s.calls = append(s.calls, method)
+	s.mu.Unlock()
+}
+
+// IsNotFoundOnRead matches zenfraclient.Client.IsNotFoundOnRead.
+func (s *state) IsNotFoundOnRead(err error) bool {
+	if zenfraclient.IsNotFound(err) {
+		return true
+	}
+	return s.TreatForbiddenAsNotFound && zenfraclient.IsForbidden(err)
+}
+
+// IsReadOnly matches zenfraclient.Client.IsReadOnly.
+func (s *state) IsReadOnly() bool {
+	return s.ReadOnly
+}
+
+// IsBulkRefresh matches zenfraclient.Client.IsBulkRefresh.
+func (s *state) IsBulkRefresh() bool {
+	return s.BulkRefresh
+}
+
+// IsDestroyProtected matches zenfraclient.Client.IsDestroyProtected.
+func (s *state) IsDestroyProtected(typeName string) bool {
+	return slices.Contains(s.ProtectedResourceTypes, typeName)
+}
+
+func notStubbed(method string) error {
+	return fmt.Errorf("zenfrafake: unexpected call to %s", method)
+}
+
+// NotFound returns the error the client returns for an HTTP 404.
+func NotFound() error {
+	return &zenfraclient.NotFoundError{APIError: zenfraclient.APIError{StatusCode: 404, Message: "not found"}}
+}
+
+// Forbidden returns the error the client returns for an HTTP 403.
+func Forbidden() error {
+	return &zenfraclient.ForbiddenError{APIError: zenfraclient.APIError{StatusCode: 403, Message: "forbidden"}}
+}
+
+// Conflict returns the error the client returns for an HTTP 409.
+func Conflict(message string) error {
+	return &zenfraclient.ConflictError{APIError: zenfraclient.APIError{StatusCode: 409, Message: message}}
+}
+
+// State returns a state of r's schema holding model, or a null state if model is nil.
+// Cast it to tfsdk.Plan or tfsdk.Config to build the other requests of a test.
+func State(t testing.TB, r resource.Resource, model any) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	if model != nil {
+		if diags := state.Set(ctx, model); diags.HasError() {
+			t.Fatalf("setting state: %v", diags)
+		}
+	}
+	return state
+}
@@ -0,0 +1,1122 @@
+// Code generated by internal/gen from the Zenfra OpenAPI document. DO NOT EDIT.
+
+package zenfraclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ActiveRun is generated from the OpenAPI schema of the same name.
+//
+// A queued or running run of a stack. While a stack has one, the API rejects changes to
+// the stack's source, triggers, and variables with 409 Conflict.
+type ActiveRun struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	TriggeredAt string `json:"triggered_at"`
+	TriggeredBy string `json:"triggered_by"`
+	Type        string `json:"type"`
+}
+
+// BundleAttachedStack is generated from the OpenAPI schema of the same name.
+//
+// A stack that receives a bundle's configuration, either through its own attachment or
+// through an attachment to its space or a parent space.
+type BundleAttachedStack struct {
+	// BundleAttachedViaStack or BundleAttachedViaSpace
+	AttachedVia string `json:"attached_via"`
+	SpaceID     string `json:"space_id"`
+	StackID     string `json:"stack_id"`
+	StackName   string `json:"stack_name"`
+	// The space the bundle is attached to, for BundleAttachedViaSpace
+	ViaSpaceID string `json:"via_space_id,omitempty"`
+}
+
+// BundleContentValidation is generated from the OpenAPI schema of the same name.
+//
+// The result of validating bundle content. The content is valid when Violations is empty.
+type BundleContentValidation struct {
+	Violations []BundleContentViolation `json:"violations"`
+}
+
+// BundleContentViolation is generated from the OpenAPI schema of the same name.
+//
+// One problem found while validating bundle content. At most one of EnvironmentVariable
+// and MountedFile names the entry at fault; neither is set for problems with the content
+// as a whole, such as its total size.
+type BundleContentViolation struct {
+	// Key of the offending variable
+	EnvironmentVariable string `json:"environment_variable,omitempty"`
+	Message             string `json:"message"`
+	// Path of the offending file
+	MountedFile string `json:"mounted_file,omitempty"`
+	Rule        string `json:"rule"`
+}
+
+// ComplianceReport is generated from the OpenAPI schema of the same name.
+//
+// A signed evidence export for audits. Once the report is ready, DownloadURL serves the
+// archive until ExpiresAt; SHA256 is the hex digest of the archive and Signature its
+// base64 signature by the signing key SigningKeyID.
+type ComplianceReport struct {
+	CreatedAt    time.Time `json:"created_at"`
+	CreatedBy    string    `json:"created_by"`
+	DownloadURL  string    `json:"download_url,omitempty"`
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	From         time.Time `json:"from"`
+	ID           string    `json:"id"`
+	Sections     []string  `json:"sections"`
+	SHA256       string    `json:"sha256,omitempty"`
+	Signature    string    `json:"signature,omitempty"`
+	SigningKeyID string    `json:"signing_key_id,omitempty"`
+	SizeBytes    int64     `json:"size_bytes,omitempty"`
+	Status       string    `json:"status"`
+	StatusReason string    `json:"status_reason,omitempty"`
+	To           time.Time `json:"to"`
+}
+
+// CreateComplianceReportRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for exporting the evidence recorded from From (inclusive) to To
+// (exclusive).
+type CreateComplianceReportRequest struct {
+	From time.Time `json:"from"`
+	// Empty exports every section
+	Sections []string  `json:"sections,omitempty"`
+	To       time.Time `json:"to"`
+}
+
+// CreateInvitationRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for inviting someone to the organization.
+type CreateInvitationRequest struct {
+	Email         string `json:"email"`
+	ExpiresInDays *int64 `json:"expires_in_days,omitempty"`
+	Role          string `json:"role"`
+}
+
+// CreateOrganizationDomainRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for claiming an email domain. An empty AutoJoinRole leaves auto-join
+// off.
+type CreateOrganizationDomainRequest struct {
+	AutoJoinRole string `json:"auto_join_role,omitempty"`
+	Domain       string `json:"domain"`
+}
+
+// CreateOutputSubscriptionRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for subscribing a stack to the outputs of another stack.
+type CreateOutputSubscriptionRequest struct {
+	Outputs       []string `json:"outputs,omitempty"`
+	SourceStackID string   `json:"source_stack_id"`
+}
+
+// CreateRateLimitPolicyRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for creating a rate limit policy.
+type CreateRateLimitPolicyRequest struct {
+	Burst             int64  `json:"burst,omitempty"`
+	Description       string `json:"description,omitempty"`
+	RequestsPerMinute int64  `json:"requests_per_minute"`
+	SourceCIDR        string `json:"source_cidr,omitempty"`
+	TokenID           string `json:"token_id,omitempty"`
+}
+
+// CreateVariableSetRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for creating a variable set.
+type CreateVariableSetRequest struct {
+	AppliesToAllStacks bool   `json:"applies_to_all_stacks"`
+	Description        string `json:"description,omitempty"`
+	Name               string `json:"name"`
+}
+
+// CreateVariableSetScopeRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for adding a scope to a variable set. Scopes cannot be changed once
+// created.
+type CreateVariableSetScopeRequest struct {
+	IncludeSubspaces bool   `json:"include_subspaces"`
+	SpaceID          string `json:"space_id,omitempty"`
+	StackLabel       string `json:"stack_label,omitempty"`
+}
+
+// EffectiveBundle is generated from the OpenAPI schema of the same name.
+//
+// A bundle a stack receives, as resolved by the API. Bundles are applied in order, so a
+// later bundle's environment variables and mounted files override an earlier one's: space
+// attachments come before the stack's own, the farthest parent space first, and
+// attachments at the same level by Priority.
+type EffectiveBundle struct {
+	// BundleAttachedViaStack or BundleAttachedViaSpace
+	AttachedVia string   `json:"attached_via"`
+	BundleID    string   `json:"bundle_id"`
+	BundleName  string   `json:"bundle_name"`
+	BundleSlug  string   `json:"bundle_slug"`
+	Labels      []string `json:"labels,omitempty"`
+	// OverriddenKeys and OverriddenPaths are the environment variables and mounted file paths
+	// of the bundle that a later bundle sets again, so the stack never sees the bundle's
+	// values for them.
+	OverriddenKeys  []string `json:"overridden_keys,omitempty"`
+	OverriddenPaths []string `json:"overridden_paths,omitempty"`
+	// The attachment's priority among those at the same level
+	Priority int `json:"priority"`
+	// The space the bundle is attached to, for BundleAttachedViaSpace
+	ViaSpaceID string `json:"via_space_id,omitempty"`
+}
+
+// EgressIPRanges is generated from the OpenAPI schema of the same name.
+//
+// The published list of Zenfra's static egress ranges, for allowlisting runners in firewalls
+// and security groups.
+type EgressIPRanges struct {
+	Regions   []EgressRegion `json:"regions"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// EgressRegion is generated from the OpenAPI schema of the same name.
+//
+// The static addresses runs in one Zenfra region connect out from.
+type EgressRegion struct {
+	IPv4CIDRs []string `json:"ipv4_cidrs"`
+	IPv6CIDRs []string `json:"ipv6_cidrs"`
+	Region    string   `json:"region"`
+}
+
+// Invitation is generated from the OpenAPI schema of the same name.
+//
+// A pending offer for someone to join the organization. It is sent by email; accepting it
+// makes the invitee a Member.
+type Invitation struct {
+	AcceptedAt     *time.Time `json:"accepted_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	CreatedBy      string     `json:"created_by"`
+	Email          string     `json:"email"`
+	ExpiresAt      time.Time  `json:"expires_at"`
+	ID             string     `json:"id"`
+	OrganizationID string     `json:"organization_id"`
+	Role           string     `json:"role"`
+	SentAt         time.Time  `json:"sent_at"`
+	Status         string     `json:"status"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// ManagedLock is generated from the OpenAPI schema of the same name.
+//
+// The "managed by Terraform" lock of a space, stack, or bundle. While it is enabled the
+// Zenfra UI refuses edits to the object. An organization admin can override it from the
+// UI, which disables it and records who did so and when.
+type ManagedLock struct {
+	Enabled      bool       `json:"enabled"`
+	LockedAt     *time.Time `json:"locked_at,omitempty"`
+	ManagedBy    string     `json:"managed_by,omitempty"`
+	OverriddenAt *time.Time `json:"overridden_at,omitempty"`
+	OverriddenBy string     `json:"overridden_by,omitempty"`
+}
+
+// Member is generated from the OpenAPI schema of the same name.
+//
+// A user who belongs to the organization.
+type Member struct {
+	Email    string    `json:"email"`
+	JoinedAt time.Time `json:"joined_at"`
+	Role     string    `json:"role"`
+	UserID   string    `json:"user_id"`
+}
+
+// OrganizationDomain is generated from the OpenAPI schema of the same name.
+//
+// An email domain the organization has claimed. Once the domain's DNS TXT record is
+// verified, users who sign in with an address at the domain can join the organization with
+// AutoJoinRole.
+type OrganizationDomain struct {
+	AutoJoinRole           string     `json:"auto_join_role,omitempty"`
+	CreatedAt              time.Time  `json:"created_at"`
+	Domain                 string     `json:"domain"`
+	ID                     string     `json:"id"`
+	OrganizationID         string     `json:"organization_id"`
+	Status                 string     `json:"status"`
+	StatusReason           string     `json:"status_reason,omitempty"`
+	UpdatedAt              time.Time  `json:"updated_at"`
+	VerificationRecordName string     `json:"verification_record_name"`
+	VerificationToken      string     `json:"verification_token"`
+	VerifiedAt             *time.Time `json:"verified_at,omitempty"`
+}
+
+// OutputSubscription is generated from the OpenAPI schema of the same name.
+//
+// OutputSubscription makes StackID consume the outputs of SourceStackID: Zenfra queues a
+// run of StackID when one of the listed Outputs of SourceStackID changes, or any of its
+// outputs when Outputs is empty.
+type OutputSubscription struct {
+	CreatedAt     time.Time `json:"created_at"`
+	ID            string    `json:"id"`
+	Outputs       []string  `json:"outputs,omitempty"`
+	SourceStackID string    `json:"source_stack_id"`
+	StackID       string    `json:"stack_id"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// RateLimitBounds is generated from the OpenAPI schema of the same name.
+//
+// The organization's API rate limits. Requests not covered by a policy get
+// DefaultRequestsPerMinute; a policy may grant at most MaxRequestsPerMinute and MaxBurst.
+// Zero maximums mean no limit.
+type RateLimitBounds struct {
+	DefaultRequestsPerMinute int64 `json:"default_requests_per_minute"`
+	MaxBurst                 int64 `json:"max_burst,omitempty"`
+	MaxRequestsPerMinute     int64 `json:"max_requests_per_minute,omitempty"`
+}
+
+// RateLimitPolicy is generated from the OpenAPI schema of the same name.
+//
+// RateLimitPolicy overrides the organization's API rate limit for the requests of one API
+// token or of one source network. Exactly one of TokenID and SourceCIDR is set.
+type RateLimitPolicy struct {
+	// Burst is how many requests may be made at once before the per-minute rate applies. Zero
+	// means the API default, which equals RequestsPerMinute.
+	Burst             int64     `json:"burst,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	Description       string    `json:"description,omitempty"`
+	ID                string    `json:"id"`
+	OrganizationID    string    `json:"organization_id"`
+	RequestsPerMinute int64     `json:"requests_per_minute"`
+	SourceCIDR        string    `json:"source_cidr,omitempty"`
+	TokenID           string    `json:"token_id,omitempty"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// ResendInvitationRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for sending an invitation's email again. The invitation's expiry
+// restarts from the time it is resent.
+type ResendInvitationRequest struct {
+	ExpiresInDays *int64 `json:"expires_in_days,omitempty"`
+}
+
+// RetentionSettings is generated from the OpenAPI schema of the same name.
+//
+// How long the organization keeps runs and run logs. Stacks can override either period.
+type RetentionSettings struct {
+	LogRetentionDays int64     `json:"log_retention_days"`
+	OrganizationID   string    `json:"organization_id"`
+	RunRetentionDays int64     `json:"run_retention_days"`
+	UpdatedAt        time.Time `json:"updated_at"`
+	UpdatedBy        string    `json:"updated_by,omitempty"`
+}
+
+// RunLogLine is generated from the OpenAPI schema of the same name.
+//
+// One line of a run's log output.
+type RunLogLine struct {
+	Message string `json:"message"`
+	// e.g. init, plan, apply
+	Phase     string    `json:"phase"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// RunLogPage is generated from the OpenAPI schema of the same name.
+//
+// A page of a run's log lines, read from a cursor.
+type RunLogPage struct {
+	// Complete reports that the run has finished and Lines reaches the end of its log.
+	Complete bool         `json:"complete"`
+	Lines    []RunLogLine `json:"lines"`
+	// NextCursor continues reading after the last line of this page. It is returned even when
+	// no further lines are available yet, so a running run can be polled.
+	NextCursor string `json:"next_cursor"`
+}
+
+// RunnerVersion is generated from the OpenAPI schema of the same name.
+//
+// A Zenfra runner release workers can be pinned to.
+type RunnerVersion struct {
+	Deprecated bool      `json:"deprecated"`
+	ReleasedAt time.Time `json:"released_at"`
+	Version    string    `json:"version"`
+}
+
+// RunnerVersionConstraint is generated from the OpenAPI schema of the same name.
+//
+// The organization's default runner version constraint, used by every worker pool that
+// does not pin its own.
+type RunnerVersionConstraint struct {
+	Constraint     string `json:"constraint"`
+	OrganizationID string `json:"organization_id"`
+	// newest catalog version matching Constraint
+	ResolvedVersion string    `json:"resolved_version"`
+	UpdatedAt       time.Time `json:"updated_at"`
+	UpdatedBy       string    `json:"updated_by,omitempty"`
+}
+
+// SetManagedLockRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for turning a managed lock on or off.
+type SetManagedLockRequest struct {
+	Enabled bool `json:"enabled"`
+}
+
+// StackHealth is generated from the OpenAPI schema of the same name.
+//
+// The operational state of a ready stack, from its status endpoint.
+type StackHealth struct {
+	// DriftDetectedAt is when the latest drift detection run found changes. It is cleared once
+	// a run reconciles the drift.
+	DriftDetectedAt *time.Time `json:"drift_detected_at,omitempty"`
+	Status          string     `json:"status"`
+}
+
+// StackManifest is generated from the OpenAPI schema of the same name.
+//
+// A stack with the manifest that describes it. Normalized is the manifest as canonical
+// JSON: formatting, key order, and defaulted fields do not change it, so it only differs
+// between two manifests that describe different stacks.
+type StackManifest struct {
+	Normalized string `json:"normalized"`
+	Stack      Stack  `json:"stack"`
+}
+
+// StackManifestError is generated from the OpenAPI schema of the same name.
+//
+// One problem found in a stack manifest. Path is the manifest field at fault, such as
+// "source.vcs.branch"; Line is zero when the problem is not tied to a line, for example a
+// missing field.
+type StackManifestError struct {
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+	Path    string `json:"path,omitempty"`
+}
+
+// StackManifestRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for creating or updating a stack from a manifest. Manifest is YAML or
+// JSON; SpaceID is the space the stack belongs to.
+type StackManifestRequest struct {
+	Manifest string `json:"manifest"`
+	SpaceID  string `json:"space_id"`
+}
+
+// StackManifestValidation is generated from the OpenAPI schema of the same name.
+//
+// The result of validating a stack manifest. The manifest is valid when Errors is empty;
+// only then are Normalized and Stack set.
+type StackManifestValidation struct {
+	Errors     []StackManifestError `json:"errors"`
+	Normalized string               `json:"normalized,omitempty"`
+	Stack      *Stack               `json:"stack,omitempty"`
+}
+
+// TokenPermissions is generated from the OpenAPI schema of the same name.
+//
+// TokenPermissions describes what the API token the client authenticates with may do.
+type TokenPermissions struct {
+	// Manage lists the kinds of objects, e.g. "stack" or "worker_pool", the token may create,
+	// update, and delete; "*" stands for every kind. Any token may read the objects it can
+	// see.
+	Manage []string `json:"manage"`
+	Role   string   `json:"role"`
+}
+
+// UpdateInvitationRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for changing a pending invitation.
+type UpdateInvitationRequest struct {
+	Role *string `json:"role,omitempty"`
+}
+
+// UpdateOrganizationDomainRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for changing an organization domain. An empty AutoJoinRole turns auto-
+// join off.
+type UpdateOrganizationDomainRequest struct {
+	AutoJoinRole *string `json:"auto_join_role,omitempty"`
+}
+
+// UpdateOutputSubscriptionRequest is generated from the OpenAPI schema of the same name.
+//
+// UpdateOutputSubscriptionRequest replaces the outputs a subscription watches. An empty
+// list watches every output.
+type UpdateOutputSubscriptionRequest struct {
+	Outputs []string `json:"outputs"`
+}
+
+// UpdateRateLimitPolicyRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for changing a rate limit policy. The target of a policy cannot change.
+// A zero Burst restores the API default.
+type UpdateRateLimitPolicyRequest struct {
+	Burst             *int64  `json:"burst,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	RequestsPerMinute *int64  `json:"requests_per_minute,omitempty"`
+}
+
+// UpdateRetentionSettingsRequest is generated from the OpenAPI schema of the same name.
+//
+// UpdateRetentionSettingsRequest replaces the organization's retention settings.
+type UpdateRetentionSettingsRequest struct {
+	LogRetentionDays int64 `json:"log_retention_days"`
+	RunRetentionDays int64 `json:"run_retention_days"`
+}
+
+// UpdateRunnerVersionConstraintRequest is generated from the OpenAPI schema of the same name.
+//
+// UpdateRunnerVersionConstraintRequest replaces the organization's default constraint.
+type UpdateRunnerVersionConstraintRequest struct {
+	Constraint string `json:"constraint"`
+}
+
+// UpdateVCSCredentialsRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for replacing the credentials of a VCS integration.
+type UpdateVCSCredentialsRequest struct {
+	GitLab *UpdateVCSGitLabCredentials `json:"gitlab,omitempty"`
+}
+
+// UpdateVCSGitLabCredentials is generated from the OpenAPI schema of the same name.
+//
+// The replacement access token of a GitLab integration. TokenType is "personal" or
+// "group"; empty keeps the current type.
+type UpdateVCSGitLabCredentials struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type,omitempty"`
+}
+
+// UpdateVariableSetRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for updating a variable set. Nil fields are left unchanged.
+type UpdateVariableSetRequest struct {
+	AppliesToAllStacks *bool   `json:"applies_to_all_stacks,omitempty"`
+	Description        *string `json:"description,omitempty"`
+	Name               *string `json:"name,omitempty"`
+}
+
+// ValidateBundleContentRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for checking bundle content without storing it. BundleID is empty for a
+// bundle that does not exist yet.
+type ValidateBundleContentRequest struct {
+	BundleID string `json:"bundle_id,omitempty"`
+	Content  any    `json:"content"`
+}
+
+// ValidateStackManifestRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for checking a stack manifest. StackID is empty for a stack that does
+// not exist yet.
+type ValidateStackManifestRequest struct {
+	Manifest string `json:"manifest"`
+	SpaceID  string `json:"space_id"`
+	StackID  string `json:"stack_id,omitempty"`
+}
+
+// VariableSet is generated from the OpenAPI schema of the same name.
+//
+// An organization-wide set of environment variables. Stacks receive its variables
+// alongside those of their bundles: every stack when AppliesToAllStacks is set, otherwise
+// the stacks matched by one of its VariableSetScopes.
+type VariableSet struct {
+	AppliesToAllStacks bool      `json:"applies_to_all_stacks"`
+	CreatedAt          time.Time `json:"created_at"`
+	Description        string    `json:"description,omitempty"`
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	OrganizationID     string    `json:"organization_id"`
+	UpdatedAt          time.Time `json:"updated_at"`
+	VariableCount      int64     `json:"variable_count"`
+}
+
+// VariableSetScope is generated from the OpenAPI schema of the same name.
+//
+// VariableSetScope selects stacks a variable set applies to: the stacks of SpaceID, and of
+// its child spaces when IncludeSubspaces is set, or the stacks labeled StackLabel. Exactly
+// one of SpaceID and StackLabel is set.
+type VariableSetScope struct {
+	CreatedAt        time.Time `json:"created_at"`
+	ID               string    `json:"id"`
+	IncludeSubspaces bool      `json:"include_subspaces"`
+	SpaceID          string    `json:"space_id,omitempty"`
+	StackLabel       string    `json:"stack_label,omitempty"`
+	VariableSetID    string    `json:"variable_set_id"`
+}
+
+// VariableSetVariableRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for creating or replacing a variable of a variable set.
+type VariableSetVariableRequest struct {
+	Description string `json:"description,omitempty"`
+	Key         string `json:"key"`
+	Secret      bool   `json:"secret"`
+	Value       string `json:"value"`
+}
+
+// WebhookEndpoint is generated from the OpenAPI schema of the same name.
+//
+// An HTTPS endpoint that receives signed event notifications.
+type WebhookEndpoint struct {
+	CreatedAt      time.Time `json:"created_at"`
+	Enabled        bool      `json:"enabled"`
+	Events         []string  `json:"events"`
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	OrganizationID string    `json:"organization_id"`
+	// nil if the secret was never rotated
+	SecretRotatedAt *time.Time `json:"secret_rotated_at,omitempty"`
+	UpdatedAt       time.Time  `json:"updated_at"`
+	URL             string     `json:"url"`
+}
+
+// WebhookSecretRotation is generated from the OpenAPI schema of the same name.
+//
+// The response to rotating a webhook endpoint's signing secret. Secret is only returned
+// here; the API never returns it again.
+type WebhookSecretRotation struct {
+	// PreviousSecretExpiresAt is when deliveries stop being signed with the old secret as
+	// well, so receivers can switch over without dropping events. Nil if there was none.
+	PreviousSecretExpiresAt *time.Time `json:"previous_secret_expires_at,omitempty"`
+	RotatedAt               time.Time  `json:"rotated_at"`
+	Secret                  string     `json:"secret"`
+	WebhookEndpointID       string     `json:"webhook_endpoint_id"`
+}
+
+// apiValidateBundleContent calls POST /api/v1/bundles/validate-content: Check bundle content without storing it.
+func (c *Client) apiValidateBundleContent(ctx context.Context, body ValidateBundleContentRequest) (*BundleContentValidation, error) {
+	var result BundleContentValidation
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/bundles/validate-content", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiSetBundleManagedLock calls PUT /api/v1/bundles/{bundle_id}/managed-lock: Turn the managed lock of a bundle on or off.
+func (c *Client) apiSetBundleManagedLock(ctx context.Context, bundleID string, body SetManagedLockRequest) (*ManagedLock, error) {
+	var result ManagedLock
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/bundles/"+bundleID+"/managed-lock", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiListBundleAttachedStacks calls GET /api/v1/bundles/{bundle_id}/stacks: List the stacks that receive a bundle's configuration.
+func (c *Client) apiListBundleAttachedStacks(ctx context.Context, bundleID string) (*ListBundleAttachedStacksResponse, error) {
+	var result ListBundleAttachedStacksResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/bundles/"+bundleID+"/stacks", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiCreateComplianceReport calls POST /api/v1/compliance-reports: Request a compliance evidence export.
+func (c *Client) apiCreateComplianceReport(ctx context.Context, body CreateComplianceReportRequest) (*ComplianceReport, error) {
+	var result ComplianceReport
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/compliance-reports", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetComplianceReport calls GET /api/v1/compliance-reports/{report_id}: Get a compliance report.
+func (c *Client) apiGetComplianceReport(ctx context.Context, reportID string) (*ComplianceReport, error) {
+	var result ComplianceReport
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/compliance-reports/"+reportID, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiCreateInvitation calls POST /api/v1/invitations: Invite someone to the organization.
+func (c *Client) apiCreateInvitation(ctx context.Context, body CreateInvitationRequest) (*Invitation, error) {
+	var result Invitation
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/invitations", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetInvitation calls GET /api/v1/invitations/{invitation_id}: Get an invitation.
+func (c *Client) apiGetInvitation(ctx context.Context, invitationID string) (*Invitation, error) {
+	var result Invitation
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/invitations/"+invitationID, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateInvitation calls PATCH /api/v1/invitations/{invitation_id}: Change a pending invitation.
+func (c *Client) apiUpdateInvitation(ctx context.Context, invitationID string, body UpdateInvitationRequest) (*Invitation, error) {
+	var result Invitation
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v1/invitations/"+invitationID, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiRevokeInvitation calls DELETE /api/v1/invitations/{invitation_id}: Revoke a pending invitation.
+func (c *Client) apiRevokeInvitation(ctx context.Context, invitationID string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/invitations/"+invitationID, nil, nil)
+}
+
+// apiResendInvitation calls POST /api/v1/invitations/{invitation_id}/resend: Email a pending invitation again.
+func (c *Client) apiResendInvitation(ctx context.Context, invitationID string, body ResendInvitationRequest) (*Invitation, error) {
+	var result Invitation
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/invitations/"+invitationID+"/resend", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiListMembers calls GET /api/v1/members: List the members of the organization.
+func (c *Client) apiListMembers(ctx context.Context, query url.Values) (*ListMembersResponse, error) {
+	var result ListMembersResponse
+	if err := c.doJSON(ctx, http.MethodGet, withQuery("/api/v1/members", nil, query), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetEgressIPRanges calls GET /api/v1/meta/egress-ip-ranges: Get the static IP ranges runners connect out from.
+func (c *Client) apiGetEgressIPRanges(ctx context.Context) (*EgressIPRanges, error) {
+	var result EgressIPRanges
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/meta/egress-ip-ranges", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiCreateOrganizationDomain calls POST /api/v1/organizations/current/domains: Claim an email domain for the organization.
+func (c *Client) apiCreateOrganizationDomain(ctx context.Context, body CreateOrganizationDomainRequest) (*OrganizationDomain, error) {
+	var result OrganizationDomain
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/organizations/current/domains", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetOrganizationDomain calls GET /api/v1/organizations/current/domains/{domain_id}: Get an organization domain.
+func (c *Client) apiGetOrganizationDomain(ctx context.Context, domainID string) (*OrganizationDomain, error) {
+	var result OrganizationDomain
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/organizations/current/domains/"+domainID, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateOrganizationDomain calls PATCH /api/v1/organizations/current/domains/{domain_id}: Change the auto-join settings of an organization domain.
+func (c *Client) apiUpdateOrganizationDomain(ctx context.Context, domainID string, body UpdateOrganizationDomainRequest) (*OrganizationDomain, error) {
+	var result OrganizationDomain
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v1/organizations/current/domains/"+domainID, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiDeleteOrganizationDomain calls DELETE /api/v1/organizations/current/domains/{domain_id}: Release an organization domain.
+func (c *Client) apiDeleteOrganizationDomain(ctx context.Context, domainID string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/organizations/current/domains/"+domainID, nil, nil)
+}
+
+// apiVerifyOrganizationDomain calls POST /api/v1/organizations/current/domains/{domain_id}/verify: Look up an organization domain's verification record now.
+func (c *Client) apiVerifyOrganizationDomain(ctx context.Context, domainID string) (*OrganizationDomain, error) {
+	var result OrganizationDomain
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/organizations/current/domains/"+domainID+"/verify", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiListRateLimitPolicies calls GET /api/v1/organizations/current/rate-limit-policies: List the organization's rate limit policies.
+func (c *Client) apiListRateLimitPolicies(ctx context.Context) (*ListRateLimitPoliciesResponse, error) {
+	var result ListRateLimitPoliciesResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/organizations/current/rate-limit-policies", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiCreateRateLimitPolicy calls POST /api/v1/organizations/current/rate-limit-policies: Create a rate limit policy.
+func (c *Client) apiCreateRateLimitPolicy(ctx context.Context, body CreateRateLimitPolicyRequest) (*RateLimitPolicy, error) {
+	var result RateLimitPolicy
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/organizations/current/rate-limit-policies", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetRateLimitPolicy calls GET /api/v1/organizations/current/rate-limit-policies/{policy_id}: Get a rate limit policy.
+func (c *Client) apiGetRateLimitPolicy(ctx context.Context, policyID string) (*RateLimitPolicy, error) {
+	var result RateLimitPolicy
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/organizations/current/rate-limit-policies/"+policyID, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateRateLimitPolicy calls PATCH /api/v1/organizations/current/rate-limit-policies/{policy_id}: Change a rate limit policy.
+func (c *Client) apiUpdateRateLimitPolicy(ctx context.Context, policyID string, body UpdateRateLimitPolicyRequest) (*RateLimitPolicy, error) {
+	var result RateLimitPolicy
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v1/organizations/current/rate-limit-policies/"+policyID, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiDeleteRateLimitPolicy calls DELETE /api/v1/organizations/current/rate-limit-policies/{policy_id}: Delete a rate limit policy.
+func (c *Client) apiDeleteRateLimitPolicy(ctx context.Context, policyID string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/organizations/current/rate-limit-policies/"+policyID, nil, nil)
+}
+
+// apiGetRateLimitBounds calls GET /api/v1/organizations/current/rate-limits: Get the organization's API rate limits.
+func (c *Client) apiGetRateLimitBounds(ctx context.Context) (*RateLimitBounds, error) {
+	var result RateLimitBounds
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/organizations/current/rate-limits", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetRetentionSettings calls GET /api/v1/organizations/current/retention-settings: Get the organization's retention settings.
+func (c *Client) apiGetRetentionSettings(ctx context.Context) (*RetentionSettings, error) {
+	var result RetentionSettings
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/organizations/current/retention-settings", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateRetentionSettings calls PUT /api/v1/organizations/current/retention-settings: Replace the organization's retention settings.
+func (c *Client) apiUpdateRetentionSettings(ctx context.Context, body UpdateRetentionSettingsRequest) (*RetentionSettings, error) {
+	var result RetentionSettings
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/organizations/current/retention-settings", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiResetRetentionSettings calls DELETE /api/v1/organizations/current/retention-settings: Restore the default retention settings.
+func (c *Client) apiResetRetentionSettings(ctx context.Context) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/organizations/current/retention-settings", nil, nil)
+}
+
+// apiGetRunnerVersionConstraint calls GET /api/v1/organizations/current/runner-version-constraint: Get the organization's default runner version constraint.
+func (c *Client) apiGetRunnerVersionConstraint(ctx context.Context) (*RunnerVersionConstraint, error) {
+	var result RunnerVersionConstraint
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/organizations/current/runner-version-constraint", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateRunnerVersionConstraint calls PUT /api/v1/organizations/current/runner-version-constraint: Replace the organization's default runner version constraint.
+func (c *Client) apiUpdateRunnerVersionConstraint(ctx context.Context, body UpdateRunnerVersionConstraintRequest) (*RunnerVersionConstraint, error) {
+	var result RunnerVersionConstraint
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/organizations/current/runner-version-constraint", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiResetRunnerVersionConstraint calls DELETE /api/v1/organizations/current/runner-version-constraint: Remove the organization's default runner version constraint.
+func (c *Client) apiResetRunnerVersionConstraint(ctx context.Context) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/organizations/current/runner-version-constraint", nil, nil)
+}
+
+// apiListRunnerVersions calls GET /api/v1/runner-versions: List the runner releases workers can be pinned to.
+func (c *Client) apiListRunnerVersions(ctx context.Context) (*ListRunnerVersionsResponse, error) {
+	var result ListRunnerVersionsResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/runner-versions", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetRunLogs calls GET /api/v1/runs/{run_id}/logs: Get a page of a run's log lines.
+func (c *Client) apiGetRunLogs(ctx context.Context, runID string, query url.Values) (*RunLogPage, error) {
+	var result RunLogPage
+	if err := c.doJSON(ctx, http.MethodGet, withQuery("/api/v1/runs/"+runID+"/logs", nil, query), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiSetSpaceManagedLock calls PUT /api/v1/spaces/{space_id}/managed-lock: Turn the managed lock of a space on or off.
+func (c *Client) apiSetSpaceManagedLock(ctx context.Context, spaceID string, body SetManagedLockRequest) (*ManagedLock, error) {
+	var result ManagedLock
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/spaces/"+spaceID+"/managed-lock", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiCreateStackFromManifest calls POST /api/v1/stacks/from-manifest: Create a stack from a manifest.
+func (c *Client) apiCreateStackFromManifest(ctx context.Context, body StackManifestRequest) (*StackManifest, error) {
+	var result StackManifest
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/stacks/from-manifest", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiValidateStackManifest calls POST /api/v1/stacks/manifests/validate: Check a stack manifest without creating or changing a stack.
+func (c *Client) apiValidateStackManifest(ctx context.Context, body ValidateStackManifestRequest) (*StackManifestValidation, error) {
+	var result StackManifestValidation
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/stacks/manifests/validate", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiResolveStackBundles calls GET /api/v1/stacks/{stack_id}/bundles/resolved: List the bundles a stack receives, in the order they are applied.
+func (c *Client) apiResolveStackBundles(ctx context.Context, stackID string) (*ResolveStackBundlesResponse, error) {
+	var result ResolveStackBundlesResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/stacks/"+stackID+"/bundles/resolved", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiSetStackManagedLock calls PUT /api/v1/stacks/{stack_id}/managed-lock: Turn the managed lock of a stack on or off.
+func (c *Client) apiSetStackManagedLock(ctx context.Context, stackID string, body SetManagedLockRequest) (*ManagedLock, error) {
+	var result ManagedLock
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/stacks/"+stackID+"/managed-lock", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetStackManifest calls GET /api/v1/stacks/{stack_id}/manifest: Get a stack with the manifest that describes it.
+func (c *Client) apiGetStackManifest(ctx context.Context, stackID string) (*StackManifest, error) {
+	var result StackManifest
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/stacks/"+stackID+"/manifest", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateStackFromManifest calls PUT /api/v1/stacks/{stack_id}/manifest: Replace a stack's settings with those of a manifest.
+func (c *Client) apiUpdateStackFromManifest(ctx context.Context, stackID string, body StackManifestRequest) (*StackManifest, error) {
+	var result StackManifest
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/stacks/"+stackID+"/manifest", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiCreateOutputSubscription calls POST /api/v1/stacks/{stack_id}/output-subscriptions: Subscribe a stack to the outputs of another stack.
+func (c *Client) apiCreateOutputSubscription(ctx context.Context, stackID string, body CreateOutputSubscriptionRequest) (*OutputSubscription, error) {
+	var result OutputSubscription
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/stacks/"+stackID+"/output-subscriptions", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetOutputSubscription calls GET /api/v1/stacks/{stack_id}/output-subscriptions/{subscription_id}: Get an output subscription.
+func (c *Client) apiGetOutputSubscription(ctx context.Context, stackID string, subscriptionID string) (*OutputSubscription, error) {
+	var result OutputSubscription
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/stacks/"+stackID+"/output-subscriptions/"+subscriptionID, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateOutputSubscription calls PATCH /api/v1/stacks/{stack_id}/output-subscriptions/{subscription_id}: Replace the outputs a subscription watches.
+func (c *Client) apiUpdateOutputSubscription(ctx context.Context, stackID string, subscriptionID string, body UpdateOutputSubscriptionRequest) (*OutputSubscription, error) {
+	var result OutputSubscription
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v1/stacks/"+stackID+"/output-subscriptions/"+subscriptionID, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiDeleteOutputSubscription calls DELETE /api/v1/stacks/{stack_id}/output-subscriptions/{subscription_id}: Delete an output subscription.
+func (c *Client) apiDeleteOutputSubscription(ctx context.Context, stackID string, subscriptionID string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/stacks/"+stackID+"/output-subscriptions/"+subscriptionID, nil, nil)
+}
+
+// apiListStackRuns calls GET /api/v1/stacks/{stack_id}/runs: List a stack's runs.
+func (c *Client) apiListStackRuns(ctx context.Context, stackID string, query url.Values) (*ListStackRunsResponse, error) {
+	var result ListStackRunsResponse
+	if err := c.doJSON(ctx, http.MethodGet, withQuery("/api/v1/stacks/"+stackID+"/runs", nil, query), nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetStackHealth calls GET /api/v1/stacks/{stack_id}/status: Get the operational state of a stack.
+func (c *Client) apiGetStackHealth(ctx context.Context, stackID string) (*StackHealth, error) {
+	var result StackHealth
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/stacks/"+stackID+"/status", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetTokenPermissions calls GET /api/v1/token/permissions: Get the role and permissions of the calling API token.
+func (c *Client) apiGetTokenPermissions(ctx context.Context) (*TokenPermissions, error) {
+	var result TokenPermissions
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/token/permissions", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiCreateVariableSet calls POST /api/v1/variable-sets: Create a variable set.
+func (c *Client) apiCreateVariableSet(ctx context.Context, body CreateVariableSetRequest) (*VariableSet, error) {
+	var result VariableSet
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/variable-sets", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetVariableSet calls GET /api/v1/variable-sets/{set_id}: Get a variable set.
+func (c *Client) apiGetVariableSet(ctx context.Context, setID string) (*VariableSet, error) {
+	var result VariableSet
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/variable-sets/"+setID, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateVariableSet calls PATCH /api/v1/variable-sets/{set_id}: Update a variable set.
+func (c *Client) apiUpdateVariableSet(ctx context.Context, setID string, body UpdateVariableSetRequest) (*VariableSet, error) {
+	var result VariableSet
+	if err := c.doJSON(ctx, http.MethodPatch, "/api/v1/variable-sets/"+setID, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiDeleteVariableSet calls DELETE /api/v1/variable-sets/{set_id}: Delete a variable set with its variables and scopes.
+func (c *Client) apiDeleteVariableSet(ctx context.Context, setID string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/variable-sets/"+setID, nil, nil)
+}
+
+// apiCreateVariableSetScope calls POST /api/v1/variable-sets/{set_id}/scopes: Add a scope to a variable set.
+func (c *Client) apiCreateVariableSetScope(ctx context.Context, setID string, body CreateVariableSetScopeRequest) (*VariableSetScope, error) {
+	var result VariableSetScope
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/variable-sets/"+setID+"/scopes", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetVariableSetScope calls GET /api/v1/variable-sets/{set_id}/scopes/{scope_id}: Get a scope of a variable set.
+func (c *Client) apiGetVariableSetScope(ctx context.Context, setID string, scopeID string) (*VariableSetScope, error) {
+	var result VariableSetScope
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/variable-sets/"+setID+"/scopes/"+scopeID, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiDeleteVariableSetScope calls DELETE /api/v1/variable-sets/{set_id}/scopes/{scope_id}: Remove a scope from a variable set.
+func (c *Client) apiDeleteVariableSetScope(ctx context.Context, setID string, scopeID string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/variable-sets/"+setID+"/scopes/"+scopeID, nil, nil)
+}
+
+// apiCreateVariableSetVariable calls POST /api/v1/variable-sets/{set_id}/variables: Add a variable to a variable set.
+func (c *Client) apiCreateVariableSetVariable(ctx context.Context, setID string, body VariableSetVariableRequest) (*VariableSetVariable, error) {
+	var result VariableSetVariable
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/variable-sets/"+setID+"/variables", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetVariableSetVariable calls GET /api/v1/variable-sets/{set_id}/variables/{key}: Get a variable of a variable set.
+func (c *Client) apiGetVariableSetVariable(ctx context.Context, setID string, key string) (*VariableSetVariable, error) {
+	var result VariableSetVariable
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/variable-sets/"+setID+"/variables/"+key, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiUpdateVariableSetVariable calls PUT /api/v1/variable-sets/{set_id}/variables/{key}: Replace a variable of a variable set.
+func (c *Client) apiUpdateVariableSetVariable(ctx context.Context, setID string, key string, body VariableSetVariableRequest) (*VariableSetVariable, error) {
+	var result VariableSetVariable
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/variable-sets/"+setID+"/variables/"+key, body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiDeleteVariableSetVariable calls DELETE /api/v1/variable-sets/{set_id}/variables/{key}: Remove a variable from a variable set.
+func (c *Client) apiDeleteVariableSetVariable(ctx context.Context, setID string, key string) error {
+	return c.doJSON(ctx, http.MethodDelete, "/api/v1/variable-sets/"+setID+"/variables/"+key, nil, nil)
+}
+
+// apiUpdateVCSIntegrationCredentials calls PUT /api/v1/vcs/integrations/{integration_id}/credentials: Replace the credentials of a VCS integration.
+func (c *Client) apiUpdateVCSIntegrationCredentials(ctx context.Context, integrationID string, body UpdateVCSCredentialsRequest) (*VCSIntegration, error) {
+	var result VCSIntegration
+	if err := c.doJSON(ctx, http.MethodPut, "/api/v1/vcs/integrations/"+integrationID+"/credentials", body, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiListWebhookEndpoints calls GET /api/v1/webhook-endpoints: List the organization's webhook endpoints.
+func (c *Client) apiListWebhookEndpoints(ctx context.Context) (*ListWebhookEndpointsResponse, error) {
+	var result ListWebhookEndpointsResponse
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/webhook-endpoints", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiGetWebhookEndpoint calls GET /api/v1/webhook-endpoints/{endpoint_id}: Get a webhook endpoint.
+func (c *Client) apiGetWebhookEndpoint(ctx context.Context, endpointID string) (*WebhookEndpoint, error) {
+	var result WebhookEndpoint
+	if err := c.doJSON(ctx, http.MethodGet, "/api/v1/webhook-endpoints/"+endpointID, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// apiRotateWebhookEndpointSecret calls POST /api/v1/webhook-endpoints/{endpoint_id}/rotate-secret: Replace the signing secret of a webhook endpoint.
+func (c *Client) apiRotateWebhookEndpointSecret(ctx context.Context, endpointID string) (*WebhookSecretRotation, error) {
+	var result WebhookSecretRotation
+	if err := c.doJSON(ctx, http.MethodPost, "/api/v1/webhook-endpoints/"+endpointID+"/rotate-secret", nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListBundleAttachedStacksResponse is generated from an inline OpenAPI schema.
+type ListBundleAttachedStacksResponse struct {
+	Stacks []BundleAttachedStack `json:"stacks"`
+}
+
+// ListMembersResponse is generated from an inline OpenAPI schema.
+type ListMembersResponse struct {
+	Items []Member `json:"items"`
+}
+
+// ListRateLimitPoliciesResponse is generated from an inline OpenAPI schema.
+type ListRateLimitPoliciesResponse struct {
+	Items []RateLimitPolicy `json:"items"`
+}
+
+// ListRunnerVersionsResponse is generated from an inline OpenAPI schema.
+type ListRunnerVersionsResponse struct {
+	Versions []RunnerVersion `json:"versions"`
+}
+
+// ResolveStackBundlesResponse is generated from an inline OpenAPI schema.
+type ResolveStackBundlesResponse struct {
+	Bundles []EffectiveBundle `json:"bundles"`
+}
+
+// ListStackRunsResponse is generated from an inline OpenAPI schema.
+type ListStackRunsResponse struct {
+	Items []ActiveRun `json:"items"`
+}
+
+// ListWebhookEndpointsResponse is generated from an inline OpenAPI schema.
+type ListWebhookEndpointsResponse struct {
+	Items []WebhookEndpoint `json:"items"`
+}
@@ -0,0 +1,800 @@
+// ABOUTME: Methods of the SDK Client, each forwarding to the provider's client.
+// ABOUTME: Leaves out the methods only the provider uses, listed in gen.go.
+
+// Code generated by gen.go from ../../internal/zenfraclient; DO NOT EDIT.
+
+package zenfra
+
+import (
+	"context"
+	"time"
+)
+
+// CreateToken creates a new API token.
+// The response includes the full token value which is only returned once at creation.
+func (c *Client) CreateToken(ctx context.Context, req CreateTokenRequest) (*CreateTokenResponse, error) {
+	return c.client.CreateToken(ctx, req)
+}
+
+// GetToken retrieves an API token by ID.
+func (c *Client) GetToken(ctx context.Context, id string) (*Token, error) {
+	return c.client.GetToken(ctx, id)
+}
+
+// ListTokens returns all API tokens in the organization.
+func (c *Client) ListTokens(ctx context.Context) ([]Token, error) {
+	return c.client.ListTokens(ctx)
+}
+
+// DeleteToken deletes an API token by ID.
+func (c *Client) DeleteToken(ctx context.Context, id string) error {
+	return c.client.DeleteToken(ctx, id)
+}
+
+// AttachBundle attaches a bundle to a stack.
+func (c *Client) AttachBundle(ctx context.Context, stackID, bundleID string) error {
+	return c.client.AttachBundle(ctx, stackID, bundleID)
+}
+
+// DetachBundle detaches a bundle from a stack.
+func (c *Client) DetachBundle(ctx context.Context, stackID, bundleID string) error {
+	return c.client.DetachBundle(ctx, stackID, bundleID)
+}
+
+// ListStackBundles returns all bundle attachments for a stack.
+func (c *Client) ListStackBundles(ctx context.Context, stackID string) ([]BundleAttachment, error) {
+	return c.client.ListStackBundles(ctx, stackID)
+}
+
+// AttachSpaceBundle attaches a bundle to a space. Stacks in the space, and in child spaces
+// that inherit bundles, receive the bundle's configuration.
+func (c *Client) AttachSpaceBundle(ctx context.Context, spaceID, bundleID string) error {
+	return c.client.AttachSpaceBundle(ctx, spaceID, bundleID)
+}
+
+// DetachSpaceBundle detaches a bundle from a space.
+func (c *Client) DetachSpaceBundle(ctx context.Context, spaceID, bundleID string) error {
+	return c.client.DetachSpaceBundle(ctx, spaceID, bundleID)
+}
+
+// ListSpaceBundles returns the bundles attached directly to a space. Bundles inherited
+// from parent spaces are not included.
+func (c *Client) ListSpaceBundles(ctx context.Context, spaceID string) ([]SpaceBundleAttachment, error) {
+	return c.client.ListSpaceBundles(ctx, spaceID)
+}
+
+// ListBundleAttachedStacks returns every stack that receives a bundle's configuration,
+// whether attached directly or through a space it inherits bundles from.
+func (c *Client) ListBundleAttachedStacks(ctx context.Context, bundleID string) ([]BundleAttachedStack, error) {
+	return c.client.ListBundleAttachedStacks(ctx, bundleID)
+}
+
+// ResolveStackBundles returns every bundle a stack receives, from its own attachments and
+// those inherited from its space and parent spaces, in the order they are applied.
+func (c *Client) ResolveStackBundles(ctx context.Context, stackID string) ([]EffectiveBundle, error) {
+	return c.client.ResolveStackBundles(ctx, stackID)
+}
+
+// CreateBundle creates a new configuration bundle.
+func (c *Client) CreateBundle(ctx context.Context, req CreateBundleRequest) (*Bundle, error) {
+	return c.client.CreateBundle(ctx, req)
+}
+
+// GetBundle retrieves a bundle by ID.
+func (c *Client) GetBundle(ctx context.Context, id string) (*Bundle, error) {
+	return c.client.GetBundle(ctx, id)
+}
+
+// ListBundles returns all bundles in the organization. opts may be nil.
+func (c *Client) ListBundles(ctx context.Context, opts *ListOptions) ([]Bundle, error) {
+	return c.client.ListBundles(ctx, opts)
+}
+
+// UpdateBundle updates bundle metadata.
+func (c *Client) UpdateBundle(ctx context.Context, id string, req UpdateBundleRequest) (*Bundle, error) {
+	return c.client.UpdateBundle(ctx, id, req)
+}
+
+// UpdateBundleContent updates the content (env vars, mounted files) of a bundle.
+func (c *Client) UpdateBundleContent(ctx context.Context, id string, req UpdateBundleContentRequest) (*UpdateBundleContentResponse, error) {
+	return c.client.UpdateBundleContent(ctx, id, req)
+}
+
+// ValidateBundleContent checks bundle content against the API's syntax, size, and path
+// rules without storing it.
+func (c *Client) ValidateBundleContent(ctx context.Context, req ValidateBundleContentRequest) (*BundleContentValidation, error) {
+	return c.client.ValidateBundleContent(ctx, req)
+}
+
+// DeleteBundle deletes a bundle by ID.
+func (c *Client) DeleteBundle(ctx context.Context, id string) error {
+	return c.client.DeleteBundle(ctx, id)
+}
+
+// Close closes the idle connections to the API and the file the client records
+// interactions to, if any. Copies of the client share both, so closing any of them
+// closes them for all; requests made afterwards still work but are no longer recorded.
+func (c *Client) Close() error {
+	return c.client.Close()
+}
+
+// CreateComplianceReport requests a new evidence export. The report starts out
+// pending; wait for it with WaitForComplianceReport.
+func (c *Client) CreateComplianceReport(ctx context.Context, req CreateComplianceReportRequest) (*ComplianceReport, error) {
+	return c.client.CreateComplianceReport(ctx, req)
+}
+
+// GetComplianceReport retrieves a compliance report by ID.
+func (c *Client) GetComplianceReport(ctx context.Context, id string) (*ComplianceReport, error) {
+	return c.client.GetComplianceReport(ctx, id)
+}
+
+// WaitForComplianceReport polls a compliance report every interval until it is ready
+// and returns it. It fails if the report fails or ctx is done first; bound the wait
+// with a context deadline.
+func (c *Client) WaitForComplianceReport(ctx context.Context, id string, interval time.Duration) (*ComplianceReport, error) {
+	return c.client.WaitForComplianceReport(ctx, id, interval)
+}
+
+// GetEgressIPRanges returns the static IP ranges, per region, that Zenfra runners use
+// for outbound connections.
+func (c *Client) GetEgressIPRanges(ctx context.Context) (*EgressIPRanges, error) {
+	return c.client.GetEgressIPRanges(ctx)
+}
+
+// GetIACVersions returns the version catalog for an IaC engine, such as "terraform" or "opentofu".
+func (c *Client) GetIACVersions(ctx context.Context, engine string) (*IACVersionCatalog, error) {
+	return c.client.GetIACVersions(ctx, engine)
+}
+
+// CreateInvitation invites someone to the organization and emails them the invitation.
+func (c *Client) CreateInvitation(ctx context.Context, req CreateInvitationRequest) (*Invitation, error) {
+	return c.client.CreateInvitation(ctx, req)
+}
+
+// GetInvitation retrieves an invitation by ID.
+func (c *Client) GetInvitation(ctx context.Context, id string) (*Invitation, error) {
+	return c.client.GetInvitation(ctx, id)
+}
+
+// UpdateInvitation changes a pending invitation.
+func (c *Client) UpdateInvitation(ctx context.Context, id string, req UpdateInvitationRequest) (*Invitation, error) {
+	return c.client.UpdateInvitation(ctx, id, req)
+}
+
+// ResendInvitation emails a pending invitation again and restarts its expiry.
+func (c *Client) ResendInvitation(ctx context.Context, id string, req ResendInvitationRequest) (*Invitation, error) {
+	return c.client.ResendInvitation(ctx, id, req)
+}
+
+// RevokeInvitation withdraws a pending invitation, so its link stops working.
+func (c *Client) RevokeInvitation(ctx context.Context, id string) error {
+	return c.client.RevokeInvitation(ctx, id)
+}
+
+// FindMember returns the organization member with the given email address, compared
+// case-insensitively, or nil if there is none.
+func (c *Client) FindMember(ctx context.Context, email string) (*Member, error) {
+	return c.client.FindMember(ctx, email)
+}
+
+// SetSpaceManagedLock turns the managed lock of a space on or off. The API records the
+// X-Zenfra-Managed-By header of the request as the lock's owner.
+func (c *Client) SetSpaceManagedLock(ctx context.Context, spaceID string, enabled bool) (*ManagedLock, error) {
+	return c.client.SetSpaceManagedLock(ctx, spaceID, enabled)
+}
+
+// SetStackManagedLock turns the managed lock of a stack on or off. The API records the
+// X-Zenfra-Managed-By header of the request as the lock's owner.
+func (c *Client) SetStackManagedLock(ctx context.Context, stackID string, enabled bool) (*ManagedLock, error) {
+	return c.client.SetStackManagedLock(ctx, stackID, enabled)
+}
+
+// SetBundleManagedLock turns the managed lock of a bundle on or off. The API records the
+// X-Zenfra-Managed-By header of the request as the lock's owner.
+func (c *Client) SetBundleManagedLock(ctx context.Context, bundleID string, enabled bool) (*ManagedLock, error) {
+	return c.client.SetBundleManagedLock(ctx, bundleID, enabled)
+}
+
+// CreateOrganizationDomain claims an email domain for the organization. The domain starts
+// out pending until its verification TXT record is found.
+func (c *Client) CreateOrganizationDomain(ctx context.Context, req CreateOrganizationDomainRequest) (*OrganizationDomain, error) {
+	return c.client.CreateOrganizationDomain(ctx, req)
+}
+
+// GetOrganizationDomain retrieves an organization domain by ID.
+func (c *Client) GetOrganizationDomain(ctx context.Context, id string) (*OrganizationDomain, error) {
+	return c.client.GetOrganizationDomain(ctx, id)
+}
+
+// UpdateOrganizationDomain changes the auto-join settings of an organization domain.
+func (c *Client) UpdateOrganizationDomain(ctx context.Context, id string, req UpdateOrganizationDomainRequest) (*OrganizationDomain, error) {
+	return c.client.UpdateOrganizationDomain(ctx, id, req)
+}
+
+// DeleteOrganizationDomain releases an organization domain by ID. Members who joined
+// through it stay in the organization.
+func (c *Client) DeleteOrganizationDomain(ctx context.Context, id string) error {
+	return c.client.DeleteOrganizationDomain(ctx, id)
+}
+
+// VerifyOrganizationDomain asks the API to look up the domain's verification TXT record
+// now rather than at its next scheduled check. The returned domain may still be
+// pending while the lookup runs.
+func (c *Client) VerifyOrganizationDomain(ctx context.Context, id string) (*OrganizationDomain, error) {
+	return c.client.VerifyOrganizationDomain(ctx, id)
+}
+
+// WaitForOrganizationDomainVerified polls an organization domain every interval until it
+// is verified and returns it. It fails if verification fails or ctx is done first;
+// bound the wait with a context deadline.
+func (c *Client) WaitForOrganizationDomainVerified(ctx context.Context, id string, interval time.Duration) (*OrganizationDomain, error) {
+	return c.client.WaitForOrganizationDomainVerified(ctx, id, interval)
+}
+
+// GetCurrentOrganization retrieves the organization for the authenticated user.
+func (c *Client) GetCurrentOrganization(ctx context.Context) (*Organization, error) {
+	return c.client.GetCurrentOrganization(ctx)
+}
+
+// GetOrganizationUsage retrieves API quota, run minute, and worker slot usage for the
+// authenticated user's organization.
+func (c *Client) GetOrganizationUsage(ctx context.Context) (*OrganizationUsage, error) {
+	return c.client.GetOrganizationUsage(ctx)
+}
+
+// CreateOutputSubscription subscribes a stack to the outputs of another stack.
+func (c *Client) CreateOutputSubscription(ctx context.Context, stackID string, req CreateOutputSubscriptionRequest) (*OutputSubscription, error) {
+	return c.client.CreateOutputSubscription(ctx, stackID, req)
+}
+
+// GetOutputSubscription retrieves a stack's output subscription by ID.
+func (c *Client) GetOutputSubscription(ctx context.Context, stackID, id string) (*OutputSubscription, error) {
+	return c.client.GetOutputSubscription(ctx, stackID, id)
+}
+
+// UpdateOutputSubscription changes which outputs of the source stack a subscription watches.
+func (c *Client) UpdateOutputSubscription(ctx context.Context, stackID, id string, req UpdateOutputSubscriptionRequest) (*OutputSubscription, error) {
+	return c.client.UpdateOutputSubscription(ctx, stackID, id, req)
+}
+
+// DeleteOutputSubscription removes a stack's output subscription.
+func (c *Client) DeleteOutputSubscription(ctx context.Context, stackID, id string) error {
+	return c.client.DeleteOutputSubscription(ctx, stackID, id)
+}
+
+// GetRateLimitBounds retrieves the organization's default API rate limit and the highest
+// limit a policy may grant.
+func (c *Client) GetRateLimitBounds(ctx context.Context) (*RateLimitBounds, error) {
+	return c.client.GetRateLimitBounds(ctx)
+}
+
+// ListRateLimitPolicies lists the organization's rate limit policies.
+func (c *Client) ListRateLimitPolicies(ctx context.Context) ([]RateLimitPolicy, error) {
+	return c.client.ListRateLimitPolicies(ctx)
+}
+
+// CreateRateLimitPolicy creates a rate limit policy for an API token or a source network.
+func (c *Client) CreateRateLimitPolicy(ctx context.Context, req CreateRateLimitPolicyRequest) (*RateLimitPolicy, error) {
+	return c.client.CreateRateLimitPolicy(ctx, req)
+}
+
+// GetRateLimitPolicy retrieves a rate limit policy by ID.
+func (c *Client) GetRateLimitPolicy(ctx context.Context, id string) (*RateLimitPolicy, error) {
+	return c.client.GetRateLimitPolicy(ctx, id)
+}
+
+// UpdateRateLimitPolicy changes the limits or description of a rate limit policy.
+func (c *Client) UpdateRateLimitPolicy(ctx context.Context, id string, req UpdateRateLimitPolicyRequest) (*RateLimitPolicy, error) {
+	return c.client.UpdateRateLimitPolicy(ctx, id, req)
+}
+
+// DeleteRateLimitPolicy deletes a rate limit policy by ID. Requests it covered fall back
+// to the organization's default limit.
+func (c *Client) DeleteRateLimitPolicy(ctx context.Context, id string) error {
+	return c.client.DeleteRateLimitPolicy(ctx, id)
+}
+
+// GetRetentionSettings retrieves the run and log retention of the authenticated user's organization.
+func (c *Client) GetRetentionSettings(ctx context.Context) (*RetentionSettings, error) {
+	return c.client.GetRetentionSettings(ctx)
+}
+
+// UpdateRetentionSettings replaces the retention settings of the organization.
+func (c *Client) UpdateRetentionSettings(ctx context.Context, req UpdateRetentionSettingsRequest) (*RetentionSettings, error) {
+	return c.client.UpdateRetentionSettings(ctx, req)
+}
+
+// ResetRetentionSettings restores the default retention of the organization's plan.
+func (c *Client) ResetRetentionSettings(ctx context.Context) error {
+	return c.client.ResetRetentionSettings(ctx)
+}
+
+// CreateRunComment posts a comment on a run.
+func (c *Client) CreateRunComment(ctx context.Context, runID string, req CreateRunCommentRequest) (*RunComment, error) {
+	return c.client.CreateRunComment(ctx, runID, req)
+}
+
+// GetRunComment retrieves a comment on a run by ID.
+func (c *Client) GetRunComment(ctx context.Context, runID, commentID string) (*RunComment, error) {
+	return c.client.GetRunComment(ctx, runID, commentID)
+}
+
+// GetRunQueueSettings retrieves the run queue settings of the authenticated user's organization.
+func (c *Client) GetRunQueueSettings(ctx context.Context) (*RunQueueSettings, error) {
+	return c.client.GetRunQueueSettings(ctx)
+}
+
+// UpdateRunQueueSettings replaces the run queue settings of the organization.
+func (c *Client) UpdateRunQueueSettings(ctx context.Context, req UpdateRunQueueSettingsRequest) (*RunQueueSettings, error) {
+	return c.client.UpdateRunQueueSettings(ctx, req)
+}
+
+// ResetRunQueueSettings restores the platform's default run queue settings for the organization.
+func (c *Client) ResetRunQueueSettings(ctx context.Context) error {
+	return c.client.ResetRunQueueSettings(ctx)
+}
+
+// ListRunnerVersions returns the runner versions workers can be pinned to.
+func (c *Client) ListRunnerVersions(ctx context.Context) ([]RunnerVersion, error) {
+	return c.client.ListRunnerVersions(ctx)
+}
+
+// GetRunnerVersionConstraint retrieves the organization's default runner version constraint.
+func (c *Client) GetRunnerVersionConstraint(ctx context.Context) (*RunnerVersionConstraint, error) {
+	return c.client.GetRunnerVersionConstraint(ctx)
+}
+
+// UpdateRunnerVersionConstraint replaces the organization's default runner version constraint.
+func (c *Client) UpdateRunnerVersionConstraint(ctx context.Context, req UpdateRunnerVersionConstraintRequest) (*RunnerVersionConstraint, error) {
+	return c.client.UpdateRunnerVersionConstraint(ctx, req)
+}
+
+// ResetRunnerVersionConstraint removes the organization's default constraint, so pools
+// without a pin of their own run the latest runner.
+func (c *Client) ResetRunnerVersionConstraint(ctx context.Context) error {
+	return c.client.ResetRunnerVersionConstraint(ctx)
+}
+
+// GetRunPlan retrieves the structured plan for a run, including resource changes
+// and the raw plan JSON as produced by the IaC engine.
+func (c *Client) GetRunPlan(ctx context.Context, runID string) (*RunPlan, error) {
+	return c.client.GetRunPlan(ctx, runID)
+}
+
+// GetRunCostEstimate retrieves the cost estimate of a run's plan.
+func (c *Client) GetRunCostEstimate(ctx context.Context, runID string) (*RunCostEstimate, error) {
+	return c.client.GetRunCostEstimate(ctx, runID)
+}
+
+// ListRunPolicyResults returns the result of every policy evaluated against a run.
+func (c *Client) ListRunPolicyResults(ctx context.Context, runID string) ([]RunPolicyResult, error) {
+	return c.client.ListRunPolicyResults(ctx, runID)
+}
+
+// GetRunLogs returns the page of a run's log lines following cursor. An empty cursor
+// reads from the start of the log.
+func (c *Client) GetRunLogs(ctx context.Context, runID, cursor string) (*RunLogPage, error) {
+	return c.client.GetRunLogs(ctx, runID, cursor)
+}
+
+// StreamRunLogs calls fn with each new batch of a run's log lines, polling every
+// interval while the run is still writing its log, and returns once the log is
+// complete. It stops early with fn's error or when ctx is done.
+func (c *Client) StreamRunLogs(ctx context.Context, runID string, interval time.Duration, fn func([]RunLogLine) error) error {
+	return c.client.StreamRunLogs(ctx, runID, interval, fn)
+}
+
+// TailRunLogs returns the last n lines a run has logged so far in phase, or in any
+// phase if phase is empty, and whether its log is complete. It reads the log without
+// waiting for the run to finish.
+func (c *Client) TailRunLogs(ctx context.Context, runID, phase string, n int) ([]RunLogLine, bool, error) {
+	return c.client.TailRunLogs(ctx, runID, phase, n)
+}
+
+// ListActiveStackRuns returns a stack's queued and running runs, oldest first.
+func (c *Client) ListActiveStackRuns(ctx context.Context, stackID string) ([]ActiveRun, error) {
+	return c.client.ListActiveStackRuns(ctx, stackID)
+}
+
+// WaitForStackIdle polls a stack's active runs every interval until it has none. It
+// fails if ctx is done first; bound the wait with a context deadline.
+func (c *Client) WaitForStackIdle(ctx context.Context, stackID string, interval time.Duration) error {
+	return c.client.WaitForStackIdle(ctx, stackID, interval)
+}
+
+// CreateSecretBackend creates a new secret backend.
+func (c *Client) CreateSecretBackend(ctx context.Context, req CreateSecretBackendRequest) (*SecretBackend, error) {
+	return c.client.CreateSecretBackend(ctx, req)
+}
+
+// GetSecretBackend retrieves a secret backend by ID.
+func (c *Client) GetSecretBackend(ctx context.Context, id string) (*SecretBackend, error) {
+	return c.client.GetSecretBackend(ctx, id)
+}
+
+// UpdateSecretBackend updates an existing secret backend.
+func (c *Client) UpdateSecretBackend(ctx context.Context, id string, req UpdateSecretBackendRequest) (*SecretBackend, error) {
+	return c.client.UpdateSecretBackend(ctx, id, req)
+}
+
+// DeleteSecretBackend deletes a secret backend by ID. The API rejects the request
+// with a ConflictError while bundle secret references still use the backend.
+func (c *Client) DeleteSecretBackend(ctx context.Context, id string) error {
+	return c.client.DeleteSecretBackend(ctx, id)
+}
+
+// CreateBundleSecretReference adds a secret reference to a bundle.
+func (c *Client) CreateBundleSecretReference(ctx context.Context, bundleID string, req BundleSecretReferenceRequest) (*BundleSecretReference, error) {
+	return c.client.CreateBundleSecretReference(ctx, bundleID, req)
+}
+
+// GetBundleSecretReference retrieves a secret reference of a bundle by ID.
+func (c *Client) GetBundleSecretReference(ctx context.Context, bundleID, id string) (*BundleSecretReference, error) {
+	return c.client.GetBundleSecretReference(ctx, bundleID, id)
+}
+
+// UpdateBundleSecretReference replaces a secret reference of a bundle.
+func (c *Client) UpdateBundleSecretReference(ctx context.Context, bundleID, id string, req BundleSecretReferenceRequest) (*BundleSecretReference, error) {
+	return c.client.UpdateBundleSecretReference(ctx, bundleID, id, req)
+}
+
+// DeleteBundleSecretReference removes a secret reference from a bundle.
+func (c *Client) DeleteBundleSecretReference(ctx context.Context, bundleID, id string) error {
+	return c.client.DeleteBundleSecretReference(ctx, bundleID, id)
+}
+
+// CreateSigningKey registers a new signing key.
+func (c *Client) CreateSigningKey(ctx context.Context, req CreateSigningKeyRequest) (*SigningKey, error) {
+	return c.client.CreateSigningKey(ctx, req)
+}
+
+// GetSigningKey retrieves a signing key by ID.
+func (c *Client) GetSigningKey(ctx context.Context, id string) (*SigningKey, error) {
+	return c.client.GetSigningKey(ctx, id)
+}
+
+// ListSigningKeys returns all signing keys in the organization.
+func (c *Client) ListSigningKeys(ctx context.Context) ([]SigningKey, error) {
+	return c.client.ListSigningKeys(ctx)
+}
+
+// UpdateSigningKey updates an existing signing key.
+func (c *Client) UpdateSigningKey(ctx context.Context, id string, req UpdateSigningKeyRequest) (*SigningKey, error) {
+	return c.client.UpdateSigningKey(ctx, id, req)
+}
+
+// DeleteSigningKey deletes a signing key by ID.
+func (c *Client) DeleteSigningKey(ctx context.Context, id string) error {
+	return c.client.DeleteSigningKey(ctx, id)
+}
+
+// GetSpaceVariables retrieves the variables set directly on a space. Variables inherited
+// from parent spaces are not included. Secret values are withheld, as for GetStackVariables.
+func (c *Client) GetSpaceVariables(ctx context.Context, spaceID string) ([]StackVariable, error) {
+	return c.client.GetSpaceVariables(ctx, spaceID)
+}
+
+// SetSpaceVariables replaces all variables set directly on a space.
+// This is a replace-all operation; missing keys are deleted.
+func (c *Client) SetSpaceVariables(ctx context.Context, spaceID string, vars []StackVariable) ([]StackVariable, error) {
+	return c.client.SetSpaceVariables(ctx, spaceID, vars)
+}
+
+// CreateSpace creates a new space.
+func (c *Client) CreateSpace(ctx context.Context, req CreateSpaceRequest) (*Space, error) {
+	return c.client.CreateSpace(ctx, req)
+}
+
+// GetSpace retrieves a space by ID.
+func (c *Client) GetSpace(ctx context.Context, id string) (*Space, error) {
+	return c.client.GetSpace(ctx, id)
+}
+
+// ListSpaces returns all spaces in the organization. opts may be nil.
+func (c *Client) ListSpaces(ctx context.Context, opts *ListOptions) ([]Space, error) {
+	return c.client.ListSpaces(ctx, opts)
+}
+
+// UpdateSpace updates an existing space.
+func (c *Client) UpdateSpace(ctx context.Context, id string, req UpdateSpaceRequest) (*Space, error) {
+	return c.client.UpdateSpace(ctx, id, req)
+}
+
+// DeleteSpace deletes a space by ID. The API rejects the request with a
+// ConflictError listing the blockers when the space still has children or stacks.
+func (c *Client) DeleteSpace(ctx context.Context, id string) error {
+	return c.client.DeleteSpace(ctx, id)
+}
+
+// DeleteSpaceRecursive deletes a space by ID together with all of its child spaces and stacks.
+func (c *Client) DeleteSpaceRecursive(ctx context.Context, id string) error {
+	return c.client.DeleteSpaceRecursive(ctx, id)
+}
+
+// GetStackDependencyGraph returns the dependency graph between stacks, optionally filtered.
+func (c *Client) GetStackDependencyGraph(ctx context.Context, opts *StackDependencyGraphOptions) (*StackDependencyGraph, error) {
+	return c.client.GetStackDependencyGraph(ctx, opts)
+}
+
+// ValidateStackManifest parses and checks a stack manifest without creating or changing
+// a stack, and returns its normalized form and the stack it describes.
+func (c *Client) ValidateStackManifest(ctx context.Context, req ValidateStackManifestRequest) (*StackManifestValidation, error) {
+	return c.client.ValidateStackManifest(ctx, req)
+}
+
+// CreateStackFromManifest creates a stack from a manifest.
+func (c *Client) CreateStackFromManifest(ctx context.Context, req StackManifestRequest) (*StackManifest, error) {
+	return c.client.CreateStackFromManifest(ctx, req)
+}
+
+// GetStackManifest retrieves a stack with the normalized manifest that describes its
+// current settings, including changes made outside the manifest.
+func (c *Client) GetStackManifest(ctx context.Context, stackID string) (*StackManifest, error) {
+	return c.client.GetStackManifest(ctx, stackID)
+}
+
+// UpdateStackFromManifest replaces a stack's settings with those of a manifest.
+// Settings the manifest leaves out are reset to their defaults.
+func (c *Client) UpdateStackFromManifest(ctx context.Context, stackID string, req StackManifestRequest) (*StackManifest, error) {
+	return c.client.UpdateStackFromManifest(ctx, stackID, req)
+}
+
+// ListStackTemplates returns the stack templates available to the organization.
+func (c *Client) ListStackTemplates(ctx context.Context) ([]StackTemplate, error) {
+	return c.client.ListStackTemplates(ctx)
+}
+
+// CreateStack creates a new stack.
+func (c *Client) CreateStack(ctx context.Context, req CreateStackRequest) (*Stack, error) {
+	return c.client.CreateStack(ctx, req)
+}
+
+// GetStack retrieves a stack by ID.
+func (c *Client) GetStack(ctx context.Context, id string) (*Stack, error) {
+	return c.client.GetStack(ctx, id)
+}
+
+// GetStackFields retrieves a stack by ID with only the given fields populated. See Fields.
+func (c *Client) GetStackFields(ctx context.Context, id string, fields Fields) (*Stack, error) {
+	return c.client.GetStackFields(ctx, id, fields)
+}
+
+// GetStackHealth retrieves the health of a stack: whether it has drifted, its latest
+// run failed, or it is locked.
+func (c *Client) GetStackHealth(ctx context.Context, id string) (*StackHealth, error) {
+	return c.client.GetStackHealth(ctx, id)
+}
+
+// WaitForStackReady polls a stack every interval until its status is ready and
+// returns the ready stack. It fails if the stack reports a failed status or ctx
+// is done first; bound the wait with a context deadline. Stacks created by an
+// API that does not report a status are treated as ready.
+func (c *Client) WaitForStackReady(ctx context.Context, id string, interval time.Duration) (*Stack, error) {
+	return c.client.WaitForStackReady(ctx, id, interval)
+}
+
+// ListStacks returns stacks in the organization, optionally filtered.
+func (c *Client) ListStacks(ctx context.Context, opts *ListStacksOptions) ([]Stack, error) {
+	return c.client.ListStacks(ctx, opts)
+}
+
+// UpdateStack updates an existing stack.
+func (c *Client) UpdateStack(ctx context.Context, id string, req UpdateStackRequest) (*Stack, error) {
+	return c.client.UpdateStack(ctx, id, req)
+}
+
+// DeleteStack deletes a stack by ID. Without options, the API refuses (409) to delete a
+// stack that has active runs or attached bundles.
+func (c *Client) DeleteStack(ctx context.Context, id string, opts *DeleteStackOptions) error {
+	return c.client.DeleteStack(ctx, id, opts)
+}
+
+// GetStackVariables retrieves the environment variables for a stack.
+// Secret values are withheld; see StackVariable.ValueMasked.
+func (c *Client) GetStackVariables(ctx context.Context, stackID string) ([]StackVariable, error) {
+	return c.client.GetStackVariables(ctx, stackID)
+}
+
+// SetStackVariables replaces all environment variables on a stack.
+// This is a replace-all operation; missing keys are deleted.
+func (c *Client) SetStackVariables(ctx context.Context, stackID string, vars []StackVariable) ([]StackVariable, error) {
+	return c.client.SetStackVariables(ctx, stackID, vars)
+}
+
+// SetStackSource updates the source configuration for a stack.
+func (c *Client) SetStackSource(ctx context.Context, stackID string, source StackSource) error {
+	return c.client.SetStackSource(ctx, stackID, source)
+}
+
+// SetStackTriggers updates the trigger configuration for a stack.
+func (c *Client) SetStackTriggers(ctx context.Context, stackID string, triggers StackTriggers) error {
+	return c.client.SetStackTriggers(ctx, stackID, triggers)
+}
+
+// ListStateSnapshots returns the stored state snapshots for a stack, newest first.
+func (c *Client) ListStateSnapshots(ctx context.Context, stackID string) ([]StateSnapshot, error) {
+	return c.client.ListStateSnapshots(ctx, stackID)
+}
+
+// RollbackState restores a stack's state to the given snapshot. The API rejects the
+// request with a conflict while a run holds the stack's state lock.
+func (c *Client) RollbackState(ctx context.Context, stackID string, req RollbackStateRequest) (*StateRollback, error) {
+	return c.client.RollbackState(ctx, stackID, req)
+}
+
+// GetTokenPermissions reads the role and permissions of the client's API token.
+func (c *Client) GetTokenPermissions(ctx context.Context) (*TokenPermissions, error) {
+	return c.client.GetTokenPermissions(ctx)
+}
+
+// CreateVariableSet creates a new variable set in the organization.
+func (c *Client) CreateVariableSet(ctx context.Context, req CreateVariableSetRequest) (*VariableSet, error) {
+	return c.client.CreateVariableSet(ctx, req)
+}
+
+// GetVariableSet retrieves a variable set by ID.
+func (c *Client) GetVariableSet(ctx context.Context, id string) (*VariableSet, error) {
+	return c.client.GetVariableSet(ctx, id)
+}
+
+// UpdateVariableSet updates the name, description, or reach of a variable set.
+func (c *Client) UpdateVariableSet(ctx context.Context, id string, req UpdateVariableSetRequest) (*VariableSet, error) {
+	return c.client.UpdateVariableSet(ctx, id, req)
+}
+
+// DeleteVariableSet deletes a variable set with its variables and scopes.
+func (c *Client) DeleteVariableSet(ctx context.Context, id string) error {
+	return c.client.DeleteVariableSet(ctx, id)
+}
+
+// CreateVariableSetVariable adds an environment variable to a variable set. The API
+// answers with a conflict if the set already has a variable with the same key.
+func (c *Client) CreateVariableSetVariable(ctx context.Context, setID string, req VariableSetVariableRequest) (*VariableSetVariable, error) {
+	return c.client.CreateVariableSetVariable(ctx, setID, req)
+}
+
+// GetVariableSetVariable retrieves a variable of a variable set by key.
+func (c *Client) GetVariableSetVariable(ctx context.Context, setID, key string) (*VariableSetVariable, error) {
+	return c.client.GetVariableSetVariable(ctx, setID, key)
+}
+
+// UpdateVariableSetVariable replaces the value, secrecy, and description of a variable
+// of a variable set. req.Key must match key.
+func (c *Client) UpdateVariableSetVariable(ctx context.Context, setID, key string, req VariableSetVariableRequest) (*VariableSetVariable, error) {
+	return c.client.UpdateVariableSetVariable(ctx, setID, key, req)
+}
+
+// DeleteVariableSetVariable removes a variable from a variable set.
+func (c *Client) DeleteVariableSetVariable(ctx context.Context, setID, key string) error {
+	return c.client.DeleteVariableSetVariable(ctx, setID, key)
+}
+
+// CreateVariableSetScope adds a scope to a variable set.
+func (c *Client) CreateVariableSetScope(ctx context.Context, setID string, req CreateVariableSetScopeRequest) (*VariableSetScope, error) {
+	return c.client.CreateVariableSetScope(ctx, setID, req)
+}
+
+// GetVariableSetScope retrieves a scope of a variable set by ID.
+func (c *Client) GetVariableSetScope(ctx context.Context, setID, id string) (*VariableSetScope, error) {
+	return c.client.GetVariableSetScope(ctx, setID, id)
+}
+
+// DeleteVariableSetScope removes a scope from a variable set.
+func (c *Client) DeleteVariableSetScope(ctx context.Context, setID, id string) error {
+	return c.client.DeleteVariableSetScope(ctx, setID, id)
+}
+
+// CreateVCSIntegration creates a new VCS integration.
+func (c *Client) CreateVCSIntegration(ctx context.Context, req CreateVCSIntegrationRequest) (*VCSIntegration, error) {
+	return c.client.CreateVCSIntegration(ctx, req)
+}
+
+// GetVCSIntegration retrieves a VCS integration by ID.
+func (c *Client) GetVCSIntegration(ctx context.Context, id string) (*VCSIntegration, error) {
+	return c.client.GetVCSIntegration(ctx, id)
+}
+
+// ListVCSIntegrations returns all VCS integrations in the organization.
+func (c *Client) ListVCSIntegrations(ctx context.Context) ([]VCSIntegration, error) {
+	return c.client.ListVCSIntegrations(ctx)
+}
+
+// UpdateVCSIntegration updates an existing VCS integration.
+func (c *Client) UpdateVCSIntegration(ctx context.Context, id string, req UpdateVCSIntegrationRequest) (*VCSIntegration, error) {
+	return c.client.UpdateVCSIntegration(ctx, id, req)
+}
+
+// UpdateVCSIntegrationCredentials replaces the credentials an integration uses to reach
+// the VCS provider. Credentials cannot be changed through UpdateVCSIntegration.
+func (c *Client) UpdateVCSIntegrationCredentials(ctx context.Context, id string, req UpdateVCSCredentialsRequest) (*VCSIntegration, error) {
+	return c.client.UpdateVCSIntegrationCredentials(ctx, id, req)
+}
+
+// DeleteVCSIntegration deletes a VCS integration by ID.
+func (c *Client) DeleteVCSIntegration(ctx context.Context, id string) error {
+	return c.client.DeleteVCSIntegration(ctx, id)
+}
+
+// ResolveVCSRef resolves a branch or tag in a repository reachable through the integration
+// to the commit it currently points at. refType may be empty, in which case the API
+// looks the ref up as a branch first and then as a tag.
+func (c *Client) ResolveVCSRef(ctx context.Context, integrationID, repositoryID, ref, refType string) (*VCSRef, error) {
+	return c.client.ResolveVCSRef(ctx, integrationID, repositoryID, ref, refType)
+}
+
+// GetWebhookEndpoint retrieves a webhook endpoint by ID.
+func (c *Client) GetWebhookEndpoint(ctx context.Context, id string) (*WebhookEndpoint, error) {
+	return c.client.GetWebhookEndpoint(ctx, id)
+}
+
+// ListWebhookEndpoints returns all webhook endpoints in the organization.
+func (c *Client) ListWebhookEndpoints(ctx context.Context) ([]WebhookEndpoint, error) {
+	return c.client.ListWebhookEndpoints(ctx)
+}
+
+// RotateWebhookEndpointSecret replaces the signing secret of a webhook endpoint and
+// returns the new one. The previous secret keeps signing deliveries until
+// PreviousSecretExpiresAt.
+func (c *Client) RotateWebhookEndpointSecret(ctx context.Context, id string) (*WebhookSecretRotation, error) {
+	return c.client.RotateWebhookEndpointSecret(ctx, id)
+}
+
+// GetWorkerPoolAssignment retrieves the default worker pool assigned to a space.
+func (c *Client) GetWorkerPoolAssignment(ctx context.Context, spaceID string) (*WorkerPoolAssignment, error) {
+	return c.client.GetWorkerPoolAssignment(ctx, spaceID)
+}
+
+// SetWorkerPoolAssignment creates or replaces the default worker pool for a space.
+func (c *Client) SetWorkerPoolAssignment(ctx context.Context, spaceID string, req SetWorkerPoolAssignmentRequest) (*WorkerPoolAssignment, error) {
+	return c.client.SetWorkerPoolAssignment(ctx, spaceID, req)
+}
+
+// DeleteWorkerPoolAssignment removes the default worker pool from a space.
+func (c *Client) DeleteWorkerPoolAssignment(ctx context.Context, spaceID string) error {
+	return c.client.DeleteWorkerPoolAssignment(ctx, spaceID)
+}
+
+// CreateWorkerPool creates a new worker pool.
+// The response includes the api_key which is only returned once at creation.
+func (c *Client) CreateWorkerPool(ctx context.Context, req CreateWorkerPoolRequest) (*CreateWorkerPoolResponse, error) {
+	return c.client.CreateWorkerPool(ctx, req)
+}
+
+// GetWorkerPool retrieves a worker pool by ID.
+func (c *Client) GetWorkerPool(ctx context.Context, id string) (*WorkerPool, error) {
+	return c.client.GetWorkerPool(ctx, id)
+}
+
+// ListWorkerPools returns all worker pools in the organization. opts may be nil.
+func (c *Client) ListWorkerPools(ctx context.Context, opts *ListOptions) ([]WorkerPool, error) {
+	return c.client.ListWorkerPools(ctx, opts)
+}
+
+// UpdateWorkerPool updates an existing worker pool.
+func (c *Client) UpdateWorkerPool(ctx context.Context, id string, req UpdateWorkerPoolRequest) (*WorkerPool, error) {
+	return c.client.UpdateWorkerPool(ctx, id, req)
+}
+
+// DrainWorkerPool stops scheduling new runs on a worker pool. Runs already executing on
+// the pool are allowed to finish.
+func (c *Client) DrainWorkerPool(ctx context.Context, id string) (*WorkerPool, error) {
+	return c.client.DrainWorkerPool(ctx, id)
+}
+
+// ResumeWorkerPool cancels a drain so the pool accepts new runs again.
+func (c *Client) ResumeWorkerPool(ctx context.Context, id string) (*WorkerPool, error) {
+	return c.client.ResumeWorkerPool(ctx, id)
+}
+
+// WaitForWorkerPoolDrained polls a draining pool every interval until no runs are
+// executing on it and returns the drained pool. It fails if ctx is done first; bound
+// the wait with a context deadline.
+func (c *Client) WaitForWorkerPoolDrained(ctx context.Context, id string, interval time.Duration) (*WorkerPool, error) {
+	return c.client.WaitForWorkerPoolDrained(ctx, id, interval)
+}
+
+// DeleteWorkerPool deletes a worker pool by ID.
+func (c *Client) DeleteWorkerPool(ctx context.Context, id string) error {
+	return c.client.DeleteWorkerPool(ctx, id)
+}
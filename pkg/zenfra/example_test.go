@@ -0,0 +1,31 @@
+// ABOUTME: Runnable documentation for the public SDK.
+// ABOUTME: Compiled by go test; not run, since it needs a Zenfra API token.
+
+package zenfra_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/zenfra/terraform-provider-zenfra/pkg/zenfra"
+)
+
+func ExampleNew() {
+	endpoint, _ := zenfra.RegionEndpoint("eu")
+	client, err := zenfra.New(endpoint, os.Getenv("ZENFRA_API_TOKEN"), zenfra.WithUserAgent("release-bot/1.0"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	stack, err := client.GetStack(context.Background(), "stack-123")
+	switch {
+	case zenfra.IsNotFound(err):
+		fmt.Println("stack-123 does not exist")
+	case err != nil:
+		log.Fatal(err)
+	default:
+		fmt.Println(stack.Name, stack.Status)
+	}
+}
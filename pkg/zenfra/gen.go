@@ -0,0 +1,306 @@
+// ABOUTME: Generates types.go, the aliases of zenfraclient's types and constants, and client.go, the Client methods.
+// ABOUTME: Run with go generate ./pkg/zenfra after adding or removing an exported type or Client method in internal/zenfraclient.
+
+//go:build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const source = "../../internal/zenfraclient"
+
+// excluded names stay internal to the provider, as do the per-domain interfaces in
+// api.go (named *API), which are shaped around the provider's resources. Everything
+// else exported by zenfraclient is part of the SDK surface and covered by its
+// compatibility promise.
+var excluded = map[string]string{
+	"Client":            "wrapped in zenfra.go so the provider-only methods stay internal",
+	"ClientConfig":      "replaced by Option",
+	"ForbiddenReadHint": "Terraform-specific wording",
+	"Interaction":       "cassette recorder for provider tests",
+	"RecordedRequest":   "cassette recorder for provider tests",
+	"RecordedResponse":  "cassette recorder for provider tests",
+}
+
+// excludedMethods are the Client methods only the provider has use for. Methods named
+// *Cached are excluded as well: they read the provider's bulk refresh snapshot.
+var excludedMethods = map[string]string{
+	"ForResource":        "attributes requests to a Terraform resource type",
+	"IsBulkRefresh":      "reports the provider's bulk refresh mode",
+	"IsDestroyProtected": "reports the provider's destroy_protection setting",
+	"IsNotFoundOnRead":   "interprets errors during a Terraform refresh",
+	"IsReadOnly":         "reports the provider's read_only setting",
+}
+
+type decl struct {
+	pos  token.Position
+	text string
+	// idents are the package-level type names a method signature uses, which must be
+	// re-exported for the method to compile in pkg/zenfra.
+	idents []string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(source, "*.go"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var decls, methods []decl
+	aliased := make(map[string]bool)
+	imports := make(map[string]string)
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		file, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fileImports := make(map[string]string)
+		for _, spec := range file.Imports {
+			path := strings.Trim(spec.Path.Value, `"`)
+			pkg := filepath.Base(path)
+			if spec.Name != nil {
+				pkg = spec.Name.Name
+			}
+			fileImports[pkg] = path
+		}
+		for _, d := range file.Decls {
+			if fn, ok := d.(*ast.FuncDecl); ok {
+				if m, ok := method(fset, fn, fileImports, imports); ok {
+					methods = append(methods, m)
+				}
+				continue
+			}
+			gen, ok := d.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			switch gen.Tok {
+			case token.TYPE:
+				for _, spec := range gen.Specs {
+					if text := alias(fset, gen, spec.(*ast.TypeSpec)); text != "" {
+						aliased[spec.(*ast.TypeSpec).Name.Name] = true
+						decls = append(decls, decl{pos: fset.Position(spec.Pos()), text: text})
+					}
+				}
+			case token.CONST:
+				if text := constants(gen); text != "" {
+					decls = append(decls, decl{pos: fset.Position(gen.Pos()), text: text})
+				}
+			}
+		}
+	}
+	sortDecls(decls)
+	sortDecls(methods)
+
+	var b bytes.Buffer
+	b.WriteString(`// ABOUTME: Aliases re-exporting the API types and constants of internal/zenfraclient.
+// ABOUTME: Keeps the SDK and the provider on one set of types so they cannot drift apart.
+
+// Code generated by gen.go from ../../internal/zenfraclient; DO NOT EDIT.
+
+package zenfra
+
+import "github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+`)
+	for _, d := range decls {
+		b.WriteString("\n" + d.text)
+	}
+
+	write("types.go", b.Bytes())
+
+	for _, m := range methods {
+		for _, name := range m.idents {
+			if !aliased[name] {
+				log.Fatalf("%s: Client method uses %s, which pkg/zenfra does not re-export", m.pos, name)
+			}
+		}
+	}
+	b.Reset()
+	b.WriteString(`// ABOUTME: Methods of the SDK Client, each forwarding to the provider's client.
+// ABOUTME: Leaves out the methods only the provider uses, listed in gen.go.
+
+// Code generated by gen.go from ../../internal/zenfraclient; DO NOT EDIT.
+
+package zenfra
+
+import (
+`)
+	paths := make([]string, 0, len(imports))
+	for _, path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		fmt.Fprintf(&b, "\t%q\n", path)
+	}
+	b.WriteString(")\n")
+	for _, m := range methods {
+		b.WriteString("\n" + m.text)
+	}
+	write("client.go", b.Bytes())
+}
+
+func sortDecls(decls []decl) {
+	sort.Slice(decls, func(i, j int) bool {
+		if decls[i].pos.Filename != decls[j].pos.Filename {
+			return decls[i].pos.Filename < decls[j].pos.Filename
+		}
+		return decls[i].pos.Offset < decls[j].pos.Offset
+	})
+}
+
+func write(name string, code []byte) {
+	src, err := format.Source(code)
+	if err != nil {
+		log.Fatalf("formatting generated code: %v\n%s", err, code)
+	}
+	if err := os.WriteFile(name, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// method returns a forwarding method for an exported Client method, recording the
+// packages its signature needs in imports.
+func method(fset *token.FileSet, fn *ast.FuncDecl, fileImports, imports map[string]string) (decl, bool) {
+	if fn.Recv == nil || !fn.Name.IsExported() || excludedMethods[fn.Name.Name] != "" ||
+		strings.HasSuffix(fn.Name.Name, "Cached") {
+		return decl{}, false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return decl{}, false
+	}
+	if recv, ok := star.X.(*ast.Ident); !ok || recv.Name != "Client" {
+		return decl{}, false
+	}
+
+	var idents []string
+	fields := fn.Type.Params.List
+	if fn.Type.Results != nil {
+		fields = append(fields[:len(fields):len(fields)], fn.Type.Results.List...)
+	}
+	for _, field := range fields {
+		ast.Inspect(field.Type, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.SelectorExpr:
+				pkg := n.X.(*ast.Ident).Name
+				imports[pkg] = fileImports[pkg]
+				return false
+			case *ast.Ident:
+				if n.IsExported() {
+					idents = append(idents, n.Name)
+				}
+			}
+			return true
+		})
+	}
+
+	var args []string
+	for _, field := range fn.Type.Params.List {
+		if len(field.Names) == 0 {
+			log.Fatalf("%s: Client.%s has an unnamed parameter", fset.Position(fn.Pos()), fn.Name.Name)
+		}
+		for _, n := range field.Names {
+			arg := n.Name
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				arg += "..."
+			}
+			args = append(args, arg)
+		}
+	}
+
+	var sig bytes.Buffer
+	_ = format.Node(&sig, fset, fn.Type)
+	call := fmt.Sprintf("c.client.%s(%s)", fn.Name.Name, strings.Join(args, ", "))
+	if fn.Type.Results != nil {
+		call = "return " + call
+	}
+
+	var b strings.Builder
+	writeDoc(&b, fn.Doc, "")
+	fmt.Fprintf(&b, "func (c *Client) %s%s {\n\t%s\n}\n",
+		fn.Name.Name, strings.TrimPrefix(sig.String(), "func"), call)
+	return decl{pos: fset.Position(fn.Pos()), text: b.String(), idents: idents}, true
+}
+
+func alias(fset *token.FileSet, gen *ast.GenDecl, ts *ast.TypeSpec) string {
+	name := ts.Name.Name
+	if !ast.IsExported(name) || excluded[name] != "" || strings.HasSuffix(name, "API") {
+		return ""
+	}
+	doc := ts.Doc
+	if doc == nil && len(gen.Specs) == 1 {
+		doc = gen.Doc
+	}
+
+	var b strings.Builder
+	writeDoc(&b, doc, name)
+	if ts.TypeParams == nil {
+		fmt.Fprintf(&b, "type %s = zenfraclient.%s\n", name, name)
+		return b.String()
+	}
+
+	var params, args []string
+	for _, field := range ts.TypeParams.List {
+		var constraint bytes.Buffer
+		_ = format.Node(&constraint, fset, field.Type)
+		var names []string
+		for _, n := range field.Names {
+			names = append(names, n.Name)
+			args = append(args, n.Name)
+		}
+		params = append(params, strings.Join(names, ", ")+" "+constraint.String())
+	}
+	fmt.Fprintf(&b, "type %s[%s] = zenfraclient.%s[%s]\n", name, strings.Join(params, ", "), name, strings.Join(args, ", "))
+	return b.String()
+}
+
+func constants(gen *ast.GenDecl) string {
+	var b strings.Builder
+	var names []string
+	for _, spec := range gen.Specs {
+		for _, n := range spec.(*ast.ValueSpec).Names {
+			if ast.IsExported(n.Name) && excluded[n.Name] == "" {
+				names = append(names, n.Name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	writeDoc(&b, gen.Doc, "")
+	b.WriteString("const (\n")
+	for _, n := range names {
+		fmt.Fprintf(&b, "\t%s = zenfraclient.%s\n", n, n)
+	}
+	b.WriteString(")\n")
+	return b.String()
+}
+
+func writeDoc(b *strings.Builder, doc *ast.CommentGroup, name string) {
+	if doc == nil {
+		if name != "" {
+			fmt.Fprintf(b, "// %s is a Zenfra API type.\n", name)
+		}
+		return
+	}
+	for _, c := range doc.List {
+		b.WriteString(c.Text + "\n")
+	}
+}
@@ -0,0 +1,74 @@
+// ABOUTME: Functional options for New, covering the client settings that are part of the SDK.
+// ABOUTME: Each option sets fields of the provider's client configuration, which stays unexported.
+
+package zenfra
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+// Option configures a client created by New.
+type Option func(*config)
+
+// config wraps the provider's client configuration so Option does not expose it.
+type config struct {
+	zenfraclient.ClientConfig
+}
+
+// WithUserAgent identifies the calling tool to the API, e.g. "release-bot/1.0". It
+// replaces the default User-Agent, which names the Terraform provider.
+func WithUserAgent(userAgent string) Option {
+	return func(c *config) { c.UserAgent = userAgent }
+}
+
+// WithTimeout bounds each HTTP request, including reading its response. The default
+// is 30 seconds.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) { c.Timeout = timeout }
+}
+
+// WithMaxRetries sets how often a request is retried after a rate limit (HTTP 429) or
+// a transient server error (HTTP 502, 503, 504, and 500 or 408 for idempotent
+// requests), with exponential backoff. The default is 3.
+func WithMaxRetries(n int) Option {
+	return func(c *config) { c.MaxRetries = n }
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy in deciding which responses are retried.
+// Wrap DefaultRetryPolicy to retry additional status codes.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *config) { c.RetryPolicy = policy }
+}
+
+// WithMaxConcurrentRequests bounds how many requests the client has in flight at once,
+// across all goroutines using it. Further requests wait for a free slot or for their
+// context to be done. By default there is no limit.
+func WithMaxConcurrentRequests(n int) Option {
+	return func(c *config) { c.MaxConcurrentRequests = n }
+}
+
+// WithHeaders sends extra headers on every request, e.g. for an access gateway in
+// front of a self-hosted API. They cannot replace the headers the client sets itself;
+// New fails if they try to.
+func WithHeaders(headers map[string]string) Option {
+	return func(c *config) { c.ExtraHeaders = headers }
+}
+
+// WithTracerProvider records an OpenTelemetry client span for every API call and
+// propagates its trace context to the API.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *config) { c.TracerProvider = tp }
+}
+
+// WithConnectionPool sets how many idle connections to the API are kept open and for
+// how long. Zero values keep the defaults of 32 connections and 90 seconds.
+func WithConnectionPool(maxIdleConns int, idleTimeout time.Duration) Option {
+	return func(c *config) {
+		c.MaxIdleConnsPerHost = maxIdleConns
+		c.IdleConnTimeout = idleTimeout
+	}
+}
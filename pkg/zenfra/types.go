@@ -0,0 +1,861 @@
+// ABOUTME: Aliases re-exporting the API types and constants of internal/zenfraclient.
+// ABOUTME: Keeps the SDK and the provider on one set of types so they cannot drift apart.
+
+// Code generated by gen.go from ../../internal/zenfraclient; DO NOT EDIT.
+
+package zenfra
+
+import "github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+
+// Discovery is the document served at /.well-known/zenfra.json.
+type Discovery = zenfraclient.Discovery
+
+// APIError is the base error type returned by the Zenfra API.
+type APIError = zenfraclient.APIError
+
+// NotFoundError indicates the requested resource was not found (HTTP 404).
+type NotFoundError = zenfraclient.NotFoundError
+
+// ConflictError indicates a conflict with existing state (HTTP 409).
+type ConflictError = zenfraclient.ConflictError
+
+// BlockingResource identifies a resource that prevents an operation from completing,
+// such as a child space or stack that keeps a space from being deleted.
+type BlockingResource = zenfraclient.BlockingResource
+
+// UnauthorizedError indicates missing or invalid authentication (HTTP 401).
+type UnauthorizedError = zenfraclient.UnauthorizedError
+
+// ForbiddenError indicates insufficient permissions (HTTP 403).
+type ForbiddenError = zenfraclient.ForbiddenError
+
+// ValidationError indicates invalid request payload (HTTP 400/422).
+type ValidationError = zenfraclient.ValidationError
+
+// Fields names the top-level attributes a GET response should include, by their JSON
+// names (e.g. "id", "name"). Nil or empty requests full objects. Attributes left out
+// decode to their zero value, so a partial object must not be written back to the API
+// or served from a refresh snapshot.
+type Fields = zenfraclient.Fields
+
+// ListOptions are optional query parameters for list endpoints without filters.
+type ListOptions = zenfraclient.ListOptions
+
+// ManagedByHeader is the request header that names the tool, configuration, and
+// resource type making a request, so the API audit log can attribute each change.
+const (
+	ManagedByHeader = zenfraclient.ManagedByHeader
+)
+
+// OrganizationHeader is the request header that selects the organization a request acts
+// on. Without it, the API uses the organization the API token belongs to.
+const (
+	OrganizationHeader = zenfraclient.OrganizationHeader
+)
+
+// RetryPolicy decides whether a request that got a response with statusCode is sent
+// again. method and header are those of the request. Network errors are always
+// retried, and the number of attempts is bounded by the configured maximum retries.
+type RetryPolicy = zenfraclient.RetryPolicy
+
+// StackDependencyGraphOptions are optional query parameters for reading the dependency graph.
+type StackDependencyGraphOptions = zenfraclient.StackDependencyGraphOptions
+
+// ListStacksOptions are optional query parameters for listing stacks.
+type ListStacksOptions = zenfraclient.ListStacksOptions
+
+// DeleteStackOptions are optional query parameters for deleting a stack.
+type DeleteStackOptions = zenfraclient.DeleteStackOptions
+
+// Space represents a logical grouping of stacks.
+type Space = zenfraclient.Space
+
+// CreateSpaceRequest is the request body for creating a space.
+type CreateSpaceRequest = zenfraclient.CreateSpaceRequest
+
+// UpdateSpaceRequest is the request body for updating a space.
+type UpdateSpaceRequest = zenfraclient.UpdateSpaceRequest
+
+// IaC engines a stack can run.
+const (
+	IACEngineTerraform = zenfraclient.IACEngineTerraform
+	IACEngineOpenTofu  = zenfraclient.IACEngineOpenTofu
+)
+
+// Stack source types.
+const (
+	StackSourceTypeRawGit = zenfraclient.StackSourceTypeRawGit
+	StackSourceTypeVCS    = zenfraclient.StackSourceTypeVCS
+)
+
+// Source ref types: what a stack's source checks out.
+const (
+	SourceRefBranch = zenfraclient.SourceRefBranch
+	SourceRefTag    = zenfraclient.SourceRefTag
+	SourceRefCommit = zenfraclient.SourceRefCommit
+)
+
+// IACConfig represents the Infrastructure as Code tool configuration.
+type IACConfig = zenfraclient.IACConfig
+
+// StackSourceRef identifies what to check out.
+type StackSourceRef = zenfraclient.StackSourceRef
+
+// StackSourceRawGit is a git source cloned by URL, either public or authenticated with
+// an SSH key or HTTPS credentials.
+type StackSourceRawGit = zenfraclient.StackSourceRawGit
+
+// StackSourceHTTPSCredentials are a username and access token for cloning over HTTPS.
+type StackSourceHTTPSCredentials = zenfraclient.StackSourceHTTPSCredentials
+
+// StackSourceVCS is an integration-backed VCS source.
+type StackSourceVCS = zenfraclient.StackSourceVCS
+
+// StackSource is a discriminated union for stack code source.
+type StackSource = zenfraclient.StackSource
+
+// StackTriggerOnPush configures push-based automation triggers.
+type StackTriggerOnPush = zenfraclient.StackTriggerOnPush
+
+// StackTriggerOnPullRequest configures pull/merge request automation triggers.
+type StackTriggerOnPullRequest = zenfraclient.StackTriggerOnPullRequest
+
+// Run types of a scheduled trigger.
+const (
+	ScheduleRunTypePlan  = zenfraclient.ScheduleRunTypePlan
+	ScheduleRunTypeApply = zenfraclient.ScheduleRunTypeApply
+)
+
+// StackTriggerOnSchedule starts a run of RunType at the times of a five-field cron
+// expression, evaluated in UTC.
+type StackTriggerOnSchedule = zenfraclient.StackTriggerOnSchedule
+
+// StackTriggers configures what events can automatically create runs.
+type StackTriggers = zenfraclient.StackTriggers
+
+// StackHooks lists shell commands executed at fixed points of a run.
+type StackHooks = zenfraclient.StackHooks
+
+// LastRunInfo contains summary information about the most recent run.
+type LastRunInfo = zenfraclient.LastRunInfo
+
+// Stack represents an IaC stack resource.
+type Stack = zenfraclient.Stack
+
+// Suggested stack environment types.
+const (
+	StackEnvironmentProduction  = zenfraclient.StackEnvironmentProduction
+	StackEnvironmentStaging     = zenfraclient.StackEnvironmentStaging
+	StackEnvironmentDevelopment = zenfraclient.StackEnvironmentDevelopment
+)
+
+// Stack status values. A new stack is pending while its source is cloned and
+// validated, and cannot run until it is ready.
+const (
+	StackStatusPending = zenfraclient.StackStatusPending
+	StackStatusReady   = zenfraclient.StackStatusReady
+	StackStatusFailed  = zenfraclient.StackStatusFailed
+)
+
+// Stack health values: ok, drifted when drift detection found changes, failed when
+// the latest run failed, and locked while the stack is locked against runs.
+const (
+	StackHealthOK      = zenfraclient.StackHealthOK
+	StackHealthDrifted = zenfraclient.StackHealthDrifted
+	StackHealthFailed  = zenfraclient.StackHealthFailed
+	StackHealthLocked  = zenfraclient.StackHealthLocked
+)
+
+// CreateStackRequest is the request body for creating a stack.
+type CreateStackRequest = zenfraclient.CreateStackRequest
+
+// StackStateSharing lists the stacks, besides the stack itself, that may read a stack's
+// outputs and state, e.g. through a terraform_remote_state data source or an output
+// subscription. The zero value shares with no other stack.
+type StackStateSharing = zenfraclient.StackStateSharing
+
+// UpdateStackRequest is the request body for updating a stack.
+type UpdateStackRequest = zenfraclient.UpdateStackRequest
+
+// MaskedValue is the placeholder the API has historically returned in place of a secret
+// variable's value.
+const (
+	MaskedValue = zenfraclient.MaskedValue
+)
+
+// StackVariable represents a single environment variable on a stack.
+type StackVariable = zenfraclient.StackVariable
+
+// GetStackVariablesResponse is the response for GET /stacks/:id/variables.
+type GetStackVariablesResponse = zenfraclient.GetStackVariablesResponse
+
+// SetStackVariablesRequest is the request for PUT /stacks/:id/variables.
+type SetStackVariablesRequest = zenfraclient.SetStackVariablesRequest
+
+// GetSpaceVariablesResponse is the response for GET /spaces/:id/variables.
+// Space variables use the same shape as stack variables.
+type GetSpaceVariablesResponse = zenfraclient.GetSpaceVariablesResponse
+
+// SetSpaceVariablesRequest is the request for PUT /spaces/:id/variables.
+type SetSpaceVariablesRequest = zenfraclient.SetSpaceVariablesRequest
+
+// StackTemplate is a blueprint that new stacks can be initialized from.
+type StackTemplate = zenfraclient.StackTemplate
+
+// Stack dependency kinds.
+const (
+	StackDependencyRunTrigger         = zenfraclient.StackDependencyRunTrigger
+	StackDependencyKVReference        = zenfraclient.StackDependencyKVReference
+	StackDependencyOutputSubscription = zenfraclient.StackDependencyOutputSubscription
+)
+
+// StackDependencyNode is a stack that appears in the dependency graph.
+type StackDependencyNode = zenfraclient.StackDependencyNode
+
+// StackDependencyEdge records that ToStackID depends on FromStackID, either because
+// a run of FromStackID triggers ToStackID or because ToStackID reads one of its outputs.
+type StackDependencyEdge = zenfraclient.StackDependencyEdge
+
+// StackDependencyGraph is the dependency graph between the organization's stacks.
+type StackDependencyGraph = zenfraclient.StackDependencyGraph
+
+// PoolCapacity shows org-level slot capacity.
+type PoolCapacity = zenfraclient.PoolCapacity
+
+// MaintenanceWindow is a recurring period during which no runs are scheduled on a
+// worker pool. Cron is a five-field expression of the window's start times, evaluated
+// in Timezone (an IANA name; empty means UTC).
+type MaintenanceWindow = zenfraclient.MaintenanceWindow
+
+// WorkerPool represents a worker pool resource.
+type WorkerPool = zenfraclient.WorkerPool
+
+// CreateWorkerPoolRequest is the request body for creating a worker pool.
+type CreateWorkerPoolRequest = zenfraclient.CreateWorkerPoolRequest
+
+// UpdateWorkerPoolRequest is the request body for updating a worker pool.
+type UpdateWorkerPoolRequest = zenfraclient.UpdateWorkerPoolRequest
+
+// CreateWorkerPoolResponse includes the pool and the write-once API key.
+type CreateWorkerPoolResponse = zenfraclient.CreateWorkerPoolResponse
+
+// WorkerPoolAssignment is the default worker pool configured for a space.
+type WorkerPoolAssignment = zenfraclient.WorkerPoolAssignment
+
+// SetWorkerPoolAssignmentRequest is the request body for setting a space's default worker pool.
+type SetWorkerPoolAssignmentRequest = zenfraclient.SetWorkerPoolAssignmentRequest
+
+// EnvVariable represents an environment variable in a bundle.
+type EnvVariable = zenfraclient.EnvVariable
+
+// MountedFile represents a mounted file in a bundle.
+type MountedFile = zenfraclient.MountedFile
+
+// Bundle represents a configuration bundle resource.
+type Bundle = zenfraclient.Bundle
+
+// CreateBundleRequest is the request body for creating a bundle.
+type CreateBundleRequest = zenfraclient.CreateBundleRequest
+
+// UpdateBundleRequest is the request body for updating bundle metadata.
+type UpdateBundleRequest = zenfraclient.UpdateBundleRequest
+
+// UpdateBundleContentRequest is the request body for updating bundle content.
+type UpdateBundleContentRequest = zenfraclient.UpdateBundleContentRequest
+
+// UpdateBundleContentResponse includes the updated bundle and dedup status.
+type UpdateBundleContentResponse = zenfraclient.UpdateBundleContentResponse
+
+// Request size limits of the API. Larger payloads are rejected with a 413 or 422 that
+// does not say which entry is too large.
+const (
+	MaxBundleContentBytes = zenfraclient.MaxBundleContentBytes
+	MaxVariableValueBytes = zenfraclient.MaxVariableValueBytes
+)
+
+// Rules a bundle content violation can break.
+const (
+	BundleContentRuleSyntax        = zenfraclient.BundleContentRuleSyntax
+	BundleContentRuleSizeLimit     = zenfraclient.BundleContentRuleSizeLimit
+	BundleContentRuleForbiddenPath = zenfraclient.BundleContentRuleForbiddenPath
+)
+
+// BundleAttachment represents a bundle attached to a stack.
+type BundleAttachment = zenfraclient.BundleAttachment
+
+// Ways a stack can receive a bundle, see BundleAttachedStack.
+const (
+	BundleAttachedViaStack = zenfraclient.BundleAttachedViaStack
+	BundleAttachedViaSpace = zenfraclient.BundleAttachedViaSpace
+)
+
+// AttachBundleRequest is the request body for attaching a bundle to a stack.
+type AttachBundleRequest = zenfraclient.AttachBundleRequest
+
+// ListAttachmentsResponse is the response for listing stack bundle attachments.
+type ListAttachmentsResponse = zenfraclient.ListAttachmentsResponse
+
+// SpaceBundleAttachment represents a bundle attached to a space.
+type SpaceBundleAttachment = zenfraclient.SpaceBundleAttachment
+
+// ListSpaceAttachmentsResponse is the response for listing space bundle attachments.
+type ListSpaceAttachmentsResponse = zenfraclient.ListSpaceAttachmentsResponse
+
+// API token roles.
+const (
+	TokenRoleRead  = zenfraclient.TokenRoleRead
+	TokenRoleWrite = zenfraclient.TokenRoleWrite
+	TokenRoleAdmin = zenfraclient.TokenRoleAdmin
+)
+
+// Token represents an API token resource.
+type Token = zenfraclient.Token
+
+// CreateTokenRequest is the request body for creating an API token.
+type CreateTokenRequest = zenfraclient.CreateTokenRequest
+
+// CreateTokenResponse includes the write-once token value.
+type CreateTokenResponse = zenfraclient.CreateTokenResponse
+
+// SigningKey is an organization public key used to verify module and provider uploads.
+type SigningKey = zenfraclient.SigningKey
+
+// CreateSigningKeyRequest is the request body for registering a signing key.
+type CreateSigningKeyRequest = zenfraclient.CreateSigningKeyRequest
+
+// UpdateSigningKeyRequest is the request body for renaming a signing key.
+// The key material and expiry cannot be changed.
+type UpdateSigningKeyRequest = zenfraclient.UpdateSigningKeyRequest
+
+// IACToolConfig represents the default IaC tool configuration.
+type IACToolConfig = zenfraclient.IACToolConfig
+
+// OrganizationSettings represents organization-level settings.
+type OrganizationSettings = zenfraclient.OrganizationSettings
+
+// OrganizationBilling represents billing information for an organization.
+type OrganizationBilling = zenfraclient.OrganizationBilling
+
+// Organization represents the current user's organization.
+type Organization = zenfraclient.Organization
+
+// APIRateLimitUsage is the request quota of the API token making the call.
+type APIRateLimitUsage = zenfraclient.APIRateLimitUsage
+
+// RunMinutesUsage is the run minutes consumed in the current billing period.
+type RunMinutesUsage = zenfraclient.RunMinutesUsage
+
+// WorkerSlotUsage is the organization's worker slot consumption.
+type WorkerSlotUsage = zenfraclient.WorkerSlotUsage
+
+// OrganizationUsage represents the organization's current API and capacity usage.
+type OrganizationUsage = zenfraclient.OrganizationUsage
+
+// RunPriorityClass gives runs of stacks in the listed spaces a scheduling priority.
+// Higher priorities are dequeued first.
+type RunPriorityClass = zenfraclient.RunPriorityClass
+
+// RunQueueSettings is the organization-wide run concurrency and queueing configuration.
+// Zero queue limits mean unlimited.
+type RunQueueSettings = zenfraclient.RunQueueSettings
+
+// UpdateRunQueueSettingsRequest replaces the organization's run queue settings.
+type UpdateRunQueueSettingsRequest = zenfraclient.UpdateRunQueueSettingsRequest
+
+// VCS providers.
+const (
+	VCSProviderGitHub = zenfraclient.VCSProviderGitHub
+	VCSProviderGitLab = zenfraclient.VCSProviderGitLab
+)
+
+// VCSExternalAccount holds provider account info.
+type VCSExternalAccount = zenfraclient.VCSExternalAccount
+
+// VCSGitHubConfig is the response for GitHub config (no sensitive fields).
+type VCSGitHubConfig = zenfraclient.VCSGitHubConfig
+
+// VCSGitLabConfig is the response for GitLab config (no sensitive fields).
+type VCSGitLabConfig = zenfraclient.VCSGitLabConfig
+
+// VCSIntegration represents a VCS integration resource.
+type VCSIntegration = zenfraclient.VCSIntegration
+
+// CreateVCSIntegrationRequest is the request body for creating a VCS integration.
+type CreateVCSIntegrationRequest = zenfraclient.CreateVCSIntegrationRequest
+
+// CreateVCSGitLabRequest contains GitLab-specific configuration.
+type CreateVCSGitLabRequest = zenfraclient.CreateVCSGitLabRequest
+
+// CreateVCSGitHubRequest contains GitHub-specific configuration.
+type CreateVCSGitHubRequest = zenfraclient.CreateVCSGitHubRequest
+
+// UpdateVCSIntegrationRequest is the request body for updating a VCS integration.
+type UpdateVCSIntegrationRequest = zenfraclient.UpdateVCSIntegrationRequest
+
+// VCSRef is a branch or tag resolved to the commit it currently points at.
+type VCSRef = zenfraclient.VCSRef
+
+// IACVersion is one release of an IaC engine in the platform's version catalog.
+type IACVersion = zenfraclient.IACVersion
+
+// IACVersionCatalog lists the versions of one IaC engine that stacks can run.
+type IACVersionCatalog = zenfraclient.IACVersionCatalog
+
+// Secret backend types.
+const (
+	SecretBackendVault             = zenfraclient.SecretBackendVault
+	SecretBackendAWSSecretsManager = zenfraclient.SecretBackendAWSSecretsManager
+)
+
+// Vault auth methods. Both use an identity Zenfra issues to the run, so no Vault
+// credential is stored in Zenfra or in Terraform state.
+const (
+	VaultAuthJWT        = zenfraclient.VaultAuthJWT
+	VaultAuthKubernetes = zenfraclient.VaultAuthKubernetes
+)
+
+// SecretBackendVaultConfig connects a secret backend to HashiCorp Vault.
+type SecretBackendVaultConfig = zenfraclient.SecretBackendVaultConfig
+
+// SecretBackendAWSConfig connects a secret backend to AWS Secrets Manager through an
+// IAM role that runs assume with their Zenfra identity token.
+type SecretBackendAWSConfig = zenfraclient.SecretBackendAWSConfig
+
+// SecretBackend is a connection to an external secret store that runs read secrets from.
+type SecretBackend = zenfraclient.SecretBackend
+
+// CreateSecretBackendRequest is the request body for creating a secret backend.
+// Exactly one of Vault and AWSSecretsManager is set, matching Type.
+type CreateSecretBackendRequest = zenfraclient.CreateSecretBackendRequest
+
+// UpdateSecretBackendRequest is the request body for updating a secret backend.
+// A set connection config replaces the stored one; the type cannot change.
+type UpdateSecretBackendRequest = zenfraclient.UpdateSecretBackendRequest
+
+// BundleSecretReference exposes a secret from a secret backend to runs as an
+// environment variable of a bundle. The secret value is read by the runner when the
+// run starts and is never returned by the API.
+type BundleSecretReference = zenfraclient.BundleSecretReference
+
+// BundleSecretReferenceRequest is the request body for creating or replacing a bundle
+// secret reference.
+type BundleSecretReferenceRequest = zenfraclient.BundleSecretReferenceRequest
+
+// VariableSetVariable is an environment variable of a variable set. As in bundles,
+// the API returns an empty Value for a secret variable.
+type VariableSetVariable = zenfraclient.VariableSetVariable
+
+// RunPlanSummary counts the planned resource actions in a run.
+type RunPlanSummary = zenfraclient.RunPlanSummary
+
+// RunPlanResourceChange describes the planned actions for a single resource instance.
+type RunPlanResourceChange = zenfraclient.RunPlanResourceChange
+
+// RunPlan is the structured plan produced by a run.
+type RunPlan = zenfraclient.RunPlan
+
+// Cost estimate statuses. Only a finished estimate carries costs.
+const (
+	CostEstimateStatusPending  = zenfraclient.CostEstimateStatusPending
+	CostEstimateStatusFinished = zenfraclient.CostEstimateStatusFinished
+	CostEstimateStatusErrored  = zenfraclient.CostEstimateStatusErrored
+	CostEstimateStatusSkipped  = zenfraclient.CostEstimateStatusSkipped
+)
+
+// RunCostEstimate summarizes the monthly cost impact of a run's plan. Costs are in Currency.
+type RunCostEstimate = zenfraclient.RunCostEstimate
+
+// Policy enforcement levels. Advisory and soft-mandatory failures let a run continue;
+// hard-mandatory failures block it.
+const (
+	PolicyEnforcementAdvisory      = zenfraclient.PolicyEnforcementAdvisory
+	PolicyEnforcementSoftMandatory = zenfraclient.PolicyEnforcementSoftMandatory
+	PolicyEnforcementHardMandatory = zenfraclient.PolicyEnforcementHardMandatory
+)
+
+// Policy result outcomes.
+const (
+	PolicyOutcomePassed = zenfraclient.PolicyOutcomePassed
+	PolicyOutcomeFailed = zenfraclient.PolicyOutcomeFailed
+)
+
+// RunPolicyViolation is a single rule violated during a policy check.
+type RunPolicyViolation = zenfraclient.RunPolicyViolation
+
+// RunPolicyResult is the outcome of evaluating one policy against a run.
+type RunPolicyResult = zenfraclient.RunPolicyResult
+
+// RunComment is a comment posted on a run. Comments are immutable.
+type RunComment = zenfraclient.RunComment
+
+// CreateRunCommentRequest is the request body for posting a comment on a run.
+type CreateRunCommentRequest = zenfraclient.CreateRunCommentRequest
+
+// StateSnapshot is a stored version of a stack's Terraform state.
+type StateSnapshot = zenfraclient.StateSnapshot
+
+// RollbackStateRequest is the request body for restoring a state snapshot.
+type RollbackStateRequest = zenfraclient.RollbackStateRequest
+
+// StateRollback records a completed state restore. The restored state is written
+// as a new snapshot, so NewSnapshotID and NewSerial identify the stack's current state.
+type StateRollback = zenfraclient.StateRollback
+
+// Compliance report statuses.
+const (
+	ComplianceReportStatusPending = zenfraclient.ComplianceReportStatusPending
+	ComplianceReportStatusReady   = zenfraclient.ComplianceReportStatusReady
+	ComplianceReportStatusFailed  = zenfraclient.ComplianceReportStatusFailed
+)
+
+// Compliance report sections: the kinds of evidence an export can contain.
+const (
+	ComplianceSectionRuns      = zenfraclient.ComplianceSectionRuns
+	ComplianceSectionApprovals = zenfraclient.ComplianceSectionApprovals
+	ComplianceSectionPolicies  = zenfraclient.ComplianceSectionPolicies
+)
+
+// Invitation status values. The API may delete an invitation once it is accepted
+// instead of reporting InvitationStatusAccepted.
+const (
+	InvitationStatusPending  = zenfraclient.InvitationStatusPending
+	InvitationStatusAccepted = zenfraclient.InvitationStatusAccepted
+	InvitationStatusExpired  = zenfraclient.InvitationStatusExpired
+	InvitationStatusRevoked  = zenfraclient.InvitationStatusRevoked
+)
+
+// Organization roles of members, invitations, and domain auto-join.
+const (
+	OrganizationRoleRead  = zenfraclient.OrganizationRoleRead
+	OrganizationRoleWrite = zenfraclient.OrganizationRoleWrite
+	OrganizationRoleAdmin = zenfraclient.OrganizationRoleAdmin
+)
+
+// Organization domain status values. A verified domain whose TXT record disappears
+// goes back to pending at the API's next check.
+const (
+	DomainStatusPending  = zenfraclient.DomainStatusPending
+	DomainStatusVerified = zenfraclient.DomainStatusVerified
+	DomainStatusFailed   = zenfraclient.DomainStatusFailed
+)
+
+// PaginatedResponse wraps paginated list responses from the API.
+type PaginatedResponse[T any] = zenfraclient.PaginatedResponse[T]
+
+// ActiveRun is generated from the OpenAPI schema of the same name.
+//
+// A queued or running run of a stack. While a stack has one, the API rejects changes to
+// the stack's source, triggers, and variables with 409 Conflict.
+type ActiveRun = zenfraclient.ActiveRun
+
+// BundleAttachedStack is generated from the OpenAPI schema of the same name.
+//
+// A stack that receives a bundle's configuration, either through its own attachment or
+// through an attachment to its space or a parent space.
+type BundleAttachedStack = zenfraclient.BundleAttachedStack
+
+// BundleContentValidation is generated from the OpenAPI schema of the same name.
+//
+// The result of validating bundle content. The content is valid when Violations is empty.
+type BundleContentValidation = zenfraclient.BundleContentValidation
+
+// BundleContentViolation is generated from the OpenAPI schema of the same name.
+//
+// One problem found while validating bundle content. At most one of EnvironmentVariable
+// and MountedFile names the entry at fault; neither is set for problems with the content
+// as a whole, such as its total size.
+type BundleContentViolation = zenfraclient.BundleContentViolation
+
+// ComplianceReport is generated from the OpenAPI schema of the same name.
+//
+// A signed evidence export for audits. Once the report is ready, DownloadURL serves the
+// archive until ExpiresAt; SHA256 is the hex digest of the archive and Signature its
+// base64 signature by the signing key SigningKeyID.
+type ComplianceReport = zenfraclient.ComplianceReport
+
+// CreateComplianceReportRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for exporting the evidence recorded from From (inclusive) to To
+// (exclusive).
+type CreateComplianceReportRequest = zenfraclient.CreateComplianceReportRequest
+
+// CreateInvitationRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for inviting someone to the organization.
+type CreateInvitationRequest = zenfraclient.CreateInvitationRequest
+
+// CreateOrganizationDomainRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for claiming an email domain. An empty AutoJoinRole leaves auto-join
+// off.
+type CreateOrganizationDomainRequest = zenfraclient.CreateOrganizationDomainRequest
+
+// CreateOutputSubscriptionRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for subscribing a stack to the outputs of another stack.
+type CreateOutputSubscriptionRequest = zenfraclient.CreateOutputSubscriptionRequest
+
+// CreateRateLimitPolicyRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for creating a rate limit policy.
+type CreateRateLimitPolicyRequest = zenfraclient.CreateRateLimitPolicyRequest
+
+// CreateVariableSetRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for creating a variable set.
+type CreateVariableSetRequest = zenfraclient.CreateVariableSetRequest
+
+// CreateVariableSetScopeRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for adding a scope to a variable set. Scopes cannot be changed once
+// created.
+type CreateVariableSetScopeRequest = zenfraclient.CreateVariableSetScopeRequest
+
+// EffectiveBundle is generated from the OpenAPI schema of the same name.
+//
+// A bundle a stack receives, as resolved by the API. Bundles are applied in order, so a
+// later bundle's environment variables and mounted files override an earlier one's: space
+// attachments come before the stack's own, the farthest parent space first, and
+// attachments at the same level by Priority.
+type EffectiveBundle = zenfraclient.EffectiveBundle
+
+// EgressIPRanges is generated from the OpenAPI schema of the same name.
+//
+// The published list of Zenfra's static egress ranges, for allowlisting runners in firewalls
+// and security groups.
+type EgressIPRanges = zenfraclient.EgressIPRanges
+
+// EgressRegion is generated from the OpenAPI schema of the same name.
+//
+// The static addresses runs in one Zenfra region connect out from.
+type EgressRegion = zenfraclient.EgressRegion
+
+// Invitation is generated from the OpenAPI schema of the same name.
+//
+// A pending offer for someone to join the organization. It is sent by email; accepting it
+// makes the invitee a Member.
+type Invitation = zenfraclient.Invitation
+
+// ManagedLock is generated from the OpenAPI schema of the same name.
+//
+// The "managed by Terraform" lock of a space, stack, or bundle. While it is enabled the
+// Zenfra UI refuses edits to the object. An organization admin can override it from the
+// UI, which disables it and records who did so and when.
+type ManagedLock = zenfraclient.ManagedLock
+
+// Member is generated from the OpenAPI schema of the same name.
+//
+// A user who belongs to the organization.
+type Member = zenfraclient.Member
+
+// OrganizationDomain is generated from the OpenAPI schema of the same name.
+//
+// An email domain the organization has claimed. Once the domain's DNS TXT record is
+// verified, users who sign in with an address at the domain can join the organization with
+// AutoJoinRole.
+type OrganizationDomain = zenfraclient.OrganizationDomain
+
+// OutputSubscription is generated from the OpenAPI schema of the same name.
+//
+// OutputSubscription makes StackID consume the outputs of SourceStackID: Zenfra queues a
+// run of StackID when one of the listed Outputs of SourceStackID changes, or any of its
+// outputs when Outputs is empty.
+type OutputSubscription = zenfraclient.OutputSubscription
+
+// RateLimitBounds is generated from the OpenAPI schema of the same name.
+//
+// The organization's API rate limits. Requests not covered by a policy get
+// DefaultRequestsPerMinute; a policy may grant at most MaxRequestsPerMinute and MaxBurst.
+// Zero maximums mean no limit.
+type RateLimitBounds = zenfraclient.RateLimitBounds
+
+// RateLimitPolicy is generated from the OpenAPI schema of the same name.
+//
+// RateLimitPolicy overrides the organization's API rate limit for the requests of one API
+// token or of one source network. Exactly one of TokenID and SourceCIDR is set.
+type RateLimitPolicy = zenfraclient.RateLimitPolicy
+
+// ResendInvitationRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for sending an invitation's email again. The invitation's expiry
+// restarts from the time it is resent.
+type ResendInvitationRequest = zenfraclient.ResendInvitationRequest
+
+// RetentionSettings is generated from the OpenAPI schema of the same name.
+//
+// How long the organization keeps runs and run logs. Stacks can override either period.
+type RetentionSettings = zenfraclient.RetentionSettings
+
+// RunLogLine is generated from the OpenAPI schema of the same name.
+//
+// One line of a run's log output.
+type RunLogLine = zenfraclient.RunLogLine
+
+// RunLogPage is generated from the OpenAPI schema of the same name.
+//
+// A page of a run's log lines, read from a cursor.
+type RunLogPage = zenfraclient.RunLogPage
+
+// RunnerVersion is generated from the OpenAPI schema of the same name.
+//
+// A Zenfra runner release workers can be pinned to.
+type RunnerVersion = zenfraclient.RunnerVersion
+
+// RunnerVersionConstraint is generated from the OpenAPI schema of the same name.
+//
+// The organization's default runner version constraint, used by every worker pool that
+// does not pin its own.
+type RunnerVersionConstraint = zenfraclient.RunnerVersionConstraint
+
+// SetManagedLockRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for turning a managed lock on or off.
+type SetManagedLockRequest = zenfraclient.SetManagedLockRequest
+
+// StackHealth is generated from the OpenAPI schema of the same name.
+//
+// The operational state of a ready stack, from its status endpoint.
+type StackHealth = zenfraclient.StackHealth
+
+// StackManifest is generated from the OpenAPI schema of the same name.
+//
+// A stack with the manifest that describes it. Normalized is the manifest as canonical
+// JSON: formatting, key order, and defaulted fields do not change it, so it only differs
+// between two manifests that describe different stacks.
+type StackManifest = zenfraclient.StackManifest
+
+// StackManifestError is generated from the OpenAPI schema of the same name.
+//
+// One problem found in a stack manifest. Path is the manifest field at fault, such as
+// "source.vcs.branch"; Line is zero when the problem is not tied to a line, for example a
+// missing field.
+type StackManifestError = zenfraclient.StackManifestError
+
+// StackManifestRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for creating or updating a stack from a manifest. Manifest is YAML or
+// JSON; SpaceID is the space the stack belongs to.
+type StackManifestRequest = zenfraclient.StackManifestRequest
+
+// StackManifestValidation is generated from the OpenAPI schema of the same name.
+//
+// The result of validating a stack manifest. The manifest is valid when Errors is empty;
+// only then are Normalized and Stack set.
+type StackManifestValidation = zenfraclient.StackManifestValidation
+
+// TokenPermissions is generated from the OpenAPI schema of the same name.
+//
+// TokenPermissions describes what the API token the client authenticates with may do.
+type TokenPermissions = zenfraclient.TokenPermissions
+
+// UpdateInvitationRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for changing a pending invitation.
+type UpdateInvitationRequest = zenfraclient.UpdateInvitationRequest
+
+// UpdateOrganizationDomainRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for changing an organization domain. An empty AutoJoinRole turns auto-
+// join off.
+type UpdateOrganizationDomainRequest = zenfraclient.UpdateOrganizationDomainRequest
+
+// UpdateOutputSubscriptionRequest is generated from the OpenAPI schema of the same name.
+//
+// UpdateOutputSubscriptionRequest replaces the outputs a subscription watches. An empty
+// list watches every output.
+type UpdateOutputSubscriptionRequest = zenfraclient.UpdateOutputSubscriptionRequest
+
+// UpdateRateLimitPolicyRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for changing a rate limit policy. The target of a policy cannot change.
+// A zero Burst restores the API default.
+type UpdateRateLimitPolicyRequest = zenfraclient.UpdateRateLimitPolicyRequest
+
+// UpdateRetentionSettingsRequest is generated from the OpenAPI schema of the same name.
+//
+// UpdateRetentionSettingsRequest replaces the organization's retention settings.
+type UpdateRetentionSettingsRequest = zenfraclient.UpdateRetentionSettingsRequest
+
+// UpdateRunnerVersionConstraintRequest is generated from the OpenAPI schema of the same name.
+//
+// UpdateRunnerVersionConstraintRequest replaces the organization's default constraint.
+type UpdateRunnerVersionConstraintRequest = zenfraclient.UpdateRunnerVersionConstraintRequest
+
+// UpdateVCSCredentialsRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for replacing the credentials of a VCS integration.
+type UpdateVCSCredentialsRequest = zenfraclient.UpdateVCSCredentialsRequest
+
+// UpdateVCSGitLabCredentials is generated from the OpenAPI schema of the same name.
+//
+// The replacement access token of a GitLab integration. TokenType is "personal" or
+// "group"; empty keeps the current type.
+type UpdateVCSGitLabCredentials = zenfraclient.UpdateVCSGitLabCredentials
+
+// UpdateVariableSetRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for updating a variable set. Nil fields are left unchanged.
+type UpdateVariableSetRequest = zenfraclient.UpdateVariableSetRequest
+
+// ValidateBundleContentRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for checking bundle content without storing it. BundleID is empty for a
+// bundle that does not exist yet.
+type ValidateBundleContentRequest = zenfraclient.ValidateBundleContentRequest
+
+// ValidateStackManifestRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for checking a stack manifest. StackID is empty for a stack that does
+// not exist yet.
+type ValidateStackManifestRequest = zenfraclient.ValidateStackManifestRequest
+
+// VariableSet is generated from the OpenAPI schema of the same name.
+//
+// An organization-wide set of environment variables. Stacks receive its variables
+// alongside those of their bundles: every stack when AppliesToAllStacks is set, otherwise
+// the stacks matched by one of its VariableSetScopes.
+type VariableSet = zenfraclient.VariableSet
+
+// VariableSetScope is generated from the OpenAPI schema of the same name.
+//
+// VariableSetScope selects stacks a variable set applies to: the stacks of SpaceID, and of
+// its child spaces when IncludeSubspaces is set, or the stacks labeled StackLabel. Exactly
+// one of SpaceID and StackLabel is set.
+type VariableSetScope = zenfraclient.VariableSetScope
+
+// VariableSetVariableRequest is generated from the OpenAPI schema of the same name.
+//
+// The request body for creating or replacing a variable of a variable set.
+type VariableSetVariableRequest = zenfraclient.VariableSetVariableRequest
+
+// WebhookEndpoint is generated from the OpenAPI schema of the same name.
+//
+// An HTTPS endpoint that receives signed event notifications.
+type WebhookEndpoint = zenfraclient.WebhookEndpoint
+
+// WebhookSecretRotation is generated from the OpenAPI schema of the same name.
+//
+// The response to rotating a webhook endpoint's signing secret. Secret is only returned
+// here; the API never returns it again.
+type WebhookSecretRotation = zenfraclient.WebhookSecretRotation
+
+// ListBundleAttachedStacksResponse is generated from an inline OpenAPI schema.
+type ListBundleAttachedStacksResponse = zenfraclient.ListBundleAttachedStacksResponse
+
+// ListMembersResponse is generated from an inline OpenAPI schema.
+type ListMembersResponse = zenfraclient.ListMembersResponse
+
+// ListRateLimitPoliciesResponse is generated from an inline OpenAPI schema.
+type ListRateLimitPoliciesResponse = zenfraclient.ListRateLimitPoliciesResponse
+
+// ListRunnerVersionsResponse is generated from an inline OpenAPI schema.
+type ListRunnerVersionsResponse = zenfraclient.ListRunnerVersionsResponse
+
+// ResolveStackBundlesResponse is generated from an inline OpenAPI schema.
+type ResolveStackBundlesResponse = zenfraclient.ResolveStackBundlesResponse
+
+// ListStackRunsResponse is generated from an inline OpenAPI schema.
+type ListStackRunsResponse = zenfraclient.ListStackRunsResponse
+
+// ListWebhookEndpointsResponse is generated from an inline OpenAPI schema.
+type ListWebhookEndpointsResponse = zenfraclient.ListWebhookEndpointsResponse
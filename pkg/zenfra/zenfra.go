@@ -0,0 +1,97 @@
+// ABOUTME: Public Go SDK for the Zenfra API, built on the client the Terraform provider uses.
+// ABOUTME: Provides the Client type, the New constructor, and re-exports the error helpers and region lookup.
+
+// Package zenfra is a Go client for the Zenfra API. It is the same client the Zenfra
+// Terraform provider uses, with the same authentication, retries with backoff, and
+// typed errors, so tools built on it behave like the provider.
+//
+// Create a client with New and call its methods:
+//
+//	client, err := zenfra.New("https://api.zenfra.io", os.Getenv("ZENFRA_API_TOKEN"),
+//		zenfra.WithUserAgent("release-bot/1.0"))
+//	if err != nil {
+//		return err
+//	}
+//	stack, err := client.GetStack(ctx, "stack-123")
+//	if zenfra.IsNotFound(err) {
+//		// ...
+//	}
+//
+// # Compatibility
+//
+// The package follows the provider's semantic version. Within a major version, the
+// exported names of this package, the methods of Client, and the fields of the API
+// types are only added to, never removed or changed; new fields may appear as the API
+// grows. The provider's internal packages carry no such promise and must not be
+// imported.
+package zenfra
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/zenfra/terraform-provider-zenfra/internal/zenfraclient"
+)
+
+//go:generate go run gen.go
+
+// Client is the Zenfra API client. It is safe for concurrent use. Its methods forward
+// to the provider's client, except for the ones only the provider uses, such as the
+// cached reads of its bulk refresh.
+type Client struct {
+	client *zenfraclient.Client
+}
+
+// New returns a client for the Zenfra API at endpoint, authenticating with apiToken.
+// Use RegionEndpoint or DiscoverEndpoint to find the endpoint of a region.
+func New(endpoint, apiToken string, opts ...Option) (*Client, error) {
+	cfg := config{zenfraclient.ClientConfig{Endpoint: endpoint, APIToken: apiToken}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	client, err := zenfraclient.NewClient(cfg.ClientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("zenfra: %w", err)
+	}
+	return &Client{client: client}, nil
+}
+
+// IsNotFound reports whether err is or wraps a NotFoundError (HTTP 404).
+func IsNotFound(err error) bool { return zenfraclient.IsNotFound(err) }
+
+// IsConflict reports whether err is or wraps a ConflictError (HTTP 409).
+func IsConflict(err error) bool { return zenfraclient.IsConflict(err) }
+
+// IsUnauthorized reports whether err is or wraps an UnauthorizedError (HTTP 401).
+func IsUnauthorized(err error) bool { return zenfraclient.IsUnauthorized(err) }
+
+// IsForbidden reports whether err is or wraps a ForbiddenError (HTTP 403).
+func IsForbidden(err error) bool { return zenfraclient.IsForbidden(err) }
+
+// DefaultRetryPolicy is the retry policy of clients created without WithRetryPolicy. It
+// retries HTTP 429, 502, 503, and 504 for every request, and HTTP 500 and 408 only for
+// idempotent methods, never for POST or PATCH.
+func DefaultRetryPolicy(method string, statusCode int, header http.Header) bool {
+	return zenfraclient.DefaultRetryPolicy(method, statusCode, header)
+}
+
+// WithOrganization returns a context whose requests act on the organization orgID, for
+// API tokens with access to several organizations. An empty orgID clears the override,
+// so requests act on the token's own organization.
+func WithOrganization(ctx context.Context, orgID string) context.Context {
+	return zenfraclient.WithOrganization(ctx, orgID)
+}
+
+// Regions returns the names of the Zenfra regions, sorted.
+func Regions() []string { return zenfraclient.Regions() }
+
+// RegionEndpoint returns the base URL of a region and whether the region is known.
+func RegionEndpoint(region string) (string, bool) { return zenfraclient.RegionEndpoint(region) }
+
+// DiscoverEndpoint fetches the discovery document served at baseURL and returns the
+// API endpoint it advertises, or baseURL itself if it advertises none. httpClient may
+// be nil to use a default client.
+func DiscoverEndpoint(ctx context.Context, httpClient *http.Client, baseURL string) (string, error) {
+	return zenfraclient.DiscoverEndpoint(ctx, httpClient, baseURL)
+}
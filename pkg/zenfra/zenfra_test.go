@@ -0,0 +1,75 @@
+// ABOUTME: Tests for the public SDK constructor and options against an httptest server.
+// ABOUTME: Checks that options reach the wire and that errors keep their SDK-visible types.
+
+package zenfra
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNew_Validation(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New("", "token"); err == nil {
+		t.Error("expected an error without an endpoint")
+	}
+	if _, err := New("https://api.example.com", ""); err == nil {
+		t.Error("expected an error without an API token")
+	}
+	if _, err := New("https://api.example.com", "token", WithHeaders(map[string]string{"Authorization": "x"})); err == nil {
+		t.Error("expected an error for a header the client sets itself")
+	}
+}
+
+func TestNew_Options(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/stacks/stack-1", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer sdk-token" {
+			t.Errorf("expected the API token, got %q", got)
+		}
+		if got := r.Header.Get("User-Agent"); got != "release-bot/1.0" {
+			t.Errorf("expected the configured User-Agent, got %q", got)
+		}
+		if got := r.Header.Get("X-Gateway"); got != "internal" {
+			t.Errorf("expected the extra header, got %q", got)
+		}
+		if got := r.Header.Get(OrganizationHeader); got != "org-2" {
+			t.Errorf("expected the organization override, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(Stack{ID: "stack-1", Name: "app", Status: StackStatusReady})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := New(server.URL, "sdk-token",
+		WithUserAgent("release-bot/1.0"),
+		WithHeaders(map[string]string{"X-Gateway": "internal"}),
+		WithTimeout(5*time.Second),
+		WithMaxRetries(1),
+		WithConnectionPool(4, time.Minute),
+		WithMaxConcurrentRequests(2),
+	)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	stack, err := client.GetStack(WithOrganization(context.Background(), "org-2"), "stack-1")
+	if err != nil {
+		t.Fatalf("GetStack: %v", err)
+	}
+	if stack.Name != "app" || stack.Status != StackStatusReady {
+		t.Errorf("unexpected stack %+v", stack)
+	}
+
+	if _, err := client.GetStack(context.Background(), "missing"); !IsNotFound(err) {
+		t.Errorf("expected a not-found error, got %v", err)
+	}
+}